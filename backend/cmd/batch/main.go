@@ -5,7 +5,16 @@ import (
 	"flag"
 	"log"
 
+	appattendance "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/attendance"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/app/batch"
+	appevent "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/event"
+	appnotification "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/notification"
+	appschedule "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/schedule"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/infra/clock"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/infra/db"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/infra/discord"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/infra/email"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/kelseyhightower/envconfig"
 )
@@ -13,16 +22,25 @@ import (
 // Config represents the application configuration
 type Config struct {
 	DatabaseURL string `envconfig:"DATABASE_URL" required:"true"`
+	BaseURL     string `envconfig:"INVITATION_BASE_URL" default:"https://vrcshift.com"`
+
+	// メール通知の配信（未設定の場合、メールの通知は配信せずに残す）
+	ResendAPIKey    string `envconfig:"RESEND_API_KEY"`
+	ResendFromEmail string `envconfig:"RESEND_FROM_EMAIL"`
 }
 
 func main() {
 	// コマンドライン引数のパース
-	taskFlag := flag.String("task", "", "Task to run: grace-expiry, webhook-cleanup, pending-cleanup")
+	taskFlag := flag.String("task", "", "Task to run: grace-expiry, webhook-cleanup, pending-cleanup, generate-business-days, deliver-notifications, discord-announcements, deadline-reminders, close-expired")
 	dryRun := flag.Bool("dry-run", false, "Dry run mode (no changes)")
+	weeks := flag.Int("weeks", appevent.DefaultUpcomingBusinessDayWeeks, "Weeks ahead to generate business days (generate-business-days)")
+	batchSize := flag.Int("batch-size", appnotification.DefaultDeliveryBatchSize, "Maximum notifications to deliver per run (deliver-notifications)")
+	within := flag.Duration("within", appnotification.DefaultDeadlineReminderWindow, "Remind members of deadlines within this duration (deadline-reminders)")
+	summary := flag.Bool("summary", false, "Announce the response summary of closed collections and schedules to Discord (close-expired)")
 	flag.Parse()
 
 	if *taskFlag == "" {
-		log.Fatal("Please specify a task with -task flag. Available tasks: grace-expiry, webhook-cleanup, pending-cleanup")
+		log.Fatal("Please specify a task with -task flag. Available tasks: grace-expiry, webhook-cleanup, pending-cleanup, generate-business-days, deliver-notifications, discord-announcements, deadline-reminders, close-expired")
 	}
 
 	log.Printf("🔄 VRC Shift Scheduler - Batch Processing")
@@ -76,9 +94,152 @@ func main() {
 			log.Printf("Summary: Deleted %d tenants, Failed %d", result.DeletedCount, result.FailedCount)
 		}
 
+	case "generate-business-days":
+		generator := appevent.NewGenerateUpcomingBusinessDaysUsecase(
+			db.NewEventRepository(pool),
+			db.NewEventBusinessDayRepository(pool),
+			db.NewTenantRepository(pool),
+			db.NewBlackoutDateSetRepository(pool),
+			db.NewShiftSlotTemplateRepository(pool),
+			db.NewShiftSlotRepository(pool),
+			db.NewInstanceRepository(pool),
+			db.NewPgxTxManager(pool),
+		)
+		result, err := processor.RunBusinessDayGeneration(ctx, generator, *weeks, *dryRun)
+		if err != nil {
+			log.Fatalf("Failed to run generate-business-days task: %v", err)
+		}
+		if !*dryRun && (result.GeneratedCount > 0 || result.FailedCount > 0) {
+			log.Printf("Summary: Created %d business days (%d shift slots) for %d tenants, Failed %d",
+				result.GeneratedCount, result.SlotCount, len(result.Tenants), result.FailedCount)
+		}
+
+	case "deliver-notifications":
+		if *dryRun {
+			log.Println("Dry run: skipping notification delivery")
+			break
+		}
+		deliverer := appnotification.NewDeliverNotificationsUsecase(
+			db.NewNotificationOutboxRepository(pool),
+			db.NewNotificationLogRepository(pool),
+			newDispatchers(pool, cfg),
+			notification.DefaultFrequencyLimit,
+			db.NewPgxTxManager(pool),
+			clock.NewRealClock(),
+		)
+		result, err := deliverer.Execute(ctx, appnotification.DeliverNotificationsInput{BatchSize: *batchSize})
+		if err != nil {
+			log.Fatalf("Failed to run deliver-notifications task: %v", err)
+		}
+		if result.Processed() > 0 {
+			log.Printf("Summary: Delivered %d, Retrying %d, Failed %d, Deferred %d",
+				result.Delivered, result.Retrying, result.Failed, result.Deferred)
+		}
+
+	case "discord-announcements":
+		announcer := appnotification.NewAnnounceUpcomingUsecase(
+			db.NewAttendanceRepository(pool),
+			db.NewEventRepository(pool),
+			db.NewEventBusinessDayRepository(pool),
+			db.NewShiftSlotRepository(pool),
+			db.NewShiftAssignmentRepository(pool),
+			db.NewTenantRepository(pool),
+			db.NewDiscordAnnouncementRepository(pool),
+			newDiscordDispatcher(pool, cfg.BaseURL),
+		)
+		result, err := processor.RunDiscordAnnouncements(ctx, announcer, *dryRun)
+		if err != nil {
+			log.Fatalf("Failed to run discord-announcements task: %v", err)
+		}
+		if !*dryRun && (result.DeadlineCount > 0 || result.UnderstaffedCount > 0 || result.FailedCount > 0) {
+			log.Printf("Summary: Announced %d deadlines and %d understaffed business days for %d tenants, Failed %d",
+				result.DeadlineCount, result.UnderstaffedCount, len(result.Tenants), result.FailedCount)
+		}
+
+	case "deadline-reminders":
+		// リマインダーはアウトボックスに書き込み、deliver-notifications が配信する
+		reminder := appnotification.NewSendDeadlineRemindersUsecase(
+			db.NewAttendanceRepository(pool),
+			db.NewScheduleRepository(pool),
+			db.NewMemberRepository(pool),
+			db.NewMemberGroupRepository(pool),
+			db.NewMemberRoleRepository(pool),
+			db.NewTenantRepository(pool),
+			db.NewNotificationOutboxRepository(pool),
+			db.NewDeadlineReminderLogRepository(pool),
+			db.NewPgxTxManager(pool),
+			channelsOf(newDispatchers(pool, cfg)),
+			cfg.BaseURL,
+		)
+		result, err := processor.RunDeadlineReminders(ctx, reminder, *within, *dryRun)
+		if err != nil {
+			log.Fatalf("Failed to run deadline-reminders task: %v", err)
+		}
+		if !*dryRun && (result.RemindedCount > 0 || result.FailedCount > 0) {
+			log.Printf("Summary: Reminded %d members for %d tenants, Skipped %d, Failed %d",
+				result.RemindedCount, len(result.Tenants), result.SkippedCount, result.FailedCount)
+		}
+
+	case "close-expired":
+		// 締切を過ぎた出欠確認・日程調整をシステムが締め切る（-summary で回答のまとめを Discord に告知）
+		discordAnnouncementRepo := db.NewDiscordAnnouncementRepository(pool)
+		dispatcher := newDiscordDispatcher(pool, cfg.BaseURL)
+		collectionCloser := appattendance.NewCloseExpiredCollectionsUsecase(
+			db.NewAttendanceRepository(pool),
+			discordAnnouncementRepo,
+			dispatcher,
+		)
+		scheduleCloser := appschedule.NewCloseExpiredSchedulesUsecase(
+			db.NewScheduleRepository(pool),
+			discordAnnouncementRepo,
+			dispatcher,
+		)
+		result, err := processor.RunCloseExpired(ctx, collectionCloser, scheduleCloser, *summary, *dryRun)
+		if err != nil {
+			log.Fatalf("Failed to run close-expired task: %v", err)
+		}
+		if !*dryRun && (result.ClosedCollectionCount > 0 || result.ClosedScheduleCount > 0 || result.FailedCount > 0) {
+			log.Printf("Summary: Closed %d collections and %d schedules for %d tenants, Announced %d summaries, Failed %d",
+				result.ClosedCollectionCount, result.ClosedScheduleCount, len(result.Tenants), result.SummaryCount, result.FailedCount)
+		}
+
 	default:
 		log.Fatalf("Unknown task: %s", *taskFlag)
 	}
 
 	log.Println("🎉 Batch processing completed!")
 }
+
+// newDispatchers returns the dispatchers of the channels that can actually be delivered
+// Resend が未設定の場合はメールの Dispatcher を登録しない（メールの通知は pending のまま残る）
+func newDispatchers(pool *pgxpool.Pool, cfg Config) []notification.Dispatcher {
+	dispatchers := []notification.Dispatcher{newDiscordDispatcher(pool, cfg.BaseURL)}
+	if cfg.ResendAPIKey == "" || cfg.ResendFromEmail == "" {
+		log.Println("Resend not configured, skipping email notifications")
+		return dispatchers
+	}
+	return append(dispatchers, email.NewDispatcher(
+		email.NewResendEmailService(cfg.ResendAPIKey, cfg.ResendFromEmail, cfg.BaseURL),
+		db.NewMemberRepository(pool),
+	))
+}
+
+// channelsOf returns the channels the dispatchers can deliver to
+func channelsOf(dispatchers []notification.Dispatcher) []notification.Channel {
+	channels := make([]notification.Channel, 0, len(dispatchers))
+	for _, d := range dispatchers {
+		channels = append(channels, d.Channel())
+	}
+	return channels
+}
+
+// newDiscordDispatcher creates the dispatcher posting to the Discord webhooks of each tenant and event
+func newDiscordDispatcher(pool *pgxpool.Pool, baseURL string) *discord.Dispatcher {
+	return discord.NewDispatcher(
+		discord.NewClient(nil),
+		baseURL,
+		db.NewDiscordWebhookRepository(pool),
+		db.NewEventBusinessDayRepository(pool),
+		db.NewMemberRepository(pool),
+	)
+}
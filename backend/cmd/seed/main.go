@@ -889,6 +889,7 @@ func createShiftTemplates(ctx context.Context, repo *db.ShiftSlotTemplateReposit
 				endTime,
 				itemDef.requiredCount,
 				itemDef.priority,
+				nil,
 				now,
 				now,
 			)
@@ -906,6 +907,7 @@ func createShiftTemplates(ctx context.Context, repo *db.ShiftSlotTemplateReposit
 			tmpl.name,
 			tmpl.description,
 			items,
+			1,
 			now,
 			now,
 			nil,
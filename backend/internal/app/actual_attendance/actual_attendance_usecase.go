@@ -7,7 +7,9 @@ import (
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
 )
 
 // TargetDateInfo represents a business day in the actual attendance response
@@ -43,6 +45,8 @@ type GetRecentActualAttendanceUsecase struct {
 	businessDayRepo event.EventBusinessDayRepository
 	memberRepo      member.MemberRepository
 	assignmentRepo  shift.ShiftAssignmentRepository
+	tenantRepo      tenant.TenantRepository
+	clock           services.Clock
 }
 
 // NewGetRecentActualAttendanceUsecase creates a new GetRecentActualAttendanceUsecase
@@ -50,18 +54,22 @@ func NewGetRecentActualAttendanceUsecase(
 	businessDayRepo event.EventBusinessDayRepository,
 	memberRepo member.MemberRepository,
 	assignmentRepo shift.ShiftAssignmentRepository,
+	tenantRepo tenant.TenantRepository,
+	clock services.Clock,
 ) *GetRecentActualAttendanceUsecase {
 	return &GetRecentActualAttendanceUsecase{
 		businessDayRepo: businessDayRepo,
 		memberRepo:      memberRepo,
 		assignmentRepo:  assignmentRepo,
+		tenantRepo:      tenantRepo,
+		clock:           clock,
 	}
 }
 
 // Execute retrieves recent actual attendance data based on shift assignments
 //
 // Implementation logic:
-//  1. Get recent N business days (past only, oldest first)
+//  1. Get recent N business days (past only, oldest first; "today" is taken in the tenant's time zone; cancelled days are excluded)
 //  2. Get all active members
 //  3. For each member and each business day, check if there are shift assignments
 //  4. Assignment exists → "attended", no assignment → "absent"
@@ -75,25 +83,35 @@ func (uc *GetRecentActualAttendanceUsecase) Execute(
 	}
 
 	// 1. Get recent N business days
+	// 「過去/未来」の境界はテナントのタイムゾーンでの今日
+	loc, err := tenant.ResolveLocation(ctx, uc.tenantRepo, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	today := common.DateIn(uc.clock.Now(), loc)
+
 	var businessDays []*event.EventBusinessDay
-	var err error
 	if input.EventID != nil {
 		// イベントIDが指定された場合、そのイベントの営業日のみ取得
-		businessDays, err = uc.businessDayRepo.FindRecentByEventID(ctx, input.TenantID, *input.EventID, limit, input.IncludeFuture)
+		businessDays, err = uc.businessDayRepo.FindRecentByEventID(ctx, input.TenantID, *input.EventID, today, limit, input.IncludeFuture)
 	} else {
 		// 全営業日から取得（過去のみ）
-		businessDays, err = uc.businessDayRepo.FindRecentByTenantID(ctx, input.TenantID, limit)
+		businessDays, err = uc.businessDayRepo.FindRecentByTenantID(ctx, input.TenantID, today, limit)
 	}
 	if err != nil {
 		return nil, err
 	}
 
 	var targetDates []TargetDateInfo
-	for i, bd := range businessDays {
+	for _, bd := range businessDays {
+		// 中止された営業日は出席の集計対象外
+		if bd.IsCancelled() {
+			continue
+		}
 		targetDates = append(targetDates, TargetDateInfo{
 			TargetDateID: string(bd.BusinessDayID()),
 			TargetDate:   bd.TargetDate(),
-			DisplayOrder: i + 1,
+			DisplayOrder: len(targetDates) + 1,
 		})
 	}
 
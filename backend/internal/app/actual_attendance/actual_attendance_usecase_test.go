@@ -0,0 +1,367 @@
+package actual_attendance_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	appactual "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/actual_attendance"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
+)
+
+// =====================================================
+// Mocks
+// =====================================================
+
+type MockClock struct {
+	nowFunc func() time.Time
+}
+
+func (m *MockClock) Now() time.Time {
+	if m.nowFunc != nil {
+		return m.nowFunc()
+	}
+	return time.Now()
+}
+
+type MockTenantRepository struct {
+	timezone string
+}
+
+func (m *MockTenantRepository) FindByID(ctx context.Context, tenantID common.TenantID) (*tenant.Tenant, error) {
+	now := time.Now()
+	return tenant.ReconstructTenant(tenantID, "Test Tenant", m.timezone, true, tenant.TenantStatusActive, nil, nil, nil, now, now, nil)
+}
+
+func (m *MockTenantRepository) FindByPendingStripeSessionID(ctx context.Context, sessionID string) (*tenant.Tenant, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockTenantRepository) Save(ctx context.Context, t *tenant.Tenant) error {
+	return nil
+}
+
+func (m *MockTenantRepository) ListAll(ctx context.Context, status *tenant.TenantStatus, limit, offset int) ([]*tenant.Tenant, int, error) {
+	return nil, 0, nil
+}
+
+type MockBusinessDayRepository struct {
+	findRecentByTenantIDFunc func(ctx context.Context, tenantID common.TenantID, today time.Time, limit int) ([]*event.EventBusinessDay, error)
+	findRecentByEventIDFunc  func(ctx context.Context, tenantID common.TenantID, eventID common.EventID, today time.Time, limit int, includeFuture bool) ([]*event.EventBusinessDay, error)
+}
+
+func (m *MockBusinessDayRepository) Save(ctx context.Context, bd *event.EventBusinessDay) error {
+	return nil
+}
+
+func (m *MockBusinessDayRepository) FindByID(ctx context.Context, tenantID common.TenantID, businessDayID event.BusinessDayID) (*event.EventBusinessDay, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockBusinessDayRepository) FindByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) ([]*event.EventBusinessDay, error) {
+	return nil, nil
+}
+
+func (m *MockBusinessDayRepository) FindByEventIDAndDateRange(ctx context.Context, tenantID common.TenantID, eventID common.EventID, startDate, endDate time.Time) ([]*event.EventBusinessDay, error) {
+	return nil, nil
+}
+
+func (m *MockBusinessDayRepository) FindActiveByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) ([]*event.EventBusinessDay, error) {
+	return nil, nil
+}
+
+func (m *MockBusinessDayRepository) FindByTenantIDAndDate(ctx context.Context, tenantID common.TenantID, date time.Time) ([]*event.EventBusinessDay, error) {
+	return nil, nil
+}
+
+func (m *MockBusinessDayRepository) Delete(ctx context.Context, tenantID common.TenantID, businessDayID event.BusinessDayID) error {
+	return nil
+}
+
+func (m *MockBusinessDayRepository) ExistsByEventIDAndDate(ctx context.Context, tenantID common.TenantID, eventID common.EventID, date time.Time, startTime time.Time) (bool, error) {
+	return false, nil
+}
+
+func (m *MockBusinessDayRepository) ExistsByEventIDAndOccurrenceDate(ctx context.Context, tenantID common.TenantID, eventID common.EventID, occurrenceDate time.Time) (bool, error) {
+	return false, nil
+}
+
+func (m *MockBusinessDayRepository) FindRecentByTenantID(ctx context.Context, tenantID common.TenantID, today time.Time, limit int) ([]*event.EventBusinessDay, error) {
+	if m.findRecentByTenantIDFunc != nil {
+		return m.findRecentByTenantIDFunc(ctx, tenantID, today, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockBusinessDayRepository) FindRecentByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID, today time.Time, limit int, includeFuture bool) ([]*event.EventBusinessDay, error) {
+	if m.findRecentByEventIDFunc != nil {
+		return m.findRecentByEventIDFunc(ctx, tenantID, eventID, today, limit, includeFuture)
+	}
+	return nil, nil
+}
+
+type MockMemberRepository struct {
+	members []*member.Member
+}
+
+func (m *MockMemberRepository) Save(ctx context.Context, mem *member.Member) error {
+	return nil
+}
+
+func (m *MockMemberRepository) FindByID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) (*member.Member, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockMemberRepository) FindByTenantID(ctx context.Context, tenantID common.TenantID) ([]*member.Member, error) {
+	return m.members, nil
+}
+
+func (m *MockMemberRepository) FindActiveByTenantID(ctx context.Context, tenantID common.TenantID) ([]*member.Member, error) {
+	return m.members, nil
+}
+
+func (m *MockMemberRepository) FindByDiscordUserID(ctx context.Context, tenantID common.TenantID, discordUserID string) (*member.Member, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockMemberRepository) FindByEmail(ctx context.Context, tenantID common.TenantID, email string) (*member.Member, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockMemberRepository) Delete(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) error {
+	return nil
+}
+
+func (m *MockMemberRepository) ExistsByDiscordUserID(ctx context.Context, tenantID common.TenantID, discordUserID string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockMemberRepository) ExistsByEmail(ctx context.Context, tenantID common.TenantID, email string) (bool, error) {
+	return false, nil
+}
+
+type MockShiftAssignmentRepository struct {
+	confirmed map[event.BusinessDayID]bool
+}
+
+func (m *MockShiftAssignmentRepository) Save(ctx context.Context, assignment *shift.ShiftAssignment) error {
+	return nil
+}
+
+func (m *MockShiftAssignmentRepository) FindByID(ctx context.Context, tenantID common.TenantID, assignmentID shift.AssignmentID) (*shift.ShiftAssignment, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockShiftAssignmentRepository) SetLive(ctx context.Context, tenantID common.TenantID, assignmentID shift.AssignmentID, live bool) error {
+	return nil
+}
+
+func (m *MockShiftAssignmentRepository) FindBySlotID(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) ([]*shift.ShiftAssignment, error) {
+	return nil, nil
+}
+
+func (m *MockShiftAssignmentRepository) FindConfirmedBySlotID(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) ([]*shift.ShiftAssignment, error) {
+	return nil, nil
+}
+
+func (m *MockShiftAssignmentRepository) FindByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*shift.ShiftAssignment, error) {
+	return nil, nil
+}
+
+func (m *MockShiftAssignmentRepository) FindConfirmedByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*shift.ShiftAssignment, error) {
+	return nil, nil
+}
+
+func (m *MockShiftAssignmentRepository) FindByPlanID(ctx context.Context, tenantID common.TenantID, planID shift.PlanID) ([]*shift.ShiftAssignment, error) {
+	return nil, nil
+}
+
+func (m *MockShiftAssignmentRepository) CountConfirmedBySlotID(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) (int, error) {
+	return 0, nil
+}
+
+func (m *MockShiftAssignmentRepository) Delete(ctx context.Context, tenantID common.TenantID, assignmentID shift.AssignmentID) error {
+	return nil
+}
+
+func (m *MockShiftAssignmentRepository) ExistsBySlotIDAndMemberID(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID, memberID common.MemberID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockShiftAssignmentRepository) HasConfirmedByMemberAndBusinessDayID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID, businessDayID event.BusinessDayID) (bool, error) {
+	return m.confirmed[businessDayID], nil
+}
+
+func (m *MockShiftAssignmentRepository) FindByBusinessDayID(ctx context.Context, tenantID common.TenantID, businessDayID event.BusinessDayID) ([]*shift.ShiftAssignment, error) {
+	return nil, nil
+}
+
+func (m *MockShiftAssignmentRepository) FindConfirmedShiftsByDateRange(ctx context.Context, tenantID common.TenantID, memberID *common.MemberID, from, to time.Time) ([]shift.AssignedShift, error) {
+	return nil, nil
+}
+
+func (m *MockShiftAssignmentRepository) CountConfirmedByMemberIDsAndDateRange(ctx context.Context, tenantID common.TenantID, memberIDs []common.MemberID, from, to time.Time) (map[common.MemberID]int, error) {
+	return nil, nil
+}
+
+// =====================================================
+// GetRecentActualAttendanceUsecase Tests
+// =====================================================
+
+func TestGetRecentActualAttendanceUsecase_Execute_TodayInTenantTimezone(t *testing.T) {
+	// 2025-03-10 03:30 UTC は ニューヨークでは夏時間開始直後の 3/9 23:30（EDT）、東京では 3/10 12:30
+	now := time.Date(2025, 3, 10, 3, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		timezone  string
+		wantToday time.Time
+	}{
+		{name: "US tenant", timezone: "America/New_York", wantToday: time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC)},
+		{name: "JST tenant", timezone: "Asia/Tokyo", wantToday: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotToday time.Time
+			bdRepo := &MockBusinessDayRepository{
+				findRecentByTenantIDFunc: func(ctx context.Context, tenantID common.TenantID, today time.Time, limit int) ([]*event.EventBusinessDay, error) {
+					gotToday = today
+					return nil, nil
+				},
+			}
+
+			usecase := appactual.NewGetRecentActualAttendanceUsecase(
+				bdRepo,
+				&MockMemberRepository{},
+				&MockShiftAssignmentRepository{},
+				&MockTenantRepository{timezone: tt.timezone},
+				&MockClock{nowFunc: func() time.Time { return now }},
+			)
+
+			if _, err := usecase.Execute(context.Background(), appactual.GetRecentActualAttendanceInput{
+				TenantID: common.NewTenantID(),
+			}); err != nil {
+				t.Fatalf("Execute() should succeed, got error: %v", err)
+			}
+
+			if !gotToday.Equal(tt.wantToday) {
+				t.Errorf("today = %s, want %s", gotToday.Format("2006-01-02"), tt.wantToday.Format("2006-01-02"))
+			}
+		})
+	}
+}
+
+func TestGetRecentActualAttendanceUsecase_Execute_FilterByEvent(t *testing.T) {
+	tenantID := common.NewTenantID()
+	eventID := common.NewEventID()
+	now := time.Date(2025, 11, 2, 4, 30, 0, 0, time.UTC) // ニューヨークでは 11/2 0:30（夏時間終了日）
+
+	startTime := time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC)
+	endTime := time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC)
+	bd, err := event.NewEventBusinessDay(now, tenantID, eventID, time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC),
+		startTime, endTime, event.OccurrenceTypeSpecial, nil)
+	if err != nil {
+		t.Fatalf("NewEventBusinessDay() should succeed, got error: %v", err)
+	}
+	m, err := member.NewMember(now, tenantID, "Alice", "", "")
+	if err != nil {
+		t.Fatalf("NewMember() should succeed, got error: %v", err)
+	}
+
+	var gotToday time.Time
+	var gotIncludeFuture bool
+	bdRepo := &MockBusinessDayRepository{
+		findRecentByEventIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID, today time.Time, limit int, includeFuture bool) ([]*event.EventBusinessDay, error) {
+			gotToday = today
+			gotIncludeFuture = includeFuture
+			return []*event.EventBusinessDay{bd}, nil
+		},
+	}
+
+	usecase := appactual.NewGetRecentActualAttendanceUsecase(
+		bdRepo,
+		&MockMemberRepository{members: []*member.Member{m}},
+		&MockShiftAssignmentRepository{confirmed: map[event.BusinessDayID]bool{bd.BusinessDayID(): true}},
+		&MockTenantRepository{timezone: "America/New_York"},
+		&MockClock{nowFunc: func() time.Time { return now }},
+	)
+
+	result, err := usecase.Execute(context.Background(), appactual.GetRecentActualAttendanceInput{
+		TenantID:      tenantID,
+		EventID:       &eventID,
+		IncludeFuture: true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if want := time.Date(2025, 11, 2, 0, 0, 0, 0, time.UTC); !gotToday.Equal(want) {
+		t.Errorf("today = %s, want %s", gotToday.Format("2006-01-02"), want.Format("2006-01-02"))
+	}
+	if !gotIncludeFuture {
+		t.Error("includeFuture should be passed to the repository")
+	}
+	if len(result.TargetDates) != 1 || len(result.MemberAttendances) != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if got := result.MemberAttendances[0].AttendanceMap[bd.BusinessDayID().String()]; got != "attended" {
+		t.Errorf("attendance = %q, want attended", got)
+	}
+}
+
+func TestGetRecentActualAttendanceUsecase_Execute_ExcludesCancelledBusinessDays(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Date(2025, 11, 10, 3, 0, 0, 0, time.UTC)
+
+	startTime := time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC)
+	endTime := time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC)
+	var businessDays []*event.EventBusinessDay
+	for _, day := range []int{1, 2, 3} {
+		bd, err := event.NewEventBusinessDay(now, tenantID, common.NewEventID(), time.Date(2025, 11, day, 0, 0, 0, 0, time.UTC),
+			startTime, endTime, event.OccurrenceTypeSpecial, nil)
+		if err != nil {
+			t.Fatalf("NewEventBusinessDay() should succeed, got error: %v", err)
+		}
+		businessDays = append(businessDays, bd)
+	}
+	if err := businessDays[1].Cancel(now, "雨天のため", nil); err != nil {
+		t.Fatalf("Cancel() should succeed, got error: %v", err)
+	}
+
+	bdRepo := &MockBusinessDayRepository{
+		findRecentByTenantIDFunc: func(ctx context.Context, tid common.TenantID, today time.Time, limit int) ([]*event.EventBusinessDay, error) {
+			return businessDays, nil
+		},
+	}
+
+	usecase := appactual.NewGetRecentActualAttendanceUsecase(
+		bdRepo,
+		&MockMemberRepository{},
+		&MockShiftAssignmentRepository{},
+		&MockTenantRepository{timezone: "Asia/Tokyo"},
+		&MockClock{nowFunc: func() time.Time { return now }},
+	)
+
+	result, err := usecase.Execute(context.Background(), appactual.GetRecentActualAttendanceInput{TenantID: tenantID})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if len(result.TargetDates) != 2 {
+		t.Fatalf("len(TargetDates) = %d, want 2", len(result.TargetDates))
+	}
+	for i, td := range result.TargetDates {
+		if td.TargetDateID == businessDays[1].BusinessDayID().String() {
+			t.Error("cancelled business day should be excluded")
+		}
+		if td.DisplayOrder != i+1 {
+			t.Errorf("TargetDates[%d].DisplayOrder = %d, want %d", i, td.DisplayOrder, i+1)
+		}
+	}
+}
@@ -41,6 +41,7 @@ type MockAttendanceCollectionRepository struct {
 	findResponsesByCollectionIDFunc      func(ctx context.Context, collectionID common.CollectionID) ([]*attendance.AttendanceResponse, error)
 	findTargetDatesByCollectionIDFunc    func(ctx context.Context, collectionID common.CollectionID) ([]*attendance.TargetDate, error)
 	replaceTargetDatesFunc               func(ctx context.Context, collectionID common.CollectionID, targetDates []*attendance.TargetDate) error
+	closeIfExpiredFunc                   func(ctx context.Context, tenantID common.TenantID, collectionID common.CollectionID, now time.Time) (bool, error)
 }
 
 func (m *MockAttendanceCollectionRepository) Save(ctx context.Context, c *attendance.AttendanceCollection) error {
@@ -50,6 +51,13 @@ func (m *MockAttendanceCollectionRepository) Save(ctx context.Context, c *attend
 	return nil
 }
 
+func (m *MockAttendanceCollectionRepository) CloseIfExpired(ctx context.Context, tenantID common.TenantID, collectionID common.CollectionID, now time.Time) (bool, error) {
+	if m.closeIfExpiredFunc != nil {
+		return m.closeIfExpiredFunc(ctx, tenantID, collectionID, now)
+	}
+	return false, nil
+}
+
 func (m *MockAttendanceCollectionRepository) FindByID(ctx context.Context, tenantID common.TenantID, collectionID common.CollectionID) (*attendance.AttendanceCollection, error) {
 	if m.findByIDFunc != nil {
 		return m.findByIDFunc(ctx, tenantID, collectionID)
@@ -200,7 +208,7 @@ func TestCreateCollectionUsecase_Execute_Success(t *testing.T) {
 	roleRepo := &MockRoleRepository{}
 	txManager := &MockTxManager{}
 
-	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, txManager, clock)
+	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, nil, txManager, clock)
 
 	input := appattendance.CreateCollectionInput{
 		TenantID:    tenantID.String(),
@@ -251,7 +259,7 @@ func TestCreateCollectionUsecase_Execute_WithDeadline(t *testing.T) {
 	roleRepo := &MockRoleRepository{}
 	txManager := &MockTxManager{}
 
-	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, txManager, clock)
+	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, nil, txManager, clock)
 
 	input := appattendance.CreateCollectionInput{
 		TenantID:    tenantID.String(),
@@ -285,7 +293,7 @@ func TestCreateCollectionUsecase_Execute_ErrorWhenTitleEmpty(t *testing.T) {
 	roleRepo := &MockRoleRepository{}
 	txManager := &MockTxManager{}
 
-	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, txManager, clock)
+	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, nil, txManager, clock)
 
 	input := appattendance.CreateCollectionInput{
 		TenantID:    tenantID.String(),
@@ -314,7 +322,7 @@ func TestCreateCollectionUsecase_Execute_ErrorWhenInvalidTargetType(t *testing.T
 	roleRepo := &MockRoleRepository{}
 	txManager := &MockTxManager{}
 
-	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, txManager, clock)
+	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, nil, txManager, clock)
 
 	input := appattendance.CreateCollectionInput{
 		TenantID:    tenantID.String(),
@@ -347,7 +355,7 @@ func TestCreateCollectionUsecase_Execute_ErrorWhenSaveFails(t *testing.T) {
 	roleRepo := &MockRoleRepository{}
 	txManager := &MockTxManager{}
 
-	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, txManager, clock)
+	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, nil, txManager, clock)
 
 	input := appattendance.CreateCollectionInput{
 		TenantID:    tenantID.String(),
@@ -375,7 +383,7 @@ func TestCreateCollectionUsecase_Execute_ErrorWhenInvalidTenantID(t *testing.T)
 	roleRepo := &MockRoleRepository{}
 	txManager := &MockTxManager{}
 
-	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, txManager, clock)
+	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, nil, txManager, clock)
 
 	input := appattendance.CreateCollectionInput{
 		TenantID:    "invalid-tenant-id", // Invalid tenant ID format
@@ -419,7 +427,7 @@ func TestCreateCollectionUsecase_Execute_WithTargetDates(t *testing.T) {
 	roleRepo := &MockRoleRepository{}
 	txManager := &MockTxManager{}
 
-	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, txManager, clock)
+	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, nil, txManager, clock)
 
 	input := appattendance.CreateCollectionInput{
 		TenantID:    tenantID.String(),
@@ -457,7 +465,7 @@ func TestCreateCollectionUsecase_Execute_BusinessDayTarget(t *testing.T) {
 	roleRepo := &MockRoleRepository{}
 	txManager := &MockTxManager{}
 
-	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, txManager, clock)
+	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, nil, txManager, clock)
 
 	input := appattendance.CreateCollectionInput{
 		TenantID:    tenantID.String(),
@@ -1086,7 +1094,7 @@ func TestCreateCollectionUsecase_Execute_WithRoleAssignment(t *testing.T) {
 	}
 	txManager := &MockTxManager{}
 
-	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, txManager, clock)
+	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, nil, txManager, clock)
 
 	input := appattendance.CreateCollectionInput{
 		TenantID:    tenantID.String(),
@@ -1141,7 +1149,7 @@ func TestCreateCollectionUsecase_Execute_WithMultipleRoles(t *testing.T) {
 	}
 	txManager := &MockTxManager{}
 
-	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, txManager, clock)
+	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, nil, txManager, clock)
 
 	input := appattendance.CreateCollectionInput{
 		TenantID:    tenantID.String(),
@@ -1185,7 +1193,7 @@ func TestCreateCollectionUsecase_Execute_WithInvalidRoleID(t *testing.T) {
 	}
 	txManager := &MockTxManager{}
 
-	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, txManager, clock)
+	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, nil, txManager, clock)
 
 	input := appattendance.CreateCollectionInput{
 		TenantID:    tenantID.String(),
@@ -1231,7 +1239,7 @@ func TestCreateCollectionUsecase_Execute_WithBothGroupAndRole(t *testing.T) {
 	}
 	txManager := &MockTxManager{}
 
-	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, txManager, clock)
+	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, nil, txManager, clock)
 
 	input := appattendance.CreateCollectionInput{
 		TenantID:    tenantID.String(),
@@ -1271,7 +1279,7 @@ func TestCreateCollectionUsecase_Execute_WithoutRoleAssignment(t *testing.T) {
 	roleRepo := &MockRoleRepository{}
 	txManager := &MockTxManager{}
 
-	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, txManager, clock)
+	usecase := appattendance.NewCreateCollectionUsecase(repo, roleRepo, nil, txManager, clock)
 
 	input := appattendance.CreateCollectionInput{
 		TenantID:    tenantID.String(),
@@ -0,0 +1,157 @@
+package attendance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	appnotification "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+)
+
+// DefaultClosedSummaryLookback は締切のまとめを告知する、システムが締め切ってからの期間
+// 閲覧時に締め切ったもの（バッチの実行前に締め切られたもの）もまとめの対象にするため
+const DefaultClosedSummaryLookback = 24 * time.Hour
+
+// closeIfDeadlinePassed closes the collection if its deadline has passed and returns the latest collection
+// 一覧・詳細の閲覧時に呼び出し、締切を過ぎても open のまま表示されないようにする
+// 同時に行われた管理者の編集を上書きしないよう条件付き UPDATE で締め切り、締め切った場合のみ再取得する
+func closeIfDeadlinePassed(ctx context.Context, repo attendance.AttendanceCollectionRepository, c *attendance.AttendanceCollection, now time.Time) (*attendance.AttendanceCollection, error) {
+	if !c.IsExpired(now) {
+		return c, nil
+	}
+	closed, err := repo.CloseIfExpired(ctx, c.TenantID(), c.CollectionID(), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to close expired collection: %w", err)
+	}
+	if !closed {
+		return c, nil
+	}
+	reloaded, err := repo.FindByID(ctx, c.TenantID(), c.CollectionID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to reload closed collection: %w", err)
+	}
+	return reloaded, nil
+}
+
+// CloseExpiredCollectionsInput represents the input for closing the expired collections of a tenant
+type CloseExpiredCollectionsInput struct {
+	TenantID  common.TenantID
+	Now       time.Time
+	Summarize bool // 締め切った出欠確認の回答のまとめを告知する
+	DryRun    bool
+}
+
+// CloseExpiredCollectionsOutput represents the result of closing the expired collections of a tenant
+type CloseExpiredCollectionsOutput struct {
+	ClosedCount  int
+	SummaryCount int // 回答のまとめを告知した件数
+	FailedCount  int
+}
+
+// CloseExpiredCollectionsUsecase closes the open collections whose deadline has passed
+// 管理者が締め切る CloseCollectionUsecase と異なり、システムが締め切ったことを記録する
+type CloseExpiredCollectionsUsecase struct {
+	repo      attendance.AttendanceCollectionRepository
+	logRepo   notification.AnnouncementLogRepository
+	announcer notification.Announcer
+}
+
+// NewCloseExpiredCollectionsUsecase creates a new CloseExpiredCollectionsUsecase
+func NewCloseExpiredCollectionsUsecase(
+	repo attendance.AttendanceCollectionRepository,
+	logRepo notification.AnnouncementLogRepository,
+	announcer notification.Announcer,
+) *CloseExpiredCollectionsUsecase {
+	return &CloseExpiredCollectionsUsecase{
+		repo:      repo,
+		logRepo:   logRepo,
+		announcer: announcer,
+	}
+}
+
+// Execute closes the expired collections of the tenant and optionally announces their summaries
+// 個々の失敗はバッチ全体を止めずに FailedCount に数える
+func (u *CloseExpiredCollectionsUsecase) Execute(ctx context.Context, input CloseExpiredCollectionsInput) (*CloseExpiredCollectionsOutput, error) {
+	collections, err := u.repo.FindByTenantID(ctx, input.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find collections: %w", err)
+	}
+
+	output := &CloseExpiredCollectionsOutput{}
+	for i, c := range collections {
+		if !c.IsExpired(input.Now) {
+			continue
+		}
+		if input.DryRun {
+			output.ClosedCount++
+			continue
+		}
+		closed, err := closeIfDeadlinePassed(ctx, u.repo, c, input.Now)
+		if err != nil {
+			output.FailedCount++
+			continue
+		}
+		if closed.IsAutoClosed() {
+			output.ClosedCount++
+		}
+		collections[i] = closed
+	}
+
+	if input.Summarize && u.announcer != nil {
+		since := input.Now.Add(-DefaultClosedSummaryLookback)
+		for _, c := range collections {
+			if !c.IsAutoClosed() || c.AutoClosedAt().Before(since) {
+				continue
+			}
+			u.summarizeOnce(ctx, input, c, output)
+		}
+	}
+
+	return output, nil
+}
+
+// summarizeOnce announces the summary of the collection unless it has already been announced
+func (u *CloseExpiredCollectionsUsecase) summarizeOnce(ctx context.Context, input CloseExpiredCollectionsInput, c *attendance.AttendanceCollection, output *CloseExpiredCollectionsOutput) {
+	kind := notification.AnnouncementKindCollectionClosed
+	announced, err := u.logRepo.Exists(ctx, input.TenantID, kind, c.CollectionID().String())
+	if err != nil {
+		output.FailedCount++
+		return
+	}
+	if announced {
+		return
+	}
+	if input.DryRun {
+		output.SummaryCount++
+		return
+	}
+
+	targetDates, err := u.repo.FindTargetDatesByCollectionID(ctx, c.CollectionID())
+	if err != nil {
+		output.FailedCount++
+		return
+	}
+	responses, err := u.repo.FindResponsesByCollectionID(ctx, c.CollectionID())
+	if err != nil {
+		output.FailedCount++
+		return
+	}
+
+	if err := u.announcer.Announce(ctx, appnotification.CollectionClosedAnnouncement(c, targetDates, responses)); err != nil {
+		// 投稿先が設定されていない場合はまとめを告知しない
+		if !errors.Is(err, notification.ErrUndeliverable) {
+			output.FailedCount++
+		}
+		return
+	}
+
+	if err := u.logRepo.Record(ctx, input.TenantID, kind, c.CollectionID().String(), input.Now); err != nil {
+		output.FailedCount++
+		return
+	}
+	output.SummaryCount++
+}
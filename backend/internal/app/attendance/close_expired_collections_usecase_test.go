@@ -0,0 +1,289 @@
+package attendance_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appattendance "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/attendance"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+)
+
+// =====================================================
+// Mock Announcement
+// =====================================================
+
+type MockAnnouncer struct {
+	announced []notification.Announcement
+	err       error
+}
+
+func (m *MockAnnouncer) Announce(ctx context.Context, a notification.Announcement) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.announced = append(m.announced, a)
+	return nil
+}
+
+type MockAnnouncementLogRepository struct {
+	recorded map[string]bool
+}
+
+func (m *MockAnnouncementLogRepository) Exists(ctx context.Context, tenantID common.TenantID, kind notification.AnnouncementKind, subjectID string) (bool, error) {
+	return m.recorded[string(kind)+":"+subjectID], nil
+}
+
+func (m *MockAnnouncementLogRepository) Record(ctx context.Context, tenantID common.TenantID, kind notification.AnnouncementKind, subjectID string, announcedAt time.Time) error {
+	if m.recorded == nil {
+		m.recorded = map[string]bool{}
+	}
+	m.recorded[string(kind)+":"+subjectID] = true
+	return nil
+}
+
+// =====================================================
+// CloseExpiredCollectionsUsecase Tests
+// =====================================================
+
+func createTestCollectionWithDeadline(t *testing.T, tenantID common.TenantID, createdAt, deadline time.Time) *attendance.AttendanceCollection {
+	t.Helper()
+
+	collection, err := attendance.NewAttendanceCollection(
+		createdAt,
+		tenantID,
+		"Test Collection",
+		"",
+		attendance.TargetTypeEvent,
+		"event-123",
+		&deadline,
+	)
+	if err != nil {
+		t.Fatalf("Failed to create test collection: %v", err)
+	}
+	return collection
+}
+
+// createTestAutoClosedCollection returns the collection as reloaded after the system closed it
+func createTestAutoClosedCollection(t *testing.T, c *attendance.AttendanceCollection, closedAt time.Time) *attendance.AttendanceCollection {
+	t.Helper()
+
+	collection, err := attendance.ReconstructAttendanceCollection(
+		c.CollectionID(),
+		c.TenantID(),
+		c.Title(),
+		c.Description(),
+		c.TargetType(),
+		c.TargetID(),
+		c.PublicToken(),
+		attendance.StatusClosed,
+		c.Deadline(),
+		&closedAt,
+		c.CreatedAt(),
+		closedAt,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("Failed to reconstruct closed collection: %v", err)
+	}
+	return collection
+}
+
+func TestCloseExpiredCollectionsUsecase_Execute_ClosesExpiredAndAnnouncesOnce(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	createdAt := now.Add(-72 * time.Hour)
+
+	expired := createTestCollectionWithDeadline(t, tenantID, createdAt, now.Add(-time.Hour))
+	upcoming := createTestCollectionWithDeadline(t, tenantID, createdAt, now.Add(time.Hour))
+	collections := []*attendance.AttendanceCollection{expired, upcoming}
+
+	// 条件付き UPDATE を模し、受付中のものだけを締め切る
+	closed := 0
+	repo := &MockAttendanceCollectionRepository{
+		saveFunc: func(ctx context.Context, c *attendance.AttendanceCollection) error {
+			t.Error("Save should not be used to close expired collections")
+			return nil
+		},
+		closeIfExpiredFunc: func(ctx context.Context, tid common.TenantID, cid common.CollectionID, closedAt time.Time) (bool, error) {
+			for i, c := range collections {
+				if c.CollectionID() == cid && c.Status() == attendance.StatusOpen {
+					collections[i] = createTestAutoClosedCollection(t, c, closedAt)
+					closed++
+					return true, nil
+				}
+			}
+			return false, nil
+		},
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, cid common.CollectionID) (*attendance.AttendanceCollection, error) {
+			for _, c := range collections {
+				if c.CollectionID() == cid {
+					return c, nil
+				}
+			}
+			return nil, common.NewNotFoundError("collection", cid.String())
+		},
+	}
+	announcer := &MockAnnouncer{}
+	logRepo := &MockAnnouncementLogRepository{}
+
+	usecase := appattendance.NewCloseExpiredCollectionsUsecase(&mockTenantCollectionRepository{repo, collections}, logRepo, announcer)
+	input := appattendance.CloseExpiredCollectionsInput{
+		TenantID:  tenantID,
+		Now:       now,
+		Summarize: true,
+	}
+
+	output, err := usecase.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Execute() should succeed: %v", err)
+	}
+
+	if output.ClosedCount != 1 || output.SummaryCount != 1 || output.FailedCount != 0 {
+		t.Errorf("Unexpected output: %+v", output)
+	}
+	if closed != 1 {
+		t.Errorf("Expected 1 conditional close, got %d", closed)
+	}
+	if !collections[0].IsAutoClosed() {
+		t.Error("Expired collection should be closed by the system")
+	}
+	if collections[1].Status() != attendance.StatusOpen {
+		t.Errorf("Upcoming collection should remain open, got %v", collections[1].Status())
+	}
+	if len(announcer.announced) != 1 || announcer.announced[0].Kind != notification.AnnouncementKindCollectionClosed {
+		t.Errorf("Expected 1 collection_closed announcement, got %+v", announcer.announced)
+	}
+
+	// 2回目の実行では締め切り済みのため何もしない
+	output, err = usecase.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Execute() should succeed: %v", err)
+	}
+	if output.ClosedCount != 0 || output.SummaryCount != 0 {
+		t.Errorf("Second run should do nothing: %+v", output)
+	}
+	if len(announcer.announced) != 1 {
+		t.Errorf("Summary should be announced once, got %d", len(announcer.announced))
+	}
+}
+
+func TestCloseExpiredCollectionsUsecase_Execute_DryRun(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	expired := createTestCollectionWithDeadline(t, tenantID, now.Add(-72*time.Hour), now.Add(-time.Hour))
+
+	repo := &MockAttendanceCollectionRepository{
+		closeIfExpiredFunc: func(ctx context.Context, tid common.TenantID, cid common.CollectionID, closedAt time.Time) (bool, error) {
+			t.Error("CloseIfExpired should not be called in dry run")
+			return false, nil
+		},
+	}
+
+	usecase := appattendance.NewCloseExpiredCollectionsUsecase(
+		&mockTenantCollectionRepository{repo, []*attendance.AttendanceCollection{expired}},
+		&MockAnnouncementLogRepository{},
+		&MockAnnouncer{},
+	)
+
+	output, err := usecase.Execute(context.Background(), appattendance.CloseExpiredCollectionsInput{
+		TenantID:  tenantID,
+		Now:       now,
+		Summarize: true,
+		DryRun:    true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed: %v", err)
+	}
+
+	if output.ClosedCount != 1 {
+		t.Errorf("Expected 1 collection to be closed, got %d", output.ClosedCount)
+	}
+	if expired.Status() != attendance.StatusOpen {
+		t.Errorf("Collection should remain open in dry run, got %v", expired.Status())
+	}
+}
+
+func TestGetCollectionUsecase_Execute_ClosesExpiredCollection(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	expired := createTestCollectionWithDeadline(t, tenantID, now.Add(-72*time.Hour), now.Add(-time.Hour))
+
+	current := expired
+	repo := &MockAttendanceCollectionRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, cid common.CollectionID) (*attendance.AttendanceCollection, error) {
+			return current, nil
+		},
+		closeIfExpiredFunc: func(ctx context.Context, tid common.TenantID, cid common.CollectionID, closedAt time.Time) (bool, error) {
+			if !closedAt.Equal(now) {
+				t.Errorf("CloseIfExpired should be called with the current time: got %v", closedAt)
+			}
+			current = createTestAutoClosedCollection(t, expired, closedAt)
+			return true, nil
+		},
+		saveFunc: func(ctx context.Context, c *attendance.AttendanceCollection) error {
+			t.Error("Save should not be used to close expired collections")
+			return nil
+		},
+	}
+	clock := &MockClock{nowFunc: func() time.Time { return now }}
+
+	usecase := appattendance.NewGetCollectionUsecase(repo, clock)
+
+	output, err := usecase.Execute(context.Background(), appattendance.GetCollectionInput{
+		TenantID:     tenantID.String(),
+		CollectionID: expired.CollectionID().String(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed: %v", err)
+	}
+
+	if output.Status != attendance.StatusClosed.String() || output.AutoClosedAt == nil {
+		t.Errorf("Expected auto-closed output, got status %v, auto_closed_at %v", output.Status, output.AutoClosedAt)
+	}
+}
+
+func TestGetCollectionUsecase_Execute_SkipsReloadWhenNotClosed(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	expired := createTestCollectionWithDeadline(t, tenantID, now.Add(-72*time.Hour), now.Add(-time.Hour))
+
+	// 読み込み後に管理者が締切を延長するなどして、条件付き UPDATE が行を更新しなかった場合
+	findCount := 0
+	repo := &MockAttendanceCollectionRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, cid common.CollectionID) (*attendance.AttendanceCollection, error) {
+			findCount++
+			return expired, nil
+		},
+		closeIfExpiredFunc: func(ctx context.Context, tid common.TenantID, cid common.CollectionID, closedAt time.Time) (bool, error) {
+			return false, nil
+		},
+	}
+	clock := &MockClock{nowFunc: func() time.Time { return now }}
+
+	usecase := appattendance.NewGetCollectionUsecase(repo, clock)
+
+	_, err := usecase.Execute(context.Background(), appattendance.GetCollectionInput{
+		TenantID:     tenantID.String(),
+		CollectionID: expired.CollectionID().String(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed: %v", err)
+	}
+
+	if findCount != 1 {
+		t.Errorf("Collection should not be reloaded when nothing was closed, got %d finds", findCount)
+	}
+}
+
+// mockTenantCollectionRepository returns the given collections from FindByTenantID
+type mockTenantCollectionRepository struct {
+	*MockAttendanceCollectionRepository
+	collections []*attendance.AttendanceCollection
+}
+
+func (m *mockTenantCollectionRepository) FindByTenantID(ctx context.Context, tenantID common.TenantID) ([]*attendance.AttendanceCollection, error) {
+	return m.collections, nil
+}
@@ -2,10 +2,14 @@ package attendance
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 
+	appnotification "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/notification"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/role"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
 )
@@ -14,20 +18,24 @@ import (
 type CreateCollectionUsecase struct {
 	repo      attendance.AttendanceCollectionRepository
 	roleRepo  role.RoleRepository
+	announcer notification.Announcer
 	txManager services.TxManager
 	clock     services.Clock
 }
 
 // NewCreateCollectionUsecase creates a new CreateCollectionUsecase
+// announcer が nil の場合は受付開始の告知を行わない
 func NewCreateCollectionUsecase(
 	repo attendance.AttendanceCollectionRepository,
 	roleRepo role.RoleRepository,
+	announcer notification.Announcer,
 	txManager services.TxManager,
 	clock services.Clock,
 ) *CreateCollectionUsecase {
 	return &CreateCollectionUsecase{
 		repo:      repo,
 		roleRepo:  roleRepo,
+		announcer: announcer,
 		txManager: txManager,
 		clock:     clock,
 	}
@@ -169,7 +177,15 @@ func (u *CreateCollectionUsecase) Execute(ctx context.Context, input CreateColle
 		return nil, err
 	}
 
-	// 11. Return output DTO
+	// 11. Announce to Discord（告知の失敗は作成結果に影響させない）
+	if u.announcer != nil {
+		announcement := appnotification.CollectionOpenedAnnouncement(collection, len(targetDates))
+		if err := u.announcer.Announce(ctx, announcement); err != nil && !errors.Is(err, notification.ErrUndeliverable) {
+			log.Printf("[Discord] 出欠確認の告知に失敗しました: collection_id=%s, error=%v", collection.CollectionID().String(), err)
+		}
+	}
+
+	// 12. Return output DTO
 	return &CreateCollectionOutput{
 		CollectionID: collection.CollectionID().String(),
 		TenantID:     collection.TenantID().String(),
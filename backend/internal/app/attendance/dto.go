@@ -129,6 +129,8 @@ type GetCollectionOutput struct {
 	PublicToken  string          `json:"public_token"`
 	Status       string          `json:"status"`
 	Deadline     *time.Time      `json:"deadline,omitempty"`
+	AutoClosedAt *time.Time      `json:"auto_closed_at,omitempty"`
+	Timezone     string          `json:"timezone,omitempty"`  // テナントのタイムゾーン（公開ページのみ）
 	GroupIDs     []string        `json:"group_ids,omitempty"` // 対象グループID
 	RoleIDs      []string        `json:"role_ids,omitempty"`  // 対象ロールID
 	CreatedAt    time.Time       `json:"created_at"`
@@ -221,3 +223,36 @@ type AdminUpdateResponseOutput struct {
 	AvailableTo   *string   `json:"available_to,omitempty"`
 	RespondedAt   time.Time `json:"responded_at"`
 }
+
+// ListMemberOpenCollectionsInput represents the input for listing the open collections of a member
+type ListMemberOpenCollectionsInput struct {
+	TenantID string // from bot context
+	MemberID string // from bot context (Discord ユーザーから解決)
+}
+
+// MemberOpenCollectionDTO represents an open collection with the member's own responses
+type MemberOpenCollectionDTO struct {
+	CollectionID string              `json:"collection_id"`
+	Title        string              `json:"title"`
+	Description  string              `json:"description"`
+	Deadline     *time.Time          `json:"deadline,omitempty"`
+	TargetDates  []TargetDateDTO     `json:"target_dates"`
+	Responses    []MemberResponseDTO `json:"responses"`
+}
+
+// ListMemberOpenCollectionsOutput represents the output for listing the open collections of a member
+type ListMemberOpenCollectionsOutput struct {
+	Collections []MemberOpenCollectionDTO `json:"collections"`
+}
+
+// SubmitMemberResponseInput represents the input for a member submitting a response by collection ID
+type SubmitMemberResponseInput struct {
+	TenantID      string // from bot context
+	CollectionID  string // from URL path
+	MemberID      string // from bot context (Discord ユーザーから解決)
+	TargetDateID  string // from request body
+	Response      string // "attending" or "absent" or "undecided"
+	Note          string
+	AvailableFrom *string // 参加可能開始時間 (HH:MM)
+	AvailableTo   *string // 参加可能終了時間 (HH:MM)
+}
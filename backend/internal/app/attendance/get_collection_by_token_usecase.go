@@ -5,19 +5,27 @@ import (
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
 )
 
 // GetCollectionByTokenUsecase handles getting a collection by public token
 type GetCollectionByTokenUsecase struct {
-	repo attendance.AttendanceCollectionRepository
+	repo       attendance.AttendanceCollectionRepository
+	tenantRepo tenant.TenantRepository
+	clock      services.Clock
 }
 
 // NewGetCollectionByTokenUsecase creates a new GetCollectionByTokenUsecase
 func NewGetCollectionByTokenUsecase(
 	repo attendance.AttendanceCollectionRepository,
+	tenantRepo tenant.TenantRepository,
+	clock services.Clock,
 ) *GetCollectionByTokenUsecase {
 	return &GetCollectionByTokenUsecase{
-		repo: repo,
+		repo:       repo,
+		tenantRepo: tenantRepo,
+		clock:      clock,
 	}
 }
 
@@ -40,6 +48,12 @@ func (u *GetCollectionByTokenUsecase) Execute(ctx context.Context, input GetColl
 		return nil, ErrCollectionNotFound
 	}
 
+	// 締切を過ぎたものは閲覧時に締め切る
+	collection, err = closeIfDeadlinePassed(ctx, u.repo, collection, u.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	// 3. Find target dates
 	targetDates, err := u.repo.FindTargetDatesByCollectionID(ctx, collection.CollectionID())
 	if err != nil {
@@ -82,7 +96,19 @@ func (u *GetCollectionByTokenUsecase) Execute(ctx context.Context, input GetColl
 		roleIDs = append(roleIDs, ra.RoleID().String())
 	}
 
-	// 6. Return output DTO
+	// 6. Resolve tenant timezone
+	// 公開ページは認証がないため、締切をテナントのタイムゾーンで表示できるようにする
+	loc, err := tenant.ResolveLocation(ctx, u.tenantRepo, collection.TenantID())
+	if err != nil {
+		return nil, err
+	}
+	deadline := collection.Deadline()
+	if deadline != nil {
+		local := deadline.In(loc)
+		deadline = &local
+	}
+
+	// 7. Return output DTO
 	return &GetCollectionOutput{
 		CollectionID: collection.CollectionID().String(),
 		TenantID:     collection.TenantID().String(),
@@ -93,7 +119,9 @@ func (u *GetCollectionByTokenUsecase) Execute(ctx context.Context, input GetColl
 		TargetDates:  targetDateDTOs,
 		PublicToken:  collection.PublicToken().String(),
 		Status:       collection.Status().String(),
-		Deadline:     collection.Deadline(),
+		Deadline:     deadline,
+		AutoClosedAt: collection.AutoClosedAt(),
+		Timezone:     loc.String(),
 		GroupIDs:     groupIDs,
 		RoleIDs:      roleIDs,
 		CreatedAt:    collection.CreatedAt(),
@@ -5,19 +5,23 @@ import (
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
 )
 
 // GetCollectionUsecase handles getting a single attendance collection
 type GetCollectionUsecase struct {
-	repo attendance.AttendanceCollectionRepository
+	repo  attendance.AttendanceCollectionRepository
+	clock services.Clock
 }
 
 // NewGetCollectionUsecase creates a new GetCollectionUsecase
 func NewGetCollectionUsecase(
 	repo attendance.AttendanceCollectionRepository,
+	clock services.Clock,
 ) *GetCollectionUsecase {
 	return &GetCollectionUsecase{
-		repo: repo,
+		repo:  repo,
+		clock: clock,
 	}
 }
 
@@ -41,6 +45,12 @@ func (u *GetCollectionUsecase) Execute(ctx context.Context, input GetCollectionI
 		return nil, err
 	}
 
+	// 締切を過ぎたものは閲覧時に締め切る
+	collection, err = closeIfDeadlinePassed(ctx, u.repo, collection, u.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	// 4. Find target dates
 	targetDates, err := u.repo.FindTargetDatesByCollectionID(ctx, collection.CollectionID())
 	if err != nil {
@@ -95,6 +105,7 @@ func (u *GetCollectionUsecase) Execute(ctx context.Context, input GetCollectionI
 		PublicToken:  collection.PublicToken().String(),
 		Status:       collection.Status().String(),
 		Deadline:     collection.Deadline(),
+		AutoClosedAt: collection.AutoClosedAt(),
 		GroupIDs:     groupIDs,
 		RoleIDs:      roleIDs,
 		CreatedAt:    collection.CreatedAt(),
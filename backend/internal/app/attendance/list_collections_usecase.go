@@ -6,17 +6,20 @@ import (
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
 )
 
 // ListCollectionsUsecase handles listing attendance collections for a tenant
 type ListCollectionsUsecase struct {
-	repo attendance.AttendanceCollectionRepository
+	repo  attendance.AttendanceCollectionRepository
+	clock services.Clock
 }
 
 // NewListCollectionsUsecase creates a new ListCollectionsUsecase
-func NewListCollectionsUsecase(repo attendance.AttendanceCollectionRepository) *ListCollectionsUsecase {
+func NewListCollectionsUsecase(repo attendance.AttendanceCollectionRepository, clock services.Clock) *ListCollectionsUsecase {
 	return &ListCollectionsUsecase{
-		repo: repo,
+		repo:  repo,
+		clock: clock,
 	}
 }
 
@@ -41,6 +44,7 @@ type CollectionSummary struct {
 	PublicToken     string     `json:"public_token"`
 	Status          string     `json:"status"`
 	Deadline        *time.Time `json:"deadline"`
+	AutoClosedAt    *time.Time `json:"auto_closed_at,omitempty"`
 	TargetDateCount int        `json:"target_date_count"`
 	ResponseCount   int        `json:"response_count"`
 	CreatedAt       time.Time  `json:"created_at"`
@@ -62,8 +66,15 @@ func (u *ListCollectionsUsecase) Execute(ctx context.Context, input ListCollecti
 	}
 
 	// 3. Get counts for each collection
+	now := u.clock.Now()
 	summaries := make([]CollectionSummary, 0, len(collections))
 	for _, c := range collections {
+		// 締切を過ぎたものは閲覧時に締め切る
+		c, err := closeIfDeadlinePassed(ctx, u.repo, c, now)
+		if err != nil {
+			return nil, err
+		}
+
 		// Get target dates count
 		targetDates, err := u.repo.FindTargetDatesByCollectionID(ctx, c.CollectionID())
 		if err != nil {
@@ -92,6 +103,7 @@ func (u *ListCollectionsUsecase) Execute(ctx context.Context, input ListCollecti
 			PublicToken:     c.PublicToken().String(),
 			Status:          c.Status().String(),
 			Deadline:        c.Deadline(),
+			AutoClosedAt:    c.AutoClosedAt(),
 			TargetDateCount: len(targetDates),
 			ResponseCount:   len(memberMap),
 			CreatedAt:       c.CreatedAt(),
@@ -0,0 +1,282 @@
+package attendance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+)
+
+// memberTargeting resolves whether a member is asked to respond to a collection
+type memberTargeting struct {
+	repo            attendance.AttendanceCollectionRepository
+	memberGroupRepo member.MemberGroupRepository
+	memberRoleRepo  member.MemberRoleRepository
+
+	groupIDs []common.MemberGroupID
+	roleIDs  []common.RoleID
+	loaded   bool
+}
+
+func (t *memberTargeting) isTarget(ctx context.Context, collection *attendance.AttendanceCollection, memberID common.MemberID) (bool, error) {
+	if !t.loaded {
+		groupIDs, err := t.memberGroupRepo.FindGroupIDsByMemberID(ctx, memberID)
+		if err != nil {
+			return false, fmt.Errorf("failed to find member groups: %w", err)
+		}
+		roleIDs, err := t.memberRoleRepo.FindRolesByMemberID(ctx, memberID)
+		if err != nil {
+			return false, fmt.Errorf("failed to find member roles: %w", err)
+		}
+		t.groupIDs, t.roleIDs, t.loaded = groupIDs, roleIDs, true
+	}
+
+	groupAssignments, err := t.repo.FindGroupAssignmentsByCollectionID(ctx, collection.CollectionID())
+	if err != nil {
+		return false, fmt.Errorf("failed to find group assignments: %w", err)
+	}
+	roleAssignments, err := t.repo.FindRoleAssignmentsByCollectionID(ctx, collection.CollectionID())
+	if err != nil {
+		return false, fmt.Errorf("failed to find role assignments: %w", err)
+	}
+
+	return attendance.IsTargetMember(groupAssignments, roleAssignments, t.groupIDs, t.roleIDs), nil
+}
+
+// =====================================================
+// List open collections
+// =====================================================
+
+// ListMemberOpenCollectionsUsecase handles listing the open collections a member is asked to respond to
+type ListMemberOpenCollectionsUsecase struct {
+	repo            attendance.AttendanceCollectionRepository
+	memberGroupRepo member.MemberGroupRepository
+	memberRoleRepo  member.MemberRoleRepository
+	clock           services.Clock
+}
+
+// NewListMemberOpenCollectionsUsecase creates a new ListMemberOpenCollectionsUsecase
+func NewListMemberOpenCollectionsUsecase(
+	repo attendance.AttendanceCollectionRepository,
+	memberGroupRepo member.MemberGroupRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	clock services.Clock,
+) *ListMemberOpenCollectionsUsecase {
+	return &ListMemberOpenCollectionsUsecase{
+		repo:            repo,
+		memberGroupRepo: memberGroupRepo,
+		memberRoleRepo:  memberRoleRepo,
+		clock:           clock,
+	}
+}
+
+// Execute lists the collections that accept responses now and target the member
+func (u *ListMemberOpenCollectionsUsecase) Execute(ctx context.Context, input ListMemberOpenCollectionsInput) (*ListMemberOpenCollectionsOutput, error) {
+	tenantID, err := common.ParseTenantID(input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	memberID, err := common.ParseMemberID(input.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	collections, err := u.repo.FindByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	targeting := &memberTargeting{repo: u.repo, memberGroupRepo: u.memberGroupRepo, memberRoleRepo: u.memberRoleRepo}
+	now := u.clock.Now()
+	result := make([]MemberOpenCollectionDTO, 0)
+	for _, c := range collections {
+		if c.CanRespond(now) != nil {
+			continue
+		}
+		target, err := targeting.isTarget(ctx, c, memberID)
+		if err != nil {
+			return nil, err
+		}
+		if !target {
+			continue
+		}
+
+		targetDates, err := u.repo.FindTargetDatesByCollectionID(ctx, c.CollectionID())
+		if err != nil {
+			return nil, err
+		}
+		targetDateDTOs := make([]TargetDateDTO, 0, len(targetDates))
+		for _, td := range targetDates {
+			targetDateDTOs = append(targetDateDTOs, TargetDateDTO{
+				TargetDateID: td.TargetDateID().String(),
+				TargetDate:   td.TargetDateValue(),
+				StartTime:    td.StartTime(),
+				EndTime:      td.EndTime(),
+				DisplayOrder: td.DisplayOrder(),
+			})
+		}
+
+		responses, err := u.repo.FindResponsesByCollectionIDAndMemberID(ctx, tenantID, c.CollectionID(), memberID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find member responses: %w", err)
+		}
+		responseDTOs := make([]MemberResponseDTO, 0, len(responses))
+		for _, resp := range responses {
+			responseDTOs = append(responseDTOs, MemberResponseDTO{
+				TargetDateID:  resp.TargetDateID().String(),
+				Response:      string(resp.Response()),
+				Note:          resp.Note(),
+				AvailableFrom: resp.AvailableFrom(),
+				AvailableTo:   resp.AvailableTo(),
+			})
+		}
+
+		result = append(result, MemberOpenCollectionDTO{
+			CollectionID: c.CollectionID().String(),
+			Title:        c.Title(),
+			Description:  c.Description(),
+			Deadline:     c.Deadline(),
+			TargetDates:  targetDateDTOs,
+			Responses:    responseDTOs,
+		})
+	}
+
+	return &ListMemberOpenCollectionsOutput{Collections: result}, nil
+}
+
+// =====================================================
+// Submit response
+// =====================================================
+
+// SubmitMemberResponseUsecase handles a member submitting a response to a collection by its ID
+// 公開 URL を介さない回答（Discord Bot など）のため、対象メンバーかどうかも検証する
+type SubmitMemberResponseUsecase struct {
+	repo            attendance.AttendanceCollectionRepository
+	memberGroupRepo member.MemberGroupRepository
+	memberRoleRepo  member.MemberRoleRepository
+	txManager       services.TxManager
+	clock           services.Clock
+}
+
+// NewSubmitMemberResponseUsecase creates a new SubmitMemberResponseUsecase
+func NewSubmitMemberResponseUsecase(
+	repo attendance.AttendanceCollectionRepository,
+	memberGroupRepo member.MemberGroupRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	txManager services.TxManager,
+	clock services.Clock,
+) *SubmitMemberResponseUsecase {
+	return &SubmitMemberResponseUsecase{
+		repo:            repo,
+		memberGroupRepo: memberGroupRepo,
+		memberRoleRepo:  memberRoleRepo,
+		txManager:       txManager,
+		clock:           clock,
+	}
+}
+
+// Execute executes the submit member response use case
+func (u *SubmitMemberResponseUsecase) Execute(ctx context.Context, input SubmitMemberResponseInput) (*SubmitResponseOutput, error) {
+	tenantID, err := common.ParseTenantID(input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	collectionID, err := common.ParseCollectionID(input.CollectionID)
+	if err != nil {
+		return nil, ErrCollectionNotFound
+	}
+	memberID, err := common.ParseMemberID(input.MemberID)
+	if err != nil {
+		return nil, ErrMemberNotAllowed
+	}
+	targetDateID, err := common.ParseTargetDateID(input.TargetDateID)
+	if err != nil {
+		return nil, common.NewValidationError("対象日IDが無効です", err)
+	}
+	responseType, err := attendance.NewResponseType(input.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var output *SubmitResponseOutput
+	err = u.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		collection, err := u.repo.FindByID(txCtx, tenantID, collectionID)
+		if err != nil {
+			if common.IsNotFoundError(err) {
+				return ErrCollectionNotFound
+			}
+			return err
+		}
+
+		now := u.clock.Now()
+		if err := collection.CanRespond(now); err != nil {
+			return err
+		}
+
+		targeting := &memberTargeting{repo: u.repo, memberGroupRepo: u.memberGroupRepo, memberRoleRepo: u.memberRoleRepo}
+		target, err := targeting.isTarget(txCtx, collection, memberID)
+		if err != nil {
+			return err
+		}
+		if !target {
+			return ErrMemberNotAllowed
+		}
+
+		// 対象日が回収に属しているか確認
+		targetDates, err := u.repo.FindTargetDatesByCollectionID(txCtx, collection.CollectionID())
+		if err != nil {
+			return err
+		}
+		found := false
+		for _, td := range targetDates {
+			if td.TargetDateID() == targetDateID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return common.NewValidationError("対象日IDが無効です", nil)
+		}
+
+		response, err := attendance.NewAttendanceResponse(
+			now,
+			collection.CollectionID(),
+			collection.TenantID(),
+			memberID,
+			targetDateID,
+			responseType,
+			input.Note,
+			input.AvailableFrom,
+			input.AvailableTo,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := u.repo.UpsertResponse(txCtx, response); err != nil {
+			return err
+		}
+
+		output = &SubmitResponseOutput{
+			ResponseID:    response.ResponseID().String(),
+			CollectionID:  response.CollectionID().String(),
+			MemberID:      response.MemberID().String(),
+			Response:      response.Response().String(),
+			Note:          response.Note(),
+			AvailableFrom: response.AvailableFrom(),
+			AvailableTo:   response.AvailableTo(),
+			RespondedAt:   response.RespondedAt(),
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
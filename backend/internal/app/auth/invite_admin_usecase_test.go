@@ -128,6 +128,10 @@ func (m *MockEmailService) SendPasswordResetEmail(ctx context.Context, input ser
 	return nil
 }
 
+func (m *MockEmailService) SendNotificationEmail(ctx context.Context, input services.SendNotificationEmailInput) error {
+	return nil
+}
+
 // =====================================================
 // Test Helper Functions
 // =====================================================
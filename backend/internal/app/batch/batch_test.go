@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/app/batch"
+	appevent "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/event"
+	appnotification "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/notification"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/kelseyhightower/envconfig"
@@ -288,6 +290,125 @@ func TestBatchProcessor_RunPendingPaymentCleanup_ActualDelete(t *testing.T) {
 
 // Helper functions
 
+// stubBusinessDayGenerator records the tenants it was called for
+type stubBusinessDayGenerator struct {
+	calls []appevent.GenerateUpcomingBusinessDaysInput
+}
+
+func (g *stubBusinessDayGenerator) Execute(ctx context.Context, input appevent.GenerateUpcomingBusinessDaysInput) (*appevent.GenerateUpcomingBusinessDaysOutput, error) {
+	g.calls = append(g.calls, input)
+	return &appevent.GenerateUpcomingBusinessDaysOutput{
+		Until:          input.Now.AddDate(0, 0, 7*input.Weeks),
+		Events:         []appevent.UpcomingBusinessDayEventResult{{EventName: "Test Event", GeneratedCount: 3, SlotCount: 6}},
+		GeneratedCount: 3,
+		SlotCount:      6,
+	}, nil
+}
+
+func TestBatchProcessor_RunBusinessDayGeneration_DryRun(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+
+	ctx := context.Background()
+	logger := &testLogger{}
+	processor := batch.NewBatchProcessor(pool, logger)
+
+	// Create a tenant with an active weekly event
+	tenantID := createTestGraceTenant(t, pool)
+	defer cleanupTestTenant(t, pool, tenantID)
+	createTestRecurringEvent(t, pool, tenantID)
+	defer cleanupTestEvents(t, pool, tenantID)
+
+	generator := &stubBusinessDayGenerator{}
+	now := time.Now()
+	result, err := processor.RunBusinessDayGenerationAt(ctx, now, generator, 4, true)
+	if err != nil {
+		t.Fatalf("RunBusinessDayGeneration failed: %v", err)
+	}
+
+	var found *batch.TenantBusinessDayGeneration
+	for i := range result.Tenants {
+		if result.Tenants[i].TenantID == tenantID {
+			found = &result.Tenants[i]
+		}
+	}
+	if found == nil {
+		t.Fatal("Expected the test tenant to be processed")
+	}
+	if found.EventCount != 1 || found.GeneratedCount != 3 || found.SlotCount != 6 {
+		t.Errorf("Unexpected tenant counts: %+v", *found)
+	}
+
+	for _, call := range generator.calls {
+		if !call.DryRun || call.Weeks != 4 || !call.Now.Equal(now) {
+			t.Errorf("Unexpected generator input: %+v", call)
+		}
+	}
+	if len(generator.calls) != len(result.Tenants) {
+		t.Errorf("Expected generator to be called once per tenant, got %d calls for %d tenants", len(generator.calls), len(result.Tenants))
+	}
+}
+
+// stubUpcomingAnnouncer records the tenants it was called for
+type stubUpcomingAnnouncer struct {
+	calls []appnotification.AnnounceUpcomingInput
+}
+
+func (a *stubUpcomingAnnouncer) Execute(ctx context.Context, input appnotification.AnnounceUpcomingInput) (*appnotification.AnnounceUpcomingOutput, error) {
+	a.calls = append(a.calls, input)
+	return &appnotification.AnnounceUpcomingOutput{DeadlineCount: 1, UnderstaffedCount: 2}, nil
+}
+
+func TestBatchProcessor_RunDiscordAnnouncements_DryRun(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+
+	ctx := context.Background()
+	logger := &testLogger{}
+	processor := batch.NewBatchProcessor(pool, logger)
+
+	// Webhook が有効なテナントのみ対象になる
+	enabledTenantID := createTestGraceTenant(t, pool)
+	defer cleanupTestTenant(t, pool, enabledTenantID)
+	createTestDiscordWebhook(t, pool, enabledTenantID, true)
+
+	disabledTenantID := createTestGraceTenant(t, pool)
+	defer cleanupTestTenant(t, pool, disabledTenantID)
+	createTestDiscordWebhook(t, pool, disabledTenantID, false)
+
+	announcer := &stubUpcomingAnnouncer{}
+	now := time.Now()
+	result, err := processor.RunDiscordAnnouncementsAt(ctx, now, announcer, true)
+	if err != nil {
+		t.Fatalf("RunDiscordAnnouncements failed: %v", err)
+	}
+
+	var found *batch.TenantDiscordAnnouncement
+	for i := range result.Tenants {
+		switch result.Tenants[i].TenantID {
+		case enabledTenantID:
+			found = &result.Tenants[i]
+		case disabledTenantID:
+			t.Error("Tenant with a disabled webhook should not be processed")
+		}
+	}
+	if found == nil {
+		t.Fatal("Expected the tenant with an enabled webhook to be processed")
+	}
+	if found.DeadlineCount != 1 || found.UnderstaffedCount != 2 {
+		t.Errorf("Unexpected tenant counts: %+v", *found)
+	}
+
+	for _, call := range announcer.calls {
+		if !call.DryRun || !call.Now.Equal(now) {
+			t.Errorf("Unexpected announcer input: %+v", call)
+		}
+	}
+	if len(announcer.calls) != len(result.Tenants) {
+		t.Errorf("Expected announcer to be called once per tenant, got %d calls for %d tenants", len(announcer.calls), len(result.Tenants))
+	}
+}
+
 func createTestGraceTenant(t *testing.T, pool *pgxpool.Pool) string {
 	t.Helper()
 	ctx := context.Background()
@@ -309,6 +430,42 @@ func createTestGraceTenant(t *testing.T, pool *pgxpool.Pool) string {
 	return tenantID
 }
 
+func createTestRecurringEvent(t *testing.T, pool *pgxpool.Pool, tenantID string) string {
+	t.Helper()
+	ctx := context.Background()
+
+	eventID := common.NewULID()
+	now := time.Now()
+
+	query := `
+		INSERT INTO events (
+			event_id, tenant_id, event_name, event_type, description, is_active,
+			recurrence_type, recurrence_start_date, recurrence_day_of_week,
+			default_start_time, default_end_time, created_at, updated_at
+		) VALUES ($1, $2, 'Test Recurring Event', 'normal', '', true, 'weekly', $3, 6, '21:00', '23:00', $3, $3)
+	`
+	_, err := pool.Exec(ctx, query, eventID, tenantID, now)
+	if err != nil {
+		t.Fatalf("Failed to create test recurring event: %v", err)
+	}
+
+	return eventID
+}
+
+func createTestDiscordWebhook(t *testing.T, pool *pgxpool.Pool, tenantID string, enabled bool) {
+	t.Helper()
+	ctx := context.Background()
+
+	query := `
+		INSERT INTO discord_webhooks (webhook_id, tenant_id, webhook_url, is_enabled)
+		VALUES ($1, $2, 'https://discord.com/api/webhooks/test', $3)
+	`
+	_, err := pool.Exec(ctx, query, common.NewULID(), tenantID, enabled)
+	if err != nil {
+		t.Fatalf("Failed to create test discord webhook: %v", err)
+	}
+}
+
 func createTestPendingTenant(t *testing.T, pool *pgxpool.Pool) string {
 	t.Helper()
 	ctx := context.Background()
@@ -402,6 +559,13 @@ func cleanupTestTenant(t *testing.T, pool *pgxpool.Pool, tenantID string) {
 	_, _ = pool.Exec(ctx, "DELETE FROM billing_audit_logs WHERE target_id = $1", tenantID)
 }
 
+func cleanupTestEvents(t *testing.T, pool *pgxpool.Pool, tenantID string) {
+	t.Helper()
+	ctx := context.Background()
+
+	_, _ = pool.Exec(ctx, "DELETE FROM events WHERE tenant_id = $1", tenantID)
+}
+
 func cleanupWebhookLog(t *testing.T, pool *pgxpool.Pool, logID int) {
 	t.Helper()
 	ctx := context.Background()
@@ -0,0 +1,128 @@
+package batch
+
+import (
+	"context"
+	"time"
+
+	appevent "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// UpcomingBusinessDayGenerator generates the upcoming business days of a tenant
+// (implemented by appevent.GenerateUpcomingBusinessDaysUsecase)
+type UpcomingBusinessDayGenerator interface {
+	Execute(ctx context.Context, input appevent.GenerateUpcomingBusinessDaysInput) (*appevent.GenerateUpcomingBusinessDaysOutput, error)
+}
+
+// TenantBusinessDayGeneration represents the business day generation result of a tenant
+type TenantBusinessDayGeneration struct {
+	TenantID       string
+	TenantName     string
+	EventCount     int
+	GeneratedCount int
+	SlotCount      int
+	FailedCount    int
+}
+
+// BusinessDayGenerationResult contains the result of business day generation
+type BusinessDayGenerationResult struct {
+	Tenants        []TenantBusinessDayGeneration
+	GeneratedCount int
+	SlotCount      int
+	FailedCount    int
+}
+
+// RunBusinessDayGeneration keeps every active recurring event populated with business days for the given weeks
+func (b *BatchProcessor) RunBusinessDayGeneration(ctx context.Context, generator UpcomingBusinessDayGenerator, weeks int, dryRun bool) (*BusinessDayGenerationResult, error) {
+	return b.RunBusinessDayGenerationAt(ctx, time.Now(), generator, weeks, dryRun)
+}
+
+// RunBusinessDayGenerationAt generates business days up to the given weeks ahead of the given time
+func (b *BatchProcessor) RunBusinessDayGenerationAt(ctx context.Context, now time.Time, generator UpcomingBusinessDayGenerator, weeks int, dryRun bool) (*BusinessDayGenerationResult, error) {
+	b.logger.Printf("📅 Running business day generation (%d weeks ahead)...", weeks)
+
+	// 有効な定期イベントを持つテナントのみ対象（停止中・削除済みのテナントは除外）
+	query := `
+		SELECT t.tenant_id, t.tenant_name
+		FROM tenants t
+		WHERE t.status IN ('active', 'grace')
+		AND t.deleted_at IS NULL
+		AND EXISTS (
+			SELECT 1 FROM events e
+			WHERE e.tenant_id = t.tenant_id
+			AND e.is_active = true
+			AND e.archived_at IS NULL
+			AND e.deleted_at IS NULL
+			AND e.recurrence_type <> 'none'
+		)
+		ORDER BY t.tenant_id
+	`
+
+	rows, err := b.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := &BusinessDayGenerationResult{}
+
+	for rows.Next() {
+		var t TenantBusinessDayGeneration
+		if err := rows.Scan(&t.TenantID, &t.TenantName); err != nil {
+			return nil, err
+		}
+		result.Tenants = append(result.Tenants, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(result.Tenants) == 0 {
+		b.logger.Println("   ✅ No tenants with recurring events found")
+		return result, nil
+	}
+
+	b.logger.Printf("   ⚠️ Found %d tenants with recurring events", len(result.Tenants))
+
+	for i := range result.Tenants {
+		t := &result.Tenants[i]
+
+		output, err := generator.Execute(ctx, appevent.GenerateUpcomingBusinessDaysInput{
+			TenantID: common.TenantID(t.TenantID),
+			Now:      now,
+			Weeks:    weeks,
+			DryRun:   dryRun,
+		})
+		if err != nil {
+			b.logger.Printf("   ❌ Failed to generate business days for tenant %s: %v", t.TenantID, err)
+			t.FailedCount++
+			result.FailedCount++
+			continue
+		}
+
+		t.EventCount = len(output.Events)
+		t.GeneratedCount = output.GeneratedCount
+		t.SlotCount = output.SlotCount
+		t.FailedCount = output.FailedCount
+
+		for _, e := range output.Events {
+			if e.Err != nil {
+				b.logger.Printf("   ❌ Failed to generate business days for event %s (%s): %v", e.EventName, e.EventID, e.Err)
+			}
+		}
+
+		if dryRun {
+			b.logger.Printf("   🔍 [DRY RUN] Would create %d business days (%d shift slots) until %s for %s (%s)",
+				t.GeneratedCount, t.SlotCount, output.Until.Format("2006-01-02"), t.TenantName, t.TenantID)
+		} else {
+			b.logger.Printf("   ✅ Created %d business days (%d shift slots) until %s for %s (%s)",
+				t.GeneratedCount, t.SlotCount, output.Until.Format("2006-01-02"), t.TenantName, t.TenantID)
+		}
+
+		result.GeneratedCount += t.GeneratedCount
+		result.SlotCount += t.SlotCount
+		result.FailedCount += t.FailedCount
+	}
+
+	return result, nil
+}
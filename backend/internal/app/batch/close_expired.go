@@ -0,0 +1,151 @@
+package batch
+
+import (
+	"context"
+	"time"
+
+	appattendance "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/attendance"
+	appschedule "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/schedule"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// ExpiredCollectionCloser closes a tenant's collections whose deadline has passed
+// (implemented by appattendance.CloseExpiredCollectionsUsecase)
+type ExpiredCollectionCloser interface {
+	Execute(ctx context.Context, input appattendance.CloseExpiredCollectionsInput) (*appattendance.CloseExpiredCollectionsOutput, error)
+}
+
+// ExpiredScheduleCloser closes a tenant's schedules whose deadline has passed
+// (implemented by appschedule.CloseExpiredSchedulesUsecase)
+type ExpiredScheduleCloser interface {
+	Execute(ctx context.Context, input appschedule.CloseExpiredSchedulesInput) (*appschedule.CloseExpiredSchedulesOutput, error)
+}
+
+// TenantCloseExpired represents the close-expired result of a tenant
+type TenantCloseExpired struct {
+	TenantID              string
+	TenantName            string
+	ClosedCollectionCount int
+	ClosedScheduleCount   int
+	SummaryCount          int
+	FailedCount           int
+}
+
+// CloseExpiredResult contains the result of closing expired collections and schedules
+type CloseExpiredResult struct {
+	Tenants               []TenantCloseExpired
+	ClosedCollectionCount int
+	ClosedScheduleCount   int
+	SummaryCount          int
+	FailedCount           int
+}
+
+// RunCloseExpired closes the open collections and schedules whose deadline has passed
+func (b *BatchProcessor) RunCloseExpired(ctx context.Context, collectionCloser ExpiredCollectionCloser, scheduleCloser ExpiredScheduleCloser, summarize, dryRun bool) (*CloseExpiredResult, error) {
+	return b.RunCloseExpiredAt(ctx, time.Now(), collectionCloser, scheduleCloser, summarize, dryRun)
+}
+
+// RunCloseExpiredAt closes as of the given time
+func (b *BatchProcessor) RunCloseExpiredAt(ctx context.Context, now time.Time, collectionCloser ExpiredCollectionCloser, scheduleCloser ExpiredScheduleCloser, summarize, dryRun bool) (*CloseExpiredResult, error) {
+	b.logger.Println("🔒 Running close expired collections and schedules...")
+
+	// 締切を過ぎた受付中の出欠確認・日程調整を持つテナントのみ対象（停止中・削除済みのテナントは除外）
+	// まとめを告知する場合は、閲覧時に締め切られたばかりのものを持つテナントも対象にする
+	query := `
+		SELECT t.tenant_id, t.tenant_name
+		FROM tenants t
+		WHERE t.status IN ('active', 'grace')
+		AND t.deleted_at IS NULL
+		AND (
+			EXISTS (
+				SELECT 1 FROM attendance_collections c
+				WHERE c.tenant_id = t.tenant_id
+				AND c.deleted_at IS NULL
+				AND ((c.status = 'open' AND c.deadline < $1) OR ($2 AND c.auto_closed_at >= $3))
+			)
+			OR EXISTS (
+				SELECT 1 FROM date_schedules s
+				WHERE s.tenant_id = t.tenant_id
+				AND s.deleted_at IS NULL
+				AND ((s.status = 'open' AND s.deadline < $1) OR ($2 AND s.auto_closed_at >= $3))
+			)
+		)
+		ORDER BY t.tenant_id
+	`
+
+	rows, err := b.pool.Query(ctx, query, now, summarize, now.Add(-appattendance.DefaultClosedSummaryLookback))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := &CloseExpiredResult{}
+
+	for rows.Next() {
+		var t TenantCloseExpired
+		if err := rows.Scan(&t.TenantID, &t.TenantName); err != nil {
+			return nil, err
+		}
+		result.Tenants = append(result.Tenants, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(result.Tenants) == 0 {
+		b.logger.Println("   ✅ No tenants with expired collections or schedules found")
+		return result, nil
+	}
+
+	b.logger.Printf("   ⚠️ Found %d tenants with expired collections or schedules", len(result.Tenants))
+
+	for i := range result.Tenants {
+		t := &result.Tenants[i]
+		tenantID := common.TenantID(t.TenantID)
+
+		collections, err := collectionCloser.Execute(ctx, appattendance.CloseExpiredCollectionsInput{
+			TenantID:  tenantID,
+			Now:       now,
+			Summarize: summarize,
+			DryRun:    dryRun,
+		})
+		if err != nil {
+			b.logger.Printf("   ❌ Failed to close expired collections for tenant %s: %v", t.TenantID, err)
+			t.FailedCount++
+		} else {
+			t.ClosedCollectionCount = collections.ClosedCount
+			t.SummaryCount += collections.SummaryCount
+			t.FailedCount += collections.FailedCount
+		}
+
+		schedules, err := scheduleCloser.Execute(ctx, appschedule.CloseExpiredSchedulesInput{
+			TenantID:  tenantID,
+			Now:       now,
+			Summarize: summarize,
+			DryRun:    dryRun,
+		})
+		if err != nil {
+			b.logger.Printf("   ❌ Failed to close expired schedules for tenant %s: %v", t.TenantID, err)
+			t.FailedCount++
+		} else {
+			t.ClosedScheduleCount = schedules.ClosedCount
+			t.SummaryCount += schedules.SummaryCount
+			t.FailedCount += schedules.FailedCount
+		}
+
+		if dryRun {
+			b.logger.Printf("   🔍 [DRY RUN] Would close %d collections and %d schedules, announce %d summaries for %s (%s)",
+				t.ClosedCollectionCount, t.ClosedScheduleCount, t.SummaryCount, t.TenantName, t.TenantID)
+		} else {
+			b.logger.Printf("   ✅ Closed %d collections and %d schedules, announced %d summaries for %s (%s), Failed %d",
+				t.ClosedCollectionCount, t.ClosedScheduleCount, t.SummaryCount, t.TenantName, t.TenantID, t.FailedCount)
+		}
+
+		result.ClosedCollectionCount += t.ClosedCollectionCount
+		result.ClosedScheduleCount += t.ClosedScheduleCount
+		result.SummaryCount += t.SummaryCount
+		result.FailedCount += t.FailedCount
+	}
+
+	return result, nil
+}
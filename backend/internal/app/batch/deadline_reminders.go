@@ -0,0 +1,136 @@
+package batch
+
+import (
+	"context"
+	"time"
+
+	appnotification "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// DeadlineReminder reminds the non-respondents of a tenant's collections and schedules nearing their deadline
+// (implemented by appnotification.SendDeadlineRemindersUsecase)
+type DeadlineReminder interface {
+	Execute(ctx context.Context, input appnotification.SendDeadlineRemindersInput) (*appnotification.SendDeadlineRemindersOutput, error)
+}
+
+// TenantDeadlineReminder represents the deadline reminder result of a tenant
+type TenantDeadlineReminder struct {
+	TenantID        string
+	TenantName      string
+	CollectionCount int
+	ScheduleCount   int
+	RemindedCount   int
+	SkippedCount    int
+	FailedCount     int
+}
+
+// DeadlineReminderResult contains the result of deadline reminders
+type DeadlineReminderResult struct {
+	Tenants       []TenantDeadlineReminder
+	RemindedCount int
+	SkippedCount  int
+	FailedCount   int
+}
+
+// RunDeadlineReminders reminds the members who have not answered collections and schedules whose deadline is within the window
+func (b *BatchProcessor) RunDeadlineReminders(ctx context.Context, reminder DeadlineReminder, within time.Duration, dryRun bool) (*DeadlineReminderResult, error) {
+	return b.RunDeadlineRemindersAt(ctx, time.Now(), reminder, within, dryRun)
+}
+
+// RunDeadlineRemindersAt reminds as of the given time
+func (b *BatchProcessor) RunDeadlineRemindersAt(ctx context.Context, now time.Time, reminder DeadlineReminder, within time.Duration, dryRun bool) (*DeadlineReminderResult, error) {
+	b.logger.Println("⏰ Running deadline reminders...")
+
+	if within <= 0 {
+		within = appnotification.DefaultDeadlineReminderWindow
+	}
+
+	// 締切が期間内の受付中の出欠確認・日程調整を持つテナントのみ対象（停止中・削除済みのテナントは除外）
+	query := `
+		SELECT t.tenant_id, t.tenant_name
+		FROM tenants t
+		WHERE t.status IN ('active', 'grace')
+		AND t.deleted_at IS NULL
+		AND (
+			EXISTS (
+				SELECT 1 FROM attendance_collections c
+				WHERE c.tenant_id = t.tenant_id
+				AND c.status = 'open'
+				AND c.deleted_at IS NULL
+				AND c.deadline BETWEEN $1 AND $2
+			)
+			OR EXISTS (
+				SELECT 1 FROM date_schedules s
+				WHERE s.tenant_id = t.tenant_id
+				AND s.status = 'open'
+				AND s.deleted_at IS NULL
+				AND s.deadline BETWEEN $1 AND $2
+			)
+		)
+		ORDER BY t.tenant_id
+	`
+
+	rows, err := b.pool.Query(ctx, query, now, now.Add(within))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := &DeadlineReminderResult{}
+
+	for rows.Next() {
+		var t TenantDeadlineReminder
+		if err := rows.Scan(&t.TenantID, &t.TenantName); err != nil {
+			return nil, err
+		}
+		result.Tenants = append(result.Tenants, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(result.Tenants) == 0 {
+		b.logger.Println("   ✅ No tenants with deadlines nearing found")
+		return result, nil
+	}
+
+	b.logger.Printf("   ⚠️ Found %d tenants with deadlines nearing", len(result.Tenants))
+
+	for i := range result.Tenants {
+		t := &result.Tenants[i]
+
+		output, err := reminder.Execute(ctx, appnotification.SendDeadlineRemindersInput{
+			TenantID: common.TenantID(t.TenantID),
+			Now:      now,
+			Within:   within,
+			DryRun:   dryRun,
+		})
+		if err != nil {
+			b.logger.Printf("   ❌ Failed to send deadline reminders for tenant %s: %v", t.TenantID, err)
+			t.FailedCount++
+			result.FailedCount++
+			continue
+		}
+
+		t.CollectionCount = output.CollectionCount
+		t.ScheduleCount = output.ScheduleCount
+		t.RemindedCount = output.RemindedCount
+		t.SkippedCount = output.SkippedCount
+		t.FailedCount = output.FailedCount
+
+		if dryRun {
+			b.logger.Printf("   🔍 [DRY RUN] Would remind %d members of %d collections and %d schedules for %s (%s)",
+				t.RemindedCount, t.CollectionCount, t.ScheduleCount, t.TenantName, t.TenantID)
+		} else {
+			b.logger.Printf("   ✅ Reminded %d members of %d collections and %d schedules for %s (%s), Skipped %d, Failed %d",
+				t.RemindedCount, t.CollectionCount, t.ScheduleCount, t.TenantName, t.TenantID, t.SkippedCount, t.FailedCount)
+		}
+
+		result.RemindedCount += t.RemindedCount
+		result.SkippedCount += t.SkippedCount
+		result.FailedCount += t.FailedCount
+	}
+
+	return result, nil
+}
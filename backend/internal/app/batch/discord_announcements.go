@@ -0,0 +1,118 @@
+package batch
+
+import (
+	"context"
+	"time"
+
+	appnotification "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// UpcomingAnnouncer announces the upcoming deadlines and understaffed business days of a tenant
+// (implemented by appnotification.AnnounceUpcomingUsecase)
+type UpcomingAnnouncer interface {
+	Execute(ctx context.Context, input appnotification.AnnounceUpcomingInput) (*appnotification.AnnounceUpcomingOutput, error)
+}
+
+// TenantDiscordAnnouncement represents the Discord announcement result of a tenant
+type TenantDiscordAnnouncement struct {
+	TenantID          string
+	TenantName        string
+	DeadlineCount     int
+	UnderstaffedCount int
+	SkippedCount      int
+	FailedCount       int
+}
+
+// DiscordAnnouncementResult contains the result of Discord announcements
+type DiscordAnnouncementResult struct {
+	Tenants           []TenantDiscordAnnouncement
+	DeadlineCount     int
+	UnderstaffedCount int
+	FailedCount       int
+}
+
+// RunDiscordAnnouncements announces deadlines nearing and understaffed business days to each tenant's Discord
+func (b *BatchProcessor) RunDiscordAnnouncements(ctx context.Context, announcer UpcomingAnnouncer, dryRun bool) (*DiscordAnnouncementResult, error) {
+	return b.RunDiscordAnnouncementsAt(ctx, time.Now(), announcer, dryRun)
+}
+
+// RunDiscordAnnouncementsAt announces as of the given time
+func (b *BatchProcessor) RunDiscordAnnouncementsAt(ctx context.Context, now time.Time, announcer UpcomingAnnouncer, dryRun bool) (*DiscordAnnouncementResult, error) {
+	b.logger.Println("📣 Running Discord announcements...")
+
+	// 有効な Discord Webhook を設定しているテナントのみ対象（停止中・削除済みのテナントは除外）
+	query := `
+		SELECT t.tenant_id, t.tenant_name
+		FROM tenants t
+		WHERE t.status IN ('active', 'grace')
+		AND t.deleted_at IS NULL
+		AND EXISTS (
+			SELECT 1 FROM discord_webhooks w
+			WHERE w.tenant_id = t.tenant_id
+			AND w.is_enabled = true
+		)
+		ORDER BY t.tenant_id
+	`
+
+	rows, err := b.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := &DiscordAnnouncementResult{}
+
+	for rows.Next() {
+		var t TenantDiscordAnnouncement
+		if err := rows.Scan(&t.TenantID, &t.TenantName); err != nil {
+			return nil, err
+		}
+		result.Tenants = append(result.Tenants, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(result.Tenants) == 0 {
+		b.logger.Println("   ✅ No tenants with Discord webhooks found")
+		return result, nil
+	}
+
+	b.logger.Printf("   ⚠️ Found %d tenants with Discord webhooks", len(result.Tenants))
+
+	for i := range result.Tenants {
+		t := &result.Tenants[i]
+
+		output, err := announcer.Execute(ctx, appnotification.AnnounceUpcomingInput{
+			TenantID: common.TenantID(t.TenantID),
+			Now:      now,
+			DryRun:   dryRun,
+		})
+		if err != nil {
+			b.logger.Printf("   ❌ Failed to announce for tenant %s: %v", t.TenantID, err)
+			t.FailedCount++
+			result.FailedCount++
+			continue
+		}
+
+		t.DeadlineCount = output.DeadlineCount
+		t.UnderstaffedCount = output.UnderstaffedCount
+		t.SkippedCount = output.SkippedCount
+		t.FailedCount = output.FailedCount
+
+		if dryRun {
+			b.logger.Printf("   🔍 [DRY RUN] Would announce %d deadlines and %d understaffed business days for %s (%s)",
+				t.DeadlineCount, t.UnderstaffedCount, t.TenantName, t.TenantID)
+		} else {
+			b.logger.Printf("   ✅ Announced %d deadlines and %d understaffed business days for %s (%s), Skipped %d, Failed %d",
+				t.DeadlineCount, t.UnderstaffedCount, t.TenantName, t.TenantID, t.SkippedCount, t.FailedCount)
+		}
+
+		result.DeadlineCount += t.DeadlineCount
+		result.UnderstaffedCount += t.UnderstaffedCount
+		result.FailedCount += t.FailedCount
+	}
+
+	return result, nil
+}
@@ -76,8 +76,10 @@ type EventOutput struct {
 
 // BusinessDayOutput represents a business day for calendar display
 type BusinessDayOutput struct {
-	ID        string    `json:"id"`
-	Date      time.Time `json:"date"`
-	StartTime string    `json:"start_time"`
-	EndTime   string    `json:"end_time"`
+	ID          string    `json:"id"`
+	Date        time.Time `json:"date"`
+	StartTime   string    `json:"start_time"`
+	EndTime     string    `json:"end_time"`
+	EndDate     time.Time `json:"end_date"` // 深夜営業は翌日、複数日営業は最終日
+	IsCancelled bool      `json:"is_cancelled"`
 }
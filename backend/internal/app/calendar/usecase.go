@@ -107,7 +107,7 @@ func (u *GetCalendarUsecase) Execute(ctx context.Context, input GetCalendarInput
 	}
 
 	// Get events with business days
-	events, err := getEventsWithBusinessDays(ctx, u.eventRepo, u.businessDayRepo, tenantID, cal.EventIDs())
+	events, err := getEventsWithBusinessDays(ctx, u.eventRepo, u.businessDayRepo, tenantID, cal.EventIDs(), true)
 	if err != nil {
 		return nil, err
 	}
@@ -198,8 +198,8 @@ func (u *GetCalendarByTokenUsecase) Execute(ctx context.Context, input GetCalend
 		return nil, common.NewNotFoundError("calendar", input.Token)
 	}
 
-	// Get events with business days
-	events, err := getEventsWithBusinessDays(ctx, u.eventRepo, u.businessDayRepo, cal.TenantID(), cal.EventIDs())
+	// Get events with business days（アーカイブ済みのイベントは公開しない）
+	events, err := getEventsWithBusinessDays(ctx, u.eventRepo, u.businessDayRepo, cal.TenantID(), cal.EventIDs(), false)
 	if err != nil {
 		return nil, err
 	}
@@ -331,7 +331,8 @@ func (u *DeleteCalendarUsecase) Execute(ctx context.Context, input DeleteCalenda
 }
 
 // getEventsWithBusinessDays fetches events and their business days
-func getEventsWithBusinessDays(ctx context.Context, eventRepo event.EventRepository, businessDayRepo event.EventBusinessDayRepository, tenantID common.TenantID, eventIDs []common.EventID) ([]EventOutput, error) {
+// includeArchived が false の場合はアーカイブ済みのイベントを除外する
+func getEventsWithBusinessDays(ctx context.Context, eventRepo event.EventRepository, businessDayRepo event.EventBusinessDayRepository, tenantID common.TenantID, eventIDs []common.EventID, includeArchived bool) ([]EventOutput, error) {
 	var outputs []EventOutput
 
 	for _, eventID := range eventIDs {
@@ -340,6 +341,9 @@ func getEventsWithBusinessDays(ctx context.Context, eventRepo event.EventReposit
 			slog.Warn("event not found, skipping", "event_id", eventID.String())
 			continue // Skip if event not found
 		}
+		if evt.IsArchived() && !includeArchived {
+			continue
+		}
 
 		// Get business days for this event
 		businessDays, err := businessDayRepo.FindByEventID(ctx, tenantID, eventID)
@@ -350,10 +354,12 @@ func getEventsWithBusinessDays(ctx context.Context, eventRepo event.EventReposit
 		var bdOutputs []BusinessDayOutput
 		for _, bd := range businessDays {
 			bdOutputs = append(bdOutputs, BusinessDayOutput{
-				ID:        bd.BusinessDayID().String(),
-				Date:      bd.TargetDate(),
-				StartTime: bd.StartTime().Format("15:04"),
-				EndTime:   bd.EndTime().Format("15:04"),
+				ID:          bd.BusinessDayID().String(),
+				Date:        bd.TargetDate(),
+				StartTime:   bd.StartTime().Format("15:04"),
+				EndTime:     bd.EndTime().Format("15:04"),
+				EndDate:     bd.EndDate(),
+				IsCancelled: bd.IsCancelled(),
 			})
 		}
 
@@ -137,11 +137,15 @@ func (m *mockBusinessDayRepository) ExistsByEventIDAndDate(ctx context.Context,
 	return false, nil
 }
 
-func (m *mockBusinessDayRepository) FindRecentByTenantID(ctx context.Context, tenantID common.TenantID, limit int) ([]*event.EventBusinessDay, error) {
+func (m *mockBusinessDayRepository) ExistsByEventIDAndOccurrenceDate(ctx context.Context, tenantID common.TenantID, eventID common.EventID, occurrenceDate time.Time) (bool, error) {
+	return false, nil
+}
+
+func (m *mockBusinessDayRepository) FindRecentByTenantID(ctx context.Context, tenantID common.TenantID, today time.Time, limit int) ([]*event.EventBusinessDay, error) {
 	return nil, nil
 }
 
-func (m *mockBusinessDayRepository) FindRecentByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID, limit int, includeFuture bool) ([]*event.EventBusinessDay, error) {
+func (m *mockBusinessDayRepository) FindRecentByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID, today time.Time, limit int, includeFuture bool) ([]*event.EventBusinessDay, error) {
 	return nil, nil
 }
 
@@ -706,6 +710,45 @@ func TestGetCalendarByTokenUsecase_Success(t *testing.T) {
 	}
 }
 
+func TestGetCalendarByTokenUsecase_SkipsArchivedEvents(t *testing.T) {
+	tenantID := createTestTenantID(t)
+	activeEvent := createTestEvent(t, tenantID)
+	archivedEvent := createTestEvent(t, tenantID)
+	if err := archivedEvent.Archive(time.Now()); err != nil {
+		t.Fatalf("failed to archive event: %v", err)
+	}
+	testCalendar := createTestCalendar(t, tenantID, []common.EventID{activeEvent.EventID(), archivedEvent.EventID()})
+	testCalendar.MakePublic(time.Now())
+
+	mockCalRepo := &mockCalendarRepository{
+		findByPublicTokenFunc: func(ctx context.Context, token common.PublicToken) (*calendar.Calendar, error) {
+			return testCalendar, nil
+		},
+	}
+
+	mockEventRepo := &mockEventRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) (*event.Event, error) {
+			if eid == archivedEvent.EventID() {
+				return archivedEvent, nil
+			}
+			return activeEvent, nil
+		},
+	}
+
+	uc := appcalendar.NewGetCalendarByTokenUsecase(mockCalRepo, mockEventRepo, &mockBusinessDayRepository{}, &mockCalendarEntryRepository{})
+
+	result, err := uc.Execute(context.Background(), appcalendar.GetCalendarByTokenInput{
+		Token: testCalendar.PublicToken().String(),
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result.Events) != 1 || result.Events[0].ID != activeEvent.EventID().String() {
+		t.Errorf("expected only the active event to be public, got %+v", result.Events)
+	}
+}
+
 func TestGetCalendarByTokenUsecase_ErrorWhenCalendarNotPublic(t *testing.T) {
 	tenantID := createTestTenantID(t)
 	eventID := createTestEventID(t)
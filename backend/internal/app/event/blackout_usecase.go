@@ -0,0 +1,295 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
+)
+
+// CreateBlackoutDateSetInput represents the input for creating a blackout date set
+type CreateBlackoutDateSetInput struct {
+	TenantID    common.TenantID
+	Name        string
+	Description string
+	Action      event.BlackoutAction
+	Dates       []event.BlackoutDate
+}
+
+// CreateBlackoutDateSetUsecase handles the blackout date set creation use case
+type CreateBlackoutDateSetUsecase struct {
+	blackoutRepo event.BlackoutDateSetRepository
+	clock        services.Clock
+}
+
+// NewCreateBlackoutDateSetUsecase creates a new CreateBlackoutDateSetUsecase
+func NewCreateBlackoutDateSetUsecase(blackoutRepo event.BlackoutDateSetRepository, clock services.Clock) *CreateBlackoutDateSetUsecase {
+	return &CreateBlackoutDateSetUsecase{
+		blackoutRepo: blackoutRepo,
+		clock:        clock,
+	}
+}
+
+// Execute creates a new blackout date set
+func (uc *CreateBlackoutDateSetUsecase) Execute(ctx context.Context, input CreateBlackoutDateSetInput) (*event.BlackoutDateSet, error) {
+	set, err := event.NewBlackoutDateSet(uc.clock.Now(), input.TenantID, input.Name, input.Description, input.Action, input.Dates)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.blackoutRepo.Save(ctx, set); err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+// ListBlackoutDateSetsInput represents the input for listing blackout date sets
+type ListBlackoutDateSetsInput struct {
+	TenantID common.TenantID
+}
+
+// ListBlackoutDateSetsUsecase handles the blackout date set listing use case
+type ListBlackoutDateSetsUsecase struct {
+	blackoutRepo event.BlackoutDateSetRepository
+}
+
+// NewListBlackoutDateSetsUsecase creates a new ListBlackoutDateSetsUsecase
+func NewListBlackoutDateSetsUsecase(blackoutRepo event.BlackoutDateSetRepository) *ListBlackoutDateSetsUsecase {
+	return &ListBlackoutDateSetsUsecase{
+		blackoutRepo: blackoutRepo,
+	}
+}
+
+// Execute lists the blackout date sets of a tenant
+func (uc *ListBlackoutDateSetsUsecase) Execute(ctx context.Context, input ListBlackoutDateSetsInput) ([]*event.BlackoutDateSet, error) {
+	return uc.blackoutRepo.FindByTenantID(ctx, input.TenantID)
+}
+
+// GetBlackoutDateSetInput represents the input for getting a blackout date set
+type GetBlackoutDateSetInput struct {
+	TenantID common.TenantID
+	SetID    event.BlackoutDateSetID
+}
+
+// GetBlackoutDateSetUsecase handles the blackout date set retrieval use case
+type GetBlackoutDateSetUsecase struct {
+	blackoutRepo event.BlackoutDateSetRepository
+}
+
+// NewGetBlackoutDateSetUsecase creates a new GetBlackoutDateSetUsecase
+func NewGetBlackoutDateSetUsecase(blackoutRepo event.BlackoutDateSetRepository) *GetBlackoutDateSetUsecase {
+	return &GetBlackoutDateSetUsecase{
+		blackoutRepo: blackoutRepo,
+	}
+}
+
+// Execute retrieves a blackout date set
+func (uc *GetBlackoutDateSetUsecase) Execute(ctx context.Context, input GetBlackoutDateSetInput) (*event.BlackoutDateSet, error) {
+	return uc.blackoutRepo.FindByID(ctx, input.TenantID, input.SetID)
+}
+
+// UpdateBlackoutDateSetInput represents the input for updating a blackout date set
+type UpdateBlackoutDateSetInput struct {
+	TenantID    common.TenantID
+	SetID       event.BlackoutDateSetID
+	Name        string
+	Description string
+	Action      event.BlackoutAction // 空の場合は変更しない
+	IsActive    *bool                // nil の場合は変更しない
+	// Dates は nil の場合は変更せず、指定された場合は全件を置き換える
+	Dates *[]event.BlackoutDate
+}
+
+// UpdateBlackoutDateSetUsecase handles the blackout date set update use case
+// 既に生成済みの営業日は変更しない（以降の自動生成から適用される）
+type UpdateBlackoutDateSetUsecase struct {
+	blackoutRepo event.BlackoutDateSetRepository
+	clock        services.Clock
+}
+
+// NewUpdateBlackoutDateSetUsecase creates a new UpdateBlackoutDateSetUsecase
+func NewUpdateBlackoutDateSetUsecase(blackoutRepo event.BlackoutDateSetRepository, clock services.Clock) *UpdateBlackoutDateSetUsecase {
+	return &UpdateBlackoutDateSetUsecase{
+		blackoutRepo: blackoutRepo,
+		clock:        clock,
+	}
+}
+
+// Execute updates a blackout date set
+func (uc *UpdateBlackoutDateSetUsecase) Execute(ctx context.Context, input UpdateBlackoutDateSetInput) (*event.BlackoutDateSet, error) {
+	set, err := uc.blackoutRepo.FindByID(ctx, input.TenantID, input.SetID)
+	if err != nil {
+		return nil, err
+	}
+
+	action := input.Action
+	if action == "" {
+		action = set.Action()
+	}
+	isActive := set.IsActive()
+	if input.IsActive != nil {
+		isActive = *input.IsActive
+	}
+
+	now := uc.clock.Now()
+	if err := set.Update(now, input.Name, input.Description, action, isActive); err != nil {
+		return nil, err
+	}
+	if input.Dates != nil {
+		if err := set.ReplaceDates(now, *input.Dates); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.blackoutRepo.Save(ctx, set); err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+// DeleteBlackoutDateSetInput represents the input for deleting a blackout date set
+type DeleteBlackoutDateSetInput struct {
+	TenantID common.TenantID
+	SetID    event.BlackoutDateSetID
+}
+
+// DeleteBlackoutDateSetUsecase handles the blackout date set deletion use case
+type DeleteBlackoutDateSetUsecase struct {
+	blackoutRepo event.BlackoutDateSetRepository
+}
+
+// NewDeleteBlackoutDateSetUsecase creates a new DeleteBlackoutDateSetUsecase
+func NewDeleteBlackoutDateSetUsecase(blackoutRepo event.BlackoutDateSetRepository) *DeleteBlackoutDateSetUsecase {
+	return &DeleteBlackoutDateSetUsecase{
+		blackoutRepo: blackoutRepo,
+	}
+}
+
+// Execute deletes a blackout date set
+func (uc *DeleteBlackoutDateSetUsecase) Execute(ctx context.Context, input DeleteBlackoutDateSetInput) error {
+	return uc.blackoutRepo.Delete(ctx, input.TenantID, input.SetID)
+}
+
+// ImportBlackoutDatesOutput represents the output of importing dates into a blackout date set
+type ImportBlackoutDatesOutput struct {
+	Set        *event.BlackoutDateSet
+	AddedCount int // 新たに追加された日付の件数（登録済みの日付は数えない）
+}
+
+// ImportJapaneseHolidaysInput represents the input for importing Japanese holidays
+type ImportJapaneseHolidaysInput struct {
+	TenantID common.TenantID
+	SetID    event.BlackoutDateSetID
+	FromYear int
+	ToYear   int
+}
+
+// ImportJapaneseHolidaysUsecase imports the Japanese national holidays into a blackout date set
+type ImportJapaneseHolidaysUsecase struct {
+	blackoutRepo event.BlackoutDateSetRepository
+	clock        services.Clock
+}
+
+// NewImportJapaneseHolidaysUsecase creates a new ImportJapaneseHolidaysUsecase
+func NewImportJapaneseHolidaysUsecase(blackoutRepo event.BlackoutDateSetRepository, clock services.Clock) *ImportJapaneseHolidaysUsecase {
+	return &ImportJapaneseHolidaysUsecase{
+		blackoutRepo: blackoutRepo,
+		clock:        clock,
+	}
+}
+
+// Execute imports the holidays of the years into the set
+func (uc *ImportJapaneseHolidaysUsecase) Execute(ctx context.Context, input ImportJapaneseHolidaysInput) (*ImportBlackoutDatesOutput, error) {
+	holidays, err := event.JapaneseHolidays(input.FromYear, input.ToYear)
+	if err != nil {
+		return nil, err
+	}
+
+	return importBlackoutDates(ctx, uc.blackoutRepo, input.TenantID, input.SetID, holidays, uc.clock.Now())
+}
+
+// ImportBlackoutICSInput represents the input for importing an ICS file
+type ImportBlackoutICSInput struct {
+	TenantID common.TenantID
+	SetID    event.BlackoutDateSetID
+	Data     []byte
+}
+
+// ImportBlackoutICSUsecase imports the events of an ICS file into a blackout date set
+type ImportBlackoutICSUsecase struct {
+	blackoutRepo event.BlackoutDateSetRepository
+	tenantRepo   tenant.TenantRepository
+	clock        services.Clock
+}
+
+// NewImportBlackoutICSUsecase creates a new ImportBlackoutICSUsecase
+func NewImportBlackoutICSUsecase(
+	blackoutRepo event.BlackoutDateSetRepository,
+	tenantRepo tenant.TenantRepository,
+	clock services.Clock,
+) *ImportBlackoutICSUsecase {
+	return &ImportBlackoutICSUsecase{
+		blackoutRepo: blackoutRepo,
+		tenantRepo:   tenantRepo,
+		clock:        clock,
+	}
+}
+
+// Execute imports the events of the ICS file into the set
+// タイムゾーン指定のない日時はテナントのタイムゾーンで日付に変換する
+func (uc *ImportBlackoutICSUsecase) Execute(ctx context.Context, input ImportBlackoutICSInput) (*ImportBlackoutDatesOutput, error) {
+	loc, err := tenant.ResolveLocation(ctx, uc.tenantRepo, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	dates, err := event.ParseBlackoutICS(input.Data, loc)
+	if err != nil {
+		return nil, err
+	}
+	if len(dates) == 0 {
+		return nil, common.NewValidationError("ics file contains no events", nil)
+	}
+
+	return importBlackoutDates(ctx, uc.blackoutRepo, input.TenantID, input.SetID, dates, uc.clock.Now())
+}
+
+// importBlackoutDates merges the dates into the set and saves it
+func importBlackoutDates(
+	ctx context.Context,
+	blackoutRepo event.BlackoutDateSetRepository,
+	tenantID common.TenantID,
+	setID event.BlackoutDateSetID,
+	dates []event.BlackoutDate,
+	now time.Time,
+) (*ImportBlackoutDatesOutput, error) {
+	set, err := blackoutRepo.FindByID(ctx, tenantID, setID)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := set.AddDates(now, dates)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := blackoutRepo.Save(ctx, set); err != nil {
+		return nil, err
+	}
+
+	return &ImportBlackoutDatesOutput{Set: set, AddedCount: added}, nil
+}
+
+// loadBlackoutCalendar loads the blackout date sets of the tenant for recurrence expansion
+func loadBlackoutCalendar(ctx context.Context, blackoutRepo event.BlackoutDateSetRepository, tenantID common.TenantID) (*event.BlackoutCalendar, error) {
+	sets, err := blackoutRepo.FindByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewBlackoutCalendar(sets), nil
+}
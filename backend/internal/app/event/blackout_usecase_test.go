@@ -0,0 +1,195 @@
+package event_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	appevent "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+)
+
+func createBlackoutDateSet(t *testing.T, tenantID common.TenantID, action event.BlackoutAction, dates ...string) *event.BlackoutDateSet {
+	t.Helper()
+	blackoutDates := make([]event.BlackoutDate, len(dates))
+	for i, d := range dates {
+		parsed, err := time.Parse("2006-01-02", d)
+		if err != nil {
+			t.Fatalf("Failed to parse date: %v", err)
+		}
+		blackoutDates[i] = event.BlackoutDate{Date: parsed}
+	}
+	set, err := event.NewBlackoutDateSet(time.Now(), tenantID, "休業日", "", action, blackoutDates)
+	if err != nil {
+		t.Fatalf("Failed to create blackout date set: %v", err)
+	}
+	return set
+}
+
+// =====================================================
+// Blackout date set usecase Tests
+// =====================================================
+
+func TestImportJapaneseHolidaysUsecase_Execute_IsIdempotent(t *testing.T) {
+	tenantID := common.NewTenantID()
+	set := createBlackoutDateSet(t, tenantID, event.BlackoutActionSkip, "2025-01-01")
+	repo := &MockBlackoutDateSetRepository{sets: []*event.BlackoutDateSet{set}}
+	usecase := appevent.NewImportJapaneseHolidaysUsecase(repo, &MockClock{})
+
+	input := appevent.ImportJapaneseHolidaysInput{
+		TenantID: tenantID,
+		SetID:    set.SetID(),
+		FromYear: 2025,
+		ToYear:   2025,
+	}
+	result, err := usecase.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+	// 2025 年の祝日 19 日のうち 1/1 は登録済み
+	if result.AddedCount != 18 || len(result.Set.Dates()) != 19 {
+		t.Errorf("AddedCount = %d (total %d), want 18 (total 19)", result.AddedCount, len(result.Set.Dates()))
+	}
+
+	result, err = usecase.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+	if result.AddedCount != 0 {
+		t.Errorf("AddedCount = %d on re-import, want 0", result.AddedCount)
+	}
+}
+
+func TestImportBlackoutICSUsecase_Execute_ErrorWhenSetOfAnotherTenant(t *testing.T) {
+	set := createBlackoutDateSet(t, common.NewTenantID(), event.BlackoutActionSkip)
+	repo := &MockBlackoutDateSetRepository{sets: []*event.BlackoutDateSet{set}}
+	usecase := appevent.NewImportBlackoutICSUsecase(repo, &MockTenantRepository{}, &MockClock{})
+
+	_, err := usecase.Execute(context.Background(), appevent.ImportBlackoutICSInput{
+		TenantID: common.NewTenantID(),
+		SetID:    set.SetID(),
+		Data:     []byte("BEGIN:VEVENT\nDTSTART;VALUE=DATE:20251231\nEND:VEVENT\n"),
+	})
+	if !common.IsNotFoundError(err) {
+		t.Errorf("Execute() should return NotFound, got %v", err)
+	}
+}
+
+func TestUpdateBlackoutDateSetUsecase_Execute_KeepsUnspecifiedFields(t *testing.T) {
+	tenantID := common.NewTenantID()
+	set := createBlackoutDateSet(t, tenantID, event.BlackoutActionMove, "2025-01-01")
+	repo := &MockBlackoutDateSetRepository{sets: []*event.BlackoutDateSet{set}}
+	usecase := appevent.NewUpdateBlackoutDateSetUsecase(repo, &MockClock{})
+
+	updated, err := usecase.Execute(context.Background(), appevent.UpdateBlackoutDateSetInput{
+		TenantID: tenantID,
+		SetID:    set.SetID(),
+		Name:     "祝日",
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+	if updated.Name() != "祝日" || updated.Action() != event.BlackoutActionMove || !updated.IsActive() || len(updated.Dates()) != 1 {
+		t.Errorf("Execute() should only change the name, got action=%s active=%v dates=%d",
+			updated.Action(), updated.IsActive(), len(updated.Dates()))
+	}
+}
+
+// =====================================================
+// Business day generation with blackout dates Tests
+// =====================================================
+
+func TestGenerateBusinessDaysUsecase_Execute_AppliesBlackoutDates(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testEvent := createSaturdayEvent(t, tenantID)
+	now := time.Date(2025, 2, 15, 3, 0, 0, 0, time.UTC)
+
+	inactive := createBlackoutDateSet(t, tenantID, event.BlackoutActionSkip, "2025-02-22")
+	if err := inactive.Update(now, inactive.Name(), "", inactive.Action(), false); err != nil {
+		t.Fatalf("Failed to deactivate blackout date set: %v", err)
+	}
+	blackoutRepo := &MockBlackoutDateSetRepository{sets: []*event.BlackoutDateSet{
+		createBlackoutDateSet(t, tenantID, event.BlackoutActionSkip, "2025-02-08"),
+		createBlackoutDateSet(t, tenantID, event.BlackoutActionMove, "2025-03-15"),
+		inactive,
+		// 他テナントの休業日は適用されない
+		createBlackoutDateSet(t, common.NewTenantID(), event.BlackoutActionSkip, "2025-03-01"),
+	}}
+
+	eventRepo := &MockEventRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) (*event.Event, error) {
+			return testEvent, nil
+		},
+	}
+	var saved []string
+	bdRepo := &MockBusinessDayRepository{
+		saveFunc: func(ctx context.Context, bd *event.EventBusinessDay) error {
+			saved = append(saved, bd.TargetDate().Format("2006-01-02"))
+			return nil
+		},
+	}
+
+	usecase := appevent.NewGenerateBusinessDaysUsecase(eventRepo, bdRepo, &MockTenantRepository{}, blackoutRepo,
+		&MockClock{nowFunc: func() time.Time { return now }})
+
+	result, err := usecase.Execute(context.Background(), appevent.GenerateBusinessDaysInput{
+		TenantID: tenantID,
+		EventID:  testEvent.EventID(),
+		Months:   1,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	// 2/1〜3/31 の土曜日 9 日から 2/8 を除外し、3/15 は 3/16 に移動
+	want := "2025-02-01,2025-02-15,2025-02-22,2025-03-01,2025-03-08,2025-03-16,2025-03-22,2025-03-29"
+	if got := strings.Join(saved, ","); got != want {
+		t.Errorf("generated dates = %s, want %s", got, want)
+	}
+	if result.GeneratedCount != 8 {
+		t.Errorf("GeneratedCount = %d, want 8", result.GeneratedCount)
+	}
+}
+
+func TestGenerateUpcomingBusinessDaysUsecase_Execute_AppliesBlackoutDates(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)
+	recurring := createSaturdayEvent(t, tenantID)
+
+	eventRepo := &MockEventRepository{
+		findActiveByTenantFunc: func(ctx context.Context, tid common.TenantID) ([]*event.Event, error) {
+			return []*event.Event{recurring}, nil
+		},
+	}
+	var saved []string
+	bdRepo := &MockBusinessDayRepository{
+		saveFunc: func(ctx context.Context, bd *event.EventBusinessDay) error {
+			saved = append(saved, bd.TargetDate().Format("2006-01-02"))
+			return nil
+		},
+	}
+	blackoutRepo := &MockBlackoutDateSetRepository{sets: []*event.BlackoutDateSet{
+		createBlackoutDateSet(t, tenantID, event.BlackoutActionMove, "2025-03-08"),
+	}}
+
+	usecase := appevent.NewGenerateUpcomingBusinessDaysUsecase(eventRepo, bdRepo, &MockTenantRepository{}, blackoutRepo,
+		&MockShiftSlotTemplateRepository{}, &MockShiftSlotRepository{}, &MockInstanceRepository{}, &MockTxManager{})
+
+	result, err := usecase.Execute(context.Background(), appevent.GenerateUpcomingBusinessDaysInput{
+		TenantID: tenantID,
+		Now:      now,
+		Weeks:    2,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if got, want := strings.Join(saved, ","), "2025-03-01,2025-03-09,2025-03-15"; got != want {
+		t.Errorf("generated dates = %s, want %s", got, want)
+	}
+	if result.GeneratedCount != 3 {
+		t.Errorf("GeneratedCount = %d, want 3", result.GeneratedCount)
+	}
+}
@@ -0,0 +1,458 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// 営業日の一括変更の定数
+const (
+	MaxBulkBusinessDays = 200 // 一度に変更できる営業日の最大件数
+	MaxBulkMoveDays     = 366 // 日付を移動できる最大日数
+)
+
+// BulkBusinessDayOperation represents the kind of bulk change applied to business days
+type BulkBusinessDayOperation string
+
+const (
+	BulkOperationShiftTime BulkBusinessDayOperation = "shift_time" // 開始・終了時刻をずらす
+	BulkOperationMove      BulkBusinessDayOperation = "move"       // 日付を移動する
+	BulkOperationCancel    BulkBusinessDayOperation = "cancel"     // 開催を中止する（シフト枠・割り当ては残す）
+)
+
+func (o BulkBusinessDayOperation) Validate() error {
+	switch o {
+	case BulkOperationShiftTime, BulkOperationMove, BulkOperationCancel:
+		return nil
+	default:
+		return common.NewValidationError(fmt.Sprintf("invalid operation: %s", o), nil)
+	}
+}
+
+// BulkUpdateBusinessDaysInput represents the input for changing business days of an event at once
+// 対象は BusinessDayIDs（選択）または StartDate〜EndDate（期間）のどちらかで指定する
+type BulkUpdateBusinessDaysInput struct {
+	TenantID       common.TenantID
+	EventID        common.EventID
+	BusinessDayIDs []event.BusinessDayID
+	StartDate      *time.Time
+	EndDate        *time.Time
+	Operation      BulkBusinessDayOperation
+	StartShift     time.Duration   // shift_time: 開始時刻をずらす幅（シフト枠も同じ幅でずらす）
+	EndShift       time.Duration   // shift_time: 終了時刻をずらす幅（営業日の終了まで続くシフト枠の終了時刻も合わせる）
+	MoveDays       int             // move: 移動する日数（負の値は前倒し）
+	CancelReason   string          // cancel: 中止理由
+	CancelledBy    *common.AdminID // cancel: 中止した管理者
+	DryRun         bool            // true の場合は変更内容と影響するメンバーの算出のみで保存しない
+}
+
+// BulkUpdatedBusinessDay represents a business day changed by the bulk operation
+type BulkUpdatedBusinessDay struct {
+	BusinessDay       *event.EventBusinessDay
+	PreviousDate      time.Time
+	PreviousStartTime time.Time
+	PreviousEndTime   time.Time
+	SlotCount         int // 時刻を変更したシフト枠の件数
+}
+
+// AffectedMember represents a member assigned to a changed business day (連絡が必要なメンバー)
+type AffectedMember struct {
+	MemberID      common.MemberID
+	DisplayName   string
+	BusinessDayID event.BusinessDayID
+	SlotID        shift.SlotID
+	SlotName      string
+}
+
+// BulkUpdateBusinessDaysOutput represents the output of the bulk operation
+type BulkUpdateBusinessDaysOutput struct {
+	BusinessDays    []BulkUpdatedBusinessDay
+	AffectedMembers []AffectedMember
+}
+
+// BulkUpdateBusinessDaysUsecase changes the time or date of business days, or cancels them, without recreating them
+// シフト枠と割り当ては保持したまま、枠の時刻を営業日の開始・終了時刻に合わせてずらす
+// 定期営業の本来の開催日（OccurrenceDate）は変更しないため、自動生成で元の日時に作り直されることはない
+type BulkUpdateBusinessDaysUsecase struct {
+	businessDayRepo event.EventBusinessDayRepository
+	eventRepo       event.EventRepository
+	slotRepo        shift.ShiftSlotRepository
+	assignmentRepo  shift.ShiftAssignmentRepository
+	memberRepo      member.MemberRepository
+	txManager       services.TxManager
+	clock           services.Clock
+}
+
+// NewBulkUpdateBusinessDaysUsecase creates a new BulkUpdateBusinessDaysUsecase
+func NewBulkUpdateBusinessDaysUsecase(
+	businessDayRepo event.EventBusinessDayRepository,
+	eventRepo event.EventRepository,
+	slotRepo shift.ShiftSlotRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	memberRepo member.MemberRepository,
+	txManager services.TxManager,
+	clock services.Clock,
+) *BulkUpdateBusinessDaysUsecase {
+	return &BulkUpdateBusinessDaysUsecase{
+		businessDayRepo: businessDayRepo,
+		eventRepo:       eventRepo,
+		slotRepo:        slotRepo,
+		assignmentRepo:  assignmentRepo,
+		memberRepo:      memberRepo,
+		txManager:       txManager,
+		clock:           clock,
+	}
+}
+
+// Execute applies the bulk operation to the selected business days in a single transaction
+func (uc *BulkUpdateBusinessDaysUsecase) Execute(ctx context.Context, input BulkUpdateBusinessDaysInput) (*BulkUpdateBusinessDaysOutput, error) {
+	if err := validateBulkUpdateInput(input); err != nil {
+		return nil, err
+	}
+
+	// イベントの存在確認
+	if _, err := uc.eventRepo.FindByID(ctx, input.TenantID, input.EventID); err != nil {
+		return nil, err
+	}
+
+	businessDays, err := uc.findTargets(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if len(businessDays) > MaxBulkBusinessDays {
+		return nil, common.NewValidationError(fmt.Sprintf("at most %d business days can be changed at once", MaxBulkBusinessDays), nil)
+	}
+	sortForBulkUpdate(businessDays, input)
+
+	now := uc.clock.Now()
+	output := &BulkUpdateBusinessDaysOutput{}
+
+	// 一括変更の途中で空いた日時・埋まった日時（DryRun でも連続した移動を正しく判定するため）
+	schedule := &bulkScheduleKeys{vacated: map[string]bool{}, occupied: map[string]bool{}}
+
+	apply := func(txCtx context.Context) error {
+		for _, bd := range businessDays {
+			updated, err := uc.applyToBusinessDay(txCtx, bd, input, schedule, now)
+			if err != nil {
+				return err
+			}
+			output.BusinessDays = append(output.BusinessDays, *updated)
+		}
+		return nil
+	}
+	if input.DryRun {
+		err = apply(ctx)
+	} else {
+		err = uc.txManager.WithTx(ctx, apply)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	output.AffectedMembers, err = uc.findAffectedMembers(ctx, input.TenantID, businessDays)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func validateBulkUpdateInput(input BulkUpdateBusinessDaysInput) error {
+	if err := input.Operation.Validate(); err != nil {
+		return err
+	}
+
+	hasSelection := len(input.BusinessDayIDs) > 0
+	hasRange := input.StartDate != nil || input.EndDate != nil
+	if hasSelection == hasRange {
+		return common.NewValidationError("either business_day_ids or start_date/end_date must be specified", nil)
+	}
+	if hasRange {
+		if input.StartDate == nil || input.EndDate == nil {
+			return common.NewValidationError("start_date and end_date must be both set", nil)
+		}
+		if input.StartDate.After(*input.EndDate) {
+			return common.NewValidationError("start_date must be before end_date", nil)
+		}
+	}
+
+	switch input.Operation {
+	case BulkOperationShiftTime:
+		if input.StartShift == 0 && input.EndShift == 0 {
+			return common.NewValidationError("start or end shift is required", nil)
+		}
+		if input.StartShift <= -24*time.Hour || input.StartShift >= 24*time.Hour ||
+			input.EndShift <= -24*time.Hour || input.EndShift >= 24*time.Hour {
+			return common.NewValidationError("shift must be less than 24 hours", nil)
+		}
+	case BulkOperationMove:
+		if input.MoveDays == 0 {
+			return common.NewValidationError("move_days is required", nil)
+		}
+		if input.MoveDays < -MaxBulkMoveDays || input.MoveDays > MaxBulkMoveDays {
+			return common.NewValidationError(fmt.Sprintf("move_days must be between -%d and %d", MaxBulkMoveDays, MaxBulkMoveDays), nil)
+		}
+	}
+
+	return nil
+}
+
+// findTargets returns the business days of the event selected by the input (deleted days are excluded)
+func (uc *BulkUpdateBusinessDaysUsecase) findTargets(ctx context.Context, input BulkUpdateBusinessDaysInput) ([]*event.EventBusinessDay, error) {
+	if len(input.BusinessDayIDs) == 0 {
+		found, err := uc.businessDayRepo.FindByEventIDAndDateRange(ctx, input.TenantID, input.EventID, *input.StartDate, *input.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		var result []*event.EventBusinessDay
+		for _, bd := range found {
+			// 期間指定の中止では、既に中止済みの営業日は対象外
+			if bd.IsDeleted() || (input.Operation == BulkOperationCancel && bd.IsCancelled()) {
+				continue
+			}
+			result = append(result, bd)
+		}
+		return result, nil
+	}
+
+	seen := make(map[event.BusinessDayID]bool, len(input.BusinessDayIDs))
+	result := make([]*event.EventBusinessDay, 0, len(input.BusinessDayIDs))
+	for _, id := range input.BusinessDayIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		bd, err := uc.businessDayRepo.FindByID(ctx, input.TenantID, id)
+		if err != nil {
+			return nil, err
+		}
+		if bd.EventID() != input.EventID || bd.IsDeleted() {
+			return nil, common.NewNotFoundError("BusinessDay", id.String())
+		}
+		result = append(result, bd)
+	}
+	return result, nil
+}
+
+// sortForBulkUpdate orders the business days so that moving one does not collide with another not yet moved
+// 後ろへずらす場合は遅い順、前へずらす場合は早い順に処理する
+func sortForBulkUpdate(businessDays []*event.EventBusinessDay, input BulkUpdateBusinessDaysInput) {
+	forward := input.MoveDays > 0 || (input.Operation == BulkOperationShiftTime && input.StartShift > 0)
+	sort.SliceStable(businessDays, func(i, j int) bool {
+		a, b := businessDays[i], businessDays[j]
+		if !a.TargetDate().Equal(b.TargetDate()) {
+			return a.TargetDate().Before(b.TargetDate()) != forward
+		}
+		return a.StartTime().Before(b.StartTime()) != forward
+	})
+}
+
+// applyToBusinessDay applies the operation to a single business day and its shift slots
+func (uc *BulkUpdateBusinessDaysUsecase) applyToBusinessDay(
+	ctx context.Context,
+	bd *event.EventBusinessDay,
+	input BulkUpdateBusinessDaysInput,
+	schedule *bulkScheduleKeys,
+	now time.Time,
+) (*BulkUpdatedBusinessDay, error) {
+	result := &BulkUpdatedBusinessDay{
+		BusinessDay:       bd,
+		PreviousDate:      bd.TargetDate(),
+		PreviousStartTime: bd.StartTime(),
+		PreviousEndTime:   bd.EndTime(),
+	}
+
+	if input.Operation == BulkOperationCancel {
+		if err := bd.Cancel(now, input.CancelReason, input.CancelledBy); err != nil {
+			return nil, err
+		}
+		if !input.DryRun {
+			if err := uc.businessDayRepo.Save(ctx, bd); err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	}
+
+	previousStart, previousEnd := bd.StartAt(time.UTC), bd.EndAt(time.UTC)
+	targetDate, startTime, endTime := bd.TargetDate(), bd.StartTime(), bd.EndTime()
+	if input.Operation == BulkOperationMove {
+		targetDate = targetDate.AddDate(0, 0, input.MoveDays)
+	} else {
+		startTime = startTime.Add(input.StartShift)
+		endTime = endTime.Add(input.EndShift)
+	}
+
+	if err := bd.Reschedule(now, targetDate, startTime, endTime); err != nil {
+		return nil, err
+	}
+
+	// 同じ日時の営業日との重複チェック
+	prevKey := scheduleKey(result.PreviousDate, result.PreviousStartTime)
+	newKey := scheduleKey(bd.TargetDate(), bd.StartTime())
+	if newKey != prevKey {
+		exists := schedule.occupied[newKey]
+		if !exists && !schedule.vacated[newKey] {
+			var err error
+			exists, err = uc.businessDayRepo.ExistsByEventIDAndDate(ctx, bd.TenantID(), bd.EventID(), bd.TargetDate(), bd.StartTime())
+			if err != nil {
+				return nil, err
+			}
+		}
+		if exists {
+			return nil, common.NewConflictError(fmt.Sprintf("Business day already exists for %s %s",
+				bd.TargetDate().Format("2006-01-02"), bd.StartTime().Format("15:04")))
+		}
+		schedule.vacated[prevKey] = true
+		delete(schedule.occupied, prevKey)
+		schedule.occupied[newKey] = true
+		delete(schedule.vacated, newKey)
+	}
+
+	var shifted []*shift.ShiftSlot
+	if input.Operation == BulkOperationShiftTime {
+		var err error
+		shifted, err = uc.shiftSlots(ctx, bd, input, result.PreviousDate, previousStart, previousEnd, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+	result.SlotCount = len(shifted)
+
+	if input.DryRun {
+		return result, nil
+	}
+	if err := uc.businessDayRepo.Save(ctx, bd); err != nil {
+		return nil, err
+	}
+	for _, slot := range shifted {
+		if err := uc.slotRepo.Save(ctx, slot); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// shiftSlots moves the slots of the business day along with its new start/end time and returns the changed slots
+// 全ての枠を開始時刻の変更幅でずらし、営業日の終了まで続いていた枠は終了時刻を営業日の終了に合わせる
+// 変更前は営業時間内だった枠が変更後の営業時間からはみ出す場合はエラーを返す
+func (uc *BulkUpdateBusinessDaysUsecase) shiftSlots(
+	ctx context.Context,
+	bd *event.EventBusinessDay,
+	input BulkUpdateBusinessDaysInput,
+	previousDate, previousStart, previousEnd time.Time,
+	now time.Time,
+) ([]*shift.ShiftSlot, error) {
+	found, err := uc.slotRepo.FindByBusinessDayID(ctx, bd.TenantID(), bd.BusinessDayID())
+	if err != nil {
+		return nil, err
+	}
+
+	var shifted []*shift.ShiftSlot
+	for _, slot := range found {
+		if slot.IsDeleted() {
+			continue
+		}
+		slotStart, slotEnd := slot.PeriodOn(previousDate)
+		withinDay := !slotStart.Before(previousStart) && !slotEnd.After(previousEnd)
+		endsWithDay := slotEnd.Equal(previousEnd)
+
+		changed := false
+		if input.StartShift != 0 {
+			if err := slot.ShiftTimes(now, input.StartShift); err != nil {
+				return nil, err
+			}
+			changed = true
+		}
+		if endsWithDay && input.EndShift != input.StartShift {
+			if err := slot.ShiftEndTime(now, input.EndShift-input.StartShift); err != nil {
+				return nil, err
+			}
+			changed = true
+		}
+
+		slotStart, slotEnd = slot.PeriodOn(bd.TargetDate())
+		if withinDay && (slotStart.Before(bd.StartAt(time.UTC)) || slotEnd.After(bd.EndAt(time.UTC))) {
+			return nil, common.NewValidationError(fmt.Sprintf("shift slot %s on %s would fall outside the business day",
+				slot.SlotName(), bd.TargetDate().Format("2006-01-02")), nil)
+		}
+		if changed {
+			shifted = append(shifted, slot)
+		}
+	}
+	return shifted, nil
+}
+
+// bulkScheduleKeys tracks the date/start time pairs freed and taken during a bulk operation
+type bulkScheduleKeys struct {
+	vacated  map[string]bool
+	occupied map[string]bool
+}
+
+func scheduleKey(date, startTime time.Time) string {
+	return date.Format("2006-01-02") + " " + startTime.Format("15:04:05")
+}
+
+// findAffectedMembers returns the members with confirmed assignments on the business days
+func (uc *BulkUpdateBusinessDaysUsecase) findAffectedMembers(ctx context.Context, tenantID common.TenantID, businessDays []*event.EventBusinessDay) ([]AffectedMember, error) {
+	var affected []AffectedMember
+	slotNames := make(map[shift.SlotID]string)
+
+	for _, bd := range businessDays {
+		assignments, err := uc.assignmentRepo.FindByBusinessDayID(ctx, tenantID, bd.BusinessDayID())
+		if err != nil {
+			return nil, err
+		}
+		if len(assignments) == 0 {
+			continue
+		}
+
+		slots, err := uc.slotRepo.FindByBusinessDayID(ctx, tenantID, bd.BusinessDayID())
+		if err != nil {
+			return nil, err
+		}
+		for _, slot := range slots {
+			slotNames[slot.SlotID()] = slot.SlotName()
+		}
+
+		for _, a := range assignments {
+			if !a.IsConfirmed() || a.IsDeleted() {
+				continue
+			}
+			affected = append(affected, AffectedMember{
+				MemberID:      a.MemberID(),
+				BusinessDayID: bd.BusinessDayID(),
+				SlotID:        a.SlotID(),
+				SlotName:      slotNames[a.SlotID()],
+			})
+		}
+	}
+	if len(affected) == 0 {
+		return nil, nil
+	}
+
+	// 表示名はメンバー一覧から一括で解決する（N+1 を避ける）
+	members, err := uc.memberRepo.FindByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[common.MemberID]string, len(members))
+	for _, m := range members {
+		names[m.MemberID()] = m.DisplayName()
+	}
+	for i := range affected {
+		affected[i].DisplayName = names[affected[i].MemberID]
+	}
+
+	return affected, nil
+}
@@ -0,0 +1,642 @@
+package event_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	appevent "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// =====================================================
+// MockShiftAssignmentRepository / MockMemberRepository
+// =====================================================
+
+// MockShiftAssignmentRepository is a mock implementation of shift.ShiftAssignmentRepository
+type MockShiftAssignmentRepository struct {
+	findByBusinessDayIDFunc    func(ctx context.Context, tenantID common.TenantID, businessDayID event.BusinessDayID) ([]*shift.ShiftAssignment, error)
+	countConfirmedBySlotIDFunc func(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) (int, error)
+}
+
+func (m *MockShiftAssignmentRepository) Save(ctx context.Context, assignment *shift.ShiftAssignment) error {
+	return nil
+}
+
+func (m *MockShiftAssignmentRepository) FindByID(ctx context.Context, tenantID common.TenantID, assignmentID shift.AssignmentID) (*shift.ShiftAssignment, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockShiftAssignmentRepository) SetLive(ctx context.Context, tenantID common.TenantID, assignmentID shift.AssignmentID, live bool) error {
+	return nil
+}
+
+func (m *MockShiftAssignmentRepository) FindBySlotID(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) ([]*shift.ShiftAssignment, error) {
+	return nil, nil
+}
+
+func (m *MockShiftAssignmentRepository) FindConfirmedBySlotID(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) ([]*shift.ShiftAssignment, error) {
+	return nil, nil
+}
+
+func (m *MockShiftAssignmentRepository) FindByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*shift.ShiftAssignment, error) {
+	return nil, nil
+}
+
+func (m *MockShiftAssignmentRepository) FindConfirmedByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*shift.ShiftAssignment, error) {
+	return nil, nil
+}
+
+func (m *MockShiftAssignmentRepository) FindByPlanID(ctx context.Context, tenantID common.TenantID, planID shift.PlanID) ([]*shift.ShiftAssignment, error) {
+	return nil, nil
+}
+
+func (m *MockShiftAssignmentRepository) CountConfirmedBySlotID(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) (int, error) {
+	if m.countConfirmedBySlotIDFunc != nil {
+		return m.countConfirmedBySlotIDFunc(ctx, tenantID, slotID)
+	}
+	return 0, nil
+}
+
+func (m *MockShiftAssignmentRepository) Delete(ctx context.Context, tenantID common.TenantID, assignmentID shift.AssignmentID) error {
+	return nil
+}
+
+func (m *MockShiftAssignmentRepository) ExistsBySlotIDAndMemberID(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID, memberID common.MemberID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockShiftAssignmentRepository) HasConfirmedByMemberAndBusinessDayID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID, businessDayID event.BusinessDayID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockShiftAssignmentRepository) FindByBusinessDayID(ctx context.Context, tenantID common.TenantID, businessDayID event.BusinessDayID) ([]*shift.ShiftAssignment, error) {
+	if m.findByBusinessDayIDFunc != nil {
+		return m.findByBusinessDayIDFunc(ctx, tenantID, businessDayID)
+	}
+	return nil, nil
+}
+
+func (m *MockShiftAssignmentRepository) FindConfirmedShiftsByDateRange(ctx context.Context, tenantID common.TenantID, memberID *common.MemberID, from, to time.Time) ([]shift.AssignedShift, error) {
+	return nil, nil
+}
+
+func (m *MockShiftAssignmentRepository) CountConfirmedByMemberIDsAndDateRange(ctx context.Context, tenantID common.TenantID, memberIDs []common.MemberID, from, to time.Time) (map[common.MemberID]int, error) {
+	return nil, nil
+}
+
+// MockMemberRepository is a mock implementation of member.MemberRepository
+type MockMemberRepository struct {
+	members []*member.Member
+}
+
+func (m *MockMemberRepository) Save(ctx context.Context, mem *member.Member) error {
+	return nil
+}
+
+func (m *MockMemberRepository) FindByID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) (*member.Member, error) {
+	for _, mem := range m.members {
+		if mem.MemberID() == memberID {
+			return mem, nil
+		}
+	}
+	return nil, common.NewNotFoundError("Member", memberID.String())
+}
+
+func (m *MockMemberRepository) FindByTenantID(ctx context.Context, tenantID common.TenantID) ([]*member.Member, error) {
+	return m.members, nil
+}
+
+func (m *MockMemberRepository) FindActiveByTenantID(ctx context.Context, tenantID common.TenantID) ([]*member.Member, error) {
+	return m.members, nil
+}
+
+func (m *MockMemberRepository) FindByDiscordUserID(ctx context.Context, tenantID common.TenantID, discordUserID string) (*member.Member, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockMemberRepository) FindByEmail(ctx context.Context, tenantID common.TenantID, email string) (*member.Member, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockMemberRepository) Delete(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) error {
+	return nil
+}
+
+func (m *MockMemberRepository) ExistsByDiscordUserID(ctx context.Context, tenantID common.TenantID, discordUserID string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockMemberRepository) ExistsByEmail(ctx context.Context, tenantID common.TenantID, email string) (bool, error) {
+	return false, nil
+}
+
+// =====================================================
+// BulkUpdateBusinessDaysUsecase Tests
+// =====================================================
+
+func createBusinessDay(t *testing.T, tenantID common.TenantID, eventID common.EventID, targetDate string) *event.EventBusinessDay {
+	t.Helper()
+	date, err := time.Parse("2006-01-02", targetDate)
+	if err != nil {
+		t.Fatalf("Failed to parse date: %v", err)
+	}
+	bd, err := event.NewEventBusinessDay(time.Now(), tenantID, eventID, date,
+		time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+		event.OccurrenceTypeSpecial, nil)
+	if err != nil {
+		t.Fatalf("Failed to create business day: %v", err)
+	}
+	return bd
+}
+
+// newBulkUpdateTestUsecase builds the usecase over the given business days (保存内容は saved に記録する)
+func newBulkUpdateTestUsecase(
+	t *testing.T,
+	testEvent *event.Event,
+	businessDays []*event.EventBusinessDay,
+	slotRepo *MockShiftSlotRepository,
+	assignmentRepo *MockShiftAssignmentRepository,
+	memberRepo *MockMemberRepository,
+	saved *[]*event.EventBusinessDay,
+) *appevent.BulkUpdateBusinessDaysUsecase {
+	t.Helper()
+	// 保存済みの日時（Reschedule でエンティティが書き換わっても DB 上の値として扱う）
+	persisted := make(map[string]bool, len(businessDays))
+	for _, bd := range businessDays {
+		persisted[bd.TargetDate().Format("2006-01-02")+" "+bd.StartTime().Format("15:04")] = true
+	}
+	eventRepo := &MockEventRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) (*event.Event, error) {
+			return testEvent, nil
+		},
+	}
+	bdRepo := &MockBusinessDayRepository{
+		saveFunc: func(ctx context.Context, bd *event.EventBusinessDay) error {
+			*saved = append(*saved, bd)
+			return nil
+		},
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			for _, bd := range businessDays {
+				if bd.BusinessDayID() == id {
+					return bd, nil
+				}
+			}
+			return nil, common.NewNotFoundError("BusinessDay", id.String())
+		},
+		findByEventIDAndDateRangeFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID, startDate, endDate time.Time) ([]*event.EventBusinessDay, error) {
+			var result []*event.EventBusinessDay
+			for _, bd := range businessDays {
+				if !bd.TargetDate().Before(startDate) && !bd.TargetDate().After(endDate) {
+					result = append(result, bd)
+				}
+			}
+			return result, nil
+		},
+		existsByEventIDAndDate: func(ctx context.Context, tid common.TenantID, eid common.EventID, date time.Time, startTime time.Time) (bool, error) {
+			return persisted[date.Format("2006-01-02")+" "+startTime.Format("15:04")], nil
+		},
+	}
+	return appevent.NewBulkUpdateBusinessDaysUsecase(bdRepo, eventRepo, slotRepo, assignmentRepo, memberRepo,
+		&MockTxManager{}, &MockClock{})
+}
+
+func TestBulkUpdateBusinessDaysUsecase_Execute_ShiftTimeMovesSlotsAndReportsMembers(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testEvent := createSaturdayEvent(t, tenantID)
+	bd := createBusinessDay(t, tenantID, testEvent.EventID(), "2025-02-01")
+
+	slot, err := shift.NewShiftSlot(time.Now(), tenantID, bd.BusinessDayID(), nil, "受付", "",
+		time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC), 1, 1)
+	if err != nil {
+		t.Fatalf("Failed to create slot: %v", err)
+	}
+	var savedSlots []*shift.ShiftSlot
+	slotRepo := &MockShiftSlotRepository{
+		saveFunc: func(ctx context.Context, s *shift.ShiftSlot) error {
+			savedSlots = append(savedSlots, s)
+			return nil
+		},
+		findByBusinessDayIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+			return []*shift.ShiftSlot{slot}, nil
+		},
+	}
+
+	m, err := member.NewMember(time.Now(), tenantID, "テストメンバー", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create member: %v", err)
+	}
+	assignment, err := shift.NewShiftAssignment(time.Now(), tenantID, shift.NewPlanID(), slot.SlotID(), m.MemberID(), shift.AssignmentMethodManual, false)
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		findByBusinessDayIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftAssignment, error) {
+			return []*shift.ShiftAssignment{assignment}, nil
+		},
+	}
+
+	var saved []*event.EventBusinessDay
+	usecase := newBulkUpdateTestUsecase(t, testEvent, []*event.EventBusinessDay{bd}, slotRepo, assignmentRepo,
+		&MockMemberRepository{members: []*member.Member{m}}, &saved)
+
+	result, err := usecase.Execute(context.Background(), appevent.BulkUpdateBusinessDaysInput{
+		TenantID:       tenantID,
+		EventID:        testEvent.EventID(),
+		BusinessDayIDs: []event.BusinessDayID{bd.BusinessDayID()},
+		Operation:      appevent.BulkOperationShiftTime,
+		StartShift:     30 * time.Minute,
+		EndShift:       time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if got := bd.StartTime().Format("15:04") + "-" + bd.EndTime().Format("15:04"); got != "21:30-00:00" {
+		t.Errorf("business day time = %s, want 21:30-00:00", got)
+	}
+	if got := slot.StartTimeString() + "-" + slot.EndTimeString(); got != "21:30-22:30" {
+		t.Errorf("slot time = %s, want 21:30-22:30", got)
+	}
+	if len(saved) != 1 || len(savedSlots) != 1 {
+		t.Errorf("saved %d business days and %d slots, want 1 and 1", len(saved), len(savedSlots))
+	}
+	if len(result.BusinessDays) != 1 || result.BusinessDays[0].SlotCount != 1 ||
+		result.BusinessDays[0].PreviousStartTime.Format("15:04") != "21:00" {
+		t.Errorf("unexpected business days in result: %+v", result.BusinessDays)
+	}
+	if len(result.AffectedMembers) != 1 || result.AffectedMembers[0].DisplayName != "テストメンバー" ||
+		result.AffectedMembers[0].SlotName != "受付" {
+		t.Errorf("unexpected affected members: %+v", result.AffectedMembers)
+	}
+}
+
+func TestBulkUpdateBusinessDaysUsecase_Execute_ShiftTimeErrorWhenSlotMovesBeforeTargetDate(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testEvent := createSaturdayEvent(t, tenantID)
+	bd := createBusinessDay(t, tenantID, testEvent.EventID(), "2025-02-01")
+
+	// 対象日の 01:00 に始まる枠は 2 時間前倒しすると前日になる
+	slot, err := shift.NewShiftSlot(time.Now(), tenantID, bd.BusinessDayID(), nil, "準備", "",
+		time.Date(2000, 1, 1, 1, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 2, 0, 0, 0, time.UTC), 1, 1)
+	if err != nil {
+		t.Fatalf("Failed to create slot: %v", err)
+	}
+	slotRepo := &MockShiftSlotRepository{
+		saveFunc: func(ctx context.Context, s *shift.ShiftSlot) error {
+			t.Error("slot should not be saved")
+			return nil
+		},
+		findByBusinessDayIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+			return []*shift.ShiftSlot{slot}, nil
+		},
+	}
+
+	var saved []*event.EventBusinessDay
+	usecase := newBulkUpdateTestUsecase(t, testEvent, []*event.EventBusinessDay{bd}, slotRepo,
+		&MockShiftAssignmentRepository{}, &MockMemberRepository{}, &saved)
+
+	_, err = usecase.Execute(context.Background(), appevent.BulkUpdateBusinessDaysInput{
+		TenantID:       tenantID,
+		EventID:        testEvent.EventID(),
+		BusinessDayIDs: []event.BusinessDayID{bd.BusinessDayID()},
+		Operation:      appevent.BulkOperationShiftTime,
+		StartShift:     -2 * time.Hour,
+	})
+	var domainErr *common.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code() != common.ErrInvalidInput {
+		t.Errorf("Execute() should return a validation error, got %v", err)
+	}
+	if len(saved) != 0 {
+		t.Errorf("saved %d business days, want 0", len(saved))
+	}
+	if slot.StartTimeString() != "01:00" || slot.DayOffset() != 0 {
+		t.Errorf("slot should not move: start = %s, day_offset = %d", slot.StartTimeString(), slot.DayOffset())
+	}
+}
+
+func TestBulkUpdateBusinessDaysUsecase_Execute_EndShiftAdjustsSlotsEndingWithBusinessDay(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testEvent := createSaturdayEvent(t, tenantID)
+	bd := createBusinessDay(t, tenantID, testEvent.EventID(), "2025-02-01")
+
+	at := func(h int) time.Time { return time.Date(2000, 1, 1, h, 0, 0, 0, time.UTC) }
+	opening, err := shift.NewShiftSlot(time.Now(), tenantID, bd.BusinessDayID(), nil, "受付", "", at(21), at(22), 1, 1)
+	if err != nil {
+		t.Fatalf("Failed to create slot: %v", err)
+	}
+	closing, err := shift.NewShiftSlot(time.Now(), tenantID, bd.BusinessDayID(), nil, "片付け", "", at(22), at(23), 1, 1)
+	if err != nil {
+		t.Fatalf("Failed to create slot: %v", err)
+	}
+	var savedSlots []*shift.ShiftSlot
+	slotRepo := &MockShiftSlotRepository{
+		saveFunc: func(ctx context.Context, s *shift.ShiftSlot) error {
+			savedSlots = append(savedSlots, s)
+			return nil
+		},
+		findByBusinessDayIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+			return []*shift.ShiftSlot{opening, closing}, nil
+		},
+	}
+
+	var saved []*event.EventBusinessDay
+	usecase := newBulkUpdateTestUsecase(t, testEvent, []*event.EventBusinessDay{bd}, slotRepo,
+		&MockShiftAssignmentRepository{}, &MockMemberRepository{}, &saved)
+
+	result, err := usecase.Execute(context.Background(), appevent.BulkUpdateBusinessDaysInput{
+		TenantID:       tenantID,
+		EventID:        testEvent.EventID(),
+		BusinessDayIDs: []event.BusinessDayID{bd.BusinessDayID()},
+		Operation:      appevent.BulkOperationShiftTime,
+		EndShift:       time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	// 営業日の終了まで続く枠だけ終了時刻を延長する
+	if got := opening.StartTimeString() + "-" + opening.EndTimeString(); got != "21:00-22:00" {
+		t.Errorf("opening slot time = %s, want 21:00-22:00", got)
+	}
+	if got := closing.StartTimeString() + "-" + closing.EndTimeString(); got != "22:00-00:00" {
+		t.Errorf("closing slot time = %s, want 22:00-00:00", got)
+	}
+	if len(savedSlots) != 1 || savedSlots[0] != closing || result.BusinessDays[0].SlotCount != 1 {
+		t.Errorf("only the closing slot should be saved, saved %d (SlotCount %d)", len(savedSlots), result.BusinessDays[0].SlotCount)
+	}
+}
+
+func TestBulkUpdateBusinessDaysUsecase_Execute_ShiftTimeErrorWhenSlotFallsOutsideBusinessDay(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testEvent := createSaturdayEvent(t, tenantID)
+	bd := createBusinessDay(t, tenantID, testEvent.EventID(), "2025-02-01")
+
+	// 21:00-23:00 の営業日を 22:00 終了に短縮すると 22:30 まで続く枠がはみ出す
+	slot, err := shift.NewShiftSlot(time.Now(), tenantID, bd.BusinessDayID(), nil, "受付", "",
+		time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 22, 30, 0, 0, time.UTC), 1, 1)
+	if err != nil {
+		t.Fatalf("Failed to create slot: %v", err)
+	}
+	slotRepo := &MockShiftSlotRepository{
+		saveFunc: func(ctx context.Context, s *shift.ShiftSlot) error {
+			t.Error("slot should not be saved")
+			return nil
+		},
+		findByBusinessDayIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+			return []*shift.ShiftSlot{slot}, nil
+		},
+	}
+
+	var saved []*event.EventBusinessDay
+	usecase := newBulkUpdateTestUsecase(t, testEvent, []*event.EventBusinessDay{bd}, slotRepo,
+		&MockShiftAssignmentRepository{}, &MockMemberRepository{}, &saved)
+
+	_, err = usecase.Execute(context.Background(), appevent.BulkUpdateBusinessDaysInput{
+		TenantID:       tenantID,
+		EventID:        testEvent.EventID(),
+		BusinessDayIDs: []event.BusinessDayID{bd.BusinessDayID()},
+		Operation:      appevent.BulkOperationShiftTime,
+		EndShift:       -time.Hour,
+	})
+	var domainErr *common.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code() != common.ErrInvalidInput {
+		t.Errorf("Execute() should return a validation error, got %v", err)
+	}
+	if len(saved) != 0 {
+		t.Errorf("saved %d business days, want 0", len(saved))
+	}
+}
+
+func TestBulkUpdateBusinessDaysUsecase_Execute_ChangedRecurringDayIsNotRegenerated(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input appevent.BulkUpdateBusinessDaysInput
+	}{
+		{"move", appevent.BulkUpdateBusinessDaysInput{Operation: appevent.BulkOperationMove, MoveDays: 1}},
+		{"shift_time", appevent.BulkUpdateBusinessDaysInput{Operation: appevent.BulkOperationShiftTime, StartShift: time.Hour, EndShift: time.Hour}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tenantID := common.NewTenantID()
+			// 2025-03-01 03:00 UTC は東京では 3/1（土）12:00
+			now := time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)
+			testEvent := createSaturdayEvent(t, tenantID)
+
+			// 定期設定から生成された 3/1 と 3/8 の営業日
+			var businessDays []*event.EventBusinessDay
+			for _, date := range []time.Time{time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC)} {
+				bd, err := event.NewEventBusinessDay(now, tenantID, testEvent.EventID(), date,
+					*testEvent.DefaultStartTime(), *testEvent.DefaultEndTime(), event.OccurrenceTypeRecurring, nil)
+				if err != nil {
+					t.Fatalf("Failed to create business day: %v", err)
+				}
+				businessDays = append(businessDays, bd)
+			}
+
+			slotRepo := &MockShiftSlotRepository{
+				findByBusinessDayIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+					return nil, nil
+				},
+			}
+			var saved []*event.EventBusinessDay
+			bulk := newBulkUpdateTestUsecase(t, testEvent, businessDays, slotRepo,
+				&MockShiftAssignmentRepository{}, &MockMemberRepository{}, &saved)
+			input := tc.input
+			input.TenantID = tenantID
+			input.EventID = testEvent.EventID()
+			input.BusinessDayIDs = []event.BusinessDayID{businessDays[0].BusinessDayID()}
+			if _, err := bulk.Execute(context.Background(), input); err != nil {
+				t.Fatalf("Execute() should succeed, got error: %v", err)
+			}
+
+			bdRepo := &MockBusinessDayRepository{
+				saveFunc: func(ctx context.Context, bd *event.EventBusinessDay) error {
+					t.Errorf("business day on %s should not be generated", bd.TargetDate().Format("2006-01-02"))
+					return nil
+				},
+				existsByEventIDAndDate: func(ctx context.Context, tid common.TenantID, eid common.EventID, date time.Time, startTime time.Time) (bool, error) {
+					for _, bd := range businessDays {
+						if bd.TargetDate().Equal(date) && bd.StartTime().Equal(startTime) {
+							return true, nil
+						}
+					}
+					return false, nil
+				},
+				existsByOccurrenceDateFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID, occurrenceDate time.Time) (bool, error) {
+					for _, bd := range businessDays {
+						if bd.OccurrenceDate() != nil && bd.OccurrenceDate().Equal(occurrenceDate) {
+							return true, nil
+						}
+					}
+					return false, nil
+				},
+			}
+			eventRepo := &MockEventRepository{
+				findActiveByTenantFunc: func(ctx context.Context, tid common.TenantID) ([]*event.Event, error) {
+					return []*event.Event{testEvent}, nil
+				},
+			}
+			generator := appevent.NewGenerateUpcomingBusinessDaysUsecase(eventRepo, bdRepo, &MockTenantRepository{}, &MockBlackoutDateSetRepository{},
+				&MockShiftSlotTemplateRepository{}, &MockShiftSlotRepository{}, &MockInstanceRepository{}, &MockTxManager{})
+
+			result, err := generator.Execute(context.Background(), appevent.GenerateUpcomingBusinessDaysInput{
+				TenantID: tenantID,
+				Now:      now,
+				Weeks:    1,
+			})
+			if err != nil {
+				t.Fatalf("Execute() should succeed, got error: %v", err)
+			}
+			if result.GeneratedCount != 0 {
+				t.Errorf("GeneratedCount = %d, want 0", result.GeneratedCount)
+			}
+		})
+	}
+}
+
+func TestBulkUpdateBusinessDaysUsecase_Execute_MovesConsecutiveDays(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testEvent := createSaturdayEvent(t, tenantID)
+	businessDays := []*event.EventBusinessDay{
+		createBusinessDay(t, tenantID, testEvent.EventID(), "2025-02-01"),
+		createBusinessDay(t, tenantID, testEvent.EventID(), "2025-02-02"),
+		createBusinessDay(t, tenantID, testEvent.EventID(), "2025-02-03"),
+	}
+
+	var saved []*event.EventBusinessDay
+	usecase := newBulkUpdateTestUsecase(t, testEvent, businessDays, &MockShiftSlotRepository{},
+		&MockShiftAssignmentRepository{}, &MockMemberRepository{}, &saved)
+
+	startDate := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC)
+	_, err := usecase.Execute(context.Background(), appevent.BulkUpdateBusinessDaysInput{
+		TenantID:  tenantID,
+		EventID:   testEvent.EventID(),
+		StartDate: &startDate,
+		EndDate:   &endDate,
+		Operation: appevent.BulkOperationMove,
+		MoveDays:  1,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	// 隣接する日への移動でも重複エラーにならないよう、遅い日から順に保存される
+	want := []string{"2025-02-04", "2025-02-03", "2025-02-02"}
+	if len(saved) != len(want) {
+		t.Fatalf("saved %d business days, want %d", len(saved), len(want))
+	}
+	for i, w := range want {
+		if got := saved[i].TargetDate().Format("2006-01-02"); got != w {
+			t.Errorf("saved[%d] = %s, want %s", i, got, w)
+		}
+	}
+}
+
+func TestBulkUpdateBusinessDaysUsecase_Execute_ConflictWithOtherBusinessDay(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testEvent := createSaturdayEvent(t, tenantID)
+	target := createBusinessDay(t, tenantID, testEvent.EventID(), "2025-02-01")
+	other := createBusinessDay(t, tenantID, testEvent.EventID(), "2025-02-08")
+
+	var saved []*event.EventBusinessDay
+	usecase := newBulkUpdateTestUsecase(t, testEvent, []*event.EventBusinessDay{target, other}, &MockShiftSlotRepository{},
+		&MockShiftAssignmentRepository{}, &MockMemberRepository{}, &saved)
+
+	_, err := usecase.Execute(context.Background(), appevent.BulkUpdateBusinessDaysInput{
+		TenantID:       tenantID,
+		EventID:        testEvent.EventID(),
+		BusinessDayIDs: []event.BusinessDayID{target.BusinessDayID()},
+		Operation:      appevent.BulkOperationMove,
+		MoveDays:       7,
+	})
+	var domainErr *common.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code() != common.ErrConflict {
+		t.Errorf("Execute() should return Conflict, got %v", err)
+	}
+	if len(saved) != 0 {
+		t.Errorf("saved %d business days, want 0", len(saved))
+	}
+}
+
+func TestBulkUpdateBusinessDaysUsecase_Execute_DryRunDoesNotSave(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testEvent := createSaturdayEvent(t, tenantID)
+	bd := createBusinessDay(t, tenantID, testEvent.EventID(), "2025-02-01")
+
+	var saved []*event.EventBusinessDay
+	usecase := newBulkUpdateTestUsecase(t, testEvent, []*event.EventBusinessDay{bd}, &MockShiftSlotRepository{},
+		&MockShiftAssignmentRepository{}, &MockMemberRepository{}, &saved)
+
+	result, err := usecase.Execute(context.Background(), appevent.BulkUpdateBusinessDaysInput{
+		TenantID:       tenantID,
+		EventID:        testEvent.EventID(),
+		BusinessDayIDs: []event.BusinessDayID{bd.BusinessDayID()},
+		Operation:      appevent.BulkOperationCancel,
+		CancelReason:   "機材トラブル",
+		DryRun:         true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+	if len(saved) != 0 {
+		t.Errorf("saved %d business days on dry run, want 0", len(saved))
+	}
+	if len(result.BusinessDays) != 1 || !result.BusinessDays[0].BusinessDay.IsCancelled() ||
+		result.BusinessDays[0].BusinessDay.Cancellation().Reason != "機材トラブル" {
+		t.Errorf("dry run should report the cancelled business day, got %+v", result.BusinessDays)
+	}
+}
+
+func TestBulkUpdateBusinessDaysUsecase_Execute_ErrorWhenInvalidInput(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testEvent := createSaturdayEvent(t, tenantID)
+	bd := createBusinessDay(t, tenantID, testEvent.EventID(), "2025-02-01")
+	startDate := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name  string
+		input appevent.BulkUpdateBusinessDaysInput
+	}{
+		{"no target", appevent.BulkUpdateBusinessDaysInput{Operation: appevent.BulkOperationCancel}},
+		{"both selection and range", appevent.BulkUpdateBusinessDaysInput{
+			BusinessDayIDs: []event.BusinessDayID{bd.BusinessDayID()}, StartDate: &startDate, EndDate: &endDate,
+			Operation: appevent.BulkOperationCancel,
+		}},
+		{"start date only", appevent.BulkUpdateBusinessDaysInput{StartDate: &startDate, Operation: appevent.BulkOperationCancel}},
+		{"unknown operation", appevent.BulkUpdateBusinessDaysInput{
+			BusinessDayIDs: []event.BusinessDayID{bd.BusinessDayID()}, Operation: "delete",
+		}},
+		{"shift without delta", appevent.BulkUpdateBusinessDaysInput{
+			BusinessDayIDs: []event.BusinessDayID{bd.BusinessDayID()}, Operation: appevent.BulkOperationShiftTime,
+		}},
+		{"move too far", appevent.BulkUpdateBusinessDaysInput{
+			BusinessDayIDs: []event.BusinessDayID{bd.BusinessDayID()}, Operation: appevent.BulkOperationMove,
+			MoveDays: appevent.MaxBulkMoveDays + 1,
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var saved []*event.EventBusinessDay
+			usecase := newBulkUpdateTestUsecase(t, testEvent, []*event.EventBusinessDay{bd}, &MockShiftSlotRepository{},
+				&MockShiftAssignmentRepository{}, &MockMemberRepository{}, &saved)
+
+			tc.input.TenantID = tenantID
+			tc.input.EventID = testEvent.EventID()
+			_, err := usecase.Execute(context.Background(), tc.input)
+			var domainErr *common.DomainError
+			if !errors.As(err, &domainErr) || domainErr.Code() != common.ErrInvalidInput {
+				t.Errorf("Execute() should return validation error, got %v", err)
+			}
+		})
+	}
+}
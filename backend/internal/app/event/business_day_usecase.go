@@ -17,6 +17,7 @@ type CreateBusinessDayInput struct {
 	TargetDate     time.Time
 	StartTime      time.Time
 	EndTime        time.Time
+	EndDate        *time.Time // optional - 複数日営業の最終日（未指定の場合は開始・終了時刻から判定）
 	OccurrenceType event.OccurrenceType
 	TemplateID     *common.ShiftSlotTemplateID // optional
 }
@@ -52,11 +53,14 @@ func NewCreateBusinessDayUsecase(
 
 // Execute creates a new business day
 func (uc *CreateBusinessDayUsecase) Execute(ctx context.Context, input CreateBusinessDayInput) (*event.EventBusinessDay, error) {
-	// イベントの存在確認
-	_, err := uc.eventRepo.FindByID(ctx, input.TenantID, input.EventID)
+	// イベントの存在確認（アーカイブ済みのイベントには営業日を追加しない）
+	e, err := uc.eventRepo.FindByID(ctx, input.TenantID, input.EventID)
 	if err != nil {
 		return nil, err
 	}
+	if err := e.EnsureNotArchived(); err != nil {
+		return nil, err
+	}
 
 	// 重複チェック
 	exists, err := uc.businessDayRepo.ExistsByEventIDAndDate(ctx, input.TenantID, input.EventID, input.TargetDate, input.StartTime)
@@ -81,6 +85,11 @@ func (uc *CreateBusinessDayUsecase) Execute(ctx context.Context, input CreateBus
 	if err != nil {
 		return nil, err
 	}
+	if input.EndDate != nil {
+		if err := newBusinessDay.SetEndDate(newBusinessDay.CreatedAt(), *input.EndDate); err != nil {
+			return nil, err
+		}
+	}
 
 	// テンプレートが指定されている場合、営業日の保存とシフト枠作成をトランザクションで実行
 	if input.TemplateID != nil {
@@ -88,6 +97,10 @@ func (uc *CreateBusinessDayUsecase) Execute(ctx context.Context, input CreateBus
 		if err != nil {
 			return nil, err
 		}
+		// 生成元のテンプレートとバージョンを記録（テンプレート更新時の反映に使用）
+		if err := newBusinessDay.RecordAppliedTemplate(newBusinessDay.CreatedAt(), template.TemplateID(), template.Version()); err != nil {
+			return nil, err
+		}
 
 		err = uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
 			if err := uc.businessDayRepo.Save(txCtx, newBusinessDay); err != nil {
@@ -154,6 +167,7 @@ func (uc *CreateBusinessDayUsecase) createShiftSlotsFromTemplate(ctx context.Con
 		}
 
 		// テンプレートの時刻を営業日の日付と組み合わせてDateTimeを作成
+		// 枠の時刻はテナントのタイムゾーンの壁時計として保持するため、サーバーのローカル時刻（DST の影響を受ける）は使わない
 		startDateTime := time.Date(
 			businessDay.TargetDate().Year(),
 			businessDay.TargetDate().Month(),
@@ -162,7 +176,7 @@ func (uc *CreateBusinessDayUsecase) createShiftSlotsFromTemplate(ctx context.Con
 			item.StartTime().Minute(),
 			item.StartTime().Second(),
 			0,
-			time.Local,
+			time.UTC,
 		)
 
 		endDateTime := time.Date(
@@ -173,7 +187,7 @@ func (uc *CreateBusinessDayUsecase) createShiftSlotsFromTemplate(ctx context.Con
 			item.EndTime().Minute(),
 			item.EndTime().Second(),
 			0,
-			time.Local,
+			time.UTC,
 		)
 
 		// シフト枠を作成
@@ -200,6 +214,15 @@ func (uc *CreateBusinessDayUsecase) createShiftSlotsFromTemplate(ctx context.Con
 			return err
 		}
 
+		// 深夜営業の場合は日付変更後の枠を翌日に配置する
+		shiftSlot.AlignToBusinessDay(shiftSlot.CreatedAt(), businessDay)
+
+		// テンプレートのロール要件をそのまま引き継ぐ
+		if err := shiftSlot.SetRoleRequirements(shiftSlot.CreatedAt(), item.RoleRequirements()); err != nil {
+			return err
+		}
+		shiftSlot.MarkFromTemplate(shiftSlot.CreatedAt(), template.TemplateID())
+
 		// シフト枠を保存
 		if err := uc.slotRepo.Save(ctx, shiftSlot); err != nil {
 			return err
@@ -307,6 +330,73 @@ func (u *DeleteBusinessDayUsecase) Execute(ctx context.Context, input DeleteBusi
 	return u.businessDayRepo.Save(ctx, bd)
 }
 
+// CancelBusinessDayInput defines the input for cancelling a business day
+type CancelBusinessDayInput struct {
+	TenantID      common.TenantID
+	BusinessDayID event.BusinessDayID
+	Reason        string
+	CancelledBy   *common.AdminID
+}
+
+// CancelBusinessDayUsecase handles business day cancellation
+// 削除と異なり、シフト枠・割り当ては監査のために残す
+type CancelBusinessDayUsecase struct {
+	businessDayRepo event.EventBusinessDayRepository
+	clock           services.Clock
+}
+
+// NewCancelBusinessDayUsecase creates a new CancelBusinessDayUsecase
+func NewCancelBusinessDayUsecase(repo event.EventBusinessDayRepository, clock services.Clock) *CancelBusinessDayUsecase {
+	return &CancelBusinessDayUsecase{businessDayRepo: repo, clock: clock}
+}
+
+// Execute cancels the business day
+func (u *CancelBusinessDayUsecase) Execute(ctx context.Context, input CancelBusinessDayInput) (*event.EventBusinessDay, error) {
+	bd, err := u.businessDayRepo.FindByID(ctx, input.TenantID, input.BusinessDayID)
+	if err != nil {
+		return nil, err
+	}
+	if err := bd.Cancel(u.clock.Now(), input.Reason, input.CancelledBy); err != nil {
+		return nil, err
+	}
+	if err := u.businessDayRepo.Save(ctx, bd); err != nil {
+		return nil, err
+	}
+	return bd, nil
+}
+
+// UncancelBusinessDayInput defines the input for reverting a business day cancellation
+type UncancelBusinessDayInput struct {
+	TenantID      common.TenantID
+	BusinessDayID event.BusinessDayID
+}
+
+// UncancelBusinessDayUsecase handles reverting a business day cancellation
+type UncancelBusinessDayUsecase struct {
+	businessDayRepo event.EventBusinessDayRepository
+	clock           services.Clock
+}
+
+// NewUncancelBusinessDayUsecase creates a new UncancelBusinessDayUsecase
+func NewUncancelBusinessDayUsecase(repo event.EventBusinessDayRepository, clock services.Clock) *UncancelBusinessDayUsecase {
+	return &UncancelBusinessDayUsecase{businessDayRepo: repo, clock: clock}
+}
+
+// Execute reverts the cancellation of the business day
+func (u *UncancelBusinessDayUsecase) Execute(ctx context.Context, input UncancelBusinessDayInput) (*event.EventBusinessDay, error) {
+	bd, err := u.businessDayRepo.FindByID(ctx, input.TenantID, input.BusinessDayID)
+	if err != nil {
+		return nil, err
+	}
+	if err := bd.Uncancel(u.clock.Now()); err != nil {
+		return nil, err
+	}
+	if err := u.businessDayRepo.Save(ctx, bd); err != nil {
+		return nil, err
+	}
+	return bd, nil
+}
+
 // ApplyTemplateInput represents the input for applying a template to a business day
 type ApplyTemplateInput struct {
 	TenantID      common.TenantID
@@ -354,9 +444,15 @@ func (uc *ApplyTemplateUsecase) Execute(ctx context.Context, input ApplyTemplate
 		return 0, err
 	}
 
-	// テンプレートからシフト枠を作成（トランザクションで実行）
+	// テンプレートからシフト枠を作成し、生成元のテンプレートとバージョンを記録（トランザクションで実行）
 	if err := uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
-		return uc.createShiftSlotsFromTemplate(txCtx, businessDay, template)
+		if err := uc.createShiftSlotsFromTemplate(txCtx, businessDay, template); err != nil {
+			return err
+		}
+		if err := businessDay.RecordAppliedTemplate(time.Now(), template.TemplateID(), template.Version()); err != nil {
+			return err
+		}
+		return uc.businessDayRepo.Save(txCtx, businessDay)
 	}); err != nil {
 		return 0, err
 	}
@@ -372,95 +468,122 @@ func (uc *ApplyTemplateUsecase) createShiftSlotsFromTemplate(ctx context.Context
 
 	// テンプレートの各アイテムからシフト枠を作成
 	for _, item := range template.Items() {
-		// インスタンス名が指定されている場合、Instance エンティティを取得または作成
-		var instance *shift.Instance
-		instanceName := item.InstanceName()
-		if instanceName != "" {
-			if cached, ok := instanceCache[instanceName]; ok {
-				instance = cached
+		if _, err := uc.createShiftSlotFromItem(ctx, businessDay, template, item, instanceCache, &displayOrderCounter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createShiftSlotFromItem creates and saves a shift slot for a single template item
+// テンプレートの再適用（SyncTemplateToBusinessDaysUsecase）で追加された枠の作成にも使用する
+func (uc *ApplyTemplateUsecase) createShiftSlotFromItem(
+	ctx context.Context,
+	businessDay *event.EventBusinessDay,
+	template *shift.ShiftSlotTemplate,
+	item *shift.ShiftSlotTemplateItem,
+	instanceCache map[string]*shift.Instance,
+	displayOrderCounter *int,
+) (*shift.ShiftSlot, error) {
+	// インスタンス名が指定されている場合、Instance エンティティを取得または作成
+	var instance *shift.Instance
+	instanceName := item.InstanceName()
+	if instanceName != "" {
+		if cached, ok := instanceCache[instanceName]; ok {
+			instance = cached
+		} else {
+			// 既存のインスタンスを検索
+			existing, err := uc.instanceRepo.FindByEventIDAndName(ctx, businessDay.TenantID(), template.EventID(), instanceName)
+			if err != nil {
+				return nil, err
+			}
+			if existing != nil {
+				instance = existing
 			} else {
-				// 既存のインスタンスを検索
-				existing, err := uc.instanceRepo.FindByEventIDAndName(ctx, businessDay.TenantID(), template.EventID(), instanceName)
+				// 新規作成
+				newInstance, err := shift.NewInstance(
+					time.Now(),
+					businessDay.TenantID(),
+					template.EventID(),
+					instanceName,
+					*displayOrderCounter,
+					nil,
+				)
 				if err != nil {
-					return err
+					return nil, err
 				}
-				if existing != nil {
-					instance = existing
-				} else {
-					// 新規作成
-					newInstance, err := shift.NewInstance(
-						time.Now(),
-						businessDay.TenantID(),
-						template.EventID(),
-						instanceName,
-						displayOrderCounter,
-						nil,
-					)
-					if err != nil {
-						return err
-					}
-					if err := uc.instanceRepo.Save(ctx, newInstance); err != nil {
-						return err
-					}
-					instance = newInstance
-					displayOrderCounter++
+				if err := uc.instanceRepo.Save(ctx, newInstance); err != nil {
+					return nil, err
 				}
-				instanceCache[instanceName] = instance
+				instance = newInstance
+				*displayOrderCounter++
 			}
+			instanceCache[instanceName] = instance
 		}
+	}
 
-		// テンプレートの時刻を営業日の日付と組み合わせてDateTimeを作成
-		startDateTime := time.Date(
-			businessDay.TargetDate().Year(),
-			businessDay.TargetDate().Month(),
-			businessDay.TargetDate().Day(),
-			item.StartTime().Hour(),
-			item.StartTime().Minute(),
-			item.StartTime().Second(),
-			0,
-			time.Local,
-		)
+	// テンプレートの時刻を営業日の日付と組み合わせてDateTimeを作成
+	// 枠の時刻はテナントのタイムゾーンの壁時計として保持するため、サーバーのローカル時刻（DST の影響を受ける）は使わない
+	startDateTime := time.Date(
+		businessDay.TargetDate().Year(),
+		businessDay.TargetDate().Month(),
+		businessDay.TargetDate().Day(),
+		item.StartTime().Hour(),
+		item.StartTime().Minute(),
+		item.StartTime().Second(),
+		0,
+		time.UTC,
+	)
 
-		endDateTime := time.Date(
-			businessDay.TargetDate().Year(),
-			businessDay.TargetDate().Month(),
-			businessDay.TargetDate().Day(),
-			item.EndTime().Hour(),
-			item.EndTime().Minute(),
-			item.EndTime().Second(),
-			0,
-			time.Local,
-		)
+	endDateTime := time.Date(
+		businessDay.TargetDate().Year(),
+		businessDay.TargetDate().Month(),
+		businessDay.TargetDate().Day(),
+		item.EndTime().Hour(),
+		item.EndTime().Minute(),
+		item.EndTime().Second(),
+		0,
+		time.UTC,
+	)
 
-		// Instance が存在する場合、instanceID を設定
-		var instanceID *shift.InstanceID
-		if instance != nil {
-			id := instance.InstanceID()
-			instanceID = &id
-		}
+	// Instance が存在する場合、instanceID を設定
+	var instanceID *shift.InstanceID
+	if instance != nil {
+		id := instance.InstanceID()
+		instanceID = &id
+	}
 
-		// シフト枠を作成
-		shiftSlot, err := shift.NewShiftSlot(
-			time.Now(),
-			businessDay.TenantID(),
-			businessDay.BusinessDayID(),
-			instanceID,
-			item.SlotName(),
-			item.InstanceName(),
-			startDateTime,
-			endDateTime,
-			item.RequiredCount(),
-			item.Priority(),
-		)
-		if err != nil {
-			return err
-		}
+	// シフト枠を作成
+	shiftSlot, err := shift.NewShiftSlot(
+		time.Now(),
+		businessDay.TenantID(),
+		businessDay.BusinessDayID(),
+		instanceID,
+		item.SlotName(),
+		item.InstanceName(),
+		startDateTime,
+		endDateTime,
+		item.RequiredCount(),
+		item.Priority(),
+	)
+	if err != nil {
+		return nil, err
+	}
 
-		// シフト枠を保存
-		if err := uc.slotRepo.Save(ctx, shiftSlot); err != nil {
-			return err
-		}
+	// 深夜営業の場合は日付変更後の枠を翌日に配置する
+	shiftSlot.AlignToBusinessDay(shiftSlot.CreatedAt(), businessDay)
+
+	// テンプレートのロール要件をそのまま引き継ぐ
+	if err := shiftSlot.SetRoleRequirements(shiftSlot.CreatedAt(), item.RoleRequirements()); err != nil {
+		return nil, err
 	}
+	shiftSlot.MarkFromTemplate(shiftSlot.CreatedAt(), template.TemplateID())
 
-	return nil
+	// シフト枠を保存
+	if err := uc.slotRepo.Save(ctx, shiftSlot); err != nil {
+		return nil, err
+	}
+
+	return shiftSlot, nil
 }
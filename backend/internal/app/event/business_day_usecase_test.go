@@ -93,6 +93,10 @@ func (m *MockShiftSlotRepository) FindByID(ctx context.Context, tenantID common.
 	return nil, errors.New("not implemented")
 }
 
+func (m *MockShiftSlotRepository) FindByIDForUpdate(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+	return m.FindByID(ctx, tenantID, slotID)
+}
+
 func (m *MockShiftSlotRepository) FindByBusinessDayID(ctx context.Context, tenantID common.TenantID, businessDayID event.BusinessDayID) ([]*shift.ShiftSlot, error) {
 	if m.findByBusinessDayIDFunc != nil {
 		return m.findByBusinessDayIDFunc(ctx, tenantID, businessDayID)
@@ -639,6 +643,85 @@ func TestApplyTemplateUsecase_Execute_Success(t *testing.T) {
 	}
 }
 
+func TestApplyTemplateUsecase_Execute_CopiesRoleRequirements(t *testing.T) {
+	tenantID := common.NewTenantID()
+	eventID := common.NewEventID()
+	testBusinessDay := createTestBusinessDay(t, tenantID, eventID)
+
+	bdRepoWithFindByID := &mockBusinessDayRepoWithFindByID{
+		MockBusinessDayRepository: &MockBusinessDayRepository{},
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, bdID event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return testBusinessDay, nil
+		},
+	}
+
+	now := time.Now()
+	tmpl, err := shift.NewShiftSlotTemplate(now, tenantID, eventID, "Test Template", "", []*shift.ShiftSlotTemplateItem{})
+	if err != nil {
+		t.Fatalf("Failed to create template: %v", err)
+	}
+	item, err := shift.NewShiftSlotTemplateItem(
+		now, tmpl.TemplateID(), "受付", "",
+		time.Date(2000, 1, 1, 20, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC),
+		2, 1,
+	)
+	if err != nil {
+		t.Fatalf("Failed to create template item: %v", err)
+	}
+	roleID := common.NewRoleID()
+	req, err := shift.NewRoleRequirement(roleID, shift.RoleRequirementRequired, 1)
+	if err != nil {
+		t.Fatalf("Failed to create role requirement: %v", err)
+	}
+	if err := item.SetRoleRequirements(now, []shift.RoleRequirement{req}); err != nil {
+		t.Fatalf("Failed to set role requirements: %v", err)
+	}
+	if err := tmpl.UpdateDetails(now, "Test Template", "", []*shift.ShiftSlotTemplateItem{item}); err != nil {
+		t.Fatalf("Failed to update template: %v", err)
+	}
+
+	templateRepo := &MockShiftSlotTemplateRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, tmplID common.ShiftSlotTemplateID) (*shift.ShiftSlotTemplate, error) {
+			return tmpl, nil
+		},
+	}
+
+	var saved []*shift.ShiftSlot
+	slotRepo := &MockShiftSlotRepository{
+		saveFunc: func(ctx context.Context, slot *shift.ShiftSlot) error {
+			saved = append(saved, slot)
+			return nil
+		},
+	}
+
+	usecase := appevent.NewApplyTemplateUsecase(bdRepoWithFindByID, templateRepo, slotRepo, &MockInstanceRepository{}, &MockTxManager{})
+	_, err = usecase.Execute(context.Background(), appevent.ApplyTemplateInput{
+		TenantID:      tenantID,
+		BusinessDayID: testBusinessDay.BusinessDayID(),
+		TemplateID:    tmpl.TemplateID(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if len(saved) != 1 {
+		t.Fatalf("Expected 1 slot created, got %d", len(saved))
+	}
+	reqs := saved[0].RoleRequirements()
+	if len(reqs) != 1 || reqs[0].RoleID() != roleID || !reqs[0].IsRequired() {
+		t.Errorf("slot should inherit the template item's role requirements, got %+v", reqs)
+	}
+
+	// 生成元のテンプレートとバージョン（UpdateDetails 済みのため 2）を記録する
+	if saved[0].TemplateID() == nil || *saved[0].TemplateID() != tmpl.TemplateID() {
+		t.Errorf("slot should record the template it was generated from, got %v", saved[0].TemplateID())
+	}
+	if at := testBusinessDay.AppliedTemplate(); at == nil || at.TemplateID != tmpl.TemplateID() || at.Version != 2 {
+		t.Errorf("business day should record the applied template version, got %+v", at)
+	}
+}
+
 func TestApplyTemplateUsecase_Execute_ErrorWhenBusinessDayNotFound(t *testing.T) {
 	tenantID := common.NewTenantID()
 	bdID := event.NewBusinessDayID()
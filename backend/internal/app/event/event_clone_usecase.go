@@ -0,0 +1,295 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
+)
+
+// CloneEventInput represents the input for cloning an event
+type CloneEventInput struct {
+	TenantID            common.TenantID
+	EventID             common.EventID
+	EventName           string // 空の場合は「<複製元のイベント名> (コピー)」
+	IncludeBusinessDays bool   // true の場合は開始前の営業日とそのシフト枠も複製する
+}
+
+// CloneEventOutput represents the output of cloning an event
+type CloneEventOutput struct {
+	Event            *event.Event
+	InstanceCount    int
+	TemplateCount    int
+	BusinessDayCount int
+	ShiftSlotCount   int
+}
+
+// CloneEventUsecase deep-copies the configuration of an event into a new event in the same tenant
+//
+// 複製対象: インスタンス・シフト枠テンプレート・メンバーグループ/ロールグループの割り当て・デフォルトテンプレート
+// IncludeBusinessDays の場合は開始前かつ中止されていない営業日とシフト枠も複製する（割り当ては複製しない）
+// 複製したシフト枠のインスタンス・生成元テンプレートは複製先のものに付け替える
+type CloneEventUsecase struct {
+	eventRepo       event.EventRepository
+	businessDayRepo event.EventBusinessDayRepository
+	instanceRepo    shift.InstanceRepository
+	templateRepo    shift.ShiftSlotTemplateRepository
+	slotRepo        shift.ShiftSlotRepository
+	groupAssignRepo event.EventGroupAssignmentRepository
+	tenantRepo      tenant.TenantRepository
+	txManager       services.TxManager
+	clock           services.Clock
+}
+
+// NewCloneEventUsecase creates a new CloneEventUsecase
+func NewCloneEventUsecase(
+	eventRepo event.EventRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	instanceRepo shift.InstanceRepository,
+	templateRepo shift.ShiftSlotTemplateRepository,
+	slotRepo shift.ShiftSlotRepository,
+	groupAssignRepo event.EventGroupAssignmentRepository,
+	tenantRepo tenant.TenantRepository,
+	txManager services.TxManager,
+	clock services.Clock,
+) *CloneEventUsecase {
+	return &CloneEventUsecase{
+		eventRepo:       eventRepo,
+		businessDayRepo: businessDayRepo,
+		instanceRepo:    instanceRepo,
+		templateRepo:    templateRepo,
+		slotRepo:        slotRepo,
+		groupAssignRepo: groupAssignRepo,
+		tenantRepo:      tenantRepo,
+		txManager:       txManager,
+		clock:           clock,
+	}
+}
+
+// Execute clones the event in a single transaction
+func (uc *CloneEventUsecase) Execute(ctx context.Context, input CloneEventInput) (*CloneEventOutput, error) {
+	source, err := uc.eventRepo.FindByID(ctx, input.TenantID, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	eventName := input.EventName
+	if eventName == "" {
+		eventName = source.EventName() + " (コピー)"
+	}
+
+	// イベント名の重複チェック
+	exists, err := uc.eventRepo.ExistsByName(ctx, input.TenantID, eventName)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, common.NewConflictError("同じ名前のイベントが既に存在します")
+	}
+
+	now := uc.clock.Now()
+	cloned, err := source.CopyAs(now, eventName)
+	if err != nil {
+		return nil, err
+	}
+	output := &CloneEventOutput{Event: cloned}
+
+	err = uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		if err := uc.eventRepo.Save(txCtx, cloned); err != nil {
+			return err
+		}
+
+		instanceIDs, err := uc.cloneInstances(txCtx, source, cloned, now, output)
+		if err != nil {
+			return err
+		}
+
+		templateIDs, err := uc.cloneTemplates(txCtx, source, cloned, now, output)
+		if err != nil {
+			return err
+		}
+
+		// デフォルトテンプレートは複製したテンプレートに付け替える（テンプレートの保存後に設定する）
+		if defaultID := source.DefaultTemplateID(); defaultID != nil {
+			if clonedID, ok := templateIDs[*defaultID]; ok {
+				cloned.SetDefaultTemplate(now, &clonedID)
+				if err := uc.eventRepo.Save(txCtx, cloned); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := uc.cloneGroupAssignments(txCtx, source, cloned); err != nil {
+			return err
+		}
+
+		if input.IncludeBusinessDays {
+			return uc.cloneBusinessDays(txCtx, source, cloned, instanceIDs, templateIDs, now, output)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// cloneInstances copies the instances and returns the mapping from the source instance IDs
+func (uc *CloneEventUsecase) cloneInstances(
+	ctx context.Context,
+	source, cloned *event.Event,
+	now time.Time,
+	output *CloneEventOutput,
+) (map[shift.InstanceID]shift.InstanceID, error) {
+	instances, err := uc.instanceRepo.FindByEventID(ctx, source.TenantID(), source.EventID())
+	if err != nil {
+		return nil, err
+	}
+
+	instanceIDs := make(map[shift.InstanceID]shift.InstanceID, len(instances))
+	for _, instance := range instances {
+		copied, err := shift.NewInstance(now, cloned.TenantID(), cloned.EventID(), instance.Name(), instance.DisplayOrder(), instance.MaxMembers())
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.instanceRepo.Save(ctx, copied); err != nil {
+			return nil, err
+		}
+		instanceIDs[instance.InstanceID()] = copied.InstanceID()
+		output.InstanceCount++
+	}
+
+	return instanceIDs, nil
+}
+
+// cloneTemplates copies the shift slot templates and returns the mapping from the source template IDs
+func (uc *CloneEventUsecase) cloneTemplates(
+	ctx context.Context,
+	source, cloned *event.Event,
+	now time.Time,
+	output *CloneEventOutput,
+) (map[common.ShiftSlotTemplateID]common.ShiftSlotTemplateID, error) {
+	templates, err := uc.templateRepo.FindByEventID(ctx, source.TenantID(), source.EventID())
+	if err != nil {
+		return nil, err
+	}
+
+	templateIDs := make(map[common.ShiftSlotTemplateID]common.ShiftSlotTemplateID, len(templates))
+	for _, template := range templates {
+		copied, err := template.CopyTo(now, cloned.EventID())
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.templateRepo.Save(ctx, copied); err != nil {
+			return nil, err
+		}
+		templateIDs[template.TemplateID()] = copied.TemplateID()
+		output.TemplateCount++
+	}
+
+	return templateIDs, nil
+}
+
+// cloneGroupAssignments copies the member group and role group assignments
+func (uc *CloneEventUsecase) cloneGroupAssignments(ctx context.Context, source, cloned *event.Event) error {
+	groupAssignments, err := uc.groupAssignRepo.FindGroupAssignmentsByEventID(ctx, source.EventID())
+	if err != nil {
+		return err
+	}
+	groupIDs := make([]common.MemberGroupID, 0, len(groupAssignments))
+	for _, a := range groupAssignments {
+		groupIDs = append(groupIDs, a.GroupID())
+	}
+	if err := uc.groupAssignRepo.SaveGroupAssignments(ctx, cloned.EventID(), groupIDs); err != nil {
+		return err
+	}
+
+	roleGroupAssignments, err := uc.groupAssignRepo.FindRoleGroupAssignmentsByEventID(ctx, source.EventID())
+	if err != nil {
+		return err
+	}
+	roleGroupIDs := make([]common.RoleGroupID, 0, len(roleGroupAssignments))
+	for _, a := range roleGroupAssignments {
+		roleGroupIDs = append(roleGroupIDs, a.RoleGroupID())
+	}
+	return uc.groupAssignRepo.SaveRoleGroupAssignments(ctx, cloned.EventID(), roleGroupIDs)
+}
+
+// cloneBusinessDays copies the future business days and their shift slots (assignments are not copied)
+func (uc *CloneEventUsecase) cloneBusinessDays(
+	ctx context.Context,
+	source, cloned *event.Event,
+	instanceIDs map[shift.InstanceID]shift.InstanceID,
+	templateIDs map[common.ShiftSlotTemplateID]common.ShiftSlotTemplateID,
+	now time.Time,
+	output *CloneEventOutput,
+) error {
+	loc, err := tenant.ResolveLocation(ctx, uc.tenantRepo, source.TenantID())
+	if err != nil {
+		return err
+	}
+
+	businessDays, err := uc.businessDayRepo.FindByEventID(ctx, source.TenantID(), source.EventID())
+	if err != nil {
+		return err
+	}
+
+	for _, bd := range businessDays {
+		if bd.IsDeleted() || bd.IsCancelled() || !bd.StartAt(loc).After(now) {
+			continue
+		}
+
+		copied, err := bd.CopyTo(now, cloned.EventID())
+		if err != nil {
+			return err
+		}
+		if applied := bd.AppliedTemplate(); applied != nil {
+			if templateID, ok := templateIDs[applied.TemplateID]; ok {
+				if err := copied.RecordAppliedTemplate(now, templateID, applied.Version); err != nil {
+					return err
+				}
+			}
+		}
+		if err := uc.businessDayRepo.Save(ctx, copied); err != nil {
+			return err
+		}
+		output.BusinessDayCount++
+
+		slots, err := uc.slotRepo.FindByBusinessDayID(ctx, bd.TenantID(), bd.BusinessDayID())
+		if err != nil {
+			return err
+		}
+		for _, slot := range slots {
+			if slot.IsDeleted() {
+				continue
+			}
+
+			var instanceID *shift.InstanceID
+			if slot.InstanceID() != nil {
+				if id, ok := instanceIDs[*slot.InstanceID()]; ok {
+					instanceID = &id
+				}
+			}
+			copiedSlot, err := slot.CopyTo(now, copied.BusinessDayID(), instanceID)
+			if err != nil {
+				return err
+			}
+			if slot.TemplateID() != nil {
+				if templateID, ok := templateIDs[*slot.TemplateID()]; ok {
+					copiedSlot.MarkFromTemplate(now, templateID)
+				}
+			}
+			if err := uc.slotRepo.Save(ctx, copiedSlot); err != nil {
+				return err
+			}
+			output.ShiftSlotCount++
+		}
+	}
+
+	return nil
+}
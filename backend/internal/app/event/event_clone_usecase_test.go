@@ -0,0 +1,334 @@
+package event_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	appevent "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// =====================================================
+// Mock EventGroupAssignmentRepository
+// =====================================================
+
+type MockEventGroupAssignmentRepository struct {
+	groupIDs     map[common.EventID][]common.MemberGroupID
+	roleGroupIDs map[common.EventID][]common.RoleGroupID
+}
+
+func (m *MockEventGroupAssignmentRepository) SaveGroupAssignments(ctx context.Context, eventID common.EventID, groupIDs []common.MemberGroupID) error {
+	m.groupIDs[eventID] = groupIDs
+	return nil
+}
+
+func (m *MockEventGroupAssignmentRepository) FindGroupAssignmentsByEventID(ctx context.Context, eventID common.EventID) ([]*event.EventGroupAssignment, error) {
+	var assignments []*event.EventGroupAssignment
+	for _, id := range m.groupIDs[eventID] {
+		a, err := event.NewEventGroupAssignment(time.Now(), eventID, id)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, a)
+	}
+	return assignments, nil
+}
+
+func (m *MockEventGroupAssignmentRepository) DeleteGroupAssignments(ctx context.Context, eventID common.EventID) error {
+	delete(m.groupIDs, eventID)
+	return nil
+}
+
+func (m *MockEventGroupAssignmentRepository) SaveRoleGroupAssignments(ctx context.Context, eventID common.EventID, roleGroupIDs []common.RoleGroupID) error {
+	m.roleGroupIDs[eventID] = roleGroupIDs
+	return nil
+}
+
+func (m *MockEventGroupAssignmentRepository) FindRoleGroupAssignmentsByEventID(ctx context.Context, eventID common.EventID) ([]*event.EventRoleGroupAssignment, error) {
+	var assignments []*event.EventRoleGroupAssignment
+	for _, id := range m.roleGroupIDs[eventID] {
+		a, err := event.NewEventRoleGroupAssignment(time.Now(), eventID, id)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, a)
+	}
+	return assignments, nil
+}
+
+func (m *MockEventGroupAssignmentRepository) DeleteRoleGroupAssignments(ctx context.Context, eventID common.EventID) error {
+	delete(m.roleGroupIDs, eventID)
+	return nil
+}
+
+// =====================================================
+// CloneEventUsecase Tests
+// =====================================================
+
+func createCloneSourceEvent(t *testing.T) *event.Event {
+	t.Helper()
+	source, err := event.NewEvent(time.Now(), common.NewTenantID(), "夏季営業", event.EventTypeNormal, "Desc", event.RecurrenceTypeNone, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create event: %v", err)
+	}
+	return source
+}
+
+func TestCloneEventUsecase_Execute_CopiesConfigurationAndFutureBusinessDays(t *testing.T) {
+	now := time.Now()
+	source := createCloneSourceEvent(t)
+	tenantID := source.TenantID()
+
+	instance, err := shift.NewInstance(now, tenantID, source.EventID(), "Main", 1, nil)
+	if err != nil {
+		t.Fatalf("Failed to create instance: %v", err)
+	}
+
+	templateID := common.NewShiftSlotTemplateID()
+	item, err := shift.NewShiftSlotTemplateItem(now, templateID, "受付", "Main",
+		time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC), 1, 1)
+	if err != nil {
+		t.Fatalf("Failed to create template item: %v", err)
+	}
+	template, err := shift.ReconstructShiftSlotTemplate(templateID, tenantID, source.EventID(), "定期営業", "",
+		[]*shift.ShiftSlotTemplateItem{item}, 3, now, now, nil)
+	if err != nil {
+		t.Fatalf("Failed to create template: %v", err)
+	}
+	source.SetDefaultTemplate(now, &templateID)
+
+	// 開始前の営業日（テンプレートから生成した枠あり）と開始済みの営業日
+	future := createBusinessDay(t, tenantID, source.EventID(), "2025-02-01")
+	if err := future.RecordAppliedTemplate(now, templateID, 2); err != nil {
+		t.Fatalf("Failed to record applied template: %v", err)
+	}
+	past := createBusinessDay(t, tenantID, source.EventID(), "2025-01-10")
+
+	instanceID := instance.InstanceID()
+	slot, err := shift.NewShiftSlot(now, tenantID, future.BusinessDayID(), &instanceID, "受付", "Main",
+		time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC), 1, 1)
+	if err != nil {
+		t.Fatalf("Failed to create slot: %v", err)
+	}
+	slot.MarkFromTemplate(now, templateID)
+
+	eventRepo := &MockEventRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) (*event.Event, error) {
+			return source, nil
+		},
+	}
+	var savedBusinessDays []*event.EventBusinessDay
+	bdRepo := &MockBusinessDayRepository{
+		findByEventIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) ([]*event.EventBusinessDay, error) {
+			return []*event.EventBusinessDay{past, future}, nil
+		},
+		saveFunc: func(ctx context.Context, bd *event.EventBusinessDay) error {
+			savedBusinessDays = append(savedBusinessDays, bd)
+			return nil
+		},
+	}
+	var savedInstances []*shift.Instance
+	instanceRepo := &MockInstanceRepository{
+		findByEventIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) ([]*shift.Instance, error) {
+			return []*shift.Instance{instance}, nil
+		},
+		saveFunc: func(ctx context.Context, i *shift.Instance) error {
+			savedInstances = append(savedInstances, i)
+			return nil
+		},
+	}
+	var savedTemplates []*shift.ShiftSlotTemplate
+	templateRepo := &MockShiftSlotTemplateRepository{
+		findByEventIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) ([]*shift.ShiftSlotTemplate, error) {
+			return []*shift.ShiftSlotTemplate{template}, nil
+		},
+		saveFunc: func(ctx context.Context, tmpl *shift.ShiftSlotTemplate) error {
+			savedTemplates = append(savedTemplates, tmpl)
+			return nil
+		},
+	}
+	var savedSlots []*shift.ShiftSlot
+	slotRepo := &MockShiftSlotRepository{
+		findByBusinessDayIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+			if id != future.BusinessDayID() {
+				t.Errorf("slots of the past business day should not be loaded")
+				return nil, nil
+			}
+			return []*shift.ShiftSlot{slot}, nil
+		},
+		saveFunc: func(ctx context.Context, s *shift.ShiftSlot) error {
+			savedSlots = append(savedSlots, s)
+			return nil
+		},
+	}
+	groupAssignRepo := &MockEventGroupAssignmentRepository{
+		groupIDs:     map[common.EventID][]common.MemberGroupID{source.EventID(): {common.NewMemberGroupID()}},
+		roleGroupIDs: map[common.EventID][]common.RoleGroupID{source.EventID(): {common.NewRoleGroupID()}},
+	}
+	clock := &MockClock{nowFunc: func() time.Time {
+		return time.Date(2025, 1, 20, 12, 0, 0, 0, time.UTC)
+	}}
+	usecase := appevent.NewCloneEventUsecase(eventRepo, bdRepo, instanceRepo, templateRepo, slotRepo, groupAssignRepo,
+		&MockTenantRepository{}, &MockTxManager{}, clock)
+
+	output, err := usecase.Execute(context.Background(), appevent.CloneEventInput{
+		TenantID:            tenantID,
+		EventID:             source.EventID(),
+		IncludeBusinessDays: true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	cloned := output.Event
+	if cloned.EventID() == source.EventID() || cloned.EventName() != "夏季営業 (コピー)" {
+		t.Errorf("unexpected cloned event: id=%s name=%s", cloned.EventID(), cloned.EventName())
+	}
+	if output.InstanceCount != 1 || output.TemplateCount != 1 || output.BusinessDayCount != 1 || output.ShiftSlotCount != 1 {
+		t.Fatalf("unexpected counts: %+v", output)
+	}
+
+	// インスタンス・テンプレートは複製先のイベントに属する
+	clonedInstance := savedInstances[0]
+	clonedTemplate := savedTemplates[0]
+	if clonedInstance.EventID() != cloned.EventID() || clonedInstance.InstanceID() == instance.InstanceID() {
+		t.Errorf("instance should be copied to the cloned event: %+v", clonedInstance)
+	}
+	if clonedTemplate.EventID() != cloned.EventID() || clonedTemplate.TemplateID() == templateID ||
+		clonedTemplate.Version() != 3 || len(clonedTemplate.Items()) != 1 {
+		t.Errorf("template should be copied to the cloned event keeping its version: %+v", clonedTemplate)
+	}
+	if id := cloned.DefaultTemplateID(); id == nil || *id != clonedTemplate.TemplateID() {
+		t.Errorf("default template should point to the cloned template, got %v", id)
+	}
+
+	// グループ割り当て
+	if len(groupAssignRepo.groupIDs[cloned.EventID()]) != 1 || len(groupAssignRepo.roleGroupIDs[cloned.EventID()]) != 1 {
+		t.Errorf("group assignments should be copied: %+v %+v", groupAssignRepo.groupIDs, groupAssignRepo.roleGroupIDs)
+	}
+
+	// 開始前の営業日のみ複製し、枠のインスタンス・テンプレートは複製先に付け替える
+	clonedDay := savedBusinessDays[0]
+	if clonedDay.EventID() != cloned.EventID() || !clonedDay.TargetDate().Equal(future.TargetDate()) {
+		t.Errorf("future business day should be copied: %+v", clonedDay)
+	}
+	if at := clonedDay.AppliedTemplate(); at == nil || at.TemplateID != clonedTemplate.TemplateID() || at.Version != 2 {
+		t.Errorf("applied template should point to the cloned template, got %+v", at)
+	}
+	clonedSlot := savedSlots[0]
+	if clonedSlot.BusinessDayID() != clonedDay.BusinessDayID() || clonedSlot.SlotID() == slot.SlotID() {
+		t.Errorf("slot should be copied to the cloned business day: %+v", clonedSlot)
+	}
+	if id := clonedSlot.InstanceID(); id == nil || *id != clonedInstance.InstanceID() {
+		t.Errorf("slot should reference the cloned instance, got %v", id)
+	}
+	if id := clonedSlot.TemplateID(); id == nil || *id != clonedTemplate.TemplateID() {
+		t.Errorf("slot should reference the cloned template, got %v", id)
+	}
+}
+
+func TestCloneEventUsecase_Execute_WithoutBusinessDays(t *testing.T) {
+	source := createCloneSourceEvent(t)
+
+	eventRepo := &MockEventRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) (*event.Event, error) {
+			return source, nil
+		},
+	}
+	bdRepo := &MockBusinessDayRepository{
+		findByEventIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) ([]*event.EventBusinessDay, error) {
+			t.Error("business days should not be loaded")
+			return nil, nil
+		},
+	}
+	instanceRepo := &MockInstanceRepository{
+		findByEventIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) ([]*shift.Instance, error) {
+			return nil, nil
+		},
+	}
+	templateRepo := &MockShiftSlotTemplateRepository{
+		findByEventIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) ([]*shift.ShiftSlotTemplate, error) {
+			return nil, nil
+		},
+	}
+	groupAssignRepo := &MockEventGroupAssignmentRepository{
+		groupIDs:     map[common.EventID][]common.MemberGroupID{},
+		roleGroupIDs: map[common.EventID][]common.RoleGroupID{},
+	}
+	usecase := appevent.NewCloneEventUsecase(eventRepo, bdRepo, instanceRepo, templateRepo, &MockShiftSlotRepository{}, groupAssignRepo,
+		&MockTenantRepository{}, &MockTxManager{}, &MockClock{})
+
+	output, err := usecase.Execute(context.Background(), appevent.CloneEventInput{
+		TenantID:  source.TenantID(),
+		EventID:   source.EventID(),
+		EventName: "冬季営業",
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if output.Event.EventName() != "冬季営業" {
+		t.Errorf("EventName = %s, want 冬季営業", output.Event.EventName())
+	}
+	if output.BusinessDayCount != 0 || output.ShiftSlotCount != 0 {
+		t.Errorf("business days should not be copied, got %d days and %d slots", output.BusinessDayCount, output.ShiftSlotCount)
+	}
+}
+
+func TestCloneEventUsecase_Execute_ErrorWhenNameExists(t *testing.T) {
+	source := createCloneSourceEvent(t)
+
+	eventRepo := &MockEventRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) (*event.Event, error) {
+			return source, nil
+		},
+		existsByNameFunc: func(ctx context.Context, tid common.TenantID, name string) (bool, error) {
+			return name == "夏季営業 (コピー)", nil
+		},
+		saveFunc: func(ctx context.Context, e *event.Event) error {
+			t.Error("no event should be saved")
+			return nil
+		},
+	}
+	usecase := appevent.NewCloneEventUsecase(eventRepo, &MockBusinessDayRepository{}, &MockInstanceRepository{},
+		&MockShiftSlotTemplateRepository{}, &MockShiftSlotRepository{}, &MockEventGroupAssignmentRepository{},
+		&MockTenantRepository{}, &MockTxManager{}, &MockClock{})
+
+	_, err := usecase.Execute(context.Background(), appevent.CloneEventInput{
+		TenantID: source.TenantID(),
+		EventID:  source.EventID(),
+	})
+	var domainErr *common.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code() != common.ErrConflict {
+		t.Errorf("Execute() should return Conflict, got %v", err)
+	}
+}
+
+func TestCloneEventUsecase_Execute_PropagatesTransactionError(t *testing.T) {
+	source := createCloneSourceEvent(t)
+
+	eventRepo := &MockEventRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) (*event.Event, error) {
+			return source, nil
+		},
+	}
+	txErr := errors.New("tx failed")
+	txManager := &MockTxManager{withTxFunc: func(ctx context.Context, fn func(context.Context) error) error {
+		return txErr
+	}}
+	usecase := appevent.NewCloneEventUsecase(eventRepo, &MockBusinessDayRepository{}, &MockInstanceRepository{},
+		&MockShiftSlotTemplateRepository{}, &MockShiftSlotRepository{}, &MockEventGroupAssignmentRepository{},
+		&MockTenantRepository{}, txManager, &MockClock{})
+
+	_, err := usecase.Execute(context.Background(), appevent.CloneEventInput{
+		TenantID: source.TenantID(),
+		EventID:  source.EventID(),
+	})
+	if !errors.Is(err, txErr) {
+		t.Errorf("Execute() should return the transaction error, got %v", err)
+	}
+}
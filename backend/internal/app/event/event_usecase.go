@@ -6,6 +6,9 @@ import (
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
 )
 
 // 営業日生成の定数
@@ -23,6 +26,7 @@ type CreateEventInput struct {
 	RecurrenceType      event.RecurrenceType
 	RecurrenceStartDate *time.Time
 	RecurrenceDayOfWeek *int
+	RecurrenceRule      string // RecurrenceType が rrule の場合の iCalendar 形式のルール（DTSTART 省略時は RecurrenceStartDate）
 	DefaultStartTime    *time.Time
 	DefaultEndTime      *time.Time
 }
@@ -31,13 +35,25 @@ type CreateEventInput struct {
 type CreateEventUsecase struct {
 	eventRepo       event.EventRepository
 	businessDayRepo event.EventBusinessDayRepository
+	tenantRepo      tenant.TenantRepository
+	blackoutRepo    event.BlackoutDateSetRepository
+	clock           services.Clock
 }
 
 // NewCreateEventUsecase creates a new CreateEventUsecase
-func NewCreateEventUsecase(eventRepo event.EventRepository, businessDayRepo event.EventBusinessDayRepository) *CreateEventUsecase {
+func NewCreateEventUsecase(
+	eventRepo event.EventRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	tenantRepo tenant.TenantRepository,
+	blackoutRepo event.BlackoutDateSetRepository,
+	clock services.Clock,
+) *CreateEventUsecase {
 	return &CreateEventUsecase{
 		eventRepo:       eventRepo,
 		businessDayRepo: businessDayRepo,
+		tenantRepo:      tenantRepo,
+		blackoutRepo:    blackoutRepo,
+		clock:           clock,
 	}
 }
 
@@ -53,20 +69,41 @@ func (uc *CreateEventUsecase) Execute(ctx context.Context, input CreateEventInpu
 	}
 
 	// イベントの作成
-	newEvent, err := event.NewEvent(
-		time.Now(),
-		input.TenantID,
-		input.EventName,
-		input.EventType,
-		input.Description,
-		input.RecurrenceType,
-		input.RecurrenceStartDate,
-		input.RecurrenceDayOfWeek,
-		input.DefaultStartTime,
-		input.DefaultEndTime,
-	)
-	if err != nil {
-		return nil, err
+	var newEvent *event.Event
+	if input.RecurrenceType == event.RecurrenceTypeRRule {
+		rule, err := event.ParseRecurrenceRule(input.RecurrenceRule, input.RecurrenceStartDate)
+		if err != nil {
+			return nil, common.NewValidationError("invalid recurrence_rule", err)
+		}
+		newEvent, err = event.NewEventWithRecurrenceRule(
+			time.Now(),
+			input.TenantID,
+			input.EventName,
+			input.EventType,
+			input.Description,
+			rule,
+			input.DefaultStartTime,
+			input.DefaultEndTime,
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		newEvent, err = event.NewEvent(
+			uc.clock.Now(),
+			input.TenantID,
+			input.EventName,
+			input.EventType,
+			input.Description,
+			input.RecurrenceType,
+			input.RecurrenceStartDate,
+			input.RecurrenceDayOfWeek,
+			input.DefaultStartTime,
+			input.DefaultEndTime,
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// 保存
@@ -85,88 +122,61 @@ func (uc *CreateEventUsecase) Execute(ctx context.Context, input CreateEventInpu
 }
 
 // generateBusinessDays generates business days for recurring events
-// 今月と来月末までの営業日を自動生成
+// 今月と来月末までの営業日を自動生成（「今月」はテナントのタイムゾーンで判定）
 func (uc *CreateEventUsecase) generateBusinessDays(ctx context.Context, e *event.Event) error {
-	if !e.HasRecurrence() {
-		return nil
+	now := uc.clock.Now()
+	today, err := tenantToday(ctx, uc.tenantRepo, e.TenantID(), now)
+	if err != nil {
+		return err
 	}
+	nextMonthEnd := monthStart(today).AddDate(0, 2, 0).AddDate(0, 0, -1)
 
-	if e.RecurrenceStartDate() == nil || e.RecurrenceDayOfWeek() == nil ||
-		e.DefaultStartTime() == nil || e.DefaultEndTime() == nil {
-		return common.NewValidationError("定期開催設定が不完全です", nil)
+	calendar, err := loadBlackoutCalendar(ctx, uc.blackoutRepo, e.TenantID())
+	if err != nil {
+		return err
 	}
 
-	now := time.Now()
-	startDate := *e.RecurrenceStartDate()
-	targetDayOfWeek := time.Weekday(*e.RecurrenceDayOfWeek())
+	_, err = generateRecurringBusinessDays(ctx, uc.businessDayRepo, e, calendar, nextMonthEnd, now)
+	return err
+}
 
-	// 今月の最初の日と来月末の日を計算
-	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
-	nextMonthEnd := currentMonth.AddDate(0, 2, 0).AddDate(0, 0, -1)
+// EventStatusFilter は一覧で返すイベントの状態
+type EventStatusFilter string
 
-	// 定期開始日から次の指定曜日を見つける
-	candidateDate := startDate
-	for candidateDate.Weekday() != targetDayOfWeek {
-		candidateDate = candidateDate.AddDate(0, 0, 1)
-	}
+const (
+	EventStatusActive   EventStatusFilter = "active"   // アーカイブされていないイベント（デフォルト）
+	EventStatusArchived EventStatusFilter = "archived" // アーカイブ済みのイベント
+	EventStatusAll      EventStatusFilter = "all"      // すべてのイベント
+)
 
-	// 営業日を生成
-	interval := 7 // 毎週の場合は7日間隔
-	if e.RecurrenceType() == event.RecurrenceTypeBiweekly {
-		interval = 14 // 隔週の場合は14日間隔
+// ParseEventStatusFilter parses the status query parameter (空の場合は active)
+func ParseEventStatusFilter(s string) (EventStatusFilter, error) {
+	switch EventStatusFilter(s) {
+	case "":
+		return EventStatusActive, nil
+	case EventStatusActive, EventStatusArchived, EventStatusAll:
+		return EventStatusFilter(s), nil
+	default:
+		return "", common.NewValidationError("status must be one of active, archived, all", nil)
 	}
+}
 
-	for candidateDate.Before(nextMonthEnd) || candidateDate.Equal(nextMonthEnd) {
-		// 開始日より前の日付はスキップ
-		if candidateDate.Before(startDate) {
-			candidateDate = candidateDate.AddDate(0, 0, interval)
-			continue
-		}
-
-		// 重複チェック
-		exists, err := uc.businessDayRepo.ExistsByEventIDAndDate(
-			ctx,
-			e.TenantID(),
-			e.EventID(),
-			candidateDate,
-			*e.DefaultStartTime(),
-		)
-		if err != nil {
-			return err
-		}
-
-		if !exists {
-			// 営業日を作成（定期営業 = recurring）
-			// イベント自体の定期設定から生成する場合は recurring_pattern_id は nil
-			businessDay, err := event.NewEventBusinessDay(
-				time.Now(),
-				e.TenantID(),
-				e.EventID(),
-				candidateDate,
-				*e.DefaultStartTime(),
-				*e.DefaultEndTime(),
-				event.OccurrenceTypeRecurring,
-				nil,
-			)
-			if err != nil {
-				return err
-			}
-
-			// 保存
-			if err := uc.businessDayRepo.Save(ctx, businessDay); err != nil {
-				return err
-			}
-		}
-
-		candidateDate = candidateDate.AddDate(0, 0, interval)
+// matches reports whether the event should be listed for the filter
+func (f EventStatusFilter) matches(e *event.Event) bool {
+	switch f {
+	case EventStatusArchived:
+		return e.IsArchived()
+	case EventStatusAll:
+		return true
+	default:
+		return !e.IsArchived()
 	}
-
-	return nil
 }
 
 // ListEventsInput represents the input for listing events
 type ListEventsInput struct {
 	TenantID common.TenantID
+	Status   EventStatusFilter // 空の場合は active
 }
 
 // ListEventsUsecase handles the event listing use case
@@ -188,7 +198,14 @@ func (uc *ListEventsUsecase) Execute(ctx context.Context, input ListEventsInput)
 		return nil, err
 	}
 
-	return events, nil
+	filtered := make([]*event.Event, 0, len(events))
+	for _, e := range events {
+		if input.Status.matches(e) {
+			filtered = append(filtered, e)
+		}
+	}
+
+	return filtered, nil
 }
 
 // GetEventInput represents the input for getting an event
@@ -236,13 +253,25 @@ type GenerateBusinessDaysOutput struct {
 type GenerateBusinessDaysUsecase struct {
 	eventRepo       event.EventRepository
 	businessDayRepo event.EventBusinessDayRepository
+	tenantRepo      tenant.TenantRepository
+	blackoutRepo    event.BlackoutDateSetRepository
+	clock           services.Clock
 }
 
 // NewGenerateBusinessDaysUsecase creates a new GenerateBusinessDaysUsecase
-func NewGenerateBusinessDaysUsecase(eventRepo event.EventRepository, businessDayRepo event.EventBusinessDayRepository) *GenerateBusinessDaysUsecase {
+func NewGenerateBusinessDaysUsecase(
+	eventRepo event.EventRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	tenantRepo tenant.TenantRepository,
+	blackoutRepo event.BlackoutDateSetRepository,
+	clock services.Clock,
+) *GenerateBusinessDaysUsecase {
 	return &GenerateBusinessDaysUsecase{
 		eventRepo:       eventRepo,
 		businessDayRepo: businessDayRepo,
+		tenantRepo:      tenantRepo,
+		blackoutRepo:    blackoutRepo,
+		clock:           clock,
 	}
 }
 
@@ -254,6 +283,11 @@ func (uc *GenerateBusinessDaysUsecase) Execute(ctx context.Context, input Genera
 		return nil, err
 	}
 
+	// アーカイブ済みのイベントには営業日を追加しない
+	if err := e.EnsureNotArchived(); err != nil {
+		return nil, err
+	}
+
 	// 定期設定がない場合はエラー
 	if !e.HasRecurrence() {
 		return nil, common.NewValidationError("イベントに定期開催設定がありません", nil)
@@ -276,17 +310,21 @@ type UpdateEventInput struct {
 	TenantID  common.TenantID
 	EventID   common.EventID
 	EventName string
+	// DefaultTemplateID は nil の場合は変更せず、空の ID の場合はデフォルトテンプレートを解除する
+	DefaultTemplateID *common.ShiftSlotTemplateID
 }
 
 // UpdateEventUsecase handles the event update use case
 type UpdateEventUsecase struct {
-	eventRepo event.EventRepository
+	eventRepo    event.EventRepository
+	templateRepo shift.ShiftSlotTemplateRepository
 }
 
 // NewUpdateEventUsecase creates a new UpdateEventUsecase
-func NewUpdateEventUsecase(eventRepo event.EventRepository) *UpdateEventUsecase {
+func NewUpdateEventUsecase(eventRepo event.EventRepository, templateRepo shift.ShiftSlotTemplateRepository) *UpdateEventUsecase {
 	return &UpdateEventUsecase{
-		eventRepo: eventRepo,
+		eventRepo:    eventRepo,
+		templateRepo: templateRepo,
 	}
 }
 
@@ -304,6 +342,23 @@ func (uc *UpdateEventUsecase) Execute(ctx context.Context, input UpdateEventInpu
 		return nil, err
 	}
 
+	// デフォルトテンプレートを更新
+	if input.DefaultTemplateID != nil {
+		if *input.DefaultTemplateID == "" {
+			e.SetDefaultTemplate(now, nil)
+		} else {
+			template, err := uc.templateRepo.FindByID(ctx, input.TenantID, *input.DefaultTemplateID)
+			if err != nil {
+				return nil, err
+			}
+			if template.EventID() != e.EventID() || template.DeletedAt() != nil {
+				return nil, common.NewValidationError("default_template_id must be a template of this event", nil)
+			}
+			templateID := template.TemplateID()
+			e.SetDefaultTemplate(now, &templateID)
+		}
+	}
+
 	// 保存
 	if err := uc.eventRepo.Save(ctx, e); err != nil {
 		return nil, err
@@ -350,18 +405,82 @@ func (uc *DeleteEventUsecase) Execute(ctx context.Context, input DeleteEventInpu
 	return nil
 }
 
-// generateBusinessDays generates business days for recurring events
-// 今月からmonths月後までの営業日を自動生成し、生成された件数を返す
-func (uc *GenerateBusinessDaysUsecase) generateBusinessDays(ctx context.Context, e *event.Event, months int) (int, error) {
-	if !e.HasRecurrence() {
-		return 0, nil
+// ArchiveEventInput represents the input for archiving an event
+type ArchiveEventInput struct {
+	TenantID common.TenantID
+	EventID  common.EventID
+}
+
+// ArchiveEventUsecase handles the event archival use case
+// アーカイブ済みのイベントは一覧・公開カレンダーに表示されず、営業日の追加・シフトの割り当てができなくなる（履歴は参照できる）
+type ArchiveEventUsecase struct {
+	eventRepo event.EventRepository
+}
+
+// NewArchiveEventUsecase creates a new ArchiveEventUsecase
+func NewArchiveEventUsecase(eventRepo event.EventRepository) *ArchiveEventUsecase {
+	return &ArchiveEventUsecase{
+		eventRepo: eventRepo,
 	}
+}
 
-	if e.RecurrenceStartDate() == nil || e.RecurrenceDayOfWeek() == nil ||
-		e.DefaultStartTime() == nil || e.DefaultEndTime() == nil {
-		return 0, common.NewValidationError("定期開催設定が不完全です", nil)
+// Execute archives an event
+func (uc *ArchiveEventUsecase) Execute(ctx context.Context, input ArchiveEventInput) (*event.Event, error) {
+	e, err := uc.eventRepo.FindByID(ctx, input.TenantID, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.Archive(time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.eventRepo.Save(ctx, e); err != nil {
+		return nil, err
 	}
 
+	return e, nil
+}
+
+// RestoreEventInput represents the input for restoring an archived event
+type RestoreEventInput struct {
+	TenantID common.TenantID
+	EventID  common.EventID
+}
+
+// RestoreEventUsecase handles restoring an archived event
+type RestoreEventUsecase struct {
+	eventRepo event.EventRepository
+}
+
+// NewRestoreEventUsecase creates a new RestoreEventUsecase
+func NewRestoreEventUsecase(eventRepo event.EventRepository) *RestoreEventUsecase {
+	return &RestoreEventUsecase{
+		eventRepo: eventRepo,
+	}
+}
+
+// Execute restores an archived event
+func (uc *RestoreEventUsecase) Execute(ctx context.Context, input RestoreEventInput) (*event.Event, error) {
+	e, err := uc.eventRepo.FindByID(ctx, input.TenantID, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.Restore(time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.eventRepo.Save(ctx, e); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// generateBusinessDays generates business days for recurring events
+// 今月からmonths月後までの営業日を自動生成し、生成された件数を返す
+func (uc *GenerateBusinessDaysUsecase) generateBusinessDays(ctx context.Context, e *event.Event, months int) (int, error) {
 	// months のバリデーション
 	if months <= 0 {
 		months = DefaultBusinessDayMonths
@@ -370,73 +489,104 @@ func (uc *GenerateBusinessDaysUsecase) generateBusinessDays(ctx context.Context,
 		months = MaxBusinessDayMonths
 	}
 
-	now := time.Now()
-	startDate := *e.RecurrenceStartDate()
-	targetDayOfWeek := time.Weekday(*e.RecurrenceDayOfWeek())
+	// 今月の最初の日から months+1 ヶ月後の末日を計算（「今月」はテナントのタイムゾーンで判定）
+	now := uc.clock.Now()
+	today, err := tenantToday(ctx, uc.tenantRepo, e.TenantID(), now)
+	if err != nil {
+		return 0, err
+	}
+	endDate := monthStart(today).AddDate(0, months+1, 0).AddDate(0, 0, -1)
+
+	calendar, err := loadBlackoutCalendar(ctx, uc.blackoutRepo, e.TenantID())
+	if err != nil {
+		return 0, err
+	}
 
-	// 今月の最初の日から months+1 ヶ月後の末日を計算
-	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
-	endDate := currentMonth.AddDate(0, months+1, 0).AddDate(0, 0, -1)
+	return generateRecurringBusinessDays(ctx, uc.businessDayRepo, e, calendar, endDate, now)
+}
 
-	// 定期開始日から次の指定曜日を見つける
-	candidateDate := startDate
-	for candidateDate.Weekday() != targetDayOfWeek {
-		candidateDate = candidateDate.AddDate(0, 0, 1)
+// tenantToday returns today's date in the tenant's time zone
+// 営業日の target_date と同じく UTC の 0 時で表現する
+func tenantToday(ctx context.Context, tenantRepo tenant.TenantRepository, tenantID common.TenantID, now time.Time) (time.Time, error) {
+	loc, err := tenant.ResolveLocation(ctx, tenantRepo, tenantID)
+	if err != nil {
+		return time.Time{}, err
 	}
+	return common.DateIn(now, loc), nil
+}
 
-	// 営業日を生成
-	interval := 7 // 毎週の場合は7日間隔
-	if e.RecurrenceType() == event.RecurrenceTypeBiweekly {
-		interval = 14 // 隔週の場合は14日間隔
+// monthStart returns the first day of the month of the given date
+func monthStart(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// occurrenceGenerated returns true if the business day of the recurrence on targetDate should not be created
+// 一度生成した開催日は、日時の変更・論理削除後も作り直さない
+// 手動で同じ日時に作成した営業日がある場合も作成しない
+func occurrenceGenerated(ctx context.Context, businessDayRepo event.EventBusinessDayRepository, e *event.Event, targetDate time.Time) (bool, error) {
+	exists, err := businessDayRepo.ExistsByEventIDAndOccurrenceDate(ctx, e.TenantID(), e.EventID(), targetDate)
+	if err != nil || exists {
+		return exists, err
+	}
+	return businessDayRepo.ExistsByEventIDAndDate(ctx, e.TenantID(), e.EventID(), targetDate, *e.DefaultStartTime())
+}
+
+// generateRecurringBusinessDays creates the business days of the event's recurrence up to endDate
+// 定期開始日（DTSTART）から endDate までの開催日を RRULE で展開し、休業日を除外・移動したうえで未登録の日付のみ作成して件数を返す
+func generateRecurringBusinessDays(
+	ctx context.Context,
+	businessDayRepo event.EventBusinessDayRepository,
+	e *event.Event,
+	calendar *event.BlackoutCalendar,
+	endDate time.Time,
+	now time.Time,
+) (int, error) {
+	if !e.HasRecurrence() {
+		return 0, nil
+	}
+
+	rule, err := e.EffectiveRecurrenceRule()
+	if err != nil {
+		return 0, err
+	}
+	if e.DefaultStartTime() == nil || e.DefaultEndTime() == nil {
+		return 0, common.NewValidationError("定期開催設定が不完全です", nil)
 	}
 
 	generatedCount := 0
 
-	for candidateDate.Before(endDate) || candidateDate.Equal(endDate) {
-		// 開始日より前の日付はスキップ
-		if candidateDate.Before(startDate) {
-			candidateDate = candidateDate.AddDate(0, 0, interval)
+	for _, targetDate := range calendar.Occurrences(rule, rule.DTStart(), endDate) {
+		// 重複チェック
+		exists, err := occurrenceGenerated(ctx, businessDayRepo, e, targetDate)
+		if err != nil {
+			return generatedCount, err
+		}
+		if exists {
 			continue
 		}
 
-		// 重複チェック
-		exists, err := uc.businessDayRepo.ExistsByEventIDAndDate(
-			ctx,
+		// 営業日を作成（定期営業 = recurring）
+		// イベント自体の定期設定から生成する場合は recurring_pattern_id は nil
+		businessDay, err := event.NewEventBusinessDay(
+			now,
 			e.TenantID(),
 			e.EventID(),
-			candidateDate,
+			targetDate,
 			*e.DefaultStartTime(),
+			*e.DefaultEndTime(),
+			event.OccurrenceTypeRecurring,
+			nil,
 		)
 		if err != nil {
 			return generatedCount, err
 		}
 
-		if !exists {
-			// 営業日を作成（定期営業 = recurring）
-			// イベント自体の定期設定から生成する場合は recurring_pattern_id は nil
-			businessDay, err := event.NewEventBusinessDay(
-				time.Now(),
-				e.TenantID(),
-				e.EventID(),
-				candidateDate,
-				*e.DefaultStartTime(),
-				*e.DefaultEndTime(),
-				event.OccurrenceTypeRecurring,
-				nil,
-			)
-			if err != nil {
-				return generatedCount, err
-			}
-
-			// 保存
-			if err := uc.businessDayRepo.Save(ctx, businessDay); err != nil {
-				return generatedCount, err
-			}
-
-			generatedCount++
+		// 保存
+		if err := businessDayRepo.Save(ctx, businessDay); err != nil {
+			return generatedCount, err
 		}
 
-		candidateDate = candidateDate.AddDate(0, 0, interval)
+		generatedCount++
 	}
 
 	return generatedCount, nil
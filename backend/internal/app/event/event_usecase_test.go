@@ -9,6 +9,8 @@ import (
 	appevent "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/event"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
 )
 
 // =====================================================
@@ -67,9 +69,12 @@ func (m *MockEventRepository) Delete(ctx context.Context, tenantID common.Tenant
 }
 
 type MockBusinessDayRepository struct {
-	saveFunc               func(ctx context.Context, bd *event.EventBusinessDay) error
-	findByEventIDFunc      func(ctx context.Context, tenantID common.TenantID, eventID common.EventID) ([]*event.EventBusinessDay, error)
-	existsByEventIDAndDate func(ctx context.Context, tenantID common.TenantID, eventID common.EventID, date time.Time, startTime time.Time) (bool, error)
+	saveFunc                      func(ctx context.Context, bd *event.EventBusinessDay) error
+	findByIDFunc                  func(ctx context.Context, tenantID common.TenantID, businessDayID event.BusinessDayID) (*event.EventBusinessDay, error)
+	findByEventIDFunc             func(ctx context.Context, tenantID common.TenantID, eventID common.EventID) ([]*event.EventBusinessDay, error)
+	findByEventIDAndDateRangeFunc func(ctx context.Context, tenantID common.TenantID, eventID common.EventID, startDate, endDate time.Time) ([]*event.EventBusinessDay, error)
+	existsByEventIDAndDate        func(ctx context.Context, tenantID common.TenantID, eventID common.EventID, date time.Time, startTime time.Time) (bool, error)
+	existsByOccurrenceDateFunc    func(ctx context.Context, tenantID common.TenantID, eventID common.EventID, occurrenceDate time.Time) (bool, error)
 }
 
 func (m *MockBusinessDayRepository) Save(ctx context.Context, bd *event.EventBusinessDay) error {
@@ -93,11 +98,24 @@ func (m *MockBusinessDayRepository) ExistsByEventIDAndDate(ctx context.Context,
 	return false, nil
 }
 
+func (m *MockBusinessDayRepository) ExistsByEventIDAndOccurrenceDate(ctx context.Context, tenantID common.TenantID, eventID common.EventID, occurrenceDate time.Time) (bool, error) {
+	if m.existsByOccurrenceDateFunc != nil {
+		return m.existsByOccurrenceDateFunc(ctx, tenantID, eventID, occurrenceDate)
+	}
+	return false, nil
+}
+
 func (m *MockBusinessDayRepository) FindByID(ctx context.Context, tenantID common.TenantID, businessDayID event.BusinessDayID) (*event.EventBusinessDay, error) {
+	if m.findByIDFunc != nil {
+		return m.findByIDFunc(ctx, tenantID, businessDayID)
+	}
 	return nil, errors.New("not implemented")
 }
 
 func (m *MockBusinessDayRepository) FindByEventIDAndDateRange(ctx context.Context, tenantID common.TenantID, eventID common.EventID, startDate, endDate time.Time) ([]*event.EventBusinessDay, error) {
+	if m.findByEventIDAndDateRangeFunc != nil {
+		return m.findByEventIDAndDateRangeFunc(ctx, tenantID, eventID, startDate, endDate)
+	}
 	return nil, nil
 }
 
@@ -113,14 +131,89 @@ func (m *MockBusinessDayRepository) Delete(ctx context.Context, tenantID common.
 	return nil
 }
 
-func (m *MockBusinessDayRepository) FindRecentByTenantID(ctx context.Context, tenantID common.TenantID, limit int) ([]*event.EventBusinessDay, error) {
+func (m *MockBusinessDayRepository) FindRecentByTenantID(ctx context.Context, tenantID common.TenantID, today time.Time, limit int) ([]*event.EventBusinessDay, error) {
 	return nil, nil
 }
 
-func (m *MockBusinessDayRepository) FindRecentByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID, limit int, includeFuture bool) ([]*event.EventBusinessDay, error) {
+func (m *MockBusinessDayRepository) FindRecentByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID, today time.Time, limit int, includeFuture bool) ([]*event.EventBusinessDay, error) {
 	return nil, nil
 }
 
+// MockTenantRepository returns a tenant in the given timezone (Asia/Tokyo by default)
+type MockTenantRepository struct {
+	timezone string
+}
+
+func (m *MockTenantRepository) FindByID(ctx context.Context, tenantID common.TenantID) (*tenant.Tenant, error) {
+	timezone := m.timezone
+	if timezone == "" {
+		timezone = tenant.DefaultTimezone
+	}
+	now := time.Now()
+	return tenant.ReconstructTenant(tenantID, "Test Tenant", timezone, true, tenant.TenantStatusActive, nil, nil, nil, now, now, nil)
+}
+
+func (m *MockTenantRepository) FindByPendingStripeSessionID(ctx context.Context, sessionID string) (*tenant.Tenant, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockTenantRepository) Save(ctx context.Context, t *tenant.Tenant) error {
+	return nil
+}
+
+func (m *MockTenantRepository) ListAll(ctx context.Context, status *tenant.TenantStatus, limit, offset int) ([]*tenant.Tenant, int, error) {
+	return nil, 0, nil
+}
+
+type MockBlackoutDateSetRepository struct {
+	sets []*event.BlackoutDateSet
+}
+
+func (m *MockBlackoutDateSetRepository) Save(ctx context.Context, set *event.BlackoutDateSet) error {
+	for i, s := range m.sets {
+		if s.SetID() == set.SetID() {
+			m.sets[i] = set
+			return nil
+		}
+	}
+	m.sets = append(m.sets, set)
+	return nil
+}
+
+func (m *MockBlackoutDateSetRepository) FindByID(ctx context.Context, tenantID common.TenantID, setID event.BlackoutDateSetID) (*event.BlackoutDateSet, error) {
+	for _, s := range m.sets {
+		if s.TenantID() == tenantID && s.SetID() == setID {
+			return s, nil
+		}
+	}
+	return nil, common.NewNotFoundError("BlackoutDateSet", setID.String())
+}
+
+func (m *MockBlackoutDateSetRepository) FindByTenantID(ctx context.Context, tenantID common.TenantID) ([]*event.BlackoutDateSet, error) {
+	var result []*event.BlackoutDateSet
+	for _, s := range m.sets {
+		if s.TenantID() == tenantID {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockBlackoutDateSetRepository) Delete(ctx context.Context, tenantID common.TenantID, setID event.BlackoutDateSetID) error {
+	return nil
+}
+
+type MockClock struct {
+	nowFunc func() time.Time
+}
+
+func (m *MockClock) Now() time.Time {
+	if m.nowFunc != nil {
+		return m.nowFunc()
+	}
+	return time.Now()
+}
+
 // =====================================================
 // CreateEventUsecase Tests
 // =====================================================
@@ -139,7 +232,7 @@ func TestCreateEventUsecase_Execute_Success(t *testing.T) {
 
 	bdRepo := &MockBusinessDayRepository{}
 
-	usecase := appevent.NewCreateEventUsecase(eventRepo, bdRepo)
+	usecase := appevent.NewCreateEventUsecase(eventRepo, bdRepo, &MockTenantRepository{}, &MockBlackoutDateSetRepository{}, &MockClock{})
 
 	input := appevent.CreateEventInput{
 		TenantID:       tenantID,
@@ -179,7 +272,7 @@ func TestCreateEventUsecase_Execute_ErrorWhenNameExists(t *testing.T) {
 
 	bdRepo := &MockBusinessDayRepository{}
 
-	usecase := appevent.NewCreateEventUsecase(eventRepo, bdRepo)
+	usecase := appevent.NewCreateEventUsecase(eventRepo, bdRepo, &MockTenantRepository{}, &MockBlackoutDateSetRepository{}, &MockClock{})
 
 	input := appevent.CreateEventInput{
 		TenantID:       tenantID,
@@ -210,7 +303,7 @@ func TestCreateEventUsecase_Execute_ErrorWhenSaveFails(t *testing.T) {
 
 	bdRepo := &MockBusinessDayRepository{}
 
-	usecase := appevent.NewCreateEventUsecase(eventRepo, bdRepo)
+	usecase := appevent.NewCreateEventUsecase(eventRepo, bdRepo, &MockTenantRepository{}, &MockBlackoutDateSetRepository{}, &MockClock{})
 
 	input := appevent.CreateEventInput{
 		TenantID:       tenantID,
@@ -389,7 +482,7 @@ func TestUpdateEventUsecase_Execute_Success(t *testing.T) {
 		},
 	}
 
-	usecase := appevent.NewUpdateEventUsecase(eventRepo)
+	usecase := appevent.NewUpdateEventUsecase(eventRepo, &MockShiftSlotTemplateRepository{})
 
 	input := appevent.UpdateEventInput{
 		TenantID:  tenantID,
@@ -418,7 +511,7 @@ func TestUpdateEventUsecase_Execute_ErrorWhenNotFound(t *testing.T) {
 		},
 	}
 
-	usecase := appevent.NewUpdateEventUsecase(eventRepo)
+	usecase := appevent.NewUpdateEventUsecase(eventRepo, &MockShiftSlotTemplateRepository{})
 
 	input := appevent.UpdateEventInput{
 		TenantID:  tenantID,
@@ -433,6 +526,98 @@ func TestUpdateEventUsecase_Execute_ErrorWhenNotFound(t *testing.T) {
 	}
 }
 
+func TestUpdateEventUsecase_Execute_SetsAndClearsDefaultTemplate(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testEvent := createSaturdayEvent(t, tenantID)
+	tmpl := createTemplateWithItem(t, tenantID, testEvent.EventID())
+
+	eventRepo := &MockEventRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) (*event.Event, error) {
+			return testEvent, nil
+		},
+	}
+	templateRepo := &MockShiftSlotTemplateRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id common.ShiftSlotTemplateID) (*shift.ShiftSlotTemplate, error) {
+			return tmpl, nil
+		},
+	}
+	usecase := appevent.NewUpdateEventUsecase(eventRepo, templateRepo)
+
+	templateID := tmpl.TemplateID()
+	result, err := usecase.Execute(context.Background(), appevent.UpdateEventInput{
+		TenantID:          tenantID,
+		EventID:           testEvent.EventID(),
+		EventName:         testEvent.EventName(),
+		DefaultTemplateID: &templateID,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+	if result.DefaultTemplateID() == nil || *result.DefaultTemplateID() != templateID {
+		t.Errorf("DefaultTemplateID = %v, want %s", result.DefaultTemplateID(), templateID)
+	}
+
+	// DefaultTemplateID を省略した場合は変更しない
+	result, err = usecase.Execute(context.Background(), appevent.UpdateEventInput{
+		TenantID:  tenantID,
+		EventID:   testEvent.EventID(),
+		EventName: "Renamed",
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+	if result.DefaultTemplateID() == nil {
+		t.Error("DefaultTemplateID should be kept when omitted")
+	}
+
+	empty := common.ShiftSlotTemplateID("")
+	result, err = usecase.Execute(context.Background(), appevent.UpdateEventInput{
+		TenantID:          tenantID,
+		EventID:           testEvent.EventID(),
+		EventName:         "Renamed",
+		DefaultTemplateID: &empty,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+	if result.DefaultTemplateID() != nil {
+		t.Errorf("DefaultTemplateID should be cleared, got %v", result.DefaultTemplateID())
+	}
+}
+
+func TestUpdateEventUsecase_Execute_ErrorWhenTemplateOfAnotherEvent(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testEvent := createSaturdayEvent(t, tenantID)
+	tmpl := createTemplateWithItem(t, tenantID, common.NewEventID())
+
+	eventRepo := &MockEventRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) (*event.Event, error) {
+			return testEvent, nil
+		},
+		saveFunc: func(ctx context.Context, e *event.Event) error {
+			t.Error("Save should not be called")
+			return nil
+		},
+	}
+	templateRepo := &MockShiftSlotTemplateRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id common.ShiftSlotTemplateID) (*shift.ShiftSlotTemplate, error) {
+			return tmpl, nil
+		},
+	}
+	usecase := appevent.NewUpdateEventUsecase(eventRepo, templateRepo)
+
+	templateID := tmpl.TemplateID()
+	_, err := usecase.Execute(context.Background(), appevent.UpdateEventInput{
+		TenantID:          tenantID,
+		EventID:           testEvent.EventID(),
+		EventName:         testEvent.EventName(),
+		DefaultTemplateID: &templateID,
+	})
+	if err == nil {
+		t.Fatal("Execute() should fail for a template of another event")
+	}
+}
+
 // =====================================================
 // DeleteEventUsecase Tests
 // =====================================================
@@ -490,6 +675,110 @@ func TestDeleteEventUsecase_Execute_ErrorWhenNotFound(t *testing.T) {
 	}
 }
 
+func TestListEventsUsecase_Execute_FiltersByStatus(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Now()
+
+	active, _ := event.NewEvent(now, tenantID, "Active", event.EventTypeNormal, "", event.RecurrenceTypeNone, nil, nil, nil, nil)
+	archived, _ := event.NewEvent(now, tenantID, "Archived", event.EventTypeNormal, "", event.RecurrenceTypeNone, nil, nil, nil, nil)
+	if err := archived.Archive(now); err != nil {
+		t.Fatalf("Archive() should succeed, got error: %v", err)
+	}
+
+	eventRepo := &MockEventRepository{
+		findByTenantFunc: func(ctx context.Context, tid common.TenantID) ([]*event.Event, error) {
+			return []*event.Event{active, archived}, nil
+		},
+	}
+	usecase := appevent.NewListEventsUsecase(eventRepo)
+
+	tests := []struct {
+		status appevent.EventStatusFilter
+		want   []*event.Event
+	}{
+		{"", []*event.Event{active}},
+		{appevent.EventStatusActive, []*event.Event{active}},
+		{appevent.EventStatusArchived, []*event.Event{archived}},
+		{appevent.EventStatusAll, []*event.Event{active, archived}},
+	}
+	for _, tt := range tests {
+		result, err := usecase.Execute(context.Background(), appevent.ListEventsInput{TenantID: tenantID, Status: tt.status})
+		if err != nil {
+			t.Fatalf("Execute(%q) should succeed, got error: %v", tt.status, err)
+		}
+		if len(result) != len(tt.want) {
+			t.Errorf("Execute(%q) returned %d events, want %d", tt.status, len(result), len(tt.want))
+			continue
+		}
+		for i := range result {
+			if result[i] != tt.want[i] {
+				t.Errorf("Execute(%q)[%d] = %s, want %s", tt.status, i, result[i].EventName(), tt.want[i].EventName())
+			}
+		}
+	}
+}
+
+func TestParseEventStatusFilter(t *testing.T) {
+	if status, err := appevent.ParseEventStatusFilter(""); err != nil || status != appevent.EventStatusActive {
+		t.Errorf("empty status should default to active, got %q (%v)", status, err)
+	}
+	if _, err := appevent.ParseEventStatusFilter("deleted"); err == nil {
+		t.Error("unknown status should be rejected")
+	}
+}
+
+// =====================================================
+// ArchiveEventUsecase / RestoreEventUsecase Tests
+// =====================================================
+
+func TestArchiveEventUsecase_Execute_ArchivesAndRestores(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testEvent, _ := event.NewEvent(time.Now(), tenantID, "Test Event", event.EventTypeNormal, "", event.RecurrenceTypeNone, nil, nil, nil, nil)
+
+	saveCount := 0
+	eventRepo := &MockEventRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) (*event.Event, error) {
+			return testEvent, nil
+		},
+		saveFunc: func(ctx context.Context, e *event.Event) error {
+			saveCount++
+			return nil
+		},
+	}
+
+	archived, err := appevent.NewArchiveEventUsecase(eventRepo).Execute(context.Background(), appevent.ArchiveEventInput{
+		TenantID: tenantID,
+		EventID:  testEvent.EventID(),
+	})
+	if err != nil {
+		t.Fatalf("Archive should succeed, got error: %v", err)
+	}
+	if !archived.IsArchived() || saveCount != 1 {
+		t.Errorf("event should be archived and saved once, archived=%v saves=%d", archived.IsArchived(), saveCount)
+	}
+
+	// 二重アーカイブは Conflict
+	_, err = appevent.NewArchiveEventUsecase(eventRepo).Execute(context.Background(), appevent.ArchiveEventInput{
+		TenantID: tenantID,
+		EventID:  testEvent.EventID(),
+	})
+	var domainErr *common.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code() != common.ErrConflict {
+		t.Errorf("archiving twice should return Conflict, got %v", err)
+	}
+
+	restored, err := appevent.NewRestoreEventUsecase(eventRepo).Execute(context.Background(), appevent.RestoreEventInput{
+		TenantID: tenantID,
+		EventID:  testEvent.EventID(),
+	})
+	if err != nil {
+		t.Fatalf("Restore should succeed, got error: %v", err)
+	}
+	if restored.IsArchived() || saveCount != 2 {
+		t.Errorf("event should be restored and saved, archived=%v saves=%d", restored.IsArchived(), saveCount)
+	}
+}
+
 // =====================================================
 // GenerateBusinessDaysUsecase Tests
 // =====================================================
@@ -542,7 +831,7 @@ func TestGenerateBusinessDaysUsecase_Execute_Success(t *testing.T) {
 		},
 	}
 
-	usecase := appevent.NewGenerateBusinessDaysUsecase(eventRepo, bdRepo)
+	usecase := appevent.NewGenerateBusinessDaysUsecase(eventRepo, bdRepo, &MockTenantRepository{}, &MockBlackoutDateSetRepository{}, &MockClock{})
 
 	input := appevent.GenerateBusinessDaysInput{
 		TenantID: tenantID,
@@ -591,7 +880,7 @@ func TestGenerateBusinessDaysUsecase_Execute_DefaultMonths(t *testing.T) {
 		},
 	}
 
-	usecase := appevent.NewGenerateBusinessDaysUsecase(eventRepo, bdRepo)
+	usecase := appevent.NewGenerateBusinessDaysUsecase(eventRepo, bdRepo, &MockTenantRepository{}, &MockBlackoutDateSetRepository{}, &MockClock{})
 
 	// months=0 → デフォルト2ヶ月に設定される
 	input := appevent.GenerateBusinessDaysInput{
@@ -633,7 +922,7 @@ func TestGenerateBusinessDaysUsecase_Execute_MaxMonthsLimit(t *testing.T) {
 		},
 	}
 
-	usecase := appevent.NewGenerateBusinessDaysUsecase(eventRepo, bdRepo)
+	usecase := appevent.NewGenerateBusinessDaysUsecase(eventRepo, bdRepo, &MockTenantRepository{}, &MockBlackoutDateSetRepository{}, &MockClock{})
 
 	// months=30 → 24ヶ月に制限される
 	input := appevent.GenerateBusinessDaysInput{
@@ -676,7 +965,7 @@ func TestGenerateBusinessDaysUsecase_Execute_NegativeMonths(t *testing.T) {
 		},
 	}
 
-	usecase := appevent.NewGenerateBusinessDaysUsecase(eventRepo, bdRepo)
+	usecase := appevent.NewGenerateBusinessDaysUsecase(eventRepo, bdRepo, &MockTenantRepository{}, &MockBlackoutDateSetRepository{}, &MockClock{})
 
 	// months=-5 → デフォルト2ヶ月に設定される
 	input := appevent.GenerateBusinessDaysInput{
@@ -720,7 +1009,7 @@ func TestGenerateBusinessDaysUsecase_Execute_NoRecurrence(t *testing.T) {
 
 	bdRepo := &MockBusinessDayRepository{}
 
-	usecase := appevent.NewGenerateBusinessDaysUsecase(eventRepo, bdRepo)
+	usecase := appevent.NewGenerateBusinessDaysUsecase(eventRepo, bdRepo, &MockTenantRepository{}, &MockBlackoutDateSetRepository{}, &MockClock{})
 
 	input := appevent.GenerateBusinessDaysInput{
 		TenantID: tenantID,
@@ -742,3 +1031,305 @@ func TestGenerateBusinessDaysUsecase_Execute_NoRecurrence(t *testing.T) {
 		t.Errorf("Expected error code %s, got: %s", common.ErrInvalidInput, domainErr.Code())
 	}
 }
+
+func TestGenerateBusinessDaysUsecase_Execute_ErrorWhenArchived(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testEvent := createEventWithRecurrence(t, tenantID)
+	if err := testEvent.Archive(time.Now()); err != nil {
+		t.Fatalf("Archive() should succeed, got error: %v", err)
+	}
+
+	eventRepo := &MockEventRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) (*event.Event, error) {
+			return testEvent, nil
+		},
+	}
+	bdRepo := &MockBusinessDayRepository{
+		saveFunc: func(ctx context.Context, bd *event.EventBusinessDay) error {
+			t.Error("business days should not be saved for an archived event")
+			return nil
+		},
+	}
+
+	usecase := appevent.NewGenerateBusinessDaysUsecase(eventRepo, bdRepo, &MockTenantRepository{}, &MockBlackoutDateSetRepository{}, &MockClock{})
+
+	_, err := usecase.Execute(context.Background(), appevent.GenerateBusinessDaysInput{
+		TenantID: tenantID,
+		EventID:  testEvent.EventID(),
+	})
+
+	var domainErr *common.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code() != common.ErrConflict {
+		t.Errorf("Execute() should return Conflict for an archived event, got %v", err)
+	}
+}
+
+// =====================================================
+// RRULE-based generation Tests
+// =====================================================
+
+func TestCreateEventUsecase_Execute_GeneratesBusinessDaysFromRRule(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	clock := &MockClock{nowFunc: func() time.Time { return now }}
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	startTime := time.Date(0, 1, 1, 21, 0, 0, 0, time.UTC)
+	endTime := time.Date(0, 1, 1, 23, 0, 0, 0, time.UTC)
+
+	var saved []*event.EventBusinessDay
+	eventRepo := &MockEventRepository{}
+	bdRepo := &MockBusinessDayRepository{
+		saveFunc: func(ctx context.Context, bd *event.EventBusinessDay) error {
+			saved = append(saved, bd)
+			return nil
+		},
+	}
+
+	usecase := appevent.NewCreateEventUsecase(eventRepo, bdRepo, &MockTenantRepository{}, &MockBlackoutDateSetRepository{}, clock)
+
+	result, err := usecase.Execute(context.Background(), appevent.CreateEventInput{
+		TenantID:            tenantID,
+		EventName:           "月末金曜集会",
+		EventType:           event.EventTypeNormal,
+		RecurrenceType:      event.RecurrenceTypeRRule,
+		RecurrenceStartDate: &monthStart,
+		RecurrenceRule:      "FREQ=MONTHLY;BYDAY=-1FR",
+		DefaultStartTime:    &startTime,
+		DefaultEndTime:      &endTime,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if result.RecurrenceRule() == nil || !result.RecurrenceRule().DTStart().Equal(monthStart) {
+		t.Errorf("DTSTART should default to recurrence_start_date, got %v", result.RecurrenceRule())
+	}
+
+	// 今月と来月の最終金曜日
+	if len(saved) != 2 {
+		t.Fatalf("expected 2 business days, got %d", len(saved))
+	}
+	for i, bd := range saved {
+		d := bd.TargetDate()
+		if d.Weekday() != time.Friday || d.AddDate(0, 0, 7).Month() == d.Month() {
+			t.Errorf("business day %d should be the last Friday of a month, got %s", i, d.Format("2006-01-02 Mon"))
+		}
+		if d.Month() != monthStart.AddDate(0, i, 0).Month() {
+			t.Errorf("business day %d is in an unexpected month: %s", i, d.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestCreateEventUsecase_Execute_ErrorWhenRRuleInvalid(t *testing.T) {
+	usecase := appevent.NewCreateEventUsecase(&MockEventRepository{}, &MockBusinessDayRepository{}, &MockTenantRepository{}, &MockBlackoutDateSetRepository{}, &MockClock{})
+
+	_, err := usecase.Execute(context.Background(), appevent.CreateEventInput{
+		TenantID:       common.NewTenantID(),
+		EventName:      "集会",
+		EventType:      event.EventTypeNormal,
+		RecurrenceType: event.RecurrenceTypeRRule,
+		RecurrenceRule: "FREQ=MONTHLY;BYDAY=-1FR", // DTSTART も recurrence_start_date もない
+	})
+
+	domainErr, ok := err.(*common.DomainError)
+	if !ok || domainErr.Code() != common.ErrInvalidInput {
+		t.Errorf("expected validation error, got %v", err)
+	}
+}
+
+func TestGenerateBusinessDaysUsecase_Execute_RRuleWithExDate(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	clock := &MockClock{nowFunc: func() time.Time { return now }}
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	startTime := time.Date(0, 1, 1, 21, 0, 0, 0, time.UTC)
+	endTime := time.Date(0, 1, 1, 23, 0, 0, 0, time.UTC)
+
+	// 第2・第4土曜日（翌月の第2土曜日は除外、翌月1日を追加）
+	rule := mustRRule(t, monthStart, "FREQ=MONTHLY;BYDAY=SA;BYSETPOS=2,4")
+	nextMonth := monthStart.AddDate(0, 1, 0)
+	excluded := rule.Between(nextMonth, nextMonth.AddDate(0, 1, -1))[0]
+	rule = mustRRule(t, monthStart, rule.RRule()+
+		"\nEXDATE;VALUE=DATE:"+excluded.Format("20060102")+
+		"\nRDATE;VALUE=DATE:"+nextMonth.Format("20060102"))
+
+	testEvent, err := event.NewEventWithRecurrenceRule(now, tenantID, "土曜集会", event.EventTypeNormal, "", rule, &startTime, &endTime)
+	if err != nil {
+		t.Fatalf("NewEventWithRecurrenceRule() should succeed, got error: %v", err)
+	}
+
+	eventRepo := &MockEventRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) (*event.Event, error) {
+			return testEvent, nil
+		},
+	}
+	saved := map[string]bool{}
+	bdRepo := &MockBusinessDayRepository{
+		saveFunc: func(ctx context.Context, bd *event.EventBusinessDay) error {
+			saved[bd.TargetDate().Format("2006-01-02")] = true
+			return nil
+		},
+	}
+
+	result, err := appevent.NewGenerateBusinessDaysUsecase(eventRepo, bdRepo, &MockTenantRepository{}, &MockBlackoutDateSetRepository{}, clock).Execute(context.Background(), appevent.GenerateBusinessDaysInput{
+		TenantID: tenantID,
+		EventID:  testEvent.EventID(),
+		Months:   1,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	// 2ヶ月 × 2回 - EXDATE 1件 + RDATE 1件
+	if result.GeneratedCount != 4 {
+		t.Errorf("expected 4 business days, got %d (%v)", result.GeneratedCount, saved)
+	}
+	if saved[excluded.Format("2006-01-02")] {
+		t.Errorf("EXDATE %s should not be generated", excluded.Format("2006-01-02"))
+	}
+	if !saved[nextMonth.Format("2006-01-02")] {
+		t.Errorf("RDATE %s should be generated", nextMonth.Format("2006-01-02"))
+	}
+}
+
+// =====================================================
+// Tenant timezone Tests
+// =====================================================
+
+// createSaturdayEvent creates a weekly Saturday event starting on 2025-02-01
+func createSaturdayEvent(t *testing.T, tenantID common.TenantID) *event.Event {
+	t.Helper()
+	recurrenceStart := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	dayOfWeek := int(time.Saturday)
+	startTime := time.Date(0, 1, 1, 21, 0, 0, 0, time.UTC)
+	endTime := time.Date(0, 1, 1, 23, 0, 0, 0, time.UTC)
+
+	e, err := event.NewEvent(recurrenceStart, tenantID, "土曜集会", event.EventTypeNormal, "",
+		event.RecurrenceTypeWeekly, &recurrenceStart, &dayOfWeek, &startTime, &endTime)
+	if err != nil {
+		t.Fatalf("Failed to create event with recurrence: %v", err)
+	}
+	return e
+}
+
+func TestGenerateBusinessDaysUsecase_Execute_UsesTenantTimezoneForCurrentMonth(t *testing.T) {
+	// 2025-03-01 03:00 UTC は ニューヨークでは 2/28 22:00（EST）、東京では 3/1 12:00
+	now := time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		timezone  string
+		wantCount int
+		wantLast  string
+	}{
+		// 今月 = 2月 → 2/1〜3/31 の土曜日
+		{name: "US tenant", timezone: "America/New_York", wantCount: 9, wantLast: "2025-03-29"},
+		// 今月 = 3月 → 2/1〜4/30 の土曜日
+		{name: "JST tenant", timezone: "Asia/Tokyo", wantCount: 13, wantLast: "2025-04-26"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantID := common.NewTenantID()
+			testEvent := createSaturdayEvent(t, tenantID)
+
+			eventRepo := &MockEventRepository{
+				findByIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) (*event.Event, error) {
+					return testEvent, nil
+				},
+			}
+			var saved []*event.EventBusinessDay
+			bdRepo := &MockBusinessDayRepository{
+				saveFunc: func(ctx context.Context, bd *event.EventBusinessDay) error {
+					saved = append(saved, bd)
+					return nil
+				},
+			}
+
+			usecase := appevent.NewGenerateBusinessDaysUsecase(eventRepo, bdRepo,
+				&MockTenantRepository{timezone: tt.timezone}, &MockBlackoutDateSetRepository{},
+				&MockClock{nowFunc: func() time.Time { return now }})
+
+			result, err := usecase.Execute(context.Background(), appevent.GenerateBusinessDaysInput{
+				TenantID: tenantID,
+				EventID:  testEvent.EventID(),
+				Months:   1,
+			})
+			if err != nil {
+				t.Fatalf("Execute() should succeed, got error: %v", err)
+			}
+
+			if result.GeneratedCount != tt.wantCount {
+				t.Errorf("GeneratedCount = %d, want %d", result.GeneratedCount, tt.wantCount)
+			}
+			if len(saved) > 0 {
+				if got := saved[len(saved)-1].TargetDate().Format("2006-01-02"); got != tt.wantLast {
+					t.Errorf("last business day = %s, want %s", got, tt.wantLast)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateBusinessDaysUsecase_Execute_USTenantAcrossDST(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testEvent := createSaturdayEvent(t, tenantID)
+	// ニューヨークの 2025-03-09（日）2:00 に夏時間開始、2025-11-02（日）2:00 に夏時間終了
+	now := time.Date(2025, 2, 15, 17, 0, 0, 0, time.UTC)
+
+	eventRepo := &MockEventRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) (*event.Event, error) {
+			return testEvent, nil
+		},
+	}
+	var saved []*event.EventBusinessDay
+	bdRepo := &MockBusinessDayRepository{
+		saveFunc: func(ctx context.Context, bd *event.EventBusinessDay) error {
+			saved = append(saved, bd)
+			return nil
+		},
+	}
+
+	usecase := appevent.NewGenerateBusinessDaysUsecase(eventRepo, bdRepo,
+		&MockTenantRepository{timezone: "America/New_York"}, &MockBlackoutDateSetRepository{},
+		&MockClock{nowFunc: func() time.Time { return now }})
+
+	// 2月〜11月末
+	result, err := usecase.Execute(context.Background(), appevent.GenerateBusinessDaysInput{
+		TenantID: tenantID,
+		EventID:  testEvent.EventID(),
+		Months:   9,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	// 2025-02-01〜2025-11-29 の土曜日
+	if result.GeneratedCount != 44 {
+		t.Errorf("GeneratedCount = %d, want 44", result.GeneratedCount)
+	}
+
+	dates := map[string]bool{}
+	for _, bd := range saved {
+		d := bd.TargetDate()
+		dates[d.Format("2006-01-02")] = true
+		// 日付は DST に関係なく 0 時・土曜日のまま（1時間ずれて金曜/日曜にならない）
+		if d.Weekday() != time.Saturday || d.Hour() != 0 {
+			t.Errorf("business day should be a Saturday date, got %s", d.Format(time.RFC3339))
+		}
+	}
+	for _, want := range []string{"2025-03-08", "2025-03-15", "2025-11-01", "2025-11-08"} {
+		if !dates[want] {
+			t.Errorf("business day %s around DST transition should be generated", want)
+		}
+	}
+}
+
+func mustRRule(t *testing.T, dtStart time.Time, text string) *event.RecurrenceRule {
+	t.Helper()
+	rule, err := event.ParseRecurrenceRule(text, &dtStart)
+	if err != nil {
+		t.Fatalf("ParseRecurrenceRule() should succeed, got error: %v", err)
+	}
+	return rule
+}
@@ -0,0 +1,302 @@
+package event
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
+)
+
+// SyncTemplateToBusinessDaysInput represents the input for re-applying the latest template to future business days
+type SyncTemplateToBusinessDaysInput struct {
+	TenantID   common.TenantID
+	EventID    common.EventID
+	TemplateID common.ShiftSlotTemplateID
+	DryRun     bool // true の場合は差分の算出のみで保存しない
+}
+
+// TemplateSlotDefinition represents the definition of a shift slot before or after the change
+type TemplateSlotDefinition struct {
+	StartTime        time.Time
+	EndTime          time.Time
+	DayOffset        int
+	RequiredCount    int
+	Priority         int
+	RoleRequirements []shift.RoleRequirement
+}
+
+// TemplateSyncSlotChange represents a change applied to a shift slot of a business day
+type TemplateSyncSlotChange struct {
+	Type          shift.TemplateSlotChangeType
+	SlotID        shift.SlotID // add の場合は作成した枠の ID（DryRun の場合は空）
+	SlotName      string
+	InstanceName  string
+	Before        *TemplateSlotDefinition // add の場合は nil
+	After         *TemplateSlotDefinition // remove の場合は nil
+	AssignedCount int                     // 変更前の枠に確定済みの割り当て数
+	Skipped       bool                    // 割り当てがあるため削除・更新しなかった枠（remove・update）
+}
+
+// TemplateSyncBusinessDay represents the changes applied to a single business day
+type TemplateSyncBusinessDay struct {
+	BusinessDay     *event.EventBusinessDay
+	PreviousVersion int
+	Changes         []TemplateSyncSlotChange
+}
+
+// SyncTemplateToBusinessDaysOutput represents the output of re-applying the template
+type SyncTemplateToBusinessDaysOutput struct {
+	TemplateID   common.ShiftSlotTemplateID
+	Version      int
+	BusinessDays []TemplateSyncBusinessDay
+}
+
+// SyncTemplateToBusinessDaysUsecase brings the slots of future business days up to the latest version of a template
+//
+// 対象は、このテンプレートの古いバージョンから枠を生成した、開始前かつ中止されていない営業日
+// 枠の対応付けは shift.DiffTemplateSlots に従い、更新する枠は作り直さないため割り当ては保持される
+// 割り当てがある枠は削除せずに残し、Skipped として返す（DeleteShiftSlotUsecase と同じ扱い）
+// 割り当てがある枠の時刻・ロール要件の変更、割り当て数を下回る必要人数への変更も適用せず Skipped として返す
+type SyncTemplateToBusinessDaysUsecase struct {
+	businessDayRepo event.EventBusinessDayRepository
+	templateRepo    shift.ShiftSlotTemplateRepository
+	slotRepo        shift.ShiftSlotRepository
+	assignmentRepo  shift.ShiftAssignmentRepository
+	tenantRepo      tenant.TenantRepository
+	applyTemplate   *ApplyTemplateUsecase
+	txManager       services.TxManager
+	clock           services.Clock
+}
+
+// NewSyncTemplateToBusinessDaysUsecase creates a new SyncTemplateToBusinessDaysUsecase
+func NewSyncTemplateToBusinessDaysUsecase(
+	businessDayRepo event.EventBusinessDayRepository,
+	templateRepo shift.ShiftSlotTemplateRepository,
+	slotRepo shift.ShiftSlotRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	instanceRepo shift.InstanceRepository,
+	tenantRepo tenant.TenantRepository,
+	txManager services.TxManager,
+	clock services.Clock,
+) *SyncTemplateToBusinessDaysUsecase {
+	return &SyncTemplateToBusinessDaysUsecase{
+		businessDayRepo: businessDayRepo,
+		templateRepo:    templateRepo,
+		slotRepo:        slotRepo,
+		assignmentRepo:  assignmentRepo,
+		tenantRepo:      tenantRepo,
+		applyTemplate:   NewApplyTemplateUsecase(businessDayRepo, templateRepo, slotRepo, instanceRepo, txManager),
+		txManager:       txManager,
+		clock:           clock,
+	}
+}
+
+// Execute re-applies the template to the outdated future business days in a single transaction
+func (uc *SyncTemplateToBusinessDaysUsecase) Execute(ctx context.Context, input SyncTemplateToBusinessDaysInput) (*SyncTemplateToBusinessDaysOutput, error) {
+	template, err := uc.templateRepo.FindByID(ctx, input.TenantID, input.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+	if template.EventID() != input.EventID {
+		return nil, common.NewNotFoundError("ShiftSlotTemplate", input.TemplateID.String())
+	}
+
+	loc, err := tenant.ResolveLocation(ctx, uc.tenantRepo, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	businessDays, err := uc.findTargets(ctx, input, template, loc, now)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &SyncTemplateToBusinessDaysOutput{
+		TemplateID: template.TemplateID(),
+		Version:    template.Version(),
+	}
+
+	apply := func(txCtx context.Context) error {
+		instanceCache := make(map[string]*shift.Instance)
+		displayOrderCounter := 0
+		for _, bd := range businessDays {
+			synced, err := uc.syncBusinessDay(txCtx, bd, template, input.DryRun, instanceCache, &displayOrderCounter, now)
+			if err != nil {
+				return err
+			}
+			output.BusinessDays = append(output.BusinessDays, *synced)
+		}
+		return nil
+	}
+	if input.DryRun {
+		err = apply(ctx)
+	} else {
+		err = uc.txManager.WithTx(ctx, apply)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findTargets returns the future business days of the event generated from an older version of the template
+func (uc *SyncTemplateToBusinessDaysUsecase) findTargets(
+	ctx context.Context,
+	input SyncTemplateToBusinessDaysInput,
+	template *shift.ShiftSlotTemplate,
+	loc *time.Location,
+	now time.Time,
+) ([]*event.EventBusinessDay, error) {
+	found, err := uc.businessDayRepo.FindByEventID(ctx, input.TenantID, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*event.EventBusinessDay
+	for _, bd := range found {
+		if bd.IsDeleted() || bd.IsCancelled() || !bd.StartAt(loc).After(now) {
+			continue
+		}
+		applied := bd.AppliedTemplate()
+		if applied == nil || applied.TemplateID != template.TemplateID() || applied.Version >= template.Version() {
+			continue
+		}
+		result = append(result, bd)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].StartAt(loc).Before(result[j].StartAt(loc))
+	})
+	return result, nil
+}
+
+// syncBusinessDay applies the template diff to a single business day and records the new version
+func (uc *SyncTemplateToBusinessDaysUsecase) syncBusinessDay(
+	ctx context.Context,
+	bd *event.EventBusinessDay,
+	template *shift.ShiftSlotTemplate,
+	dryRun bool,
+	instanceCache map[string]*shift.Instance,
+	displayOrderCounter *int,
+	now time.Time,
+) (*TemplateSyncBusinessDay, error) {
+	result := &TemplateSyncBusinessDay{
+		BusinessDay:     bd,
+		PreviousVersion: bd.AppliedTemplate().Version,
+	}
+
+	slots, err := uc.slotRepo.FindByBusinessDayID(ctx, bd.TenantID(), bd.BusinessDayID())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, change := range shift.DiffTemplateSlots(template, slots) {
+		synced := TemplateSyncSlotChange{Type: change.Type}
+
+		switch change.Type {
+		case shift.TemplateSlotChangeAdd:
+			synced.SlotName = change.Item.SlotName()
+			synced.InstanceName = change.Item.InstanceName()
+			synced.After = itemDefinition(change.Item, bd)
+			if !dryRun {
+				slot, err := uc.applyTemplate.createShiftSlotFromItem(ctx, bd, template, change.Item, instanceCache, displayOrderCounter)
+				if err != nil {
+					return nil, err
+				}
+				synced.SlotID = slot.SlotID()
+			}
+
+		case shift.TemplateSlotChangeUpdate:
+			slot := change.Slot
+			synced.SlotID = slot.SlotID()
+			synced.SlotName = slot.SlotName()
+			synced.InstanceName = slot.InstanceName()
+			synced.Before = slotDefinition(slot)
+			if synced.AssignedCount, err = uc.assignmentRepo.CountConfirmedBySlotID(ctx, bd.TenantID(), slot.SlotID()); err != nil {
+				return nil, err
+			}
+			// 割り当て済みのメンバーに影響する変更は適用しない（メンバーへの連絡・調整は管理者が行う）
+			if synced.AssignedCount > 0 &&
+				(change.Item.RequiredCount() < synced.AssignedCount || slot.ChangesTimesOrRolesFrom(change.Item)) {
+				synced.After = itemDefinition(change.Item, bd)
+				synced.Skipped = true
+				break
+			}
+			if err := slot.ApplyTemplateItem(now, change.Item); err != nil {
+				return nil, err
+			}
+			slot.AlignToBusinessDay(now, bd)
+			synced.After = slotDefinition(slot)
+			if !dryRun {
+				if err := uc.slotRepo.Save(ctx, slot); err != nil {
+					return nil, err
+				}
+			}
+
+		case shift.TemplateSlotChangeRemove:
+			slot := change.Slot
+			synced.SlotID = slot.SlotID()
+			synced.SlotName = slot.SlotName()
+			synced.InstanceName = slot.InstanceName()
+			synced.Before = slotDefinition(slot)
+			if synced.AssignedCount, err = uc.assignmentRepo.CountConfirmedBySlotID(ctx, bd.TenantID(), slot.SlotID()); err != nil {
+				return nil, err
+			}
+			// 割り当てがある枠は削除しない（メンバーへの連絡・調整は管理者が行う）
+			if synced.AssignedCount > 0 {
+				synced.Skipped = true
+				break
+			}
+			slot.Delete(now)
+			if !dryRun {
+				if err := uc.slotRepo.Save(ctx, slot); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		result.Changes = append(result.Changes, synced)
+	}
+
+	if err := bd.RecordAppliedTemplate(now, template.TemplateID(), template.Version()); err != nil {
+		return nil, err
+	}
+	if !dryRun {
+		if err := uc.businessDayRepo.Save(ctx, bd); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// slotDefinition returns the current definition of the slot
+func slotDefinition(slot *shift.ShiftSlot) *TemplateSlotDefinition {
+	return &TemplateSlotDefinition{
+		StartTime:        slot.StartTime(),
+		EndTime:          slot.EndTime(),
+		DayOffset:        slot.DayOffset(),
+		RequiredCount:    slot.RequiredCount(),
+		Priority:         slot.Priority(),
+		RoleRequirements: slot.RoleRequirements(),
+	}
+}
+
+// itemDefinition returns the definition of a slot generated from the item on the business day
+func itemDefinition(item *shift.ShiftSlotTemplateItem, bd *event.EventBusinessDay) *TemplateSlotDefinition {
+	return &TemplateSlotDefinition{
+		StartTime:        item.StartTime(),
+		EndTime:          item.EndTime(),
+		DayOffset:        bd.DayOffsetFor(item.StartTime()),
+		RequiredCount:    item.RequiredCount(),
+		Priority:         item.Priority(),
+		RoleRequirements: item.RoleRequirements(),
+	}
+}
@@ -0,0 +1,398 @@
+package event_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appevent "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// =====================================================
+// SyncTemplateToBusinessDaysUsecase Tests
+// =====================================================
+
+func createTemplateItem(t *testing.T, templateID common.ShiftSlotTemplateID, name string, startHour, endHour, count int) *shift.ShiftSlotTemplateItem {
+	t.Helper()
+	item, err := shift.NewShiftSlotTemplateItem(time.Now(), templateID, name, "",
+		time.Date(2000, 1, 1, startHour, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, endHour, 0, 0, 0, time.UTC), count, 1)
+	if err != nil {
+		t.Fatalf("Failed to create template item: %v", err)
+	}
+	return item
+}
+
+func createTemplateSlot(t *testing.T, bd *event.EventBusinessDay, templateID *common.ShiftSlotTemplateID, name string, startHour, endHour, count int) *shift.ShiftSlot {
+	t.Helper()
+	slot, err := shift.NewShiftSlot(time.Now(), bd.TenantID(), bd.BusinessDayID(), nil, name, "",
+		time.Date(2000, 1, 1, startHour, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, endHour, 0, 0, 0, time.UTC), count, 1)
+	if err != nil {
+		t.Fatalf("Failed to create slot: %v", err)
+	}
+	if templateID != nil {
+		slot.MarkFromTemplate(time.Now(), *templateID)
+	}
+	return slot
+}
+
+func TestSyncTemplateToBusinessDaysUsecase_Execute_AppliesDiffAndKeepsAssignments(t *testing.T) {
+	tenantID := common.NewTenantID()
+	eventID := common.NewEventID()
+	templateID := common.NewShiftSlotTemplateID()
+	now := time.Now()
+
+	template, err := shift.ReconstructShiftSlotTemplate(templateID, tenantID, eventID, "定期営業", "",
+		[]*shift.ShiftSlotTemplateItem{
+			createTemplateItem(t, templateID, "受付", 21, 22, 2),
+			createTemplateItem(t, templateID, "写真", 21, 23, 1),
+		},
+		2, now, now, nil)
+	if err != nil {
+		t.Fatalf("Failed to create template: %v", err)
+	}
+
+	bd := createBusinessDay(t, tenantID, eventID, "2025-02-01")
+	if err := bd.RecordAppliedTemplate(now, templateID, 1); err != nil {
+		t.Fatalf("Failed to record applied template: %v", err)
+	}
+	reception := createTemplateSlot(t, bd, &templateID, "受付", 21, 22, 1) // テンプレートで人数が変わった枠（割り当てあり）
+	teardown := createTemplateSlot(t, bd, &templateID, "撤去", 22, 23, 1)  // テンプレートから削除された枠（割り当てなし）
+	cleanup := createTemplateSlot(t, bd, &templateID, "片付け", 22, 23, 1)  // テンプレートから削除された枠（割り当てあり）
+	manual := createTemplateSlot(t, bd, nil, "臨時", 21, 23, 1)            // 手動で追加した枠
+
+	// 対象外: 開始済みの営業日・最新バージョンの営業日・中止された営業日
+	past := createBusinessDay(t, tenantID, eventID, "2025-01-10")
+	latest := createBusinessDay(t, tenantID, eventID, "2025-02-08")
+	cancelled := createBusinessDay(t, tenantID, eventID, "2025-02-15")
+	for _, other := range []*event.EventBusinessDay{past, cancelled} {
+		if err := other.RecordAppliedTemplate(now, templateID, 1); err != nil {
+			t.Fatalf("Failed to record applied template: %v", err)
+		}
+	}
+	if err := latest.RecordAppliedTemplate(now, templateID, 2); err != nil {
+		t.Fatalf("Failed to record applied template: %v", err)
+	}
+	if err := cancelled.Cancel(now, "", nil); err != nil {
+		t.Fatalf("Failed to cancel business day: %v", err)
+	}
+
+	var savedDays []*event.EventBusinessDay
+	bdRepo := &MockBusinessDayRepository{
+		saveFunc: func(ctx context.Context, saved *event.EventBusinessDay) error {
+			savedDays = append(savedDays, saved)
+			return nil
+		},
+		findByEventIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) ([]*event.EventBusinessDay, error) {
+			return []*event.EventBusinessDay{bd, past, latest, cancelled}, nil
+		},
+	}
+	templateRepo := &MockShiftSlotTemplateRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id common.ShiftSlotTemplateID) (*shift.ShiftSlotTemplate, error) {
+			return template, nil
+		},
+	}
+	var savedSlots []*shift.ShiftSlot
+	slotRepo := &MockShiftSlotRepository{
+		saveFunc: func(ctx context.Context, slot *shift.ShiftSlot) error {
+			savedSlots = append(savedSlots, slot)
+			return nil
+		},
+		findByBusinessDayIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+			if id != bd.BusinessDayID() {
+				t.Errorf("unexpected business day %s", id)
+				return nil, nil
+			}
+			return []*shift.ShiftSlot{reception, teardown, cleanup, manual}, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		countConfirmedBySlotIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (int, error) {
+			if slotID == cleanup.SlotID() || slotID == reception.SlotID() {
+				return 1, nil
+			}
+			return 0, nil
+		},
+	}
+	clock := &MockClock{nowFunc: func() time.Time {
+		return time.Date(2025, 1, 20, 12, 0, 0, 0, time.UTC)
+	}}
+	usecase := appevent.NewSyncTemplateToBusinessDaysUsecase(bdRepo, templateRepo, slotRepo, assignmentRepo,
+		&MockInstanceRepository{}, &MockTenantRepository{}, &MockTxManager{}, clock)
+
+	output, err := usecase.Execute(context.Background(), appevent.SyncTemplateToBusinessDaysInput{
+		TenantID:   tenantID,
+		EventID:    eventID,
+		TemplateID: templateID,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if output.Version != 2 || len(output.BusinessDays) != 1 {
+		t.Fatalf("expected 1 business day synced to version 2, got version %d and %d days", output.Version, len(output.BusinessDays))
+	}
+	synced := output.BusinessDays[0]
+	if synced.BusinessDay != bd || synced.PreviousVersion != 1 {
+		t.Errorf("unexpected synced business day: %+v", synced)
+	}
+
+	changes := make(map[string]appevent.TemplateSyncSlotChange, len(synced.Changes))
+	for _, c := range synced.Changes {
+		changes[c.SlotName] = c
+	}
+	if len(changes) != 4 {
+		t.Fatalf("expected 4 changes, got %+v", synced.Changes)
+	}
+	if c := changes["受付"]; c.Type != shift.TemplateSlotChangeUpdate || c.SlotID != reception.SlotID() || c.Skipped ||
+		c.Before.RequiredCount != 1 || c.After.RequiredCount != 2 || c.AssignedCount != 1 {
+		t.Errorf("受付 should be updated in place keeping its assignment: %+v", c)
+	}
+	if c := changes["写真"]; c.Type != shift.TemplateSlotChangeAdd || c.SlotID == "" || c.Before != nil {
+		t.Errorf("写真 should be added: %+v", c)
+	}
+	if c := changes["撤去"]; c.Type != shift.TemplateSlotChangeRemove || c.Skipped || !teardown.IsDeleted() {
+		t.Errorf("撤去 should be removed: %+v", c)
+	}
+	if c := changes["片付け"]; c.Type != shift.TemplateSlotChangeRemove || !c.Skipped || cleanup.IsDeleted() {
+		t.Errorf("片付け should be kept because it has an assignment: %+v", c)
+	}
+	if manual.IsDeleted() {
+		t.Error("manually added slot should be kept")
+	}
+
+	// 受付（更新）・写真（追加）・撤去（削除）を保存する
+	if len(savedSlots) != 3 {
+		t.Errorf("saved %d slots, want 3", len(savedSlots))
+	}
+	if reception.RequiredCount() != 2 {
+		t.Errorf("受付 required_count = %d, want 2", reception.RequiredCount())
+	}
+	if len(savedDays) != 1 || bd.AppliedTemplate().Version != 2 {
+		t.Errorf("business day should be saved with version 2, saved %d days", len(savedDays))
+	}
+}
+
+func TestSyncTemplateToBusinessDaysUsecase_Execute_SkipsUpdatesAffectingAssignments(t *testing.T) {
+	roleID := common.NewRoleID()
+
+	tests := []struct {
+		name          string
+		startHour     int
+		endHour       int
+		requiredCount int
+		requiredRole  bool
+		assignedCount int
+		wantSkipped   bool
+	}{
+		{name: "必要人数を割り当て数未満に減らす", startHour: 21, endHour: 22, requiredCount: 1, assignedCount: 2, wantSkipped: true},
+		{name: "必要人数を割り当て数まで減らす", startHour: 21, endHour: 22, requiredCount: 2, assignedCount: 2, wantSkipped: false},
+		{name: "割り当てがある枠の時刻を変える", startHour: 22, endHour: 23, requiredCount: 3, assignedCount: 1, wantSkipped: true},
+		{name: "割り当てがある枠のロール要件を変える", startHour: 21, endHour: 22, requiredCount: 3, requiredRole: true, assignedCount: 1, wantSkipped: true},
+		{name: "割り当てがない枠の時刻・ロール要件を変える", startHour: 22, endHour: 23, requiredCount: 1, requiredRole: true, assignedCount: 0, wantSkipped: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantID := common.NewTenantID()
+			eventID := common.NewEventID()
+			templateID := common.NewShiftSlotTemplateID()
+			now := time.Now()
+
+			item := createTemplateItem(t, templateID, "受付", tt.startHour, tt.endHour, tt.requiredCount)
+			if tt.requiredRole {
+				req, err := shift.NewRoleRequirement(roleID, shift.RoleRequirementRequired, 1)
+				if err != nil {
+					t.Fatalf("Failed to create role requirement: %v", err)
+				}
+				if err := item.SetRoleRequirements(now, []shift.RoleRequirement{req}); err != nil {
+					t.Fatalf("Failed to set role requirements: %v", err)
+				}
+			}
+			template, err := shift.ReconstructShiftSlotTemplate(templateID, tenantID, eventID, "定期営業", "",
+				[]*shift.ShiftSlotTemplateItem{item}, 2, now, now, nil)
+			if err != nil {
+				t.Fatalf("Failed to create template: %v", err)
+			}
+
+			bd := createBusinessDay(t, tenantID, eventID, "2025-02-01")
+			if err := bd.RecordAppliedTemplate(now, templateID, 1); err != nil {
+				t.Fatalf("Failed to record applied template: %v", err)
+			}
+			slot := createTemplateSlot(t, bd, &templateID, "受付", 21, 22, 3)
+
+			bdRepo := &MockBusinessDayRepository{
+				findByEventIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) ([]*event.EventBusinessDay, error) {
+					return []*event.EventBusinessDay{bd}, nil
+				},
+			}
+			templateRepo := &MockShiftSlotTemplateRepository{
+				findByIDFunc: func(ctx context.Context, tid common.TenantID, id common.ShiftSlotTemplateID) (*shift.ShiftSlotTemplate, error) {
+					return template, nil
+				},
+			}
+			var savedSlots []*shift.ShiftSlot
+			slotRepo := &MockShiftSlotRepository{
+				saveFunc: func(ctx context.Context, s *shift.ShiftSlot) error {
+					savedSlots = append(savedSlots, s)
+					return nil
+				},
+				findByBusinessDayIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+					return []*shift.ShiftSlot{slot}, nil
+				},
+			}
+			assignmentRepo := &MockShiftAssignmentRepository{
+				countConfirmedBySlotIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (int, error) {
+					return tt.assignedCount, nil
+				},
+			}
+			clock := &MockClock{nowFunc: func() time.Time {
+				return time.Date(2025, 1, 20, 12, 0, 0, 0, time.UTC)
+			}}
+			usecase := appevent.NewSyncTemplateToBusinessDaysUsecase(bdRepo, templateRepo, slotRepo, assignmentRepo,
+				&MockInstanceRepository{}, &MockTenantRepository{}, &MockTxManager{}, clock)
+
+			output, err := usecase.Execute(context.Background(), appevent.SyncTemplateToBusinessDaysInput{
+				TenantID:   tenantID,
+				EventID:    eventID,
+				TemplateID: templateID,
+			})
+			if err != nil {
+				t.Fatalf("Execute() should succeed, got error: %v", err)
+			}
+
+			if len(output.BusinessDays) != 1 || len(output.BusinessDays[0].Changes) != 1 {
+				t.Fatalf("expected 1 change, got %+v", output.BusinessDays)
+			}
+			c := output.BusinessDays[0].Changes[0]
+			if c.Type != shift.TemplateSlotChangeUpdate || c.Skipped != tt.wantSkipped || c.AssignedCount != tt.assignedCount {
+				t.Errorf("unexpected change: %+v", c)
+			}
+			if c.After == nil || c.After.RequiredCount != tt.requiredCount {
+				t.Errorf("After should be the template definition: %+v", c.After)
+			}
+
+			if tt.wantSkipped {
+				if len(savedSlots) != 0 {
+					t.Errorf("saved %d slots, want 0", len(savedSlots))
+				}
+				if slot.RequiredCount() != 3 || slot.StartTimeString() != "21:00" || slot.HasRoleRequirements() {
+					t.Errorf("slot should not change: required_count = %d, start = %s", slot.RequiredCount(), slot.StartTimeString())
+				}
+			} else {
+				if len(savedSlots) != 1 {
+					t.Errorf("saved %d slots, want 1", len(savedSlots))
+				}
+				if slot.RequiredCount() != tt.requiredCount || slot.StartTime().Hour() != tt.startHour {
+					t.Errorf("slot should be updated: required_count = %d, start = %s", slot.RequiredCount(), slot.StartTimeString())
+				}
+			}
+			// 適用しなかった変更があっても営業日は最新バージョンとして記録する
+			if bd.AppliedTemplate().Version != 2 {
+				t.Errorf("applied version = %d, want 2", bd.AppliedTemplate().Version)
+			}
+		})
+	}
+}
+
+func TestSyncTemplateToBusinessDaysUsecase_Execute_DryRunDoesNotSave(t *testing.T) {
+	tenantID := common.NewTenantID()
+	eventID := common.NewEventID()
+	templateID := common.NewShiftSlotTemplateID()
+	now := time.Now()
+
+	template, err := shift.ReconstructShiftSlotTemplate(templateID, tenantID, eventID, "定期営業", "",
+		[]*shift.ShiftSlotTemplateItem{
+			createTemplateItem(t, templateID, "受付", 21, 22, 2),
+			createTemplateItem(t, templateID, "写真", 21, 23, 1),
+		},
+		2, now, now, nil)
+	if err != nil {
+		t.Fatalf("Failed to create template: %v", err)
+	}
+	bd := createBusinessDay(t, tenantID, eventID, "2025-02-01")
+	if err := bd.RecordAppliedTemplate(now, templateID, 1); err != nil {
+		t.Fatalf("Failed to record applied template: %v", err)
+	}
+	reception := createTemplateSlot(t, bd, &templateID, "受付", 21, 22, 1)
+	teardown := createTemplateSlot(t, bd, &templateID, "撤去", 22, 23, 1)
+
+	bdRepo := &MockBusinessDayRepository{
+		saveFunc: func(ctx context.Context, saved *event.EventBusinessDay) error {
+			t.Error("business day should not be saved on dry run")
+			return nil
+		},
+		findByEventIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) ([]*event.EventBusinessDay, error) {
+			return []*event.EventBusinessDay{bd}, nil
+		},
+	}
+	templateRepo := &MockShiftSlotTemplateRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id common.ShiftSlotTemplateID) (*shift.ShiftSlotTemplate, error) {
+			return template, nil
+		},
+	}
+	slotRepo := &MockShiftSlotRepository{
+		saveFunc: func(ctx context.Context, slot *shift.ShiftSlot) error {
+			t.Error("slot should not be saved on dry run")
+			return nil
+		},
+		findByBusinessDayIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+			return []*shift.ShiftSlot{reception, teardown}, nil
+		},
+	}
+	clock := &MockClock{nowFunc: func() time.Time {
+		return time.Date(2025, 1, 20, 12, 0, 0, 0, time.UTC)
+	}}
+	usecase := appevent.NewSyncTemplateToBusinessDaysUsecase(bdRepo, templateRepo, slotRepo, &MockShiftAssignmentRepository{},
+		&MockInstanceRepository{}, &MockTenantRepository{}, &MockTxManager{}, clock)
+
+	output, err := usecase.Execute(context.Background(), appevent.SyncTemplateToBusinessDaysInput{
+		TenantID:   tenantID,
+		EventID:    eventID,
+		TemplateID: templateID,
+		DryRun:     true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if len(output.BusinessDays) != 1 || len(output.BusinessDays[0].Changes) != 3 {
+		t.Fatalf("dry run should report the changes, got %+v", output.BusinessDays)
+	}
+	for _, c := range output.BusinessDays[0].Changes {
+		if c.Type == shift.TemplateSlotChangeAdd && c.SlotID != "" {
+			t.Errorf("added slot should not have an ID on dry run: %+v", c)
+		}
+	}
+}
+
+func TestSyncTemplateToBusinessDaysUsecase_Execute_ErrorWhenTemplateOfOtherEvent(t *testing.T) {
+	tenantID := common.NewTenantID()
+	templateID := common.NewShiftSlotTemplateID()
+	now := time.Now()
+
+	template, err := shift.ReconstructShiftSlotTemplate(templateID, tenantID, common.NewEventID(), "定期営業", "",
+		[]*shift.ShiftSlotTemplateItem{createTemplateItem(t, templateID, "受付", 21, 22, 1)},
+		2, now, now, nil)
+	if err != nil {
+		t.Fatalf("Failed to create template: %v", err)
+	}
+	templateRepo := &MockShiftSlotTemplateRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id common.ShiftSlotTemplateID) (*shift.ShiftSlotTemplate, error) {
+			return template, nil
+		},
+	}
+	usecase := appevent.NewSyncTemplateToBusinessDaysUsecase(&MockBusinessDayRepository{}, templateRepo, &MockShiftSlotRepository{},
+		&MockShiftAssignmentRepository{}, &MockInstanceRepository{}, &MockTenantRepository{}, &MockTxManager{}, &MockClock{})
+
+	_, err = usecase.Execute(context.Background(), appevent.SyncTemplateToBusinessDaysInput{
+		TenantID:   tenantID,
+		EventID:    common.NewEventID(),
+		TemplateID: templateID,
+	})
+	if !common.IsNotFoundError(err) {
+		t.Errorf("Execute() should return NotFound, got %v", err)
+	}
+}
@@ -0,0 +1,224 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
+)
+
+// 営業日の自動生成（バッチ）の定数
+const (
+	DefaultUpcomingBusinessDayWeeks = 8   // デフォルトの生成期間（週）
+	MaxUpcomingBusinessDayWeeks     = 104 // 最大の生成期間（週）
+)
+
+// GenerateUpcomingBusinessDaysInput represents the input for generating upcoming business days of a tenant
+type GenerateUpcomingBusinessDaysInput struct {
+	TenantID common.TenantID
+	Now      time.Time // 基準時刻（テナントのタイムゾーンで「今日」を判定する）
+	Weeks    int       // 今日から何週間先まで生成するか（デフォルト8、最大104）
+	DryRun   bool      // true の場合は件数の算出のみで保存しない
+}
+
+// UpcomingBusinessDayEventResult represents the generation result of a single event
+type UpcomingBusinessDayEventResult struct {
+	EventID        common.EventID
+	EventName      string
+	GeneratedCount int   // 作成した（DryRun の場合は作成する）営業日の件数
+	SlotCount      int   // デフォルトテンプレートから作成したシフト枠の件数
+	Err            error // 生成に失敗した場合のエラー（他のイベントの処理は継続する）
+}
+
+// GenerateUpcomingBusinessDaysOutput represents the output of generating upcoming business days of a tenant
+type GenerateUpcomingBusinessDaysOutput struct {
+	Until          time.Time // 生成対象の最終日（テナントのタイムゾーンでの日付）
+	Events         []UpcomingBusinessDayEventResult
+	GeneratedCount int
+	SlotCount      int
+	FailedCount    int
+}
+
+// GenerateUpcomingBusinessDaysUsecase keeps the active recurring events of a tenant populated with business days
+// 今日から Weeks 週間先までの未登録の開催日を作成し、イベントのデフォルトテンプレートからシフト枠を作成する
+type GenerateUpcomingBusinessDaysUsecase struct {
+	eventRepo       event.EventRepository
+	businessDayRepo event.EventBusinessDayRepository
+	tenantRepo      tenant.TenantRepository
+	blackoutRepo    event.BlackoutDateSetRepository
+	templateRepo    shift.ShiftSlotTemplateRepository
+	applyTemplate   *ApplyTemplateUsecase
+	txManager       services.TxManager
+}
+
+// NewGenerateUpcomingBusinessDaysUsecase creates a new GenerateUpcomingBusinessDaysUsecase
+func NewGenerateUpcomingBusinessDaysUsecase(
+	eventRepo event.EventRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	tenantRepo tenant.TenantRepository,
+	blackoutRepo event.BlackoutDateSetRepository,
+	templateRepo shift.ShiftSlotTemplateRepository,
+	slotRepo shift.ShiftSlotRepository,
+	instanceRepo shift.InstanceRepository,
+	txManager services.TxManager,
+) *GenerateUpcomingBusinessDaysUsecase {
+	return &GenerateUpcomingBusinessDaysUsecase{
+		eventRepo:       eventRepo,
+		businessDayRepo: businessDayRepo,
+		tenantRepo:      tenantRepo,
+		blackoutRepo:    blackoutRepo,
+		templateRepo:    templateRepo,
+		applyTemplate:   NewApplyTemplateUsecase(businessDayRepo, templateRepo, slotRepo, instanceRepo, txManager),
+		txManager:       txManager,
+	}
+}
+
+// Execute generates the upcoming business days of all active recurring events of the tenant
+func (uc *GenerateUpcomingBusinessDaysUsecase) Execute(ctx context.Context, input GenerateUpcomingBusinessDaysInput) (*GenerateUpcomingBusinessDaysOutput, error) {
+	weeks := input.Weeks
+	if weeks <= 0 {
+		weeks = DefaultUpcomingBusinessDayWeeks
+	}
+	if weeks > MaxUpcomingBusinessDayWeeks {
+		weeks = MaxUpcomingBusinessDayWeeks
+	}
+
+	today, err := tenantToday(ctx, uc.tenantRepo, input.TenantID, input.Now)
+	if err != nil {
+		return nil, err
+	}
+	until := today.AddDate(0, 0, 7*weeks)
+
+	events, err := uc.eventRepo.FindActiveByTenantID(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := loadBlackoutCalendar(ctx, uc.blackoutRepo, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &GenerateUpcomingBusinessDaysOutput{Until: until}
+	for _, e := range events {
+		if !e.HasRecurrence() {
+			continue
+		}
+
+		result := UpcomingBusinessDayEventResult{
+			EventID:   e.EventID(),
+			EventName: e.EventName(),
+		}
+		result.GeneratedCount, result.SlotCount, result.Err = uc.generateForEvent(ctx, e, calendar, today, until, input.Now, input.DryRun)
+		if result.Err != nil {
+			output.FailedCount++
+		}
+		output.GeneratedCount += result.GeneratedCount
+		output.SlotCount += result.SlotCount
+		output.Events = append(output.Events, result)
+	}
+
+	return output, nil
+}
+
+// generateForEvent creates the missing business days of the event between from and until
+// 休業日は除外・移動し、営業日ごとにトランザクションを分けて途中で失敗しても作成済みの営業日は残す
+// 管理者が日時を変更・削除した開催日は作り直さない
+func (uc *GenerateUpcomingBusinessDaysUsecase) generateForEvent(
+	ctx context.Context,
+	e *event.Event,
+	calendar *event.BlackoutCalendar,
+	from, until time.Time,
+	now time.Time,
+	dryRun bool,
+) (int, int, error) {
+	rule, err := e.EffectiveRecurrenceRule()
+	if err != nil {
+		return 0, 0, err
+	}
+	if e.DefaultStartTime() == nil || e.DefaultEndTime() == nil {
+		return 0, 0, common.NewValidationError("定期開催設定が不完全です", nil)
+	}
+
+	template, err := uc.findDefaultTemplate(ctx, e)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	generatedCount := 0
+	slotCount := 0
+	for _, targetDate := range calendar.Occurrences(rule, from, until) {
+		exists, err := occurrenceGenerated(ctx, uc.businessDayRepo, e, targetDate)
+		if err != nil {
+			return generatedCount, slotCount, err
+		}
+		if exists {
+			continue
+		}
+
+		businessDay, err := event.NewEventBusinessDay(
+			now,
+			e.TenantID(),
+			e.EventID(),
+			targetDate,
+			*e.DefaultStartTime(),
+			*e.DefaultEndTime(),
+			event.OccurrenceTypeRecurring,
+			nil,
+		)
+		if err != nil {
+			return generatedCount, slotCount, err
+		}
+		if template != nil {
+			if err := businessDay.RecordAppliedTemplate(now, template.TemplateID(), template.Version()); err != nil {
+				return generatedCount, slotCount, err
+			}
+		}
+
+		if !dryRun {
+			err = uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+				if err := uc.businessDayRepo.Save(txCtx, businessDay); err != nil {
+					return err
+				}
+				if template == nil {
+					return nil
+				}
+				return uc.applyTemplate.createShiftSlotsFromTemplate(txCtx, businessDay, template)
+			})
+			if err != nil {
+				return generatedCount, slotCount, err
+			}
+		}
+
+		generatedCount++
+		if template != nil {
+			slotCount += len(template.Items())
+		}
+	}
+
+	return generatedCount, slotCount, nil
+}
+
+// findDefaultTemplate returns the event's default template (nil when not set or deleted)
+func (uc *GenerateUpcomingBusinessDaysUsecase) findDefaultTemplate(ctx context.Context, e *event.Event) (*shift.ShiftSlotTemplate, error) {
+	if e.DefaultTemplateID() == nil {
+		return nil, nil
+	}
+
+	template, err := uc.templateRepo.FindByID(ctx, e.TenantID(), *e.DefaultTemplateID())
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	// 論理削除されたテンプレートは適用しない（営業日のみ作成する）
+	if template.DeletedAt() != nil {
+		return nil, nil
+	}
+	return template, nil
+}
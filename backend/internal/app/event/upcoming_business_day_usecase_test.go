@@ -0,0 +1,329 @@
+package event_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	appevent "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+func createTemplateWithItem(t *testing.T, tenantID common.TenantID, eventID common.EventID) *shift.ShiftSlotTemplate {
+	t.Helper()
+	now := time.Now()
+	tmpl, err := shift.NewShiftSlotTemplate(now, tenantID, eventID, "Default Template", "", []*shift.ShiftSlotTemplateItem{})
+	if err != nil {
+		t.Fatalf("Failed to create template: %v", err)
+	}
+	item, err := shift.NewShiftSlotTemplateItem(
+		now, tmpl.TemplateID(), "受付", "",
+		time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC),
+		2, 1,
+	)
+	if err != nil {
+		t.Fatalf("Failed to create template item: %v", err)
+	}
+	if err := tmpl.UpdateDetails(now, "Default Template", "", []*shift.ShiftSlotTemplateItem{item}); err != nil {
+		t.Fatalf("Failed to update template: %v", err)
+	}
+	return tmpl
+}
+
+func TestGenerateUpcomingBusinessDaysUsecase_Execute_AppliesDefaultTemplate(t *testing.T) {
+	tenantID := common.NewTenantID()
+	// 2025-03-01 03:00 UTC は東京では 3/1（土）12:00
+	now := time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)
+
+	recurring := createSaturdayEvent(t, tenantID)
+	tmpl := createTemplateWithItem(t, tenantID, recurring.EventID())
+	templateID := tmpl.TemplateID()
+	recurring.SetDefaultTemplate(now, &templateID)
+
+	oneOff, err := event.NewEvent(now, tenantID, "単発イベント", event.EventTypeSpecial, "",
+		event.RecurrenceTypeNone, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create event: %v", err)
+	}
+
+	eventRepo := &MockEventRepository{
+		findActiveByTenantFunc: func(ctx context.Context, tid common.TenantID) ([]*event.Event, error) {
+			return []*event.Event{recurring, oneOff}, nil
+		},
+	}
+	var saved []*event.EventBusinessDay
+	bdRepo := &MockBusinessDayRepository{
+		saveFunc: func(ctx context.Context, bd *event.EventBusinessDay) error {
+			saved = append(saved, bd)
+			return nil
+		},
+		existsByEventIDAndDate: func(ctx context.Context, tid common.TenantID, eid common.EventID, date time.Time, startTime time.Time) (bool, error) {
+			// 3/8 は作成済み
+			return date.Format("2006-01-02") == "2025-03-08", nil
+		},
+	}
+	templateRepo := &MockShiftSlotTemplateRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id common.ShiftSlotTemplateID) (*shift.ShiftSlotTemplate, error) {
+			return tmpl, nil
+		},
+	}
+	var slots []*shift.ShiftSlot
+	slotRepo := &MockShiftSlotRepository{
+		saveFunc: func(ctx context.Context, slot *shift.ShiftSlot) error {
+			slots = append(slots, slot)
+			return nil
+		},
+	}
+
+	usecase := appevent.NewGenerateUpcomingBusinessDaysUsecase(eventRepo, bdRepo, &MockTenantRepository{}, &MockBlackoutDateSetRepository{},
+		templateRepo, slotRepo, &MockInstanceRepository{}, &MockTxManager{})
+
+	result, err := usecase.Execute(context.Background(), appevent.GenerateUpcomingBusinessDaysInput{
+		TenantID: tenantID,
+		Now:      now,
+		Weeks:    2,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	// 3/1〜3/15 の土曜日のうち 3/8 を除く 2 日
+	if result.GeneratedCount != 2 || len(saved) != 2 {
+		t.Fatalf("GeneratedCount = %d (saved %d), want 2", result.GeneratedCount, len(saved))
+	}
+	if got := saved[0].TargetDate().Format("2006-01-02"); got != "2025-03-01" {
+		t.Errorf("first business day = %s, want 2025-03-01", got)
+	}
+	if got := saved[1].TargetDate().Format("2006-01-02"); got != "2025-03-15" {
+		t.Errorf("last business day = %s, want 2025-03-15", got)
+	}
+	if result.Until.Format("2006-01-02") != "2025-03-15" {
+		t.Errorf("Until = %s, want 2025-03-15", result.Until.Format("2006-01-02"))
+	}
+
+	// テンプレートの枠が営業日ごとに作成される
+	if result.SlotCount != 2 || len(slots) != 2 {
+		t.Errorf("SlotCount = %d (saved %d), want 2", result.SlotCount, len(slots))
+	}
+	for i, slot := range slots {
+		if slot.BusinessDayID() != saved[i].BusinessDayID() || slot.SlotName() != "受付" {
+			t.Errorf("slot %d should be created from the template for business day %s", i, saved[i].BusinessDayID())
+		}
+	}
+
+	// 定期設定のないイベントは対象外
+	if len(result.Events) != 1 || result.Events[0].EventID != recurring.EventID() {
+		t.Errorf("Events should only contain the recurring event, got %+v", result.Events)
+	}
+}
+
+func TestGenerateUpcomingBusinessDaysUsecase_Execute_UsesTenantTimezone(t *testing.T) {
+	tenantID := common.NewTenantID()
+	// ニューヨークでは 2/28（金）22:00 なので 3/14 までが対象
+	now := time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)
+	recurring := createSaturdayEvent(t, tenantID)
+
+	eventRepo := &MockEventRepository{
+		findActiveByTenantFunc: func(ctx context.Context, tid common.TenantID) ([]*event.Event, error) {
+			return []*event.Event{recurring}, nil
+		},
+	}
+
+	usecase := appevent.NewGenerateUpcomingBusinessDaysUsecase(eventRepo, &MockBusinessDayRepository{},
+		&MockTenantRepository{timezone: "America/New_York"}, &MockBlackoutDateSetRepository{},
+		&MockShiftSlotTemplateRepository{}, &MockShiftSlotRepository{}, &MockInstanceRepository{}, &MockTxManager{})
+
+	result, err := usecase.Execute(context.Background(), appevent.GenerateUpcomingBusinessDaysInput{
+		TenantID: tenantID,
+		Now:      now,
+		Weeks:    2,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if result.GeneratedCount != 2 {
+		t.Errorf("GeneratedCount = %d, want 2 (3/1 and 3/8)", result.GeneratedCount)
+	}
+	if result.SlotCount != 0 {
+		t.Errorf("SlotCount = %d, want 0 without a default template", result.SlotCount)
+	}
+}
+
+func TestGenerateUpcomingBusinessDaysUsecase_Execute_DryRun(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)
+
+	recurring := createSaturdayEvent(t, tenantID)
+	tmpl := createTemplateWithItem(t, tenantID, recurring.EventID())
+	templateID := tmpl.TemplateID()
+	recurring.SetDefaultTemplate(now, &templateID)
+
+	eventRepo := &MockEventRepository{
+		findActiveByTenantFunc: func(ctx context.Context, tid common.TenantID) ([]*event.Event, error) {
+			return []*event.Event{recurring}, nil
+		},
+	}
+	bdRepo := &MockBusinessDayRepository{
+		saveFunc: func(ctx context.Context, bd *event.EventBusinessDay) error {
+			t.Error("Save should not be called in dry run")
+			return nil
+		},
+	}
+	templateRepo := &MockShiftSlotTemplateRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id common.ShiftSlotTemplateID) (*shift.ShiftSlotTemplate, error) {
+			return tmpl, nil
+		},
+	}
+	slotRepo := &MockShiftSlotRepository{
+		saveFunc: func(ctx context.Context, slot *shift.ShiftSlot) error {
+			t.Error("Slot save should not be called in dry run")
+			return nil
+		},
+	}
+
+	usecase := appevent.NewGenerateUpcomingBusinessDaysUsecase(eventRepo, bdRepo, &MockTenantRepository{}, &MockBlackoutDateSetRepository{},
+		templateRepo, slotRepo, &MockInstanceRepository{}, &MockTxManager{})
+
+	result, err := usecase.Execute(context.Background(), appevent.GenerateUpcomingBusinessDaysInput{
+		TenantID: tenantID,
+		Now:      now,
+		Weeks:    4,
+		DryRun:   true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	// 3/1〜3/29 の土曜日
+	if result.GeneratedCount != 5 || result.SlotCount != 5 {
+		t.Errorf("GeneratedCount = %d, SlotCount = %d, want 5 and 5", result.GeneratedCount, result.SlotCount)
+	}
+}
+
+func TestGenerateUpcomingBusinessDaysUsecase_Execute_ContinuesAfterEventFailure(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)
+
+	failing := createSaturdayEvent(t, tenantID)
+	succeeding := createSaturdayEvent(t, tenantID)
+
+	eventRepo := &MockEventRepository{
+		findActiveByTenantFunc: func(ctx context.Context, tid common.TenantID) ([]*event.Event, error) {
+			return []*event.Event{failing, succeeding}, nil
+		},
+	}
+	bdRepo := &MockBusinessDayRepository{
+		saveFunc: func(ctx context.Context, bd *event.EventBusinessDay) error {
+			if bd.EventID() == failing.EventID() {
+				return errors.New("database error")
+			}
+			return nil
+		},
+	}
+
+	usecase := appevent.NewGenerateUpcomingBusinessDaysUsecase(eventRepo, bdRepo, &MockTenantRepository{}, &MockBlackoutDateSetRepository{},
+		&MockShiftSlotTemplateRepository{}, &MockShiftSlotRepository{}, &MockInstanceRepository{}, &MockTxManager{})
+
+	result, err := usecase.Execute(context.Background(), appevent.GenerateUpcomingBusinessDaysInput{
+		TenantID: tenantID,
+		Now:      now,
+		Weeks:    1,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if result.FailedCount != 1 {
+		t.Errorf("FailedCount = %d, want 1", result.FailedCount)
+	}
+	if result.Events[0].Err == nil {
+		t.Error("failing event should report an error")
+	}
+	// 3/1, 3/8
+	if result.Events[1].GeneratedCount != 2 || result.GeneratedCount != 2 {
+		t.Errorf("GeneratedCount = %d (event %d), want 2", result.GeneratedCount, result.Events[1].GeneratedCount)
+	}
+}
+
+func TestGenerateUpcomingBusinessDaysUsecase_Execute_DoesNotRecreateDeletedBusinessDay(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)
+	recurring := createSaturdayEvent(t, tenantID)
+
+	eventRepo := &MockEventRepository{
+		findActiveByTenantFunc: func(ctx context.Context, tid common.TenantID) ([]*event.Event, error) {
+			return []*event.Event{recurring}, nil
+		},
+	}
+	// 論理削除した営業日も保持する DB と同じように振る舞う
+	stored := map[event.BusinessDayID]*event.EventBusinessDay{}
+	bdRepo := &MockBusinessDayRepository{
+		saveFunc: func(ctx context.Context, bd *event.EventBusinessDay) error {
+			stored[bd.BusinessDayID()] = bd
+			return nil
+		},
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			bd, ok := stored[id]
+			if !ok || bd.IsDeleted() {
+				return nil, common.NewNotFoundError("EventBusinessDay", id.String())
+			}
+			return bd, nil
+		},
+		existsByEventIDAndDate: func(ctx context.Context, tid common.TenantID, eid common.EventID, date time.Time, startTime time.Time) (bool, error) {
+			for _, bd := range stored {
+				if !bd.IsDeleted() && bd.TargetDate().Equal(date) && bd.StartTime().Equal(startTime) {
+					return true, nil
+				}
+			}
+			return false, nil
+		},
+		existsByOccurrenceDateFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID, occurrenceDate time.Time) (bool, error) {
+			for _, bd := range stored {
+				if bd.OccurrenceDate() != nil && bd.OccurrenceDate().Equal(occurrenceDate) {
+					return true, nil
+				}
+			}
+			return false, nil
+		},
+	}
+
+	usecase := appevent.NewGenerateUpcomingBusinessDaysUsecase(eventRepo, bdRepo, &MockTenantRepository{}, &MockBlackoutDateSetRepository{},
+		&MockShiftSlotTemplateRepository{}, &MockShiftSlotRepository{}, &MockInstanceRepository{}, &MockTxManager{})
+	input := appevent.GenerateUpcomingBusinessDaysInput{TenantID: tenantID, Now: now, Weeks: 1}
+
+	first, err := usecase.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+	if first.GeneratedCount != 2 {
+		t.Fatalf("GeneratedCount = %d, want 2 (3/1 and 3/8)", first.GeneratedCount)
+	}
+
+	// 管理者が 3/8 の営業日を削除する
+	var deleted *event.EventBusinessDay
+	for _, bd := range stored {
+		if bd.TargetDate().Format("2006-01-02") == "2025-03-08" {
+			deleted = bd
+		}
+	}
+	err = appevent.NewDeleteBusinessDayUsecase(bdRepo).Execute(context.Background(), appevent.DeleteBusinessDayInput{
+		TenantID:      tenantID,
+		BusinessDayID: deleted.BusinessDayID(),
+	})
+	if err != nil {
+		t.Fatalf("DeleteBusinessDayUsecase.Execute() should succeed, got error: %v", err)
+	}
+
+	second, err := usecase.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+	if second.GeneratedCount != 0 || len(stored) != 2 {
+		t.Errorf("deleted business day should not be recreated, GeneratedCount = %d (stored %d)", second.GeneratedCount, len(stored))
+	}
+}
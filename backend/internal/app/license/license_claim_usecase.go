@@ -130,7 +130,7 @@ func (uc *LicenseClaimUsecase) Execute(ctx context.Context, input LicenseClaimIn
 		}
 
 		// 3. Create tenant
-		newTenant, err := tenant.NewTenant(now, input.TenantName, "Asia/Tokyo")
+		newTenant, err := tenant.NewTenant(now, input.TenantName, tenant.DefaultTimezone)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,388 @@
+package member
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+)
+
+// authorizeAvailabilityActor checks that a member (non-admin) only touches their own availability
+// actorMemberID が nil の場合は管理者による操作
+func authorizeAvailabilityActor(actorMemberID *common.MemberID, memberID common.MemberID) error {
+	if actorMemberID != nil && *actorMemberID != memberID {
+		return common.NewUnauthorizedError("members can only manage their own availability")
+	}
+	return nil
+}
+
+// =====================================================
+// Get
+// =====================================================
+
+// GetMemberAvailabilityInput represents the input for getting a member's availability
+type GetMemberAvailabilityInput struct {
+	TenantID      common.TenantID
+	MemberID      common.MemberID
+	ActorMemberID *common.MemberID
+}
+
+// MemberAvailability represents the availability windows and blackouts of a member
+type MemberAvailability struct {
+	Windows   []*member.AvailabilityWindow
+	Blackouts []*member.AvailabilityBlackout
+}
+
+// GetMemberAvailabilityUsecase handles retrieving a member's availability calendar
+type GetMemberAvailabilityUsecase struct {
+	memberRepo       MemberRepository
+	availabilityRepo member.AvailabilityRepository
+}
+
+// NewGetMemberAvailabilityUsecase creates a new GetMemberAvailabilityUsecase
+func NewGetMemberAvailabilityUsecase(memberRepo MemberRepository, availabilityRepo member.AvailabilityRepository) *GetMemberAvailabilityUsecase {
+	return &GetMemberAvailabilityUsecase{
+		memberRepo:       memberRepo,
+		availabilityRepo: availabilityRepo,
+	}
+}
+
+// Execute retrieves the availability windows and blackouts of a member
+func (uc *GetMemberAvailabilityUsecase) Execute(ctx context.Context, input GetMemberAvailabilityInput) (*MemberAvailability, error) {
+	if err := authorizeAvailabilityActor(input.ActorMemberID, input.MemberID); err != nil {
+		return nil, err
+	}
+
+	// メンバーの存在確認
+	if _, err := uc.memberRepo.FindByID(ctx, input.TenantID, input.MemberID); err != nil {
+		return nil, err
+	}
+
+	windows, err := uc.availabilityRepo.FindWindowsByMemberID(ctx, input.TenantID, input.MemberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find availability windows: %w", err)
+	}
+
+	blackouts, err := uc.availabilityRepo.FindBlackoutsByMemberID(ctx, input.TenantID, input.MemberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find availability blackouts: %w", err)
+	}
+
+	return &MemberAvailability{
+		Windows:   windows,
+		Blackouts: blackouts,
+	}, nil
+}
+
+// =====================================================
+// Availability Windows
+// =====================================================
+
+// AvailabilityWindowInput represents the input for creating or updating an availability window
+type AvailabilityWindowInput struct {
+	TenantID      common.TenantID
+	MemberID      common.MemberID
+	ActorMemberID *common.MemberID
+	WindowID      member.AvailabilityWindowID // 更新時のみ
+	DayOfWeek     time.Weekday
+	StartTime     time.Time
+	EndTime       time.Time
+	Note          string
+}
+
+// CreateAvailabilityWindowUsecase handles adding a weekly availability window to a member
+type CreateAvailabilityWindowUsecase struct {
+	memberRepo       MemberRepository
+	availabilityRepo member.AvailabilityRepository
+	clock            services.Clock
+}
+
+// NewCreateAvailabilityWindowUsecase creates a new CreateAvailabilityWindowUsecase
+func NewCreateAvailabilityWindowUsecase(
+	memberRepo MemberRepository,
+	availabilityRepo member.AvailabilityRepository,
+	clock services.Clock,
+) *CreateAvailabilityWindowUsecase {
+	return &CreateAvailabilityWindowUsecase{
+		memberRepo:       memberRepo,
+		availabilityRepo: availabilityRepo,
+		clock:            clock,
+	}
+}
+
+// Execute creates a new availability window
+func (uc *CreateAvailabilityWindowUsecase) Execute(ctx context.Context, input AvailabilityWindowInput) (*member.AvailabilityWindow, error) {
+	if err := authorizeAvailabilityActor(input.ActorMemberID, input.MemberID); err != nil {
+		return nil, err
+	}
+
+	// メンバーの存在確認
+	if _, err := uc.memberRepo.FindByID(ctx, input.TenantID, input.MemberID); err != nil {
+		return nil, err
+	}
+
+	window, err := member.NewAvailabilityWindow(
+		uc.clock.Now(),
+		input.TenantID,
+		input.MemberID,
+		input.DayOfWeek,
+		input.StartTime,
+		input.EndTime,
+		input.Note,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.availabilityRepo.SaveWindow(ctx, window); err != nil {
+		return nil, fmt.Errorf("failed to save availability window: %w", err)
+	}
+
+	return window, nil
+}
+
+// UpdateAvailabilityWindowUsecase handles updating a weekly availability window
+type UpdateAvailabilityWindowUsecase struct {
+	availabilityRepo member.AvailabilityRepository
+	clock            services.Clock
+}
+
+// NewUpdateAvailabilityWindowUsecase creates a new UpdateAvailabilityWindowUsecase
+func NewUpdateAvailabilityWindowUsecase(availabilityRepo member.AvailabilityRepository, clock services.Clock) *UpdateAvailabilityWindowUsecase {
+	return &UpdateAvailabilityWindowUsecase{
+		availabilityRepo: availabilityRepo,
+		clock:            clock,
+	}
+}
+
+// Execute updates an availability window
+func (uc *UpdateAvailabilityWindowUsecase) Execute(ctx context.Context, input AvailabilityWindowInput) (*member.AvailabilityWindow, error) {
+	if err := authorizeAvailabilityActor(input.ActorMemberID, input.MemberID); err != nil {
+		return nil, err
+	}
+
+	window, err := findMemberWindow(ctx, uc.availabilityRepo, input.TenantID, input.MemberID, input.WindowID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := window.Update(uc.clock.Now(), input.DayOfWeek, input.StartTime, input.EndTime, input.Note); err != nil {
+		return nil, err
+	}
+
+	if err := uc.availabilityRepo.SaveWindow(ctx, window); err != nil {
+		return nil, fmt.Errorf("failed to save availability window: %w", err)
+	}
+
+	return window, nil
+}
+
+// DeleteAvailabilityWindowInput represents the input for deleting an availability window
+type DeleteAvailabilityWindowInput struct {
+	TenantID      common.TenantID
+	MemberID      common.MemberID
+	ActorMemberID *common.MemberID
+	WindowID      member.AvailabilityWindowID
+}
+
+// DeleteAvailabilityWindowUsecase handles deleting a weekly availability window
+type DeleteAvailabilityWindowUsecase struct {
+	availabilityRepo member.AvailabilityRepository
+}
+
+// NewDeleteAvailabilityWindowUsecase creates a new DeleteAvailabilityWindowUsecase
+func NewDeleteAvailabilityWindowUsecase(availabilityRepo member.AvailabilityRepository) *DeleteAvailabilityWindowUsecase {
+	return &DeleteAvailabilityWindowUsecase{
+		availabilityRepo: availabilityRepo,
+	}
+}
+
+// Execute deletes an availability window
+func (uc *DeleteAvailabilityWindowUsecase) Execute(ctx context.Context, input DeleteAvailabilityWindowInput) error {
+	if err := authorizeAvailabilityActor(input.ActorMemberID, input.MemberID); err != nil {
+		return err
+	}
+
+	if _, err := findMemberWindow(ctx, uc.availabilityRepo, input.TenantID, input.MemberID, input.WindowID); err != nil {
+		return err
+	}
+
+	return uc.availabilityRepo.DeleteWindow(ctx, input.TenantID, input.WindowID)
+}
+
+// findMemberWindow finds an availability window and checks it belongs to the member
+// 他のメンバーの時間帯は存在しないものとして扱う
+func findMemberWindow(
+	ctx context.Context,
+	availabilityRepo member.AvailabilityRepository,
+	tenantID common.TenantID,
+	memberID common.MemberID,
+	windowID member.AvailabilityWindowID,
+) (*member.AvailabilityWindow, error) {
+	window, err := availabilityRepo.FindWindowByID(ctx, tenantID, windowID)
+	if err != nil {
+		return nil, err
+	}
+	if window.MemberID() != memberID {
+		return nil, common.NewNotFoundError("AvailabilityWindow", windowID.String())
+	}
+	return window, nil
+}
+
+// =====================================================
+// Blackouts
+// =====================================================
+
+// AvailabilityBlackoutInput represents the input for creating or updating a blackout
+// StartTime / EndTime が nil の場合は終日
+type AvailabilityBlackoutInput struct {
+	TenantID      common.TenantID
+	MemberID      common.MemberID
+	ActorMemberID *common.MemberID
+	BlackoutID    member.BlackoutID // 更新時のみ
+	Date          time.Time
+	StartTime     *time.Time
+	EndTime       *time.Time
+	Reason        string
+}
+
+// CreateAvailabilityBlackoutUsecase handles adding a blackout date to a member
+type CreateAvailabilityBlackoutUsecase struct {
+	memberRepo       MemberRepository
+	availabilityRepo member.AvailabilityRepository
+	clock            services.Clock
+}
+
+// NewCreateAvailabilityBlackoutUsecase creates a new CreateAvailabilityBlackoutUsecase
+func NewCreateAvailabilityBlackoutUsecase(
+	memberRepo MemberRepository,
+	availabilityRepo member.AvailabilityRepository,
+	clock services.Clock,
+) *CreateAvailabilityBlackoutUsecase {
+	return &CreateAvailabilityBlackoutUsecase{
+		memberRepo:       memberRepo,
+		availabilityRepo: availabilityRepo,
+		clock:            clock,
+	}
+}
+
+// Execute creates a new blackout
+func (uc *CreateAvailabilityBlackoutUsecase) Execute(ctx context.Context, input AvailabilityBlackoutInput) (*member.AvailabilityBlackout, error) {
+	if err := authorizeAvailabilityActor(input.ActorMemberID, input.MemberID); err != nil {
+		return nil, err
+	}
+
+	// メンバーの存在確認
+	if _, err := uc.memberRepo.FindByID(ctx, input.TenantID, input.MemberID); err != nil {
+		return nil, err
+	}
+
+	blackout, err := member.NewAvailabilityBlackout(
+		uc.clock.Now(),
+		input.TenantID,
+		input.MemberID,
+		input.Date,
+		input.StartTime,
+		input.EndTime,
+		input.Reason,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.availabilityRepo.SaveBlackout(ctx, blackout); err != nil {
+		return nil, fmt.Errorf("failed to save availability blackout: %w", err)
+	}
+
+	return blackout, nil
+}
+
+// UpdateAvailabilityBlackoutUsecase handles updating a blackout date
+type UpdateAvailabilityBlackoutUsecase struct {
+	availabilityRepo member.AvailabilityRepository
+	clock            services.Clock
+}
+
+// NewUpdateAvailabilityBlackoutUsecase creates a new UpdateAvailabilityBlackoutUsecase
+func NewUpdateAvailabilityBlackoutUsecase(availabilityRepo member.AvailabilityRepository, clock services.Clock) *UpdateAvailabilityBlackoutUsecase {
+	return &UpdateAvailabilityBlackoutUsecase{
+		availabilityRepo: availabilityRepo,
+		clock:            clock,
+	}
+}
+
+// Execute updates a blackout
+func (uc *UpdateAvailabilityBlackoutUsecase) Execute(ctx context.Context, input AvailabilityBlackoutInput) (*member.AvailabilityBlackout, error) {
+	if err := authorizeAvailabilityActor(input.ActorMemberID, input.MemberID); err != nil {
+		return nil, err
+	}
+
+	blackout, err := findMemberBlackout(ctx, uc.availabilityRepo, input.TenantID, input.MemberID, input.BlackoutID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := blackout.Update(uc.clock.Now(), input.Date, input.StartTime, input.EndTime, input.Reason); err != nil {
+		return nil, err
+	}
+
+	if err := uc.availabilityRepo.SaveBlackout(ctx, blackout); err != nil {
+		return nil, fmt.Errorf("failed to save availability blackout: %w", err)
+	}
+
+	return blackout, nil
+}
+
+// DeleteAvailabilityBlackoutInput represents the input for deleting a blackout
+type DeleteAvailabilityBlackoutInput struct {
+	TenantID      common.TenantID
+	MemberID      common.MemberID
+	ActorMemberID *common.MemberID
+	BlackoutID    member.BlackoutID
+}
+
+// DeleteAvailabilityBlackoutUsecase handles deleting a blackout date
+type DeleteAvailabilityBlackoutUsecase struct {
+	availabilityRepo member.AvailabilityRepository
+}
+
+// NewDeleteAvailabilityBlackoutUsecase creates a new DeleteAvailabilityBlackoutUsecase
+func NewDeleteAvailabilityBlackoutUsecase(availabilityRepo member.AvailabilityRepository) *DeleteAvailabilityBlackoutUsecase {
+	return &DeleteAvailabilityBlackoutUsecase{
+		availabilityRepo: availabilityRepo,
+	}
+}
+
+// Execute deletes a blackout
+func (uc *DeleteAvailabilityBlackoutUsecase) Execute(ctx context.Context, input DeleteAvailabilityBlackoutInput) error {
+	if err := authorizeAvailabilityActor(input.ActorMemberID, input.MemberID); err != nil {
+		return err
+	}
+
+	if _, err := findMemberBlackout(ctx, uc.availabilityRepo, input.TenantID, input.MemberID, input.BlackoutID); err != nil {
+		return err
+	}
+
+	return uc.availabilityRepo.DeleteBlackout(ctx, input.TenantID, input.BlackoutID)
+}
+
+// findMemberBlackout finds a blackout and checks it belongs to the member
+func findMemberBlackout(
+	ctx context.Context,
+	availabilityRepo member.AvailabilityRepository,
+	tenantID common.TenantID,
+	memberID common.MemberID,
+	blackoutID member.BlackoutID,
+) (*member.AvailabilityBlackout, error) {
+	blackout, err := availabilityRepo.FindBlackoutByID(ctx, tenantID, blackoutID)
+	if err != nil {
+		return nil, err
+	}
+	if blackout.MemberID() != memberID {
+		return nil, common.NewNotFoundError("AvailabilityBlackout", blackoutID.String())
+	}
+	return blackout, nil
+}
@@ -0,0 +1,220 @@
+package member_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	appmember "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+)
+
+// =====================================================
+// Mock Availability Repository
+// =====================================================
+
+type MockClock struct {
+	now time.Time
+}
+
+func (m *MockClock) Now() time.Time {
+	return m.now
+}
+
+type MockAvailabilityRepository struct {
+	windows   map[member.AvailabilityWindowID]*member.AvailabilityWindow
+	blackouts map[member.BlackoutID]*member.AvailabilityBlackout
+}
+
+func (m *MockAvailabilityRepository) SaveWindow(ctx context.Context, window *member.AvailabilityWindow) error {
+	m.windows[window.WindowID()] = window
+	return nil
+}
+
+func (m *MockAvailabilityRepository) FindWindowByID(ctx context.Context, tenantID common.TenantID, windowID member.AvailabilityWindowID) (*member.AvailabilityWindow, error) {
+	window, ok := m.windows[windowID]
+	if !ok || window.TenantID() != tenantID {
+		return nil, common.NewNotFoundError("AvailabilityWindow", windowID.String())
+	}
+	return window, nil
+}
+
+func (m *MockAvailabilityRepository) FindWindowsByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*member.AvailabilityWindow, error) {
+	var result []*member.AvailabilityWindow
+	for _, window := range m.windows {
+		if window.TenantID() == tenantID && window.MemberID() == memberID {
+			result = append(result, window)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockAvailabilityRepository) DeleteWindow(ctx context.Context, tenantID common.TenantID, windowID member.AvailabilityWindowID) error {
+	delete(m.windows, windowID)
+	return nil
+}
+
+func (m *MockAvailabilityRepository) SaveBlackout(ctx context.Context, blackout *member.AvailabilityBlackout) error {
+	m.blackouts[blackout.BlackoutID()] = blackout
+	return nil
+}
+
+func (m *MockAvailabilityRepository) FindBlackoutByID(ctx context.Context, tenantID common.TenantID, blackoutID member.BlackoutID) (*member.AvailabilityBlackout, error) {
+	blackout, ok := m.blackouts[blackoutID]
+	if !ok || blackout.TenantID() != tenantID {
+		return nil, common.NewNotFoundError("AvailabilityBlackout", blackoutID.String())
+	}
+	return blackout, nil
+}
+
+func (m *MockAvailabilityRepository) FindBlackoutsByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*member.AvailabilityBlackout, error) {
+	var result []*member.AvailabilityBlackout
+	for _, blackout := range m.blackouts {
+		if blackout.TenantID() == tenantID && blackout.MemberID() == memberID {
+			result = append(result, blackout)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockAvailabilityRepository) FindBlackoutsByMemberIDAndDateRange(ctx context.Context, tenantID common.TenantID, memberID common.MemberID, from, to time.Time) ([]*member.AvailabilityBlackout, error) {
+	return m.FindBlackoutsByMemberID(ctx, tenantID, memberID)
+}
+
+func (m *MockAvailabilityRepository) DeleteBlackout(ctx context.Context, tenantID common.TenantID, blackoutID member.BlackoutID) error {
+	delete(m.blackouts, blackoutID)
+	return nil
+}
+
+// =====================================================
+// Availability Usecase Tests
+// =====================================================
+
+func TestCreateAvailabilityWindowUsecase_Execute_MemberCanManageOwnCalendar(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testMember := createTestMember(t, tenantID, "Test Member")
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memberID common.MemberID) (*member.Member, error) {
+			return testMember, nil
+		},
+	}
+	availabilityRepo := &MockAvailabilityRepository{
+		windows:   map[member.AvailabilityWindowID]*member.AvailabilityWindow{},
+		blackouts: map[member.BlackoutID]*member.AvailabilityBlackout{},
+	}
+	memberID := testMember.MemberID()
+
+	usecase := appmember.NewCreateAvailabilityWindowUsecase(memberRepo, availabilityRepo, &MockClock{now: time.Now()})
+	window, err := usecase.Execute(context.Background(), appmember.AvailabilityWindowInput{
+		TenantID:      tenantID,
+		MemberID:      memberID,
+		ActorMemberID: &memberID,
+		DayOfWeek:     time.Friday,
+		StartTime:     time.Date(2000, 1, 1, 20, 0, 0, 0, time.UTC),
+		EndTime:       time.Date(2000, 1, 1, 2, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+	if !window.IsOvernight() {
+		t.Errorf("window ending before it starts should be overnight")
+	}
+	if len(availabilityRepo.windows) != 1 {
+		t.Errorf("window should be saved, got %d windows", len(availabilityRepo.windows))
+	}
+}
+
+func TestCreateAvailabilityBlackoutUsecase_Execute_ErrorWhenOtherMember(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testMember := createTestMember(t, tenantID, "Test Member")
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memberID common.MemberID) (*member.Member, error) {
+			return testMember, nil
+		},
+	}
+	otherMemberID := common.NewMemberID()
+	availabilityRepo := &MockAvailabilityRepository{
+		windows:   map[member.AvailabilityWindowID]*member.AvailabilityWindow{},
+		blackouts: map[member.BlackoutID]*member.AvailabilityBlackout{},
+	}
+
+	usecase := appmember.NewCreateAvailabilityBlackoutUsecase(memberRepo, availabilityRepo, &MockClock{now: time.Now()})
+	_, err := usecase.Execute(context.Background(), appmember.AvailabilityBlackoutInput{
+		TenantID:      tenantID,
+		MemberID:      testMember.MemberID(),
+		ActorMemberID: &otherMemberID,
+		Date:          time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+	})
+
+	var domainErr *common.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code() != common.ErrUnauthorized {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+}
+
+func TestUpdateAvailabilityWindowUsecase_Execute_ErrorWhenWindowBelongsToAnotherMember(t *testing.T) {
+	tenantID := common.NewTenantID()
+	memberID := common.NewMemberID()
+	availabilityRepo := &MockAvailabilityRepository{
+		windows:   map[member.AvailabilityWindowID]*member.AvailabilityWindow{},
+		blackouts: map[member.BlackoutID]*member.AvailabilityBlackout{},
+	}
+
+	otherWindow, err := member.NewAvailabilityWindow(time.Now(), tenantID, common.NewMemberID(), time.Friday,
+		time.Date(2000, 1, 1, 20, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC), "")
+	if err != nil {
+		t.Fatalf("NewAvailabilityWindow() should succeed, got error: %v", err)
+	}
+	_ = availabilityRepo.SaveWindow(context.Background(), otherWindow)
+
+	// 管理者でも URL のメンバーに属さない時間帯は更新できない
+	usecase := appmember.NewUpdateAvailabilityWindowUsecase(availabilityRepo, &MockClock{now: time.Now()})
+	_, err = usecase.Execute(context.Background(), appmember.AvailabilityWindowInput{
+		TenantID:  tenantID,
+		MemberID:  memberID,
+		WindowID:  otherWindow.WindowID(),
+		DayOfWeek: time.Saturday,
+		StartTime: time.Date(2000, 1, 1, 20, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+	})
+	if !common.IsNotFoundError(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	if otherWindow.DayOfWeek() != time.Friday {
+		t.Errorf("window of another member should not change")
+	}
+}
+
+func TestGetMemberAvailabilityUsecase_Execute(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testMember := createTestMember(t, tenantID, "Test Member")
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memberID common.MemberID) (*member.Member, error) {
+			return testMember, nil
+		},
+	}
+	availabilityRepo := &MockAvailabilityRepository{
+		windows:   map[member.AvailabilityWindowID]*member.AvailabilityWindow{},
+		blackouts: map[member.BlackoutID]*member.AvailabilityBlackout{},
+	}
+
+	blackout, err := member.NewAvailabilityBlackout(time.Now(), tenantID, testMember.MemberID(), time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), nil, nil, "")
+	if err != nil {
+		t.Fatalf("NewAvailabilityBlackout() should succeed, got error: %v", err)
+	}
+	_ = availabilityRepo.SaveBlackout(context.Background(), blackout)
+
+	usecase := appmember.NewGetMemberAvailabilityUsecase(memberRepo, availabilityRepo)
+	result, err := usecase.Execute(context.Background(), appmember.GetMemberAvailabilityInput{
+		TenantID: tenantID,
+		MemberID: testMember.MemberID(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+	if len(result.Windows) != 0 || len(result.Blackouts) != 1 || !result.Blackouts[0].IsAllDay() {
+		t.Errorf("expected one all-day blackout and no windows, got %+v", result)
+	}
+}
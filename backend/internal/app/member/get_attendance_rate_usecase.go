@@ -5,23 +5,27 @@ import (
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
 )
 
 // GetAttendanceRateUsecase handles getting attendance rates for members
 type GetAttendanceRateUsecase struct {
-	memberRepo     member.MemberRepository
-	attendanceRepo attendance.AttendanceCollectionRepository
+	memberRepo      member.MemberRepository
+	attendanceRepo  attendance.AttendanceCollectionRepository
+	businessDayRepo event.EventBusinessDayRepository
 }
 
 // NewGetAttendanceRateUsecase creates a new GetAttendanceRateUsecase
 func NewGetAttendanceRateUsecase(
 	memberRepo member.MemberRepository,
 	attendanceRepo attendance.AttendanceCollectionRepository,
+	businessDayRepo event.EventBusinessDayRepository,
 ) *GetAttendanceRateUsecase {
 	return &GetAttendanceRateUsecase{
-		memberRepo:     memberRepo,
-		attendanceRepo: attendanceRepo,
+		memberRepo:      memberRepo,
+		attendanceRepo:  attendanceRepo,
+		businessDayRepo: businessDayRepo,
 	}
 }
 
@@ -58,6 +62,8 @@ func (u *GetAttendanceRateUsecase) Execute(ctx context.Context, input GetAttenda
 	}
 
 	// 3. Calculate attendance rate for each member
+	// 中止された営業日に対する回答は集計から除外する
+	cancelled := newCancelledTargetDates(u.attendanceRepo, u.businessDayRepo, tenantID)
 	rates := make([]MemberAttendanceRate, 0, len(members))
 	for _, m := range members {
 		// Get all responses for this member
@@ -74,9 +80,17 @@ func (u *GetAttendanceRateUsecase) Execute(ctx context.Context, input GetAttenda
 		}
 
 		// Count attending responses
-		totalResponses := len(responses)
+		totalResponses := 0
 		attendingCount := 0
 		for _, resp := range responses {
+			isCancelled, err := cancelled.contains(ctx, resp)
+			if err != nil {
+				return nil, err
+			}
+			if isCancelled {
+				continue
+			}
+			totalResponses++
 			if resp.Response().String() == "attending" {
 				attendingCount++
 			}
@@ -100,3 +114,100 @@ func (u *GetAttendanceRateUsecase) Execute(ctx context.Context, input GetAttenda
 		Rates: rates,
 	}, nil
 }
+
+// cancelledTargetDates determines whether a response's target date falls on a cancelled business day
+// 出欠確認・対象日・営業日の取得結果はキャッシュする
+type cancelledTargetDates struct {
+	attendanceRepo  attendance.AttendanceCollectionRepository
+	businessDayRepo event.EventBusinessDayRepository
+	tenantID        common.TenantID
+	collections     map[common.CollectionID]*attendance.AttendanceCollection
+	targetDates     map[common.CollectionID]map[common.TargetDateID]string
+	cancelledDates  map[string]map[string]bool // 出欠確認の対象（target_type:target_id） -> 中止された日付
+}
+
+func newCancelledTargetDates(
+	attendanceRepo attendance.AttendanceCollectionRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	tenantID common.TenantID,
+) *cancelledTargetDates {
+	return &cancelledTargetDates{
+		attendanceRepo:  attendanceRepo,
+		businessDayRepo: businessDayRepo,
+		tenantID:        tenantID,
+		collections:     make(map[common.CollectionID]*attendance.AttendanceCollection),
+		targetDates:     make(map[common.CollectionID]map[common.TargetDateID]string),
+		cancelledDates:  make(map[string]map[string]bool),
+	}
+}
+
+// contains returns true if the response is for a date on which the collection's business day was cancelled
+// 対象（営業日・イベント）が指定されていない出欠確認は判定できないため常に false
+func (c *cancelledTargetDates) contains(ctx context.Context, resp *attendance.AttendanceResponse) (bool, error) {
+	collection, ok := c.collections[resp.CollectionID()]
+	if !ok {
+		var err error
+		collection, err = c.attendanceRepo.FindByID(ctx, c.tenantID, resp.CollectionID())
+		if err != nil {
+			return false, err
+		}
+		c.collections[resp.CollectionID()] = collection
+	}
+	if collection.TargetID() == "" {
+		return false, nil
+	}
+
+	dates, ok := c.targetDates[collection.CollectionID()]
+	if !ok {
+		found, err := c.attendanceRepo.FindTargetDatesByCollectionID(ctx, collection.CollectionID())
+		if err != nil {
+			return false, err
+		}
+		dates = make(map[common.TargetDateID]string, len(found))
+		for _, td := range found {
+			dates[td.TargetDateID()] = td.TargetDateValue().Format("2006-01-02")
+		}
+		c.targetDates[collection.CollectionID()] = dates
+	}
+
+	key := string(collection.TargetType()) + ":" + collection.TargetID()
+	cancelled, ok := c.cancelledDates[key]
+	if !ok {
+		var err error
+		cancelled, err = c.findCancelledDates(ctx, collection)
+		if err != nil {
+			return false, err
+		}
+		c.cancelledDates[key] = cancelled
+	}
+
+	return cancelled[dates[resp.TargetDateID()]], nil
+}
+
+func (c *cancelledTargetDates) findCancelledDates(ctx context.Context, collection *attendance.AttendanceCollection) (map[string]bool, error) {
+	var businessDays []*event.EventBusinessDay
+	switch collection.TargetType() {
+	case attendance.TargetTypeBusinessDay:
+		bd, err := c.businessDayRepo.FindByID(ctx, c.tenantID, event.BusinessDayID(collection.TargetID()))
+		if err != nil && !common.IsNotFoundError(err) {
+			return nil, err
+		}
+		if bd != nil {
+			businessDays = append(businessDays, bd)
+		}
+	case attendance.TargetTypeEvent:
+		var err error
+		businessDays, err = c.businessDayRepo.FindByEventID(ctx, c.tenantID, common.EventID(collection.TargetID()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := make(map[string]bool)
+	for _, bd := range businessDays {
+		if bd.IsCancelled() {
+			result[bd.TargetDate().Format("2006-01-02")] = true
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,306 @@
+package member
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// maxWorkloadReportDays はレポート対象期間の上限日数
+const maxWorkloadReportDays = 366
+
+// findWorkloadPolicy finds the tenant default (memberID == nil) or member override policy
+// 未設定の場合は nil を返す
+func findWorkloadPolicy(
+	ctx context.Context,
+	policyRepo member.WorkloadPolicyRepository,
+	tenantID common.TenantID,
+	memberID *common.MemberID,
+) (*member.WorkloadPolicy, error) {
+	var (
+		policy *member.WorkloadPolicy
+		err    error
+	)
+	if memberID == nil {
+		policy, err = policyRepo.FindTenantDefault(ctx, tenantID)
+	} else {
+		policy, err = policyRepo.FindByMemberID(ctx, tenantID, *memberID)
+	}
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find workload policy: %w", err)
+	}
+	return policy, nil
+}
+
+// =====================================================
+// Get
+// =====================================================
+
+// GetWorkloadPolicyInput represents the input for getting a workload policy
+// MemberID が nil の場合はテナントの既定ポリシー
+type GetWorkloadPolicyInput struct {
+	TenantID      common.TenantID
+	MemberID      *common.MemberID
+	ActorMemberID *common.MemberID
+}
+
+// WorkloadPolicyResult represents a workload policy and the limits effectively applied
+type WorkloadPolicyResult struct {
+	Policy      *member.WorkloadPolicy // 未設定の場合は nil
+	Limits      member.WorkloadLimits  // テナントの既定値とメンバー個別の上書きを解決した値
+	Enforcement member.WorkloadEnforcement
+}
+
+// GetWorkloadPolicyUsecase handles retrieving a workload policy
+type GetWorkloadPolicyUsecase struct {
+	memberRepo MemberRepository
+	policyRepo member.WorkloadPolicyRepository
+}
+
+// NewGetWorkloadPolicyUsecase creates a new GetWorkloadPolicyUsecase
+func NewGetWorkloadPolicyUsecase(memberRepo MemberRepository, policyRepo member.WorkloadPolicyRepository) *GetWorkloadPolicyUsecase {
+	return &GetWorkloadPolicyUsecase{
+		memberRepo: memberRepo,
+		policyRepo: policyRepo,
+	}
+}
+
+// Execute retrieves the policy and the effective limits
+func (uc *GetWorkloadPolicyUsecase) Execute(ctx context.Context, input GetWorkloadPolicyInput) (*WorkloadPolicyResult, error) {
+	if input.MemberID != nil {
+		if input.ActorMemberID != nil && *input.ActorMemberID != *input.MemberID {
+			return nil, common.NewUnauthorizedError("members can only view their own workload policy")
+		}
+
+		// メンバーの存在確認
+		if _, err := uc.memberRepo.FindByID(ctx, input.TenantID, *input.MemberID); err != nil {
+			return nil, err
+		}
+	}
+
+	tenantPolicy, err := findWorkloadPolicy(ctx, uc.policyRepo, input.TenantID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.MemberID == nil {
+		limits, enforcement := member.ResolveWorkloadLimits(tenantPolicy, nil)
+		return &WorkloadPolicyResult{Policy: tenantPolicy, Limits: limits, Enforcement: enforcement}, nil
+	}
+
+	memberPolicy, err := findWorkloadPolicy(ctx, uc.policyRepo, input.TenantID, input.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, enforcement := member.ResolveWorkloadLimits(tenantPolicy, memberPolicy)
+	return &WorkloadPolicyResult{Policy: memberPolicy, Limits: limits, Enforcement: enforcement}, nil
+}
+
+// =====================================================
+// Put / Delete
+// =====================================================
+
+// PutWorkloadPolicyInput represents the input for setting a workload policy
+// MemberID が nil の場合はテナントの既定ポリシー
+type PutWorkloadPolicyInput struct {
+	TenantID    common.TenantID
+	MemberID    *common.MemberID
+	Limits      member.WorkloadLimits
+	Enforcement member.WorkloadEnforcement
+}
+
+// PutWorkloadPolicyUsecase handles creating or replacing a workload policy
+type PutWorkloadPolicyUsecase struct {
+	memberRepo MemberRepository
+	policyRepo member.WorkloadPolicyRepository
+	clock      services.Clock
+}
+
+// NewPutWorkloadPolicyUsecase creates a new PutWorkloadPolicyUsecase
+func NewPutWorkloadPolicyUsecase(
+	memberRepo MemberRepository,
+	policyRepo member.WorkloadPolicyRepository,
+	clock services.Clock,
+) *PutWorkloadPolicyUsecase {
+	return &PutWorkloadPolicyUsecase{
+		memberRepo: memberRepo,
+		policyRepo: policyRepo,
+		clock:      clock,
+	}
+}
+
+// Execute creates the policy, or replaces its limits if it already exists
+func (uc *PutWorkloadPolicyUsecase) Execute(ctx context.Context, input PutWorkloadPolicyInput) (*member.WorkloadPolicy, error) {
+	if input.MemberID != nil {
+		// メンバーの存在確認
+		if _, err := uc.memberRepo.FindByID(ctx, input.TenantID, *input.MemberID); err != nil {
+			return nil, err
+		}
+	}
+
+	policy, err := findWorkloadPolicy(ctx, uc.policyRepo, input.TenantID, input.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	if policy == nil {
+		policy, err = member.NewWorkloadPolicy(now, input.TenantID, input.MemberID, input.Limits, input.Enforcement)
+		if err != nil {
+			return nil, err
+		}
+	} else if err := policy.Update(now, input.Limits, input.Enforcement); err != nil {
+		return nil, err
+	}
+
+	if err := uc.policyRepo.Save(ctx, policy); err != nil {
+		return nil, fmt.Errorf("failed to save workload policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// DeleteWorkloadPolicyInput represents the input for deleting a workload policy
+// MemberID が nil の場合はテナントの既定ポリシー
+type DeleteWorkloadPolicyInput struct {
+	TenantID common.TenantID
+	MemberID *common.MemberID
+}
+
+// DeleteWorkloadPolicyUsecase handles deleting a workload policy
+// メンバー個別ポリシーを削除するとテナントの既定値に戻る
+type DeleteWorkloadPolicyUsecase struct {
+	policyRepo member.WorkloadPolicyRepository
+}
+
+// NewDeleteWorkloadPolicyUsecase creates a new DeleteWorkloadPolicyUsecase
+func NewDeleteWorkloadPolicyUsecase(policyRepo member.WorkloadPolicyRepository) *DeleteWorkloadPolicyUsecase {
+	return &DeleteWorkloadPolicyUsecase{
+		policyRepo: policyRepo,
+	}
+}
+
+// Execute deletes the policy
+func (uc *DeleteWorkloadPolicyUsecase) Execute(ctx context.Context, input DeleteWorkloadPolicyInput) error {
+	policy, err := findWorkloadPolicy(ctx, uc.policyRepo, input.TenantID, input.MemberID)
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		return common.NewNotFoundError("WorkloadPolicy", "")
+	}
+
+	return uc.policyRepo.Delete(ctx, input.TenantID, policy.PolicyID())
+}
+
+// =====================================================
+// Report
+// =====================================================
+
+// GetWorkloadReportInput represents the input for the workload report
+type GetWorkloadReportInput struct {
+	TenantID common.TenantID
+	From     time.Time
+	To       time.Time
+	// IncludeWithin が true の場合、上限・目標の範囲内のメンバーも含める
+	IncludeWithin bool
+}
+
+// MemberWorkload represents the workload of a member over the report range
+type MemberWorkload struct {
+	Member  *member.Member
+	Limits  member.WorkloadLimits
+	Summary member.WorkloadSummary
+}
+
+// WorkloadReport represents the workload of members over a date range
+type WorkloadReport struct {
+	From    time.Time
+	To      time.Time
+	Members []MemberWorkload
+}
+
+// GetWorkloadReportUsecase handles listing members over or under their workload targets
+type GetWorkloadReportUsecase struct {
+	memberRepo     MemberRepository
+	policyRepo     member.WorkloadPolicyRepository
+	assignmentRepo shift.ShiftAssignmentRepository
+}
+
+// NewGetWorkloadReportUsecase creates a new GetWorkloadReportUsecase
+func NewGetWorkloadReportUsecase(
+	memberRepo MemberRepository,
+	policyRepo member.WorkloadPolicyRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+) *GetWorkloadReportUsecase {
+	return &GetWorkloadReportUsecase{
+		memberRepo:     memberRepo,
+		policyRepo:     policyRepo,
+		assignmentRepo: assignmentRepo,
+	}
+}
+
+// Execute summarizes the confirmed shifts of each active member within [From, To]
+func (uc *GetWorkloadReportUsecase) Execute(ctx context.Context, input GetWorkloadReportInput) (*WorkloadReport, error) {
+	if input.To.Before(input.From) {
+		return nil, common.NewValidationError("to must not be before from", nil)
+	}
+	if input.To.Sub(input.From) > maxWorkloadReportDays*24*time.Hour {
+		return nil, common.NewValidationError(fmt.Sprintf("date range must not exceed %d days", maxWorkloadReportDays), nil)
+	}
+
+	members, err := uc.memberRepo.FindActiveByTenantID(ctx, input.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find members: %w", err)
+	}
+
+	tenantPolicy, err := findWorkloadPolicy(ctx, uc.policyRepo, input.TenantID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	memberPolicies, err := uc.policyRepo.FindMemberPoliciesByTenantID(ctx, input.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find member workload policies: %w", err)
+	}
+	policyByMember := make(map[common.MemberID]*member.WorkloadPolicy, len(memberPolicies))
+	for _, p := range memberPolicies {
+		policyByMember[*p.MemberID()] = p
+	}
+
+	// 全メンバーの確定済みシフトを一括取得（N+1 回避）
+	shifts, err := uc.assignmentRepo.FindConfirmedShiftsByDateRange(ctx, input.TenantID, nil, input.From, input.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find assigned shifts: %w", err)
+	}
+	periodsByMember := make(map[common.MemberID][]member.WorkPeriod)
+	for _, s := range shifts {
+		start, end := s.Period()
+		periodsByMember[s.MemberID] = append(periodsByMember[s.MemberID], member.WorkPeriod{Date: s.TargetDate, Start: start, End: end})
+	}
+
+	report := &WorkloadReport{From: input.From, To: input.To, Members: []MemberWorkload{}}
+	for _, m := range members {
+		limits, _ := member.ResolveWorkloadLimits(tenantPolicy, policyByMember[m.MemberID()])
+		summary := limits.Summarize(periodsByMember[m.MemberID()], input.From, input.To)
+		if summary.Status == member.WorkloadStatusWithin && !input.IncludeWithin {
+			continue
+		}
+		report.Members = append(report.Members, MemberWorkload{
+			Member:  m,
+			Limits:  limits,
+			Summary: summary,
+		})
+	}
+
+	return report, nil
+}
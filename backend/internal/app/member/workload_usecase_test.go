@@ -0,0 +1,151 @@
+package member_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appmember "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+)
+
+// =====================================================
+// Mock Workload Policy Repository
+// =====================================================
+
+type MockWorkloadPolicyRepository struct {
+	policies map[member.WorkloadPolicyID]*member.WorkloadPolicy
+}
+
+func (m *MockWorkloadPolicyRepository) Save(ctx context.Context, policy *member.WorkloadPolicy) error {
+	m.policies[policy.PolicyID()] = policy
+	return nil
+}
+
+func (m *MockWorkloadPolicyRepository) FindTenantDefault(ctx context.Context, tenantID common.TenantID) (*member.WorkloadPolicy, error) {
+	for _, policy := range m.policies {
+		if policy.TenantID() == tenantID && policy.IsTenantDefault() {
+			return policy, nil
+		}
+	}
+	return nil, common.NewNotFoundError("WorkloadPolicy", tenantID.String())
+}
+
+func (m *MockWorkloadPolicyRepository) FindByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) (*member.WorkloadPolicy, error) {
+	for _, policy := range m.policies {
+		if policy.TenantID() == tenantID && policy.MemberID() != nil && *policy.MemberID() == memberID {
+			return policy, nil
+		}
+	}
+	return nil, common.NewNotFoundError("WorkloadPolicy", memberID.String())
+}
+
+func (m *MockWorkloadPolicyRepository) FindMemberPoliciesByTenantID(ctx context.Context, tenantID common.TenantID) ([]*member.WorkloadPolicy, error) {
+	var result []*member.WorkloadPolicy
+	for _, policy := range m.policies {
+		if policy.TenantID() == tenantID && !policy.IsTenantDefault() {
+			result = append(result, policy)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockWorkloadPolicyRepository) Delete(ctx context.Context, tenantID common.TenantID, policyID member.WorkloadPolicyID) error {
+	delete(m.policies, policyID)
+	return nil
+}
+
+// =====================================================
+// Workload Policy Usecase Tests
+// =====================================================
+
+func TestPutWorkloadPolicyUsecase_Execute_ReplacesExistingPolicy(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testMember := createTestMember(t, tenantID, "Test Member")
+	memberID := testMember.MemberID()
+
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, mid common.MemberID) (*member.Member, error) {
+			return testMember, nil
+		},
+	}
+	policyRepo := &MockWorkloadPolicyRepository{policies: map[member.WorkloadPolicyID]*member.WorkloadPolicy{}}
+	two, four := 2, 4
+
+	usecase := appmember.NewPutWorkloadPolicyUsecase(memberRepo, policyRepo, &MockClock{now: time.Now()})
+	first, err := usecase.Execute(context.Background(), appmember.PutWorkloadPolicyInput{
+		TenantID: tenantID,
+		MemberID: &memberID,
+		Limits:   member.WorkloadLimits{MaxShiftsPerWeek: &two},
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	second, err := usecase.Execute(context.Background(), appmember.PutWorkloadPolicyInput{
+		TenantID:    tenantID,
+		MemberID:    &memberID,
+		Limits:      member.WorkloadLimits{MaxShiftsPerWeek: &four},
+		Enforcement: member.WorkloadEnforcementBlock,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if first.PolicyID() != second.PolicyID() || len(policyRepo.policies) != 1 {
+		t.Errorf("member should have a single policy, got %d", len(policyRepo.policies))
+	}
+	if *second.Limits().MaxShiftsPerWeek != 4 || second.Enforcement() != member.WorkloadEnforcementBlock {
+		t.Errorf("policy should be replaced, got %+v %s", second.Limits(), second.Enforcement())
+	}
+}
+
+func TestGetWorkloadPolicyUsecase_Execute_ResolvesTenantDefault(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testMember := createTestMember(t, tenantID, "Test Member")
+	memberID := testMember.MemberID()
+
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, mid common.MemberID) (*member.Member, error) {
+			return testMember, nil
+		},
+	}
+	policyRepo := &MockWorkloadPolicyRepository{policies: map[member.WorkloadPolicyID]*member.WorkloadPolicy{}}
+	three := 3
+
+	tenantPolicy, err := member.NewWorkloadPolicy(time.Now(), tenantID, nil, member.WorkloadLimits{MaxShiftsPerWeek: &three}, member.WorkloadEnforcementBlock)
+	if err != nil {
+		t.Fatalf("NewWorkloadPolicy() should succeed, got error: %v", err)
+	}
+	_ = policyRepo.Save(context.Background(), tenantPolicy)
+
+	usecase := appmember.NewGetWorkloadPolicyUsecase(memberRepo, policyRepo)
+	result, err := usecase.Execute(context.Background(), appmember.GetWorkloadPolicyInput{
+		TenantID:      tenantID,
+		MemberID:      &memberID,
+		ActorMemberID: &memberID,
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+	if result.Policy != nil {
+		t.Errorf("member without override should have no policy, got %+v", result.Policy)
+	}
+	if result.Limits.MaxShiftsPerWeek == nil || *result.Limits.MaxShiftsPerWeek != 3 || result.Enforcement != member.WorkloadEnforcementBlock {
+		t.Errorf("effective limits should come from the tenant default, got %+v %s", result.Limits, result.Enforcement)
+	}
+}
+
+func TestDeleteWorkloadPolicyUsecase_Execute_NotFoundWithoutPolicy(t *testing.T) {
+	memberID := common.NewMemberID()
+
+	usecase := appmember.NewDeleteWorkloadPolicyUsecase(&MockWorkloadPolicyRepository{policies: map[member.WorkloadPolicyID]*member.WorkloadPolicy{}})
+	err := usecase.Execute(context.Background(), appmember.DeleteWorkloadPolicyInput{
+		TenantID: common.NewTenantID(),
+		MemberID: &memberID,
+	})
+	if !common.IsNotFoundError(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
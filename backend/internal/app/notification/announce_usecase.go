@@ -0,0 +1,238 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
+)
+
+const (
+	// DefaultDeadlineAnnouncementWindow は締切間近として告知する締切までの時間
+	DefaultDeadlineAnnouncementWindow = 24 * time.Hour
+	// DefaultStaffingAnnouncementWindow は人員不足を告知する営業日開始までの時間
+	DefaultStaffingAnnouncementWindow = 72 * time.Hour
+)
+
+// maxAnnouncementFields is the maximum number of fields in an announcement (Discord の Embed の上限)
+const maxAnnouncementFields = 25
+
+// AnnounceUpcomingInput represents the input for announcing upcoming deadlines and understaffed slots
+type AnnounceUpcomingInput struct {
+	TenantID       common.TenantID
+	Now            time.Time
+	DeadlineWithin time.Duration // 0 以下の場合は DefaultDeadlineAnnouncementWindow
+	StaffingWithin time.Duration // 0 以下の場合は DefaultStaffingAnnouncementWindow
+	DryRun         bool
+}
+
+// AnnounceUpcomingOutput represents the result of the announcements of a tenant
+type AnnounceUpcomingOutput struct {
+	DeadlineCount     int // 締切間近を告知した出欠確認の件数
+	UnderstaffedCount int // 人員不足を告知した営業日の件数
+	SkippedCount      int // 投稿先が無効化されていて告知しなかった件数
+	FailedCount       int
+}
+
+// AnnounceUpcomingUsecase announces attendance collections nearing their deadline and understaffed business days
+// 告知は対象ごとに1回だけ行う（告知履歴で重複を防ぐ）
+type AnnounceUpcomingUsecase struct {
+	collectionRepo  attendance.AttendanceCollectionRepository
+	eventRepo       event.EventRepository
+	businessDayRepo event.EventBusinessDayRepository
+	slotRepo        shift.ShiftSlotRepository
+	assignmentRepo  shift.ShiftAssignmentRepository
+	tenantRepo      tenant.TenantRepository
+	logRepo         notification.AnnouncementLogRepository
+	announcer       notification.Announcer
+}
+
+// NewAnnounceUpcomingUsecase creates a new AnnounceUpcomingUsecase
+func NewAnnounceUpcomingUsecase(
+	collectionRepo attendance.AttendanceCollectionRepository,
+	eventRepo event.EventRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	slotRepo shift.ShiftSlotRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	tenantRepo tenant.TenantRepository,
+	logRepo notification.AnnouncementLogRepository,
+	announcer notification.Announcer,
+) *AnnounceUpcomingUsecase {
+	return &AnnounceUpcomingUsecase{
+		collectionRepo:  collectionRepo,
+		eventRepo:       eventRepo,
+		businessDayRepo: businessDayRepo,
+		slotRepo:        slotRepo,
+		assignmentRepo:  assignmentRepo,
+		tenantRepo:      tenantRepo,
+		logRepo:         logRepo,
+		announcer:       announcer,
+	}
+}
+
+// Execute announces the upcoming deadlines and understaffed business days of the tenant
+func (uc *AnnounceUpcomingUsecase) Execute(ctx context.Context, input AnnounceUpcomingInput) (*AnnounceUpcomingOutput, error) {
+	deadlineWithin := input.DeadlineWithin
+	if deadlineWithin <= 0 {
+		deadlineWithin = DefaultDeadlineAnnouncementWindow
+	}
+	staffingWithin := input.StaffingWithin
+	if staffingWithin <= 0 {
+		staffingWithin = DefaultStaffingAnnouncementWindow
+	}
+
+	output := &AnnounceUpcomingOutput{}
+	if err := uc.announceDeadlines(ctx, input, deadlineWithin, output); err != nil {
+		return output, err
+	}
+	if err := uc.announceUnderstaffed(ctx, input, staffingWithin, output); err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+// announceDeadlines announces the open collections whose deadline is within the window
+func (uc *AnnounceUpcomingUsecase) announceDeadlines(ctx context.Context, input AnnounceUpcomingInput, within time.Duration, output *AnnounceUpcomingOutput) error {
+	collections, err := uc.collectionRepo.FindByTenantID(ctx, input.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to find collections: %w", err)
+	}
+
+	until := input.Now.Add(within)
+	for _, c := range collections {
+		if c.Status() != attendance.StatusOpen || c.Deadline() == nil {
+			continue
+		}
+		if c.Deadline().Before(input.Now) || c.Deadline().After(until) {
+			continue
+		}
+
+		responses, err := uc.collectionRepo.FindResponsesByCollectionID(ctx, c.CollectionID())
+		if err != nil {
+			return fmt.Errorf("failed to find responses: %w", err)
+		}
+		responded := make(map[common.MemberID]bool)
+		for _, r := range responses {
+			responded[r.MemberID()] = true
+		}
+
+		uc.announceOnce(ctx, input, c.CollectionID().String(), CollectionDeadlineAnnouncement(c, len(responded)), &output.DeadlineCount, output)
+	}
+
+	return nil
+}
+
+// announceUnderstaffed announces the business days starting within the window that still have understaffed slots
+func (uc *AnnounceUpcomingUsecase) announceUnderstaffed(ctx context.Context, input AnnounceUpcomingInput, within time.Duration, output *AnnounceUpcomingOutput) error {
+	loc, err := tenant.ResolveLocation(ctx, uc.tenantRepo, input.TenantID)
+	if err != nil {
+		return err
+	}
+
+	events, err := uc.eventRepo.FindActiveByTenantID(ctx, input.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to find events: %w", err)
+	}
+
+	until := input.Now.Add(within)
+	fromDate := common.DateIn(input.Now, loc)
+	toDate := common.DateIn(until, loc)
+
+	for _, e := range events {
+		if e.IsArchived() {
+			continue
+		}
+
+		businessDays, err := uc.businessDayRepo.FindByEventIDAndDateRange(ctx, input.TenantID, e.EventID(), fromDate, toDate)
+		if err != nil {
+			return fmt.Errorf("failed to find business days: %w", err)
+		}
+
+		for _, bd := range businessDays {
+			if !bd.IsActive() || bd.IsCancelled() {
+				continue
+			}
+			startAt := bd.StartAt(loc)
+			if startAt.Before(input.Now) || startAt.After(until) {
+				continue
+			}
+
+			slots, err := uc.understaffedSlots(ctx, bd)
+			if err != nil {
+				return err
+			}
+			if len(slots) == 0 {
+				continue
+			}
+
+			uc.announceOnce(ctx, input, bd.BusinessDayID().String(), UnderstaffedBusinessDayAnnouncement(e.EventName(), bd, slots, loc), &output.UnderstaffedCount, output)
+		}
+	}
+
+	return nil
+}
+
+// understaffedSlots returns the slots of the business day with fewer confirmed members than required
+func (uc *AnnounceUpcomingUsecase) understaffedSlots(ctx context.Context, bd *event.EventBusinessDay) ([]UnderstaffedSlot, error) {
+	slots, err := uc.slotRepo.FindByBusinessDayID(ctx, bd.TenantID(), bd.BusinessDayID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find shift slots: %w", err)
+	}
+
+	var result []UnderstaffedSlot
+	for _, slot := range slots {
+		if len(result) == maxAnnouncementFields {
+			break
+		}
+		assigned, err := uc.assignmentRepo.CountConfirmedBySlotID(ctx, bd.TenantID(), slot.SlotID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to count assignments: %w", err)
+		}
+		if assigned < slot.RequiredCount() {
+			result = append(result, UnderstaffedSlot{Slot: slot, Assigned: assigned})
+		}
+	}
+
+	return result, nil
+}
+
+// announceOnce posts the announcement unless the subject has already been announced
+// 個々の告知の失敗はバッチ全体を止めずに FailedCount に数える
+func (uc *AnnounceUpcomingUsecase) announceOnce(ctx context.Context, input AnnounceUpcomingInput, subjectID string, a notification.Announcement, count *int, output *AnnounceUpcomingOutput) {
+	announced, err := uc.logRepo.Exists(ctx, input.TenantID, a.Kind, subjectID)
+	if err != nil {
+		output.FailedCount++
+		return
+	}
+	if announced {
+		return
+	}
+
+	if input.DryRun {
+		*count++
+		return
+	}
+
+	if err := uc.announcer.Announce(ctx, a); err != nil {
+		if errors.Is(err, notification.ErrUndeliverable) {
+			output.SkippedCount++
+		} else {
+			output.FailedCount++
+		}
+		return
+	}
+
+	if err := uc.logRepo.Record(ctx, input.TenantID, a.Kind, subjectID, input.Now); err != nil {
+		output.FailedCount++
+		return
+	}
+	*count++
+}
@@ -0,0 +1,172 @@
+package notification
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/schedule"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// UnderstaffedSlot represents a shift slot with fewer confirmed members than required
+type UnderstaffedSlot struct {
+	Slot     *shift.ShiftSlot
+	Assigned int
+}
+
+// discordTimestamp returns the Discord timestamp markup (閲覧者のタイムゾーンで表示される)
+func discordTimestamp(t time.Time) string {
+	return fmt.Sprintf("<t:%d:f>", t.Unix())
+}
+
+// collectionTarget returns the event or business day the collection belongs to
+func collectionTarget(c *attendance.AttendanceCollection) (*common.EventID, *event.BusinessDayID) {
+	if c.TargetID() == "" {
+		return nil, nil
+	}
+	switch c.TargetType() {
+	case attendance.TargetTypeEvent:
+		id := common.EventID(c.TargetID())
+		return &id, nil
+	case attendance.TargetTypeBusinessDay:
+		id := event.BusinessDayID(c.TargetID())
+		return nil, &id
+	default:
+		return nil, nil
+	}
+}
+
+// collectionAnnouncement builds the common part of the collection announcements
+func collectionAnnouncement(c *attendance.AttendanceCollection, kind notification.AnnouncementKind, title string) notification.Announcement {
+	eventID, businessDayID := collectionTarget(c)
+	a := notification.Announcement{
+		TenantID:      c.TenantID(),
+		EventID:       eventID,
+		BusinessDayID: businessDayID,
+		Kind:          kind,
+		Title:         title,
+		Description:   c.Description(),
+		URL:           "/p/attendance/" + c.PublicToken().String(),
+	}
+	if c.Deadline() != nil {
+		a.Fields = append(a.Fields, notification.AnnouncementField{Name: "回答締切", Value: discordTimestamp(*c.Deadline())})
+	}
+	return a
+}
+
+// CollectionOpenedAnnouncement builds the announcement that an attendance collection has opened
+func CollectionOpenedAnnouncement(c *attendance.AttendanceCollection, targetDateCount int) notification.Announcement {
+	a := collectionAnnouncement(c, notification.AnnouncementKindCollectionOpened, fmt.Sprintf("出欠確認「%s」の回答受付を開始しました", c.Title()))
+	if targetDateCount > 0 {
+		a.Fields = append(a.Fields, notification.AnnouncementField{Name: "対象日", Value: fmt.Sprintf("%d日", targetDateCount)})
+	}
+	return a
+}
+
+// CollectionDeadlineAnnouncement builds the announcement that the deadline of an attendance collection is near
+func CollectionDeadlineAnnouncement(c *attendance.AttendanceCollection, responded int) notification.Announcement {
+	a := collectionAnnouncement(c, notification.AnnouncementKindCollectionDeadline, fmt.Sprintf("出欠確認「%s」の締切が近づいています", c.Title()))
+	a.Fields = append(a.Fields, notification.AnnouncementField{Name: "回答済み", Value: fmt.Sprintf("%d名", responded)})
+	return a
+}
+
+// CollectionClosedAnnouncement builds the summary of an attendance collection closed after its deadline
+func CollectionClosedAnnouncement(c *attendance.AttendanceCollection, targetDates []*attendance.TargetDate, responses []*attendance.AttendanceResponse) notification.Announcement {
+	a := collectionAnnouncement(c, notification.AnnouncementKindCollectionClosed, fmt.Sprintf("出欠確認「%s」の回答を締め切りました", c.Title()))
+
+	respondents := make(map[common.MemberID]bool)
+	counts := make(map[common.TargetDateID]map[attendance.ResponseType]int)
+	for _, r := range responses {
+		respondents[r.MemberID()] = true
+		if counts[r.TargetDateID()] == nil {
+			counts[r.TargetDateID()] = make(map[attendance.ResponseType]int)
+		}
+		counts[r.TargetDateID()][r.Response()]++
+	}
+	a.Fields = append(a.Fields, notification.AnnouncementField{Name: "回答者", Value: fmt.Sprintf("%d名", len(respondents))})
+
+	for _, td := range targetDates {
+		if len(a.Fields) == maxAnnouncementFields {
+			break
+		}
+		name := td.TargetDateValue().Format("2006-01-02")
+		if td.StartTime() != nil {
+			name += " " + *td.StartTime()
+		}
+		count := counts[td.TargetDateID()]
+		a.Fields = append(a.Fields, notification.AnnouncementField{
+			Name: name,
+			Value: fmt.Sprintf("出席 %d / 欠席 %d / 未定 %d",
+				count[attendance.ResponseTypeAttending], count[attendance.ResponseTypeAbsent], count[attendance.ResponseTypeUndecided]),
+		})
+	}
+	return a
+}
+
+// ScheduleClosedAnnouncement builds the summary of a date schedule closed after its deadline
+func ScheduleClosedAnnouncement(s *schedule.DateSchedule, candidates []*schedule.CandidateDate, responses []*schedule.DateScheduleResponse) notification.Announcement {
+	a := notification.Announcement{
+		TenantID:    s.TenantID(),
+		EventID:     s.EventID(),
+		Kind:        notification.AnnouncementKindScheduleClosed,
+		Title:       fmt.Sprintf("日程調整「%s」の回答を締め切りました", s.Title()),
+		Description: s.Description(),
+		URL:         "/p/schedule/" + s.PublicToken().String(),
+	}
+	if s.Deadline() != nil {
+		a.Fields = append(a.Fields, notification.AnnouncementField{Name: "回答締切", Value: discordTimestamp(*s.Deadline())})
+	}
+
+	respondents := make(map[common.MemberID]bool)
+	counts := make(map[common.CandidateID]map[schedule.Availability]int)
+	for _, r := range responses {
+		respondents[r.MemberID()] = true
+		if counts[r.CandidateID()] == nil {
+			counts[r.CandidateID()] = make(map[schedule.Availability]int)
+		}
+		counts[r.CandidateID()][r.Availability()]++
+	}
+	a.Fields = append(a.Fields, notification.AnnouncementField{Name: "回答者", Value: fmt.Sprintf("%d名", len(respondents))})
+
+	for _, c := range candidates {
+		if len(a.Fields) == maxAnnouncementFields {
+			break
+		}
+		name := c.CandidateDateValue().Format("2006-01-02")
+		if c.StartTime() != nil {
+			name += " " + c.StartTime().Format("15:04")
+		}
+		count := counts[c.CandidateID()]
+		a.Fields = append(a.Fields, notification.AnnouncementField{
+			Name: name,
+			Value: fmt.Sprintf("参加可能 %d / 未定 %d / 参加不可 %d",
+				count[schedule.AvailabilityAvailable], count[schedule.AvailabilityMaybe], count[schedule.AvailabilityUnavailable]),
+		})
+	}
+	return a
+}
+
+// UnderstaffedBusinessDayAnnouncement builds the announcement that slots of a business day are still understaffed
+func UnderstaffedBusinessDayAnnouncement(eventName string, bd *event.EventBusinessDay, slots []UnderstaffedSlot, loc *time.Location) notification.Announcement {
+	eventID := bd.EventID()
+	businessDayID := bd.BusinessDayID()
+	a := notification.Announcement{
+		TenantID:      bd.TenantID(),
+		EventID:       &eventID,
+		BusinessDayID: &businessDayID,
+		Kind:          notification.AnnouncementKindUnderstaffedSlot,
+		Title:         fmt.Sprintf("%s %s のシフトに空きがあります", eventName, bd.TargetDate().Format("2006-01-02")),
+		Description:   fmt.Sprintf("開始: %s", discordTimestamp(bd.StartAt(loc))),
+	}
+	for _, s := range slots {
+		a.Fields = append(a.Fields, notification.AnnouncementField{
+			Name:  fmt.Sprintf("%s %s〜%s", s.Slot.SlotName(), s.Slot.StartTimeString(), s.Slot.EndTimeString()),
+			Value: fmt.Sprintf("%d / %d名（あと%d名）", s.Assigned, s.Slot.RequiredCount(), s.Slot.RequiredCount()-s.Assigned),
+		})
+	}
+	return a
+}
@@ -0,0 +1,373 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/schedule"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
+)
+
+// DefaultDeadlineReminderWindow は未回答のメンバーにリマインドする締切までの時間
+const DefaultDeadlineReminderWindow = 24 * time.Hour
+
+// SendDeadlineRemindersInput represents the input for sending deadline reminders of a tenant
+type SendDeadlineRemindersInput struct {
+	TenantID common.TenantID
+	Now      time.Time
+	Within   time.Duration // 0 以下の場合は DefaultDeadlineReminderWindow
+	DryRun   bool
+}
+
+// SendDeadlineRemindersOutput represents the result of the deadline reminders of a tenant
+type SendDeadlineRemindersOutput struct {
+	CollectionCount int // 締切間近の出欠確認の件数
+	ScheduleCount   int // 締切間近の日程調整の件数
+	RemindedCount   int // リマインダーを送ったメンバーの延べ人数
+	SkippedCount    int // 配信できるチャネル（Discord・メール）の連絡先がなくリマインドできなかった延べ人数
+	FailedCount     int
+}
+
+// SendDeadlineRemindersUsecase reminds the members who have not answered collections and schedules nearing their deadline
+// リマインダーはアウトボックス経由でメンバーの優先チャネル（Discord / メール）に送る。
+// 同じ締切については、メンバーごとに1回だけ送る（送信履歴で重複を防ぐ）
+// 配信できない（Dispatcher が登録されていない）チャネルには送らず、送信履歴も残さない
+type SendDeadlineRemindersUsecase struct {
+	collectionRepo  attendance.AttendanceCollectionRepository
+	scheduleRepo    schedule.DateScheduleRepository
+	memberRepo      member.MemberRepository
+	memberGroupRepo member.MemberGroupRepository
+	memberRoleRepo  member.MemberRoleRepository
+	tenantRepo      tenant.TenantRepository
+	outboxRepo      notification.OutboxRepository
+	logRepo         notification.DeadlineReminderLogRepository
+	txManager       services.TxManager
+	channels        map[notification.Channel]bool // 配信できるチャネル
+	baseURL         string                        // 回答ページの相対URLに付与するフロントエンドのURL
+}
+
+// NewSendDeadlineRemindersUsecase creates a new SendDeadlineRemindersUsecase
+func NewSendDeadlineRemindersUsecase(
+	collectionRepo attendance.AttendanceCollectionRepository,
+	scheduleRepo schedule.DateScheduleRepository,
+	memberRepo member.MemberRepository,
+	memberGroupRepo member.MemberGroupRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	tenantRepo tenant.TenantRepository,
+	outboxRepo notification.OutboxRepository,
+	logRepo notification.DeadlineReminderLogRepository,
+	txManager services.TxManager,
+	channels []notification.Channel,
+	baseURL string,
+) *SendDeadlineRemindersUsecase {
+	deliverable := make(map[notification.Channel]bool, len(channels))
+	for _, c := range channels {
+		deliverable[c] = true
+	}
+	return &SendDeadlineRemindersUsecase{
+		collectionRepo:  collectionRepo,
+		scheduleRepo:    scheduleRepo,
+		memberRepo:      memberRepo,
+		memberGroupRepo: memberGroupRepo,
+		memberRoleRepo:  memberRoleRepo,
+		tenantRepo:      tenantRepo,
+		outboxRepo:      outboxRepo,
+		logRepo:         logRepo,
+		txManager:       txManager,
+		channels:        deliverable,
+		baseURL:         strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// memberAffiliation holds the groups and roles of a member (1回の実行の中でキャッシュする)
+type memberAffiliation struct {
+	groupIDs []common.MemberGroupID
+	roleIDs  []common.RoleID
+}
+
+// reminderRun holds the state of a single execution for a tenant
+type reminderRun struct {
+	input        SendDeadlineRemindersInput
+	loc          *time.Location
+	members      []*member.Member
+	affiliations map[common.MemberID]*memberAffiliation
+	output       *SendDeadlineRemindersOutput
+}
+
+// Execute reminds the non-respondents of the collections and schedules whose deadline is within the window
+func (uc *SendDeadlineRemindersUsecase) Execute(ctx context.Context, input SendDeadlineRemindersInput) (*SendDeadlineRemindersOutput, error) {
+	if input.Within <= 0 {
+		input.Within = DefaultDeadlineReminderWindow
+	}
+
+	loc, err := tenant.ResolveLocation(ctx, uc.tenantRepo, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := uc.memberRepo.FindActiveByTenantID(ctx, input.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find members: %w", err)
+	}
+
+	run := &reminderRun{
+		input:        input,
+		loc:          loc,
+		members:      members,
+		affiliations: make(map[common.MemberID]*memberAffiliation),
+		output:       &SendDeadlineRemindersOutput{},
+	}
+
+	if err := uc.remindCollections(ctx, run); err != nil {
+		return run.output, err
+	}
+	if err := uc.remindSchedules(ctx, run); err != nil {
+		return run.output, err
+	}
+
+	return run.output, nil
+}
+
+// isDeadlineWithin reports whether the deadline is between now and the end of the window
+func (run *reminderRun) isDeadlineWithin(deadline *time.Time) bool {
+	if deadline == nil {
+		return false
+	}
+	return !deadline.Before(run.input.Now) && !deadline.After(run.input.Now.Add(run.input.Within))
+}
+
+// remindCollections reminds the non-respondents of the open collections nearing their deadline
+func (uc *SendDeadlineRemindersUsecase) remindCollections(ctx context.Context, run *reminderRun) error {
+	collections, err := uc.collectionRepo.FindByTenantID(ctx, run.input.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to find collections: %w", err)
+	}
+
+	for _, c := range collections {
+		if c.Status() != attendance.StatusOpen || c.IsDeleted() || !run.isDeadlineWithin(c.Deadline()) {
+			continue
+		}
+		run.output.CollectionCount++
+
+		groupAssignments, err := uc.collectionRepo.FindGroupAssignmentsByCollectionID(ctx, c.CollectionID())
+		if err != nil {
+			return fmt.Errorf("failed to find group assignments: %w", err)
+		}
+		roleAssignments, err := uc.collectionRepo.FindRoleAssignmentsByCollectionID(ctx, c.CollectionID())
+		if err != nil {
+			return fmt.Errorf("failed to find role assignments: %w", err)
+		}
+		targetDates, err := uc.collectionRepo.FindTargetDatesByCollectionID(ctx, c.CollectionID())
+		if err != nil {
+			return fmt.Errorf("failed to find target dates: %w", err)
+		}
+		responses, err := uc.collectionRepo.FindResponsesByCollectionID(ctx, c.CollectionID())
+		if err != nil {
+			return fmt.Errorf("failed to find responses: %w", err)
+		}
+
+		targetDateIDs := make([]string, 0, len(targetDates))
+		for _, td := range targetDates {
+			targetDateIDs = append(targetDateIDs, td.TargetDateID().String())
+		}
+		answered := make(map[common.MemberID]map[string]bool)
+		for _, r := range responses {
+			if answered[r.MemberID()] == nil {
+				answered[r.MemberID()] = make(map[string]bool)
+			}
+			answered[r.MemberID()][r.TargetDateID().String()] = true
+		}
+
+		_, businessDayID := collectionTarget(c)
+		content := fmt.Sprintf("出欠確認「%s」の回答締切が近づいています（締切: %s）\n%s",
+			c.Title(), c.Deadline().In(run.loc).Format("2006-01-02 15:04"), uc.baseURL+"/p/attendance/"+c.PublicToken().String())
+
+		for _, m := range run.members {
+			if hasAnsweredAll(answered[m.MemberID()], targetDateIDs) {
+				continue
+			}
+			aff, err := uc.affiliationOf(ctx, run, m.MemberID())
+			if err != nil {
+				return err
+			}
+			if !attendance.IsTargetMember(groupAssignments, roleAssignments, aff.groupIDs, aff.roleIDs) {
+				continue
+			}
+
+			uc.remindOnce(ctx, run, notification.ReminderSubjectAttendanceCollection, c.CollectionID().String(), *c.Deadline(), m, businessDayID, content)
+		}
+	}
+
+	return nil
+}
+
+// remindSchedules reminds the non-respondents of the open schedules nearing their deadline
+func (uc *SendDeadlineRemindersUsecase) remindSchedules(ctx context.Context, run *reminderRun) error {
+	schedules, err := uc.scheduleRepo.FindByTenantID(ctx, run.input.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to find schedules: %w", err)
+	}
+
+	for _, s := range schedules {
+		if s.Status() != schedule.StatusOpen || s.IsDeleted() || !run.isDeadlineWithin(s.Deadline()) {
+			continue
+		}
+		run.output.ScheduleCount++
+
+		groupAssignments, err := uc.scheduleRepo.FindGroupAssignmentsByScheduleID(ctx, s.ScheduleID())
+		if err != nil {
+			return fmt.Errorf("failed to find group assignments: %w", err)
+		}
+		candidates, err := uc.scheduleRepo.FindCandidatesByScheduleID(ctx, s.ScheduleID())
+		if err != nil {
+			return fmt.Errorf("failed to find candidates: %w", err)
+		}
+		responses, err := uc.scheduleRepo.FindResponsesByScheduleID(ctx, s.ScheduleID())
+		if err != nil {
+			return fmt.Errorf("failed to find responses: %w", err)
+		}
+
+		candidateIDs := make([]string, 0, len(candidates))
+		for _, c := range candidates {
+			candidateIDs = append(candidateIDs, c.CandidateID().String())
+		}
+		answered := make(map[common.MemberID]map[string]bool)
+		for _, r := range responses {
+			if answered[r.MemberID()] == nil {
+				answered[r.MemberID()] = make(map[string]bool)
+			}
+			answered[r.MemberID()][r.CandidateID().String()] = true
+		}
+
+		content := fmt.Sprintf("日程調整「%s」の回答締切が近づいています（締切: %s）\n%s",
+			s.Title(), s.Deadline().In(run.loc).Format("2006-01-02 15:04"), uc.baseURL+"/p/schedule/"+s.PublicToken().String())
+
+		for _, m := range run.members {
+			if hasAnsweredAll(answered[m.MemberID()], candidateIDs) {
+				continue
+			}
+			aff, err := uc.affiliationOf(ctx, run, m.MemberID())
+			if err != nil {
+				return err
+			}
+			if !schedule.IsTargetMember(groupAssignments, aff.groupIDs) {
+				continue
+			}
+
+			uc.remindOnce(ctx, run, notification.ReminderSubjectDateSchedule, s.ScheduleID().String(), *s.Deadline(), m, nil, content)
+		}
+	}
+
+	return nil
+}
+
+// hasAnsweredAll reports whether the member has answered every target date (candidate)
+// 対象日がない場合は、何らかの回答があれば回答済みとみなす
+func hasAnsweredAll(answered map[string]bool, targetIDs []string) bool {
+	if len(targetIDs) == 0 {
+		return len(answered) > 0
+	}
+	for _, id := range targetIDs {
+		if !answered[id] {
+			return false
+		}
+	}
+	return true
+}
+
+// affiliationOf returns the groups and roles of the member
+func (uc *SendDeadlineRemindersUsecase) affiliationOf(ctx context.Context, run *reminderRun, memberID common.MemberID) (*memberAffiliation, error) {
+	if aff, ok := run.affiliations[memberID]; ok {
+		return aff, nil
+	}
+
+	groupIDs, err := uc.memberGroupRepo.FindGroupIDsByMemberID(ctx, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find member groups: %w", err)
+	}
+	roleIDs, err := uc.memberRoleRepo.FindRolesByMemberID(ctx, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find member roles: %w", err)
+	}
+
+	aff := &memberAffiliation{groupIDs: groupIDs, roleIDs: roleIDs}
+	run.affiliations[memberID] = aff
+	return aff, nil
+}
+
+// remindOnce enqueues the reminder unless the member has already been reminded of the deadline
+// 送信履歴とアウトボックスは同じトランザクションで書き込む。個々の失敗はバッチ全体を止めずに FailedCount に数える
+func (uc *SendDeadlineRemindersUsecase) remindOnce(
+	ctx context.Context,
+	run *reminderRun,
+	kind notification.ReminderSubjectKind,
+	subjectID string,
+	deadline time.Time,
+	recipient *member.Member,
+	businessDayID *event.BusinessDayID,
+	content string,
+) {
+	channel, ok := uc.channelFor(recipient)
+	if !ok {
+		run.output.SkippedCount++
+		return
+	}
+
+	tenantID := run.input.TenantID
+	if run.input.DryRun {
+		reminded, err := uc.logRepo.Exists(ctx, tenantID, kind, subjectID, recipient.MemberID(), deadline)
+		if err != nil {
+			run.output.FailedCount++
+			return
+		}
+		if !reminded {
+			run.output.RemindedCount++
+		}
+		return
+	}
+
+	var recorded bool
+	err := uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		var err error
+		recorded, err = uc.logRepo.Record(txCtx, tenantID, kind, subjectID, recipient.MemberID(), deadline, run.input.Now)
+		if err != nil || !recorded {
+			return err
+		}
+
+		msg, err := notification.NewOutboxMessage(run.input.Now, tenantID, businessDayID, recipient.MemberID(), notification.NotificationTypeDeadlineReminder, channel, content)
+		if err != nil {
+			return err
+		}
+		if err := uc.outboxRepo.Save(txCtx, msg); err != nil {
+			return fmt.Errorf("failed to enqueue notification: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		run.output.FailedCount++
+		return
+	}
+	if recorded {
+		run.output.RemindedCount++
+	}
+}
+
+// channelFor returns the preferred channel of the recipient among the deliverable channels
+func (uc *SendDeadlineRemindersUsecase) channelFor(recipient *member.Member) (notification.Channel, bool) {
+	discordUserID, email := recipient.DiscordUserID(), recipient.Email()
+	if !uc.channels[notification.ChannelDiscord] {
+		discordUserID = ""
+	}
+	if !uc.channels[notification.ChannelEmail] {
+		email = ""
+	}
+	return notification.PreferredChannel(discordUserID, email)
+}
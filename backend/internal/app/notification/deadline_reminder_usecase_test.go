@@ -0,0 +1,431 @@
+package notification_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	appnotification "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/schedule"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
+)
+
+// =====================================================
+// Mocks
+// =====================================================
+
+type MockCollectionRepository struct {
+	attendance.AttendanceCollectionRepository
+	collections      []*attendance.AttendanceCollection
+	targetDates      []*attendance.TargetDate
+	responses        []*attendance.AttendanceResponse
+	groupAssignments []*attendance.CollectionGroupAssignment
+}
+
+func (m *MockCollectionRepository) FindByTenantID(ctx context.Context, tenantID common.TenantID) ([]*attendance.AttendanceCollection, error) {
+	return m.collections, nil
+}
+
+func (m *MockCollectionRepository) FindTargetDatesByCollectionID(ctx context.Context, collectionID common.CollectionID) ([]*attendance.TargetDate, error) {
+	return m.targetDates, nil
+}
+
+func (m *MockCollectionRepository) FindResponsesByCollectionID(ctx context.Context, collectionID common.CollectionID) ([]*attendance.AttendanceResponse, error) {
+	return m.responses, nil
+}
+
+func (m *MockCollectionRepository) FindGroupAssignmentsByCollectionID(ctx context.Context, collectionID common.CollectionID) ([]*attendance.CollectionGroupAssignment, error) {
+	return m.groupAssignments, nil
+}
+
+func (m *MockCollectionRepository) FindRoleAssignmentsByCollectionID(ctx context.Context, collectionID common.CollectionID) ([]*attendance.CollectionRoleAssignment, error) {
+	return nil, nil
+}
+
+type MockScheduleRepository struct {
+	schedule.DateScheduleRepository
+	schedules  []*schedule.DateSchedule
+	candidates []*schedule.CandidateDate
+	responses  []*schedule.DateScheduleResponse
+}
+
+func (m *MockScheduleRepository) FindByTenantID(ctx context.Context, tenantID common.TenantID) ([]*schedule.DateSchedule, error) {
+	return m.schedules, nil
+}
+
+func (m *MockScheduleRepository) FindCandidatesByScheduleID(ctx context.Context, scheduleID common.ScheduleID) ([]*schedule.CandidateDate, error) {
+	return m.candidates, nil
+}
+
+func (m *MockScheduleRepository) FindResponsesByScheduleID(ctx context.Context, scheduleID common.ScheduleID) ([]*schedule.DateScheduleResponse, error) {
+	return m.responses, nil
+}
+
+func (m *MockScheduleRepository) FindGroupAssignmentsByScheduleID(ctx context.Context, scheduleID common.ScheduleID) ([]*schedule.ScheduleGroupAssignment, error) {
+	return nil, nil
+}
+
+type MockMemberRepository struct {
+	member.MemberRepository
+	members []*member.Member
+}
+
+func (m *MockMemberRepository) FindActiveByTenantID(ctx context.Context, tenantID common.TenantID) ([]*member.Member, error) {
+	return m.members, nil
+}
+
+type MockMemberGroupRepository struct {
+	member.MemberGroupRepository
+	groups map[common.MemberID][]common.MemberGroupID
+}
+
+func (m *MockMemberGroupRepository) FindGroupIDsByMemberID(ctx context.Context, memberID common.MemberID) ([]common.MemberGroupID, error) {
+	return m.groups[memberID], nil
+}
+
+type MockMemberRoleRepository struct {
+	member.MemberRoleRepository
+}
+
+func (m *MockMemberRoleRepository) FindRolesByMemberID(ctx context.Context, memberID common.MemberID) ([]common.RoleID, error) {
+	return nil, nil
+}
+
+type MockTenantRepository struct {
+	tenant.TenantRepository
+	tenant *tenant.Tenant
+}
+
+func (m *MockTenantRepository) FindByID(ctx context.Context, tenantID common.TenantID) (*tenant.Tenant, error) {
+	return m.tenant, nil
+}
+
+// MockEnqueueOutboxRepository records the enqueued messages
+type MockEnqueueOutboxRepository struct {
+	notification.OutboxRepository
+	saved []*notification.OutboxMessage
+}
+
+func (m *MockEnqueueOutboxRepository) Save(ctx context.Context, msg *notification.OutboxMessage) error {
+	m.saved = append(m.saved, msg)
+	return nil
+}
+
+// MockReminderLogRepository is an in-memory reminder log
+type MockReminderLogRepository struct {
+	logs map[string]bool
+}
+
+func reminderLogKey(kind notification.ReminderSubjectKind, subjectID string, memberID common.MemberID, deadline time.Time) string {
+	return string(kind) + "/" + subjectID + "/" + memberID.String() + "/" + deadline.UTC().Format(time.RFC3339)
+}
+
+func (m *MockReminderLogRepository) Exists(ctx context.Context, tenantID common.TenantID, kind notification.ReminderSubjectKind, subjectID string, memberID common.MemberID, deadline time.Time) (bool, error) {
+	return m.logs[reminderLogKey(kind, subjectID, memberID, deadline)], nil
+}
+
+func (m *MockReminderLogRepository) Record(ctx context.Context, tenantID common.TenantID, kind notification.ReminderSubjectKind, subjectID string, memberID common.MemberID, deadline time.Time, remindedAt time.Time) (bool, error) {
+	key := reminderLogKey(kind, subjectID, memberID, deadline)
+	if m.logs[key] {
+		return false, nil
+	}
+	m.logs[key] = true
+	return true, nil
+}
+
+// =====================================================
+// Tests
+// =====================================================
+
+func createTestTenant(t *testing.T, now time.Time) *tenant.Tenant {
+	t.Helper()
+	ten, err := tenant.NewTenant(now, "Test Tenant", "Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to create tenant: %v", err)
+	}
+	return ten
+}
+
+func createTestMember(t *testing.T, now time.Time, tenantID common.TenantID, name, discordUserID, email string) *member.Member {
+	t.Helper()
+	m, err := member.NewMember(now, tenantID, name, discordUserID, email)
+	if err != nil {
+		t.Fatalf("failed to create member: %v", err)
+	}
+	return m
+}
+
+func TestSendDeadlineRemindersUsecase_RemindsNonRespondentsOnce(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	ten := createTestTenant(t, now)
+	tenantID := ten.TenantID()
+
+	deadline := now.Add(6 * time.Hour)
+	collection, err := attendance.NewAttendanceCollection(now.Add(-48*time.Hour), tenantID, "1月の出欠", "", attendance.TargetTypeEvent, common.NewEventID().String(), &deadline)
+	if err != nil {
+		t.Fatalf("failed to create collection: %v", err)
+	}
+	td1, _ := attendance.NewTargetDate(now, collection.CollectionID(), now.AddDate(0, 0, 3), nil, nil, 0)
+	td2, _ := attendance.NewTargetDate(now, collection.CollectionID(), now.AddDate(0, 0, 4), nil, nil, 1)
+	groupID := common.NewMemberGroupID()
+	assignment, _ := attendance.NewCollectionGroupAssignment(now, collection.CollectionID(), groupID)
+
+	answeredAll := createTestMember(t, now, tenantID, "回答済み", "100", "")
+	answeredPartly := createTestMember(t, now, tenantID, "一部回答", "200", "")
+	notAnswered := createTestMember(t, now, tenantID, "未回答", "", "member@example.com")
+	noChannel := createTestMember(t, now, tenantID, "連絡先なし", "", "")
+	outsider := createTestMember(t, now, tenantID, "対象外", "300", "") // 対象グループに所属していない
+
+	groups := make(map[common.MemberID][]common.MemberGroupID)
+	for _, m := range []*member.Member{answeredAll, answeredPartly, notAnswered, noChannel} {
+		groups[m.MemberID()] = []common.MemberGroupID{groupID}
+	}
+
+	var responses []*attendance.AttendanceResponse
+	for _, r := range []struct {
+		m  *member.Member
+		td *attendance.TargetDate
+	}{{answeredAll, td1}, {answeredAll, td2}, {answeredPartly, td1}} {
+		response, err := attendance.NewAttendanceResponse(now, collection.CollectionID(), tenantID, r.m.MemberID(), r.td.TargetDateID(), attendance.ResponseTypeAttending, "", nil, nil)
+		if err != nil {
+			t.Fatalf("failed to create response: %v", err)
+		}
+		responses = append(responses, response)
+	}
+
+	outboxRepo := &MockEnqueueOutboxRepository{}
+	uc := appnotification.NewSendDeadlineRemindersUsecase(
+		&MockCollectionRepository{
+			collections:      []*attendance.AttendanceCollection{collection},
+			targetDates:      []*attendance.TargetDate{td1, td2},
+			responses:        responses,
+			groupAssignments: []*attendance.CollectionGroupAssignment{assignment},
+		},
+		&MockScheduleRepository{},
+		&MockMemberRepository{members: []*member.Member{answeredAll, answeredPartly, notAnswered, noChannel, outsider}},
+		&MockMemberGroupRepository{groups: groups},
+		&MockMemberRoleRepository{},
+		&MockTenantRepository{tenant: ten},
+		outboxRepo,
+		&MockReminderLogRepository{logs: make(map[string]bool)},
+		&MockTxManager{},
+		[]notification.Channel{notification.ChannelDiscord, notification.ChannelEmail},
+		"https://example.com/",
+	)
+
+	output, err := uc.Execute(context.Background(), appnotification.SendDeadlineRemindersInput{TenantID: tenantID, Now: now})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if output.CollectionCount != 1 || output.RemindedCount != 2 || output.SkippedCount != 1 || output.FailedCount != 0 {
+		t.Fatalf("unexpected output: %+v", output)
+	}
+
+	channels := make(map[common.MemberID]notification.Channel)
+	for _, msg := range outboxRepo.saved {
+		if msg.NotificationType() != notification.NotificationTypeDeadlineReminder {
+			t.Errorf("NotificationType = %s, want %s", msg.NotificationType(), notification.NotificationTypeDeadlineReminder)
+		}
+		if !strings.Contains(msg.Content(), "https://example.com/p/attendance/"+collection.PublicToken().String()) {
+			t.Errorf("content does not contain the response URL: %s", msg.Content())
+		}
+		// 締切はテナントのタイムゾーン（Asia/Tokyo）で表示する
+		if !strings.Contains(msg.Content(), "2025-01-11 03:00") {
+			t.Errorf("content does not contain the deadline in the tenant timezone: %s", msg.Content())
+		}
+		channels[msg.RecipientID()] = msg.Channel()
+	}
+	if channels[answeredPartly.MemberID()] != notification.ChannelDiscord {
+		t.Errorf("partly answered member channel = %q, want %q", channels[answeredPartly.MemberID()], notification.ChannelDiscord)
+	}
+	if channels[notAnswered.MemberID()] != notification.ChannelEmail {
+		t.Errorf("not answered member channel = %q, want %q", channels[notAnswered.MemberID()], notification.ChannelEmail)
+	}
+
+	// 2回目の実行では同じ締切について再送しない
+	output, err = uc.Execute(context.Background(), appnotification.SendDeadlineRemindersInput{TenantID: tenantID, Now: now.Add(time.Hour)})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.RemindedCount != 0 || len(outboxRepo.saved) != 2 {
+		t.Errorf("reminded again: output = %+v, saved = %d", output, len(outboxRepo.saved))
+	}
+}
+
+func TestSendDeadlineRemindersUsecase_SkipsDeadlinesOutsideWindow(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	ten := createTestTenant(t, now)
+	tenantID := ten.TenantID()
+
+	later := now.Add(48 * time.Hour)
+	passed := now.Add(-time.Hour)
+	var collections []*attendance.AttendanceCollection
+	for _, deadline := range []*time.Time{&later, &passed, nil} {
+		c, err := attendance.NewAttendanceCollection(now.Add(-72*time.Hour), tenantID, "出欠", "", attendance.TargetTypeEvent, common.NewEventID().String(), deadline)
+		if err != nil {
+			t.Fatalf("failed to create collection: %v", err)
+		}
+		collections = append(collections, c)
+	}
+
+	tests := []struct {
+		name                string
+		within              time.Duration
+		wantCollectionCount int
+		wantRemindedCount   int
+	}{
+		{name: "既定の期間外の締切は対象外", within: 0, wantCollectionCount: 0, wantRemindedCount: 0},
+		{name: "期間を広げると対象になる", within: 72 * time.Hour, wantCollectionCount: 1, wantRemindedCount: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outboxRepo := &MockEnqueueOutboxRepository{}
+			uc := appnotification.NewSendDeadlineRemindersUsecase(
+				&MockCollectionRepository{collections: collections},
+				&MockScheduleRepository{},
+				&MockMemberRepository{members: []*member.Member{createTestMember(t, now, tenantID, "未回答", "100", "")}},
+				&MockMemberGroupRepository{},
+				&MockMemberRoleRepository{},
+				&MockTenantRepository{tenant: ten},
+				outboxRepo,
+				&MockReminderLogRepository{logs: make(map[string]bool)},
+				&MockTxManager{},
+				[]notification.Channel{notification.ChannelDiscord, notification.ChannelEmail},
+				"https://example.com/",
+			)
+
+			output, err := uc.Execute(context.Background(), appnotification.SendDeadlineRemindersInput{TenantID: tenantID, Now: now, Within: tt.within})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if output.CollectionCount != tt.wantCollectionCount || output.RemindedCount != tt.wantRemindedCount || len(outboxRepo.saved) != tt.wantRemindedCount {
+				t.Errorf("unexpected output: %+v, saved = %d", output, len(outboxRepo.saved))
+			}
+		})
+	}
+}
+
+func TestSendDeadlineRemindersUsecase_DoesNotRecordUndeliverableChannel(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	ten := createTestTenant(t, now)
+	tenantID := ten.TenantID()
+
+	deadline := now.Add(6 * time.Hour)
+	collection, err := attendance.NewAttendanceCollection(now.Add(-48*time.Hour), tenantID, "1月の出欠", "", attendance.TargetTypeEvent, common.NewEventID().String(), &deadline)
+	if err != nil {
+		t.Fatalf("failed to create collection: %v", err)
+	}
+	emailOnly := createTestMember(t, now, tenantID, "メールのみ", "", "member@example.com")
+
+	outboxRepo := &MockEnqueueOutboxRepository{}
+	logRepo := &MockReminderLogRepository{logs: make(map[string]bool)}
+	newUsecase := func(channels ...notification.Channel) *appnotification.SendDeadlineRemindersUsecase {
+		return appnotification.NewSendDeadlineRemindersUsecase(
+			&MockCollectionRepository{collections: []*attendance.AttendanceCollection{collection}},
+			&MockScheduleRepository{},
+			&MockMemberRepository{members: []*member.Member{emailOnly}},
+			&MockMemberGroupRepository{},
+			&MockMemberRoleRepository{},
+			&MockTenantRepository{tenant: ten},
+			outboxRepo,
+			logRepo,
+			&MockTxManager{},
+			channels,
+			"https://example.com/",
+		)
+	}
+
+	// メールの Dispatcher がない間は送らず、送信履歴も残さない
+	output, err := newUsecase(notification.ChannelDiscord).Execute(context.Background(), appnotification.SendDeadlineRemindersInput{TenantID: tenantID, Now: now})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.RemindedCount != 0 || output.SkippedCount != 1 || len(outboxRepo.saved) != 0 || len(logRepo.logs) != 0 {
+		t.Fatalf("unexpected output: %+v, saved = %d, logs = %d", output, len(outboxRepo.saved), len(logRepo.logs))
+	}
+
+	// メールを配信できるようになれば、同じ締切についてリマインドする
+	output, err = newUsecase(notification.ChannelDiscord, notification.ChannelEmail).Execute(context.Background(), appnotification.SendDeadlineRemindersInput{TenantID: tenantID, Now: now.Add(time.Hour)})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.RemindedCount != 1 || len(outboxRepo.saved) != 1 || outboxRepo.saved[0].Channel() != notification.ChannelEmail {
+		t.Errorf("unexpected output: %+v, saved = %d", output, len(outboxRepo.saved))
+	}
+}
+
+func TestSendDeadlineRemindersUsecase_SchedulesAndDryRun(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	ten := createTestTenant(t, now)
+	tenantID := ten.TenantID()
+
+	deadline := now.Add(3 * time.Hour)
+	scheduleID := common.NewScheduleID()
+	candidate, err := schedule.NewCandidateDate(now, scheduleID, now.AddDate(0, 0, 7), nil, nil, 0)
+	if err != nil {
+		t.Fatalf("failed to create candidate: %v", err)
+	}
+	s, err := schedule.NewDateSchedule(now, scheduleID, tenantID, "打ち上げ日程", "", nil, []*schedule.CandidateDate{candidate}, &deadline)
+	if err != nil {
+		t.Fatalf("failed to create schedule: %v", err)
+	}
+
+	answered := createTestMember(t, now, tenantID, "回答済み", "100", "")
+	notAnswered := createTestMember(t, now, tenantID, "未回答", "200", "")
+	response, err := schedule.NewDateScheduleResponse(now, scheduleID, tenantID, answered.MemberID(), candidate.CandidateID(), schedule.AvailabilityAvailable, "")
+	if err != nil {
+		t.Fatalf("failed to create response: %v", err)
+	}
+
+	outboxRepo := &MockEnqueueOutboxRepository{}
+	logRepo := &MockReminderLogRepository{logs: make(map[string]bool)}
+	uc := appnotification.NewSendDeadlineRemindersUsecase(
+		&MockCollectionRepository{},
+		&MockScheduleRepository{
+			schedules:  []*schedule.DateSchedule{s},
+			candidates: []*schedule.CandidateDate{candidate},
+			responses:  []*schedule.DateScheduleResponse{response},
+		},
+		&MockMemberRepository{members: []*member.Member{answered, notAnswered}},
+		&MockMemberGroupRepository{},
+		&MockMemberRoleRepository{},
+		&MockTenantRepository{tenant: ten},
+		outboxRepo,
+		logRepo,
+		&MockTxManager{},
+		[]notification.Channel{notification.ChannelDiscord, notification.ChannelEmail},
+		"https://example.com/",
+	)
+
+	output, err := uc.Execute(context.Background(), appnotification.SendDeadlineRemindersInput{TenantID: tenantID, Now: now, DryRun: true})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.ScheduleCount != 1 || output.RemindedCount != 1 {
+		t.Errorf("unexpected dry run output: %+v", output)
+	}
+	if len(outboxRepo.saved) != 0 || len(logRepo.logs) != 0 {
+		t.Errorf("dry run wrote reminders: saved = %d, logs = %d", len(outboxRepo.saved), len(logRepo.logs))
+	}
+
+	output, err = uc.Execute(context.Background(), appnotification.SendDeadlineRemindersInput{TenantID: tenantID, Now: now})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.RemindedCount != 1 || len(outboxRepo.saved) != 1 {
+		t.Fatalf("unexpected output: %+v, saved = %d", output, len(outboxRepo.saved))
+	}
+	if outboxRepo.saved[0].RecipientID() != notAnswered.MemberID() {
+		t.Errorf("RecipientID = %v, want %v", outboxRepo.saved[0].RecipientID(), notAnswered.MemberID())
+	}
+	if !strings.Contains(outboxRepo.saved[0].Content(), "https://example.com/p/schedule/"+s.PublicToken().String()) {
+		t.Errorf("content does not contain the response URL: %s", outboxRepo.saved[0].Content())
+	}
+}
@@ -0,0 +1,181 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+)
+
+// DefaultDeliveryBatchSize is the number of outbox messages processed per run
+const DefaultDeliveryBatchSize = 100
+
+// DeliverNotificationsInput represents the input for delivering notifications
+type DeliverNotificationsInput struct {
+	BatchSize int // 1回の実行で処理する最大件数（0 以下の場合は DefaultDeliveryBatchSize）
+}
+
+// DeliverNotificationsOutput represents the result of a delivery run
+type DeliverNotificationsOutput struct {
+	Delivered int // 配信に成功した件数
+	Retrying  int // 配信に失敗し、再配信を予約した件数
+	Failed    int // リトライ上限に達して配信を断念した件数
+	Deferred  int // 頻度制御で送信を見送った件数
+}
+
+// Processed returns the number of messages handled in the run
+func (o *DeliverNotificationsOutput) Processed() int {
+	return o.Delivered + o.Retrying + o.Failed + o.Deferred
+}
+
+// DeliverNotificationsUsecase is the outbox worker that delivers pending notifications
+//
+// Logic (メッセージごとに1トランザクション):
+//  1. 配信期限の来た pending のメッセージを1件ロックして取得（登録済みのチャネルのみ）
+//  2. 頻度制御: 直近 Window 内の同一メンバーへの送信成功件数が上限に達していれば延期
+//  3. チャネルの Dispatcher で配信
+//  4. 結果を notification_logs に記録し、アウトボックスの状態を更新（失敗時は指数バックオフで再配信、配信先がない場合は断念）
+type DeliverNotificationsUsecase struct {
+	outboxRepo  notification.OutboxRepository
+	logRepo     notification.LogRepository
+	dispatchers map[notification.Channel]notification.Dispatcher
+	limit       notification.FrequencyLimit
+	txManager   services.TxManager
+	clock       services.Clock
+}
+
+// NewDeliverNotificationsUsecase creates a new DeliverNotificationsUsecase
+// dispatchers に登録されていないチャネルのメッセージは配信せず pending のまま残す
+func NewDeliverNotificationsUsecase(
+	outboxRepo notification.OutboxRepository,
+	logRepo notification.LogRepository,
+	dispatchers []notification.Dispatcher,
+	limit notification.FrequencyLimit,
+	txManager services.TxManager,
+	clock services.Clock,
+) *DeliverNotificationsUsecase {
+	byChannel := make(map[notification.Channel]notification.Dispatcher, len(dispatchers))
+	for _, d := range dispatchers {
+		byChannel[d.Channel()] = d
+	}
+
+	return &DeliverNotificationsUsecase{
+		outboxRepo:  outboxRepo,
+		logRepo:     logRepo,
+		dispatchers: byChannel,
+		limit:       limit,
+		txManager:   txManager,
+		clock:       clock,
+	}
+}
+
+// Channels returns the channels that can be delivered
+func (uc *DeliverNotificationsUsecase) Channels() []notification.Channel {
+	channels := make([]notification.Channel, 0, len(uc.dispatchers))
+	for _, c := range []notification.Channel{notification.ChannelDiscord, notification.ChannelEmail, notification.ChannelWebPush} {
+		if _, ok := uc.dispatchers[c]; ok {
+			channels = append(channels, c)
+		}
+	}
+	return channels
+}
+
+// Execute delivers due notifications until none are left or the batch size is reached
+func (uc *DeliverNotificationsUsecase) Execute(ctx context.Context, input DeliverNotificationsInput) (*DeliverNotificationsOutput, error) {
+	batchSize := input.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultDeliveryBatchSize
+	}
+
+	output := &DeliverNotificationsOutput{}
+	channels := uc.Channels()
+	if len(channels) == 0 {
+		return output, nil
+	}
+
+	for output.Processed() < batchSize {
+		processed := false
+		err := uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+			messages, err := uc.outboxRepo.FindDue(txCtx, uc.clock.Now(), channels, 1)
+			if err != nil {
+				return err
+			}
+			if len(messages) == 0 {
+				return nil
+			}
+			processed = true
+			return uc.deliver(txCtx, messages[0], output)
+		})
+		if err != nil {
+			return output, err
+		}
+		if !processed {
+			break
+		}
+	}
+
+	return output, nil
+}
+
+// deliver delivers a single message and records the result
+func (uc *DeliverNotificationsUsecase) deliver(ctx context.Context, msg *notification.OutboxMessage, output *DeliverNotificationsOutput) error {
+	now := uc.clock.Now()
+
+	// 頻度制御（緊急ヘルプ要請は対象外）
+	if !msg.NotificationType().BypassesFrequencyLimit() {
+		sent, err := uc.logRepo.CountSentSince(ctx, msg.TenantID(), msg.RecipientID(), uc.limit.Since(now))
+		if err != nil {
+			return err
+		}
+		if !uc.limit.Allows(sent) {
+			if err := msg.Defer(now, uc.limit.DeferUntil(now)); err != nil {
+				return err
+			}
+			output.Deferred++
+			return uc.outboxRepo.Save(ctx, msg)
+		}
+	}
+
+	dispatcher, ok := uc.dispatchers[msg.Channel()]
+	if !ok {
+		return fmt.Errorf("no dispatcher for channel %s", msg.Channel())
+	}
+
+	deliveryErr := dispatcher.Dispatch(ctx, msg.Message())
+
+	deliveryLog, err := notification.NewDeliveryLog(now, msg, deliveryErr)
+	if err != nil {
+		return err
+	}
+	if err := uc.logRepo.Save(ctx, deliveryLog); err != nil {
+		return err
+	}
+
+	if deliveryErr == nil {
+		if err := msg.MarkDelivered(now); err != nil {
+			return err
+		}
+		output.Delivered++
+	} else if errors.Is(deliveryErr, notification.ErrUndeliverable) {
+		if err := msg.GiveUp(now, deliveryErr.Error()); err != nil {
+			return err
+		}
+		output.Failed++
+	} else {
+		if err := msg.MarkFailed(now, deliveryErr.Error()); err != nil {
+			return err
+		}
+		if msg.IsPending() {
+			output.Retrying++
+		} else {
+			output.Failed++
+			log.Printf("[Notification] 配信を断念しました: outbox_id=%s, channel=%s, attempts=%d, error=%v",
+				msg.OutboxID().String(), msg.Channel(), msg.Attempts(), deliveryErr)
+		}
+	}
+
+	return uc.outboxRepo.Save(ctx, msg)
+}
@@ -0,0 +1,175 @@
+package notification_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	appnotification "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+)
+
+// =====================================================
+// Mocks
+// =====================================================
+
+type MockClock struct {
+	now time.Time
+}
+
+func (m *MockClock) Now() time.Time { return m.now }
+
+type MockTxManager struct{}
+
+func (m *MockTxManager) WithTx(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+// MockOutboxRepository is an in-memory outbox
+type MockOutboxRepository struct {
+	messages []*notification.OutboxMessage
+}
+
+func (m *MockOutboxRepository) Save(ctx context.Context, msg *notification.OutboxMessage) error {
+	return nil
+}
+
+func (m *MockOutboxRepository) FindDue(ctx context.Context, now time.Time, channels []notification.Channel, limit int) ([]*notification.OutboxMessage, error) {
+	var result []*notification.OutboxMessage
+	for _, msg := range m.messages {
+		if !msg.IsPending() || msg.NextAttemptAt().After(now) {
+			continue
+		}
+		for _, c := range channels {
+			if msg.Channel() == c && len(result) < limit {
+				result = append(result, msg)
+			}
+		}
+	}
+	return result, nil
+}
+
+// MockLogRepository records the notification logs
+type MockLogRepository struct {
+	logs []*notification.NotificationLog
+}
+
+func (m *MockLogRepository) Save(ctx context.Context, log *notification.NotificationLog) error {
+	m.logs = append(m.logs, log)
+	return nil
+}
+
+func (m *MockLogRepository) CountSentSince(ctx context.Context, tenantID common.TenantID, recipientID common.MemberID, since time.Time) (int, error) {
+	count := 0
+	for _, l := range m.logs {
+		if l.RecipientID() == recipientID && l.IsSuccess() && !l.SentAt().Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// MockDispatcher fails the delivery when err is set
+type MockDispatcher struct {
+	channel notification.Channel
+	err     error
+	sent    []notification.Message
+}
+
+func (d *MockDispatcher) Channel() notification.Channel { return d.channel }
+
+func (d *MockDispatcher) Dispatch(ctx context.Context, msg notification.Message) error {
+	if d.err != nil {
+		return d.err
+	}
+	d.sent = append(d.sent, msg)
+	return nil
+}
+
+func createOutboxMessage(t *testing.T, now time.Time, recipientID common.MemberID, notificationType notification.NotificationType, channel notification.Channel) *notification.OutboxMessage {
+	t.Helper()
+	msg, err := notification.NewOutboxMessage(now, common.NewTenantID(), nil, recipientID, notificationType, channel, "テスト通知")
+	if err != nil {
+		t.Fatalf("Failed to create outbox message: %v", err)
+	}
+	return msg
+}
+
+// =====================================================
+// DeliverNotificationsUsecase Tests
+// =====================================================
+
+func TestDeliverNotificationsUsecase_DeliversAndRecordsLogs(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	recipientID := common.NewMemberID()
+	delivered := createOutboxMessage(t, now, recipientID, notification.NotificationTypeShiftConfirmed, notification.ChannelDiscord)
+	failing := createOutboxMessage(t, now, common.NewMemberID(), notification.NotificationTypeShiftConfirmed, notification.ChannelEmail)
+	noDispatcher := createOutboxMessage(t, now, common.NewMemberID(), notification.NotificationTypeShiftConfirmed, notification.ChannelWebPush)
+
+	outboxRepo := &MockOutboxRepository{messages: []*notification.OutboxMessage{delivered, failing, noDispatcher}}
+	logRepo := &MockLogRepository{}
+	discord := &MockDispatcher{channel: notification.ChannelDiscord}
+	email := &MockDispatcher{channel: notification.ChannelEmail, err: errors.New("smtp unavailable")}
+
+	uc := appnotification.NewDeliverNotificationsUsecase(outboxRepo, logRepo, []notification.Dispatcher{discord, email},
+		notification.DefaultFrequencyLimit, &MockTxManager{}, &MockClock{now: now})
+
+	output, err := uc.Execute(context.Background(), appnotification.DeliverNotificationsInput{})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if output.Delivered != 1 || output.Retrying != 1 || output.Failed != 0 || output.Deferred != 0 {
+		t.Errorf("unexpected output: %+v", *output)
+	}
+	if delivered.Status() != notification.OutboxStatusDelivered || len(discord.sent) != 1 {
+		t.Errorf("discord message should be delivered: status=%s", delivered.Status())
+	}
+	if !failing.IsPending() || failing.Attempts() != 1 || !failing.NextAttemptAt().After(now) {
+		t.Errorf("failed message should be scheduled for retry: attempts=%d next=%v", failing.Attempts(), failing.NextAttemptAt())
+	}
+	if !noDispatcher.IsPending() || noDispatcher.Attempts() != 0 {
+		t.Errorf("message without a dispatcher should be left untouched")
+	}
+
+	if len(logRepo.logs) != 2 {
+		t.Fatalf("expected 2 notification logs, got %d", len(logRepo.logs))
+	}
+	if !logRepo.logs[0].IsSuccess() || logRepo.logs[1].Status() != notification.DeliveryStatusFailed || logRepo.logs[1].ErrorMessage() != "smtp unavailable" {
+		t.Errorf("unexpected logs: %s, %s", logRepo.logs[0].Status(), logRepo.logs[1].Status())
+	}
+}
+
+func TestDeliverNotificationsUsecase_DefersWhenFrequencyLimitReached(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	recipientID := common.NewMemberID()
+	first := createOutboxMessage(t, now, recipientID, notification.NotificationTypeShiftConfirmed, notification.ChannelDiscord)
+	second := createOutboxMessage(t, now, recipientID, notification.NotificationTypeShiftConfirmed, notification.ChannelDiscord)
+	urgent := createOutboxMessage(t, now, recipientID, notification.NotificationTypeUrgentHelp, notification.ChannelDiscord)
+
+	outboxRepo := &MockOutboxRepository{messages: []*notification.OutboxMessage{first, second, urgent}}
+	logRepo := &MockLogRepository{}
+	discord := &MockDispatcher{channel: notification.ChannelDiscord}
+	limit := notification.FrequencyLimit{MaxCount: 1, Window: time.Hour}
+
+	uc := appnotification.NewDeliverNotificationsUsecase(outboxRepo, logRepo, []notification.Dispatcher{discord},
+		limit, &MockTxManager{}, &MockClock{now: now})
+
+	output, err := uc.Execute(context.Background(), appnotification.DeliverNotificationsInput{})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	// 2件目は頻度制御で延期、緊急ヘルプ要請は制限の対象外
+	if output.Delivered != 2 || output.Deferred != 1 {
+		t.Errorf("unexpected output: %+v", *output)
+	}
+	if !second.IsPending() || second.Attempts() != 0 || !second.NextAttemptAt().Equal(limit.DeferUntil(now)) {
+		t.Errorf("second message should be deferred: attempts=%d next=%v", second.Attempts(), second.NextAttemptAt())
+	}
+	if urgent.Status() != notification.OutboxStatusDelivered {
+		t.Errorf("urgent help should bypass the frequency limit: status=%s", urgent.Status())
+	}
+}
@@ -0,0 +1,197 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+)
+
+// findDiscordWebhook finds the tenant default (eventID == nil) or event override webhook
+// 未設定の場合は nil を返す
+func findDiscordWebhook(
+	ctx context.Context,
+	webhookRepo notification.DiscordWebhookRepository,
+	tenantID common.TenantID,
+	eventID *common.EventID,
+) (*notification.DiscordWebhook, error) {
+	var (
+		webhook *notification.DiscordWebhook
+		err     error
+	)
+	if eventID == nil {
+		webhook, err = webhookRepo.FindTenantDefault(ctx, tenantID)
+	} else {
+		webhook, err = webhookRepo.FindByEventID(ctx, tenantID, *eventID)
+	}
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find discord webhook: %w", err)
+	}
+	return webhook, nil
+}
+
+// =====================================================
+// Get
+// =====================================================
+
+// GetDiscordWebhookInput represents the input for getting a Discord webhook setting
+// EventID が nil の場合はテナントの既定設定
+type GetDiscordWebhookInput struct {
+	TenantID common.TenantID
+	EventID  *common.EventID
+}
+
+// DiscordWebhookResult represents a webhook setting and the webhook effectively used
+type DiscordWebhookResult struct {
+	Webhook   *notification.DiscordWebhook // 未設定の場合は nil
+	Effective *notification.DiscordWebhook // テナントの既定設定とイベント個別の設定を解決した結果（投稿しない場合は nil）
+}
+
+// GetDiscordWebhookUsecase handles retrieving a Discord webhook setting
+type GetDiscordWebhookUsecase struct {
+	eventRepo   event.EventRepository
+	webhookRepo notification.DiscordWebhookRepository
+}
+
+// NewGetDiscordWebhookUsecase creates a new GetDiscordWebhookUsecase
+func NewGetDiscordWebhookUsecase(eventRepo event.EventRepository, webhookRepo notification.DiscordWebhookRepository) *GetDiscordWebhookUsecase {
+	return &GetDiscordWebhookUsecase{
+		eventRepo:   eventRepo,
+		webhookRepo: webhookRepo,
+	}
+}
+
+// Execute retrieves the webhook setting and the effective webhook
+func (uc *GetDiscordWebhookUsecase) Execute(ctx context.Context, input GetDiscordWebhookInput) (*DiscordWebhookResult, error) {
+	if input.EventID != nil {
+		// イベントの存在確認
+		if _, err := uc.eventRepo.FindByID(ctx, input.TenantID, *input.EventID); err != nil {
+			return nil, err
+		}
+	}
+
+	tenantDefault, err := findDiscordWebhook(ctx, uc.webhookRepo, input.TenantID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.EventID == nil {
+		return &DiscordWebhookResult{
+			Webhook:   tenantDefault,
+			Effective: notification.ResolveDiscordWebhook(tenantDefault, nil),
+		}, nil
+	}
+
+	eventWebhook, err := findDiscordWebhook(ctx, uc.webhookRepo, input.TenantID, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DiscordWebhookResult{
+		Webhook:   eventWebhook,
+		Effective: notification.ResolveDiscordWebhook(tenantDefault, eventWebhook),
+	}, nil
+}
+
+// =====================================================
+// Put / Delete
+// =====================================================
+
+// PutDiscordWebhookInput represents the input for setting a Discord webhook
+// EventID が nil の場合はテナントの既定設定
+type PutDiscordWebhookInput struct {
+	TenantID   common.TenantID
+	EventID    *common.EventID
+	WebhookURL string
+	Enabled    bool
+}
+
+// PutDiscordWebhookUsecase handles creating or replacing a Discord webhook setting
+type PutDiscordWebhookUsecase struct {
+	eventRepo   event.EventRepository
+	webhookRepo notification.DiscordWebhookRepository
+	clock       services.Clock
+}
+
+// NewPutDiscordWebhookUsecase creates a new PutDiscordWebhookUsecase
+func NewPutDiscordWebhookUsecase(
+	eventRepo event.EventRepository,
+	webhookRepo notification.DiscordWebhookRepository,
+	clock services.Clock,
+) *PutDiscordWebhookUsecase {
+	return &PutDiscordWebhookUsecase{
+		eventRepo:   eventRepo,
+		webhookRepo: webhookRepo,
+		clock:       clock,
+	}
+}
+
+// Execute creates the webhook setting, or replaces it if it already exists
+func (uc *PutDiscordWebhookUsecase) Execute(ctx context.Context, input PutDiscordWebhookInput) (*notification.DiscordWebhook, error) {
+	if input.EventID != nil {
+		// イベントの存在確認
+		if _, err := uc.eventRepo.FindByID(ctx, input.TenantID, *input.EventID); err != nil {
+			return nil, err
+		}
+	}
+
+	webhook, err := findDiscordWebhook(ctx, uc.webhookRepo, input.TenantID, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	if webhook == nil {
+		webhook, err = notification.NewDiscordWebhook(now, input.TenantID, input.EventID, input.WebhookURL, input.Enabled)
+		if err != nil {
+			return nil, err
+		}
+	} else if err := webhook.Update(now, input.WebhookURL, input.Enabled); err != nil {
+		return nil, err
+	}
+
+	if err := uc.webhookRepo.Save(ctx, webhook); err != nil {
+		return nil, fmt.Errorf("failed to save discord webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+// DeleteDiscordWebhookInput represents the input for deleting a Discord webhook setting
+// EventID が nil の場合はテナントの既定設定
+type DeleteDiscordWebhookInput struct {
+	TenantID common.TenantID
+	EventID  *common.EventID
+}
+
+// DeleteDiscordWebhookUsecase handles deleting a Discord webhook setting
+// イベント個別の設定を削除するとテナントの既定設定に戻る
+type DeleteDiscordWebhookUsecase struct {
+	webhookRepo notification.DiscordWebhookRepository
+}
+
+// NewDeleteDiscordWebhookUsecase creates a new DeleteDiscordWebhookUsecase
+func NewDeleteDiscordWebhookUsecase(webhookRepo notification.DiscordWebhookRepository) *DeleteDiscordWebhookUsecase {
+	return &DeleteDiscordWebhookUsecase{
+		webhookRepo: webhookRepo,
+	}
+}
+
+// Execute deletes the webhook setting
+func (uc *DeleteDiscordWebhookUsecase) Execute(ctx context.Context, input DeleteDiscordWebhookInput) error {
+	webhook, err := findDiscordWebhook(ctx, uc.webhookRepo, input.TenantID, input.EventID)
+	if err != nil {
+		return err
+	}
+	if webhook == nil {
+		return common.NewNotFoundError("DiscordWebhook", "")
+	}
+
+	return uc.webhookRepo.Delete(ctx, input.TenantID, webhook.WebhookID())
+}
@@ -197,7 +197,7 @@ func (uc *SubscribeUsecase) validateInput(input SubscribeInput) error {
 		return common.NewValidationError("表示名は必須です", nil)
 	}
 	if input.Timezone == "" {
-		input.Timezone = "Asia/Tokyo"
+		input.Timezone = tenant.DefaultTimezone
 	}
 	return nil
 }
@@ -0,0 +1,156 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	appnotification "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/schedule"
+)
+
+// DefaultClosedSummaryLookback は締切のまとめを告知する、システムが締め切ってからの期間（出欠確認と同じ）
+const DefaultClosedSummaryLookback = 24 * time.Hour
+
+// closeIfDeadlinePassed closes the schedule if its deadline has passed and returns the latest schedule
+// 一覧・詳細の閲覧時に呼び出し、締切を過ぎても open のまま表示されないようにする
+// 同時に行われた管理者の編集を上書きしないよう条件付き UPDATE で締め切り、締め切った場合のみ再取得する
+func closeIfDeadlinePassed(ctx context.Context, repo schedule.DateScheduleRepository, s *schedule.DateSchedule, now time.Time) (*schedule.DateSchedule, error) {
+	if !s.IsExpired(now) {
+		return s, nil
+	}
+	closed, err := repo.CloseIfExpired(ctx, s.TenantID(), s.ScheduleID(), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to close expired schedule: %w", err)
+	}
+	if !closed {
+		return s, nil
+	}
+	reloaded, err := repo.FindByID(ctx, s.TenantID(), s.ScheduleID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to reload closed schedule: %w", err)
+	}
+	return reloaded, nil
+}
+
+// CloseExpiredSchedulesInput represents the input for closing the expired schedules of a tenant
+type CloseExpiredSchedulesInput struct {
+	TenantID  common.TenantID
+	Now       time.Time
+	Summarize bool // 締め切った日程調整の回答のまとめを告知する
+	DryRun    bool
+}
+
+// CloseExpiredSchedulesOutput represents the result of closing the expired schedules of a tenant
+type CloseExpiredSchedulesOutput struct {
+	ClosedCount  int
+	SummaryCount int // 回答のまとめを告知した件数
+	FailedCount  int
+}
+
+// CloseExpiredSchedulesUsecase closes the open schedules whose deadline has passed
+// 管理者が締め切る CloseScheduleUsecase と異なり、システムが締め切ったことを記録する
+type CloseExpiredSchedulesUsecase struct {
+	repo      schedule.DateScheduleRepository
+	logRepo   notification.AnnouncementLogRepository
+	announcer notification.Announcer
+}
+
+// NewCloseExpiredSchedulesUsecase creates a new CloseExpiredSchedulesUsecase
+func NewCloseExpiredSchedulesUsecase(
+	repo schedule.DateScheduleRepository,
+	logRepo notification.AnnouncementLogRepository,
+	announcer notification.Announcer,
+) *CloseExpiredSchedulesUsecase {
+	return &CloseExpiredSchedulesUsecase{
+		repo:      repo,
+		logRepo:   logRepo,
+		announcer: announcer,
+	}
+}
+
+// Execute closes the expired schedules of the tenant and optionally announces their summaries
+// 個々の失敗はバッチ全体を止めずに FailedCount に数える
+func (u *CloseExpiredSchedulesUsecase) Execute(ctx context.Context, input CloseExpiredSchedulesInput) (*CloseExpiredSchedulesOutput, error) {
+	schedules, err := u.repo.FindByTenantID(ctx, input.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find schedules: %w", err)
+	}
+
+	output := &CloseExpiredSchedulesOutput{}
+	for i, s := range schedules {
+		if !s.IsExpired(input.Now) {
+			continue
+		}
+		if input.DryRun {
+			output.ClosedCount++
+			continue
+		}
+		closed, err := closeIfDeadlinePassed(ctx, u.repo, s, input.Now)
+		if err != nil {
+			output.FailedCount++
+			continue
+		}
+		if closed.IsAutoClosed() {
+			output.ClosedCount++
+		}
+		schedules[i] = closed
+	}
+
+	if input.Summarize && u.announcer != nil {
+		since := input.Now.Add(-DefaultClosedSummaryLookback)
+		for _, s := range schedules {
+			if !s.IsAutoClosed() || s.AutoClosedAt().Before(since) {
+				continue
+			}
+			u.summarizeOnce(ctx, input, s, output)
+		}
+	}
+
+	return output, nil
+}
+
+// summarizeOnce announces the summary of the schedule unless it has already been announced
+func (u *CloseExpiredSchedulesUsecase) summarizeOnce(ctx context.Context, input CloseExpiredSchedulesInput, s *schedule.DateSchedule, output *CloseExpiredSchedulesOutput) {
+	kind := notification.AnnouncementKindScheduleClosed
+	announced, err := u.logRepo.Exists(ctx, input.TenantID, kind, s.ScheduleID().String())
+	if err != nil {
+		output.FailedCount++
+		return
+	}
+	if announced {
+		return
+	}
+	if input.DryRun {
+		output.SummaryCount++
+		return
+	}
+
+	candidates, err := u.repo.FindCandidatesByScheduleID(ctx, s.ScheduleID())
+	if err != nil {
+		output.FailedCount++
+		return
+	}
+	responses, err := u.repo.FindResponsesByScheduleID(ctx, s.ScheduleID())
+	if err != nil {
+		output.FailedCount++
+		return
+	}
+
+	if err := u.announcer.Announce(ctx, appnotification.ScheduleClosedAnnouncement(s, candidates, responses)); err != nil {
+		// 投稿先が設定されていない場合はまとめを告知しない
+		if !errors.Is(err, notification.ErrUndeliverable) {
+			output.FailedCount++
+		}
+		return
+	}
+
+	if err := u.logRepo.Record(ctx, input.TenantID, kind, s.ScheduleID().String(), input.Now); err != nil {
+		output.FailedCount++
+		return
+	}
+	output.SummaryCount++
+}
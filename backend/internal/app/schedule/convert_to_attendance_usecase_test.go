@@ -44,6 +44,10 @@ func (m *MockAttendanceCollectionRepository) Save(ctx context.Context, collectio
 	return nil
 }
 
+func (m *MockAttendanceCollectionRepository) CloseIfExpired(ctx context.Context, tenantID common.TenantID, id common.CollectionID, now time.Time) (bool, error) {
+	return false, nil
+}
+
 func (m *MockAttendanceCollectionRepository) FindByID(ctx context.Context, tenantID common.TenantID, id common.CollectionID) (*attendance.AttendanceCollection, error) {
 	if m.findByIDFunc != nil {
 		return m.findByIDFunc(ctx, tenantID, id)
@@ -131,6 +131,8 @@ type GetScheduleOutput struct {
 	PublicToken        string         `json:"public_token"`
 	Status             string         `json:"status"`
 	Deadline           *time.Time     `json:"deadline,omitempty"`
+	AutoClosedAt       *time.Time     `json:"auto_closed_at,omitempty"`
+	Timezone           string         `json:"timezone,omitempty"` // テナントのタイムゾーン（公開ページのみ）
 	DecidedCandidateID *string        `json:"decided_candidate_id,omitempty"`
 	Candidates         []CandidateDTO `json:"candidates"`
 	GroupIDs           []string       `json:"group_ids,omitempty"`
@@ -188,3 +190,25 @@ type ConvertToAttendanceOutput struct {
 	PublicToken  string `json:"public_token"`
 	Title        string `json:"title"`
 }
+
+// ListMemberOpenSchedulesInput represents the input for listing the open schedules of a member
+type ListMemberOpenSchedulesInput struct {
+	TenantID string // from bot context
+	MemberID string // from bot context (Discord ユーザーから解決)
+}
+
+// MemberOpenScheduleDTO represents an open schedule with the member's own responses
+type MemberOpenScheduleDTO struct {
+	ScheduleID  string                `json:"schedule_id"`
+	Title       string                `json:"title"`
+	Description string                `json:"description"`
+	PublicToken string                `json:"public_token"` // 回答は公開ページから行う
+	Deadline    *time.Time            `json:"deadline,omitempty"`
+	Candidates  []CandidateDTO        `json:"candidates"`
+	Responses   []ScheduleResponseDTO `json:"responses"`
+}
+
+// ListMemberOpenSchedulesOutput represents the output for listing the open schedules of a member
+type ListMemberOpenSchedulesOutput struct {
+	Schedules []MemberOpenScheduleDTO `json:"schedules"`
+}
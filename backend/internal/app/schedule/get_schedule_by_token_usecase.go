@@ -5,19 +5,27 @@ import (
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/schedule"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
 )
 
 // GetScheduleByTokenUsecase handles getting a schedule by public token
 type GetScheduleByTokenUsecase struct {
-	repo schedule.DateScheduleRepository
+	repo       schedule.DateScheduleRepository
+	tenantRepo tenant.TenantRepository
+	clock      services.Clock
 }
 
 // NewGetScheduleByTokenUsecase creates a new GetScheduleByTokenUsecase
 func NewGetScheduleByTokenUsecase(
 	repo schedule.DateScheduleRepository,
+	tenantRepo tenant.TenantRepository,
+	clock services.Clock,
 ) *GetScheduleByTokenUsecase {
 	return &GetScheduleByTokenUsecase{
-		repo: repo,
+		repo:       repo,
+		tenantRepo: tenantRepo,
+		clock:      clock,
 	}
 }
 
@@ -40,6 +48,12 @@ func (u *GetScheduleByTokenUsecase) Execute(ctx context.Context, input GetSchedu
 		return nil, ErrScheduleNotFound
 	}
 
+	// 締切を過ぎたものは閲覧時に締め切る
+	sched, err = closeIfDeadlinePassed(ctx, u.repo, sched, u.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	// 3. Get candidates
 	candidates, err := u.repo.FindCandidatesByScheduleID(ctx, sched.ScheduleID())
 	if err != nil {
@@ -56,7 +70,19 @@ func (u *GetScheduleByTokenUsecase) Execute(ctx context.Context, input GetSchedu
 		groupIDs[i] = ga.GroupID().String()
 	}
 
-	// 5. Convert to output
+	// 5. Resolve tenant timezone
+	// 公開ページは認証がないため、締切をテナントのタイムゾーンで表示できるようにする
+	loc, err := tenant.ResolveLocation(ctx, u.tenantRepo, sched.TenantID())
+	if err != nil {
+		return nil, err
+	}
+	deadline := sched.Deadline()
+	if deadline != nil {
+		local := deadline.In(loc)
+		deadline = &local
+	}
+
+	// 6. Convert to output
 	candidateOutputs := make([]CandidateDTO, len(candidates))
 	for i, c := range candidates {
 		candidateOutputs[i] = CandidateDTO{
@@ -87,7 +113,9 @@ func (u *GetScheduleByTokenUsecase) Execute(ctx context.Context, input GetSchedu
 		EventID:            eventID,
 		PublicToken:        sched.PublicToken().String(),
 		Status:             sched.Status().String(),
-		Deadline:           sched.Deadline(),
+		Deadline:           deadline,
+		AutoClosedAt:       sched.AutoClosedAt(),
+		Timezone:           loc.String(),
 		DecidedCandidateID: decidedCandidateID,
 		Candidates:         candidateOutputs,
 		GroupIDs:           groupIDs,
@@ -5,14 +5,16 @@ import (
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/schedule"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
 )
 
 type GetScheduleUsecase struct {
-	repo schedule.DateScheduleRepository
+	repo  schedule.DateScheduleRepository
+	clock services.Clock
 }
 
-func NewGetScheduleUsecase(repo schedule.DateScheduleRepository) *GetScheduleUsecase {
-	return &GetScheduleUsecase{repo: repo}
+func NewGetScheduleUsecase(repo schedule.DateScheduleRepository, clock services.Clock) *GetScheduleUsecase {
+	return &GetScheduleUsecase{repo: repo, clock: clock}
 }
 
 func (u *GetScheduleUsecase) Execute(ctx context.Context, input GetScheduleInput) (*GetScheduleOutput, error) {
@@ -31,6 +33,12 @@ func (u *GetScheduleUsecase) Execute(ctx context.Context, input GetScheduleInput
 		return nil, err
 	}
 
+	// 締切を過ぎたものは閲覧時に締め切る
+	sch, err = closeIfDeadlinePassed(ctx, u.repo, sch, u.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	candidateDTOs := make([]CandidateDTO, len(sch.Candidates()))
 	for i, c := range sch.Candidates() {
 		candidateDTOs[i] = CandidateDTO{
@@ -72,6 +80,7 @@ func (u *GetScheduleUsecase) Execute(ctx context.Context, input GetScheduleInput
 		PublicToken:        sch.PublicToken().String(),
 		Status:             sch.Status().String(),
 		Deadline:           sch.Deadline(),
+		AutoClosedAt:       sch.AutoClosedAt(),
 		DecidedCandidateID: decidedCandidateIDStr,
 		Candidates:         candidateDTOs,
 		GroupIDs:           groupIDs,
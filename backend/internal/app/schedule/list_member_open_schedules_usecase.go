@@ -0,0 +1,114 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/schedule"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+)
+
+// ListMemberOpenSchedulesUsecase handles listing the open schedules a member is asked to respond to
+type ListMemberOpenSchedulesUsecase struct {
+	repo            schedule.DateScheduleRepository
+	memberGroupRepo member.MemberGroupRepository
+	clock           services.Clock
+}
+
+// NewListMemberOpenSchedulesUsecase creates a new ListMemberOpenSchedulesUsecase
+func NewListMemberOpenSchedulesUsecase(
+	repo schedule.DateScheduleRepository,
+	memberGroupRepo member.MemberGroupRepository,
+	clock services.Clock,
+) *ListMemberOpenSchedulesUsecase {
+	return &ListMemberOpenSchedulesUsecase{
+		repo:            repo,
+		memberGroupRepo: memberGroupRepo,
+		clock:           clock,
+	}
+}
+
+// Execute lists the schedules that accept responses now and target the member
+func (u *ListMemberOpenSchedulesUsecase) Execute(ctx context.Context, input ListMemberOpenSchedulesInput) (*ListMemberOpenSchedulesOutput, error) {
+	tenantID, err := common.ParseTenantID(input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	memberID, err := common.ParseMemberID(input.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules, err := u.repo.FindByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupIDs, err := u.memberGroupRepo.FindGroupIDsByMemberID(ctx, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find member groups: %w", err)
+	}
+
+	now := u.clock.Now()
+	result := make([]MemberOpenScheduleDTO, 0)
+	for _, s := range schedules {
+		if s.CanRespond(now) != nil {
+			continue
+		}
+
+		assignments, err := u.repo.FindGroupAssignmentsByScheduleID(ctx, s.ScheduleID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to find group assignments: %w", err)
+		}
+		if !schedule.IsTargetMember(assignments, groupIDs) {
+			continue
+		}
+
+		candidates, err := u.repo.FindCandidatesByScheduleID(ctx, s.ScheduleID())
+		if err != nil {
+			return nil, err
+		}
+		candidateDTOs := make([]CandidateDTO, 0, len(candidates))
+		for _, c := range candidates {
+			candidateDTOs = append(candidateDTOs, CandidateDTO{
+				CandidateID: c.CandidateID().String(),
+				Date:        c.CandidateDateValue(),
+				StartTime:   c.StartTime(),
+				EndTime:     c.EndTime(),
+			})
+		}
+
+		responses, err := u.repo.FindResponsesByScheduleID(ctx, s.ScheduleID())
+		if err != nil {
+			return nil, err
+		}
+		responseDTOs := make([]ScheduleResponseDTO, 0)
+		for _, r := range responses {
+			if r.MemberID() != memberID {
+				continue
+			}
+			responseDTOs = append(responseDTOs, ScheduleResponseDTO{
+				ResponseID:   r.ResponseID().String(),
+				MemberID:     r.MemberID().String(),
+				CandidateID:  r.CandidateID().String(),
+				Availability: r.Availability().String(),
+				Note:         r.Note(),
+				RespondedAt:  r.RespondedAt(),
+			})
+		}
+
+		result = append(result, MemberOpenScheduleDTO{
+			ScheduleID:  s.ScheduleID().String(),
+			Title:       s.Title(),
+			Description: s.Description(),
+			PublicToken: s.PublicToken().String(),
+			Deadline:    s.Deadline(),
+			Candidates:  candidateDTOs,
+			Responses:   responseDTOs,
+		})
+	}
+
+	return &ListMemberOpenSchedulesOutput{Schedules: result}, nil
+}
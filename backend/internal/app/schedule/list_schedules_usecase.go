@@ -6,17 +6,20 @@ import (
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/schedule"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
 )
 
 // ListSchedulesUsecase handles listing schedules for a tenant
 type ListSchedulesUsecase struct {
 	scheduleRepo schedule.DateScheduleRepository
+	clock        services.Clock
 }
 
 // NewListSchedulesUsecase creates a new ListSchedulesUsecase
-func NewListSchedulesUsecase(scheduleRepo schedule.DateScheduleRepository) *ListSchedulesUsecase {
+func NewListSchedulesUsecase(scheduleRepo schedule.DateScheduleRepository, clock services.Clock) *ListSchedulesUsecase {
 	return &ListSchedulesUsecase{
 		scheduleRepo: scheduleRepo,
+		clock:        clock,
 	}
 }
 
@@ -40,6 +43,7 @@ type ScheduleSummary struct {
 	PublicToken        string     `json:"public_token"`
 	Status             string     `json:"status"`
 	Deadline           *time.Time `json:"deadline"`
+	AutoClosedAt       *time.Time `json:"auto_closed_at,omitempty"`
 	DecidedCandidateID *string    `json:"decided_candidate_id"`
 	CandidateCount     int        `json:"candidate_count"`
 	ResponseCount      int        `json:"response_count"`
@@ -62,8 +66,15 @@ func (u *ListSchedulesUsecase) Execute(ctx context.Context, input ListSchedulesI
 	}
 
 	// 3. Get response counts for each schedule
+	now := u.clock.Now()
 	summaries := make([]ScheduleSummary, 0, len(schedules))
 	for _, s := range schedules {
+		// 締切を過ぎたものは閲覧時に締め切る
+		s, err := closeIfDeadlinePassed(ctx, u.scheduleRepo, s, now)
+		if err != nil {
+			return nil, err
+		}
+
 		// Get responses for this schedule
 		responses, err := u.scheduleRepo.FindResponsesByScheduleID(ctx, s.ScheduleID())
 		if err != nil {
@@ -97,6 +108,7 @@ func (u *ListSchedulesUsecase) Execute(ctx context.Context, input ListSchedulesI
 			PublicToken:        s.PublicToken().String(),
 			Status:             s.Status().String(),
 			Deadline:           s.Deadline(),
+			AutoClosedAt:       s.AutoClosedAt(),
 			DecidedCandidateID: decidedCandidateIDStr,
 			CandidateCount:     len(s.Candidates()),
 			ResponseCount:      len(memberMap),
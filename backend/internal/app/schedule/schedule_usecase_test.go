@@ -9,6 +9,7 @@ import (
 	appschedule "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/schedule"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/schedule"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
 )
 
 // =====================================================
@@ -26,6 +27,7 @@ type MockDateScheduleRepository struct {
 	findCandidatesByScheduleIDFunc       func(ctx context.Context, scheduleID common.ScheduleID) ([]*schedule.CandidateDate, error)
 	saveGroupAssignmentsFunc             func(ctx context.Context, scheduleID common.ScheduleID, assignments []*schedule.ScheduleGroupAssignment) error
 	findGroupAssignmentsByScheduleIDFunc func(ctx context.Context, scheduleID common.ScheduleID) ([]*schedule.ScheduleGroupAssignment, error)
+	closeIfExpiredFunc                   func(ctx context.Context, tenantID common.TenantID, id common.ScheduleID, now time.Time) (bool, error)
 }
 
 func (m *MockDateScheduleRepository) Save(ctx context.Context, sch *schedule.DateSchedule) error {
@@ -35,6 +37,13 @@ func (m *MockDateScheduleRepository) Save(ctx context.Context, sch *schedule.Dat
 	return nil
 }
 
+func (m *MockDateScheduleRepository) CloseIfExpired(ctx context.Context, tenantID common.TenantID, id common.ScheduleID, now time.Time) (bool, error) {
+	if m.closeIfExpiredFunc != nil {
+		return m.closeIfExpiredFunc(ctx, tenantID, id, now)
+	}
+	return false, nil
+}
+
 func (m *MockDateScheduleRepository) FindByID(ctx context.Context, tenantID common.TenantID, id common.ScheduleID) (*schedule.DateSchedule, error) {
 	if m.findByIDFunc != nil {
 		return m.findByIDFunc(ctx, tenantID, id)
@@ -103,6 +112,28 @@ func (m *MockClock) Now() time.Time {
 	return time.Now()
 }
 
+// MockTenantRepository returns a tenant in the given timezone
+type MockTenantRepository struct {
+	timezone string
+}
+
+func (m *MockTenantRepository) FindByID(ctx context.Context, tenantID common.TenantID) (*tenant.Tenant, error) {
+	now := time.Now()
+	return tenant.ReconstructTenant(tenantID, "Test Tenant", m.timezone, true, tenant.TenantStatusActive, nil, nil, nil, now, now, nil)
+}
+
+func (m *MockTenantRepository) FindByPendingStripeSessionID(ctx context.Context, sessionID string) (*tenant.Tenant, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockTenantRepository) Save(ctx context.Context, t *tenant.Tenant) error {
+	return nil
+}
+
+func (m *MockTenantRepository) ListAll(ctx context.Context, status *tenant.TenantStatus, limit, offset int) ([]*tenant.Tenant, int, error) {
+	return nil, 0, nil
+}
+
 // =====================================================
 // Test Helper Functions
 // =====================================================
@@ -247,7 +278,8 @@ func TestListSchedulesUsecase_Execute_Success(t *testing.T) {
 		},
 	}
 
-	usecase := appschedule.NewListSchedulesUsecase(repo)
+	clock := &MockClock{nowFunc: func() time.Time { return time.Now() }}
+	usecase := appschedule.NewListSchedulesUsecase(repo, clock)
 
 	input := appschedule.ListSchedulesInput{
 		TenantID: tenantID.String(),
@@ -273,7 +305,8 @@ func TestListSchedulesUsecase_Execute_EmptyList(t *testing.T) {
 		},
 	}
 
-	usecase := appschedule.NewListSchedulesUsecase(repo)
+	clock := &MockClock{nowFunc: func() time.Time { return time.Now() }}
+	usecase := appschedule.NewListSchedulesUsecase(repo, clock)
 
 	input := appschedule.ListSchedulesInput{
 		TenantID: tenantID.String(),
@@ -293,7 +326,8 @@ func TestListSchedulesUsecase_Execute_EmptyList(t *testing.T) {
 func TestListSchedulesUsecase_Execute_ErrorWhenInvalidTenantID(t *testing.T) {
 	repo := &MockDateScheduleRepository{}
 
-	usecase := appschedule.NewListSchedulesUsecase(repo)
+	clock := &MockClock{nowFunc: func() time.Time { return time.Now() }}
+	usecase := appschedule.NewListSchedulesUsecase(repo, clock)
 
 	input := appschedule.ListSchedulesInput{
 		TenantID: "invalid-ulid",
@@ -315,7 +349,8 @@ func TestListSchedulesUsecase_Execute_ErrorWhenFindFails(t *testing.T) {
 		},
 	}
 
-	usecase := appschedule.NewListSchedulesUsecase(repo)
+	clock := &MockClock{nowFunc: func() time.Time { return time.Now() }}
+	usecase := appschedule.NewListSchedulesUsecase(repo, clock)
 
 	input := appschedule.ListSchedulesInput{
 		TenantID: tenantID.String(),
@@ -345,7 +380,8 @@ func TestGetScheduleUsecase_Execute_Success(t *testing.T) {
 		},
 	}
 
-	usecase := appschedule.NewGetScheduleUsecase(repo)
+	clock := &MockClock{nowFunc: func() time.Time { return time.Now() }}
+	usecase := appschedule.NewGetScheduleUsecase(repo, clock)
 
 	input := appschedule.GetScheduleInput{
 		TenantID:   tenantID.String(),
@@ -377,7 +413,8 @@ func TestGetScheduleUsecase_Execute_NotFound(t *testing.T) {
 		},
 	}
 
-	usecase := appschedule.NewGetScheduleUsecase(repo)
+	clock := &MockClock{nowFunc: func() time.Time { return time.Now() }}
+	usecase := appschedule.NewGetScheduleUsecase(repo, clock)
 
 	input := appschedule.GetScheduleInput{
 		TenantID:   tenantID.String(),
@@ -394,7 +431,8 @@ func TestGetScheduleUsecase_Execute_NotFound(t *testing.T) {
 func TestGetScheduleUsecase_Execute_ErrorWhenInvalidTenantID(t *testing.T) {
 	repo := &MockDateScheduleRepository{}
 
-	usecase := appschedule.NewGetScheduleUsecase(repo)
+	clock := &MockClock{nowFunc: func() time.Time { return time.Now() }}
+	usecase := appschedule.NewGetScheduleUsecase(repo, clock)
 
 	input := appschedule.GetScheduleInput{
 		TenantID:   "invalid-ulid",
@@ -412,7 +450,8 @@ func TestGetScheduleUsecase_Execute_ErrorWhenInvalidScheduleID(t *testing.T) {
 	tenantID := common.NewTenantID()
 	repo := &MockDateScheduleRepository{}
 
-	usecase := appschedule.NewGetScheduleUsecase(repo)
+	clock := &MockClock{nowFunc: func() time.Time { return time.Now() }}
+	usecase := appschedule.NewGetScheduleUsecase(repo, clock)
 
 	input := appschedule.GetScheduleInput{
 		TenantID:   tenantID.String(),
@@ -748,3 +787,53 @@ func TestDeleteScheduleUsecase_Execute_ErrorWhenSaveFails(t *testing.T) {
 		t.Fatal("Execute() should fail when save fails")
 	}
 }
+
+// =====================================================
+// GetScheduleByTokenUsecase Tests
+// =====================================================
+
+func TestGetScheduleByTokenUsecase_Execute_DeadlineInTenantTimezone(t *testing.T) {
+	tenantID := common.NewTenantID()
+	scheduleID := common.NewScheduleID()
+	now := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	// ニューヨークの 3/9（夏時間開始日）23:59:59 EDT
+	deadline := time.Date(2025, 3, 10, 3, 59, 59, 0, time.UTC)
+
+	candidate, err := schedule.NewCandidateDate(now, scheduleID, time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC), nil, nil, 0)
+	if err != nil {
+		t.Fatalf("Failed to create test candidate: %v", err)
+	}
+	sch, err := schedule.NewDateSchedule(now, scheduleID, tenantID, "Test Schedule", "", nil, []*schedule.CandidateDate{candidate}, &deadline)
+	if err != nil {
+		t.Fatalf("Failed to create test schedule: %v", err)
+	}
+
+	repo := &MockDateScheduleRepository{
+		findByTokenFunc: func(ctx context.Context, token common.PublicToken) (*schedule.DateSchedule, error) {
+			return sch, nil
+		},
+		findCandidatesByScheduleIDFunc: func(ctx context.Context, scheduleID common.ScheduleID) ([]*schedule.CandidateDate, error) {
+			return []*schedule.CandidateDate{candidate}, nil
+		},
+	}
+
+	clock := &MockClock{nowFunc: func() time.Time { return now }}
+	usecase := appschedule.NewGetScheduleByTokenUsecase(repo, &MockTenantRepository{timezone: "America/New_York"}, clock)
+
+	result, err := usecase.Execute(context.Background(), appschedule.GetScheduleByTokenInput{
+		PublicToken: sch.PublicToken().String(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if result.Timezone != "America/New_York" {
+		t.Errorf("Timezone = %q, want America/New_York", result.Timezone)
+	}
+	if result.Deadline == nil || !result.Deadline.Equal(deadline) {
+		t.Fatalf("Deadline = %v, want %v", result.Deadline, deadline)
+	}
+	if got := result.Deadline.Format(time.RFC3339); got != "2025-03-09T23:59:59-04:00" {
+		t.Errorf("Deadline should be expressed in the tenant timezone, got %s", got)
+	}
+}
@@ -0,0 +1,160 @@
+package shift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// assignmentChecker runs the checks shared by every path that confirms an assignment
+// 手動確定・自動割り当て・交換・空き待ちの繰り上げで同じ検証を行うため、ここにまとめる。
+// 呼び出し側で ShiftSlotRepository.FindByIDForUpdate により枠をロックしておくこと
+type assignmentChecker struct {
+	slotRepo           shift.ShiftSlotRepository
+	assignmentRepo     shift.ShiftAssignmentRepository
+	memberRoleRepo     member.MemberRoleRepository
+	availabilityRepo   member.AvailabilityRepository
+	workloadPolicyRepo member.WorkloadPolicyRepository
+	eventRepo          event.EventRepository
+	businessDayRepo    event.EventBusinessDayRepository
+}
+
+// assignmentCheckOptions controls which blocking checks may be overridden
+type assignmentCheckOptions struct {
+	// Force が true の場合、時間帯の重複があっても確定できる
+	Force bool
+	// OverrideWorkload が true の場合、勤務量の上限超過（enforcement = block）があっても確定できる
+	OverrideWorkload bool
+}
+
+// assignmentCheckResult is the outcome of a successful assignment check
+type assignmentCheckResult struct {
+	BusinessDay *event.EventBusinessDay
+	// Conflicts は Force により無視した重複割り当て（割り当てに強制確定として記録する）
+	Conflicts []shift.AssignmentConflict
+	// OverriddenWorkload は OverrideWorkload により無視した勤務量の上限超過
+	OverriddenWorkload []member.WorkloadViolation
+	Warnings           []AssignmentWarning
+}
+
+// ensureAssignable loads the slot's business day and checks that the slot accepts assignments
+// 中止された営業日・アーカイブ済みのイベントの営業日には割り当てない
+func (c assignmentChecker) ensureAssignable(ctx context.Context, slot *shift.ShiftSlot) (*event.EventBusinessDay, error) {
+	businessDay, err := c.businessDayRepo.FindByID(ctx, slot.TenantID(), slot.BusinessDayID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find business day: %w", err)
+	}
+	if err := businessDay.EnsureNotCancelled(); err != nil {
+		return nil, err
+	}
+	if err := ensureEventNotArchived(ctx, c.eventRepo, slot.TenantID(), businessDay.EventID()); err != nil {
+		return nil, err
+	}
+	return businessDay, nil
+}
+
+// check validates assigning the member to the slot
+//
+// Logic:
+//  1. Return ErrSlotFull if the slot already has required_count confirmed assignments
+//  2. Check role requirements of the slot
+//     必須ロールを満たせなくなる場合は RoleRequirementError、推奨ロールの不足は警告として返す
+//  3. Check the slot accepts assignments (ensureAssignable)
+//  4. Detect overlapping assignments of the member (return AssignmentConflictError unless Force)
+//  5. Check the member's availability calendar
+//     参加可能時間帯の外・ブラックアウト日の場合も割り当ては行い、警告として返す
+//  6. Check the member's workload limits
+//     enforcement が block の場合は WorkloadLimitError（OverrideWorkload 指定時を除く）、warn の場合は警告として返す
+func (c assignmentChecker) check(
+	ctx context.Context,
+	slot *shift.ShiftSlot,
+	memberID common.MemberID,
+	opts assignmentCheckOptions,
+) (*assignmentCheckResult, error) {
+	tenantID := slot.TenantID()
+
+	// 1. Capacity
+	currentCount, err := c.assignmentRepo.CountConfirmedBySlotID(ctx, tenantID, slot.SlotID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count assignments: %w", err)
+	}
+	if currentCount >= slot.RequiredCount() {
+		return nil, shift.ErrSlotFull
+	}
+
+	// 2. Role requirements
+	roleCheck, err := checkRoleRequirements(ctx, c.assignmentRepo, c.memberRoleRepo, tenantID, memberID, slot)
+	if err != nil {
+		return nil, err
+	}
+	if !roleCheck.Satisfiable() {
+		return nil, &shift.RoleRequirementError{
+			MemberID:   memberID,
+			Shortfalls: roleCheck.Violations,
+		}
+	}
+	result := &assignmentCheckResult{
+		Warnings: roleRequirementWarnings(roleCheck),
+	}
+
+	// 3. Business day / event state
+	result.BusinessDay, err = c.ensureAssignable(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	targetDate := result.BusinessDay.TargetDate()
+
+	// 4. Overlapping assignments
+	result.Conflicts, err = findAssignmentConflicts(
+		ctx, c.businessDayRepo, c.slotRepo, c.assignmentRepo,
+		tenantID, memberID, slot, targetDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Conflicts) > 0 && !opts.Force {
+		return nil, &shift.AssignmentConflictError{
+			MemberID:  memberID,
+			Conflicts: result.Conflicts,
+		}
+	}
+
+	// 5. Availability
+	availability, err := checkMemberAvailability(ctx, c.availabilityRepo, tenantID, memberID, slot, targetDate)
+	if err != nil {
+		return nil, err
+	}
+	result.Warnings = append(result.Warnings, availabilityWarnings(availability)...)
+
+	// 6. Workload
+	workload, err := checkWorkload(ctx, c.assignmentRepo, c.workloadPolicyRepo, tenantID, memberID, slot, targetDate)
+	if err != nil {
+		return nil, err
+	}
+	if workload.Blocks() {
+		if !opts.OverrideWorkload {
+			return nil, &member.WorkloadLimitError{
+				MemberID:   memberID,
+				Violations: workload.Violations,
+			}
+		}
+		result.OverriddenWorkload = workload.Violations
+	}
+	result.Warnings = append(result.Warnings, workloadWarnings(workload)...)
+
+	return result, nil
+}
+
+// isMemberAssignmentRejection reports whether the check rejected the assignment for reasons specific to the member
+// 時間帯の重複・必須ロール・勤務量の上限は、次の待機者・候補者に回せる
+func isMemberAssignmentRejection(err error) bool {
+	var conflictErr *shift.AssignmentConflictError
+	var roleErr *shift.RoleRequirementError
+	var workloadErr *member.WorkloadLimitError
+	return errors.As(err, &conflictErr) || errors.As(err, &roleErr) || errors.As(err, &workloadErr)
+}
@@ -0,0 +1,91 @@
+package shift
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// findAssignmentConflicts finds confirmed assignments of the member that overlap with the slot
+//
+// 深夜帯の枠・複数日営業が日付をまたぐため、枠の前日から終了日までに開催中の営業日（全イベント・全インスタンス）を対象とする。
+// 中止された営業日の割り当ては監査のために残っているだけなので重複とみなさない。
+// 同じ枠への重複割り当ては時間帯の重複ではなく ConflictError として返す。
+func findAssignmentConflicts(
+	ctx context.Context,
+	businessDayRepo event.EventBusinessDayRepository,
+	slotRepo shift.ShiftSlotRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	tenantID common.TenantID,
+	memberID common.MemberID,
+	slot *shift.ShiftSlot,
+	targetDate time.Time,
+) ([]shift.AssignmentConflict, error) {
+	var conflicts []shift.AssignmentConflict
+	slotCache := map[shift.SlotID]*shift.ShiftSlot{slot.SlotID(): slot}
+	seen := make(map[event.BusinessDayID]bool)
+
+	slotStart, slotEnd := slot.PeriodOn(targetDate)
+	firstDate := time.Date(slotStart.Year(), slotStart.Month(), slotStart.Day()-1, 0, 0, 0, 0, time.UTC)
+	lastDate := time.Date(slotEnd.Year(), slotEnd.Month(), slotEnd.Day(), 0, 0, 0, 0, time.UTC)
+	for date := firstDate; !date.After(lastDate); date = date.AddDate(0, 0, 1) {
+		businessDays, err := businessDayRepo.FindByTenantIDAndDate(ctx, tenantID, date)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find business days: %w", err)
+		}
+
+		for _, bd := range businessDays {
+			// 複数日にまたがる営業日は複数の日付で見つかるため一度だけ確認する
+			if seen[bd.BusinessDayID()] {
+				continue
+			}
+			seen[bd.BusinessDayID()] = true
+			if bd.IsCancelled() {
+				continue
+			}
+
+			assignments, err := assignmentRepo.FindByBusinessDayID(ctx, tenantID, bd.BusinessDayID())
+			if err != nil {
+				return nil, fmt.Errorf("failed to find assignments: %w", err)
+			}
+
+			for _, a := range assignments {
+				if a.MemberID() != memberID || !a.IsConfirmed() {
+					continue
+				}
+				if a.SlotID() == slot.SlotID() {
+					return nil, common.NewConflictError("member is already assigned to this slot")
+				}
+
+				other, ok := slotCache[a.SlotID()]
+				if !ok {
+					other, err = slotRepo.FindByID(ctx, tenantID, a.SlotID())
+					if err != nil {
+						return nil, fmt.Errorf("failed to find shift slot: %w", err)
+					}
+					slotCache[a.SlotID()] = other
+				}
+
+				if !slot.OverlapsWith(targetDate, other, bd.TargetDate()) {
+					continue
+				}
+
+				startAt, endAt := other.PeriodOn(bd.TargetDate())
+				conflicts = append(conflicts, shift.AssignmentConflict{
+					AssignmentID:  a.AssignmentID(),
+					SlotID:        other.SlotID(),
+					SlotName:      other.SlotName(),
+					BusinessDayID: bd.BusinessDayID(),
+					StartAt:       startAt,
+					EndAt:         endAt,
+				})
+			}
+		}
+	}
+
+	return conflicts, nil
+}
@@ -0,0 +1,518 @@
+package shift
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// FairnessWindowDays は公平性の計算に使う、対象の営業日までの割り当て期間（日数）
+// 割り当てた日時ではなく、割り当て先の営業日の日付で数える
+const FairnessWindowDays = 30
+
+// AutoAssignInput represents the input for automatic shift assignment
+type AutoAssignInput struct {
+	TenantID      common.TenantID
+	BusinessDayID event.BusinessDayID
+	ActorID       common.MemberID
+}
+
+// UnfilledSlot は自動割り当て後も必要人数に満たないシフト枠
+type UnfilledSlot struct {
+	SlotID        shift.SlotID
+	SlotName      string
+	RequiredCount int
+	AssignedCount int
+}
+
+// AutoAssignOutput represents the result of automatic shift assignment
+type AutoAssignOutput struct {
+	BusinessDayID event.BusinessDayID
+	Assignments   []*shift.ShiftAssignment
+	UnfilledSlots []UnfilledSlot
+}
+
+// autoAssignCandidate は割り当て候補となるメンバー
+type autoAssignCandidate struct {
+	memberID      common.MemberID
+	memberEntity  *member.Member
+	availableFrom *string
+	availableTo   *string
+	respondedAt   time.Time
+	recentCount   int
+}
+
+// AutoAssignUsecase は出欠回答からシフト枠を自動で埋める
+type AutoAssignUsecase struct {
+	eventRepo       event.EventRepository
+	businessDayRepo event.EventBusinessDayRepository
+	slotRepo        shift.ShiftSlotRepository
+	assignmentRepo  shift.ShiftAssignmentRepository
+	memberRepo      member.MemberRepository
+	memberRoleRepo  member.MemberRoleRepository
+	attendanceRepo  attendance.AttendanceCollectionRepository
+	checker         assignmentChecker
+	outboxRepo      notification.OutboxRepository
+	txManager       TxManager
+	clock           services.Clock
+}
+
+// NewAutoAssignUsecase creates a new AutoAssignUsecase
+func NewAutoAssignUsecase(
+	eventRepo event.EventRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	slotRepo shift.ShiftSlotRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	memberRepo member.MemberRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	availabilityRepo member.AvailabilityRepository,
+	workloadPolicyRepo member.WorkloadPolicyRepository,
+	attendanceRepo attendance.AttendanceCollectionRepository,
+	outboxRepo notification.OutboxRepository,
+	txManager TxManager,
+	clock services.Clock,
+) *AutoAssignUsecase {
+	return &AutoAssignUsecase{
+		eventRepo:       eventRepo,
+		businessDayRepo: businessDayRepo,
+		slotRepo:        slotRepo,
+		assignmentRepo:  assignmentRepo,
+		memberRepo:      memberRepo,
+		memberRoleRepo:  memberRoleRepo,
+		attendanceRepo:  attendanceRepo,
+		checker: assignmentChecker{
+			slotRepo:           slotRepo,
+			assignmentRepo:     assignmentRepo,
+			memberRoleRepo:     memberRoleRepo,
+			availabilityRepo:   availabilityRepo,
+			workloadPolicyRepo: workloadPolicyRepo,
+			eventRepo:          eventRepo,
+			businessDayRepo:    businessDayRepo,
+		},
+		outboxRepo: outboxRepo,
+		txManager:  txManager,
+		clock:      clock,
+	}
+}
+
+// Execute fills the shift slots of a business day from attendance responses
+//
+// Logic (2-7 はトランザクション内で実行):
+//  1. 営業日を取得（中止された営業日・アーカイブ済みのイベントの営業日はエラー）
+//  2. 営業日のシフト枠を SlotID 順に行ロックし、ロック後の確定割り当てを集計
+//     手動確定・他の自動割り当てと同じ枠を同時に埋めても定員を超えない
+//  3. 営業日の日付に対応する出欠回答（attending）を収集
+//     - 出欠確認に対象ロールが設定されている場合、そのロールを持つメンバーのみ候補とする
+//  4. 直近 FairnessWindowDays 日の確定割り当て数を集計（公平性）
+//  5. priority 昇順にシフト枠を走査し、RequiredCount まで埋める
+//     - 参加可能時間内に収まるメンバーを優先
+//     - 次に直近の割り当て数が少ないメンバーを優先
+//     - 収まるメンバーが足りない場合は時間外のメンバーを isOutsidePreference=true で割り当てる
+//     - 選んだメンバーは手動確定と同じ検証（assignmentChecker）にかける
+//     - 時間帯の重複・必須ロール・勤務量の上限で弾かれた場合は、その枠では次の候補を選ぶ
+//  6. 同一営業日に同じメンバーを重複して割り当てない
+//  7. 割り当てを保存し、確定通知をアウトボックスに書き込む
+func (uc *AutoAssignUsecase) Execute(ctx context.Context, input AutoAssignInput) (*AutoAssignOutput, error) {
+	now := uc.clock.Now()
+
+	// 1. 営業日を取得（ロック後も assignmentChecker で再確認する）
+	businessDay, err := uc.businessDayRepo.FindByID(ctx, input.TenantID, input.BusinessDayID)
+	if err != nil {
+		return nil, err
+	}
+	if err := businessDay.EnsureNotCancelled(); err != nil {
+		return nil, err
+	}
+	if err := ensureEventNotArchived(ctx, uc.eventRepo, input.TenantID, businessDay.EventID()); err != nil {
+		return nil, err
+	}
+
+	var output *AutoAssignOutput
+	err = uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		var err error
+		output, err = uc.fillSlots(txCtx, businessDay, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[AuditLog Stub] AUTO_ASSIGN ShiftAssignment: actor_id=%s, business_day_id=%s, created=%d, unfilled_slots=%d",
+		input.ActorID.String(),
+		input.BusinessDayID.String(),
+		len(output.Assignments),
+		len(output.UnfilledSlots),
+	)
+
+	return output, nil
+}
+
+// fillSlots selects and saves the assignments of the business day (2-7 of Execute)
+func (uc *AutoAssignUsecase) fillSlots(ctx context.Context, businessDay *event.EventBusinessDay, now time.Time) (*AutoAssignOutput, error) {
+	tenantID := businessDay.TenantID()
+
+	// 2. シフト枠をロック（デッドロックを避けるため SlotID 順にロックする）
+	found, err := uc.slotRepo.FindByBusinessDayID(ctx, tenantID, businessDay.BusinessDayID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find shift slots: %w", err)
+	}
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].SlotID() < found[j].SlotID()
+	})
+	slots := make([]*shift.ShiftSlot, 0, len(found))
+	for _, s := range found {
+		slot, err := uc.slotRepo.FindByIDForUpdate(ctx, tenantID, s.SlotID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to find shift slot: %w", err)
+		}
+		slots = append(slots, slot)
+	}
+
+	// ロック後の確定割り当てを集計
+	existing, err := uc.assignmentRepo.FindByBusinessDayID(ctx, tenantID, businessDay.BusinessDayID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find assignments: %w", err)
+	}
+	assignedCount := make(map[shift.SlotID]int)
+	assignedMembers := make(map[common.MemberID]bool)
+	for _, a := range existing {
+		if !a.IsConfirmed() {
+			continue
+		}
+		assignedCount[a.SlotID()]++
+		assignedMembers[a.MemberID()] = true
+	}
+
+	// 3. 出欠回答から候補者を収集
+	candidates, err := uc.collectCandidates(ctx, businessDay)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. 公平性のための直近割り当て数（全候補者をまとめて集計）
+	memberIDs := make([]common.MemberID, len(candidates))
+	for i, c := range candidates {
+		memberIDs[i] = c.memberID
+	}
+	windowEnd := businessDay.TargetDate()
+	recentCounts, err := uc.assignmentRepo.CountConfirmedByMemberIDsAndDateRange(ctx, tenantID, memberIDs, windowEnd.AddDate(0, 0, -FairnessWindowDays), windowEnd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count member assignments: %w", err)
+	}
+	for _, c := range candidates {
+		c.recentCount = recentCounts[c.memberID]
+	}
+
+	// 5. priority 昇順（同値は開始日時順）でシフト枠を埋める
+	sort.SliceStable(slots, func(i, j int) bool {
+		if slots[i].Priority() != slots[j].Priority() {
+			return slots[i].Priority() < slots[j].Priority()
+		}
+		if slots[i].DayOffset() != slots[j].DayOffset() {
+			return slots[i].DayOffset() < slots[j].DayOffset()
+		}
+		return slots[i].StartTime().Before(slots[j].StartTime())
+	})
+
+	output := &AutoAssignOutput{BusinessDayID: businessDay.BusinessDayID()}
+	for _, slot := range slots {
+		remaining := slot.RequiredCount() - assignedCount[slot.SlotID()]
+		rejected := make(map[common.MemberID]bool)
+
+		for remaining > 0 {
+			best := pickCandidate(slot, candidates, assignedMembers, rejected)
+			if best == nil {
+				break
+			}
+
+			// 手動確定と同じ検証。メンバー固有の理由で弾かれた場合は次の候補を選ぶ
+			if _, err := uc.checker.check(ctx, slot, best.memberID, assignmentCheckOptions{}); err != nil {
+				if isMemberAssignmentRejection(err) {
+					rejected[best.memberID] = true
+					continue
+				}
+				return nil, err
+			}
+
+			// 7. 保存と確定通知
+			assignment, err := uc.saveAssignment(ctx, businessDay, slot, best, now)
+			if err != nil {
+				return nil, err
+			}
+
+			output.Assignments = append(output.Assignments, assignment)
+			assignedMembers[best.memberID] = true
+			assignedCount[slot.SlotID()]++
+			best.recentCount++
+			remaining--
+		}
+
+		if remaining > 0 {
+			output.UnfilledSlots = append(output.UnfilledSlots, UnfilledSlot{
+				SlotID:        slot.SlotID(),
+				SlotName:      slot.SlotName(),
+				RequiredCount: slot.RequiredCount(),
+				AssignedCount: assignedCount[slot.SlotID()],
+			})
+		}
+	}
+
+	return output, nil
+}
+
+// saveAssignment saves the automatic assignment and enqueues the shift confirmed notification
+func (uc *AutoAssignUsecase) saveAssignment(
+	ctx context.Context,
+	businessDay *event.EventBusinessDay,
+	slot *shift.ShiftSlot,
+	candidate *autoAssignCandidate,
+	now time.Time,
+) (*shift.ShiftAssignment, error) {
+	var nilPlanID shift.PlanID // Zero value (treated as NULL)
+	assignment, err := shift.NewShiftAssignment(
+		now,
+		businessDay.TenantID(),
+		nilPlanID,
+		slot.SlotID(),
+		candidate.memberID,
+		shift.AssignmentMethodAuto,
+		!fitsAvailability(slot, candidate.availableFrom, candidate.availableTo),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create shift assignment: %w", err)
+	}
+	if err := uc.assignmentRepo.Save(ctx, assignment); err != nil {
+		return nil, fmt.Errorf("failed to save shift assignment: %w", err)
+	}
+
+	businessDayID := businessDay.BusinessDayID()
+	if _, err := enqueueNotification(ctx, uc.outboxRepo, now, candidate.memberEntity, &businessDayID,
+		notification.NotificationTypeShiftConfirmed, shiftConfirmedContent(businessDay, slot)); err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+// collectCandidates は営業日の日付に一致する対象日への attending 回答を集める
+// 同じメンバーが複数の出欠確認に回答している場合は最新の回答を採用する
+func (uc *AutoAssignUsecase) collectCandidates(ctx context.Context, businessDay *event.EventBusinessDay) ([]*autoAssignCandidate, error) {
+	activeMembers, err := uc.memberRepo.FindActiveByTenantID(ctx, businessDay.TenantID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find members: %w", err)
+	}
+	active := make(map[common.MemberID]bool, len(activeMembers))
+	members := make(map[common.MemberID]*member.Member, len(activeMembers))
+	for _, m := range activeMembers {
+		active[m.MemberID()] = true
+		members[m.MemberID()] = m
+	}
+
+	latest, err := findLatestAttendanceResponses(ctx, uc.attendanceRepo, uc.memberRoleRepo, businessDay, active)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]*autoAssignCandidate, 0, len(latest))
+	for memberID, r := range latest {
+		if r.Response() != attendance.ResponseTypeAttending {
+			continue
+		}
+		candidates = append(candidates, &autoAssignCandidate{
+			memberID:      memberID,
+			memberEntity:  members[memberID],
+			availableFrom: r.AvailableFrom(),
+			availableTo:   r.AvailableTo(),
+			respondedAt:   r.RespondedAt(),
+		})
+	}
+
+	// map の走査順に依存しないよう回答日時順に並べておく
+	sort.Slice(candidates, func(i, j int) bool {
+		if !candidates[i].respondedAt.Equal(candidates[j].respondedAt) {
+			return candidates[i].respondedAt.Before(candidates[j].respondedAt)
+		}
+		return candidates[i].memberID < candidates[j].memberID
+	})
+
+	return candidates, nil
+}
+
+// findLatestAttendanceResponses は営業日の日付に一致する対象日への回答をメンバーごとに集める
+// 同じメンバーが複数の出欠確認に回答している場合は最新の回答を採用する。active に含まれないメンバーは除外する
+func findLatestAttendanceResponses(
+	ctx context.Context,
+	attendanceRepo attendance.AttendanceCollectionRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	businessDay *event.EventBusinessDay,
+	active map[common.MemberID]bool,
+) (map[common.MemberID]*attendance.AttendanceResponse, error) {
+	collections, err := attendanceRepo.FindByTenantID(ctx, businessDay.TenantID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find attendance collections: %w", err)
+	}
+
+	latest := make(map[common.MemberID]*attendance.AttendanceResponse)
+	for _, collection := range collections {
+		if collection.IsDeleted() || !isCollectionForBusinessDay(collection, businessDay) {
+			continue
+		}
+
+		targetDates, err := attendanceRepo.FindTargetDatesByCollectionID(ctx, collection.CollectionID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to find target dates: %w", err)
+		}
+		var targetDateID common.TargetDateID
+		for _, td := range targetDates {
+			if sameDate(td.TargetDateValue(), businessDay.TargetDate()) {
+				targetDateID = td.TargetDateID()
+				break
+			}
+		}
+		if targetDateID == "" {
+			continue
+		}
+
+		// 対象ロールが設定されている場合はロールで絞り込む
+		roleAssignments, err := attendanceRepo.FindRoleAssignmentsByCollectionID(ctx, collection.CollectionID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to find role assignments: %w", err)
+		}
+		targetRoles := make(map[common.RoleID]bool, len(roleAssignments))
+		for _, ra := range roleAssignments {
+			targetRoles[ra.RoleID()] = true
+		}
+
+		responses, err := attendanceRepo.FindResponsesByCollectionID(ctx, collection.CollectionID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to find attendance responses: %w", err)
+		}
+		for _, r := range responses {
+			if r.TargetDateID() != targetDateID || !active[r.MemberID()] {
+				continue
+			}
+			if len(targetRoles) > 0 {
+				ok, err := hasAnyRole(ctx, memberRoleRepo, r.MemberID(), targetRoles)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					continue
+				}
+			}
+			if prev, ok := latest[r.MemberID()]; !ok || r.RespondedAt().After(prev.RespondedAt()) {
+				latest[r.MemberID()] = r
+			}
+		}
+	}
+
+	return latest, nil
+}
+
+func hasAnyRole(ctx context.Context, memberRoleRepo member.MemberRoleRepository, memberID common.MemberID, roles map[common.RoleID]bool) (bool, error) {
+	memberRoles, err := memberRoleRepo.FindRolesByMemberID(ctx, memberID)
+	if err != nil {
+		return false, fmt.Errorf("failed to find member roles: %w", err)
+	}
+	for _, roleID := range memberRoles {
+		if roles[roleID] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isCollectionForBusinessDay は出欠確認が営業日に関係するかを判定する
+// 対象未指定の出欠確認は日付の一致のみで判定する
+func isCollectionForBusinessDay(collection *attendance.AttendanceCollection, businessDay *event.EventBusinessDay) bool {
+	if collection.TargetID() == "" {
+		return true
+	}
+	switch collection.TargetType() {
+	case attendance.TargetTypeBusinessDay:
+		return collection.TargetID() == businessDay.BusinessDayID().String()
+	case attendance.TargetTypeEvent:
+		return collection.TargetID() == businessDay.EventID().String()
+	}
+	return false
+}
+
+// pickCandidate はシフト枠に最適な未割り当てメンバーを選ぶ（rejected はこの枠の検証で弾かれたメンバー）
+// 参加可能時間に収まるメンバー > 直近の割り当て数が少ないメンバー > 回答が早いメンバー の順
+func pickCandidate(slot *shift.ShiftSlot, candidates []*autoAssignCandidate, assigned, rejected map[common.MemberID]bool) *autoAssignCandidate {
+	var best *autoAssignCandidate
+	bestFits := false
+	for _, c := range candidates {
+		if assigned[c.memberID] || rejected[c.memberID] {
+			continue
+		}
+		fits := fitsAvailability(slot, c.availableFrom, c.availableTo)
+		if best == nil ||
+			(fits && !bestFits) ||
+			(fits == bestFits && c.recentCount < best.recentCount) {
+			best = c
+			bestFits = fits
+		}
+	}
+	return best
+}
+
+// fitsAvailability はシフト枠が参加可能時間（HH:MM）に収まるかを判定する
+// 未指定の場合は終日参加可能とみなす。日付を跨ぐ時間帯にも対応する
+func fitsAvailability(slot *shift.ShiftSlot, from, to *string) bool {
+	slotStart := minutesOfDay(slot.StartTime())
+	slotEnd := minutesOfDay(slot.EndTime())
+	if slot.IsOvernight() {
+		slotEnd += 24 * 60
+	}
+
+	availFrom := 0
+	availTo := 48 * 60
+	if from != nil && *from != "" {
+		m, ok := parseHHMM(*from)
+		if !ok {
+			return true
+		}
+		availFrom = m
+	}
+	if to != nil && *to != "" {
+		m, ok := parseHHMM(*to)
+		if !ok {
+			return true
+		}
+		availTo = m
+		if availTo <= availFrom {
+			availTo += 24 * 60
+		}
+	}
+
+	return availFrom <= slotStart && slotEnd <= availTo
+}
+
+func minutesOfDay(t time.Time) int {
+	return t.Hour()*60 + t.Minute()
+}
+
+func parseHHMM(s string) (int, bool) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, false
+	}
+	return minutesOfDay(t), true
+}
+
+func sameDate(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -0,0 +1,966 @@
+package shift_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appshift "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// =====================================================
+// Mock Repositories (auto assign)
+// =====================================================
+
+type MockClock struct {
+	now time.Time
+}
+
+func (m *MockClock) Now() time.Time {
+	return m.now
+}
+
+type MockAttendanceCollectionRepository struct {
+	collections     []*attendance.AttendanceCollection
+	targetDates     map[common.CollectionID][]*attendance.TargetDate
+	responses       map[common.CollectionID][]*attendance.AttendanceResponse
+	roleAssignments map[common.CollectionID][]*attendance.CollectionRoleAssignment
+}
+
+func (m *MockAttendanceCollectionRepository) Save(ctx context.Context, c *attendance.AttendanceCollection) error {
+	return nil
+}
+
+func (m *MockAttendanceCollectionRepository) CloseIfExpired(ctx context.Context, tenantID common.TenantID, id common.CollectionID, now time.Time) (bool, error) {
+	return false, nil
+}
+
+func (m *MockAttendanceCollectionRepository) FindByID(ctx context.Context, tenantID common.TenantID, id common.CollectionID) (*attendance.AttendanceCollection, error) {
+	return nil, nil
+}
+
+func (m *MockAttendanceCollectionRepository) FindByToken(ctx context.Context, token common.PublicToken) (*attendance.AttendanceCollection, error) {
+	return nil, nil
+}
+
+func (m *MockAttendanceCollectionRepository) FindByTenantID(ctx context.Context, tenantID common.TenantID) ([]*attendance.AttendanceCollection, error) {
+	return m.collections, nil
+}
+
+func (m *MockAttendanceCollectionRepository) UpsertResponse(ctx context.Context, response *attendance.AttendanceResponse) error {
+	return nil
+}
+
+func (m *MockAttendanceCollectionRepository) FindResponsesByCollectionID(ctx context.Context, collectionID common.CollectionID) ([]*attendance.AttendanceResponse, error) {
+	return m.responses[collectionID], nil
+}
+
+func (m *MockAttendanceCollectionRepository) FindResponsesByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*attendance.AttendanceResponse, error) {
+	return nil, nil
+}
+
+func (m *MockAttendanceCollectionRepository) FindResponsesByCollectionIDAndMemberID(ctx context.Context, tenantID common.TenantID, collectionID common.CollectionID, memberID common.MemberID) ([]*attendance.AttendanceResponse, error) {
+	return nil, nil
+}
+
+func (m *MockAttendanceCollectionRepository) SaveTargetDates(ctx context.Context, collectionID common.CollectionID, targetDates []*attendance.TargetDate) error {
+	return nil
+}
+
+func (m *MockAttendanceCollectionRepository) ReplaceTargetDates(ctx context.Context, collectionID common.CollectionID, targetDates []*attendance.TargetDate) error {
+	return nil
+}
+
+func (m *MockAttendanceCollectionRepository) FindTargetDatesByCollectionID(ctx context.Context, collectionID common.CollectionID) ([]*attendance.TargetDate, error) {
+	return m.targetDates[collectionID], nil
+}
+
+func (m *MockAttendanceCollectionRepository) SaveGroupAssignments(ctx context.Context, collectionID common.CollectionID, assignments []*attendance.CollectionGroupAssignment) error {
+	return nil
+}
+
+func (m *MockAttendanceCollectionRepository) FindGroupAssignmentsByCollectionID(ctx context.Context, collectionID common.CollectionID) ([]*attendance.CollectionGroupAssignment, error) {
+	return nil, nil
+}
+
+func (m *MockAttendanceCollectionRepository) SaveRoleAssignments(ctx context.Context, collectionID common.CollectionID, assignments []*attendance.CollectionRoleAssignment) error {
+	return nil
+}
+
+func (m *MockAttendanceCollectionRepository) FindRoleAssignmentsByCollectionID(ctx context.Context, collectionID common.CollectionID) ([]*attendance.CollectionRoleAssignment, error) {
+	return m.roleAssignments[collectionID], nil
+}
+
+type MockMemberRoleRepository struct {
+	roles map[common.MemberID][]common.RoleID
+}
+
+func (m *MockMemberRoleRepository) AssignRole(ctx context.Context, memberID common.MemberID, roleID common.RoleID) error {
+	return nil
+}
+
+func (m *MockMemberRoleRepository) RemoveRole(ctx context.Context, memberID common.MemberID, roleID common.RoleID) error {
+	return nil
+}
+
+func (m *MockMemberRoleRepository) SetMemberRoles(ctx context.Context, memberID common.MemberID, roleIDs []common.RoleID) error {
+	return nil
+}
+
+func (m *MockMemberRoleRepository) FindRolesByMemberID(ctx context.Context, memberID common.MemberID) ([]common.RoleID, error) {
+	return m.roles[memberID], nil
+}
+
+func (m *MockMemberRoleRepository) FindMemberIDsByRoleID(ctx context.Context, roleID common.RoleID) ([]common.MemberID, error) {
+	return nil, nil
+}
+
+// =====================================================
+// Helper functions (auto assign)
+// =====================================================
+
+// createTestBusinessDayOn creates a 20:00-23:00 business day on the date
+func createTestBusinessDayOn(t *testing.T, tenantID common.TenantID, targetDate time.Time) *event.EventBusinessDay {
+	t.Helper()
+	bd, err := event.NewEventBusinessDay(time.Now(), tenantID, common.NewEventID(), targetDate,
+		time.Date(2000, 1, 1, 20, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+		event.OccurrenceTypeSpecial, nil)
+	if err != nil {
+		t.Fatalf("Failed to create business day: %v", err)
+	}
+	return bd
+}
+
+// createTestSlotIn creates a shift slot on the business day
+func createTestSlotIn(t *testing.T, bd *event.EventBusinessDay, name string, start, end time.Time, required, priority int) *shift.ShiftSlot {
+	t.Helper()
+	slot, err := shift.NewShiftSlot(time.Now(), bd.TenantID(), bd.BusinessDayID(), nil, name, "", start, end, required, priority)
+	if err != nil {
+		t.Fatalf("Failed to create shift slot: %v", err)
+	}
+	return slot
+}
+
+// createTestAttendanceCollection creates a collection for the business day's event with a target date on the business day
+func createTestAttendanceCollection(t *testing.T, bd *event.EventBusinessDay) (*attendance.AttendanceCollection, *attendance.TargetDate) {
+	t.Helper()
+	collection, err := attendance.NewAttendanceCollection(time.Now(), bd.TenantID(), "出欠確認", "", attendance.TargetTypeEvent, bd.EventID().String(), nil)
+	if err != nil {
+		t.Fatalf("Failed to create collection: %v", err)
+	}
+	td, err := attendance.NewTargetDate(time.Now(), collection.CollectionID(), bd.TargetDate(), nil, nil, 0)
+	if err != nil {
+		t.Fatalf("Failed to create target date: %v", err)
+	}
+	return collection, td
+}
+
+// createTestAttendanceResponse creates a member and their response to the target date
+func createTestAttendanceResponse(
+	t *testing.T,
+	td *attendance.TargetDate,
+	tenantID common.TenantID,
+	name string,
+	respondedAt time.Time,
+	responseType attendance.ResponseType,
+	from, to *string,
+) (*member.Member, *attendance.AttendanceResponse) {
+	t.Helper()
+	mem, err := member.NewMember(time.Now(), tenantID, name, "discord_"+name, "")
+	if err != nil {
+		t.Fatalf("Failed to create member: %v", err)
+	}
+	resp, err := attendance.NewAttendanceResponse(respondedAt, td.CollectionID(), tenantID, mem.MemberID(), td.TargetDateID(), responseType, "", from, to)
+	if err != nil {
+		t.Fatalf("Failed to create response: %v", err)
+	}
+	return mem, resp
+}
+
+func hhmm(s string) *string {
+	return &s
+}
+
+func slotTime(hour, minute int) time.Time {
+	return time.Date(2000, 1, 1, hour, minute, 0, 0, time.UTC)
+}
+
+// assignmentsOnSlot returns the assignments of the slot
+func assignmentsOnSlot(assignments []*shift.ShiftAssignment, slotID shift.SlotID) []*shift.ShiftAssignment {
+	var result []*shift.ShiftAssignment
+	for _, a := range assignments {
+		if a.SlotID() == slotID {
+			result = append(result, a)
+		}
+	}
+	return result
+}
+
+// =====================================================
+// AutoAssignUsecase Tests
+// =====================================================
+
+func TestAutoAssignUsecase_Execute_FillsSlotsFromAttendingResponses(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDayOn(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	slot := createTestSlotIn(t, bd, "受付", slotTime(20, 0), slotTime(22, 0), 2, 1)
+	collection, td := createTestAttendanceCollection(t, bd)
+	base := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	m1, r1 := createTestAttendanceResponse(t, td, tenantID, "A", base, attendance.ResponseTypeAttending, nil, nil)
+	m2, r2 := createTestAttendanceResponse(t, td, tenantID, "B", base.Add(time.Hour), attendance.ResponseTypeAttending, nil, nil)
+	m3, r3 := createTestAttendanceResponse(t, td, tenantID, "C", base, attendance.ResponseTypeAbsent, nil, nil)
+	m4, r4 := createTestAttendanceResponse(t, td, tenantID, "D", base, attendance.ResponseTypeUndecided, nil, nil)
+
+	// シフト枠のロックと保存・通知が同じトランザクション内で行われることを確認する
+	type txKey struct{}
+	inTx := func(ctx context.Context) bool { return ctx.Value(txKey{}) != nil }
+	txManager := &MockTxManager{withTxFunc: func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(context.WithValue(ctx, txKey{}, true))
+	}}
+	lockedInTx := false
+	slotRepo := &MockShiftSlotRepository{
+		findByBusinessDayFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+			return []*shift.ShiftSlot{slot}, nil
+		},
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			lockedInTx = inTx(ctx)
+			return slot, nil
+		},
+	}
+	var saved []*shift.ShiftAssignment
+	assignmentRepo := &MockShiftAssignmentRepository{
+		saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+			if !inTx(ctx) {
+				t.Error("assignment should be saved inside the transaction")
+			}
+			saved = append(saved, a)
+			return nil
+		},
+	}
+	outboxRepo := &MockOutboxRepository{}
+
+	usecase := appshift.NewAutoAssignUsecase(
+		&MockEventRepository{},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return bd, nil
+			},
+		},
+		slotRepo,
+		assignmentRepo,
+		&MockMemberRepository{
+			findActiveByTenantIDFunc: func(ctx context.Context, tid common.TenantID) ([]*member.Member, error) {
+				return []*member.Member{m1, m2, m3, m4}, nil
+			},
+		},
+		&MockMemberRoleRepository{},
+		&MockAvailabilityRepository{},
+		&MockWorkloadPolicyRepository{},
+		&MockAttendanceCollectionRepository{
+			collections: []*attendance.AttendanceCollection{collection},
+			targetDates: map[common.CollectionID][]*attendance.TargetDate{collection.CollectionID(): {td}},
+			responses:   map[common.CollectionID][]*attendance.AttendanceResponse{collection.CollectionID(): {r1, r2, r3, r4}},
+		},
+		outboxRepo,
+		txManager,
+		&MockClock{now: time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)},
+	)
+
+	output, err := usecase.Execute(context.Background(), appshift.AutoAssignInput{
+		TenantID:      tenantID,
+		BusinessDayID: bd.BusinessDayID(),
+		ActorID:       common.NewMemberID(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if len(output.Assignments) != 2 || len(saved) != 2 {
+		t.Fatalf("expected 2 assignments saved, got %d (saved %d)", len(output.Assignments), len(saved))
+	}
+	got := map[common.MemberID]bool{}
+	for _, a := range output.Assignments {
+		if a.AssignmentMethod() != shift.AssignmentMethodAuto || a.SlotID() != slot.SlotID() || a.IsOutsidePreference() {
+			t.Errorf("unexpected assignment: method=%s slot=%s outside=%t", a.AssignmentMethod(), a.SlotID(), a.IsOutsidePreference())
+		}
+		got[a.MemberID()] = true
+	}
+	if !got[m1.MemberID()] || !got[m2.MemberID()] {
+		t.Errorf("expected attending members to be assigned, got %v", got)
+	}
+	if len(output.UnfilledSlots) != 0 {
+		t.Errorf("expected no unfilled slots, got %d", len(output.UnfilledSlots))
+	}
+	if !lockedInTx {
+		t.Error("slot should be locked inside the transaction")
+	}
+	if len(outboxRepo.messages) != 2 || outboxRepo.messages[0].NotificationType() != notification.NotificationTypeShiftConfirmed {
+		t.Errorf("expected 2 shift confirmed notifications, got %d", len(outboxRepo.messages))
+	}
+}
+
+func TestAutoAssignUsecase_Execute_HonoursPriorityAndReportsUnfilled(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDayOn(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	low := createTestSlotIn(t, bd, "清掃", slotTime(20, 0), slotTime(21, 0), 1, 5)
+	high := createTestSlotIn(t, bd, "受付", slotTime(20, 0), slotTime(21, 0), 1, 1)
+	collection, td := createTestAttendanceCollection(t, bd)
+	m, r := createTestAttendanceResponse(t, td, tenantID, "A", time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), attendance.ResponseTypeAttending, nil, nil)
+
+	slots := []*shift.ShiftSlot{low, high}
+	usecase := appshift.NewAutoAssignUsecase(
+		&MockEventRepository{},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return bd, nil
+			},
+		},
+		&MockShiftSlotRepository{
+			findByBusinessDayFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+				return slots, nil
+			},
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+				for _, s := range slots {
+					if s.SlotID() == slotID {
+						return s, nil
+					}
+				}
+				return nil, common.NewNotFoundError("ShiftSlot", slotID.String())
+			},
+		},
+		&MockShiftAssignmentRepository{},
+		&MockMemberRepository{
+			findActiveByTenantIDFunc: func(ctx context.Context, tid common.TenantID) ([]*member.Member, error) {
+				return []*member.Member{m}, nil
+			},
+		},
+		&MockMemberRoleRepository{},
+		&MockAvailabilityRepository{},
+		&MockWorkloadPolicyRepository{},
+		&MockAttendanceCollectionRepository{
+			collections: []*attendance.AttendanceCollection{collection},
+			targetDates: map[common.CollectionID][]*attendance.TargetDate{collection.CollectionID(): {td}},
+			responses:   map[common.CollectionID][]*attendance.AttendanceResponse{collection.CollectionID(): {r}},
+		},
+		&MockOutboxRepository{},
+		&MockTxManager{},
+		&MockClock{now: time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)},
+	)
+
+	output, err := usecase.Execute(context.Background(), appshift.AutoAssignInput{
+		TenantID:      tenantID,
+		BusinessDayID: bd.BusinessDayID(),
+		ActorID:       common.NewMemberID(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if len(output.Assignments) != 1 {
+		t.Fatalf("expected 1 assignment, got %d", len(output.Assignments))
+	}
+	if output.Assignments[0].SlotID() != high.SlotID() || output.Assignments[0].MemberID() != m.MemberID() {
+		t.Errorf("expected member to fill the higher priority slot")
+	}
+	if len(output.UnfilledSlots) != 1 || output.UnfilledSlots[0].SlotID != low.SlotID() {
+		t.Fatalf("expected lower priority slot to be unfilled, got %+v", output.UnfilledSlots)
+	}
+	if output.UnfilledSlots[0].RequiredCount != 1 || output.UnfilledSlots[0].AssignedCount != 0 {
+		t.Errorf("unexpected unfilled counts: %+v", output.UnfilledSlots[0])
+	}
+}
+
+func TestAutoAssignUsecase_Execute_AvailabilityWindowPreference(t *testing.T) {
+	tests := []struct {
+		name        string
+		start, end  time.Time
+		windows     [][2]*string // 回答順の参加可能時間
+		wantMember  int
+		wantOutside bool
+	}{
+		{
+			name:  "時間内のメンバーを優先する",
+			start: slotTime(22, 0), end: slotTime(23, 0),
+			// 早く回答しているが時間外のメンバーより、時間内のメンバーを選ぶ
+			windows:    [][2]*string{{hhmm("20:00"), hhmm("21:00")}, {hhmm("21:30"), hhmm("23:30")}},
+			wantMember: 1, wantOutside: false,
+		},
+		{
+			name:  "日付を跨ぐ枠が時間外なら isOutsidePreference を立てる",
+			start: slotTime(23, 0), end: slotTime(1, 0),
+			windows:    [][2]*string{{hhmm("20:00"), hhmm("23:30")}},
+			wantMember: 0, wantOutside: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantID := common.NewTenantID()
+			bd := createTestBusinessDayOn(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+			slot := createTestSlotIn(t, bd, "受付", tt.start, tt.end, 1, 1)
+			collection, td := createTestAttendanceCollection(t, bd)
+			base := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+			var members []*member.Member
+			var responses []*attendance.AttendanceResponse
+			for i, w := range tt.windows {
+				m, r := createTestAttendanceResponse(t, td, tenantID, string(rune('A'+i)), base.Add(time.Duration(i)*time.Hour),
+					attendance.ResponseTypeAttending, w[0], w[1])
+				members = append(members, m)
+				responses = append(responses, r)
+			}
+
+			usecase := appshift.NewAutoAssignUsecase(
+				&MockEventRepository{},
+				&MockBusinessDayRepository{
+					findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+						return bd, nil
+					},
+				},
+				&MockShiftSlotRepository{
+					findByBusinessDayFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+						return []*shift.ShiftSlot{slot}, nil
+					},
+					findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+						return slot, nil
+					},
+				},
+				&MockShiftAssignmentRepository{},
+				&MockMemberRepository{
+					findActiveByTenantIDFunc: func(ctx context.Context, tid common.TenantID) ([]*member.Member, error) {
+						return members, nil
+					},
+				},
+				&MockMemberRoleRepository{},
+				&MockAvailabilityRepository{},
+				&MockWorkloadPolicyRepository{},
+				&MockAttendanceCollectionRepository{
+					collections: []*attendance.AttendanceCollection{collection},
+					targetDates: map[common.CollectionID][]*attendance.TargetDate{collection.CollectionID(): {td}},
+					responses:   map[common.CollectionID][]*attendance.AttendanceResponse{collection.CollectionID(): responses},
+				},
+				&MockOutboxRepository{},
+				&MockTxManager{},
+				&MockClock{now: time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)},
+			)
+
+			output, err := usecase.Execute(context.Background(), appshift.AutoAssignInput{
+				TenantID:      tenantID,
+				BusinessDayID: bd.BusinessDayID(),
+				ActorID:       common.NewMemberID(),
+			})
+			if err != nil {
+				t.Fatalf("Execute() should succeed, got error: %v", err)
+			}
+
+			if len(output.Assignments) != 1 {
+				t.Fatalf("expected 1 assignment, got %d", len(output.Assignments))
+			}
+			a := output.Assignments[0]
+			if a.MemberID() != members[tt.wantMember].MemberID() || a.IsOutsidePreference() != tt.wantOutside {
+				t.Errorf("unexpected assignment: member=%s outside=%t", a.MemberID(), a.IsOutsidePreference())
+			}
+		})
+	}
+}
+
+func TestAutoAssignUsecase_Execute_FairnessAcrossRecentAssignments(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDayOn(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	slot := createTestSlotIn(t, bd, "受付", slotTime(20, 0), slotTime(21, 0), 1, 1)
+	collection, td := createTestAttendanceCollection(t, bd)
+	base := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	busy, r1 := createTestAttendanceResponse(t, td, tenantID, "A", base, attendance.ResponseTypeAttending, nil, nil)
+	fresh, r2 := createTestAttendanceResponse(t, td, tenantID, "B", base.Add(time.Hour), attendance.ResponseTypeAttending, nil, nil)
+
+	// 直近の割り当ては営業日の日付で、全候補者をまとめて1回で集計する
+	countCalls := 0
+	usecase := appshift.NewAutoAssignUsecase(
+		&MockEventRepository{},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return bd, nil
+			},
+		},
+		&MockShiftSlotRepository{
+			findByBusinessDayFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+				return []*shift.ShiftSlot{slot}, nil
+			},
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+				return slot, nil
+			},
+		},
+		&MockShiftAssignmentRepository{
+			countConfirmedByMembersFunc: func(ctx context.Context, tid common.TenantID, memberIDs []common.MemberID, from, to time.Time) (map[common.MemberID]int, error) {
+				countCalls++
+				if len(memberIDs) != 2 {
+					t.Errorf("expected both candidates to be counted at once, got %d", len(memberIDs))
+				}
+				if !from.Equal(time.Date(2024, 12, 11, 0, 0, 0, 0, time.UTC)) || !to.Equal(bd.TargetDate()) {
+					t.Errorf("unexpected fairness window: %v - %v", from, to)
+				}
+				return map[common.MemberID]int{busy.MemberID(): 2}, nil
+			},
+		},
+		&MockMemberRepository{
+			findActiveByTenantIDFunc: func(ctx context.Context, tid common.TenantID) ([]*member.Member, error) {
+				return []*member.Member{busy, fresh}, nil
+			},
+		},
+		&MockMemberRoleRepository{},
+		&MockAvailabilityRepository{},
+		&MockWorkloadPolicyRepository{},
+		&MockAttendanceCollectionRepository{
+			collections: []*attendance.AttendanceCollection{collection},
+			targetDates: map[common.CollectionID][]*attendance.TargetDate{collection.CollectionID(): {td}},
+			responses:   map[common.CollectionID][]*attendance.AttendanceResponse{collection.CollectionID(): {r1, r2}},
+		},
+		&MockOutboxRepository{},
+		&MockTxManager{},
+		&MockClock{now: time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)},
+	)
+
+	output, err := usecase.Execute(context.Background(), appshift.AutoAssignInput{
+		TenantID:      tenantID,
+		BusinessDayID: bd.BusinessDayID(),
+		ActorID:       common.NewMemberID(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if countCalls != 1 {
+		t.Errorf("expected recent assignments to be counted once, got %d", countCalls)
+	}
+	if len(output.Assignments) != 1 || output.Assignments[0].MemberID() != fresh.MemberID() {
+		t.Errorf("expected member with fewer recent assignments to be chosen")
+	}
+}
+
+func TestAutoAssignUsecase_Execute_SkipsAlreadyAssignedAndCountsExisting(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDayOn(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	slot := createTestSlotIn(t, bd, "受付", slotTime(20, 0), slotTime(21, 0), 2, 1)
+	collection, td := createTestAttendanceCollection(t, bd)
+	base := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	already, r1 := createTestAttendanceResponse(t, td, tenantID, "A", base, attendance.ResponseTypeAttending, nil, nil)
+	other, r2 := createTestAttendanceResponse(t, td, tenantID, "B", base, attendance.ResponseTypeAttending, nil, nil)
+	existing := createTestAssignment(t, slot, already.MemberID())
+
+	// 既存の割り当てはシフト枠のロック後に集計する
+	locked := false
+	usecase := appshift.NewAutoAssignUsecase(
+		&MockEventRepository{},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return bd, nil
+			},
+		},
+		&MockShiftSlotRepository{
+			findByBusinessDayFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+				return []*shift.ShiftSlot{slot}, nil
+			},
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+				locked = true
+				return slot, nil
+			},
+		},
+		&MockShiftAssignmentRepository{
+			findByBusinessDayIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftAssignment, error) {
+				if !locked {
+					t.Error("existing assignments should be counted after locking the slots")
+				}
+				return []*shift.ShiftAssignment{existing}, nil
+			},
+			countConfirmedBySlotFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (int, error) {
+				return 1, nil
+			},
+		},
+		&MockMemberRepository{
+			findActiveByTenantIDFunc: func(ctx context.Context, tid common.TenantID) ([]*member.Member, error) {
+				return []*member.Member{already, other}, nil
+			},
+		},
+		&MockMemberRoleRepository{},
+		&MockAvailabilityRepository{},
+		&MockWorkloadPolicyRepository{},
+		&MockAttendanceCollectionRepository{
+			collections: []*attendance.AttendanceCollection{collection},
+			targetDates: map[common.CollectionID][]*attendance.TargetDate{collection.CollectionID(): {td}},
+			responses:   map[common.CollectionID][]*attendance.AttendanceResponse{collection.CollectionID(): {r1, r2}},
+		},
+		&MockOutboxRepository{},
+		&MockTxManager{},
+		&MockClock{now: time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)},
+	)
+
+	output, err := usecase.Execute(context.Background(), appshift.AutoAssignInput{
+		TenantID:      tenantID,
+		BusinessDayID: bd.BusinessDayID(),
+		ActorID:       common.NewMemberID(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if len(output.Assignments) != 1 || output.Assignments[0].MemberID() != other.MemberID() {
+		t.Errorf("expected only the unassigned member to fill the remaining seat")
+	}
+}
+
+func TestAutoAssignUsecase_Execute_RestrictsToCollectionRoles(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDayOn(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	slot := createTestSlotIn(t, bd, "受付", slotTime(20, 0), slotTime(21, 0), 2, 1)
+	collection, td := createTestAttendanceCollection(t, bd)
+	base := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	staff, r1 := createTestAttendanceResponse(t, td, tenantID, "A", base, attendance.ResponseTypeAttending, nil, nil)
+	guest, r2 := createTestAttendanceResponse(t, td, tenantID, "B", base, attendance.ResponseTypeAttending, nil, nil)
+
+	roleID := common.NewRoleID()
+	ra, err := attendance.NewCollectionRoleAssignment(time.Now(), collection.CollectionID(), roleID)
+	if err != nil {
+		t.Fatalf("Failed to create role assignment: %v", err)
+	}
+
+	usecase := appshift.NewAutoAssignUsecase(
+		&MockEventRepository{},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return bd, nil
+			},
+		},
+		&MockShiftSlotRepository{
+			findByBusinessDayFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+				return []*shift.ShiftSlot{slot}, nil
+			},
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+				return slot, nil
+			},
+		},
+		&MockShiftAssignmentRepository{},
+		&MockMemberRepository{
+			findActiveByTenantIDFunc: func(ctx context.Context, tid common.TenantID) ([]*member.Member, error) {
+				return []*member.Member{staff, guest}, nil
+			},
+		},
+		&MockMemberRoleRepository{roles: map[common.MemberID][]common.RoleID{staff.MemberID(): {roleID}}},
+		&MockAvailabilityRepository{},
+		&MockWorkloadPolicyRepository{},
+		&MockAttendanceCollectionRepository{
+			collections:     []*attendance.AttendanceCollection{collection},
+			targetDates:     map[common.CollectionID][]*attendance.TargetDate{collection.CollectionID(): {td}},
+			responses:       map[common.CollectionID][]*attendance.AttendanceResponse{collection.CollectionID(): {r1, r2}},
+			roleAssignments: map[common.CollectionID][]*attendance.CollectionRoleAssignment{collection.CollectionID(): {ra}},
+		},
+		&MockOutboxRepository{},
+		&MockTxManager{},
+		&MockClock{now: time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)},
+	)
+
+	output, err := usecase.Execute(context.Background(), appshift.AutoAssignInput{
+		TenantID:      tenantID,
+		BusinessDayID: bd.BusinessDayID(),
+		ActorID:       common.NewMemberID(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if len(output.Assignments) != 1 || output.Assignments[0].MemberID() != staff.MemberID() {
+		t.Errorf("expected only members with the collection role to be assigned")
+	}
+}
+
+func TestAutoAssignUsecase_Execute_RespectsRequiredSlotRoles(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDayOn(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	slot := createTestSlotIn(t, bd, "DJ", slotTime(20, 0), slotTime(21, 0), 2, 1)
+	roleID := common.NewRoleID()
+	req, err := shift.NewRoleRequirement(roleID, shift.RoleRequirementRequired, 1)
+	if err != nil {
+		t.Fatalf("Failed to create role requirement: %v", err)
+	}
+	if err := slot.SetRoleRequirements(time.Now(), []shift.RoleRequirement{req}); err != nil {
+		t.Fatalf("Failed to set role requirements: %v", err)
+	}
+
+	// 先に回答した A, B はロールなし。C のみ必須ロールを持つ
+	collection, td := createTestAttendanceCollection(t, bd)
+	base := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	a, r1 := createTestAttendanceResponse(t, td, tenantID, "A", base, attendance.ResponseTypeAttending, nil, nil)
+	b, r2 := createTestAttendanceResponse(t, td, tenantID, "B", base.Add(time.Hour), attendance.ResponseTypeAttending, nil, nil)
+	dj, r3 := createTestAttendanceResponse(t, td, tenantID, "C", base.Add(2*time.Hour), attendance.ResponseTypeAttending, nil, nil)
+
+	// 必須ロールの判定は保存済みの割り当てに対して行われる
+	var saved []*shift.ShiftAssignment
+	usecase := appshift.NewAutoAssignUsecase(
+		&MockEventRepository{},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return bd, nil
+			},
+		},
+		&MockShiftSlotRepository{
+			findByBusinessDayFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+				return []*shift.ShiftSlot{slot}, nil
+			},
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+				return slot, nil
+			},
+		},
+		&MockShiftAssignmentRepository{
+			saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+				saved = append(saved, a)
+				return nil
+			},
+			countConfirmedBySlotFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (int, error) {
+				return len(assignmentsOnSlot(saved, slotID)), nil
+			},
+			findConfirmedBySlotIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) ([]*shift.ShiftAssignment, error) {
+				return assignmentsOnSlot(saved, slotID), nil
+			},
+		},
+		&MockMemberRepository{
+			findActiveByTenantIDFunc: func(ctx context.Context, tid common.TenantID) ([]*member.Member, error) {
+				return []*member.Member{a, b, dj}, nil
+			},
+		},
+		&MockMemberRoleRepository{roles: map[common.MemberID][]common.RoleID{dj.MemberID(): {roleID}}},
+		&MockAvailabilityRepository{},
+		&MockWorkloadPolicyRepository{},
+		&MockAttendanceCollectionRepository{
+			collections: []*attendance.AttendanceCollection{collection},
+			targetDates: map[common.CollectionID][]*attendance.TargetDate{collection.CollectionID(): {td}},
+			responses:   map[common.CollectionID][]*attendance.AttendanceResponse{collection.CollectionID(): {r1, r2, r3}},
+		},
+		&MockOutboxRepository{},
+		&MockTxManager{},
+		&MockClock{now: time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)},
+	)
+
+	output, err := usecase.Execute(context.Background(), appshift.AutoAssignInput{
+		TenantID:      tenantID,
+		BusinessDayID: bd.BusinessDayID(),
+		ActorID:       common.NewMemberID(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	assigned := map[common.MemberID]bool{}
+	for _, as := range output.Assignments {
+		assigned[as.MemberID()] = true
+	}
+	if len(output.Assignments) != 2 || !assigned[a.MemberID()] || !assigned[dj.MemberID()] {
+		t.Errorf("expected A and the member with the required role, got %d assignments", len(output.Assignments))
+	}
+}
+
+func TestAutoAssignUsecase_Execute_SkipsMembersRejectedByAssignmentChecks(t *testing.T) {
+	tenantID := common.NewTenantID()
+	targetDate := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	bd := createTestBusinessDayOn(t, tenantID, targetDate)
+	slot := createTestSlotIn(t, bd, "受付", slotTime(20, 0), slotTime(22, 0), 1, 1)
+	collection, td := createTestAttendanceCollection(t, bd)
+	base := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	overworked, r1 := createTestAttendanceResponse(t, td, tenantID, "A", base, attendance.ResponseTypeAttending, nil, nil)
+	doubleBooked, r2 := createTestAttendanceResponse(t, td, tenantID, "B", base.Add(time.Hour), attendance.ResponseTypeAttending, nil, nil)
+	free, r3 := createTestAttendanceResponse(t, td, tenantID, "C", base.Add(2*time.Hour), attendance.ResponseTypeAttending, nil, nil)
+
+	// A: 1 営業日 60 分までの上限（block）を超える
+	maxMinutes := 60
+	memberID := overworked.MemberID()
+	policy, err := member.NewWorkloadPolicy(time.Now(), tenantID, &memberID,
+		member.WorkloadLimits{MaxMinutesPerBusinessDay: &maxMinutes}, member.WorkloadEnforcementBlock)
+	if err != nil {
+		t.Fatalf("Failed to create workload policy: %v", err)
+	}
+
+	// B: 同じ日の別イベントで時間帯が重なる枠に確定済み
+	otherDay, otherSlot := createTestSlotOnDate(t, tenantID, targetDate)
+	otherAssignment := createTestAssignment(t, otherSlot, doubleBooked.MemberID())
+
+	var saved []*shift.ShiftAssignment
+	usecase := appshift.NewAutoAssignUsecase(
+		&MockEventRepository{},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return bd, nil
+			},
+			findByTenantIDAndDateFunc: func(ctx context.Context, tid common.TenantID, date time.Time) ([]*event.EventBusinessDay, error) {
+				return []*event.EventBusinessDay{otherDay}, nil
+			},
+		},
+		&MockShiftSlotRepository{
+			findByBusinessDayFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+				return []*shift.ShiftSlot{slot}, nil
+			},
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+				if slotID == otherSlot.SlotID() {
+					return otherSlot, nil
+				}
+				return slot, nil
+			},
+		},
+		&MockShiftAssignmentRepository{
+			saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+				saved = append(saved, a)
+				return nil
+			},
+			findByBusinessDayIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftAssignment, error) {
+				if id == otherDay.BusinessDayID() {
+					return []*shift.ShiftAssignment{otherAssignment}, nil
+				}
+				return nil, nil
+			},
+		},
+		&MockMemberRepository{
+			findActiveByTenantIDFunc: func(ctx context.Context, tid common.TenantID) ([]*member.Member, error) {
+				return []*member.Member{overworked, doubleBooked, free}, nil
+			},
+		},
+		&MockMemberRoleRepository{},
+		&MockAvailabilityRepository{},
+		&MockWorkloadPolicyRepository{memberPolicies: map[common.MemberID]*member.WorkloadPolicy{memberID: policy}},
+		&MockAttendanceCollectionRepository{
+			collections: []*attendance.AttendanceCollection{collection},
+			targetDates: map[common.CollectionID][]*attendance.TargetDate{collection.CollectionID(): {td}},
+			responses:   map[common.CollectionID][]*attendance.AttendanceResponse{collection.CollectionID(): {r1, r2, r3}},
+		},
+		&MockOutboxRepository{},
+		&MockTxManager{},
+		&MockClock{now: time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)},
+	)
+
+	output, err := usecase.Execute(context.Background(), appshift.AutoAssignInput{
+		TenantID:      tenantID,
+		BusinessDayID: bd.BusinessDayID(),
+		ActorID:       common.NewMemberID(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if len(output.Assignments) != 1 || output.Assignments[0].MemberID() != free.MemberID() {
+		t.Fatalf("expected only the member passing the assignment checks to be assigned, got %+v", output.Assignments)
+	}
+	if len(saved) != 1 || output.Assignments[0].IsConflictOverridden() {
+		t.Errorf("expected 1 saved assignment without conflict override, saved %d", len(saved))
+	}
+}
+
+func TestAutoAssignUsecase_Execute_IgnoresOtherDatesAndEvents(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDayOn(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	slot := createTestSlotIn(t, bd, "受付", slotTime(20, 0), slotTime(21, 0), 1, 1)
+
+	// 別イベント向けの出欠確認
+	other, err := attendance.NewAttendanceCollection(time.Now(), tenantID, "別イベント", "", attendance.TargetTypeEvent, common.NewEventID().String(), nil)
+	if err != nil {
+		t.Fatalf("Failed to create collection: %v", err)
+	}
+	td, err := attendance.NewTargetDate(time.Now(), other.CollectionID(), bd.TargetDate(), nil, nil, 0)
+	if err != nil {
+		t.Fatalf("Failed to create target date: %v", err)
+	}
+	m, r := createTestAttendanceResponse(t, td, tenantID, "A", time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), attendance.ResponseTypeAttending, nil, nil)
+
+	usecase := appshift.NewAutoAssignUsecase(
+		&MockEventRepository{},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return bd, nil
+			},
+		},
+		&MockShiftSlotRepository{
+			findByBusinessDayFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftSlot, error) {
+				return []*shift.ShiftSlot{slot}, nil
+			},
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+				return slot, nil
+			},
+		},
+		&MockShiftAssignmentRepository{
+			saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+				t.Error("nothing should be saved")
+				return nil
+			},
+		},
+		&MockMemberRepository{
+			findActiveByTenantIDFunc: func(ctx context.Context, tid common.TenantID) ([]*member.Member, error) {
+				return []*member.Member{m}, nil
+			},
+		},
+		&MockMemberRoleRepository{},
+		&MockAvailabilityRepository{},
+		&MockWorkloadPolicyRepository{},
+		&MockAttendanceCollectionRepository{
+			collections: []*attendance.AttendanceCollection{other},
+			targetDates: map[common.CollectionID][]*attendance.TargetDate{other.CollectionID(): {td}},
+			responses:   map[common.CollectionID][]*attendance.AttendanceResponse{other.CollectionID(): {r}},
+		},
+		&MockOutboxRepository{},
+		&MockTxManager{},
+		&MockClock{now: time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)},
+	)
+
+	output, err := usecase.Execute(context.Background(), appshift.AutoAssignInput{
+		TenantID:      tenantID,
+		BusinessDayID: bd.BusinessDayID(),
+		ActorID:       common.NewMemberID(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if len(output.Assignments) != 0 {
+		t.Errorf("expected no assignments, got %d", len(output.Assignments))
+	}
+}
+
+func TestAutoAssignUsecase_Execute_ErrorWhenBusinessDayCancelled(t *testing.T) {
+	tenantID := common.NewTenantID()
+	businessDay := createTestBusinessDay(t, tenantID, common.NewEventID())
+	if err := businessDay.Cancel(time.Now(), "", nil); err != nil {
+		t.Fatalf("Failed to cancel business day: %v", err)
+	}
+
+	usecase := appshift.NewAutoAssignUsecase(
+		&MockEventRepository{},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return businessDay, nil
+			},
+		},
+		&MockShiftSlotRepository{},
+		&MockShiftAssignmentRepository{
+			saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+				t.Error("assignment should not be saved for a cancelled business day")
+				return nil
+			},
+		},
+		&MockMemberRepository{},
+		&MockMemberRoleRepository{},
+		&MockAvailabilityRepository{},
+		&MockWorkloadPolicyRepository{},
+		&MockAttendanceCollectionRepository{},
+		&MockOutboxRepository{},
+		&MockTxManager{},
+		&MockClock{now: time.Now()},
+	)
+
+	_, err := usecase.Execute(context.Background(), appshift.AutoAssignInput{
+		TenantID:      tenantID,
+		BusinessDayID: businessDay.BusinessDayID(),
+		ActorID:       common.NewMemberID(),
+	})
+	if !isConflictError(err) {
+		t.Errorf("Execute() should return Conflict for a cancelled business day, got %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package shift
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// AssignmentWarningMemberUnavailable はメンバーの参加可能時間帯の外、またはブラックアウト日に割り当てたことを示す
+const AssignmentWarningMemberUnavailable = "MEMBER_UNAVAILABLE"
+
+// checkMemberAvailability checks the member's availability calendar against the slot on targetDate
+// 深夜帯の枠・ブラックアウトが日付をまたぐため、前日から翌日までのブラックアウトを対象とする
+func checkMemberAvailability(
+	ctx context.Context,
+	availabilityRepo member.AvailabilityRepository,
+	tenantID common.TenantID,
+	memberID common.MemberID,
+	slot *shift.ShiftSlot,
+	targetDate time.Time,
+) (member.Availability, error) {
+	windows, err := availabilityRepo.FindWindowsByMemberID(ctx, tenantID, memberID)
+	if err != nil {
+		return member.Availability{}, fmt.Errorf("failed to find availability windows: %w", err)
+	}
+
+	blackouts, err := availabilityRepo.FindBlackoutsByMemberIDAndDateRange(
+		ctx, tenantID, memberID, targetDate.AddDate(0, 0, -1), targetDate.AddDate(0, 0, 1),
+	)
+	if err != nil {
+		return member.Availability{}, fmt.Errorf("failed to find availability blackouts: %w", err)
+	}
+
+	start, end := slot.PeriodOn(targetDate)
+	return member.CheckAvailability(windows, blackouts, start, end), nil
+}
+
+// availabilityWarnings converts an unavailable result to assignment warnings
+func availabilityWarnings(availability member.Availability) []AssignmentWarning {
+	if availability.Available {
+		return nil
+	}
+
+	message := "member is outside their availability windows for this slot"
+	if availability.Reason == member.UnavailabilityReasonBlackout {
+		message = "member has a blackout on " + availability.Blackout.Date().Format("2006-01-02")
+		if availability.Blackout.Reason() != "" {
+			message += ": " + availability.Blackout.Reason()
+		}
+	}
+	return []AssignmentWarning{{
+		Code:    AssignmentWarningMemberUnavailable,
+		Message: message,
+	}}
+}
@@ -0,0 +1,50 @@
+package shift
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// enqueueNotification writes a notification for the member to the outbox
+// 呼び出し元のトランザクション（context）で保存し、配信はワーカーが行う。
+// Discord・メールのどちらも連携していないメンバーには通知しない（false を返す）
+func enqueueNotification(
+	ctx context.Context,
+	outboxRepo notification.OutboxRepository,
+	now time.Time,
+	recipient *member.Member,
+	businessDayID *event.BusinessDayID,
+	notificationType notification.NotificationType,
+	content string,
+) (bool, error) {
+	channel, ok := notification.PreferredChannel(recipient.DiscordUserID(), recipient.Email())
+	if !ok {
+		return false, nil
+	}
+
+	msg, err := notification.NewOutboxMessage(now, recipient.TenantID(), businessDayID, recipient.MemberID(), notificationType, channel, content)
+	if err != nil {
+		return false, err
+	}
+	if err := outboxRepo.Save(ctx, msg); err != nil {
+		return false, fmt.Errorf("failed to enqueue notification: %w", err)
+	}
+
+	return true, nil
+}
+
+// shiftConfirmedContent returns the content of the shift confirmed notification for the slot
+func shiftConfirmedContent(businessDay *event.EventBusinessDay, slot *shift.ShiftSlot) string {
+	return fmt.Sprintf("シフトが確定しました: %s %s %s〜%s",
+		businessDay.TargetDate().Format("2006-01-02"),
+		slot.SlotName(),
+		slot.StartTime().Format("15:04"),
+		slot.EndTime().Format("15:04"),
+	)
+}
@@ -0,0 +1,176 @@
+package shift
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/role"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// AssignmentWarningPreferredRoleMissing は推奨ロールを持たないメンバーを割り当てたことを示す
+const AssignmentWarningPreferredRoleMissing = "PREFERRED_ROLE_MISSING"
+
+// AssignmentWarning represents a non-blocking issue found while confirming an assignment
+type AssignmentWarning struct {
+	Code    string
+	Message string
+}
+
+// RoleRequirementInput represents a role requirement in slot and template inputs
+// Count が 0 の場合は 1 として扱う
+type RoleRequirementInput struct {
+	RoleID common.RoleID
+	Kind   shift.RoleRequirementKind
+	Count  int
+}
+
+// toRoleRequirements converts inputs to role requirement value objects
+func toRoleRequirements(inputs []RoleRequirementInput) ([]shift.RoleRequirement, error) {
+	requirements := make([]shift.RoleRequirement, 0, len(inputs))
+	for _, in := range inputs {
+		count := in.Count
+		if count == 0 {
+			count = 1
+		}
+		req, err := shift.NewRoleRequirement(in.RoleID, in.Kind, count)
+		if err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, req)
+	}
+	return requirements, nil
+}
+
+// ensureRequirementRolesExist checks that every role referenced by the requirements belongs to the tenant
+func ensureRequirementRolesExist(
+	ctx context.Context,
+	roleRepo role.RoleRepository,
+	tenantID common.TenantID,
+	inputs []RoleRequirementInput,
+) error {
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	roleIDs := make([]common.RoleID, 0, len(inputs))
+	for _, in := range inputs {
+		roleIDs = append(roleIDs, in.RoleID)
+	}
+	foundRoles, err := roleRepo.FindByIDs(ctx, tenantID, roleIDs)
+	if err != nil {
+		return err
+	}
+
+	foundRoleMap := make(map[common.RoleID]bool, len(foundRoles))
+	for _, r := range foundRoles {
+		foundRoleMap[r.RoleID()] = true
+	}
+	for _, in := range inputs {
+		if !foundRoleMap[in.RoleID] {
+			return common.NewValidationError(
+				fmt.Sprintf("role not found or not accessible: %s", in.RoleID),
+				nil,
+			)
+		}
+	}
+	return nil
+}
+
+// findAssignedMemberRoles returns the roles of each member confirmed on the slot
+func findAssignedMemberRoles(
+	ctx context.Context,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	tenantID common.TenantID,
+	slotID shift.SlotID,
+) ([][]common.RoleID, error) {
+	assignments, err := assignmentRepo.FindConfirmedBySlotID(ctx, tenantID, slotID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find slot assignments: %w", err)
+	}
+	return findMemberRolesOf(ctx, memberRoleRepo, assignments)
+}
+
+// findMemberRolesOf returns the roles of each assigned member
+func findMemberRolesOf(
+	ctx context.Context,
+	memberRoleRepo member.MemberRoleRepository,
+	assignments []*shift.ShiftAssignment,
+) ([][]common.RoleID, error) {
+	assignedRoles := make([][]common.RoleID, 0, len(assignments))
+	for _, a := range assignments {
+		roles, err := memberRoleRepo.FindRolesByMemberID(ctx, a.MemberID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to find member roles: %w", err)
+		}
+		assignedRoles = append(assignedRoles, roles)
+	}
+	return assignedRoles, nil
+}
+
+// checkRoleRequirements evaluates assigning the member to the slot against its role requirements
+// ロール要件のない枠ではリポジトリにアクセスしない
+func checkRoleRequirements(
+	ctx context.Context,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	tenantID common.TenantID,
+	memberID common.MemberID,
+	slot *shift.ShiftSlot,
+) (shift.RoleRequirementCheck, error) {
+	if !slot.HasRoleRequirements() {
+		return shift.RoleRequirementCheck{}, nil
+	}
+
+	assignments, err := assignmentRepo.FindConfirmedBySlotID(ctx, tenantID, slot.SlotID())
+	if err != nil {
+		return shift.RoleRequirementCheck{}, fmt.Errorf("failed to find slot assignments: %w", err)
+	}
+	return evaluateRoleRequirements(ctx, memberRoleRepo, slot, assignments, memberID)
+}
+
+// evaluateRoleRequirements evaluates adding the member to the given confirmed assignments of the slot
+// 下書きプランではプラン内の割り当てを対象に評価する
+func evaluateRoleRequirements(
+	ctx context.Context,
+	memberRoleRepo member.MemberRoleRepository,
+	slot *shift.ShiftSlot,
+	assignments []*shift.ShiftAssignment,
+	memberID common.MemberID,
+) (shift.RoleRequirementCheck, error) {
+	if !slot.HasRoleRequirements() {
+		return shift.RoleRequirementCheck{}, nil
+	}
+
+	assignedRoles, err := findMemberRolesOf(ctx, memberRoleRepo, assignments)
+	if err != nil {
+		return shift.RoleRequirementCheck{}, err
+	}
+
+	candidateRoles, err := memberRoleRepo.FindRolesByMemberID(ctx, memberID)
+	if err != nil {
+		return shift.RoleRequirementCheck{}, fmt.Errorf("failed to find member roles: %w", err)
+	}
+
+	return slot.EvaluateRoleRequirements(assignedRoles, candidateRoles), nil
+}
+
+// roleRequirementWarnings converts unmet preferred roles to assignment warnings
+func roleRequirementWarnings(check shift.RoleRequirementCheck) []AssignmentWarning {
+	if len(check.Warnings) == 0 {
+		return nil
+	}
+
+	roleIDs := make([]string, 0, len(check.Warnings))
+	for _, w := range check.Warnings {
+		roleIDs = append(roleIDs, w.RoleID.String())
+	}
+	return []AssignmentWarning{{
+		Code:    AssignmentWarningPreferredRoleMissing,
+		Message: "member does not have preferred role(s): " + strings.Join(roleIDs, ", "),
+	}}
+}
@@ -0,0 +1,363 @@
+package shift
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
+)
+
+// DefaultSelfServiceDays is the default number of days ahead shown to members
+const DefaultSelfServiceDays = 14
+
+// maxSelfServiceDays is the maximum number of days ahead shown to members
+const maxSelfServiceDays = 90
+
+// selfServiceUntil returns the end of the period shown to members
+func selfServiceUntil(now time.Time, days int) time.Time {
+	if days <= 0 {
+		days = DefaultSelfServiceDays
+	}
+	if days > maxSelfServiceDays {
+		days = maxSelfServiceDays
+	}
+	return now.AddDate(0, 0, days)
+}
+
+// eventNameCache caches event names looked up while listing shifts
+type eventNameCache struct {
+	eventRepo event.EventRepository
+	tenantID  common.TenantID
+	names     map[common.EventID]string
+}
+
+func (c *eventNameCache) name(ctx context.Context, eventID common.EventID) (string, error) {
+	if name, ok := c.names[eventID]; ok {
+		return name, nil
+	}
+	e, err := c.eventRepo.FindByID(ctx, c.tenantID, eventID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find event: %w", err)
+	}
+	c.names[eventID] = e.EventName()
+	return e.EventName(), nil
+}
+
+// =====================================================
+// Upcoming shifts
+// =====================================================
+
+// ListUpcomingShiftsInput represents the input for listing a member's upcoming shifts
+type ListUpcomingShiftsInput struct {
+	TenantID common.TenantID
+	MemberID common.MemberID
+	Days     int // 0 以下の場合は DefaultSelfServiceDays
+}
+
+// UpcomingShift represents a confirmed shift of the member that has not ended yet
+type UpcomingShift struct {
+	Shift     shift.AssignedShift
+	EventID   common.EventID
+	EventName string
+	StartAt   time.Time
+	EndAt     time.Time
+}
+
+// ListUpcomingShiftsUsecase handles listing a member's upcoming confirmed shifts
+type ListUpcomingShiftsUsecase struct {
+	assignmentRepo  shift.ShiftAssignmentRepository
+	businessDayRepo event.EventBusinessDayRepository
+	eventRepo       event.EventRepository
+	tenantRepo      tenant.TenantRepository
+	clock           services.Clock
+}
+
+// NewListUpcomingShiftsUsecase creates a new ListUpcomingShiftsUsecase
+func NewListUpcomingShiftsUsecase(
+	assignmentRepo shift.ShiftAssignmentRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	eventRepo event.EventRepository,
+	tenantRepo tenant.TenantRepository,
+	clock services.Clock,
+) *ListUpcomingShiftsUsecase {
+	return &ListUpcomingShiftsUsecase{
+		assignmentRepo:  assignmentRepo,
+		businessDayRepo: businessDayRepo,
+		eventRepo:       eventRepo,
+		tenantRepo:      tenantRepo,
+		clock:           clock,
+	}
+}
+
+// Execute lists the member's confirmed shifts from now until the given days ahead (開始時刻の昇順)
+// 進行中のシフトも終了するまでは含める
+func (uc *ListUpcomingShiftsUsecase) Execute(ctx context.Context, input ListUpcomingShiftsInput) ([]UpcomingShift, error) {
+	loc, err := tenant.ResolveLocation(ctx, uc.tenantRepo, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	until := selfServiceUntil(now, input.Days)
+
+	// 日を跨ぐ営業日のシフトを取りこぼさないよう、前日の営業日から検索する
+	from := common.DateIn(now, loc).AddDate(0, 0, -1)
+	to := common.DateIn(until, loc)
+	shifts, err := uc.assignmentRepo.FindConfirmedShiftsByDateRange(ctx, input.TenantID, &input.MemberID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	events := &eventNameCache{eventRepo: uc.eventRepo, tenantID: input.TenantID, names: make(map[common.EventID]string)}
+	result := make([]UpcomingShift, 0, len(shifts))
+	for _, s := range shifts {
+		startAt, endAt := s.PeriodIn(loc)
+		if !endAt.After(now) || startAt.After(until) {
+			continue
+		}
+
+		businessDay, err := uc.businessDayRepo.FindByID(ctx, input.TenantID, s.BusinessDayID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find business day: %w", err)
+		}
+		eventName, err := events.name(ctx, businessDay.EventID())
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, UpcomingShift{
+			Shift:     s,
+			EventID:   businessDay.EventID(),
+			EventName: eventName,
+			StartAt:   startAt,
+			EndAt:     endAt,
+		})
+	}
+
+	return result, nil
+}
+
+// =====================================================
+// Open slots
+// =====================================================
+
+// ListOpenSlotsInput represents the input for listing slots with vacancies
+type ListOpenSlotsInput struct {
+	TenantID common.TenantID
+	MemberID common.MemberID // このメンバーが割り当て済みの枠は除く
+	Days     int             // 0 以下の場合は DefaultSelfServiceDays
+}
+
+// OpenSlot represents a slot of an upcoming business day that still has vacancies
+type OpenSlot struct {
+	Slot        *shift.ShiftSlot
+	BusinessDay *event.EventBusinessDay
+	EventName   string
+	Assigned    int
+	StartAt     time.Time
+	EndAt       time.Time
+}
+
+// ListOpenSlotsUsecase handles listing the slots members can claim
+type ListOpenSlotsUsecase struct {
+	eventRepo       event.EventRepository
+	businessDayRepo event.EventBusinessDayRepository
+	slotRepo        shift.ShiftSlotRepository
+	assignmentRepo  shift.ShiftAssignmentRepository
+	tenantRepo      tenant.TenantRepository
+	clock           services.Clock
+}
+
+// NewListOpenSlotsUsecase creates a new ListOpenSlotsUsecase
+func NewListOpenSlotsUsecase(
+	eventRepo event.EventRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	slotRepo shift.ShiftSlotRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	tenantRepo tenant.TenantRepository,
+	clock services.Clock,
+) *ListOpenSlotsUsecase {
+	return &ListOpenSlotsUsecase{
+		eventRepo:       eventRepo,
+		businessDayRepo: businessDayRepo,
+		slotRepo:        slotRepo,
+		assignmentRepo:  assignmentRepo,
+		tenantRepo:      tenantRepo,
+		clock:           clock,
+	}
+}
+
+// Execute lists the slots with vacancies on business days that have not started yet (開始時刻の昇順)
+func (uc *ListOpenSlotsUsecase) Execute(ctx context.Context, input ListOpenSlotsInput) ([]OpenSlot, error) {
+	loc, err := tenant.ResolveLocation(ctx, uc.tenantRepo, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	until := selfServiceUntil(now, input.Days)
+
+	events, err := uc.eventRepo.FindActiveByTenantID(ctx, input.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find events: %w", err)
+	}
+
+	var result []OpenSlot
+	for _, e := range events {
+		if e.IsArchived() {
+			continue
+		}
+
+		businessDays, err := uc.businessDayRepo.FindByEventIDAndDateRange(ctx, input.TenantID, e.EventID(), common.DateIn(now, loc), common.DateIn(until, loc))
+		if err != nil {
+			return nil, fmt.Errorf("failed to find business days: %w", err)
+		}
+
+		for _, bd := range businessDays {
+			if !isClaimable(bd, now, loc) {
+				continue
+			}
+
+			slots, err := uc.slotRepo.FindByBusinessDayID(ctx, input.TenantID, bd.BusinessDayID())
+			if err != nil {
+				return nil, fmt.Errorf("failed to find shift slots: %w", err)
+			}
+
+			for _, slot := range slots {
+				assigned, err := uc.assignmentRepo.CountConfirmedBySlotID(ctx, input.TenantID, slot.SlotID())
+				if err != nil {
+					return nil, fmt.Errorf("failed to count assignments: %w", err)
+				}
+				if assigned >= slot.RequiredCount() {
+					continue
+				}
+				mine, err := uc.assignmentRepo.ExistsBySlotIDAndMemberID(ctx, input.TenantID, slot.SlotID(), input.MemberID)
+				if err != nil {
+					return nil, err
+				}
+				if mine {
+					continue
+				}
+
+				startAt, endAt := slot.PeriodIn(bd.TargetDate(), loc)
+				result = append(result, OpenSlot{
+					Slot:        slot,
+					BusinessDay: bd,
+					EventName:   e.EventName(),
+					Assigned:    assigned,
+					StartAt:     startAt,
+					EndAt:       endAt,
+				})
+			}
+		}
+	}
+
+	sortOpenSlots(result)
+	return result, nil
+}
+
+// sortOpenSlots sorts the slots by start time (同時刻の場合はイベント名順)
+func sortOpenSlots(slots []OpenSlot) {
+	sort.SliceStable(slots, func(i, j int) bool {
+		if !slots[i].StartAt.Equal(slots[j].StartAt) {
+			return slots[i].StartAt.Before(slots[j].StartAt)
+		}
+		return slots[i].EventName < slots[j].EventName
+	})
+}
+
+// isClaimable reports whether members can still sign up for the business day
+func isClaimable(bd *event.EventBusinessDay, now time.Time, loc *time.Location) bool {
+	return bd.IsActive() && !bd.IsCancelled() && bd.StartAt(loc).After(now)
+}
+
+// =====================================================
+// Claim
+// =====================================================
+
+// SlotAssigner confirms an assignment of a member to a slot
+// (implemented by ConfirmManualAssignmentUsecase)
+type SlotAssigner interface {
+	Execute(ctx context.Context, input ConfirmManualAssignmentInput) (*ConfirmManualAssignmentResult, error)
+}
+
+// ClaimOpenSlotInput represents the input for a member claiming an open slot
+type ClaimOpenSlotInput struct {
+	TenantID common.TenantID
+	SlotID   shift.SlotID
+	MemberID common.MemberID
+	Note     string
+}
+
+// ClaimOpenSlotUsecase handles a member signing themselves up for a slot with a vacancy
+type ClaimOpenSlotUsecase struct {
+	slotRepo        shift.ShiftSlotRepository
+	businessDayRepo event.EventBusinessDayRepository
+	assignmentRepo  shift.ShiftAssignmentRepository
+	tenantRepo      tenant.TenantRepository
+	assigner        SlotAssigner
+	clock           services.Clock
+}
+
+// NewClaimOpenSlotUsecase creates a new ClaimOpenSlotUsecase
+func NewClaimOpenSlotUsecase(
+	slotRepo shift.ShiftSlotRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	tenantRepo tenant.TenantRepository,
+	assigner SlotAssigner,
+	clock services.Clock,
+) *ClaimOpenSlotUsecase {
+	return &ClaimOpenSlotUsecase{
+		slotRepo:        slotRepo,
+		businessDayRepo: businessDayRepo,
+		assignmentRepo:  assignmentRepo,
+		tenantRepo:      tenantRepo,
+		assigner:        assigner,
+		clock:           clock,
+	}
+}
+
+// Execute assigns the member to the slot
+// 定員・必須ロール・時間帯の重複・勤務量の上限は手動割り当てと同じ規則で検証する（強制確定はできない）
+func (uc *ClaimOpenSlotUsecase) Execute(ctx context.Context, input ClaimOpenSlotInput) (*ConfirmManualAssignmentResult, error) {
+	slot, err := uc.slotRepo.FindByID(ctx, input.TenantID, input.SlotID)
+	if err != nil {
+		return nil, err
+	}
+
+	businessDay, err := uc.businessDayRepo.FindByID(ctx, input.TenantID, slot.BusinessDayID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find business day: %w", err)
+	}
+
+	loc, err := tenant.ResolveLocation(ctx, uc.tenantRepo, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if !isClaimable(businessDay, uc.clock.Now(), loc) {
+		return nil, shift.ErrSlotNotClaimable
+	}
+
+	assigned, err := uc.assignmentRepo.ExistsBySlotIDAndMemberID(ctx, input.TenantID, input.SlotID, input.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	if assigned {
+		return nil, shift.ErrAlreadyAssigned
+	}
+
+	return uc.assigner.Execute(ctx, ConfirmManualAssignmentInput{
+		TenantID: input.TenantID,
+		SlotID:   input.SlotID,
+		MemberID: input.MemberID,
+		ActorID:  input.MemberID,
+		Note:     input.Note,
+	})
+}
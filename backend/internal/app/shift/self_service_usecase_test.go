@@ -0,0 +1,212 @@
+package shift_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	appshift "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
+)
+
+// =====================================================
+// Mocks
+// =====================================================
+
+type MockTenantRepository struct {
+	tenant *tenant.Tenant
+}
+
+func (m *MockTenantRepository) FindByID(ctx context.Context, tenantID common.TenantID) (*tenant.Tenant, error) {
+	return m.tenant, nil
+}
+
+func (m *MockTenantRepository) FindByPendingStripeSessionID(ctx context.Context, sessionID string) (*tenant.Tenant, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockTenantRepository) Save(ctx context.Context, t *tenant.Tenant) error {
+	return nil
+}
+
+func (m *MockTenantRepository) ListAll(ctx context.Context, status *tenant.TenantStatus, limit, offset int) ([]*tenant.Tenant, int, error) {
+	return nil, 0, nil
+}
+
+// MockSlotAssigner records the assignment delegated by ClaimOpenSlotUsecase
+type MockSlotAssigner struct {
+	inputs []appshift.ConfirmManualAssignmentInput
+}
+
+func (m *MockSlotAssigner) Execute(ctx context.Context, input appshift.ConfirmManualAssignmentInput) (*appshift.ConfirmManualAssignmentResult, error) {
+	m.inputs = append(m.inputs, input)
+	return &appshift.ConfirmManualAssignmentResult{}, nil
+}
+
+// =====================================================
+// ClaimOpenSlotUsecase Tests
+// =====================================================
+
+// createTestClaimableSlot creates a slot on a business day starting at 2025-01-10 21:00 UTC
+func createTestClaimableSlot(t *testing.T) (*tenant.Tenant, *event.EventBusinessDay, *shift.ShiftSlot) {
+	t.Helper()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ten, err := tenant.NewTenant(now, "Test Organization", "UTC")
+	if err != nil {
+		t.Fatalf("Failed to create tenant: %v", err)
+	}
+	start := time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC)
+	end := time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC)
+
+	businessDay, err := event.NewEventBusinessDay(now, ten.TenantID(), common.NewEventID(), time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), start, end, event.OccurrenceTypeSpecial, nil)
+	if err != nil {
+		t.Fatalf("Failed to create business day: %v", err)
+	}
+	slot, err := shift.NewShiftSlot(now, ten.TenantID(), businessDay.BusinessDayID(), nil, "受付", "", start, end, 2, 1)
+	if err != nil {
+		t.Fatalf("Failed to create shift slot: %v", err)
+	}
+	return ten, businessDay, slot
+}
+
+func TestClaimOpenSlot_AssignsMemberAsActor(t *testing.T) {
+	ten, businessDay, slot := createTestClaimableSlot(t)
+	memberID := common.NewMemberID()
+	assigner := &MockSlotAssigner{}
+
+	usecase := appshift.NewClaimOpenSlotUsecase(
+		&MockShiftSlotRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+				return slot, nil
+			},
+		},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return businessDay, nil
+			},
+		},
+		&MockShiftAssignmentRepository{},
+		&MockTenantRepository{tenant: ten},
+		assigner,
+		&MockClock{now: time.Date(2025, 1, 10, 20, 0, 0, 0, time.UTC)},
+	)
+
+	_, err := usecase.Execute(context.Background(), appshift.ClaimOpenSlotInput{
+		TenantID: ten.TenantID(),
+		SlotID:   slot.SlotID(),
+		MemberID: memberID,
+		Note:     "入れます",
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if len(assigner.inputs) != 1 {
+		t.Fatalf("assignment should be delegated once: got %d", len(assigner.inputs))
+	}
+	input := assigner.inputs[0]
+	if input.MemberID != memberID || input.ActorID != memberID {
+		t.Errorf("member should be both assignee and actor: got member %v, actor %v", input.MemberID, input.ActorID)
+	}
+	if input.Force || input.OverrideWorkload {
+		t.Error("self-service claims must not force the assignment or override workload limits")
+	}
+	if input.Note != "入れます" {
+		t.Errorf("Note mismatch: got %q", input.Note)
+	}
+}
+
+func TestClaimOpenSlot_ErrorWhenSlotNotClaimable(t *testing.T) {
+	tests := []struct {
+		name      string
+		now       time.Time
+		cancelled bool
+	}{
+		{name: "営業日が開始済み", now: time.Date(2025, 1, 10, 21, 30, 0, 0, time.UTC)},
+		{name: "営業日が中止", now: time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC), cancelled: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ten, businessDay, slot := createTestClaimableSlot(t)
+			if tt.cancelled {
+				if err := businessDay.Cancel(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), "雨天中止", nil); err != nil {
+					t.Fatalf("Failed to cancel business day: %v", err)
+				}
+			}
+			assigner := &MockSlotAssigner{}
+
+			usecase := appshift.NewClaimOpenSlotUsecase(
+				&MockShiftSlotRepository{
+					findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+						return slot, nil
+					},
+				},
+				&MockBusinessDayRepository{
+					findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+						return businessDay, nil
+					},
+				},
+				&MockShiftAssignmentRepository{},
+				&MockTenantRepository{tenant: ten},
+				assigner,
+				&MockClock{now: tt.now},
+			)
+
+			_, err := usecase.Execute(context.Background(), appshift.ClaimOpenSlotInput{
+				TenantID: ten.TenantID(),
+				SlotID:   slot.SlotID(),
+				MemberID: common.NewMemberID(),
+			})
+			if !errors.Is(err, shift.ErrSlotNotClaimable) {
+				t.Fatalf("Execute() should fail with ErrSlotNotClaimable, got: %v", err)
+			}
+			if len(assigner.inputs) != 0 {
+				t.Error("assignment should not be delegated")
+			}
+		})
+	}
+}
+
+func TestClaimOpenSlot_ErrorWhenAlreadyAssigned(t *testing.T) {
+	ten, businessDay, slot := createTestClaimableSlot(t)
+	memberID := common.NewMemberID()
+	assigner := &MockSlotAssigner{}
+
+	usecase := appshift.NewClaimOpenSlotUsecase(
+		&MockShiftSlotRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+				return slot, nil
+			},
+		},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return businessDay, nil
+			},
+		},
+		&MockShiftAssignmentRepository{
+			existsBySlotAndMemberFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID, mid common.MemberID) (bool, error) {
+				return mid == memberID, nil
+			},
+		},
+		&MockTenantRepository{tenant: ten},
+		assigner,
+		&MockClock{now: time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)},
+	)
+
+	_, err := usecase.Execute(context.Background(), appshift.ClaimOpenSlotInput{
+		TenantID: ten.TenantID(),
+		SlotID:   slot.SlotID(),
+		MemberID: memberID,
+	})
+	if !errors.Is(err, shift.ErrAlreadyAssigned) {
+		t.Fatalf("Execute() should fail with ErrAlreadyAssigned, got: %v", err)
+	}
+	if len(assigner.inputs) != 0 {
+		t.Error("assignment should not be delegated")
+	}
+}
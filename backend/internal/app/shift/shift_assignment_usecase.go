@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
 )
 
@@ -19,6 +22,18 @@ type ConfirmManualAssignmentInput struct {
 	MemberID common.MemberID
 	ActorID  common.MemberID
 	Note     string
+	// Force が true の場合、時間帯が重複する割り当てがあっても確定する（割り当てに記録される）
+	Force bool
+	// OverrideWorkload が true の場合、勤務量の上限超過（enforcement = block）も警告として扱い確定する
+	OverrideWorkload bool
+}
+
+// ConfirmManualAssignmentResult represents the confirmed assignment and any warnings
+type ConfirmManualAssignmentResult struct {
+	Assignment *shift.ShiftAssignment
+	// OverriddenWorkloadLimits は OverrideWorkload により上書きした勤務量の上限
+	OverriddenWorkloadLimits []member.WorkloadViolation
+	Warnings                 []AssignmentWarning
 }
 
 // ConfirmManualAssignmentUsecase handles manual shift assignment confirmation
@@ -26,6 +41,10 @@ type ConfirmManualAssignmentUsecase struct {
 	slotRepo       shift.ShiftSlotRepository
 	assignmentRepo shift.ShiftAssignmentRepository
 	memberRepo     member.MemberRepository
+	checker        assignmentChecker
+	outboxRepo     notification.OutboxRepository
+	txManager      services.TxManager
+	clock          services.Clock
 }
 
 // NewConfirmManualAssignmentUsecase creates a new ConfirmManualAssignmentUsecase
@@ -33,92 +52,144 @@ func NewConfirmManualAssignmentUsecase(
 	slotRepo shift.ShiftSlotRepository,
 	assignmentRepo shift.ShiftAssignmentRepository,
 	memberRepo member.MemberRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	availabilityRepo member.AvailabilityRepository,
+	workloadPolicyRepo member.WorkloadPolicyRepository,
+	eventRepo event.EventRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	outboxRepo notification.OutboxRepository,
+	txManager services.TxManager,
+	clock services.Clock,
 ) *ConfirmManualAssignmentUsecase {
 	return &ConfirmManualAssignmentUsecase{
 		slotRepo:       slotRepo,
 		assignmentRepo: assignmentRepo,
 		memberRepo:     memberRepo,
+		checker: assignmentChecker{
+			slotRepo:           slotRepo,
+			assignmentRepo:     assignmentRepo,
+			memberRoleRepo:     memberRoleRepo,
+			availabilityRepo:   availabilityRepo,
+			workloadPolicyRepo: workloadPolicyRepo,
+			eventRepo:          eventRepo,
+			businessDayRepo:    businessDayRepo,
+		},
+		outboxRepo: outboxRepo,
+		txManager:  txManager,
+		clock:      clock,
 	}
 }
 
 // Execute confirms a manual shift assignment
 //
-// Logic:
-//  1. Get ShiftSlot (with tenant_id check)
+// Logic (1-11 はトランザクション内で実行):
+//  1. Get ShiftSlot with row lock (with tenant_id check)
+//     同じ枠への同時確定はロック解放まで待機するため、定員チェックと保存の間に割り込まれない
 //  2. Get Member (with tenant_id check)
-//  3. Count existing confirmed assignments for the slot
-//  4. Return ErrSlotFull if count >= required_count
-//  5. Create ShiftAssignment
-//  6. Save assignment
-//  7. Log notification stub
-//  8. Log audit log stub
+//  3. Return ErrSlotFull if count >= required_count
+//  4. Check role requirements of the slot
+//  5. Check the business day is not cancelled and the event is not archived
+//  6. Detect overlapping assignments of the member (return AssignmentConflictError unless Force)
+//  7. Check the member's availability calendar
+//  8. Check the member's workload limits (return WorkloadLimitError unless OverrideWorkload)
+//     3-8 は交換・空き待ちの繰り上げと共通（assignmentChecker.check）
+//  9. Create ShiftAssignment (record conflict override if forced)
+//  10. Save assignment
+//  11. Enqueue shift confirmed notification to the outbox
+//  12. Log audit log stub
 func (uc *ConfirmManualAssignmentUsecase) Execute(
 	ctx context.Context,
 	input ConfirmManualAssignmentInput,
-) (*shift.ShiftAssignment, error) {
-	// 1. Get ShiftSlot (with tenant_id check)
-	slot, err := uc.slotRepo.FindByID(ctx, input.TenantID, input.SlotID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find shift slot: %w", err)
-	}
+) (*ConfirmManualAssignmentResult, error) {
+	var (
+		slot         *shift.ShiftSlot
+		memberEntity *member.Member
+		assignment   *shift.ShiftAssignment
+		overridden   []member.WorkloadViolation
+		warnings     []AssignmentWarning
+	)
 
-	// 2. Get Member (with tenant_id check)
-	memberEntity, err := uc.memberRepo.FindByID(ctx, input.TenantID, input.MemberID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find member: %w", err)
-	}
+	err := uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		var err error
 
-	// 3. Count existing confirmed assignments
-	currentCount, err := uc.assignmentRepo.CountConfirmedBySlotID(ctx, input.TenantID, input.SlotID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to count assignments: %w", err)
-	}
+		// 1. Get ShiftSlot with row lock (with tenant_id check)
+		slot, err = uc.slotRepo.FindByIDForUpdate(txCtx, input.TenantID, input.SlotID)
+		if err != nil {
+			return fmt.Errorf("failed to find shift slot: %w", err)
+		}
+
+		// 2. Get Member (with tenant_id check)
+		memberEntity, err = uc.memberRepo.FindByID(txCtx, input.TenantID, input.MemberID)
+		if err != nil {
+			return fmt.Errorf("failed to find member: %w", err)
+		}
 
-	// 4. Return ErrSlotFull if full
-	if currentCount >= slot.RequiredCount() {
-		return nil, common.NewDomainError(
-			common.ErrConflict,
-			fmt.Sprintf("slot is full: %d/%d", currentCount, slot.RequiredCount()),
+		// 3-8. Capacity, role requirements, conflicts, availability and workload checks
+		checked, err := uc.checker.check(txCtx, slot, input.MemberID, assignmentCheckOptions{
+			Force:            input.Force,
+			OverrideWorkload: input.OverrideWorkload,
+		})
+		if err != nil {
+			return err
+		}
+		overridden = checked.OverriddenWorkload
+		warnings = checked.Warnings
+		businessDay := checked.BusinessDay
+
+		// 9. Create ShiftAssignment
+		now := uc.clock.Now()
+		var nilPlanID shift.PlanID // Zero value (treated as NULL)
+		assignment, err = shift.NewShiftAssignment(
+			now,
+			input.TenantID,
+			nilPlanID,
+			input.SlotID,
+			input.MemberID,
+			shift.AssignmentMethodManual,
+			false, // is_outside_preference
 		)
-	}
+		if err != nil {
+			return fmt.Errorf("failed to create shift assignment: %w", err)
+		}
+		if len(checked.Conflicts) > 0 {
+			assignment.OverrideConflict(now)
+		}
 
-	// 5. Create ShiftAssignment
-	now := time.Now()
-	var nilPlanID shift.PlanID // Zero value (treated as NULL)
-	assignment, err := shift.NewShiftAssignment(
-		now,
-		input.TenantID,
-		nilPlanID,
-		input.SlotID,
-		input.MemberID,
-		shift.AssignmentMethodManual,
-		false, // is_outside_preference
-	)
+		// 10. Save assignment
+		if err := uc.assignmentRepo.Save(txCtx, assignment); err != nil {
+			return fmt.Errorf("failed to save shift assignment: %w", err)
+		}
+
+		// 11. Enqueue shift confirmed notification（割り当てと同じトランザクションでアウトボックスに書き込む）
+		businessDayID := businessDay.BusinessDayID()
+		_, err = enqueueNotification(txCtx, uc.outboxRepo, now, memberEntity, &businessDayID,
+			notification.NotificationTypeShiftConfirmed, shiftConfirmedContent(businessDay, slot))
+		return err
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create shift assignment: %w", err)
+		return nil, err
 	}
 
-	// 6. Save assignment
-	if err := uc.assignmentRepo.Save(ctx, assignment); err != nil {
-		return nil, fmt.Errorf("failed to save shift assignment: %w", err)
+	// 12. AuditLog stub (log output)
+	overriddenKinds := make([]string, 0, len(overridden))
+	for _, v := range overridden {
+		overriddenKinds = append(overriddenKinds, string(v.Kind))
 	}
-
-	// 7. Notification stub (log output)
-	log.Printf("[Notification Stub] シフト確定通知: member=%s, slot=%s, assigned_at=%s",
-		memberEntity.DisplayName(),
-		slot.SlotName(),
-		assignment.AssignedAt().Format("2006-01-02 15:04:05"),
-	)
-
-	// 8. AuditLog stub (log output)
-	log.Printf("[AuditLog Stub] CREATE ShiftAssignment: actor_id=%s, assignment_id=%s, member_id=%s, slot_id=%s",
+	log.Printf("[AuditLog Stub] CREATE ShiftAssignment: actor_id=%s, assignment_id=%s, member_id=%s, slot_id=%s, conflict_overridden=%t, workload_overridden=[%s], warnings=%d",
 		input.ActorID.String(),
 		assignment.AssignmentID().String(),
 		input.MemberID.String(),
 		input.SlotID.String(),
+		assignment.IsConflictOverridden(),
+		strings.Join(overriddenKinds, ","),
+		len(warnings),
 	)
 
-	return assignment, nil
+	return &ConfirmManualAssignmentResult{
+		Assignment:               assignment,
+		OverriddenWorkloadLimits: overridden,
+		Warnings:                 warnings,
+	}, nil
 }
 
 // GetAssignmentsInput represents the input for getting assignments
@@ -328,20 +399,86 @@ type CancelAssignmentInput struct {
 
 // CancelAssignmentUsecase handles canceling a shift assignment
 type CancelAssignmentUsecase struct {
-	assignmentRepo shift.ShiftAssignmentRepository
+	promoter  standbyPromoter
+	txManager services.TxManager
+	clock     services.Clock
 }
 
 // NewCancelAssignmentUsecase creates a new CancelAssignmentUsecase
-func NewCancelAssignmentUsecase(assignmentRepo shift.ShiftAssignmentRepository) *CancelAssignmentUsecase {
+func NewCancelAssignmentUsecase(
+	assignmentRepo shift.ShiftAssignmentRepository,
+	slotRepo shift.ShiftSlotRepository,
+	standbyRepo shift.StandbyRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	eventRepo event.EventRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	availabilityRepo member.AvailabilityRepository,
+	workloadPolicyRepo member.WorkloadPolicyRepository,
+	txManager services.TxManager,
+	clock services.Clock,
+) *CancelAssignmentUsecase {
 	return &CancelAssignmentUsecase{
-		assignmentRepo: assignmentRepo,
+		promoter: newStandbyPromoter(
+			standbyRepo, slotRepo, assignmentRepo, businessDayRepo,
+			eventRepo, memberRoleRepo, availabilityRepo, workloadPolicyRepo,
+		),
+		txManager: txManager,
+		clock:     clock,
 	}
 }
 
-// Execute cancels a shift assignment (logical delete)
+// Execute cancels a shift assignment and fills the vacancy from the slot's standby list
+//
+// Logic (1-4 はトランザクション内で実行):
+//  1. Get ShiftAssignment (with tenant_id check)
+//  2. Lock the ShiftSlot (繰り上げと手動確定の同時実行を防ぐ)
+//  3. Delete assignment
+//  4. Promote or offer the slot to standby members in position order
+//  5. Log notification stub
 func (uc *CancelAssignmentUsecase) Execute(
 	ctx context.Context,
 	input CancelAssignmentInput,
 ) error {
-	return uc.assignmentRepo.Delete(ctx, input.TenantID, input.AssignmentID)
+	var changed []*shift.StandbyEntry
+
+	err := uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		// 1. Get ShiftAssignment
+		assignment, err := uc.promoter.assignmentRepo.FindByID(txCtx, input.TenantID, input.AssignmentID)
+		if err != nil {
+			return err
+		}
+
+		// 2. Lock the ShiftSlot
+		slot, err := uc.promoter.slotRepo.FindByIDForUpdate(txCtx, input.TenantID, assignment.SlotID())
+		if err != nil {
+			return fmt.Errorf("failed to find shift slot: %w", err)
+		}
+
+		// 3. Delete assignment
+		if err := uc.promoter.assignmentRepo.Delete(txCtx, input.TenantID, input.AssignmentID); err != nil {
+			return err
+		}
+
+		// 4. Fill the vacancy from the standby list
+		changed, err = uc.promoter.fillVacancies(txCtx, slot, uc.clock.Now())
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// 5. Notification stub (log output)
+	logStandbyChanges(changed)
+
+	return nil
+}
+
+// ensureEventNotArchived returns a conflict error when the event has been archived
+// アーカイブ済みのイベントには新しい割り当てを作成しない
+func ensureEventNotArchived(ctx context.Context, eventRepo event.EventRepository, tenantID common.TenantID, eventID common.EventID) error {
+	e, err := eventRepo.FindByID(ctx, tenantID, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to find event: %w", err)
+	}
+	return e.EnsureNotArchived()
 }
@@ -0,0 +1,780 @@
+package shift
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// findPublishedPlanInScope は plan と同じ範囲の公開中プランを返す（存在しない場合は nil）
+func findPublishedPlanInScope(ctx context.Context, planRepo shift.ShiftPlanRepository, plan *shift.ShiftPlan) (*shift.ShiftPlan, error) {
+	published, err := planRepo.FindPublishedByEventID(ctx, plan.TenantID(), plan.EventID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find published shift plans: %w", err)
+	}
+	for _, p := range published {
+		if p.PlanID() != plan.PlanID() && p.SameScope(plan) {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
+
+// findOverlappingPublishedPlan は plan と範囲が一部重なる（同じ範囲ではない）公開中プランを返す（存在しない場合は nil）
+// 期間単位のプランどうしは期間で、営業日単位のプランとは営業日の日付が期間に含まれるかで判定する
+func findOverlappingPublishedPlan(ctx context.Context, planRepo shift.ShiftPlanRepository, businessDayRepo event.EventBusinessDayRepository, plan *shift.ShiftPlan) (*shift.ShiftPlan, error) {
+	published, err := planRepo.FindPublishedByEventID(ctx, plan.TenantID(), plan.EventID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find published shift plans: %w", err)
+	}
+	for _, p := range published {
+		if p.PlanID() == plan.PlanID() || p.SameScope(plan) {
+			continue
+		}
+		if p.IsBusinessDayPlan() && plan.IsBusinessDayPlan() {
+			continue
+		}
+		if !p.IsBusinessDayPlan() && !plan.IsBusinessDayPlan() {
+			if p.OverlapsPeriod(plan) {
+				return p, nil
+			}
+			continue
+		}
+
+		periodPlan, dayPlan := p, plan
+		if p.IsBusinessDayPlan() {
+			periodPlan, dayPlan = plan, p
+		}
+		bd, err := businessDayRepo.FindByID(ctx, plan.TenantID(), *dayPlan.BusinessDayID())
+		if err != nil {
+			return nil, err
+		}
+		if periodPlan.Covers(bd) {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateShiftPlanInput represents the input for creating a draft shift plan
+type CreateShiftPlanInput struct {
+	TenantID      common.TenantID
+	EventID       common.EventID
+	BusinessDayID *event.BusinessDayID // 営業日単位の場合
+	PeriodStart   *time.Time           // イベント期間単位の場合
+	PeriodEnd     *time.Time           // イベント期間単位の場合
+	PlanName      string
+	// CopyFromPublished が true の場合、同じ範囲の公開中プランの割り当てを下書きにコピーする
+	CopyFromPublished bool
+}
+
+// CreateShiftPlanUsecase handles creating a draft shift plan
+type CreateShiftPlanUsecase struct {
+	planRepo        shift.ShiftPlanRepository
+	eventRepo       event.EventRepository
+	businessDayRepo event.EventBusinessDayRepository
+	assignmentRepo  shift.ShiftAssignmentRepository
+	txManager       TxManager
+	clock           services.Clock
+}
+
+// NewCreateShiftPlanUsecase creates a new CreateShiftPlanUsecase
+func NewCreateShiftPlanUsecase(
+	planRepo shift.ShiftPlanRepository,
+	eventRepo event.EventRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	txManager TxManager,
+	clock services.Clock,
+) *CreateShiftPlanUsecase {
+	return &CreateShiftPlanUsecase{
+		planRepo:        planRepo,
+		eventRepo:       eventRepo,
+		businessDayRepo: businessDayRepo,
+		assignmentRepo:  assignmentRepo,
+		txManager:       txManager,
+		clock:           clock,
+	}
+}
+
+// Execute creates a draft shift plan
+func (uc *CreateShiftPlanUsecase) Execute(ctx context.Context, input CreateShiftPlanInput) (*shift.ShiftPlan, error) {
+	now := uc.clock.Now()
+
+	// イベントの存在確認（アーカイブ済みのイベントにはプランを作成しない）
+	e, err := uc.eventRepo.FindByID(ctx, input.TenantID, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.EnsureNotArchived(); err != nil {
+		return nil, err
+	}
+
+	// 営業日がイベントに属しているか確認
+	if input.BusinessDayID != nil {
+		bd, err := uc.businessDayRepo.FindByID(ctx, input.TenantID, *input.BusinessDayID)
+		if err != nil {
+			return nil, err
+		}
+		if bd.EventID() != input.EventID {
+			return nil, common.NewValidationError("business day does not belong to the event", nil)
+		}
+	}
+
+	plan, err := shift.NewShiftPlan(now, input.TenantID, input.EventID, input.BusinessDayID, input.PeriodStart, input.PeriodEnd, input.PlanName)
+	if err != nil {
+		return nil, err
+	}
+
+	// 公開中プランの割り当てをコピー
+	var copies []*shift.ShiftAssignment
+	if input.CopyFromPublished {
+		published, err := findPublishedPlanInScope(ctx, uc.planRepo, plan)
+		if err != nil {
+			return nil, err
+		}
+		if published != nil {
+			assignments, err := uc.assignmentRepo.FindByPlanID(ctx, input.TenantID, published.PlanID())
+			if err != nil {
+				return nil, fmt.Errorf("failed to find published assignments: %w", err)
+			}
+			for _, a := range assignments {
+				if !a.IsConfirmed() {
+					continue
+				}
+				c, err := shift.NewShiftAssignment(now, input.TenantID, plan.PlanID(), a.SlotID(), a.MemberID(), a.AssignmentMethod(), a.IsOutsidePreference())
+				if err != nil {
+					return nil, fmt.Errorf("failed to copy shift assignment: %w", err)
+				}
+				copies = append(copies, c)
+			}
+		}
+	}
+
+	err = uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		if err := uc.planRepo.Save(txCtx, plan); err != nil {
+			return err
+		}
+		for _, c := range copies {
+			if err := uc.assignmentRepo.Save(txCtx, c); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// ListShiftPlansInput represents the input for listing shift plans of an event
+type ListShiftPlansInput struct {
+	TenantID common.TenantID
+	EventID  common.EventID
+}
+
+// ListShiftPlansUsecase handles listing shift plans
+type ListShiftPlansUsecase struct {
+	planRepo shift.ShiftPlanRepository
+}
+
+// NewListShiftPlansUsecase creates a new ListShiftPlansUsecase
+func NewListShiftPlansUsecase(planRepo shift.ShiftPlanRepository) *ListShiftPlansUsecase {
+	return &ListShiftPlansUsecase{planRepo: planRepo}
+}
+
+// Execute lists shift plans of an event
+func (uc *ListShiftPlansUsecase) Execute(ctx context.Context, input ListShiftPlansInput) ([]*shift.ShiftPlan, error) {
+	return uc.planRepo.FindByEventID(ctx, input.TenantID, input.EventID)
+}
+
+// GetShiftPlanInput represents the input for getting a shift plan
+type GetShiftPlanInput struct {
+	TenantID common.TenantID
+	PlanID   shift.PlanID
+}
+
+// ShiftPlanWithAssignments represents a shift plan with its assignments
+type ShiftPlanWithAssignments struct {
+	Plan        *shift.ShiftPlan
+	Assignments []*shift.ShiftAssignment
+}
+
+// GetShiftPlanUsecase handles getting a shift plan with its assignments
+type GetShiftPlanUsecase struct {
+	planRepo       shift.ShiftPlanRepository
+	assignmentRepo shift.ShiftAssignmentRepository
+}
+
+// NewGetShiftPlanUsecase creates a new GetShiftPlanUsecase
+func NewGetShiftPlanUsecase(planRepo shift.ShiftPlanRepository, assignmentRepo shift.ShiftAssignmentRepository) *GetShiftPlanUsecase {
+	return &GetShiftPlanUsecase{
+		planRepo:       planRepo,
+		assignmentRepo: assignmentRepo,
+	}
+}
+
+// Execute gets a shift plan with its assignments
+func (uc *GetShiftPlanUsecase) Execute(ctx context.Context, input GetShiftPlanInput) (*ShiftPlanWithAssignments, error) {
+	plan, err := uc.planRepo.FindByID(ctx, input.TenantID, input.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := uc.assignmentRepo.FindByPlanID(ctx, input.TenantID, input.PlanID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find plan assignments: %w", err)
+	}
+
+	return &ShiftPlanWithAssignments{Plan: plan, Assignments: assignments}, nil
+}
+
+// AddPlanAssignmentInput represents the input for adding an assignment to a draft plan
+type AddPlanAssignmentInput struct {
+	TenantID common.TenantID
+	PlanID   shift.PlanID
+	SlotID   shift.SlotID
+	MemberID common.MemberID
+}
+
+// AddPlanAssignmentUsecase handles adding an assignment to a draft plan
+type AddPlanAssignmentUsecase struct {
+	planRepo        shift.ShiftPlanRepository
+	slotRepo        shift.ShiftSlotRepository
+	businessDayRepo event.EventBusinessDayRepository
+	memberRepo      member.MemberRepository
+	memberRoleRepo  member.MemberRoleRepository
+	assignmentRepo  shift.ShiftAssignmentRepository
+	clock           services.Clock
+}
+
+// NewAddPlanAssignmentUsecase creates a new AddPlanAssignmentUsecase
+func NewAddPlanAssignmentUsecase(
+	planRepo shift.ShiftPlanRepository,
+	slotRepo shift.ShiftSlotRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	memberRepo member.MemberRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	clock services.Clock,
+) *AddPlanAssignmentUsecase {
+	return &AddPlanAssignmentUsecase{
+		planRepo:        planRepo,
+		slotRepo:        slotRepo,
+		businessDayRepo: businessDayRepo,
+		memberRepo:      memberRepo,
+		memberRoleRepo:  memberRoleRepo,
+		assignmentRepo:  assignmentRepo,
+		clock:           clock,
+	}
+}
+
+// Execute adds an assignment to a draft plan
+//
+// Logic:
+//  1. プランが下書きであることを確認
+//  2. シフト枠がプランの範囲内（営業日 / 期間）であり、営業日が中止されていないことを確認
+//  3. メンバーの存在確認
+//  4. プラン内で同じ枠・メンバーの重複、および必要人数の超過を確認
+//  5. プラン内の割り当てに対して枠のロール要件を確認
+//  6. 割り当てを保存（メンバーには公開されない）
+func (uc *AddPlanAssignmentUsecase) Execute(ctx context.Context, input AddPlanAssignmentInput) (*shift.ShiftAssignment, error) {
+	plan, err := uc.planRepo.FindByID(ctx, input.TenantID, input.PlanID)
+	if err != nil {
+		return nil, err
+	}
+	if !plan.IsDraft() {
+		return nil, shift.ErrPlanNotDraft
+	}
+
+	slot, err := uc.slotRepo.FindByID(ctx, input.TenantID, input.SlotID)
+	if err != nil {
+		return nil, err
+	}
+	bd, err := uc.businessDayRepo.FindByID(ctx, input.TenantID, slot.BusinessDayID())
+	if err != nil {
+		return nil, err
+	}
+	if !plan.Covers(bd) {
+		return nil, shift.ErrSlotOutOfPlanScope
+	}
+	if err := bd.EnsureNotCancelled(); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.memberRepo.FindByID(ctx, input.TenantID, input.MemberID); err != nil {
+		return nil, err
+	}
+
+	planAssignments, err := uc.assignmentRepo.FindByPlanID(ctx, input.TenantID, input.PlanID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find plan assignments: %w", err)
+	}
+	var slotAssignments []*shift.ShiftAssignment
+	for _, a := range planAssignments {
+		if !a.IsConfirmed() || a.SlotID() != input.SlotID {
+			continue
+		}
+		if a.MemberID() == input.MemberID {
+			return nil, common.NewConflictError("member is already assigned to this slot in the plan")
+		}
+		slotAssignments = append(slotAssignments, a)
+	}
+	if len(slotAssignments) >= slot.RequiredCount() {
+		return nil, shift.ErrSlotFull
+	}
+
+	roleCheck, err := evaluateRoleRequirements(ctx, uc.memberRoleRepo, slot, slotAssignments, input.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	if !roleCheck.Satisfiable() {
+		return nil, &shift.RoleRequirementError{
+			MemberID:   input.MemberID,
+			Shortfalls: roleCheck.Violations,
+		}
+	}
+
+	assignment, err := shift.NewShiftAssignment(
+		uc.clock.Now(),
+		input.TenantID,
+		input.PlanID,
+		input.SlotID,
+		input.MemberID,
+		shift.AssignmentMethodManual,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.assignmentRepo.Save(ctx, assignment); err != nil {
+		return nil, fmt.Errorf("failed to save shift assignment: %w", err)
+	}
+
+	return assignment, nil
+}
+
+// RemovePlanAssignmentInput represents the input for removing an assignment from a draft plan
+type RemovePlanAssignmentInput struct {
+	TenantID     common.TenantID
+	PlanID       shift.PlanID
+	AssignmentID shift.AssignmentID
+}
+
+// RemovePlanAssignmentUsecase handles removing an assignment from a draft plan
+type RemovePlanAssignmentUsecase struct {
+	planRepo       shift.ShiftPlanRepository
+	assignmentRepo shift.ShiftAssignmentRepository
+}
+
+// NewRemovePlanAssignmentUsecase creates a new RemovePlanAssignmentUsecase
+func NewRemovePlanAssignmentUsecase(planRepo shift.ShiftPlanRepository, assignmentRepo shift.ShiftAssignmentRepository) *RemovePlanAssignmentUsecase {
+	return &RemovePlanAssignmentUsecase{
+		planRepo:       planRepo,
+		assignmentRepo: assignmentRepo,
+	}
+}
+
+// Execute removes an assignment from a draft plan
+func (uc *RemovePlanAssignmentUsecase) Execute(ctx context.Context, input RemovePlanAssignmentInput) error {
+	plan, err := uc.planRepo.FindByID(ctx, input.TenantID, input.PlanID)
+	if err != nil {
+		return err
+	}
+	if !plan.IsDraft() {
+		return shift.ErrPlanNotDraft
+	}
+
+	assignment, err := uc.assignmentRepo.FindByID(ctx, input.TenantID, input.AssignmentID)
+	if err != nil {
+		return err
+	}
+	if assignment.PlanID() != input.PlanID {
+		return common.NewNotFoundError("ShiftAssignment", input.AssignmentID.String())
+	}
+
+	return uc.assignmentRepo.Delete(ctx, input.TenantID, input.AssignmentID)
+}
+
+// DiffShiftPlanInput represents the input for diffing a plan against the published plan
+type DiffShiftPlanInput struct {
+	TenantID common.TenantID
+	PlanID   shift.PlanID
+}
+
+// ShiftPlanDiff は下書きプランと公開中プランの差分
+// 同じシフト枠・メンバーの組み合わせを同一の割り当てとみなす
+type ShiftPlanDiff struct {
+	Plan          *shift.ShiftPlan
+	PublishedPlan *shift.ShiftPlan // 同じ範囲の公開中プラン（存在しない場合は nil）
+	Added         []*shift.ShiftAssignment
+	Removed       []*shift.ShiftAssignment
+	Unchanged     []*shift.ShiftAssignment
+}
+
+// DiffShiftPlanUsecase handles diffing a plan against the currently published plan
+type DiffShiftPlanUsecase struct {
+	planRepo       shift.ShiftPlanRepository
+	assignmentRepo shift.ShiftAssignmentRepository
+}
+
+// NewDiffShiftPlanUsecase creates a new DiffShiftPlanUsecase
+func NewDiffShiftPlanUsecase(planRepo shift.ShiftPlanRepository, assignmentRepo shift.ShiftAssignmentRepository) *DiffShiftPlanUsecase {
+	return &DiffShiftPlanUsecase{
+		planRepo:       planRepo,
+		assignmentRepo: assignmentRepo,
+	}
+}
+
+// Execute diffs a plan against the currently published plan in the same scope
+func (uc *DiffShiftPlanUsecase) Execute(ctx context.Context, input DiffShiftPlanInput) (*ShiftPlanDiff, error) {
+	plan, err := uc.planRepo.FindByID(ctx, input.TenantID, input.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	draftAssignments, err := uc.assignmentRepo.FindByPlanID(ctx, input.TenantID, plan.PlanID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find plan assignments: %w", err)
+	}
+
+	published, err := findPublishedPlanInScope(ctx, uc.planRepo, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	var publishedAssignments []*shift.ShiftAssignment
+	if published != nil {
+		publishedAssignments, err = uc.assignmentRepo.FindByPlanID(ctx, input.TenantID, published.PlanID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to find published assignments: %w", err)
+		}
+	}
+
+	type key struct {
+		slotID   shift.SlotID
+		memberID common.MemberID
+	}
+	publishedKeys := make(map[key]bool)
+	for _, a := range publishedAssignments {
+		if a.IsConfirmed() {
+			publishedKeys[key{a.SlotID(), a.MemberID()}] = true
+		}
+	}
+
+	diff := &ShiftPlanDiff{Plan: plan, PublishedPlan: published}
+	draftKeys := make(map[key]bool)
+	for _, a := range draftAssignments {
+		if !a.IsConfirmed() {
+			continue
+		}
+		k := key{a.SlotID(), a.MemberID()}
+		draftKeys[k] = true
+		if publishedKeys[k] {
+			diff.Unchanged = append(diff.Unchanged, a)
+		} else {
+			diff.Added = append(diff.Added, a)
+		}
+	}
+	for _, a := range publishedAssignments {
+		if a.IsConfirmed() && !draftKeys[key{a.SlotID(), a.MemberID()}] {
+			diff.Removed = append(diff.Removed, a)
+		}
+	}
+
+	return diff, nil
+}
+
+// PublishShiftPlanInput represents the input for publishing a draft plan
+type PublishShiftPlanInput struct {
+	TenantID common.TenantID
+	PlanID   shift.PlanID
+	ActorID  common.MemberID
+}
+
+// PublishShiftPlanOutput represents the result of publishing a plan
+type PublishShiftPlanOutput struct {
+	Plan         *shift.ShiftPlan
+	ArchivedPlan *shift.ShiftPlan // 置き換えられた旧プラン（存在しない場合は nil）
+}
+
+// PublishShiftPlanUsecase handles publishing a draft plan
+type PublishShiftPlanUsecase struct {
+	planRepo        shift.ShiftPlanRepository
+	slotRepo        shift.ShiftSlotRepository
+	assignmentRepo  shift.ShiftAssignmentRepository
+	memberRepo      member.MemberRepository
+	businessDayRepo event.EventBusinessDayRepository
+	checker         assignmentChecker
+	outboxRepo      notification.OutboxRepository
+	txManager       TxManager
+	clock           services.Clock
+}
+
+// NewPublishShiftPlanUsecase creates a new PublishShiftPlanUsecase
+func NewPublishShiftPlanUsecase(
+	planRepo shift.ShiftPlanRepository,
+	slotRepo shift.ShiftSlotRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	memberRepo member.MemberRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	availabilityRepo member.AvailabilityRepository,
+	workloadPolicyRepo member.WorkloadPolicyRepository,
+	eventRepo event.EventRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	outboxRepo notification.OutboxRepository,
+	txManager TxManager,
+	clock services.Clock,
+) *PublishShiftPlanUsecase {
+	return &PublishShiftPlanUsecase{
+		planRepo:        planRepo,
+		slotRepo:        slotRepo,
+		assignmentRepo:  assignmentRepo,
+		memberRepo:      memberRepo,
+		businessDayRepo: businessDayRepo,
+		checker: assignmentChecker{
+			slotRepo:           slotRepo,
+			assignmentRepo:     assignmentRepo,
+			memberRoleRepo:     memberRoleRepo,
+			availabilityRepo:   availabilityRepo,
+			workloadPolicyRepo: workloadPolicyRepo,
+			eventRepo:          eventRepo,
+			businessDayRepo:    businessDayRepo,
+		},
+		outboxRepo: outboxRepo,
+		txManager:  txManager,
+		clock:      clock,
+	}
+}
+
+// Execute publishes a draft plan atomically
+//
+// 同じ範囲の公開中プランを archived にし、下書きを published にする。
+// 範囲が一部だけ重なる公開中プラン（期間どうし、または期間と営業日）がある場合は公開しない（ErrPlanScopeOverlaps）。
+// 割り当ての可視性は公開・アーカイブ時に切り替える（ShiftAssignmentRepository.SetLive）。
+// 公開が通知・エクスポートの起点となる（割り当てのあるメンバーへの確定通知を同じトランザクションでアウトボックスに書き込む）。
+//
+// Logic (1-6 はトランザクション内で実行):
+//  1. 同じイベントのプランの公開を直列化する（LockPublicationByEventID）
+//  2. ロック取得後にプランを読み直し、範囲が一部重なる公開中プランがないことを確認する。
+//     同じ範囲の公開中プランはアーカイブして割り当てを非公開にする
+//  3. プランの割り当ての枠を FindByIDForUpdate でロックする（デッドロックを避けるため枠 ID 順）
+//  4. 確定済みの割り当てを 1 件ずつ手動確定と同じ検証（assignmentChecker）にかけてから公開する
+//     公開済みの割り当てに対して定員・ロール要件・中止された営業日・時間帯の重複・勤務量の上限を確認し、
+//     1 件でも満たせない場合は公開しない（その検証エラーを返す）
+//  5. プランを published にする
+//  6. 確定通知をアウトボックスに書き込む
+func (uc *PublishShiftPlanUsecase) Execute(ctx context.Context, input PublishShiftPlanInput) (*PublishShiftPlanOutput, error) {
+	now := uc.clock.Now()
+	output := &PublishShiftPlanOutput{}
+
+	err := uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		plan, err := uc.planRepo.FindByID(txCtx, input.TenantID, input.PlanID)
+		if err != nil {
+			return err
+		}
+
+		// 1. 同じ範囲のプランの同時公開を防ぐ
+		if err := uc.planRepo.LockPublicationByEventID(txCtx, input.TenantID, plan.EventID()); err != nil {
+			return err
+		}
+		plan, err = uc.planRepo.FindByID(txCtx, input.TenantID, input.PlanID)
+		if err != nil {
+			return err
+		}
+		if err := plan.Publish(now); err != nil {
+			return err
+		}
+
+		// 2. 公開中のプランを置き換える（範囲が一部重なるプランとは両立させない）
+		overlapping, err := findOverlappingPublishedPlan(txCtx, uc.planRepo, uc.businessDayRepo, plan)
+		if err != nil {
+			return err
+		}
+		if overlapping != nil {
+			return shift.ErrPlanScopeOverlaps
+		}
+		published, err := findPublishedPlanInScope(txCtx, uc.planRepo, plan)
+		if err != nil {
+			return err
+		}
+		if published != nil {
+			if err := uc.archive(txCtx, published, now); err != nil {
+				return err
+			}
+		}
+
+		// 3-4. 割り当ての検証と公開
+		assignments, err := uc.assignmentRepo.FindByPlanID(txCtx, plan.TenantID(), plan.PlanID())
+		if err != nil {
+			return fmt.Errorf("failed to find plan assignments: %w", err)
+		}
+		if err := uc.publishAssignments(txCtx, plan.TenantID(), assignments); err != nil {
+			return err
+		}
+
+		// 5. プランの公開
+		if err := uc.planRepo.Save(txCtx, plan); err != nil {
+			return err
+		}
+
+		// 6. 確定通知
+		if err := uc.enqueueNotifications(txCtx, plan, assignments, now); err != nil {
+			return err
+		}
+
+		output.Plan = plan
+		output.ArchivedPlan = published
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// AuditLog stub (log output)
+	log.Printf("[AuditLog Stub] PUBLISH ShiftPlan: actor_id=%s, plan_id=%s",
+		input.ActorID.String(),
+		output.Plan.PlanID().String(),
+	)
+
+	return output, nil
+}
+
+// archive archives the published plan and hides its assignments from members
+func (uc *PublishShiftPlanUsecase) archive(ctx context.Context, plan *shift.ShiftPlan, now time.Time) error {
+	if err := plan.Archive(now); err != nil {
+		return err
+	}
+	if err := uc.planRepo.Save(ctx, plan); err != nil {
+		return err
+	}
+
+	assignments, err := uc.assignmentRepo.FindByPlanID(ctx, plan.TenantID(), plan.PlanID())
+	if err != nil {
+		return fmt.Errorf("failed to find published assignments: %w", err)
+	}
+	for _, a := range assignments {
+		if err := uc.assignmentRepo.SetLive(ctx, plan.TenantID(), a.AssignmentID(), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// publishAssignments locks the slots of the plan's assignments, then validates and publishes each confirmed assignment
+// 検証は公開済みの割り当てに対して行うため、先に公開した割り当ても後の割り当ての定員・重複の判定に含まれる
+func (uc *PublishShiftPlanUsecase) publishAssignments(ctx context.Context, tenantID common.TenantID, assignments []*shift.ShiftAssignment) error {
+	var slotIDs []shift.SlotID
+	seen := make(map[shift.SlotID]bool)
+	for _, a := range assignments {
+		if a.IsConfirmed() && !seen[a.SlotID()] {
+			seen[a.SlotID()] = true
+			slotIDs = append(slotIDs, a.SlotID())
+		}
+	}
+	sort.Slice(slotIDs, func(i, j int) bool { return slotIDs[i] < slotIDs[j] })
+
+	slots := make(map[shift.SlotID]*shift.ShiftSlot, len(slotIDs))
+	for _, slotID := range slotIDs {
+		slot, err := uc.slotRepo.FindByIDForUpdate(ctx, tenantID, slotID)
+		if err != nil {
+			return err
+		}
+		slots[slotID] = slot
+	}
+
+	for _, a := range assignments {
+		if !a.IsConfirmed() {
+			continue
+		}
+		if _, err := uc.checker.check(ctx, slots[a.SlotID()], a.MemberID(), assignmentCheckOptions{}); err != nil {
+			return err
+		}
+		if err := uc.assignmentRepo.SetLive(ctx, tenantID, a.AssignmentID(), true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enqueueNotifications enqueues a shift confirmed notification to each member assigned in the plan
+func (uc *PublishShiftPlanUsecase) enqueueNotifications(ctx context.Context, plan *shift.ShiftPlan, assignments []*shift.ShiftAssignment, now time.Time) error {
+	// メンバーごとに担当件数を集計（割り当て順を保つ）
+	var memberIDs []common.MemberID
+	counts := make(map[common.MemberID]int)
+	for _, a := range assignments {
+		if !a.IsConfirmed() {
+			continue
+		}
+		if counts[a.MemberID()] == 0 {
+			memberIDs = append(memberIDs, a.MemberID())
+		}
+		counts[a.MemberID()]++
+	}
+
+	for _, memberID := range memberIDs {
+		m, err := uc.memberRepo.FindByID(ctx, plan.TenantID(), memberID)
+		if err != nil {
+			if common.IsNotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("failed to find member: %w", err)
+		}
+		if !m.IsActive() {
+			continue
+		}
+
+		content := fmt.Sprintf("シフト「%s」が公開されました（担当 %d 件）", plan.PlanName(), counts[memberID])
+		if _, err := enqueueNotification(ctx, uc.outboxRepo, now, m, plan.BusinessDayID(), notification.NotificationTypeShiftConfirmed, content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteShiftPlanInput represents the input for discarding a draft plan
+type DeleteShiftPlanInput struct {
+	TenantID common.TenantID
+	PlanID   shift.PlanID
+}
+
+// DeleteShiftPlanUsecase handles discarding a draft plan
+type DeleteShiftPlanUsecase struct {
+	planRepo shift.ShiftPlanRepository
+	clock    services.Clock
+}
+
+// NewDeleteShiftPlanUsecase creates a new DeleteShiftPlanUsecase
+func NewDeleteShiftPlanUsecase(planRepo shift.ShiftPlanRepository, clock services.Clock) *DeleteShiftPlanUsecase {
+	return &DeleteShiftPlanUsecase{
+		planRepo: planRepo,
+		clock:    clock,
+	}
+}
+
+// Execute discards a draft plan (soft delete)
+func (uc *DeleteShiftPlanUsecase) Execute(ctx context.Context, input DeleteShiftPlanInput) error {
+	plan, err := uc.planRepo.FindByID(ctx, input.TenantID, input.PlanID)
+	if err != nil {
+		return err
+	}
+
+	if err := plan.Delete(uc.clock.Now()); err != nil {
+		return err
+	}
+
+	return uc.planRepo.Save(ctx, plan)
+}
@@ -0,0 +1,783 @@
+package shift_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	appshift "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// =====================================================
+// Mock Repositories (shift plan)
+// =====================================================
+
+type MockShiftPlanRepository struct {
+	plans               map[shift.PlanID]*shift.ShiftPlan
+	lockPublicationFunc func(ctx context.Context, tenantID common.TenantID, eventID common.EventID) error
+}
+
+func (m *MockShiftPlanRepository) Save(ctx context.Context, plan *shift.ShiftPlan) error {
+	m.plans[plan.PlanID()] = plan
+	return nil
+}
+
+func (m *MockShiftPlanRepository) FindByID(ctx context.Context, tenantID common.TenantID, planID shift.PlanID) (*shift.ShiftPlan, error) {
+	plan, ok := m.plans[planID]
+	if !ok || plan.IsDeleted() {
+		return nil, common.NewNotFoundError("ShiftPlan", planID.String())
+	}
+	return plan, nil
+}
+
+func (m *MockShiftPlanRepository) FindByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) ([]*shift.ShiftPlan, error) {
+	var result []*shift.ShiftPlan
+	for _, p := range m.plans {
+		if p.EventID() == eventID && !p.IsDeleted() {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockShiftPlanRepository) FindPublishedByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) ([]*shift.ShiftPlan, error) {
+	var result []*shift.ShiftPlan
+	for _, p := range m.plans {
+		if p.EventID() == eventID && p.IsPublished() && !p.IsDeleted() {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockShiftPlanRepository) LockPublicationByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) error {
+	if m.lockPublicationFunc != nil {
+		return m.lockPublicationFunc(ctx, tenantID, eventID)
+	}
+	return nil
+}
+
+// =====================================================
+// Helper Functions (shift plan)
+// =====================================================
+
+func createTestShiftPlan(t *testing.T, bd *event.EventBusinessDay, published bool) *shift.ShiftPlan {
+	t.Helper()
+	bdID := bd.BusinessDayID()
+	plan, err := shift.NewShiftPlan(time.Now(), bd.TenantID(), bd.EventID(), &bdID, nil, nil, "当日シフト")
+	if err != nil {
+		t.Fatalf("Failed to create test shift plan: %v", err)
+	}
+	if published {
+		if err := plan.Publish(time.Now()); err != nil {
+			t.Fatalf("Failed to publish test shift plan: %v", err)
+		}
+	}
+	return plan
+}
+
+func createTestPlanSlot(t *testing.T, bd *event.EventBusinessDay) *shift.ShiftSlot {
+	t.Helper()
+	slot, err := shift.NewShiftSlot(
+		time.Now(), bd.TenantID(), bd.BusinessDayID(), nil, "受付", "",
+		time.Date(2000, 1, 1, 20, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC),
+		2, 1,
+	)
+	if err != nil {
+		t.Fatalf("Failed to create test shift slot: %v", err)
+	}
+	return slot
+}
+
+func createTestPlanAssignment(t *testing.T, plan *shift.ShiftPlan, slot *shift.ShiftSlot, memberID common.MemberID) *shift.ShiftAssignment {
+	t.Helper()
+	a, err := shift.NewShiftAssignment(time.Now(), plan.TenantID(), plan.PlanID(), slot.SlotID(), memberID, shift.AssignmentMethodManual, false)
+	if err != nil {
+		t.Fatalf("Failed to create test shift assignment: %v", err)
+	}
+	return a
+}
+
+func isConflictError(err error) bool {
+	var domainErr *common.DomainError
+	return errors.As(err, &domainErr) && domainErr.Code() == common.ErrConflict
+}
+
+// =====================================================
+// AddPlanAssignmentUsecase Tests
+// =====================================================
+
+func TestAddPlanAssignmentUsecase_Success(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDay(t, tenantID, common.NewEventID())
+	slot := createTestPlanSlot(t, bd)
+	plan := createTestShiftPlan(t, bd, false)
+
+	var saved *shift.ShiftAssignment
+	usecase := appshift.NewAddPlanAssignmentUsecase(
+		&MockShiftPlanRepository{plans: map[shift.PlanID]*shift.ShiftPlan{plan.PlanID(): plan}},
+		&MockShiftSlotRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+				return slot, nil
+			},
+		},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return bd, nil
+			},
+		},
+		&MockMemberRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, memberID common.MemberID) (*member.Member, error) {
+				return createTestMember(t, tid), nil
+			},
+		},
+		&MockMemberRoleRepository{},
+		&MockShiftAssignmentRepository{
+			saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+				saved = a
+				return nil
+			},
+		},
+		&MockClock{now: time.Now()},
+	)
+
+	assignment, err := usecase.Execute(context.Background(), appshift.AddPlanAssignmentInput{
+		TenantID: tenantID,
+		PlanID:   plan.PlanID(),
+		SlotID:   slot.SlotID(),
+		MemberID: common.NewMemberID(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if assignment.PlanID() != plan.PlanID() {
+		t.Errorf("PlanID: expected %s, got %s", plan.PlanID(), assignment.PlanID())
+	}
+	if saved == nil || saved.AssignmentID() != assignment.AssignmentID() {
+		t.Errorf("assignment should be saved")
+	}
+}
+
+func TestAddPlanAssignmentUsecase_ErrorWhenPlanPublished(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDay(t, tenantID, common.NewEventID())
+	plan := createTestShiftPlan(t, bd, true)
+
+	usecase := appshift.NewAddPlanAssignmentUsecase(
+		&MockShiftPlanRepository{plans: map[shift.PlanID]*shift.ShiftPlan{plan.PlanID(): plan}},
+		&MockShiftSlotRepository{},
+		&MockBusinessDayRepository{},
+		&MockMemberRepository{},
+		&MockMemberRoleRepository{},
+		&MockShiftAssignmentRepository{},
+		&MockClock{now: time.Now()},
+	)
+
+	_, err := usecase.Execute(context.Background(), appshift.AddPlanAssignmentInput{
+		TenantID: tenantID,
+		PlanID:   plan.PlanID(),
+		SlotID:   shift.NewSlotID(),
+		MemberID: common.NewMemberID(),
+	})
+	if !errors.Is(err, shift.ErrPlanNotDraft) {
+		t.Errorf("Execute() should return ErrPlanNotDraft, got %v", err)
+	}
+}
+
+func TestAddPlanAssignmentUsecase_ErrorWhenSlotOutOfScope(t *testing.T) {
+	tenantID := common.NewTenantID()
+	eventID := common.NewEventID()
+	bd := createTestBusinessDay(t, tenantID, eventID)
+	slot := createTestPlanSlot(t, bd)
+	otherBD := event.NewBusinessDayID()
+	plan, err := shift.NewShiftPlan(time.Now(), tenantID, eventID, &otherBD, nil, nil, "別日")
+	if err != nil {
+		t.Fatalf("Failed to create test shift plan: %v", err)
+	}
+
+	usecase := appshift.NewAddPlanAssignmentUsecase(
+		&MockShiftPlanRepository{plans: map[shift.PlanID]*shift.ShiftPlan{plan.PlanID(): plan}},
+		&MockShiftSlotRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+				return slot, nil
+			},
+		},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return bd, nil
+			},
+		},
+		&MockMemberRepository{},
+		&MockMemberRoleRepository{},
+		&MockShiftAssignmentRepository{},
+		&MockClock{now: time.Now()},
+	)
+
+	_, err = usecase.Execute(context.Background(), appshift.AddPlanAssignmentInput{
+		TenantID: tenantID,
+		PlanID:   plan.PlanID(),
+		SlotID:   slot.SlotID(),
+		MemberID: common.NewMemberID(),
+	})
+	if !errors.Is(err, shift.ErrSlotOutOfPlanScope) {
+		t.Errorf("Execute() should return ErrSlotOutOfPlanScope, got %v", err)
+	}
+}
+
+func TestAddPlanAssignmentUsecase_ErrorWhenDuplicateOrFull(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDay(t, tenantID, common.NewEventID())
+	slot := createTestPlanSlot(t, bd) // 必要人数 2
+	plan := createTestShiftPlan(t, bd, false)
+	m1 := common.NewMemberID()
+
+	tests := []struct {
+		name     string
+		existing []common.MemberID
+		memberID common.MemberID
+	}{
+		{name: "同じメンバーの重複", existing: []common.MemberID{m1}, memberID: m1},
+		{name: "必要人数に達している", existing: []common.MemberID{m1, common.NewMemberID()}, memberID: common.NewMemberID()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var existing []*shift.ShiftAssignment
+			for _, memberID := range tt.existing {
+				existing = append(existing, createTestPlanAssignment(t, plan, slot, memberID))
+			}
+
+			usecase := appshift.NewAddPlanAssignmentUsecase(
+				&MockShiftPlanRepository{plans: map[shift.PlanID]*shift.ShiftPlan{plan.PlanID(): plan}},
+				&MockShiftSlotRepository{
+					findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+						return slot, nil
+					},
+				},
+				&MockBusinessDayRepository{
+					findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+						return bd, nil
+					},
+				},
+				&MockMemberRepository{
+					findByIDFunc: func(ctx context.Context, tid common.TenantID, memberID common.MemberID) (*member.Member, error) {
+						return createTestMember(t, tid), nil
+					},
+				},
+				&MockMemberRoleRepository{},
+				&MockShiftAssignmentRepository{
+					findByPlanIDFunc: func(ctx context.Context, tid common.TenantID, planID shift.PlanID) ([]*shift.ShiftAssignment, error) {
+						return existing, nil
+					},
+					saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+						t.Error("assignment should not be saved")
+						return nil
+					},
+				},
+				&MockClock{now: time.Now()},
+			)
+
+			_, err := usecase.Execute(context.Background(), appshift.AddPlanAssignmentInput{
+				TenantID: tenantID,
+				PlanID:   plan.PlanID(),
+				SlotID:   slot.SlotID(),
+				MemberID: tt.memberID,
+			})
+			if !isConflictError(err) {
+				t.Errorf("Execute() should return conflict error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestAddPlanAssignmentUsecase_ErrorWhenRequiredRoleUnfillable(t *testing.T) {
+	tenantID := common.NewTenantID()
+	eventID := common.NewEventID()
+	bd := createTestBusinessDay(t, tenantID, eventID)
+	bdID := bd.BusinessDayID()
+	_, slot := createTestSlotOnDate(t, tenantID, bd.TargetDate())
+	if err := slot.UpdateRequiredCount(time.Now(), 2); err != nil {
+		t.Fatalf("Failed to update required count: %v", err)
+	}
+	req, err := shift.NewRoleRequirement(common.NewRoleID(), shift.RoleRequirementRequired, 1)
+	if err != nil {
+		t.Fatalf("Failed to create role requirement: %v", err)
+	}
+	if err := slot.SetRoleRequirements(time.Now(), []shift.RoleRequirement{req}); err != nil {
+		t.Fatalf("Failed to set role requirements: %v", err)
+	}
+
+	plan, err := shift.NewShiftPlan(time.Now(), tenantID, eventID, &bdID, nil, nil, "当日シフト")
+	if err != nil {
+		t.Fatalf("Failed to create test shift plan: %v", err)
+	}
+	// プラン内の既存の割り当ても必須ロールを持たない
+	existing, err := shift.NewShiftAssignment(time.Now(), tenantID, plan.PlanID(), slot.SlotID(), common.NewMemberID(), shift.AssignmentMethodManual, false)
+	if err != nil {
+		t.Fatalf("Failed to create test shift assignment: %v", err)
+	}
+
+	saved := false
+	usecase := appshift.NewAddPlanAssignmentUsecase(
+		&MockShiftPlanRepository{plans: map[shift.PlanID]*shift.ShiftPlan{plan.PlanID(): plan}},
+		&MockShiftSlotRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+				return slot, nil
+			},
+		},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return bd, nil
+			},
+		},
+		&MockMemberRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, memberID common.MemberID) (*member.Member, error) {
+				return createTestMember(t, tid), nil
+			},
+		},
+		&MockMemberRoleRepository{},
+		&MockShiftAssignmentRepository{
+			findByPlanIDFunc: func(ctx context.Context, tid common.TenantID, planID shift.PlanID) ([]*shift.ShiftAssignment, error) {
+				return []*shift.ShiftAssignment{existing}, nil
+			},
+			saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+				saved = true
+				return nil
+			},
+		},
+		&MockClock{now: time.Now()},
+	)
+
+	_, err = usecase.Execute(context.Background(), appshift.AddPlanAssignmentInput{
+		TenantID: tenantID,
+		PlanID:   plan.PlanID(),
+		SlotID:   slot.SlotID(),
+		MemberID: common.NewMemberID(),
+	})
+
+	var roleErr *shift.RoleRequirementError
+	if !errors.As(err, &roleErr) {
+		t.Fatalf("Execute() should return RoleRequirementError, got %v", err)
+	}
+	if saved {
+		t.Error("assignment should not be saved")
+	}
+}
+
+// =====================================================
+// RemovePlanAssignmentUsecase Tests
+// =====================================================
+
+func TestRemovePlanAssignmentUsecase_ErrorWhenAssignmentOfAnotherPlan(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDay(t, tenantID, common.NewEventID())
+	slot := createTestPlanSlot(t, bd)
+	draft := createTestShiftPlan(t, bd, false)
+	published := createTestShiftPlan(t, bd, true)
+	a := createTestPlanAssignment(t, published, slot, common.NewMemberID())
+
+	usecase := appshift.NewRemovePlanAssignmentUsecase(
+		&MockShiftPlanRepository{plans: map[shift.PlanID]*shift.ShiftPlan{draft.PlanID(): draft, published.PlanID(): published}},
+		&MockShiftAssignmentRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) (*shift.ShiftAssignment, error) {
+				return a, nil
+			},
+			deleteFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) error {
+				t.Error("assignment of another plan should not be deleted")
+				return nil
+			},
+		},
+	)
+
+	err := usecase.Execute(context.Background(), appshift.RemovePlanAssignmentInput{
+		TenantID:     tenantID,
+		PlanID:       draft.PlanID(),
+		AssignmentID: a.AssignmentID(),
+	})
+	if !common.IsNotFoundError(err) {
+		t.Errorf("Execute() should return not found error, got %v", err)
+	}
+}
+
+// =====================================================
+// DiffShiftPlanUsecase Tests
+// =====================================================
+
+func TestDiffShiftPlanUsecase_AgainstPublishedPlan(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDay(t, tenantID, common.NewEventID())
+	slot := createTestPlanSlot(t, bd)
+	published := createTestShiftPlan(t, bd, true)
+	draft := createTestShiftPlan(t, bd, false)
+
+	kept := common.NewMemberID()
+	removed := common.NewMemberID()
+	added := common.NewMemberID()
+	assignments := map[shift.PlanID][]*shift.ShiftAssignment{
+		published.PlanID(): {
+			createTestPlanAssignment(t, published, slot, kept),
+			createTestPlanAssignment(t, published, slot, removed),
+		},
+		draft.PlanID(): {
+			createTestPlanAssignment(t, draft, slot, kept),
+			createTestPlanAssignment(t, draft, slot, added),
+		},
+	}
+
+	usecase := appshift.NewDiffShiftPlanUsecase(
+		&MockShiftPlanRepository{plans: map[shift.PlanID]*shift.ShiftPlan{draft.PlanID(): draft, published.PlanID(): published}},
+		&MockShiftAssignmentRepository{
+			findByPlanIDFunc: func(ctx context.Context, tid common.TenantID, planID shift.PlanID) ([]*shift.ShiftAssignment, error) {
+				return assignments[planID], nil
+			},
+		},
+	)
+
+	diff, err := usecase.Execute(context.Background(), appshift.DiffShiftPlanInput{
+		TenantID: tenantID,
+		PlanID:   draft.PlanID(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if diff.PublishedPlan == nil || diff.PublishedPlan.PlanID() != published.PlanID() {
+		t.Fatalf("PublishedPlan should be %s", published.PlanID())
+	}
+	if len(diff.Added) != 1 || diff.Added[0].MemberID() != added {
+		t.Errorf("Added: expected [%s], got %v", added, diff.Added)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].MemberID() != removed {
+		t.Errorf("Removed: expected [%s], got %v", removed, diff.Removed)
+	}
+	if len(diff.Unchanged) != 1 || diff.Unchanged[0].MemberID() != kept {
+		t.Errorf("Unchanged: expected [%s], got %v", kept, diff.Unchanged)
+	}
+}
+
+// =====================================================
+// PublishShiftPlanUsecase Tests
+// =====================================================
+
+func TestPublishShiftPlanUsecase_ArchivesPreviousPlan(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDay(t, tenantID, common.NewEventID())
+	slot := createTestPlanSlot(t, bd)
+	previous := createTestShiftPlan(t, bd, true)
+	draft := createTestShiftPlan(t, bd, false)
+	previousAssignment := createTestPlanAssignment(t, previous, slot, common.NewMemberID())
+	draftAssignments := []*shift.ShiftAssignment{
+		createTestPlanAssignment(t, draft, slot, common.NewMemberID()),
+		createTestPlanAssignment(t, draft, slot, common.NewMemberID()),
+	}
+
+	// 公開の直列化・枠のロック・可視性の切り替えがすべてトランザクション内で行われることを確認する
+	type txKey struct{}
+	inTx := func(ctx context.Context) bool { return ctx.Value(txKey{}) != nil }
+	var locked, slotLocked bool
+	live := make(map[shift.AssignmentID]bool)
+	planRepo := &MockShiftPlanRepository{
+		plans: map[shift.PlanID]*shift.ShiftPlan{previous.PlanID(): previous, draft.PlanID(): draft},
+		lockPublicationFunc: func(ctx context.Context, tid common.TenantID, eventID common.EventID) error {
+			locked = inTx(ctx) && eventID == bd.EventID()
+			return nil
+		},
+	}
+	outboxRepo := &MockOutboxRepository{}
+
+	usecase := appshift.NewPublishShiftPlanUsecase(
+		planRepo,
+		&MockShiftSlotRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+				slotLocked = inTx(ctx)
+				return slot, nil
+			},
+		},
+		&MockShiftAssignmentRepository{
+			findByPlanIDFunc: func(ctx context.Context, tid common.TenantID, planID shift.PlanID) ([]*shift.ShiftAssignment, error) {
+				if planID == previous.PlanID() {
+					return []*shift.ShiftAssignment{previousAssignment}, nil
+				}
+				return draftAssignments, nil
+			},
+			setLiveFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID, isLive bool) error {
+				if !inTx(ctx) {
+					t.Error("assignment visibility should be switched inside the transaction")
+				}
+				live[id] = isLive
+				return nil
+			},
+		},
+		&MockMemberRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, memberID common.MemberID) (*member.Member, error) {
+				return createTestMember(t, tid), nil
+			},
+		},
+		&MockMemberRoleRepository{},
+		&MockAvailabilityRepository{},
+		&MockWorkloadPolicyRepository{},
+		&MockEventRepository{},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return bd, nil
+			},
+		},
+		outboxRepo,
+		&MockTxManager{
+			withTxFunc: func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(context.WithValue(ctx, txKey{}, true))
+			},
+		},
+		&MockClock{now: time.Now()},
+	)
+
+	output, err := usecase.Execute(context.Background(), appshift.PublishShiftPlanInput{
+		TenantID: tenantID,
+		PlanID:   draft.PlanID(),
+		ActorID:  common.NewMemberID(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if !locked || !slotLocked {
+		t.Errorf("publication and slots should be locked inside the transaction (publication=%t, slot=%t)", locked, slotLocked)
+	}
+	if !output.Plan.IsPublished() {
+		t.Errorf("draft should be published, got %s", output.Plan.PlanStatus())
+	}
+	if output.ArchivedPlan == nil || output.ArchivedPlan.PlanID() != previous.PlanID() {
+		t.Fatalf("ArchivedPlan should be %s", previous.PlanID())
+	}
+	if !planRepo.plans[previous.PlanID()].IsArchived() {
+		t.Errorf("previous plan should be archived")
+	}
+
+	// 旧プランの割り当ては非公開、新プランの割り当ては公開になる
+	if isLive, ok := live[previousAssignment.AssignmentID()]; !ok || isLive {
+		t.Errorf("assignment of the archived plan should be hidden")
+	}
+	for _, a := range draftAssignments {
+		if !live[a.AssignmentID()] {
+			t.Errorf("assignment %s of the published plan should be live", a.AssignmentID())
+		}
+	}
+
+	// 公開したプランの割り当てメンバーにのみ確定通知を積む
+	if len(outboxRepo.messages) != 2 {
+		t.Fatalf("expected 2 notifications for the published plan, got %d", len(outboxRepo.messages))
+	}
+	for _, msg := range outboxRepo.messages {
+		if msg.NotificationType() != notification.NotificationTypeShiftConfirmed || msg.Channel() != notification.ChannelDiscord {
+			t.Errorf("unexpected notification: type=%s channel=%s", msg.NotificationType(), msg.Channel())
+		}
+	}
+}
+
+func TestPublishShiftPlanUsecase_RejectsAssignmentsFailingLiveChecks(t *testing.T) {
+	tests := []struct {
+		name      string
+		liveCount int  // 公開済みの割り当て数（プラン外）
+		cancelled bool // 営業日が中止されている
+		wantErr   func(error) bool
+	}{
+		{
+			name:      "公開済みの割り当てで枠が埋まっている",
+			liveCount: 2,
+			wantErr:   func(err error) bool { return errors.Is(err, shift.ErrSlotFull) },
+		},
+		{
+			name:      "営業日が中止されている",
+			cancelled: true,
+			wantErr:   isConflictError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantID := common.NewTenantID()
+			bd := createTestBusinessDay(t, tenantID, common.NewEventID())
+			if tt.cancelled {
+				if err := bd.Cancel(time.Now(), "", nil); err != nil {
+					t.Fatalf("Failed to cancel business day: %v", err)
+				}
+			}
+			slot := createTestPlanSlot(t, bd)
+			draft := createTestShiftPlan(t, bd, false)
+			a := createTestPlanAssignment(t, draft, slot, common.NewMemberID())
+			outboxRepo := &MockOutboxRepository{}
+
+			usecase := appshift.NewPublishShiftPlanUsecase(
+				&MockShiftPlanRepository{plans: map[shift.PlanID]*shift.ShiftPlan{draft.PlanID(): draft}},
+				&MockShiftSlotRepository{
+					findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+						return slot, nil
+					},
+				},
+				&MockShiftAssignmentRepository{
+					findByPlanIDFunc: func(ctx context.Context, tid common.TenantID, planID shift.PlanID) ([]*shift.ShiftAssignment, error) {
+						return []*shift.ShiftAssignment{a}, nil
+					},
+					countConfirmedBySlotFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (int, error) {
+						return tt.liveCount, nil
+					},
+					setLiveFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID, isLive bool) error {
+						t.Error("assignment failing the checks should not be published")
+						return nil
+					},
+				},
+				&MockMemberRepository{},
+				&MockMemberRoleRepository{},
+				&MockAvailabilityRepository{},
+				&MockWorkloadPolicyRepository{},
+				&MockEventRepository{},
+				&MockBusinessDayRepository{
+					findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+						return bd, nil
+					},
+				},
+				outboxRepo,
+				&MockTxManager{},
+				&MockClock{now: time.Now()},
+			)
+
+			_, err := usecase.Execute(context.Background(), appshift.PublishShiftPlanInput{
+				TenantID: tenantID,
+				PlanID:   draft.PlanID(),
+				ActorID:  common.NewMemberID(),
+			})
+			if !tt.wantErr(err) {
+				t.Errorf("Execute() returned unexpected error: %v", err)
+			}
+			if len(outboxRepo.messages) != 0 {
+				t.Errorf("no notification should be enqueued, got %d", len(outboxRepo.messages))
+			}
+		})
+	}
+}
+
+func TestPublishShiftPlanUsecase_RejectsOverlappingPublishedPlan(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDay(t, tenantID, common.NewEventID())
+	period := func(t *testing.T, from, to int, published bool) *shift.ShiftPlan {
+		t.Helper()
+		start := bd.TargetDate().AddDate(0, 0, from)
+		end := bd.TargetDate().AddDate(0, 0, to)
+		plan, err := shift.NewShiftPlan(time.Now(), tenantID, bd.EventID(), nil, &start, &end, "期間シフト")
+		if err != nil {
+			t.Fatalf("Failed to create test shift plan: %v", err)
+		}
+		if published {
+			if err := plan.Publish(time.Now()); err != nil {
+				t.Fatalf("Failed to publish test shift plan: %v", err)
+			}
+		}
+		return plan
+	}
+
+	tests := []struct {
+		name      string
+		published func(t *testing.T) *shift.ShiftPlan
+		draft     func(t *testing.T) *shift.ShiftPlan
+	}{
+		{
+			name:      "営業日単位の公開中プランを含む期間",
+			published: func(t *testing.T) *shift.ShiftPlan { return createTestShiftPlan(t, bd, true) },
+			draft:     func(t *testing.T) *shift.ShiftPlan { return period(t, -3, 3, false) },
+		},
+		{
+			name:      "公開中の期間に含まれる営業日",
+			published: func(t *testing.T) *shift.ShiftPlan { return period(t, -3, 3, true) },
+			draft:     func(t *testing.T) *shift.ShiftPlan { return createTestShiftPlan(t, bd, false) },
+		},
+		{
+			name:      "一部が重なる期間",
+			published: func(t *testing.T) *shift.ShiftPlan { return period(t, -3, 3, true) },
+			draft:     func(t *testing.T) *shift.ShiftPlan { return period(t, 3, 10, false) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			published := tt.published(t)
+			draft := tt.draft(t)
+			planRepo := &MockShiftPlanRepository{
+				plans: map[shift.PlanID]*shift.ShiftPlan{published.PlanID(): published, draft.PlanID(): draft},
+			}
+
+			usecase := appshift.NewPublishShiftPlanUsecase(
+				planRepo,
+				&MockShiftSlotRepository{},
+				&MockShiftAssignmentRepository{
+					setLiveFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID, isLive bool) error {
+						t.Error("assignment visibility should not be switched")
+						return nil
+					},
+				},
+				&MockMemberRepository{},
+				&MockMemberRoleRepository{},
+				&MockAvailabilityRepository{},
+				&MockWorkloadPolicyRepository{},
+				&MockEventRepository{},
+				&MockBusinessDayRepository{
+					findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+						return bd, nil
+					},
+				},
+				&MockOutboxRepository{},
+				&MockTxManager{},
+				&MockClock{now: time.Now()},
+			)
+
+			_, err := usecase.Execute(context.Background(), appshift.PublishShiftPlanInput{
+				TenantID: tenantID,
+				PlanID:   draft.PlanID(),
+				ActorID:  common.NewMemberID(),
+			})
+			if !errors.Is(err, shift.ErrPlanScopeOverlaps) {
+				t.Fatalf("Execute() should return ErrPlanScopeOverlaps, got %v", err)
+			}
+			if !planRepo.plans[published.PlanID()].IsPublished() {
+				t.Errorf("overlapping published plan should stay published")
+			}
+		})
+	}
+}
+
+func TestPublishShiftPlanUsecase_ErrorWhenNotDraft(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDay(t, tenantID, common.NewEventID())
+	plan := createTestShiftPlan(t, bd, true)
+
+	usecase := appshift.NewPublishShiftPlanUsecase(
+		&MockShiftPlanRepository{plans: map[shift.PlanID]*shift.ShiftPlan{plan.PlanID(): plan}},
+		&MockShiftSlotRepository{},
+		&MockShiftAssignmentRepository{},
+		&MockMemberRepository{},
+		&MockMemberRoleRepository{},
+		&MockAvailabilityRepository{},
+		&MockWorkloadPolicyRepository{},
+		&MockEventRepository{},
+		&MockBusinessDayRepository{},
+		&MockOutboxRepository{},
+		&MockTxManager{},
+		&MockClock{now: time.Now()},
+	)
+
+	_, err := usecase.Execute(context.Background(), appshift.PublishShiftPlanInput{
+		TenantID: tenantID,
+		PlanID:   plan.PlanID(),
+		ActorID:  common.NewMemberID(),
+	})
+	if !errors.Is(err, shift.ErrPlanNotDraft) {
+		t.Errorf("Execute() should return ErrPlanNotDraft, got %v", err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/role"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
 )
 
@@ -22,6 +23,10 @@ type CreateShiftSlotInput struct {
 	EndTime       time.Time
 	RequiredCount int
 	Priority      int
+	// DayOffset は営業日の target_date から枠の開始日までの日数（nil の場合は営業日の開始時刻から判定）
+	DayOffset *int
+	// RoleRequirements は枠に必要/推奨されるロール（任意）
+	RoleRequirements []RoleRequirementInput
 }
 
 // CreateShiftSlotUsecase handles the shift slot creation use case
@@ -29,6 +34,7 @@ type CreateShiftSlotUsecase struct {
 	slotRepo        shift.ShiftSlotRepository
 	businessDayRepo event.EventBusinessDayRepository
 	instanceRepo    shift.InstanceRepository
+	roleRepo        role.RoleRepository
 }
 
 // NewCreateShiftSlotUsecase creates a new CreateShiftSlotUsecase
@@ -36,11 +42,13 @@ func NewCreateShiftSlotUsecase(
 	slotRepo shift.ShiftSlotRepository,
 	businessDayRepo event.EventBusinessDayRepository,
 	instanceRepo shift.InstanceRepository,
+	roleRepo role.RoleRepository,
 ) *CreateShiftSlotUsecase {
 	return &CreateShiftSlotUsecase{
 		slotRepo:        slotRepo,
 		businessDayRepo: businessDayRepo,
 		instanceRepo:    instanceRepo,
+		roleRepo:        roleRepo,
 	}
 }
 
@@ -68,6 +76,11 @@ func (uc *CreateShiftSlotUsecase) Execute(ctx context.Context, input CreateShift
 		}
 	}
 
+	// ロール要件のロールが同じテナントに属しているか検証
+	if err := ensureRequirementRolesExist(ctx, uc.roleRepo, input.TenantID, input.RoleRequirements); err != nil {
+		return nil, err
+	}
+
 	// Priority のデフォルト値設定（未指定の場合は1）
 	priority := input.Priority
 	if priority == 0 {
@@ -91,6 +104,26 @@ func (uc *CreateShiftSlotUsecase) Execute(ctx context.Context, input CreateShift
 		return nil, err
 	}
 
+	// 深夜営業・複数日営業の場合は枠の開始日を営業日に合わせる
+	if input.DayOffset != nil {
+		if *input.DayOffset > businessDay.SpanDays() {
+			return nil, common.NewValidationError("day_offset must be within the business day", nil)
+		}
+		if err := newSlot.SetDayOffset(newSlot.CreatedAt(), *input.DayOffset); err != nil {
+			return nil, err
+		}
+	} else {
+		newSlot.AlignToBusinessDay(newSlot.CreatedAt(), businessDay)
+	}
+
+	requirements, err := toRoleRequirements(input.RoleRequirements)
+	if err != nil {
+		return nil, err
+	}
+	if err := newSlot.SetRoleRequirements(newSlot.CreatedAt(), requirements); err != nil {
+		return nil, err
+	}
+
 	// 保存
 	if err := uc.slotRepo.Save(ctx, newSlot); err != nil {
 		return nil, err
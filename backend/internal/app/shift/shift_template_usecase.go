@@ -6,6 +6,7 @@ import (
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/role"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
 )
 
@@ -17,6 +18,8 @@ type TemplateItemInput struct {
 	EndTime       time.Time
 	RequiredCount int
 	Priority      int
+	// RoleRequirements は生成されるシフト枠にコピーされる（任意）
+	RoleRequirements []RoleRequirementInput
 }
 
 // CreateShiftTemplateInput represents the input for creating a shift template
@@ -31,12 +34,14 @@ type CreateShiftTemplateInput struct {
 // CreateShiftTemplateUsecase handles shift template creation
 type CreateShiftTemplateUsecase struct {
 	templateRepo shift.ShiftSlotTemplateRepository
+	roleRepo     role.RoleRepository
 }
 
 // NewCreateShiftTemplateUsecase creates a new CreateShiftTemplateUsecase
-func NewCreateShiftTemplateUsecase(templateRepo shift.ShiftSlotTemplateRepository) *CreateShiftTemplateUsecase {
+func NewCreateShiftTemplateUsecase(templateRepo shift.ShiftSlotTemplateRepository, roleRepo role.RoleRepository) *CreateShiftTemplateUsecase {
 	return &CreateShiftTemplateUsecase{
 		templateRepo: templateRepo,
+		roleRepo:     roleRepo,
 	}
 }
 
@@ -74,6 +79,17 @@ func (uc *CreateShiftTemplateUsecase) Execute(ctx context.Context, input CreateS
 			return nil, err
 		}
 
+		if err := ensureRequirementRolesExist(ctx, uc.roleRepo, input.TenantID, itemInput.RoleRequirements); err != nil {
+			return nil, err
+		}
+		requirements, err := toRoleRequirements(itemInput.RoleRequirements)
+		if err != nil {
+			return nil, err
+		}
+		if err := item.SetRoleRequirements(now, requirements); err != nil {
+			return nil, err
+		}
+
 		items = append(items, item)
 	}
 
@@ -158,12 +174,14 @@ type UpdateShiftTemplateInput struct {
 // UpdateShiftTemplateUsecase handles shift template update
 type UpdateShiftTemplateUsecase struct {
 	templateRepo shift.ShiftSlotTemplateRepository
+	roleRepo     role.RoleRepository
 }
 
 // NewUpdateShiftTemplateUsecase creates a new UpdateShiftTemplateUsecase
-func NewUpdateShiftTemplateUsecase(templateRepo shift.ShiftSlotTemplateRepository) *UpdateShiftTemplateUsecase {
+func NewUpdateShiftTemplateUsecase(templateRepo shift.ShiftSlotTemplateRepository, roleRepo role.RoleRepository) *UpdateShiftTemplateUsecase {
 	return &UpdateShiftTemplateUsecase{
 		templateRepo: templateRepo,
+		roleRepo:     roleRepo,
 	}
 }
 
@@ -194,6 +212,17 @@ func (uc *UpdateShiftTemplateUsecase) Execute(ctx context.Context, input UpdateS
 			return nil, err
 		}
 
+		if err := ensureRequirementRolesExist(ctx, uc.roleRepo, input.TenantID, itemInput.RoleRequirements); err != nil {
+			return nil, err
+		}
+		requirements, err := toRoleRequirements(itemInput.RoleRequirements)
+		if err != nil {
+			return nil, err
+		}
+		if err := item.SetRoleRequirements(now, requirements); err != nil {
+			return nil, err
+		}
+
 		items = append(items, item)
 	}
 
@@ -303,6 +332,9 @@ func (uc *SaveBusinessDayAsTemplateUsecase) Execute(ctx context.Context, input S
 		if err != nil {
 			return nil, err
 		}
+		if err := item.SetRoleRequirements(now, slot.RoleRequirements()); err != nil {
+			return nil, err
+		}
 
 		items = append(items, item)
 	}
@@ -10,6 +10,8 @@ import (
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/role"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
 )
 
@@ -39,6 +41,10 @@ func (m *MockShiftSlotRepository) FindByID(ctx context.Context, tenantID common.
 	return nil, errors.New("not implemented")
 }
 
+func (m *MockShiftSlotRepository) FindByIDForUpdate(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+	return m.FindByID(ctx, tenantID, slotID)
+}
+
 func (m *MockShiftSlotRepository) FindByBusinessDayID(ctx context.Context, tenantID common.TenantID, businessDayID event.BusinessDayID) ([]*shift.ShiftSlot, error) {
 	if m.findByBusinessDayFunc != nil {
 		return m.findByBusinessDayFunc(ctx, tenantID, businessDayID)
@@ -65,12 +71,20 @@ func (m *MockShiftSlotRepository) Delete(ctx context.Context, tenantID common.Te
 }
 
 type MockShiftAssignmentRepository struct {
-	saveFunc                 func(ctx context.Context, assignment *shift.ShiftAssignment) error
-	findByIDFunc             func(ctx context.Context, tenantID common.TenantID, assignmentID shift.AssignmentID) (*shift.ShiftAssignment, error)
-	findBySlotIDFunc         func(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) ([]*shift.ShiftAssignment, error)
-	findByMemberIDFunc       func(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*shift.ShiftAssignment, error)
-	countConfirmedBySlotFunc func(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) (int, error)
-	deleteFunc               func(ctx context.Context, tenantID common.TenantID, assignmentID shift.AssignmentID) error
+	saveFunc                    func(ctx context.Context, assignment *shift.ShiftAssignment) error
+	findByIDFunc                func(ctx context.Context, tenantID common.TenantID, assignmentID shift.AssignmentID) (*shift.ShiftAssignment, error)
+	findBySlotIDFunc            func(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) ([]*shift.ShiftAssignment, error)
+	findConfirmedBySlotIDFunc   func(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) ([]*shift.ShiftAssignment, error)
+	findByMemberIDFunc          func(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*shift.ShiftAssignment, error)
+	countConfirmedBySlotFunc    func(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) (int, error)
+	deleteFunc                  func(ctx context.Context, tenantID common.TenantID, assignmentID shift.AssignmentID) error
+	findConfirmedByMemberIDFunc func(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*shift.ShiftAssignment, error)
+	findByBusinessDayIDFunc     func(ctx context.Context, tenantID common.TenantID, businessDayID event.BusinessDayID) ([]*shift.ShiftAssignment, error)
+	findByPlanIDFunc            func(ctx context.Context, tenantID common.TenantID, planID shift.PlanID) ([]*shift.ShiftAssignment, error)
+	findConfirmedShiftsFunc     func(ctx context.Context, tenantID common.TenantID, memberID *common.MemberID, from, to time.Time) ([]shift.AssignedShift, error)
+	countConfirmedByMembersFunc func(ctx context.Context, tenantID common.TenantID, memberIDs []common.MemberID, from, to time.Time) (map[common.MemberID]int, error)
+	existsBySlotAndMemberFunc   func(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID, memberID common.MemberID) (bool, error)
+	setLiveFunc                 func(ctx context.Context, tenantID common.TenantID, assignmentID shift.AssignmentID, live bool) error
 }
 
 func (m *MockShiftAssignmentRepository) Save(ctx context.Context, assignment *shift.ShiftAssignment) error {
@@ -87,6 +101,13 @@ func (m *MockShiftAssignmentRepository) FindByID(ctx context.Context, tenantID c
 	return nil, errors.New("not implemented")
 }
 
+func (m *MockShiftAssignmentRepository) SetLive(ctx context.Context, tenantID common.TenantID, assignmentID shift.AssignmentID, live bool) error {
+	if m.setLiveFunc != nil {
+		return m.setLiveFunc(ctx, tenantID, assignmentID, live)
+	}
+	return nil
+}
+
 func (m *MockShiftAssignmentRepository) FindBySlotID(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) ([]*shift.ShiftAssignment, error) {
 	if m.findBySlotIDFunc != nil {
 		return m.findBySlotIDFunc(ctx, tenantID, slotID)
@@ -95,6 +116,9 @@ func (m *MockShiftAssignmentRepository) FindBySlotID(ctx context.Context, tenant
 }
 
 func (m *MockShiftAssignmentRepository) FindConfirmedBySlotID(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) ([]*shift.ShiftAssignment, error) {
+	if m.findConfirmedBySlotIDFunc != nil {
+		return m.findConfirmedBySlotIDFunc(ctx, tenantID, slotID)
+	}
 	return nil, nil
 }
 
@@ -106,10 +130,16 @@ func (m *MockShiftAssignmentRepository) FindByMemberID(ctx context.Context, tena
 }
 
 func (m *MockShiftAssignmentRepository) FindConfirmedByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*shift.ShiftAssignment, error) {
+	if m.findConfirmedByMemberIDFunc != nil {
+		return m.findConfirmedByMemberIDFunc(ctx, tenantID, memberID)
+	}
 	return nil, nil
 }
 
 func (m *MockShiftAssignmentRepository) FindByPlanID(ctx context.Context, tenantID common.TenantID, planID shift.PlanID) ([]*shift.ShiftAssignment, error) {
+	if m.findByPlanIDFunc != nil {
+		return m.findByPlanIDFunc(ctx, tenantID, planID)
+	}
 	return nil, nil
 }
 
@@ -128,6 +158,9 @@ func (m *MockShiftAssignmentRepository) Delete(ctx context.Context, tenantID com
 }
 
 func (m *MockShiftAssignmentRepository) ExistsBySlotIDAndMemberID(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID, memberID common.MemberID) (bool, error) {
+	if m.existsBySlotAndMemberFunc != nil {
+		return m.existsBySlotAndMemberFunc(ctx, tenantID, slotID, memberID)
+	}
 	return false, nil
 }
 
@@ -136,11 +169,29 @@ func (m *MockShiftAssignmentRepository) HasConfirmedByMemberAndBusinessDayID(ctx
 }
 
 func (m *MockShiftAssignmentRepository) FindByBusinessDayID(ctx context.Context, tenantID common.TenantID, businessDayID event.BusinessDayID) ([]*shift.ShiftAssignment, error) {
+	if m.findByBusinessDayIDFunc != nil {
+		return m.findByBusinessDayIDFunc(ctx, tenantID, businessDayID)
+	}
+	return nil, nil
+}
+
+func (m *MockShiftAssignmentRepository) FindConfirmedShiftsByDateRange(ctx context.Context, tenantID common.TenantID, memberID *common.MemberID, from, to time.Time) ([]shift.AssignedShift, error) {
+	if m.findConfirmedShiftsFunc != nil {
+		return m.findConfirmedShiftsFunc(ctx, tenantID, memberID, from, to)
+	}
+	return nil, nil
+}
+
+func (m *MockShiftAssignmentRepository) CountConfirmedByMemberIDsAndDateRange(ctx context.Context, tenantID common.TenantID, memberIDs []common.MemberID, from, to time.Time) (map[common.MemberID]int, error) {
+	if m.countConfirmedByMembersFunc != nil {
+		return m.countConfirmedByMembersFunc(ctx, tenantID, memberIDs, from, to)
+	}
 	return nil, nil
 }
 
 type MockBusinessDayRepository struct {
-	findByIDFunc func(ctx context.Context, tenantID common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error)
+	findByIDFunc              func(ctx context.Context, tenantID common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error)
+	findByTenantIDAndDateFunc func(ctx context.Context, tenantID common.TenantID, date time.Time) ([]*event.EventBusinessDay, error)
 }
 
 func (m *MockBusinessDayRepository) Save(ctx context.Context, bd *event.EventBusinessDay) error {
@@ -162,6 +213,10 @@ func (m *MockBusinessDayRepository) ExistsByEventIDAndDate(ctx context.Context,
 	return false, nil
 }
 
+func (m *MockBusinessDayRepository) ExistsByEventIDAndOccurrenceDate(ctx context.Context, tenantID common.TenantID, eventID common.EventID, occurrenceDate time.Time) (bool, error) {
+	return false, nil
+}
+
 func (m *MockBusinessDayRepository) FindByEventIDAndDateRange(ctx context.Context, tenantID common.TenantID, eventID common.EventID, startDate, endDate time.Time) ([]*event.EventBusinessDay, error) {
 	return nil, nil
 }
@@ -171,6 +226,9 @@ func (m *MockBusinessDayRepository) FindActiveByEventID(ctx context.Context, ten
 }
 
 func (m *MockBusinessDayRepository) FindByTenantIDAndDate(ctx context.Context, tenantID common.TenantID, date time.Time) ([]*event.EventBusinessDay, error) {
+	if m.findByTenantIDAndDateFunc != nil {
+		return m.findByTenantIDAndDateFunc(ctx, tenantID, date)
+	}
 	return nil, nil
 }
 
@@ -178,16 +236,49 @@ func (m *MockBusinessDayRepository) Delete(ctx context.Context, tenantID common.
 	return nil
 }
 
-func (m *MockBusinessDayRepository) FindRecentByTenantID(ctx context.Context, tenantID common.TenantID, limit int) ([]*event.EventBusinessDay, error) {
+func (m *MockBusinessDayRepository) FindRecentByTenantID(ctx context.Context, tenantID common.TenantID, today time.Time, limit int) ([]*event.EventBusinessDay, error) {
+	return nil, nil
+}
+
+func (m *MockBusinessDayRepository) FindRecentByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID, today time.Time, limit int, includeFuture bool) ([]*event.EventBusinessDay, error) {
+	return nil, nil
+}
+
+// MockEventRepository returns an active (not archived) event unless findByIDFunc is set
+type MockEventRepository struct {
+	findByIDFunc func(ctx context.Context, tenantID common.TenantID, eventID common.EventID) (*event.Event, error)
+}
+
+func (m *MockEventRepository) Save(ctx context.Context, e *event.Event) error {
+	return nil
+}
+
+func (m *MockEventRepository) FindByID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) (*event.Event, error) {
+	if m.findByIDFunc != nil {
+		return m.findByIDFunc(ctx, tenantID, eventID)
+	}
+	return event.NewEvent(time.Now(), tenantID, "Test Event", event.EventTypeNormal, "", event.RecurrenceTypeNone, nil, nil, nil, nil)
+}
+
+func (m *MockEventRepository) FindByTenantID(ctx context.Context, tenantID common.TenantID) ([]*event.Event, error) {
 	return nil, nil
 }
 
-func (m *MockBusinessDayRepository) FindRecentByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID, limit int, includeFuture bool) ([]*event.EventBusinessDay, error) {
+func (m *MockEventRepository) FindActiveByTenantID(ctx context.Context, tenantID common.TenantID) ([]*event.Event, error) {
 	return nil, nil
 }
 
+func (m *MockEventRepository) Delete(ctx context.Context, tenantID common.TenantID, eventID common.EventID) error {
+	return nil
+}
+
+func (m *MockEventRepository) ExistsByName(ctx context.Context, tenantID common.TenantID, eventName string) (bool, error) {
+	return false, nil
+}
+
 type MockMemberRepository struct {
-	findByIDFunc func(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) (*member.Member, error)
+	findByIDFunc             func(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) (*member.Member, error)
+	findActiveByTenantIDFunc func(ctx context.Context, tenantID common.TenantID) ([]*member.Member, error)
 }
 
 func (m *MockMemberRepository) Save(ctx context.Context, mem *member.Member) error {
@@ -206,6 +297,9 @@ func (m *MockMemberRepository) FindByTenantID(ctx context.Context, tenantID comm
 }
 
 func (m *MockMemberRepository) FindActiveByTenantID(ctx context.Context, tenantID common.TenantID) ([]*member.Member, error) {
+	if m.findActiveByTenantIDFunc != nil {
+		return m.findActiveByTenantIDFunc(ctx, tenantID)
+	}
 	return nil, nil
 }
 
@@ -256,6 +350,41 @@ func (m *MockInstanceRepository) Delete(ctx context.Context, tenantID common.Ten
 	return nil
 }
 
+// MockRoleRepository returns the roles of roleIDs as belonging to the tenant
+type MockRoleRepository struct {
+	roleIDs []common.RoleID
+}
+
+func (m *MockRoleRepository) Save(ctx context.Context, r *role.Role) error {
+	return nil
+}
+
+func (m *MockRoleRepository) FindByID(ctx context.Context, tenantID common.TenantID, roleID common.RoleID) (*role.Role, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRoleRepository) FindByIDs(ctx context.Context, tenantID common.TenantID, roleIDs []common.RoleID) ([]*role.Role, error) {
+	now := time.Now()
+	var roles []*role.Role
+	for _, rid := range roleIDs {
+		for _, known := range m.roleIDs {
+			if rid == known {
+				r, _ := role.ReconstructRole(rid, tenantID, "Mock Role", "", "", 0, now, now, nil)
+				roles = append(roles, r)
+			}
+		}
+	}
+	return roles, nil
+}
+
+func (m *MockRoleRepository) FindByTenantID(ctx context.Context, tenantID common.TenantID) ([]*role.Role, error) {
+	return nil, nil
+}
+
+func (m *MockRoleRepository) Delete(ctx context.Context, tenantID common.TenantID, roleID common.RoleID) error {
+	return nil
+}
+
 func (m *MockMemberRepository) FindByDiscordUserID(ctx context.Context, tenantID common.TenantID, discordUserID string) (*member.Member, error) {
 	return nil, nil
 }
@@ -268,6 +397,20 @@ func (m *MockMemberRepository) ExistsByEmail(ctx context.Context, tenantID commo
 	return false, nil
 }
 
+// MockOutboxRepository records the notifications written to the outbox
+type MockOutboxRepository struct {
+	messages []*notification.OutboxMessage
+}
+
+func (m *MockOutboxRepository) Save(ctx context.Context, msg *notification.OutboxMessage) error {
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+func (m *MockOutboxRepository) FindDue(ctx context.Context, now time.Time, channels []notification.Channel, limit int) ([]*notification.OutboxMessage, error) {
+	return nil, nil
+}
+
 // MockTxManager is a mock implementation of TxManager for testing
 type MockTxManager struct {
 	withTxFunc func(ctx context.Context, fn func(context.Context) error) error
@@ -281,6 +424,91 @@ func (m *MockTxManager) WithTx(ctx context.Context, fn func(context.Context) err
 	return fn(ctx)
 }
 
+// MockAvailabilityRepository is an in-memory availability calendar (empty means no restriction)
+type MockAvailabilityRepository struct {
+	windows   map[common.MemberID][]*member.AvailabilityWindow
+	blackouts map[common.MemberID][]*member.AvailabilityBlackout
+}
+
+func (m *MockAvailabilityRepository) SaveWindow(ctx context.Context, window *member.AvailabilityWindow) error {
+	return nil
+}
+
+func (m *MockAvailabilityRepository) FindWindowByID(ctx context.Context, tenantID common.TenantID, windowID member.AvailabilityWindowID) (*member.AvailabilityWindow, error) {
+	return nil, common.NewNotFoundError("AvailabilityWindow", windowID.String())
+}
+
+func (m *MockAvailabilityRepository) FindWindowsByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*member.AvailabilityWindow, error) {
+	return m.windows[memberID], nil
+}
+
+func (m *MockAvailabilityRepository) DeleteWindow(ctx context.Context, tenantID common.TenantID, windowID member.AvailabilityWindowID) error {
+	return nil
+}
+
+func (m *MockAvailabilityRepository) SaveBlackout(ctx context.Context, blackout *member.AvailabilityBlackout) error {
+	return nil
+}
+
+func (m *MockAvailabilityRepository) FindBlackoutByID(ctx context.Context, tenantID common.TenantID, blackoutID member.BlackoutID) (*member.AvailabilityBlackout, error) {
+	return nil, common.NewNotFoundError("AvailabilityBlackout", blackoutID.String())
+}
+
+func (m *MockAvailabilityRepository) FindBlackoutsByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*member.AvailabilityBlackout, error) {
+	return m.blackouts[memberID], nil
+}
+
+func (m *MockAvailabilityRepository) FindBlackoutsByMemberIDAndDateRange(ctx context.Context, tenantID common.TenantID, memberID common.MemberID, from, to time.Time) ([]*member.AvailabilityBlackout, error) {
+	var result []*member.AvailabilityBlackout
+	for _, b := range m.blackouts[memberID] {
+		if !b.Date().Before(from) && !b.Date().After(to) {
+			result = append(result, b)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockAvailabilityRepository) DeleteBlackout(ctx context.Context, tenantID common.TenantID, blackoutID member.BlackoutID) error {
+	return nil
+}
+
+// MockWorkloadPolicyRepository holds the tenant default and member override policies (nil means not set)
+type MockWorkloadPolicyRepository struct {
+	tenantPolicy   *member.WorkloadPolicy
+	memberPolicies map[common.MemberID]*member.WorkloadPolicy
+}
+
+func (m *MockWorkloadPolicyRepository) Save(ctx context.Context, policy *member.WorkloadPolicy) error {
+	return nil
+}
+
+func (m *MockWorkloadPolicyRepository) FindTenantDefault(ctx context.Context, tenantID common.TenantID) (*member.WorkloadPolicy, error) {
+	if m.tenantPolicy == nil {
+		return nil, common.NewNotFoundError("WorkloadPolicy", tenantID.String())
+	}
+	return m.tenantPolicy, nil
+}
+
+func (m *MockWorkloadPolicyRepository) FindByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) (*member.WorkloadPolicy, error) {
+	policy, ok := m.memberPolicies[memberID]
+	if !ok {
+		return nil, common.NewNotFoundError("WorkloadPolicy", memberID.String())
+	}
+	return policy, nil
+}
+
+func (m *MockWorkloadPolicyRepository) FindMemberPoliciesByTenantID(ctx context.Context, tenantID common.TenantID) ([]*member.WorkloadPolicy, error) {
+	var result []*member.WorkloadPolicy
+	for _, policy := range m.memberPolicies {
+		result = append(result, policy)
+	}
+	return result, nil
+}
+
+func (m *MockWorkloadPolicyRepository) Delete(ctx context.Context, tenantID common.TenantID, policyID member.WorkloadPolicyID) error {
+	return nil
+}
+
 // =====================================================
 // Helper functions
 // =====================================================
@@ -308,6 +536,32 @@ func createTestShiftSlot(t *testing.T, tenantID common.TenantID) *shift.ShiftSlo
 	return slot
 }
 
+// createTestSlotOnDate creates a business day on targetDate and a 21:00-23:00 slot with one seat
+func createTestSlotOnDate(t *testing.T, tenantID common.TenantID, targetDate time.Time) (*event.EventBusinessDay, *shift.ShiftSlot) {
+	t.Helper()
+	start := time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC)
+	end := time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC)
+
+	bd, err := event.NewEventBusinessDay(time.Now(), tenantID, common.NewEventID(), targetDate, start, end, event.OccurrenceTypeSpecial, nil)
+	if err != nil {
+		t.Fatalf("Failed to create business day: %v", err)
+	}
+	slot, err := shift.NewShiftSlot(time.Now(), tenantID, bd.BusinessDayID(), nil, "受付", "", start, end, 1, 1)
+	if err != nil {
+		t.Fatalf("Failed to create shift slot: %v", err)
+	}
+	return bd, slot
+}
+
+func createTestAssignment(t *testing.T, slot *shift.ShiftSlot, memberID common.MemberID) *shift.ShiftAssignment {
+	t.Helper()
+	a, err := shift.NewShiftAssignment(time.Now(), slot.TenantID(), "", slot.SlotID(), memberID, shift.AssignmentMethodManual, false)
+	if err != nil {
+		t.Fatalf("Failed to create shift assignment: %v", err)
+	}
+	return a
+}
+
 func createTestMember(t *testing.T, tenantID common.TenantID) *member.Member {
 	t.Helper()
 	now := time.Now()
@@ -371,7 +625,7 @@ func TestCreateShiftSlotUsecase_Execute_Success(t *testing.T) {
 
 	instanceRepo := &MockInstanceRepository{}
 
-	usecase := appshift.NewCreateShiftSlotUsecase(slotRepo, bdRepo, instanceRepo)
+	usecase := appshift.NewCreateShiftSlotUsecase(slotRepo, bdRepo, instanceRepo, &MockRoleRepository{})
 
 	input := appshift.CreateShiftSlotInput{
 		TenantID:      tenantID,
@@ -416,7 +670,7 @@ func TestCreateShiftSlotUsecase_Execute_ErrorWhenBusinessDayNotFound(t *testing.
 	slotRepo := &MockShiftSlotRepository{}
 	instanceRepo := &MockInstanceRepository{}
 
-	usecase := appshift.NewCreateShiftSlotUsecase(slotRepo, bdRepo, instanceRepo)
+	usecase := appshift.NewCreateShiftSlotUsecase(slotRepo, bdRepo, instanceRepo, &MockRoleRepository{})
 
 	input := appshift.CreateShiftSlotInput{
 		TenantID:      tenantID,
@@ -456,7 +710,7 @@ func TestCreateShiftSlotUsecase_Execute_ErrorWhenSaveFails(t *testing.T) {
 
 	instanceRepo := &MockInstanceRepository{}
 
-	usecase := appshift.NewCreateShiftSlotUsecase(slotRepo, bdRepo, instanceRepo)
+	usecase := appshift.NewCreateShiftSlotUsecase(slotRepo, bdRepo, instanceRepo, &MockRoleRepository{})
 
 	input := appshift.CreateShiftSlotInput{
 		TenantID:      tenantID,
@@ -476,6 +730,47 @@ func TestCreateShiftSlotUsecase_Execute_ErrorWhenSaveFails(t *testing.T) {
 	}
 }
 
+func TestCreateShiftSlotUsecase_Execute_PlacesSlotAfterMidnightOnNextDay(t *testing.T) {
+	tenantID := common.NewTenantID()
+	at := func(h int) time.Time { return time.Date(2000, 1, 1, h, 0, 0, 0, time.UTC) }
+	// 22:00〜翌3:00 の営業日
+	businessDay, err := event.NewEventBusinessDay(time.Now(), tenantID, common.NewEventID(),
+		time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), at(22), at(3), event.OccurrenceTypeSpecial, nil)
+	if err != nil {
+		t.Fatalf("Failed to create business day: %v", err)
+	}
+
+	bdRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return businessDay, nil
+		},
+	}
+	usecase := appshift.NewCreateShiftSlotUsecase(&MockShiftSlotRepository{}, bdRepo, &MockInstanceRepository{}, &MockRoleRepository{})
+
+	input := appshift.CreateShiftSlotInput{
+		TenantID:      tenantID,
+		BusinessDayID: businessDay.BusinessDayID(),
+		SlotName:      "撤収",
+		StartTime:     at(1),
+		EndTime:       at(3),
+		RequiredCount: 1,
+	}
+	result, err := usecase.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+	if start, _ := result.PeriodOn(businessDay.TargetDate()); !start.Equal(time.Date(2025, 2, 1, 1, 0, 0, 0, time.UTC)) {
+		t.Errorf("slot start = %v, want 2025-02-01 01:00", start)
+	}
+
+	// 営業日の範囲を超える day_offset は指定できない
+	tooLate := 2
+	input.DayOffset = &tooLate
+	if _, err := usecase.Execute(context.Background(), input); err == nil {
+		t.Error("Execute() should fail when day_offset exceeds the business day")
+	}
+}
+
 func TestCreateShiftSlotUsecase_Execute_SuccessWithInstanceID(t *testing.T) {
 	tenantID := common.NewTenantID()
 	eventID := common.NewEventID()
@@ -508,7 +803,7 @@ func TestCreateShiftSlotUsecase_Execute_SuccessWithInstanceID(t *testing.T) {
 		},
 	}
 
-	usecase := appshift.NewCreateShiftSlotUsecase(slotRepo, bdRepo, instanceRepo)
+	usecase := appshift.NewCreateShiftSlotUsecase(slotRepo, bdRepo, instanceRepo, &MockRoleRepository{})
 
 	input := appshift.CreateShiftSlotInput{
 		TenantID:      tenantID,
@@ -559,7 +854,7 @@ func TestCreateShiftSlotUsecase_Execute_ErrorWhenInstanceNotFound(t *testing.T)
 		},
 	}
 
-	usecase := appshift.NewCreateShiftSlotUsecase(slotRepo, bdRepo, instanceRepo)
+	usecase := appshift.NewCreateShiftSlotUsecase(slotRepo, bdRepo, instanceRepo, &MockRoleRepository{})
 
 	input := appshift.CreateShiftSlotInput{
 		TenantID:      tenantID,
@@ -580,6 +875,45 @@ func TestCreateShiftSlotUsecase_Execute_ErrorWhenInstanceNotFound(t *testing.T)
 	}
 }
 
+func TestCreateShiftSlotUsecase_Execute_ErrorWhenRequirementRoleNotInTenant(t *testing.T) {
+	tenantID := common.NewTenantID()
+	businessDay := createTestBusinessDay(t, tenantID, common.NewEventID())
+	knownRoleID := common.NewRoleID()
+
+	bdRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return businessDay, nil
+		},
+	}
+	slotRepo := &MockShiftSlotRepository{
+		saveFunc: func(ctx context.Context, slot *shift.ShiftSlot) error {
+			t.Error("Save should not be called")
+			return nil
+		},
+	}
+
+	usecase := appshift.NewCreateShiftSlotUsecase(slotRepo, bdRepo, &MockInstanceRepository{}, &MockRoleRepository{roleIDs: []common.RoleID{knownRoleID}})
+
+	// 他テナントのロールは見つからない
+	_, err := usecase.Execute(context.Background(), appshift.CreateShiftSlotInput{
+		TenantID:      tenantID,
+		BusinessDayID: businessDay.BusinessDayID(),
+		SlotName:      "受付",
+		StartTime:     time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC),
+		EndTime:       time.Date(2024, 1, 1, 22, 0, 0, 0, time.UTC),
+		RequiredCount: 2,
+		RoleRequirements: []appshift.RoleRequirementInput{
+			{RoleID: knownRoleID, Kind: shift.RoleRequirementRequired},
+			{RoleID: common.NewRoleID(), Kind: shift.RoleRequirementPreferred},
+		},
+	})
+
+	var domainErr *common.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code() != common.ErrInvalidInput {
+		t.Fatalf("Execute() should return a validation error, got %v", err)
+	}
+}
+
 func TestCreateShiftSlotUsecase_Execute_ErrorWhenInstanceBelongsToDifferentEvent(t *testing.T) {
 	tenantID := common.NewTenantID()
 	eventID := common.NewEventID()
@@ -609,7 +943,7 @@ func TestCreateShiftSlotUsecase_Execute_ErrorWhenInstanceBelongsToDifferentEvent
 		},
 	}
 
-	usecase := appshift.NewCreateShiftSlotUsecase(slotRepo, bdRepo, instanceRepo)
+	usecase := appshift.NewCreateShiftSlotUsecase(slotRepo, bdRepo, instanceRepo, &MockRoleRepository{})
 
 	input := appshift.CreateShiftSlotInput{
 		TenantID:      tenantID,
@@ -827,7 +1161,13 @@ func TestConfirmManualAssignmentUsecase_Execute_Success(t *testing.T) {
 		},
 	}
 
-	usecase := appshift.NewConfirmManualAssignmentUsecase(slotRepo, assignmentRepo, memberRepo)
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return createTestBusinessDay(t, tid, common.NewEventID()), nil
+		},
+	}
+
+	usecase := appshift.NewConfirmManualAssignmentUsecase(slotRepo, assignmentRepo, memberRepo, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{}, &MockEventRepository{}, businessDayRepo, &MockOutboxRepository{}, &MockTxManager{}, &MockClock{now: time.Now()})
 
 	input := appshift.ConfirmManualAssignmentInput{
 		TenantID: tenantID,
@@ -847,8 +1187,118 @@ func TestConfirmManualAssignmentUsecase_Execute_Success(t *testing.T) {
 		t.Fatal("Result should not be nil")
 	}
 
-	if result.MemberID() != testMember.MemberID() {
-		t.Errorf("MemberID mismatch: got %v, want %v", result.MemberID(), testMember.MemberID())
+	if result.Assignment.MemberID() != testMember.MemberID() {
+		t.Errorf("MemberID mismatch: got %v, want %v", result.Assignment.MemberID(), testMember.MemberID())
+	}
+	if len(result.Warnings) != 0 {
+		t.Errorf("Warnings should be empty for a slot without role requirements, got %+v", result.Warnings)
+	}
+}
+
+func TestConfirmManualAssignmentUsecase_Execute_ErrorWhenEventArchived(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testSlot := createTestShiftSlot(t, tenantID)
+	testMember := createTestMember(t, tenantID)
+
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return testSlot, nil
+		},
+	}
+
+	assignmentRepo := &MockShiftAssignmentRepository{
+		countConfirmedBySlotFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (int, error) {
+			return 0, nil
+		},
+		saveFunc: func(ctx context.Context, assignment *shift.ShiftAssignment) error {
+			t.Error("assignment should not be saved for an archived event")
+			return nil
+		},
+	}
+
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memID common.MemberID) (*member.Member, error) {
+			return testMember, nil
+		},
+	}
+
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return createTestBusinessDay(t, tid, common.NewEventID()), nil
+		},
+	}
+
+	eventRepo := &MockEventRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) (*event.Event, error) {
+			e, err := event.NewEvent(time.Now(), tid, "Archived Event", event.EventTypeNormal, "", event.RecurrenceTypeNone, nil, nil, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			return e, e.Archive(time.Now())
+		},
+	}
+
+	usecase := appshift.NewConfirmManualAssignmentUsecase(slotRepo, assignmentRepo, memberRepo, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{}, eventRepo, businessDayRepo, &MockOutboxRepository{}, &MockTxManager{}, &MockClock{now: time.Now()})
+
+	_, err := usecase.Execute(context.Background(), appshift.ConfirmManualAssignmentInput{
+		TenantID: tenantID,
+		SlotID:   testSlot.SlotID(),
+		MemberID: testMember.MemberID(),
+		ActorID:  common.NewMemberID(),
+	})
+
+	var domainErr *common.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code() != common.ErrConflict {
+		t.Errorf("Execute() should return Conflict for an archived event, got %v", err)
+	}
+}
+
+func TestConfirmManualAssignmentUsecase_Execute_ErrorWhenBusinessDayCancelled(t *testing.T) {
+	tenantID := common.NewTenantID()
+	testSlot := createTestShiftSlot(t, tenantID)
+	testMember := createTestMember(t, tenantID)
+	businessDay := createTestBusinessDay(t, tenantID, common.NewEventID())
+	if err := businessDay.Cancel(time.Now(), "雨天中止", nil); err != nil {
+		t.Fatalf("Failed to cancel business day: %v", err)
+	}
+
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return testSlot, nil
+		},
+	}
+
+	assignmentRepo := &MockShiftAssignmentRepository{
+		saveFunc: func(ctx context.Context, assignment *shift.ShiftAssignment) error {
+			t.Error("assignment should not be saved for a cancelled business day")
+			return nil
+		},
+	}
+
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memID common.MemberID) (*member.Member, error) {
+			return testMember, nil
+		},
+	}
+
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return businessDay, nil
+		},
+	}
+
+	usecase := appshift.NewConfirmManualAssignmentUsecase(slotRepo, assignmentRepo, memberRepo, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{}, &MockEventRepository{}, businessDayRepo, &MockOutboxRepository{}, &MockTxManager{}, &MockClock{now: time.Now()})
+
+	_, err := usecase.Execute(context.Background(), appshift.ConfirmManualAssignmentInput{
+		TenantID: tenantID,
+		SlotID:   testSlot.SlotID(),
+		MemberID: testMember.MemberID(),
+		ActorID:  common.NewMemberID(),
+	})
+
+	var domainErr *common.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code() != common.ErrConflict {
+		t.Errorf("Execute() should return Conflict for a cancelled business day, got %v", err)
 	}
 }
 
@@ -875,7 +1325,7 @@ func TestConfirmManualAssignmentUsecase_Execute_ErrorWhenSlotFull(t *testing.T)
 		},
 	}
 
-	usecase := appshift.NewConfirmManualAssignmentUsecase(slotRepo, assignmentRepo, memberRepo)
+	usecase := appshift.NewConfirmManualAssignmentUsecase(slotRepo, assignmentRepo, memberRepo, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{}, &MockEventRepository{}, &MockBusinessDayRepository{}, &MockOutboxRepository{}, &MockTxManager{}, &MockClock{now: time.Now()})
 
 	input := appshift.ConfirmManualAssignmentInput{
 		TenantID: tenantID,
@@ -887,8 +1337,8 @@ func TestConfirmManualAssignmentUsecase_Execute_ErrorWhenSlotFull(t *testing.T)
 
 	_, err := usecase.Execute(context.Background(), input)
 
-	if err == nil {
-		t.Fatal("Execute() should fail when slot is full")
+	if !errors.Is(err, shift.ErrSlotFull) {
+		t.Fatalf("Execute() should return ErrSlotFull when slot is full, got %v", err)
 	}
 }
 
@@ -905,7 +1355,7 @@ func TestConfirmManualAssignmentUsecase_Execute_ErrorWhenSlotNotFound(t *testing
 	assignmentRepo := &MockShiftAssignmentRepository{}
 	memberRepo := &MockMemberRepository{}
 
-	usecase := appshift.NewConfirmManualAssignmentUsecase(slotRepo, assignmentRepo, memberRepo)
+	usecase := appshift.NewConfirmManualAssignmentUsecase(slotRepo, assignmentRepo, memberRepo, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{}, &MockEventRepository{}, &MockBusinessDayRepository{}, &MockOutboxRepository{}, &MockTxManager{}, &MockClock{now: time.Now()})
 
 	input := appshift.ConfirmManualAssignmentInput{
 		TenantID: tenantID,
@@ -941,7 +1391,7 @@ func TestConfirmManualAssignmentUsecase_Execute_ErrorWhenMemberNotFound(t *testi
 		},
 	}
 
-	usecase := appshift.NewConfirmManualAssignmentUsecase(slotRepo, assignmentRepo, memberRepo)
+	usecase := appshift.NewConfirmManualAssignmentUsecase(slotRepo, assignmentRepo, memberRepo, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{}, &MockEventRepository{}, &MockBusinessDayRepository{}, &MockOutboxRepository{}, &MockTxManager{}, &MockClock{now: time.Now()})
 
 	input := appshift.ConfirmManualAssignmentInput{
 		TenantID: tenantID,
@@ -958,21 +1408,478 @@ func TestConfirmManualAssignmentUsecase_Execute_ErrorWhenMemberNotFound(t *testi
 	}
 }
 
+// confirmWithExistingAssignment は既存の割り当てがある状態で手動割り当てを確定するテストヘルパー
+// existingDayOffset は新しい枠の営業日から見た既存割り当ての営業日のずれ（日数）
+// existingCancelled が true の場合、既存割り当ての営業日を中止しておく
+func confirmWithExistingAssignment(
+	t *testing.T,
+	newStart, newEnd, existingStart, existingEnd time.Time,
+	existingDayOffset int,
+	existingCancelled, force bool,
+) (*appshift.ConfirmManualAssignmentResult, error) {
+	t.Helper()
+	tenantID := common.NewTenantID()
+	testMember := createTestMember(t, tenantID)
+	targetDate := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	newBD, err := event.NewEventBusinessDay(time.Now(), tenantID, common.NewEventID(), targetDate, newStart, newEnd, event.OccurrenceTypeSpecial, nil)
+	if err != nil {
+		t.Fatalf("Failed to create business day: %v", err)
+	}
+	existingBD, err := event.NewEventBusinessDay(time.Now(), tenantID, common.NewEventID(), targetDate.AddDate(0, 0, existingDayOffset), existingStart, existingEnd, event.OccurrenceTypeSpecial, nil)
+	if err != nil {
+		t.Fatalf("Failed to create business day: %v", err)
+	}
+	if existingCancelled {
+		if err := existingBD.Cancel(time.Now(), "", nil); err != nil {
+			t.Fatalf("Failed to cancel business day: %v", err)
+		}
+	}
+
+	newSlot, err := shift.NewShiftSlot(time.Now(), tenantID, newBD.BusinessDayID(), nil, "新しい枠", "", newStart, newEnd, 2, 1)
+	if err != nil {
+		t.Fatalf("Failed to create shift slot: %v", err)
+	}
+	existingSlot, err := shift.NewShiftSlot(time.Now(), tenantID, existingBD.BusinessDayID(), nil, "既存の枠", "", existingStart, existingEnd, 2, 1)
+	if err != nil {
+		t.Fatalf("Failed to create shift slot: %v", err)
+	}
+	existing, err := shift.NewShiftAssignment(time.Now(), tenantID, "", existingSlot.SlotID(), testMember.MemberID(), shift.AssignmentMethodManual, false)
+	if err != nil {
+		t.Fatalf("Failed to create shift assignment: %v", err)
+	}
+
+	slots := map[shift.SlotID]*shift.ShiftSlot{newSlot.SlotID(): newSlot, existingSlot.SlotID(): existingSlot}
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return slots[slotID], nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		findByBusinessDayIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftAssignment, error) {
+			if id == existingBD.BusinessDayID() {
+				return []*shift.ShiftAssignment{existing}, nil
+			}
+			return nil, nil
+		},
+	}
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memID common.MemberID) (*member.Member, error) {
+			return testMember, nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return newBD, nil
+		},
+		findByTenantIDAndDateFunc: func(ctx context.Context, tid common.TenantID, date time.Time) ([]*event.EventBusinessDay, error) {
+			var result []*event.EventBusinessDay
+			for _, bd := range []*event.EventBusinessDay{newBD, existingBD} {
+				if bd.TargetDate().Equal(date) {
+					result = append(result, bd)
+				}
+			}
+			return result, nil
+		},
+	}
+
+	usecase := appshift.NewConfirmManualAssignmentUsecase(slotRepo, assignmentRepo, memberRepo, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{}, &MockEventRepository{}, businessDayRepo, &MockOutboxRepository{}, &MockTxManager{}, &MockClock{now: time.Now()})
+	return usecase.Execute(context.Background(), appshift.ConfirmManualAssignmentInput{
+		TenantID: tenantID,
+		SlotID:   newSlot.SlotID(),
+		MemberID: testMember.MemberID(),
+		ActorID:  common.NewMemberID(),
+		Force:    force,
+	})
+}
+
+func TestConfirmManualAssignmentUsecase_Execute_ErrorWhenOverlapping(t *testing.T) {
+	_, err := confirmWithExistingAssignment(t,
+		time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 30, 0, 0, time.UTC),
+		0, false, false,
+	)
+
+	var conflictErr *shift.AssignmentConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("Execute() should return AssignmentConflictError, got %v", err)
+	}
+	if len(conflictErr.Conflicts) != 1 || conflictErr.Conflicts[0].SlotName != "既存の枠" {
+		t.Errorf("Conflicts: expected the existing slot, got %+v", conflictErr.Conflicts)
+	}
+}
+
+func TestConfirmManualAssignmentUsecase_Execute_ErrorWhenOverlappingOvernightFromPreviousDay(t *testing.T) {
+	// 前日 23:00-02:00 の深夜シフトと当日 01:00-03:00 のシフトは重複する
+	_, err := confirmWithExistingAssignment(t,
+		time.Date(2000, 1, 1, 1, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 3, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 2, 0, 0, 0, time.UTC),
+		-1, false, false,
+	)
+
+	var conflictErr *shift.AssignmentConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("Execute() should return AssignmentConflictError, got %v", err)
+	}
+}
+
+func TestConfirmManualAssignmentUsecase_Execute_SuccessWhenAdjacent(t *testing.T) {
+	result, err := confirmWithExistingAssignment(t,
+		time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+		0, false, false,
+	)
+	if err != nil {
+		t.Fatalf("Execute() should succeed for adjacent slots, got error: %v", err)
+	}
+	if result.Assignment.IsConflictOverridden() {
+		t.Errorf("IsConflictOverridden() should be false when there is no conflict")
+	}
+}
+
+func TestConfirmManualAssignmentUsecase_Execute_ForceRecordsOverride(t *testing.T) {
+	result, err := confirmWithExistingAssignment(t,
+		time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 30, 0, 0, time.UTC),
+		0, false, true,
+	)
+	if err != nil {
+		t.Fatalf("Execute() with force should succeed, got error: %v", err)
+	}
+	if !result.Assignment.IsConflictOverridden() {
+		t.Errorf("IsConflictOverridden() should be true when forced over a conflict")
+	}
+}
+
+func TestConfirmManualAssignmentUsecase_Execute_IgnoresAssignmentsOnCancelledBusinessDay(t *testing.T) {
+	// 中止された営業日の割り当ては時間帯が重なっても重複とみなさない
+	result, err := confirmWithExistingAssignment(t,
+		time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 30, 0, 0, time.UTC),
+		0, true, false,
+	)
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+	if result.Assignment.IsConflictOverridden() {
+		t.Errorf("IsConflictOverridden() should be false when the overlapping business day is cancelled")
+	}
+}
+
+// confirmWithRoleRequirement confirms a member without roles on a slot that already has one member without roles
+func confirmWithRoleRequirement(t *testing.T, requiredCount int, kind shift.RoleRequirementKind) (*appshift.ConfirmManualAssignmentResult, error) {
+	t.Helper()
+	tenantID := common.NewTenantID()
+	testSlot := createTestShiftSlot(t, tenantID)
+	if err := testSlot.UpdateRequiredCount(time.Now(), requiredCount); err != nil {
+		t.Fatalf("Failed to update required count: %v", err)
+	}
+	req, err := shift.NewRoleRequirement(common.NewRoleID(), kind, 1)
+	if err != nil {
+		t.Fatalf("Failed to create role requirement: %v", err)
+	}
+	if err := testSlot.SetRoleRequirements(time.Now(), []shift.RoleRequirement{req}); err != nil {
+		t.Fatalf("Failed to set role requirements: %v", err)
+	}
+	testMember := createTestMember(t, tenantID)
+
+	existing, err := shift.NewShiftAssignment(time.Now(), tenantID, "", testSlot.SlotID(), common.NewMemberID(), shift.AssignmentMethodManual, false)
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return testSlot, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		countConfirmedBySlotFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (int, error) {
+			return 1, nil
+		},
+		findConfirmedBySlotIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) ([]*shift.ShiftAssignment, error) {
+			return []*shift.ShiftAssignment{existing}, nil
+		},
+	}
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memID common.MemberID) (*member.Member, error) {
+			return testMember, nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return createTestBusinessDay(t, tid, common.NewEventID()), nil
+		},
+	}
+	memberRoleRepo := &MockMemberRoleRepository{roles: map[common.MemberID][]common.RoleID{}}
+
+	usecase := appshift.NewConfirmManualAssignmentUsecase(slotRepo, assignmentRepo, memberRepo, memberRoleRepo, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{}, &MockEventRepository{}, businessDayRepo, &MockOutboxRepository{}, &MockTxManager{}, &MockClock{now: time.Now()})
+	return usecase.Execute(context.Background(), appshift.ConfirmManualAssignmentInput{
+		TenantID: tenantID,
+		SlotID:   testSlot.SlotID(),
+		MemberID: testMember.MemberID(),
+		ActorID:  common.NewMemberID(),
+		Force:    true, // force でも必須ロールは上書きできない
+	})
+}
+
+func TestConfirmManualAssignmentUsecase_Execute_ErrorWhenRequiredRoleCannotBeFilled(t *testing.T) {
+	// 2人枠の残り1枠に必須ロールを持たないメンバーは割り当てられない
+	_, err := confirmWithRoleRequirement(t, 2, shift.RoleRequirementRequired)
+
+	var roleErr *shift.RoleRequirementError
+	if !errors.As(err, &roleErr) {
+		t.Fatalf("Execute() should return RoleRequirementError, got %v", err)
+	}
+	if len(roleErr.Shortfalls) != 1 {
+		t.Errorf("expected one shortfall, got %+v", roleErr.Shortfalls)
+	}
+}
+
+func TestConfirmManualAssignmentUsecase_Execute_SuccessWhenRequiredRoleStillFillable(t *testing.T) {
+	result, err := confirmWithRoleRequirement(t, 3, shift.RoleRequirementRequired)
+	if err != nil {
+		t.Fatalf("Execute() should succeed while seats remain for the role, got error: %v", err)
+	}
+	if len(result.Warnings) != 0 {
+		t.Errorf("Warnings should be empty, got %+v", result.Warnings)
+	}
+}
+
+func TestConfirmManualAssignmentUsecase_Execute_WarnsWhenPreferredRoleMissing(t *testing.T) {
+	result, err := confirmWithRoleRequirement(t, 2, shift.RoleRequirementPreferred)
+	if err != nil {
+		t.Fatalf("Execute() should succeed for preferred roles, got error: %v", err)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0].Code != appshift.AssignmentWarningPreferredRoleMissing {
+		t.Errorf("expected a preferred role warning, got %+v", result.Warnings)
+	}
+}
+
+// confirmWithAvailability confirms a member on a 20:00-22:00 slot with the availability calendar built by setup
+func confirmWithAvailability(
+	t *testing.T,
+	setup func(tenantID common.TenantID, memberID common.MemberID, targetDate time.Time) *MockAvailabilityRepository,
+) (*appshift.ConfirmManualAssignmentResult, error) {
+	t.Helper()
+	tenantID := common.NewTenantID()
+	testSlot := createTestShiftSlot(t, tenantID)
+	testMember := createTestMember(t, tenantID)
+	businessDay := createTestBusinessDay(t, tenantID, common.NewEventID())
+
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return testSlot, nil
+		},
+	}
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memID common.MemberID) (*member.Member, error) {
+			return testMember, nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return businessDay, nil
+		},
+	}
+	availabilityRepo := setup(tenantID, testMember.MemberID(), businessDay.TargetDate())
+
+	usecase := appshift.NewConfirmManualAssignmentUsecase(slotRepo, &MockShiftAssignmentRepository{}, memberRepo, &MockMemberRoleRepository{}, availabilityRepo, &MockWorkloadPolicyRepository{}, &MockEventRepository{}, businessDayRepo, &MockOutboxRepository{}, &MockTxManager{}, &MockClock{now: time.Now()})
+	return usecase.Execute(context.Background(), appshift.ConfirmManualAssignmentInput{
+		TenantID: tenantID,
+		SlotID:   testSlot.SlotID(),
+		MemberID: testMember.MemberID(),
+		ActorID:  common.NewMemberID(),
+	})
+}
+
+func TestConfirmManualAssignmentUsecase_Execute_WarnsWhenMemberHasBlackout(t *testing.T) {
+	result, err := confirmWithAvailability(t, func(tenantID common.TenantID, memberID common.MemberID, targetDate time.Time) *MockAvailabilityRepository {
+		blackout, err := member.NewAvailabilityBlackout(time.Now(), tenantID, memberID, targetDate, nil, nil, "旅行")
+		if err != nil {
+			t.Fatalf("Failed to create blackout: %v", err)
+		}
+		return &MockAvailabilityRepository{
+			blackouts: map[common.MemberID][]*member.AvailabilityBlackout{memberID: {blackout}},
+		}
+	})
+	if err != nil {
+		t.Fatalf("Execute() should still assign an unavailable member, got error: %v", err)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0].Code != appshift.AssignmentWarningMemberUnavailable {
+		t.Errorf("expected a member unavailable warning, got %+v", result.Warnings)
+	}
+}
+
+func TestConfirmManualAssignmentUsecase_Execute_AvailabilityWindows(t *testing.T) {
+	tests := []struct {
+		name        string
+		start       time.Time
+		end         time.Time
+		wantWarning bool
+	}{
+		{"window covers the slot", time.Date(2000, 1, 1, 19, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC), false},
+		{"window ends before the slot", time.Date(2000, 1, 1, 18, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := confirmWithAvailability(t, func(tenantID common.TenantID, memberID common.MemberID, targetDate time.Time) *MockAvailabilityRepository {
+				window, err := member.NewAvailabilityWindow(time.Now(), tenantID, memberID, targetDate.Weekday(), tt.start, tt.end, "")
+				if err != nil {
+					t.Fatalf("Failed to create availability window: %v", err)
+				}
+				return &MockAvailabilityRepository{
+					windows: map[common.MemberID][]*member.AvailabilityWindow{memberID: {window}},
+				}
+			})
+			if err != nil {
+				t.Fatalf("Execute() should succeed, got error: %v", err)
+			}
+			if got := len(result.Warnings) > 0; got != tt.wantWarning {
+				t.Errorf("warning = %v, want %v (warnings: %+v)", got, tt.wantWarning, result.Warnings)
+			}
+		})
+	}
+}
+
+// confirmWithWorkload confirms the test slot (20:00-22:00) for a member with the given policy
+// and an existing shift of the member from 16:00 to 19:30 on the same business day
+func confirmWithWorkload(t *testing.T, limits member.WorkloadLimits, enforcement member.WorkloadEnforcement, force, overrideWorkload bool) (*appshift.ConfirmManualAssignmentResult, error) {
+	t.Helper()
+	tenantID := common.NewTenantID()
+	testSlot := createTestShiftSlot(t, tenantID)
+	testMember := createTestMember(t, tenantID)
+	businessDay := createTestBusinessDay(t, tenantID, common.NewEventID())
+
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return testSlot, nil
+		},
+	}
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memID common.MemberID) (*member.Member, error) {
+			return testMember, nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return businessDay, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		findConfirmedShiftsFunc: func(ctx context.Context, tid common.TenantID, memberID *common.MemberID, from, to time.Time) ([]shift.AssignedShift, error) {
+			return []shift.AssignedShift{{
+				AssignmentID:  shift.NewAssignmentID(),
+				MemberID:      *memberID,
+				SlotID:        shift.NewSlotID(),
+				BusinessDayID: businessDay.BusinessDayID(),
+				TargetDate:    businessDay.TargetDate(),
+				StartTime:     time.Date(2000, 1, 1, 16, 0, 0, 0, time.UTC),
+				EndTime:       time.Date(2000, 1, 1, 19, 30, 0, 0, time.UTC),
+			}}, nil
+		},
+	}
+
+	policy, err := member.NewWorkloadPolicy(time.Now(), tenantID, nil, limits, enforcement)
+	if err != nil {
+		t.Fatalf("Failed to create workload policy: %v", err)
+	}
+
+	usecase := appshift.NewConfirmManualAssignmentUsecase(slotRepo, assignmentRepo, memberRepo, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{tenantPolicy: policy}, &MockEventRepository{}, businessDayRepo, &MockOutboxRepository{}, &MockTxManager{}, &MockClock{now: time.Now()})
+	return usecase.Execute(context.Background(), appshift.ConfirmManualAssignmentInput{
+		TenantID:         tenantID,
+		SlotID:           testSlot.SlotID(),
+		MemberID:         testMember.MemberID(),
+		ActorID:          common.NewMemberID(),
+		Force:            force,
+		OverrideWorkload: overrideWorkload,
+	})
+}
+
+func TestConfirmManualAssignmentUsecase_Execute_WorkloadLimits(t *testing.T) {
+	one, sixty, sixHours := 1, 60, 360
+
+	tests := []struct {
+		name           string
+		limits         member.WorkloadLimits
+		enforcement    member.WorkloadEnforcement
+		force          bool
+		override       bool
+		wantBlocked    bool
+		wantWarnings   int
+		wantOverridden int
+	}{
+		{"within limits", member.WorkloadLimits{MaxMinutesPerBusinessDay: &sixHours}, member.WorkloadEnforcementBlock, false, false, false, 0, 0},
+		{"warn on weekly limit", member.WorkloadLimits{MaxShiftsPerWeek: &one}, member.WorkloadEnforcementWarn, false, false, false, 1, 0},
+		{"block on weekly limit", member.WorkloadLimits{MaxShiftsPerWeek: &one}, member.WorkloadEnforcementBlock, false, false, true, 0, 0},
+		{"force does not override block", member.WorkloadLimits{MaxShiftsPerWeek: &one}, member.WorkloadEnforcementBlock, true, false, true, 0, 0},
+		{"override_workload overrides block", member.WorkloadLimits{MaxShiftsPerWeek: &one}, member.WorkloadEnforcementBlock, false, true, false, 1, 1},
+		{"override_workload on warn overrides nothing", member.WorkloadLimits{MaxShiftsPerWeek: &one}, member.WorkloadEnforcementWarn, false, true, false, 1, 0},
+		{"block on minimum rest", member.WorkloadLimits{MinRestMinutesBetweenShifts: &sixty}, member.WorkloadEnforcementBlock, false, false, true, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := confirmWithWorkload(t, tt.limits, tt.enforcement, tt.force, tt.override)
+
+			var workloadErr *member.WorkloadLimitError
+			if tt.wantBlocked {
+				if !errors.As(err, &workloadErr) {
+					t.Fatalf("expected WorkloadLimitError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() should succeed, got error: %v", err)
+			}
+			if len(result.Warnings) != tt.wantWarnings {
+				t.Fatalf("expected %d warnings, got %+v", tt.wantWarnings, result.Warnings)
+			}
+			for _, w := range result.Warnings {
+				if w.Code != appshift.AssignmentWarningWorkloadLimitExceeded {
+					t.Errorf("unexpected warning code %s", w.Code)
+				}
+			}
+			if len(result.OverriddenWorkloadLimits) != tt.wantOverridden {
+				t.Errorf("expected %d overridden limits, got %+v", tt.wantOverridden, result.OverriddenWorkloadLimits)
+			}
+		})
+	}
+}
+
 // =====================================================
 // CancelAssignmentUsecase Tests
 // =====================================================
 
+// newTestCancelAssignmentUsecase creates a CancelAssignmentUsecase with an empty standby list
+func newTestCancelAssignmentUsecase(t *testing.T, tenantID common.TenantID, assignmentRepo *MockShiftAssignmentRepository) *appshift.CancelAssignmentUsecase {
+	t.Helper()
+	slot := createTestShiftSlot(t, tenantID)
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, sid shift.SlotID) (*shift.ShiftSlot, error) {
+			return slot, nil
+		},
+	}
+	standbyRepo := &MockStandbyRepository{entries: map[shift.StandbyID]*shift.StandbyEntry{}}
+
+	return appshift.NewCancelAssignmentUsecase(assignmentRepo, slotRepo, standbyRepo, &MockBusinessDayRepository{}, &MockEventRepository{}, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{}, &MockTxManager{}, &MockClock{now: time.Now()})
+}
+
 func TestCancelAssignmentUsecase_Execute_Success(t *testing.T) {
 	tenantID := common.NewTenantID()
 	assignmentID := shift.NewAssignmentID()
 
 	assignmentRepo := &MockShiftAssignmentRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, aid shift.AssignmentID) (*shift.ShiftAssignment, error) {
+			return shift.NewShiftAssignment(time.Now(), tid, "", shift.NewSlotID(), common.NewMemberID(), shift.AssignmentMethodManual, false)
+		},
 		deleteFunc: func(ctx context.Context, tid common.TenantID, aid shift.AssignmentID) error {
 			return nil
 		},
 	}
 
-	usecase := appshift.NewCancelAssignmentUsecase(assignmentRepo)
+	usecase := newTestCancelAssignmentUsecase(t, tenantID, assignmentRepo)
 
 	input := appshift.CancelAssignmentInput{
 		TenantID:     tenantID,
@@ -991,12 +1898,15 @@ func TestCancelAssignmentUsecase_Execute_ErrorWhenDeleteFails(t *testing.T) {
 	assignmentID := shift.NewAssignmentID()
 
 	assignmentRepo := &MockShiftAssignmentRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, aid shift.AssignmentID) (*shift.ShiftAssignment, error) {
+			return shift.NewShiftAssignment(time.Now(), tid, "", shift.NewSlotID(), common.NewMemberID(), shift.AssignmentMethodManual, false)
+		},
 		deleteFunc: func(ctx context.Context, tid common.TenantID, aid shift.AssignmentID) error {
 			return errors.New("database error")
 		},
 	}
 
-	usecase := appshift.NewCancelAssignmentUsecase(assignmentRepo)
+	usecase := newTestCancelAssignmentUsecase(t, tenantID, assignmentRepo)
 
 	input := appshift.CancelAssignmentInput{
 		TenantID:     tenantID,
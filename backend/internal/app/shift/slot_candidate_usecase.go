@@ -0,0 +1,324 @@
+package shift
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// 候補者スコアの配点
+// 出欠回答を最も重視し、時間帯・ロール・当日の他のシフト・直近の割り当て数・勤務量上限で調整する
+const (
+	candidateScoreAttending        = 100
+	candidateScoreUndecided        = 30
+	candidateScoreAbsent           = -100
+	candidateScoreCoversSlot       = 30
+	candidateScoreOutsideHours     = -30
+	candidateScoreUnavailable      = -50
+	candidateScoreRequiredRole     = 40
+	candidateScorePreferredRole    = 15
+	candidateScoreRoleViolation    = -80
+	candidateScoreOverlappingShift = -100
+	candidateScoreSameNightShift   = -10 // 時間帯が重ならない当日のシフト1件あたり
+	candidateScoreRecentShift      = -5  // 直近 FairnessWindowDays 日のシフト1件あたり
+	candidateScoreWorkloadLimit    = -40 // 勤務量上限の違反1件あたり
+)
+
+// ListSlotCandidatesInput represents the input for ranking candidates of a shift slot
+type ListSlotCandidatesInput struct {
+	TenantID common.TenantID
+	SlotID   shift.SlotID
+}
+
+// SlotCandidate represents a member ranked for a shift slot
+type SlotCandidate struct {
+	Member *member.Member
+	Score  int
+	Reason string // スコアの内訳（"; " 区切り）
+
+	// 営業日への出欠回答（未回答の場合は空）と参加可能時間
+	AttendanceResponse attendance.ResponseType
+	AvailableFrom      *string
+	AvailableTo        *string
+	// CoversSlot は参加可能時間が枠の時間帯を含むか（出席・未定の回答がない場合は nil）
+	CoversSlot *bool
+
+	// メンバーの参加可能時間帯・ブラックアウト日による判定
+	Availability member.Availability
+
+	// 枠のロール要件のうち、候補者が満たせる未充足の要件と、割り当てた場合の判定
+	MatchedRoles []shift.RoleRequirement
+	RoleCheck    shift.RoleRequirementCheck
+
+	// 営業日当日（深夜帯の前後を含む）の他の確定シフト
+	ShiftsThatNight []shift.AssignedShift
+	HasOverlap      bool
+
+	RecentShiftCount   int
+	WorkloadViolations []member.WorkloadViolation
+}
+
+// SlotCandidates represents the ranked candidates of a shift slot
+type SlotCandidates struct {
+	Slot        *shift.ShiftSlot
+	BusinessDay *event.EventBusinessDay
+	Candidates  []SlotCandidate
+}
+
+// ListSlotCandidatesUsecase ranks tenant members for a shift slot
+type ListSlotCandidatesUsecase struct {
+	slotRepo           shift.ShiftSlotRepository
+	businessDayRepo    event.EventBusinessDayRepository
+	assignmentRepo     shift.ShiftAssignmentRepository
+	memberRepo         member.MemberRepository
+	memberRoleRepo     member.MemberRoleRepository
+	attendanceRepo     attendance.AttendanceCollectionRepository
+	availabilityRepo   member.AvailabilityRepository
+	workloadPolicyRepo member.WorkloadPolicyRepository
+}
+
+// NewListSlotCandidatesUsecase creates a new ListSlotCandidatesUsecase
+func NewListSlotCandidatesUsecase(
+	slotRepo shift.ShiftSlotRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	memberRepo member.MemberRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	attendanceRepo attendance.AttendanceCollectionRepository,
+	availabilityRepo member.AvailabilityRepository,
+	workloadPolicyRepo member.WorkloadPolicyRepository,
+) *ListSlotCandidatesUsecase {
+	return &ListSlotCandidatesUsecase{
+		slotRepo:           slotRepo,
+		businessDayRepo:    businessDayRepo,
+		assignmentRepo:     assignmentRepo,
+		memberRepo:         memberRepo,
+		memberRoleRepo:     memberRoleRepo,
+		attendanceRepo:     attendanceRepo,
+		availabilityRepo:   availabilityRepo,
+		workloadPolicyRepo: workloadPolicyRepo,
+	}
+}
+
+// Execute returns the active members of the tenant ranked for the slot
+//
+// Logic:
+//  1. シフト枠・営業日を取得
+//  2. 営業日への出欠回答をメンバーごとに集める（自動割り当てと同じ規則）
+//  3. 営業日の前後 workloadLookbackDays 日の確定シフトを一括取得
+//     （当日の他のシフト・直近の割り当て数・勤務量上限の判定に使用）
+//  4. 既にこの枠に確定しているメンバーを除き、メンバーごとにスコアを計算
+//  5. スコア降順 > 直近の割り当て数昇順 > 表示名順 に並べる
+func (uc *ListSlotCandidatesUsecase) Execute(ctx context.Context, input ListSlotCandidatesInput) (*SlotCandidates, error) {
+	// 1. シフト枠・営業日を取得
+	slot, err := uc.slotRepo.FindByID(ctx, input.TenantID, input.SlotID)
+	if err != nil {
+		return nil, err
+	}
+
+	businessDay, err := uc.businessDayRepo.FindByID(ctx, input.TenantID, slot.BusinessDayID())
+	if err != nil {
+		return nil, err
+	}
+	targetDate := businessDay.TargetDate()
+
+	members, err := uc.memberRepo.FindActiveByTenantID(ctx, input.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find members: %w", err)
+	}
+	active := make(map[common.MemberID]bool, len(members))
+	for _, m := range members {
+		active[m.MemberID()] = true
+	}
+
+	// 2. 出欠回答
+	responses, err := findLatestAttendanceResponses(ctx, uc.attendanceRepo, uc.memberRoleRepo, businessDay, active)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 前後の確定シフトを一括取得（N+1 回避）
+	shifts, err := uc.assignmentRepo.FindConfirmedShiftsByDateRange(
+		ctx, input.TenantID, nil,
+		targetDate.AddDate(0, 0, -workloadLookbackDays), targetDate.AddDate(0, 0, workloadLookbackDays),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find assigned shifts: %w", err)
+	}
+	shiftsByMember := make(map[common.MemberID][]shift.AssignedShift)
+	assignedToSlot := make(map[common.MemberID]bool)
+	for _, s := range shifts {
+		if s.SlotID == slot.SlotID() {
+			assignedToSlot[s.MemberID] = true
+			continue
+		}
+		shiftsByMember[s.MemberID] = append(shiftsByMember[s.MemberID], s)
+	}
+
+	assignedRoles, err := findAssignedMemberRoles(ctx, uc.assignmentRepo, uc.memberRoleRepo, input.TenantID, slot.SlotID())
+	if err != nil {
+		return nil, err
+	}
+
+	tenantPolicy, err := uc.workloadPolicyRepo.FindTenantDefault(ctx, input.TenantID)
+	if err != nil && !common.IsNotFoundError(err) {
+		return nil, fmt.Errorf("failed to find tenant workload policy: %w", err)
+	}
+	memberPolicies, err := uc.workloadPolicyRepo.FindMemberPoliciesByTenantID(ctx, input.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find member workload policies: %w", err)
+	}
+	policyByMember := make(map[common.MemberID]*member.WorkloadPolicy, len(memberPolicies))
+	for _, p := range memberPolicies {
+		policyByMember[*p.MemberID()] = p
+	}
+
+	// 4. メンバーごとにスコアを計算
+	result := &SlotCandidates{Slot: slot, BusinessDay: businessDay, Candidates: []SlotCandidate{}}
+	for _, m := range members {
+		if assignedToSlot[m.MemberID()] {
+			continue
+		}
+
+		candidate := SlotCandidate{Member: m}
+		var reasons []string
+		add := func(points int, reason string) {
+			candidate.Score += points
+			reasons = append(reasons, fmt.Sprintf("%s (%+d)", reason, points))
+		}
+
+		// 出欠回答と参加可能時間
+		if r, ok := responses[m.MemberID()]; ok {
+			candidate.AttendanceResponse = r.Response()
+			candidate.AvailableFrom = r.AvailableFrom()
+			candidate.AvailableTo = r.AvailableTo()
+			switch r.Response() {
+			case attendance.ResponseTypeAttending:
+				add(candidateScoreAttending, "attending")
+			case attendance.ResponseTypeUndecided:
+				add(candidateScoreUndecided, "undecided")
+			case attendance.ResponseTypeAbsent:
+				add(candidateScoreAbsent, "absent")
+			}
+			if r.Response() != attendance.ResponseTypeAbsent {
+				covers := fitsAvailability(slot, r.AvailableFrom(), r.AvailableTo())
+				candidate.CoversSlot = &covers
+				if covers {
+					add(candidateScoreCoversSlot, "available hours cover the slot")
+				} else {
+					add(candidateScoreOutsideHours, "available hours "+availableHours(r.AvailableFrom(), r.AvailableTo())+" do not cover the slot")
+				}
+			}
+		} else {
+			reasons = append(reasons, "no attendance response")
+		}
+
+		// 参加可能時間帯・ブラックアウト日
+		candidate.Availability, err = checkMemberAvailability(ctx, uc.availabilityRepo, input.TenantID, m.MemberID(), slot, targetDate)
+		if err != nil {
+			return nil, err
+		}
+		if !candidate.Availability.Available {
+			if candidate.Availability.Reason == member.UnavailabilityReasonBlackout {
+				add(candidateScoreUnavailable, "blackout on "+candidate.Availability.Blackout.Date().Format("2006-01-02"))
+			} else {
+				add(candidateScoreUnavailable, "outside weekly availability")
+			}
+		}
+
+		// ロール要件
+		if slot.HasRoleRequirements() {
+			roles, err := uc.memberRoleRepo.FindRolesByMemberID(ctx, m.MemberID())
+			if err != nil {
+				return nil, fmt.Errorf("failed to find member roles: %w", err)
+			}
+			candidate.RoleCheck = slot.EvaluateRoleRequirements(assignedRoles, roles)
+			candidate.MatchedRoles = slot.MatchingRoleRequirements(assignedRoles, roles)
+			for _, req := range candidate.MatchedRoles {
+				if req.IsRequired() {
+					add(candidateScoreRequiredRole, "has required role "+req.RoleID().String())
+				} else {
+					add(candidateScorePreferredRole, "has preferred role "+req.RoleID().String())
+				}
+			}
+			if !candidate.RoleCheck.Satisfiable() {
+				add(candidateScoreRoleViolation, "would leave required roles unfillable")
+			}
+		}
+
+		// 当日の他のシフトと直近の割り当て数
+		slotStart, slotEnd := slot.PeriodOn(targetDate)
+		recentFrom := targetDate.AddDate(0, 0, -FairnessWindowDays)
+		memberShifts := shiftsByMember[m.MemberID()]
+		sameNight := 0
+		for _, s := range memberShifts {
+			start, end := s.Period()
+			overlaps := start.Before(slotEnd) && slotStart.Before(end)
+			if sameDate(s.TargetDate, targetDate) || overlaps {
+				candidate.ShiftsThatNight = append(candidate.ShiftsThatNight, s)
+				if overlaps {
+					candidate.HasOverlap = true
+				} else {
+					sameNight++
+				}
+			}
+			if !s.TargetDate.Before(recentFrom) && s.TargetDate.Before(targetDate) {
+				candidate.RecentShiftCount++
+			}
+		}
+		if candidate.HasOverlap {
+			add(candidateScoreOverlappingShift, "already assigned to an overlapping shift")
+		}
+		if sameNight > 0 {
+			add(candidateScoreSameNightShift*sameNight, fmt.Sprintf("%d other shift(s) that night", sameNight))
+		}
+		if candidate.RecentShiftCount > 0 {
+			add(candidateScoreRecentShift*candidate.RecentShiftCount, fmt.Sprintf("%d shift(s) in the last %d days", candidate.RecentShiftCount, FairnessWindowDays))
+		}
+
+		// 勤務量上限
+		limits, _ := member.ResolveWorkloadLimits(tenantPolicy, policyByMember[m.MemberID()])
+		candidate.WorkloadViolations = limits.EvaluateAssignment(
+			member.WorkPeriod{Date: targetDate, Start: slotStart, End: slotEnd},
+			toWorkPeriods(memberShifts),
+		)
+		for _, v := range candidate.WorkloadViolations {
+			add(candidateScoreWorkloadLimit, fmt.Sprintf("would exceed %s (%d > %d)", v.Kind, v.Actual, v.Limit))
+		}
+
+		candidate.Reason = strings.Join(reasons, "; ")
+		result.Candidates = append(result.Candidates, candidate)
+	}
+
+	// 5. 並べ替え
+	sort.SliceStable(result.Candidates, func(i, j int) bool {
+		a, b := result.Candidates[i], result.Candidates[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.RecentShiftCount != b.RecentShiftCount {
+			return a.RecentShiftCount < b.RecentShiftCount
+		}
+		return a.Member.DisplayName() < b.Member.DisplayName()
+	})
+
+	return result, nil
+}
+
+// availableHours formats the available hours of an attendance response for reasons
+func availableHours(from, to *string) string {
+	format := func(s *string) string {
+		if s == nil || *s == "" {
+			return "--:--"
+		}
+		return *s
+	}
+	return format(from) + "-" + format(to)
+}
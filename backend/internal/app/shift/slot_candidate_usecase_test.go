@@ -0,0 +1,283 @@
+package shift_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	appshift "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// =====================================================
+// Helper functions (slot candidates)
+// =====================================================
+
+// assignedShiftOn returns a confirmed shift of the member on the given date (hours on that date)
+func assignedShiftOn(memberID common.MemberID, date time.Time, startHour, endHour int) shift.AssignedShift {
+	return shift.AssignedShift{
+		AssignmentID: shift.NewAssignmentID(),
+		MemberID:     memberID,
+		SlotID:       shift.NewSlotID(),
+		SlotName:     "他の枠",
+		TargetDate:   date,
+		StartTime:    slotTime(startHour, 0),
+		EndTime:      slotTime(endHour, 0),
+	}
+}
+
+func candidateRanks(result *appshift.SlotCandidates) map[common.MemberID]int {
+	ranks := make(map[common.MemberID]int, len(result.Candidates))
+	for i, c := range result.Candidates {
+		ranks[c.Member.MemberID()] = i
+	}
+	return ranks
+}
+
+// =====================================================
+// ListSlotCandidatesUsecase Tests
+// =====================================================
+
+func TestListSlotCandidatesUsecase_Execute_RanksByAttendanceAndCoverage(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDayOn(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	slot := createTestSlotIn(t, bd, "受付", slotTime(20, 0), slotTime(22, 0), 2, 1)
+	collection, td := createTestAttendanceCollection(t, bd)
+	base := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	full, r1 := createTestAttendanceResponse(t, td, tenantID, "A", base, attendance.ResponseTypeAttending, nil, nil)
+	partial, r2 := createTestAttendanceResponse(t, td, tenantID, "B", base, attendance.ResponseTypeAttending, hhmm("21:00"), nil)
+	undecided, r3 := createTestAttendanceResponse(t, td, tenantID, "C", base, attendance.ResponseTypeUndecided, nil, nil)
+	absent, r4 := createTestAttendanceResponse(t, td, tenantID, "D", base, attendance.ResponseTypeAbsent, nil, nil)
+
+	usecase := appshift.NewListSlotCandidatesUsecase(
+		&MockShiftSlotRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+				return slot, nil
+			},
+		},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return bd, nil
+			},
+		},
+		&MockShiftAssignmentRepository{},
+		&MockMemberRepository{
+			findActiveByTenantIDFunc: func(ctx context.Context, tid common.TenantID) ([]*member.Member, error) {
+				return []*member.Member{full, partial, undecided, absent}, nil
+			},
+		},
+		&MockMemberRoleRepository{},
+		&MockAttendanceCollectionRepository{
+			collections: []*attendance.AttendanceCollection{collection},
+			targetDates: map[common.CollectionID][]*attendance.TargetDate{collection.CollectionID(): {td}},
+			responses:   map[common.CollectionID][]*attendance.AttendanceResponse{collection.CollectionID(): {r1, r2, r3, r4}},
+		},
+		&MockAvailabilityRepository{},
+		&MockWorkloadPolicyRepository{},
+	)
+
+	result, err := usecase.Execute(context.Background(), appshift.ListSlotCandidatesInput{
+		TenantID: tenantID,
+		SlotID:   slot.SlotID(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if len(result.Candidates) != 4 {
+		t.Fatalf("expected 4 candidates, got %d", len(result.Candidates))
+	}
+	want := []common.MemberID{full.MemberID(), partial.MemberID(), undecided.MemberID(), absent.MemberID()}
+	for i, id := range want {
+		if got := result.Candidates[i].Member.MemberID(); got != id {
+			t.Errorf("rank %d: expected %s, got %s (%s)", i, id, got, result.Candidates[i].Reason)
+		}
+	}
+
+	first := result.Candidates[0]
+	if first.AttendanceResponse != attendance.ResponseTypeAttending || first.CoversSlot == nil || !*first.CoversSlot {
+		t.Errorf("top candidate should be attending and cover the slot, got %+v", first)
+	}
+	if !strings.Contains(result.Candidates[1].Reason, "do not cover the slot") {
+		t.Errorf("reason should explain the partial coverage, got %q", result.Candidates[1].Reason)
+	}
+	if result.Candidates[3].CoversSlot != nil {
+		t.Errorf("coverage should not be evaluated for absent members")
+	}
+}
+
+func TestListSlotCandidatesUsecase_Execute_PenalisesOverlapAndRecentShifts(t *testing.T) {
+	tenantID := common.NewTenantID()
+	targetDate := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	bd := createTestBusinessDayOn(t, tenantID, targetDate)
+	slot := createTestSlotIn(t, bd, "受付", slotTime(20, 0), slotTime(22, 0), 2, 1)
+	collection, td := createTestAttendanceCollection(t, bd)
+	base := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	overlapping, r1 := createTestAttendanceResponse(t, td, tenantID, "A", base, attendance.ResponseTypeAttending, nil, nil)
+	busy, r2 := createTestAttendanceResponse(t, td, tenantID, "B", base, attendance.ResponseTypeAttending, nil, nil)
+	fresh, r3 := createTestAttendanceResponse(t, td, tenantID, "C", base, attendance.ResponseTypeAttending, nil, nil)
+	assigned, r4 := createTestAttendanceResponse(t, td, tenantID, "D", base, attendance.ResponseTypeAttending, nil, nil)
+
+	shifts := []shift.AssignedShift{
+		assignedShiftOn(overlapping.MemberID(), targetDate, 21, 23),
+		assignedShiftOn(busy.MemberID(), targetDate.AddDate(0, 0, -3), 20, 22),
+		assignedShiftOn(busy.MemberID(), targetDate.AddDate(0, 0, -5), 20, 22),
+		{
+			AssignmentID: shift.NewAssignmentID(),
+			MemberID:     assigned.MemberID(),
+			SlotID:       slot.SlotID(),
+			TargetDate:   targetDate,
+			StartTime:    slotTime(20, 0),
+			EndTime:      slotTime(22, 0),
+		},
+	}
+
+	usecase := appshift.NewListSlotCandidatesUsecase(
+		&MockShiftSlotRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+				return slot, nil
+			},
+		},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return bd, nil
+			},
+		},
+		&MockShiftAssignmentRepository{
+			findConfirmedShiftsFunc: func(ctx context.Context, tid common.TenantID, memberID *common.MemberID, from, to time.Time) ([]shift.AssignedShift, error) {
+				return shifts, nil
+			},
+		},
+		&MockMemberRepository{
+			findActiveByTenantIDFunc: func(ctx context.Context, tid common.TenantID) ([]*member.Member, error) {
+				return []*member.Member{overlapping, busy, fresh, assigned}, nil
+			},
+		},
+		&MockMemberRoleRepository{},
+		&MockAttendanceCollectionRepository{
+			collections: []*attendance.AttendanceCollection{collection},
+			targetDates: map[common.CollectionID][]*attendance.TargetDate{collection.CollectionID(): {td}},
+			responses:   map[common.CollectionID][]*attendance.AttendanceResponse{collection.CollectionID(): {r1, r2, r3, r4}},
+		},
+		&MockAvailabilityRepository{},
+		&MockWorkloadPolicyRepository{},
+	)
+
+	result, err := usecase.Execute(context.Background(), appshift.ListSlotCandidatesInput{
+		TenantID: tenantID,
+		SlotID:   slot.SlotID(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	ranks := candidateRanks(result)
+	if _, ok := ranks[assigned.MemberID()]; ok {
+		t.Errorf("members already assigned to the slot should be excluded")
+	}
+	if !(ranks[fresh.MemberID()] < ranks[busy.MemberID()] && ranks[busy.MemberID()] < ranks[overlapping.MemberID()]) {
+		t.Errorf("unexpected order: %v", ranks)
+	}
+
+	last := result.Candidates[ranks[overlapping.MemberID()]]
+	if !last.HasOverlap || len(last.ShiftsThatNight) != 1 || last.ShiftsThatNight[0].SlotName != "他の枠" {
+		t.Errorf("overlapping shift should be reported, got %+v", last.ShiftsThatNight)
+	}
+	if got := result.Candidates[ranks[busy.MemberID()]].RecentShiftCount; got != 2 {
+		t.Errorf("expected 2 recent shifts, got %d", got)
+	}
+}
+
+func TestListSlotCandidatesUsecase_Execute_RewardsMissingRoles(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDayOn(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	slot := createTestSlotIn(t, bd, "DJ", slotTime(20, 0), slotTime(22, 0), 1, 1)
+	dj := common.NewRoleID()
+	req, err := shift.NewRoleRequirement(dj, shift.RoleRequirementRequired, 1)
+	if err != nil {
+		t.Fatalf("NewRoleRequirement() should succeed, got error: %v", err)
+	}
+	if err := slot.SetRoleRequirements(time.Now(), []shift.RoleRequirement{req}); err != nil {
+		t.Fatalf("SetRoleRequirements() should succeed, got error: %v", err)
+	}
+	collection, td := createTestAttendanceCollection(t, bd)
+	base := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	withoutRole, r1 := createTestAttendanceResponse(t, td, tenantID, "A", base, attendance.ResponseTypeAttending, nil, nil)
+	withRole, r2 := createTestAttendanceResponse(t, td, tenantID, "B", base, attendance.ResponseTypeAttending, nil, nil)
+
+	usecase := appshift.NewListSlotCandidatesUsecase(
+		&MockShiftSlotRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+				return slot, nil
+			},
+		},
+		&MockBusinessDayRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+				return bd, nil
+			},
+		},
+		&MockShiftAssignmentRepository{},
+		&MockMemberRepository{
+			findActiveByTenantIDFunc: func(ctx context.Context, tid common.TenantID) ([]*member.Member, error) {
+				return []*member.Member{withoutRole, withRole}, nil
+			},
+		},
+		&MockMemberRoleRepository{roles: map[common.MemberID][]common.RoleID{withRole.MemberID(): {dj}}},
+		&MockAttendanceCollectionRepository{
+			collections: []*attendance.AttendanceCollection{collection},
+			targetDates: map[common.CollectionID][]*attendance.TargetDate{collection.CollectionID(): {td}},
+			responses:   map[common.CollectionID][]*attendance.AttendanceResponse{collection.CollectionID(): {r1, r2}},
+		},
+		&MockAvailabilityRepository{},
+		&MockWorkloadPolicyRepository{},
+	)
+
+	result, err := usecase.Execute(context.Background(), appshift.ListSlotCandidatesInput{
+		TenantID: tenantID,
+		SlotID:   slot.SlotID(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() should succeed, got error: %v", err)
+	}
+
+	if result.Candidates[0].Member.MemberID() != withRole.MemberID() {
+		t.Fatalf("member with the required role should rank first, got %s", result.Candidates[0].Reason)
+	}
+	if len(result.Candidates[0].MatchedRoles) != 1 || result.Candidates[0].MatchedRoles[0].RoleID() != dj {
+		t.Errorf("expected the DJ requirement to match, got %+v", result.Candidates[0].MatchedRoles)
+	}
+	second := result.Candidates[1]
+	if second.Member.MemberID() != withoutRole.MemberID() || second.RoleCheck.Satisfiable() {
+		t.Errorf("member without the role should be flagged as a role violation, got %+v", second.RoleCheck)
+	}
+}
+
+func TestListSlotCandidatesUsecase_Execute_ErrorWhenSlotNotFound(t *testing.T) {
+	usecase := appshift.NewListSlotCandidatesUsecase(
+		&MockShiftSlotRepository{
+			findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+				return nil, common.NewNotFoundError("ShiftSlot", slotID.String())
+			},
+		},
+		&MockBusinessDayRepository{},
+		&MockShiftAssignmentRepository{},
+		&MockMemberRepository{},
+		&MockMemberRoleRepository{},
+		&MockAttendanceCollectionRepository{},
+		&MockAvailabilityRepository{},
+		&MockWorkloadPolicyRepository{},
+	)
+
+	_, err := usecase.Execute(context.Background(), appshift.ListSlotCandidatesInput{
+		TenantID: common.NewTenantID(),
+		SlotID:   shift.NewSlotID(),
+	})
+	if !common.IsNotFoundError(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
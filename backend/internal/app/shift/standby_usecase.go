@@ -0,0 +1,653 @@
+package shift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// =====================================================
+// Promotion
+// =====================================================
+
+// standbyPromoter fills vacancies of a slot from its standby list
+// 呼び出し側で ShiftSlotRepository.FindByIDForUpdate により枠をロックしておくこと
+type standbyPromoter struct {
+	standbyRepo    shift.StandbyRepository
+	slotRepo       shift.ShiftSlotRepository
+	assignmentRepo shift.ShiftAssignmentRepository
+	checker        assignmentChecker
+}
+
+// newStandbyPromoter creates a standbyPromoter whose assignments go through the shared assignment checks
+func newStandbyPromoter(
+	standbyRepo shift.StandbyRepository,
+	slotRepo shift.ShiftSlotRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	eventRepo event.EventRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	availabilityRepo member.AvailabilityRepository,
+	workloadPolicyRepo member.WorkloadPolicyRepository,
+) standbyPromoter {
+	return standbyPromoter{
+		standbyRepo:    standbyRepo,
+		slotRepo:       slotRepo,
+		assignmentRepo: assignmentRepo,
+		checker: assignmentChecker{
+			slotRepo:           slotRepo,
+			assignmentRepo:     assignmentRepo,
+			memberRoleRepo:     memberRoleRepo,
+			availabilityRepo:   availabilityRepo,
+			workloadPolicyRepo: workloadPolicyRepo,
+			eventRepo:          eventRepo,
+			businessDayRepo:    businessDayRepo,
+		},
+	}
+}
+
+// fillVacancies promotes or offers the slot to standby members in position order
+//
+// Logic:
+//  1. 回答期限を過ぎたオファーを失効させる
+//  2. 回答待ちのオファーの分は空きを確保済みとして扱う
+//  3. 残りの空きを position の小さい順に埋める（アーカイブ済みのイベントの枠は繰り上げない）
+//     - autoAccept のメンバーは手動確定と同じ検証を通して即座に割り当てる。
+//     時間帯の重複・必須ロール・勤務量の上限で割り当てられない場合は飛ばして待機のまま
+//     - それ以外のメンバーには回答期限付きでオファーする
+//
+// 状態が変わったエントリを返す（通知用）
+func (p standbyPromoter) fillVacancies(ctx context.Context, slot *shift.ShiftSlot, now time.Time) ([]*shift.StandbyEntry, error) {
+	tenantID := slot.TenantID()
+
+	entries, err := p.standbyRepo.FindActiveBySlotID(ctx, tenantID, slot.SlotID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find standby entries: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	var changed []*shift.StandbyEntry
+
+	// 1. 期限切れのオファーを失効させる
+	for _, entry := range entries {
+		if !entry.IsOfferExpired(now) {
+			continue
+		}
+		if err := entry.Expire(now); err != nil {
+			return nil, err
+		}
+		if err := p.standbyRepo.Save(ctx, entry); err != nil {
+			return nil, fmt.Errorf("failed to save standby entry: %w", err)
+		}
+		changed = append(changed, entry)
+	}
+
+	// 2. 空き数の算出（回答待ちのオファーは確保済み）
+	confirmedCount, err := p.assignmentRepo.CountConfirmedBySlotID(ctx, tenantID, slot.SlotID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count assignments: %w", err)
+	}
+	vacancies := slot.RequiredCount() - confirmedCount
+	for _, entry := range entries {
+		if entry.IsOffered() {
+			vacancies--
+		}
+	}
+
+	if vacancies <= 0 {
+		return changed, nil
+	}
+
+	// 3. 待機中のメンバーで空きを埋める
+	if _, err := p.checker.ensureAssignable(ctx, slot); err != nil {
+		var domainErr *common.DomainError
+		if errors.As(err, &domainErr) && domainErr.Code() == common.ErrConflict {
+			return changed, nil
+		}
+		return nil, err
+	}
+	for _, entry := range entries {
+		if vacancies <= 0 {
+			break
+		}
+		if !entry.IsWaiting() {
+			continue
+		}
+
+		if !entry.AutoAccept() {
+			if err := entry.Offer(now, now.Add(shift.DefaultStandbyOfferWindow)); err != nil {
+				return nil, err
+			}
+		} else {
+			assignment, err := p.assign(ctx, slot, entry.MemberID(), now)
+			if err != nil {
+				if isMemberAssignmentRejection(err) {
+					continue
+				}
+				return nil, err
+			}
+			if assignment == nil {
+				// 既に同じ枠に割り当て済みのメンバーは空き待ちから外す
+				if err := entry.Withdraw(now); err != nil {
+					return nil, err
+				}
+			} else if err := entry.Promote(now, assignment.AssignmentID()); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := p.standbyRepo.Save(ctx, entry); err != nil {
+			return nil, fmt.Errorf("failed to save standby entry: %w", err)
+		}
+		changed = append(changed, entry)
+		if entry.Status() != shift.StandbyStatusWithdrawn {
+			vacancies--
+		}
+	}
+
+	return changed, nil
+}
+
+// assign creates a confirmed assignment for the standby member
+// 既に同じ枠に割り当て済みの場合は nil を返す。手動確定と同じ検証（assignmentChecker）に失敗した場合はそのエラーを返す
+func (p standbyPromoter) assign(
+	ctx context.Context,
+	slot *shift.ShiftSlot,
+	memberID common.MemberID,
+	now time.Time,
+) (*shift.ShiftAssignment, error) {
+	tenantID := slot.TenantID()
+
+	exists, err := p.assignmentRepo.ExistsBySlotIDAndMemberID(ctx, tenantID, slot.SlotID(), memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing assignment: %w", err)
+	}
+	if exists {
+		return nil, nil
+	}
+
+	if _, err := p.checker.check(ctx, slot, memberID, assignmentCheckOptions{}); err != nil {
+		return nil, err
+	}
+
+	var nilPlanID shift.PlanID // Zero value (treated as NULL)
+	assignment, err := shift.NewShiftAssignment(
+		now,
+		tenantID,
+		nilPlanID,
+		slot.SlotID(),
+		memberID,
+		shift.AssignmentMethodManual,
+		false, // is_outside_preference
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create shift assignment: %w", err)
+	}
+	if err := p.assignmentRepo.Save(ctx, assignment); err != nil {
+		return nil, fmt.Errorf("failed to save shift assignment: %w", err)
+	}
+
+	return assignment, nil
+}
+
+// lockStandbyEntry locks the slot of the standby entry and returns the latest entry state
+func lockStandbyEntry(
+	ctx context.Context,
+	standbyRepo shift.StandbyRepository,
+	slotRepo shift.ShiftSlotRepository,
+	tenantID common.TenantID,
+	standbyID shift.StandbyID,
+) (*shift.StandbyEntry, *shift.ShiftSlot, error) {
+	entry, err := standbyRepo.FindByID(ctx, tenantID, standbyID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	slot, err := slotRepo.FindByIDForUpdate(ctx, tenantID, entry.SlotID())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find shift slot: %w", err)
+	}
+
+	// ロック取得までに他のトランザクションで更新されている可能性があるため再取得する
+	entry, err = standbyRepo.FindByID(ctx, tenantID, standbyID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return entry, slot, nil
+}
+
+func logStandbyChanges(entries []*shift.StandbyEntry) {
+	for _, entry := range entries {
+		switch entry.Status() {
+		case shift.StandbyStatusPromoted:
+			log.Printf("[Notification Stub] 空き待ち繰り上げ通知: standby_id=%s, member=%s, slot=%s, assignment_id=%s",
+				entry.StandbyID().String(),
+				entry.MemberID().String(),
+				entry.SlotID().String(),
+				entry.AssignmentID().String(),
+			)
+		case shift.StandbyStatusOffered:
+			log.Printf("[Notification Stub] 空き待ちオファー通知: standby_id=%s, member=%s, slot=%s, expires_at=%s",
+				entry.StandbyID().String(),
+				entry.MemberID().String(),
+				entry.SlotID().String(),
+				entry.OfferExpiresAt().Format("2006-01-02 15:04:05"),
+			)
+		}
+	}
+}
+
+// =====================================================
+// Join / List
+// =====================================================
+
+// JoinStandbyInput represents the input for adding a member to a slot's standby list
+type JoinStandbyInput struct {
+	TenantID common.TenantID
+	SlotID   shift.SlotID
+	MemberID common.MemberID
+	// ActorMemberID はメンバー本人が登録する場合に指定する（管理者が代理で登録する場合は nil）
+	ActorMemberID *common.MemberID
+	AutoAccept    bool
+	Note          string
+}
+
+// JoinStandbyUsecase handles adding a member to the end of a slot's standby list
+type JoinStandbyUsecase struct {
+	promoter   standbyPromoter
+	memberRepo member.MemberRepository
+	txManager  services.TxManager
+	clock      services.Clock
+}
+
+// NewJoinStandbyUsecase creates a new JoinStandbyUsecase
+func NewJoinStandbyUsecase(
+	standbyRepo shift.StandbyRepository,
+	slotRepo shift.ShiftSlotRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	eventRepo event.EventRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	availabilityRepo member.AvailabilityRepository,
+	workloadPolicyRepo member.WorkloadPolicyRepository,
+	memberRepo member.MemberRepository,
+	txManager services.TxManager,
+	clock services.Clock,
+) *JoinStandbyUsecase {
+	return &JoinStandbyUsecase{
+		promoter: newStandbyPromoter(
+			standbyRepo, slotRepo, assignmentRepo, businessDayRepo,
+			eventRepo, memberRoleRepo, availabilityRepo, workloadPolicyRepo,
+		),
+		memberRepo: memberRepo,
+		txManager:  txManager,
+		clock:      clock,
+	}
+}
+
+// Execute adds the member to the standby list
+// 枠に空きがある場合は登録と同時に繰り上げ（またはオファー）を行う
+func (uc *JoinStandbyUsecase) Execute(ctx context.Context, input JoinStandbyInput) (*shift.StandbyEntry, error) {
+	// メンバー本人は自分のみ登録できる
+	if input.ActorMemberID != nil && *input.ActorMemberID != input.MemberID {
+		return nil, common.NewUnauthorizedError("members can only add themselves to the standby list")
+	}
+
+	var (
+		entry   *shift.StandbyEntry
+		changed []*shift.StandbyEntry
+	)
+	err := uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		slot, err := uc.promoter.slotRepo.FindByIDForUpdate(txCtx, input.TenantID, input.SlotID)
+		if err != nil {
+			return fmt.Errorf("failed to find shift slot: %w", err)
+		}
+
+		memberEntity, err := uc.memberRepo.FindByID(txCtx, input.TenantID, input.MemberID)
+		if err != nil {
+			return fmt.Errorf("failed to find member: %w", err)
+		}
+		if !memberEntity.IsActive() {
+			return common.NewValidationError("inactive members cannot be added to the standby list", nil)
+		}
+
+		// 割り当て済み・登録済みのメンバーは登録できない
+		assigned, err := uc.promoter.assignmentRepo.ExistsBySlotIDAndMemberID(txCtx, input.TenantID, input.SlotID, input.MemberID)
+		if err != nil {
+			return fmt.Errorf("failed to check existing assignment: %w", err)
+		}
+		if assigned {
+			return shift.ErrAlreadyOnStandby
+		}
+
+		existing, err := uc.promoter.standbyRepo.FindBySlotID(txCtx, input.TenantID, input.SlotID)
+		if err != nil {
+			return fmt.Errorf("failed to find standby entries: %w", err)
+		}
+		position := 1
+		for _, e := range existing {
+			if e.IsActive() && e.MemberID() == input.MemberID {
+				return shift.ErrAlreadyOnStandby
+			}
+			if e.Position() >= position {
+				position = e.Position() + 1
+			}
+		}
+
+		now := uc.clock.Now()
+		entry, err = shift.NewStandbyEntry(now, input.TenantID, input.SlotID, input.MemberID, position, input.AutoAccept, input.Note)
+		if err != nil {
+			return err
+		}
+		if err := uc.promoter.standbyRepo.Save(txCtx, entry); err != nil {
+			return fmt.Errorf("failed to save standby entry: %w", err)
+		}
+
+		changed, err = uc.promoter.fillVacancies(txCtx, slot, now)
+		if err != nil {
+			return err
+		}
+
+		// 繰り上げで状態が変わった場合は最新の状態を返す
+		for _, c := range changed {
+			if c.StandbyID() == entry.StandbyID() {
+				entry = c
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logStandbyChanges(changed)
+
+	return entry, nil
+}
+
+// ListStandbyInput represents the input for listing a slot's standby entries
+type ListStandbyInput struct {
+	TenantID common.TenantID
+	SlotID   shift.SlotID
+}
+
+// ListStandbyUsecase handles listing a slot's standby entries (history included)
+type ListStandbyUsecase struct {
+	standbyRepo shift.StandbyRepository
+	slotRepo    shift.ShiftSlotRepository
+}
+
+// NewListStandbyUsecase creates a new ListStandbyUsecase
+func NewListStandbyUsecase(standbyRepo shift.StandbyRepository, slotRepo shift.ShiftSlotRepository) *ListStandbyUsecase {
+	return &ListStandbyUsecase{
+		standbyRepo: standbyRepo,
+		slotRepo:    slotRepo,
+	}
+}
+
+// Execute returns the standby entries in position order
+func (uc *ListStandbyUsecase) Execute(ctx context.Context, input ListStandbyInput) ([]*shift.StandbyEntry, error) {
+	// 枠の存在確認（テナント境界チェックを含む）
+	if _, err := uc.slotRepo.FindByID(ctx, input.TenantID, input.SlotID); err != nil {
+		return nil, err
+	}
+
+	return uc.standbyRepo.FindBySlotID(ctx, input.TenantID, input.SlotID)
+}
+
+// =====================================================
+// Offer response / Withdraw
+// =====================================================
+
+// StandbyActionInput represents the input for acting on a standby entry
+type StandbyActionInput struct {
+	TenantID  common.TenantID
+	StandbyID shift.StandbyID
+	// ActorMemberID はメンバー本人が操作する場合に指定する（管理者が代理で操作する場合は nil）
+	ActorMemberID *common.MemberID
+}
+
+func (in StandbyActionInput) authorize(entry *shift.StandbyEntry) error {
+	if in.ActorMemberID != nil && *in.ActorMemberID != entry.MemberID() {
+		return common.NewUnauthorizedError("only the standby member can respond to this entry")
+	}
+	return nil
+}
+
+// AcceptStandbyOfferUsecase handles accepting an offered slot
+type AcceptStandbyOfferUsecase struct {
+	promoter  standbyPromoter
+	txManager services.TxManager
+	clock     services.Clock
+}
+
+// NewAcceptStandbyOfferUsecase creates a new AcceptStandbyOfferUsecase
+func NewAcceptStandbyOfferUsecase(
+	standbyRepo shift.StandbyRepository,
+	slotRepo shift.ShiftSlotRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	eventRepo event.EventRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	availabilityRepo member.AvailabilityRepository,
+	workloadPolicyRepo member.WorkloadPolicyRepository,
+	txManager services.TxManager,
+	clock services.Clock,
+) *AcceptStandbyOfferUsecase {
+	return &AcceptStandbyOfferUsecase{
+		promoter: newStandbyPromoter(
+			standbyRepo, slotRepo, assignmentRepo, businessDayRepo,
+			eventRepo, memberRoleRepo, availabilityRepo, workloadPolicyRepo,
+		),
+		txManager: txManager,
+		clock:     clock,
+	}
+}
+
+// Execute confirms the assignment for the offered standby member
+// 回答期限を過ぎていた場合はオファーを失効させて次の待機者に回し、ErrStandbyOfferExpired を返す
+func (uc *AcceptStandbyOfferUsecase) Execute(ctx context.Context, input StandbyActionInput) (*shift.StandbyEntry, error) {
+	var (
+		entry   *shift.StandbyEntry
+		changed []*shift.StandbyEntry
+		expired bool
+	)
+	err := uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		var slot *shift.ShiftSlot
+		var err error
+		entry, slot, err = lockStandbyEntry(txCtx, uc.promoter.standbyRepo, uc.promoter.slotRepo, input.TenantID, input.StandbyID)
+		if err != nil {
+			return err
+		}
+		if err := input.authorize(entry); err != nil {
+			return err
+		}
+		if !entry.IsOffered() {
+			return shift.ErrStandbyNotOffered
+		}
+
+		now := uc.clock.Now()
+
+		// 期限切れの場合は失効を確定させるためトランザクションはコミットする
+		if entry.IsOfferExpired(now) {
+			expired = true
+			changed, err = uc.promoter.fillVacancies(txCtx, slot, now)
+			return err
+		}
+
+		assignment, err := uc.promoter.assign(txCtx, slot, entry.MemberID(), now)
+		if err != nil {
+			return err
+		}
+		if assignment == nil {
+			return shift.ErrAlreadyOnStandby
+		}
+
+		if err := entry.Promote(now, assignment.AssignmentID()); err != nil {
+			return err
+		}
+		if err := uc.promoter.standbyRepo.Save(txCtx, entry); err != nil {
+			return fmt.Errorf("failed to save standby entry: %w", err)
+		}
+		changed = []*shift.StandbyEntry{entry}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logStandbyChanges(changed)
+
+	if expired {
+		return nil, shift.ErrStandbyOfferExpired
+	}
+	return entry, nil
+}
+
+// DeclineStandbyOfferUsecase handles declining an offered slot
+type DeclineStandbyOfferUsecase struct {
+	promoter  standbyPromoter
+	txManager services.TxManager
+	clock     services.Clock
+}
+
+// NewDeclineStandbyOfferUsecase creates a new DeclineStandbyOfferUsecase
+func NewDeclineStandbyOfferUsecase(
+	standbyRepo shift.StandbyRepository,
+	slotRepo shift.ShiftSlotRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	eventRepo event.EventRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	availabilityRepo member.AvailabilityRepository,
+	workloadPolicyRepo member.WorkloadPolicyRepository,
+	txManager services.TxManager,
+	clock services.Clock,
+) *DeclineStandbyOfferUsecase {
+	return &DeclineStandbyOfferUsecase{
+		promoter: newStandbyPromoter(
+			standbyRepo, slotRepo, assignmentRepo, businessDayRepo,
+			eventRepo, memberRoleRepo, availabilityRepo, workloadPolicyRepo,
+		),
+		txManager: txManager,
+		clock:     clock,
+	}
+}
+
+// Execute declines the offer and passes the slot to the next standby member
+func (uc *DeclineStandbyOfferUsecase) Execute(ctx context.Context, input StandbyActionInput) (*shift.StandbyEntry, error) {
+	var (
+		entry   *shift.StandbyEntry
+		changed []*shift.StandbyEntry
+	)
+	err := uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		var slot *shift.ShiftSlot
+		var err error
+		entry, slot, err = lockStandbyEntry(txCtx, uc.promoter.standbyRepo, uc.promoter.slotRepo, input.TenantID, input.StandbyID)
+		if err != nil {
+			return err
+		}
+		if err := input.authorize(entry); err != nil {
+			return err
+		}
+
+		now := uc.clock.Now()
+		if err := entry.Decline(now); err != nil {
+			return err
+		}
+		if err := uc.promoter.standbyRepo.Save(txCtx, entry); err != nil {
+			return fmt.Errorf("failed to save standby entry: %w", err)
+		}
+
+		changed, err = uc.promoter.fillVacancies(txCtx, slot, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logStandbyChanges(changed)
+
+	return entry, nil
+}
+
+// WithdrawStandbyUsecase handles removing a member from the standby list
+type WithdrawStandbyUsecase struct {
+	promoter  standbyPromoter
+	txManager services.TxManager
+	clock     services.Clock
+}
+
+// NewWithdrawStandbyUsecase creates a new WithdrawStandbyUsecase
+func NewWithdrawStandbyUsecase(
+	standbyRepo shift.StandbyRepository,
+	slotRepo shift.ShiftSlotRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	eventRepo event.EventRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	availabilityRepo member.AvailabilityRepository,
+	workloadPolicyRepo member.WorkloadPolicyRepository,
+	txManager services.TxManager,
+	clock services.Clock,
+) *WithdrawStandbyUsecase {
+	return &WithdrawStandbyUsecase{
+		promoter: newStandbyPromoter(
+			standbyRepo, slotRepo, assignmentRepo, businessDayRepo,
+			eventRepo, memberRoleRepo, availabilityRepo, workloadPolicyRepo,
+		),
+		txManager: txManager,
+		clock:     clock,
+	}
+}
+
+// Execute withdraws the entry; a held offer is passed to the next standby member
+func (uc *WithdrawStandbyUsecase) Execute(ctx context.Context, input StandbyActionInput) (*shift.StandbyEntry, error) {
+	var (
+		entry   *shift.StandbyEntry
+		changed []*shift.StandbyEntry
+	)
+	err := uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		var slot *shift.ShiftSlot
+		var err error
+		entry, slot, err = lockStandbyEntry(txCtx, uc.promoter.standbyRepo, uc.promoter.slotRepo, input.TenantID, input.StandbyID)
+		if err != nil {
+			return err
+		}
+		if err := input.authorize(entry); err != nil {
+			return err
+		}
+
+		now := uc.clock.Now()
+		wasOffered := entry.IsOffered()
+		if err := entry.Withdraw(now); err != nil {
+			return err
+		}
+		if err := uc.promoter.standbyRepo.Save(txCtx, entry); err != nil {
+			return fmt.Errorf("failed to save standby entry: %w", err)
+		}
+
+		if wasOffered {
+			changed, err = uc.promoter.fillVacancies(txCtx, slot, now)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logStandbyChanges(changed)
+
+	return entry, nil
+}
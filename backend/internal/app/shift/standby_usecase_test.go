@@ -0,0 +1,747 @@
+package shift_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	appshift "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// =====================================================
+// Mock StandbyRepository
+// =====================================================
+
+type MockStandbyRepository struct {
+	entries map[shift.StandbyID]*shift.StandbyEntry
+}
+
+func (m *MockStandbyRepository) Save(ctx context.Context, entry *shift.StandbyEntry) error {
+	m.entries[entry.StandbyID()] = entry
+	return nil
+}
+
+func (m *MockStandbyRepository) FindByID(ctx context.Context, tenantID common.TenantID, standbyID shift.StandbyID) (*shift.StandbyEntry, error) {
+	if entry, ok := m.entries[standbyID]; ok && entry.TenantID() == tenantID {
+		return entry, nil
+	}
+	return nil, common.NewNotFoundError("StandbyEntry", standbyID.String())
+}
+
+func (m *MockStandbyRepository) FindBySlotID(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) ([]*shift.StandbyEntry, error) {
+	var result []*shift.StandbyEntry
+	for _, entry := range m.entries {
+		if entry.TenantID() == tenantID && entry.SlotID() == slotID {
+			result = append(result, entry)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Position() < result[j].Position() })
+	return result, nil
+}
+
+func (m *MockStandbyRepository) FindActiveBySlotID(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) ([]*shift.StandbyEntry, error) {
+	all, _ := m.FindBySlotID(ctx, tenantID, slotID)
+	var result []*shift.StandbyEntry
+	for _, entry := range all {
+		if entry.IsActive() {
+			result = append(result, entry)
+		}
+	}
+	return result, nil
+}
+
+// =====================================================
+// Helper functions
+// =====================================================
+
+func createTestStandbyEntry(t *testing.T, slot *shift.ShiftSlot, memberID common.MemberID, position int, autoAccept bool) *shift.StandbyEntry {
+	t.Helper()
+	entry, err := shift.NewStandbyEntry(time.Now(), slot.TenantID(), slot.SlotID(), memberID, position, autoAccept, "")
+	if err != nil {
+		t.Fatalf("Failed to create standby entry: %v", err)
+	}
+	return entry
+}
+
+// =====================================================
+// JoinStandbyUsecase Tests
+// =====================================================
+
+func TestJoinStandbyUsecase_AppendsInOrder(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	_, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	existing := createTestStandbyEntry(t, slot, common.NewMemberID(), 1, false)
+
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return slot, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		countConfirmedBySlotFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (int, error) {
+			return 1, nil // 満員
+		},
+	}
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memberID common.MemberID) (*member.Member, error) {
+			return createTestMember(t, tid), nil
+		},
+	}
+	standbyRepo := &MockStandbyRepository{entries: map[shift.StandbyID]*shift.StandbyEntry{existing.StandbyID(): existing}}
+
+	uc := appshift.NewJoinStandbyUsecase(
+		standbyRepo, slotRepo, assignmentRepo, &MockBusinessDayRepository{},
+		&MockEventRepository{}, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		memberRepo, &MockTxManager{}, &MockClock{now: now},
+	)
+	entry, err := uc.Execute(context.Background(), appshift.JoinStandbyInput{
+		TenantID:   tenantID,
+		SlotID:     slot.SlotID(),
+		MemberID:   common.NewMemberID(),
+		AutoAccept: true,
+	})
+	if err != nil {
+		t.Fatalf("JoinStandby should succeed, got error: %v", err)
+	}
+
+	if entry.Position() != 2 {
+		t.Errorf("expected position 2, got %d", entry.Position())
+	}
+	if !entry.IsWaiting() {
+		t.Errorf("entry should wait while the slot is full, got %s", entry.Status())
+	}
+}
+
+func TestJoinStandbyUsecase_ErrorWhenAlreadyOnStandby(t *testing.T) {
+	tenantID := common.NewTenantID()
+	_, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	memberID := common.NewMemberID()
+	existing := createTestStandbyEntry(t, slot, memberID, 1, false)
+
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return slot, nil
+		},
+	}
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, mid common.MemberID) (*member.Member, error) {
+			return createTestMember(t, tid), nil
+		},
+	}
+	standbyRepo := &MockStandbyRepository{entries: map[shift.StandbyID]*shift.StandbyEntry{existing.StandbyID(): existing}}
+
+	uc := appshift.NewJoinStandbyUsecase(
+		standbyRepo, slotRepo, &MockShiftAssignmentRepository{}, &MockBusinessDayRepository{},
+		&MockEventRepository{}, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		memberRepo, &MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	_, err := uc.Execute(context.Background(), appshift.JoinStandbyInput{
+		TenantID: tenantID,
+		SlotID:   slot.SlotID(),
+		MemberID: memberID,
+	})
+	if !errors.Is(err, shift.ErrAlreadyOnStandby) {
+		t.Errorf("expected ErrAlreadyOnStandby, got %v", err)
+	}
+}
+
+func TestJoinStandbyUsecase_ErrorWhenJoiningForOtherMember(t *testing.T) {
+	tenantID := common.NewTenantID()
+	actor := common.NewMemberID()
+
+	uc := appshift.NewJoinStandbyUsecase(
+		&MockStandbyRepository{entries: map[shift.StandbyID]*shift.StandbyEntry{}},
+		&MockShiftSlotRepository{}, &MockShiftAssignmentRepository{}, &MockBusinessDayRepository{},
+		&MockEventRepository{}, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockMemberRepository{}, &MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	_, err := uc.Execute(context.Background(), appshift.JoinStandbyInput{
+		TenantID:      tenantID,
+		SlotID:        shift.NewSlotID(),
+		MemberID:      common.NewMemberID(),
+		ActorMemberID: &actor,
+	})
+
+	var domainErr *common.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code() != common.ErrUnauthorized {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+}
+
+func TestJoinStandbyUsecase_PromotesImmediatelyWhenSlotHasVacancy(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	memberID := common.NewMemberID()
+
+	var saved *shift.ShiftAssignment
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return slot, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+			saved = a
+			return nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return bd, nil
+		},
+	}
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, mid common.MemberID) (*member.Member, error) {
+			return createTestMember(t, tid), nil
+		},
+	}
+
+	uc := appshift.NewJoinStandbyUsecase(
+		&MockStandbyRepository{entries: map[shift.StandbyID]*shift.StandbyEntry{}}, slotRepo, assignmentRepo, businessDayRepo,
+		&MockEventRepository{}, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		memberRepo, &MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	entry, err := uc.Execute(context.Background(), appshift.JoinStandbyInput{
+		TenantID:   tenantID,
+		SlotID:     slot.SlotID(),
+		MemberID:   memberID,
+		AutoAccept: true,
+	})
+	if err != nil {
+		t.Fatalf("JoinStandby should succeed, got error: %v", err)
+	}
+
+	if entry.Status() != shift.StandbyStatusPromoted {
+		t.Errorf("expected promoted, got %s", entry.Status())
+	}
+	if saved == nil || saved.MemberID() != memberID {
+		t.Errorf("standby member should be assigned")
+	}
+}
+
+// =====================================================
+// CancelAssignmentUsecase promotion Tests
+// =====================================================
+
+func TestCancelAssignmentUsecase_PromotesFirstStandby(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	assignment := createTestAssignment(t, slot, common.NewMemberID())
+	first := createTestStandbyEntry(t, slot, common.NewMemberID(), 1, true)
+	second := createTestStandbyEntry(t, slot, common.NewMemberID(), 2, true)
+
+	confirmed := 1
+	var saved *shift.ShiftAssignment
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return slot, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) (*shift.ShiftAssignment, error) {
+			return assignment, nil
+		},
+		deleteFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) error {
+			confirmed--
+			return nil
+		},
+		countConfirmedBySlotFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (int, error) {
+			return confirmed, nil
+		},
+		saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+			saved = a
+			confirmed++
+			return nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return bd, nil
+		},
+	}
+	standbyRepo := &MockStandbyRepository{entries: map[shift.StandbyID]*shift.StandbyEntry{first.StandbyID(): first, second.StandbyID(): second}}
+
+	uc := appshift.NewCancelAssignmentUsecase(
+		assignmentRepo, slotRepo, standbyRepo, businessDayRepo,
+		&MockEventRepository{}, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	if err := uc.Execute(context.Background(), appshift.CancelAssignmentInput{
+		TenantID:     tenantID,
+		AssignmentID: assignment.AssignmentID(),
+	}); err != nil {
+		t.Fatalf("CancelAssignment should succeed, got error: %v", err)
+	}
+
+	if first.Status() != shift.StandbyStatusPromoted || first.AssignmentID() == nil {
+		t.Errorf("first standby should be promoted, got %s", first.Status())
+	}
+	if !second.IsWaiting() {
+		t.Errorf("second standby should keep waiting, got %s", second.Status())
+	}
+	if saved == nil || saved.MemberID() != first.MemberID() {
+		t.Errorf("first standby member should be assigned")
+	}
+}
+
+func TestCancelAssignmentUsecase_OffersWithDeadline(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	bd, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	assignment := createTestAssignment(t, slot, common.NewMemberID())
+	first := createTestStandbyEntry(t, slot, common.NewMemberID(), 1, false)
+
+	confirmed := 1
+	var saved *shift.ShiftAssignment
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return slot, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) (*shift.ShiftAssignment, error) {
+			return assignment, nil
+		},
+		deleteFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) error {
+			confirmed--
+			return nil
+		},
+		countConfirmedBySlotFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (int, error) {
+			return confirmed, nil
+		},
+		saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+			saved = a
+			confirmed++
+			return nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return bd, nil
+		},
+	}
+	standbyRepo := &MockStandbyRepository{entries: map[shift.StandbyID]*shift.StandbyEntry{first.StandbyID(): first}}
+
+	cancel := appshift.NewCancelAssignmentUsecase(
+		assignmentRepo, slotRepo, standbyRepo, businessDayRepo,
+		&MockEventRepository{}, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: now},
+	)
+	if err := cancel.Execute(context.Background(), appshift.CancelAssignmentInput{
+		TenantID:     tenantID,
+		AssignmentID: assignment.AssignmentID(),
+	}); err != nil {
+		t.Fatalf("CancelAssignment should succeed, got error: %v", err)
+	}
+
+	if !first.IsOffered() {
+		t.Fatalf("first standby should be offered, got %s", first.Status())
+	}
+	if want := now.Add(shift.DefaultStandbyOfferWindow); !first.OfferExpiresAt().Equal(want) {
+		t.Errorf("offer deadline: expected %s, got %s", want, first.OfferExpiresAt())
+	}
+	if saved != nil {
+		t.Errorf("slot should stay vacant until the offer is accepted")
+	}
+
+	accept := appshift.NewAcceptStandbyOfferUsecase(
+		standbyRepo, slotRepo, assignmentRepo, businessDayRepo,
+		&MockEventRepository{}, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: now.Add(time.Hour)},
+	)
+	accepted, err := accept.Execute(context.Background(), appshift.StandbyActionInput{
+		TenantID:  tenantID,
+		StandbyID: first.StandbyID(),
+	})
+	if err != nil {
+		t.Fatalf("AcceptStandbyOffer should succeed, got error: %v", err)
+	}
+	if accepted.Status() != shift.StandbyStatusPromoted {
+		t.Errorf("expected promoted, got %s", accepted.Status())
+	}
+	if saved == nil || saved.MemberID() != first.MemberID() {
+		t.Errorf("standby member should be assigned after accepting")
+	}
+}
+
+func TestCancelAssignmentUsecase_SkipsStandbyWithOverlappingShift(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	assignment := createTestAssignment(t, slot, common.NewMemberID())
+	first := createTestStandbyEntry(t, slot, common.NewMemberID(), 1, true)
+	second := createTestStandbyEntry(t, slot, common.NewMemberID(), 2, false)
+
+	// 待機1は同じ時間帯の別の枠に割り当て済み
+	other, err := shift.NewShiftSlot(time.Now(), tenantID, bd.BusinessDayID(), nil, "案内", "", slot.StartTime(), slot.EndTime(), 1, 1)
+	if err != nil {
+		t.Fatalf("Failed to create shift slot: %v", err)
+	}
+	busy := createTestAssignment(t, other, first.MemberID())
+
+	confirmed := 1
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			if slotID == other.SlotID() {
+				return other, nil
+			}
+			return slot, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) (*shift.ShiftAssignment, error) {
+			return assignment, nil
+		},
+		deleteFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) error {
+			confirmed--
+			return nil
+		},
+		countConfirmedBySlotFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (int, error) {
+			return confirmed, nil
+		},
+		findByBusinessDayIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftAssignment, error) {
+			return []*shift.ShiftAssignment{busy}, nil
+		},
+		saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+			t.Errorf("no assignment should be created, got one for %s", a.MemberID())
+			return nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return bd, nil
+		},
+		findByTenantIDAndDateFunc: func(ctx context.Context, tid common.TenantID, date time.Time) ([]*event.EventBusinessDay, error) {
+			if date.Equal(bd.TargetDate()) {
+				return []*event.EventBusinessDay{bd}, nil
+			}
+			return nil, nil
+		},
+	}
+	standbyRepo := &MockStandbyRepository{entries: map[shift.StandbyID]*shift.StandbyEntry{first.StandbyID(): first, second.StandbyID(): second}}
+
+	uc := appshift.NewCancelAssignmentUsecase(
+		assignmentRepo, slotRepo, standbyRepo, businessDayRepo,
+		&MockEventRepository{}, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	if err := uc.Execute(context.Background(), appshift.CancelAssignmentInput{
+		TenantID:     tenantID,
+		AssignmentID: assignment.AssignmentID(),
+	}); err != nil {
+		t.Fatalf("CancelAssignment should succeed, got error: %v", err)
+	}
+
+	if !first.IsWaiting() {
+		t.Errorf("standby with an overlapping shift should keep waiting, got %s", first.Status())
+	}
+	if !second.IsOffered() {
+		t.Errorf("next standby should be offered, got %s", second.Status())
+	}
+}
+
+func TestCancelAssignmentUsecase_SkipsStandbyExceedingWorkloadLimit(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	assignment := createTestAssignment(t, slot, common.NewMemberID())
+	first := createTestStandbyEntry(t, slot, common.NewMemberID(), 1, true)
+	second := createTestStandbyEntry(t, slot, common.NewMemberID(), 2, true)
+
+	// 待機1だけ1日60分までに制限されている（枠は2時間）
+	maxMinutes := 60
+	memberID := first.MemberID()
+	policy, err := member.NewWorkloadPolicy(time.Now(), tenantID, &memberID, member.WorkloadLimits{MaxMinutesPerBusinessDay: &maxMinutes}, member.WorkloadEnforcementBlock)
+	if err != nil {
+		t.Fatalf("Failed to create workload policy: %v", err)
+	}
+
+	confirmed := 1
+	var saved *shift.ShiftAssignment
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return slot, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) (*shift.ShiftAssignment, error) {
+			return assignment, nil
+		},
+		deleteFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) error {
+			confirmed--
+			return nil
+		},
+		countConfirmedBySlotFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (int, error) {
+			return confirmed, nil
+		},
+		saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+			saved = a
+			confirmed++
+			return nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return bd, nil
+		},
+	}
+	standbyRepo := &MockStandbyRepository{entries: map[shift.StandbyID]*shift.StandbyEntry{first.StandbyID(): first, second.StandbyID(): second}}
+	workloadPolicyRepo := &MockWorkloadPolicyRepository{memberPolicies: map[common.MemberID]*member.WorkloadPolicy{memberID: policy}}
+
+	uc := appshift.NewCancelAssignmentUsecase(
+		assignmentRepo, slotRepo, standbyRepo, businessDayRepo,
+		&MockEventRepository{}, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, workloadPolicyRepo,
+		&MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	if err := uc.Execute(context.Background(), appshift.CancelAssignmentInput{
+		TenantID:     tenantID,
+		AssignmentID: assignment.AssignmentID(),
+	}); err != nil {
+		t.Fatalf("CancelAssignment should succeed, got error: %v", err)
+	}
+
+	if !first.IsWaiting() {
+		t.Errorf("standby over the workload limit should keep waiting, got %s", first.Status())
+	}
+	if second.Status() != shift.StandbyStatusPromoted || saved == nil || saved.MemberID() != second.MemberID() {
+		t.Errorf("next standby should be promoted, got %s", second.Status())
+	}
+}
+
+func TestCancelAssignmentUsecase_DoesNotPromoteWhenEventArchived(t *testing.T) {
+	tenantID := common.NewTenantID()
+	bd, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	assignment := createTestAssignment(t, slot, common.NewMemberID())
+	first := createTestStandbyEntry(t, slot, common.NewMemberID(), 1, true)
+
+	deleted := false
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return slot, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) (*shift.ShiftAssignment, error) {
+			return assignment, nil
+		},
+		deleteFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) error {
+			deleted = true
+			return nil
+		},
+		saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+			t.Error("no assignment should be created for an archived event")
+			return nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return bd, nil
+		},
+	}
+	eventRepo := &MockEventRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) (*event.Event, error) {
+			e, err := event.NewEvent(time.Now(), tid, "Archived Event", event.EventTypeNormal, "", event.RecurrenceTypeNone, nil, nil, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			return e, e.Archive(time.Now())
+		},
+	}
+	standbyRepo := &MockStandbyRepository{entries: map[shift.StandbyID]*shift.StandbyEntry{first.StandbyID(): first}}
+
+	uc := appshift.NewCancelAssignmentUsecase(
+		assignmentRepo, slotRepo, standbyRepo, businessDayRepo,
+		eventRepo, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	if err := uc.Execute(context.Background(), appshift.CancelAssignmentInput{
+		TenantID:     tenantID,
+		AssignmentID: assignment.AssignmentID(),
+	}); err != nil {
+		t.Fatalf("CancelAssignment should succeed for an archived event, got error: %v", err)
+	}
+
+	if !deleted {
+		t.Error("assignment should be cancelled")
+	}
+	if !first.IsWaiting() {
+		t.Errorf("standby should not be promoted for an archived event, got %s", first.Status())
+	}
+}
+
+// =====================================================
+// Offer response Tests
+// =====================================================
+
+func TestDeclineStandbyOfferUsecase_OffersNext(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	bd, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	first := createTestStandbyEntry(t, slot, common.NewMemberID(), 1, false)
+	second := createTestStandbyEntry(t, slot, common.NewMemberID(), 2, false)
+	if err := first.Offer(now, now.Add(shift.DefaultStandbyOfferWindow)); err != nil {
+		t.Fatalf("Offer should succeed, got error: %v", err)
+	}
+
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return slot, nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return bd, nil
+		},
+	}
+	standbyRepo := &MockStandbyRepository{entries: map[shift.StandbyID]*shift.StandbyEntry{first.StandbyID(): first, second.StandbyID(): second}}
+
+	decline := appshift.NewDeclineStandbyOfferUsecase(
+		standbyRepo, slotRepo, &MockShiftAssignmentRepository{}, businessDayRepo,
+		&MockEventRepository{}, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: now},
+	)
+	if _, err := decline.Execute(context.Background(), appshift.StandbyActionInput{
+		TenantID:  tenantID,
+		StandbyID: first.StandbyID(),
+	}); err != nil {
+		t.Fatalf("DeclineStandbyOffer should succeed, got error: %v", err)
+	}
+
+	if first.Status() != shift.StandbyStatusDeclined {
+		t.Errorf("expected declined, got %s", first.Status())
+	}
+	if !second.IsOffered() {
+		t.Errorf("next standby should be offered, got %s", second.Status())
+	}
+}
+
+func TestAcceptStandbyOfferUsecase_ErrorWhenExpired(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	bd, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	first := createTestStandbyEntry(t, slot, common.NewMemberID(), 1, false)
+	second := createTestStandbyEntry(t, slot, common.NewMemberID(), 2, false)
+	if err := first.Offer(now, now.Add(shift.DefaultStandbyOfferWindow)); err != nil {
+		t.Fatalf("Offer should succeed, got error: %v", err)
+	}
+
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return slot, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+			t.Error("no assignment should be created after the offer expired")
+			return nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return bd, nil
+		},
+	}
+	standbyRepo := &MockStandbyRepository{entries: map[shift.StandbyID]*shift.StandbyEntry{first.StandbyID(): first, second.StandbyID(): second}}
+
+	late := now.Add(shift.DefaultStandbyOfferWindow + time.Minute)
+	accept := appshift.NewAcceptStandbyOfferUsecase(
+		standbyRepo, slotRepo, assignmentRepo, businessDayRepo,
+		&MockEventRepository{}, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: late},
+	)
+	_, err := accept.Execute(context.Background(), appshift.StandbyActionInput{
+		TenantID:  tenantID,
+		StandbyID: first.StandbyID(),
+	})
+
+	if !errors.Is(err, shift.ErrStandbyOfferExpired) {
+		t.Fatalf("expected ErrStandbyOfferExpired, got %v", err)
+	}
+	if first.Status() != shift.StandbyStatusExpired {
+		t.Errorf("expected expired, got %s", first.Status())
+	}
+	if !second.IsOffered() {
+		t.Errorf("next standby should be offered after expiry, got %s", second.Status())
+	}
+}
+
+func TestAcceptStandbyOfferUsecase_ErrorWhenNotOwnEntry(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	_, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	first := createTestStandbyEntry(t, slot, common.NewMemberID(), 1, false)
+	if err := first.Offer(now, now.Add(shift.DefaultStandbyOfferWindow)); err != nil {
+		t.Fatalf("Offer should succeed, got error: %v", err)
+	}
+	actor := common.NewMemberID()
+
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return slot, nil
+		},
+	}
+	standbyRepo := &MockStandbyRepository{entries: map[shift.StandbyID]*shift.StandbyEntry{first.StandbyID(): first}}
+
+	accept := appshift.NewAcceptStandbyOfferUsecase(
+		standbyRepo, slotRepo, &MockShiftAssignmentRepository{}, &MockBusinessDayRepository{},
+		&MockEventRepository{}, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: now},
+	)
+	_, err := accept.Execute(context.Background(), appshift.StandbyActionInput{
+		TenantID:      tenantID,
+		StandbyID:     first.StandbyID(),
+		ActorMemberID: &actor,
+	})
+
+	var domainErr *common.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code() != common.ErrUnauthorized {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+}
+
+func TestWithdrawStandbyUsecase_PassesHeldOffer(t *testing.T) {
+	tenantID := common.NewTenantID()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	bd, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	first := createTestStandbyEntry(t, slot, common.NewMemberID(), 1, false)
+	second := createTestStandbyEntry(t, slot, common.NewMemberID(), 2, true)
+	if err := first.Offer(now, now.Add(shift.DefaultStandbyOfferWindow)); err != nil {
+		t.Fatalf("Offer should succeed, got error: %v", err)
+	}
+
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return slot, nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return bd, nil
+		},
+	}
+	standbyRepo := &MockStandbyRepository{entries: map[shift.StandbyID]*shift.StandbyEntry{first.StandbyID(): first, second.StandbyID(): second}}
+
+	withdraw := appshift.NewWithdrawStandbyUsecase(
+		standbyRepo, slotRepo, &MockShiftAssignmentRepository{}, businessDayRepo,
+		&MockEventRepository{}, &MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: now},
+	)
+	if _, err := withdraw.Execute(context.Background(), appshift.StandbyActionInput{
+		TenantID:  tenantID,
+		StandbyID: first.StandbyID(),
+	}); err != nil {
+		t.Fatalf("WithdrawStandby should succeed, got error: %v", err)
+	}
+
+	if first.Status() != shift.StandbyStatusWithdrawn {
+		t.Errorf("expected withdrawn, got %s", first.Status())
+	}
+	if second.Status() != shift.StandbyStatusPromoted {
+		t.Errorf("next auto-accept standby should be promoted, got %s", second.Status())
+	}
+}
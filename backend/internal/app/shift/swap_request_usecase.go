@@ -0,0 +1,719 @@
+package shift
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// =====================================================
+// Create
+// =====================================================
+
+// CreateSwapRequestInput represents the input for offering an assignment for swap
+type CreateSwapRequestInput struct {
+	TenantID     common.TenantID
+	AssignmentID shift.AssignmentID
+	// ActorMemberID はメンバー本人が依頼する場合に指定する（管理者が代理で依頼する場合は nil）
+	ActorMemberID    *common.MemberID
+	RequestType      shift.SwapRequestType
+	RequiresApproval bool
+	Note             string
+}
+
+// CreateSwapRequestUsecase handles offering a confirmed assignment for swap or drop
+type CreateSwapRequestUsecase struct {
+	swapRepo       shift.SwapRequestRepository
+	assignmentRepo shift.ShiftAssignmentRepository
+	clock          services.Clock
+}
+
+// NewCreateSwapRequestUsecase creates a new CreateSwapRequestUsecase
+func NewCreateSwapRequestUsecase(
+	swapRepo shift.SwapRequestRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	clock services.Clock,
+) *CreateSwapRequestUsecase {
+	return &CreateSwapRequestUsecase{
+		swapRepo:       swapRepo,
+		assignmentRepo: assignmentRepo,
+		clock:          clock,
+	}
+}
+
+// Execute creates an open swap request with a public token
+func (uc *CreateSwapRequestUsecase) Execute(ctx context.Context, input CreateSwapRequestInput) (*shift.SwapRequest, error) {
+	assignment, err := uc.assignmentRepo.FindByID(ctx, input.TenantID, input.AssignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// メンバー本人は自分の割り当てのみ依頼できる
+	if input.ActorMemberID != nil && *input.ActorMemberID != assignment.MemberID() {
+		return nil, common.NewUnauthorizedError("only the assigned member can offer this assignment")
+	}
+
+	// 同じ割り当てに進行中の依頼がある場合は作成しない
+	if _, err := uc.swapRepo.FindActiveByAssignmentID(ctx, input.TenantID, input.AssignmentID); err == nil {
+		return nil, common.NewConflictError("an open swap request already exists for this assignment")
+	} else if !common.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	req, err := shift.NewSwapRequest(
+		uc.clock.Now(),
+		input.TenantID,
+		input.RequestType,
+		assignment,
+		input.RequiresApproval,
+		input.Note,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.swapRepo.Save(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// =====================================================
+// List / Get
+// =====================================================
+
+// ListSwapRequestsInput represents the input for listing swap requests
+type ListSwapRequestsInput struct {
+	TenantID common.TenantID
+	Status   shift.SwapRequestStatus // 空の場合は全ステータス
+	MemberID *common.MemberID        // 指定時は依頼者または引き受け者として関わった依頼のみ
+}
+
+// ListSwapRequestsUsecase handles listing swap requests (交換履歴を含む)
+type ListSwapRequestsUsecase struct {
+	swapRepo shift.SwapRequestRepository
+}
+
+// NewListSwapRequestsUsecase creates a new ListSwapRequestsUsecase
+func NewListSwapRequestsUsecase(swapRepo shift.SwapRequestRepository) *ListSwapRequestsUsecase {
+	return &ListSwapRequestsUsecase{swapRepo: swapRepo}
+}
+
+// Execute lists swap requests (newest first)
+func (uc *ListSwapRequestsUsecase) Execute(ctx context.Context, input ListSwapRequestsInput) ([]*shift.SwapRequest, error) {
+	if input.Status != "" {
+		if err := input.Status.Validate(); err != nil {
+			return nil, common.NewValidationError("invalid status", err)
+		}
+	}
+
+	if input.MemberID == nil {
+		return uc.swapRepo.FindByTenantID(ctx, input.TenantID, input.Status)
+	}
+
+	reqs, err := uc.swapRepo.FindByMemberID(ctx, input.TenantID, *input.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	if input.Status == "" {
+		return reqs, nil
+	}
+
+	filtered := make([]*shift.SwapRequest, 0, len(reqs))
+	for _, req := range reqs {
+		if req.Status() == input.Status {
+			filtered = append(filtered, req)
+		}
+	}
+	return filtered, nil
+}
+
+// SwapRequestDetail represents a swap request with the offered shift for the public claim page
+type SwapRequestDetail struct {
+	Request              *shift.SwapRequest
+	RequesterDisplayName string
+	SlotName             string
+	TargetDate           time.Time
+	StartTime            time.Time
+	EndTime              time.Time
+}
+
+// GetSwapRequestByTokenInput represents the input for getting a swap request by public token
+type GetSwapRequestByTokenInput struct {
+	PublicToken string
+}
+
+// GetSwapRequestByTokenUsecase handles getting a swap request via its public link
+type GetSwapRequestByTokenUsecase struct {
+	swapRepo        shift.SwapRequestRepository
+	assignmentRepo  shift.ShiftAssignmentRepository
+	slotRepo        shift.ShiftSlotRepository
+	businessDayRepo event.EventBusinessDayRepository
+	memberRepo      member.MemberRepository
+}
+
+// NewGetSwapRequestByTokenUsecase creates a new GetSwapRequestByTokenUsecase
+func NewGetSwapRequestByTokenUsecase(
+	swapRepo shift.SwapRequestRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	slotRepo shift.ShiftSlotRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	memberRepo member.MemberRepository,
+) *GetSwapRequestByTokenUsecase {
+	return &GetSwapRequestByTokenUsecase{
+		swapRepo:        swapRepo,
+		assignmentRepo:  assignmentRepo,
+		slotRepo:        slotRepo,
+		businessDayRepo: businessDayRepo,
+		memberRepo:      memberRepo,
+	}
+}
+
+// Execute gets a swap request with the offered shift
+func (uc *GetSwapRequestByTokenUsecase) Execute(ctx context.Context, input GetSwapRequestByTokenInput) (*SwapRequestDetail, error) {
+	token, err := common.ParsePublicToken(input.PublicToken)
+	if err != nil {
+		// トークンエラー → 404
+		return nil, common.NewNotFoundError("SwapRequest", input.PublicToken)
+	}
+
+	req, err := uc.swapRepo.FindByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment, err := uc.assignmentRepo.FindByID(ctx, req.TenantID(), req.AssignmentID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find shift assignment: %w", err)
+	}
+
+	slot, err := uc.slotRepo.FindByID(ctx, req.TenantID(), assignment.SlotID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find shift slot: %w", err)
+	}
+
+	businessDay, err := uc.businessDayRepo.FindByID(ctx, req.TenantID(), slot.BusinessDayID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find business day: %w", err)
+	}
+
+	requester, err := uc.memberRepo.FindByID(ctx, req.TenantID(), req.RequesterMemberID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find member: %w", err)
+	}
+
+	return &SwapRequestDetail{
+		Request:              req,
+		RequesterDisplayName: requester.DisplayName(),
+		SlotName:             slot.SlotName(),
+		TargetDate:           businessDay.TargetDate(),
+		StartTime:            slot.StartTime(),
+		EndTime:              slot.EndTime(),
+	}, nil
+}
+
+// =====================================================
+// Claim / Approve / Reject / Cancel
+// =====================================================
+
+// swapExecutor replaces the offered assignment(s) within the caller's transaction
+// 新しい割り当ては手動確定と同じ検証（assignmentChecker）を通す
+type swapExecutor struct {
+	slotRepo       shift.ShiftSlotRepository
+	assignmentRepo shift.ShiftAssignmentRepository
+	checker        assignmentChecker
+}
+
+// execute cancels the offered assignment and creates one for the claimant on the same slot
+// swap の場合は引き受け側の割り当ても取り消し、依頼者をその枠に割り当てる。
+// 取り消しを先に保存するため、時間帯の重複チェックでは手放す割り当ては除外される。
+func (e swapExecutor) execute(ctx context.Context, req *shift.SwapRequest, now time.Time) (shift.AssignmentID, *shift.AssignmentID, error) {
+	claimantID := *req.ClaimantMemberID()
+
+	offered, err := e.releaseAssignment(ctx, req.TenantID(), req.AssignmentID(), req.RequesterMemberID(), now)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var counterOffered *shift.ShiftAssignment
+	if req.RequestType() == shift.SwapRequestTypeSwap {
+		counterOffered, err = e.releaseAssignment(ctx, req.TenantID(), *req.ClaimantAssignmentID(), claimantID, now)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	newAssignment, err := e.assign(ctx, offered, claimantID, now)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var counterID *shift.AssignmentID
+	if counterOffered != nil {
+		counterAssignment, err := e.assign(ctx, counterOffered, req.RequesterMemberID(), now)
+		if err != nil {
+			return "", nil, err
+		}
+		id := counterAssignment.AssignmentID()
+		counterID = &id
+	}
+
+	return newAssignment.AssignmentID(), counterID, nil
+}
+
+// releaseAssignment cancels the assignment after checking it is still held by the member
+func (e swapExecutor) releaseAssignment(ctx context.Context, tenantID common.TenantID, assignmentID shift.AssignmentID, memberID common.MemberID, now time.Time) (*shift.ShiftAssignment, error) {
+	assignment, err := e.assignmentRepo.FindByID(ctx, tenantID, assignmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find shift assignment: %w", err)
+	}
+	if !assignment.IsConfirmed() || assignment.MemberID() != memberID {
+		return nil, shift.ErrAssignmentNotSwappable
+	}
+
+	if err := assignment.Cancel(now); err != nil {
+		return nil, err
+	}
+	if err := e.assignmentRepo.Save(ctx, assignment); err != nil {
+		return nil, fmt.Errorf("failed to save shift assignment: %w", err)
+	}
+
+	return assignment, nil
+}
+
+// assign creates a confirmed assignment for the member on the released assignment's slot
+func (e swapExecutor) assign(ctx context.Context, released *shift.ShiftAssignment, memberID common.MemberID, now time.Time) (*shift.ShiftAssignment, error) {
+	tenantID := released.TenantID()
+
+	slot, err := e.slotRepo.FindByIDForUpdate(ctx, tenantID, released.SlotID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find shift slot: %w", err)
+	}
+
+	// 手放す割り当ては取り消し済みのため、定員・ロール要件・重複の判定から除外される
+	if _, err := e.checker.check(ctx, slot, memberID, assignmentCheckOptions{}); err != nil {
+		return nil, err
+	}
+
+	assignment, err := shift.NewShiftAssignment(
+		now,
+		tenantID,
+		released.PlanID(),
+		slot.SlotID(),
+		memberID,
+		shift.AssignmentMethodManual,
+		false, // is_outside_preference
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create shift assignment: %w", err)
+	}
+	if err := e.assignmentRepo.Save(ctx, assignment); err != nil {
+		return nil, fmt.Errorf("failed to save shift assignment: %w", err)
+	}
+
+	return assignment, nil
+}
+
+// isEligibleForSwap checks whether the claimant shares a role and a group with the requester
+// 依頼者にロール（グループ）が設定されていない場合、その条件は問わない
+func isEligibleForSwap(
+	ctx context.Context,
+	memberRoleRepo member.MemberRoleRepository,
+	memberGroupRepo member.MemberGroupRepository,
+	requesterID common.MemberID,
+	claimantID common.MemberID,
+) (bool, error) {
+	requesterRoles, err := memberRoleRepo.FindRolesByMemberID(ctx, requesterID)
+	if err != nil {
+		return false, fmt.Errorf("failed to find member roles: %w", err)
+	}
+	if len(requesterRoles) > 0 {
+		claimantRoles, err := memberRoleRepo.FindRolesByMemberID(ctx, claimantID)
+		if err != nil {
+			return false, fmt.Errorf("failed to find member roles: %w", err)
+		}
+		if !sharesAny(requesterRoles, claimantRoles) {
+			return false, nil
+		}
+	}
+
+	requesterGroups, err := memberGroupRepo.FindGroupIDsByMemberID(ctx, requesterID)
+	if err != nil {
+		return false, fmt.Errorf("failed to find member groups: %w", err)
+	}
+	if len(requesterGroups) > 0 {
+		claimantGroups, err := memberGroupRepo.FindGroupIDsByMemberID(ctx, claimantID)
+		if err != nil {
+			return false, fmt.Errorf("failed to find member groups: %w", err)
+		}
+		if !sharesAny(requesterGroups, claimantGroups) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func sharesAny[T comparable](a, b []T) bool {
+	set := make(map[T]struct{}, len(a))
+	for _, v := range a {
+		set[v] = struct{}{}
+	}
+	for _, v := range b {
+		if _, ok := set[v]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ClaimSwapRequestInput represents the input for claiming a swap request via its public link
+type ClaimSwapRequestInput struct {
+	PublicToken string
+	MemberID    string
+	// OfferedAssignmentID は swap の場合に引き受け側が差し出す割り当て
+	OfferedAssignmentID string
+}
+
+// ClaimSwapRequestUsecase handles claiming a swap request (認証不要の公開API)
+type ClaimSwapRequestUsecase struct {
+	swapRepo        shift.SwapRequestRepository
+	memberRepo      member.MemberRepository
+	memberRoleRepo  member.MemberRoleRepository
+	memberGroupRepo member.MemberGroupRepository
+	swapper         swapExecutor
+	txManager       services.TxManager
+	clock           services.Clock
+}
+
+// NewClaimSwapRequestUsecase creates a new ClaimSwapRequestUsecase
+func NewClaimSwapRequestUsecase(
+	swapRepo shift.SwapRequestRepository,
+	slotRepo shift.ShiftSlotRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	eventRepo event.EventRepository,
+	memberRepo member.MemberRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	memberGroupRepo member.MemberGroupRepository,
+	availabilityRepo member.AvailabilityRepository,
+	workloadPolicyRepo member.WorkloadPolicyRepository,
+	txManager services.TxManager,
+	clock services.Clock,
+) *ClaimSwapRequestUsecase {
+	return &ClaimSwapRequestUsecase{
+		swapRepo:        swapRepo,
+		memberRepo:      memberRepo,
+		memberRoleRepo:  memberRoleRepo,
+		memberGroupRepo: memberGroupRepo,
+		swapper: swapExecutor{
+			slotRepo:       slotRepo,
+			assignmentRepo: assignmentRepo,
+			checker: assignmentChecker{
+				slotRepo:           slotRepo,
+				assignmentRepo:     assignmentRepo,
+				memberRoleRepo:     memberRoleRepo,
+				availabilityRepo:   availabilityRepo,
+				workloadPolicyRepo: workloadPolicyRepo,
+				eventRepo:          eventRepo,
+				businessDayRepo:    businessDayRepo,
+			},
+		},
+		txManager: txManager,
+		clock:     clock,
+	}
+}
+
+// Execute claims the swap request
+//
+// Logic (トランザクション内で実行):
+//  1. Get SwapRequest by token with row lock（同時に引き受けた場合は後続が ErrSwapRequestNotOpen）
+//  2. Check the claimant is an active member sharing a role/group with the requester
+//  3. Record the claimant
+//  4. 承認不要の場合はその場で割り当てを入れ替えて完了
+func (uc *ClaimSwapRequestUsecase) Execute(ctx context.Context, input ClaimSwapRequestInput) (*shift.SwapRequest, error) {
+	token, err := common.ParsePublicToken(input.PublicToken)
+	if err != nil {
+		// トークンエラー → 404
+		return nil, common.NewNotFoundError("SwapRequest", input.PublicToken)
+	}
+
+	claimantID, err := common.ParseMemberID(input.MemberID)
+	if err != nil {
+		return nil, common.NewValidationError("invalid member_id", err)
+	}
+
+	var offeredID *shift.AssignmentID
+	if input.OfferedAssignmentID != "" {
+		id, err := shift.ParseAssignmentID(input.OfferedAssignmentID)
+		if err != nil {
+			return nil, common.NewValidationError("invalid offered_assignment_id", err)
+		}
+		offeredID = &id
+	}
+
+	var req *shift.SwapRequest
+	err = uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		var err error
+
+		// 1. Get SwapRequest with row lock
+		req, err = uc.swapRepo.FindByTokenForUpdate(txCtx, token)
+		if err != nil {
+			return err
+		}
+		if !req.IsOpen() {
+			return shift.ErrSwapRequestNotOpen
+		}
+
+		// 2. Check eligibility
+		claimant, err := uc.memberRepo.FindByID(txCtx, req.TenantID(), claimantID)
+		if err != nil {
+			if common.IsNotFoundError(err) {
+				return shift.ErrSwapNotEligible
+			}
+			return fmt.Errorf("failed to find member: %w", err)
+		}
+		if !claimant.IsActive() {
+			return shift.ErrSwapNotEligible
+		}
+
+		eligible, err := isEligibleForSwap(txCtx, uc.memberRoleRepo, uc.memberGroupRepo, req.RequesterMemberID(), claimantID)
+		if err != nil {
+			return err
+		}
+		if !eligible {
+			return shift.ErrSwapNotEligible
+		}
+
+		// 3. Record the claimant
+		now := uc.clock.Now()
+		if err := req.Claim(now, claimantID, offeredID); err != nil {
+			return err
+		}
+
+		// 4. Complete immediately if no approval is required
+		if !req.RequiresApproval() {
+			newID, counterID, err := uc.swapper.execute(txCtx, req, now)
+			if err != nil {
+				return err
+			}
+			if err := req.Complete(now, newID, counterID, nil); err != nil {
+				return err
+			}
+		}
+
+		return uc.swapRepo.Save(txCtx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Status() == shift.SwapRequestStatusCompleted {
+		logSwapCompleted(req)
+	} else {
+		log.Printf("[Notification Stub] シフト交換承認依頼: swap_request_id=%s, claimant=%s",
+			req.SwapRequestID().String(),
+			claimantID.String(),
+		)
+	}
+
+	return req, nil
+}
+
+// ApproveSwapRequestInput represents the input for approving a claimed swap request
+type ApproveSwapRequestInput struct {
+	TenantID      common.TenantID
+	SwapRequestID shift.SwapRequestID
+	AdminID       common.AdminID
+}
+
+// ApproveSwapRequestUsecase handles approving a claimed swap request (manager only)
+type ApproveSwapRequestUsecase struct {
+	swapRepo  shift.SwapRequestRepository
+	swapper   swapExecutor
+	txManager services.TxManager
+	clock     services.Clock
+}
+
+// NewApproveSwapRequestUsecase creates a new ApproveSwapRequestUsecase
+func NewApproveSwapRequestUsecase(
+	swapRepo shift.SwapRequestRepository,
+	slotRepo shift.ShiftSlotRepository,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	eventRepo event.EventRepository,
+	memberRoleRepo member.MemberRoleRepository,
+	availabilityRepo member.AvailabilityRepository,
+	workloadPolicyRepo member.WorkloadPolicyRepository,
+	txManager services.TxManager,
+	clock services.Clock,
+) *ApproveSwapRequestUsecase {
+	return &ApproveSwapRequestUsecase{
+		swapRepo: swapRepo,
+		swapper: swapExecutor{
+			slotRepo:       slotRepo,
+			assignmentRepo: assignmentRepo,
+			checker: assignmentChecker{
+				slotRepo:           slotRepo,
+				assignmentRepo:     assignmentRepo,
+				memberRoleRepo:     memberRoleRepo,
+				availabilityRepo:   availabilityRepo,
+				workloadPolicyRepo: workloadPolicyRepo,
+				eventRepo:          eventRepo,
+				businessDayRepo:    businessDayRepo,
+			},
+		},
+		txManager: txManager,
+		clock:     clock,
+	}
+}
+
+// Execute approves the swap request and replaces the assignments in one transaction
+func (uc *ApproveSwapRequestUsecase) Execute(ctx context.Context, input ApproveSwapRequestInput) (*shift.SwapRequest, error) {
+	var req *shift.SwapRequest
+	err := uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		var err error
+		req, err = uc.swapRepo.FindByIDForUpdate(txCtx, input.TenantID, input.SwapRequestID)
+		if err != nil {
+			return err
+		}
+		if !req.IsPendingApproval() {
+			return shift.ErrSwapRequestNotPending
+		}
+
+		now := uc.clock.Now()
+		newID, counterID, err := uc.swapper.execute(txCtx, req, now)
+		if err != nil {
+			return err
+		}
+		if err := req.Complete(now, newID, counterID, &input.AdminID); err != nil {
+			return err
+		}
+
+		return uc.swapRepo.Save(txCtx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logSwapCompleted(req)
+
+	return req, nil
+}
+
+// RejectSwapRequestInput represents the input for rejecting a claimed swap request
+type RejectSwapRequestInput struct {
+	TenantID      common.TenantID
+	SwapRequestID shift.SwapRequestID
+	AdminID       common.AdminID
+}
+
+// RejectSwapRequestUsecase handles rejecting a claimed swap request (manager only)
+type RejectSwapRequestUsecase struct {
+	swapRepo  shift.SwapRequestRepository
+	txManager services.TxManager
+	clock     services.Clock
+}
+
+// NewRejectSwapRequestUsecase creates a new RejectSwapRequestUsecase
+func NewRejectSwapRequestUsecase(
+	swapRepo shift.SwapRequestRepository,
+	txManager services.TxManager,
+	clock services.Clock,
+) *RejectSwapRequestUsecase {
+	return &RejectSwapRequestUsecase{
+		swapRepo:  swapRepo,
+		txManager: txManager,
+		clock:     clock,
+	}
+}
+
+// Execute rejects the swap request (割り当ては変更しない)
+func (uc *RejectSwapRequestUsecase) Execute(ctx context.Context, input RejectSwapRequestInput) (*shift.SwapRequest, error) {
+	var req *shift.SwapRequest
+	err := uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		var err error
+		req, err = uc.swapRepo.FindByIDForUpdate(txCtx, input.TenantID, input.SwapRequestID)
+		if err != nil {
+			return err
+		}
+		if err := req.Reject(uc.clock.Now(), input.AdminID); err != nil {
+			return err
+		}
+		return uc.swapRepo.Save(txCtx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// CancelSwapRequestInput represents the input for withdrawing a swap request
+type CancelSwapRequestInput struct {
+	TenantID      common.TenantID
+	SwapRequestID shift.SwapRequestID
+	// ActorMemberID はメンバー本人が取り下げる場合に指定する（管理者の場合は nil）
+	ActorMemberID *common.MemberID
+}
+
+// CancelSwapRequestUsecase handles withdrawing a swap request before completion
+type CancelSwapRequestUsecase struct {
+	swapRepo  shift.SwapRequestRepository
+	txManager services.TxManager
+	clock     services.Clock
+}
+
+// NewCancelSwapRequestUsecase creates a new CancelSwapRequestUsecase
+func NewCancelSwapRequestUsecase(
+	swapRepo shift.SwapRequestRepository,
+	txManager services.TxManager,
+	clock services.Clock,
+) *CancelSwapRequestUsecase {
+	return &CancelSwapRequestUsecase{
+		swapRepo:  swapRepo,
+		txManager: txManager,
+		clock:     clock,
+	}
+}
+
+// Execute cancels the swap request
+func (uc *CancelSwapRequestUsecase) Execute(ctx context.Context, input CancelSwapRequestInput) (*shift.SwapRequest, error) {
+	var req *shift.SwapRequest
+	err := uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		var err error
+		req, err = uc.swapRepo.FindByIDForUpdate(txCtx, input.TenantID, input.SwapRequestID)
+		if err != nil {
+			return err
+		}
+		if input.ActorMemberID != nil && *input.ActorMemberID != req.RequesterMemberID() {
+			return common.NewUnauthorizedError("only the requester can cancel this swap request")
+		}
+		if err := req.Cancel(uc.clock.Now()); err != nil {
+			return err
+		}
+		return uc.swapRepo.Save(txCtx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func logSwapCompleted(req *shift.SwapRequest) {
+	log.Printf("[Notification Stub] シフト交換完了通知: swap_request_id=%s, type=%s, requester=%s, claimant=%s",
+		req.SwapRequestID().String(),
+		req.RequestType(),
+		req.RequesterMemberID().String(),
+		req.ClaimantMemberID().String(),
+	)
+}
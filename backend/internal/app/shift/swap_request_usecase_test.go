@@ -0,0 +1,745 @@
+package shift_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	appshift "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// =====================================================
+// Mock SwapRequestRepository / MemberGroupRepository
+// =====================================================
+
+type MockSwapRequestRepository struct {
+	requests map[shift.SwapRequestID]*shift.SwapRequest
+}
+
+func (m *MockSwapRequestRepository) Save(ctx context.Context, req *shift.SwapRequest) error {
+	m.requests[req.SwapRequestID()] = req
+	return nil
+}
+
+func (m *MockSwapRequestRepository) FindByID(ctx context.Context, tenantID common.TenantID, id shift.SwapRequestID) (*shift.SwapRequest, error) {
+	if req, ok := m.requests[id]; ok && req.TenantID() == tenantID {
+		return req, nil
+	}
+	return nil, common.NewNotFoundError("SwapRequest", id.String())
+}
+
+func (m *MockSwapRequestRepository) FindByIDForUpdate(ctx context.Context, tenantID common.TenantID, id shift.SwapRequestID) (*shift.SwapRequest, error) {
+	return m.FindByID(ctx, tenantID, id)
+}
+
+func (m *MockSwapRequestRepository) FindByToken(ctx context.Context, token common.PublicToken) (*shift.SwapRequest, error) {
+	for _, req := range m.requests {
+		if req.PublicToken() == token {
+			return req, nil
+		}
+	}
+	return nil, common.NewNotFoundError("SwapRequest", token.String())
+}
+
+func (m *MockSwapRequestRepository) FindByTokenForUpdate(ctx context.Context, token common.PublicToken) (*shift.SwapRequest, error) {
+	return m.FindByToken(ctx, token)
+}
+
+func (m *MockSwapRequestRepository) FindByTenantID(ctx context.Context, tenantID common.TenantID, status shift.SwapRequestStatus) ([]*shift.SwapRequest, error) {
+	var result []*shift.SwapRequest
+	for _, req := range m.requests {
+		if req.TenantID() == tenantID && (status == "" || req.Status() == status) {
+			result = append(result, req)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockSwapRequestRepository) FindByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*shift.SwapRequest, error) {
+	var result []*shift.SwapRequest
+	for _, req := range m.requests {
+		if req.RequesterMemberID() == memberID || (req.ClaimantMemberID() != nil && *req.ClaimantMemberID() == memberID) {
+			result = append(result, req)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockSwapRequestRepository) FindActiveByAssignmentID(ctx context.Context, tenantID common.TenantID, assignmentID shift.AssignmentID) (*shift.SwapRequest, error) {
+	for _, req := range m.requests {
+		if req.AssignmentID() == assignmentID && (req.IsOpen() || req.IsPendingApproval()) {
+			return req, nil
+		}
+	}
+	return nil, common.NewNotFoundError("SwapRequest", assignmentID.String())
+}
+
+type MockMemberGroupRepository struct {
+	groups map[common.MemberID][]common.MemberGroupID
+}
+
+func (m *MockMemberGroupRepository) Save(ctx context.Context, group *member.MemberGroup) error {
+	return nil
+}
+
+func (m *MockMemberGroupRepository) FindByID(ctx context.Context, tenantID common.TenantID, groupID common.MemberGroupID) (*member.MemberGroup, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockMemberGroupRepository) FindByTenantID(ctx context.Context, tenantID common.TenantID) ([]*member.MemberGroup, error) {
+	return nil, nil
+}
+
+func (m *MockMemberGroupRepository) Delete(ctx context.Context, tenantID common.TenantID, groupID common.MemberGroupID) error {
+	return nil
+}
+
+func (m *MockMemberGroupRepository) AssignMember(ctx context.Context, groupID common.MemberGroupID, memberID common.MemberID) error {
+	return nil
+}
+
+func (m *MockMemberGroupRepository) RemoveMember(ctx context.Context, groupID common.MemberGroupID, memberID common.MemberID) error {
+	return nil
+}
+
+func (m *MockMemberGroupRepository) FindMemberIDsByGroupID(ctx context.Context, groupID common.MemberGroupID) ([]common.MemberID, error) {
+	return nil, nil
+}
+
+func (m *MockMemberGroupRepository) FindGroupIDsByMemberID(ctx context.Context, memberID common.MemberID) ([]common.MemberGroupID, error) {
+	return m.groups[memberID], nil
+}
+
+func (m *MockMemberGroupRepository) SetMemberGroups(ctx context.Context, memberID common.MemberID, groupIDs []common.MemberGroupID) error {
+	return nil
+}
+
+// =====================================================
+// Helper functions
+// =====================================================
+
+func createTestSwapRequest(t *testing.T, assignment *shift.ShiftAssignment, requestType shift.SwapRequestType, requiresApproval bool) *shift.SwapRequest {
+	t.Helper()
+	req, err := shift.NewSwapRequest(time.Now(), assignment.TenantID(), requestType, assignment, requiresApproval, "")
+	if err != nil {
+		t.Fatalf("Failed to create swap request: %v", err)
+	}
+	return req
+}
+
+// =====================================================
+// CreateSwapRequestUsecase Tests
+// =====================================================
+
+func TestCreateSwapRequestUsecase_ErrorWhenNotOwnAssignment(t *testing.T) {
+	tenantID := common.NewTenantID()
+	_, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	assignment := createTestAssignment(t, slot, common.NewMemberID())
+	otherID := common.NewMemberID()
+
+	assignmentRepo := &MockShiftAssignmentRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) (*shift.ShiftAssignment, error) {
+			return assignment, nil
+		},
+	}
+	swapRepo := &MockSwapRequestRepository{requests: map[shift.SwapRequestID]*shift.SwapRequest{}}
+
+	uc := appshift.NewCreateSwapRequestUsecase(swapRepo, assignmentRepo, &MockClock{now: time.Now()})
+	_, err := uc.Execute(context.Background(), appshift.CreateSwapRequestInput{
+		TenantID:      tenantID,
+		AssignmentID:  assignment.AssignmentID(),
+		ActorMemberID: &otherID,
+		RequestType:   shift.SwapRequestTypeDrop,
+	})
+
+	var domainErr *common.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code() != common.ErrUnauthorized {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+}
+
+func TestCreateSwapRequestUsecase_ErrorWhenAlreadyOffered(t *testing.T) {
+	tenantID := common.NewTenantID()
+	_, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	assignment := createTestAssignment(t, slot, common.NewMemberID())
+	existing := createTestSwapRequest(t, assignment, shift.SwapRequestTypeDrop, false)
+
+	assignmentRepo := &MockShiftAssignmentRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) (*shift.ShiftAssignment, error) {
+			return assignment, nil
+		},
+	}
+	swapRepo := &MockSwapRequestRepository{requests: map[shift.SwapRequestID]*shift.SwapRequest{existing.SwapRequestID(): existing}}
+
+	uc := appshift.NewCreateSwapRequestUsecase(swapRepo, assignmentRepo, &MockClock{now: time.Now()})
+	_, err := uc.Execute(context.Background(), appshift.CreateSwapRequestInput{
+		TenantID:     tenantID,
+		AssignmentID: assignment.AssignmentID(),
+		RequestType:  shift.SwapRequestTypeDrop,
+	})
+	if !isConflictError(err) {
+		t.Errorf("expected conflict error, got %v", err)
+	}
+}
+
+// =====================================================
+// ClaimSwapRequestUsecase Tests
+// =====================================================
+
+func TestClaimSwapRequestUsecase_DropCompletesImmediately(t *testing.T) {
+	tenantID := common.NewTenantID()
+	requester := createTestMember(t, tenantID)
+	claimant := createTestMember(t, tenantID)
+	bd, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	offered := createTestAssignment(t, slot, requester.MemberID())
+	req := createTestSwapRequest(t, offered, shift.SwapRequestTypeDrop, false)
+
+	var saved []*shift.ShiftAssignment
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return slot, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) (*shift.ShiftAssignment, error) {
+			return offered, nil
+		},
+		saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+			saved = append(saved, a)
+			return nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return bd, nil
+		},
+	}
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memberID common.MemberID) (*member.Member, error) {
+			return claimant, nil
+		},
+	}
+
+	uc := appshift.NewClaimSwapRequestUsecase(
+		&MockSwapRequestRepository{requests: map[shift.SwapRequestID]*shift.SwapRequest{req.SwapRequestID(): req}},
+		slotRepo, assignmentRepo, businessDayRepo, &MockEventRepository{},
+		memberRepo, &MockMemberRoleRepository{}, &MockMemberGroupRepository{},
+		&MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	result, err := uc.Execute(context.Background(), appshift.ClaimSwapRequestInput{
+		PublicToken: req.PublicToken().String(),
+		MemberID:    claimant.MemberID().String(),
+	})
+	if err != nil {
+		t.Fatalf("Claim should succeed, got error: %v", err)
+	}
+
+	if result.Status() != shift.SwapRequestStatusCompleted {
+		t.Errorf("expected completed, got %s", result.Status())
+	}
+	if !offered.IsCancelled() {
+		t.Errorf("requester's assignment should be cancelled")
+	}
+	if len(saved) != 2 || saved[1].MemberID() != claimant.MemberID() || saved[1].SlotID() != slot.SlotID() {
+		t.Fatalf("claimant should be assigned to the slot, got %d saved assignment(s)", len(saved))
+	}
+	if result.NewAssignmentID() == nil || *result.NewAssignmentID() != saved[1].AssignmentID() {
+		t.Errorf("new assignment should be recorded in the history")
+	}
+}
+
+func TestClaimSwapRequestUsecase_SwapExchangesAssignments(t *testing.T) {
+	tenantID := common.NewTenantID()
+	requester := createTestMember(t, tenantID)
+	claimant := createTestMember(t, tenantID)
+	requesterBD, requesterSlot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	claimantBD, claimantSlot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 17, 0, 0, 0, 0, time.UTC))
+	offered := createTestAssignment(t, requesterSlot, requester.MemberID())
+	counter := createTestAssignment(t, claimantSlot, claimant.MemberID())
+	req := createTestSwapRequest(t, offered, shift.SwapRequestTypeSwap, false)
+
+	assigned := map[shift.SlotID]common.MemberID{}
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			if slotID == claimantSlot.SlotID() {
+				return claimantSlot, nil
+			}
+			return requesterSlot, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) (*shift.ShiftAssignment, error) {
+			if id == counter.AssignmentID() {
+				return counter, nil
+			}
+			return offered, nil
+		},
+		saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+			if a.IsConfirmed() {
+				assigned[a.SlotID()] = a.MemberID()
+			}
+			return nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			if id == claimantBD.BusinessDayID() {
+				return claimantBD, nil
+			}
+			return requesterBD, nil
+		},
+	}
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memberID common.MemberID) (*member.Member, error) {
+			return claimant, nil
+		},
+	}
+
+	uc := appshift.NewClaimSwapRequestUsecase(
+		&MockSwapRequestRepository{requests: map[shift.SwapRequestID]*shift.SwapRequest{req.SwapRequestID(): req}},
+		slotRepo, assignmentRepo, businessDayRepo, &MockEventRepository{},
+		memberRepo, &MockMemberRoleRepository{}, &MockMemberGroupRepository{},
+		&MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	result, err := uc.Execute(context.Background(), appshift.ClaimSwapRequestInput{
+		PublicToken:         req.PublicToken().String(),
+		MemberID:            claimant.MemberID().String(),
+		OfferedAssignmentID: counter.AssignmentID().String(),
+	})
+	if err != nil {
+		t.Fatalf("Claim should succeed, got error: %v", err)
+	}
+
+	if assigned[requesterSlot.SlotID()] != claimant.MemberID() {
+		t.Errorf("claimant should take the requester's slot")
+	}
+	if assigned[claimantSlot.SlotID()] != requester.MemberID() {
+		t.Errorf("requester should take the claimant's slot")
+	}
+	if !offered.IsCancelled() || !counter.IsCancelled() {
+		t.Errorf("both offered assignments should be cancelled")
+	}
+	if result.CounterAssignmentID() == nil {
+		t.Errorf("counter assignment should be recorded for swap")
+	}
+}
+
+func TestClaimSwapRequestUsecase_WaitsForApproval(t *testing.T) {
+	tenantID := common.NewTenantID()
+	requester := createTestMember(t, tenantID)
+	claimant := createTestMember(t, tenantID)
+	bd, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	offered := createTestAssignment(t, slot, requester.MemberID())
+	req := createTestSwapRequest(t, offered, shift.SwapRequestTypeDrop, true)
+
+	var newAssignment *shift.ShiftAssignment
+	swapRepo := &MockSwapRequestRepository{requests: map[shift.SwapRequestID]*shift.SwapRequest{req.SwapRequestID(): req}}
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return slot, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) (*shift.ShiftAssignment, error) {
+			return offered, nil
+		},
+		saveFunc: func(ctx context.Context, a *shift.ShiftAssignment) error {
+			if a.AssignmentID() != offered.AssignmentID() {
+				newAssignment = a
+			}
+			return nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return bd, nil
+		},
+	}
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memberID common.MemberID) (*member.Member, error) {
+			return claimant, nil
+		},
+	}
+
+	claim := appshift.NewClaimSwapRequestUsecase(
+		swapRepo, slotRepo, assignmentRepo, businessDayRepo, &MockEventRepository{},
+		memberRepo, &MockMemberRoleRepository{}, &MockMemberGroupRepository{},
+		&MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	result, err := claim.Execute(context.Background(), appshift.ClaimSwapRequestInput{
+		PublicToken: req.PublicToken().String(),
+		MemberID:    claimant.MemberID().String(),
+	})
+	if err != nil {
+		t.Fatalf("Claim should succeed, got error: %v", err)
+	}
+	if !result.IsPendingApproval() {
+		t.Fatalf("expected pending_approval, got %s", result.Status())
+	}
+	if !offered.IsConfirmed() || newAssignment != nil {
+		t.Fatalf("assignment should not change before approval")
+	}
+
+	adminID := common.NewAdminID()
+	approve := appshift.NewApproveSwapRequestUsecase(
+		swapRepo, slotRepo, assignmentRepo, businessDayRepo, &MockEventRepository{},
+		&MockMemberRoleRepository{}, &MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	approved, err := approve.Execute(context.Background(), appshift.ApproveSwapRequestInput{
+		TenantID:      tenantID,
+		SwapRequestID: req.SwapRequestID(),
+		AdminID:       adminID,
+	})
+	if err != nil {
+		t.Fatalf("Approve should succeed, got error: %v", err)
+	}
+
+	if approved.Status() != shift.SwapRequestStatusCompleted || *approved.DecidedByAdminID() != adminID {
+		t.Errorf("expected completed by %s, got %s", adminID, approved.Status())
+	}
+	if newAssignment == nil || newAssignment.MemberID() != claimant.MemberID() {
+		t.Errorf("claimant should be assigned after approval")
+	}
+}
+
+func TestClaimSwapRequestUsecase_ErrorWhenNoSharedRole(t *testing.T) {
+	tenantID := common.NewTenantID()
+	requester := createTestMember(t, tenantID)
+	claimant := createTestMember(t, tenantID)
+	_, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	offered := createTestAssignment(t, slot, requester.MemberID())
+	req := createTestSwapRequest(t, offered, shift.SwapRequestTypeDrop, false)
+
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memberID common.MemberID) (*member.Member, error) {
+			return claimant, nil
+		},
+	}
+	memberRoleRepo := &MockMemberRoleRepository{roles: map[common.MemberID][]common.RoleID{
+		requester.MemberID(): {common.NewRoleID()},
+		claimant.MemberID():  {common.NewRoleID()},
+	}}
+
+	uc := appshift.NewClaimSwapRequestUsecase(
+		&MockSwapRequestRepository{requests: map[shift.SwapRequestID]*shift.SwapRequest{req.SwapRequestID(): req}},
+		&MockShiftSlotRepository{}, &MockShiftAssignmentRepository{}, &MockBusinessDayRepository{}, &MockEventRepository{},
+		memberRepo, memberRoleRepo, &MockMemberGroupRepository{},
+		&MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	_, err := uc.Execute(context.Background(), appshift.ClaimSwapRequestInput{
+		PublicToken: req.PublicToken().String(),
+		MemberID:    claimant.MemberID().String(),
+	})
+	if !errors.Is(err, shift.ErrSwapNotEligible) {
+		t.Errorf("expected ErrSwapNotEligible, got %v", err)
+	}
+	if !offered.IsConfirmed() {
+		t.Errorf("assignment should not change when claim is rejected")
+	}
+}
+
+func TestClaimSwapRequestUsecase_EligibleWithSharedGroup(t *testing.T) {
+	tenantID := common.NewTenantID()
+	requester := createTestMember(t, tenantID)
+	claimant := createTestMember(t, tenantID)
+	bd, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	offered := createTestAssignment(t, slot, requester.MemberID())
+	req := createTestSwapRequest(t, offered, shift.SwapRequestTypeDrop, false)
+	groupID := common.NewMemberGroupID()
+
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return slot, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) (*shift.ShiftAssignment, error) {
+			return offered, nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return bd, nil
+		},
+	}
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memberID common.MemberID) (*member.Member, error) {
+			return claimant, nil
+		},
+	}
+	memberGroupRepo := &MockMemberGroupRepository{groups: map[common.MemberID][]common.MemberGroupID{
+		requester.MemberID(): {groupID, common.NewMemberGroupID()},
+		claimant.MemberID():  {groupID},
+	}}
+
+	uc := appshift.NewClaimSwapRequestUsecase(
+		&MockSwapRequestRepository{requests: map[shift.SwapRequestID]*shift.SwapRequest{req.SwapRequestID(): req}},
+		slotRepo, assignmentRepo, businessDayRepo, &MockEventRepository{},
+		memberRepo, &MockMemberRoleRepository{}, memberGroupRepo,
+		&MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	if _, err := uc.Execute(context.Background(), appshift.ClaimSwapRequestInput{
+		PublicToken: req.PublicToken().String(),
+		MemberID:    claimant.MemberID().String(),
+	}); err != nil {
+		t.Errorf("Claim should succeed for a member in the same group, got error: %v", err)
+	}
+}
+
+func TestClaimSwapRequestUsecase_ErrorWhenClaimantHasOverlappingShift(t *testing.T) {
+	tenantID := common.NewTenantID()
+	requester := createTestMember(t, tenantID)
+	claimant := createTestMember(t, tenantID)
+	bd, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	offered := createTestAssignment(t, slot, requester.MemberID())
+	req := createTestSwapRequest(t, offered, shift.SwapRequestTypeDrop, false)
+
+	// 引き受け者は同じ営業日・同じ時間帯の別の枠に入っている
+	otherSlot, err := shift.NewShiftSlot(time.Now(), tenantID, bd.BusinessDayID(), nil, "案内", "", slot.StartTime(), slot.EndTime(), 1, 1)
+	if err != nil {
+		t.Fatalf("Failed to create shift slot: %v", err)
+	}
+	busy := createTestAssignment(t, otherSlot, claimant.MemberID())
+
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			if slotID == otherSlot.SlotID() {
+				return otherSlot, nil
+			}
+			return slot, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) (*shift.ShiftAssignment, error) {
+			return offered, nil
+		},
+		findByBusinessDayIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) ([]*shift.ShiftAssignment, error) {
+			return []*shift.ShiftAssignment{offered, busy}, nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return bd, nil
+		},
+		findByTenantIDAndDateFunc: func(ctx context.Context, tid common.TenantID, date time.Time) ([]*event.EventBusinessDay, error) {
+			if date.Equal(bd.TargetDate()) {
+				return []*event.EventBusinessDay{bd}, nil
+			}
+			return nil, nil
+		},
+	}
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memberID common.MemberID) (*member.Member, error) {
+			return claimant, nil
+		},
+	}
+
+	uc := appshift.NewClaimSwapRequestUsecase(
+		&MockSwapRequestRepository{requests: map[shift.SwapRequestID]*shift.SwapRequest{req.SwapRequestID(): req}},
+		slotRepo, assignmentRepo, businessDayRepo, &MockEventRepository{},
+		memberRepo, &MockMemberRoleRepository{}, &MockMemberGroupRepository{},
+		&MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	_, err = uc.Execute(context.Background(), appshift.ClaimSwapRequestInput{
+		PublicToken: req.PublicToken().String(),
+		MemberID:    claimant.MemberID().String(),
+	})
+
+	var conflictErr *shift.AssignmentConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Errorf("expected AssignmentConflictError, got %v", err)
+	}
+}
+
+func TestClaimSwapRequestUsecase_ErrorWhenClaimantExceedsWorkloadLimit(t *testing.T) {
+	tenantID := common.NewTenantID()
+	requester := createTestMember(t, tenantID)
+	claimant := createTestMember(t, tenantID)
+	bd, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	offered := createTestAssignment(t, slot, requester.MemberID())
+	req := createTestSwapRequest(t, offered, shift.SwapRequestTypeDrop, false)
+
+	maxMinutes := 60
+	policy, err := member.NewWorkloadPolicy(time.Now(), tenantID, nil, member.WorkloadLimits{MaxMinutesPerBusinessDay: &maxMinutes}, member.WorkloadEnforcementBlock)
+	if err != nil {
+		t.Fatalf("Failed to create workload policy: %v", err)
+	}
+
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return slot, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) (*shift.ShiftAssignment, error) {
+			return offered, nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return bd, nil
+		},
+	}
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memberID common.MemberID) (*member.Member, error) {
+			return claimant, nil
+		},
+	}
+
+	uc := appshift.NewClaimSwapRequestUsecase(
+		&MockSwapRequestRepository{requests: map[shift.SwapRequestID]*shift.SwapRequest{req.SwapRequestID(): req}},
+		slotRepo, assignmentRepo, businessDayRepo, &MockEventRepository{},
+		memberRepo, &MockMemberRoleRepository{}, &MockMemberGroupRepository{},
+		&MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{tenantPolicy: policy},
+		&MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	_, err = uc.Execute(context.Background(), appshift.ClaimSwapRequestInput{
+		PublicToken: req.PublicToken().String(),
+		MemberID:    claimant.MemberID().String(),
+	})
+
+	var workloadErr *member.WorkloadLimitError
+	if !errors.As(err, &workloadErr) {
+		t.Errorf("expected WorkloadLimitError, got %v", err)
+	}
+}
+
+func TestClaimSwapRequestUsecase_ErrorWhenEventArchived(t *testing.T) {
+	tenantID := common.NewTenantID()
+	requester := createTestMember(t, tenantID)
+	claimant := createTestMember(t, tenantID)
+	bd, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	offered := createTestAssignment(t, slot, requester.MemberID())
+	req := createTestSwapRequest(t, offered, shift.SwapRequestTypeDrop, false)
+
+	slotRepo := &MockShiftSlotRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+			return slot, nil
+		},
+	}
+	assignmentRepo := &MockShiftAssignmentRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id shift.AssignmentID) (*shift.ShiftAssignment, error) {
+			return offered, nil
+		},
+	}
+	businessDayRepo := &MockBusinessDayRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+			return bd, nil
+		},
+	}
+	eventRepo := &MockEventRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, eid common.EventID) (*event.Event, error) {
+			e, err := event.NewEvent(time.Now(), tid, "Archived Event", event.EventTypeNormal, "", event.RecurrenceTypeNone, nil, nil, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			return e, e.Archive(time.Now())
+		},
+	}
+	memberRepo := &MockMemberRepository{
+		findByIDFunc: func(ctx context.Context, tid common.TenantID, memberID common.MemberID) (*member.Member, error) {
+			return claimant, nil
+		},
+	}
+
+	uc := appshift.NewClaimSwapRequestUsecase(
+		&MockSwapRequestRepository{requests: map[shift.SwapRequestID]*shift.SwapRequest{req.SwapRequestID(): req}},
+		slotRepo, assignmentRepo, businessDayRepo, eventRepo,
+		memberRepo, &MockMemberRoleRepository{}, &MockMemberGroupRepository{},
+		&MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	_, err := uc.Execute(context.Background(), appshift.ClaimSwapRequestInput{
+		PublicToken: req.PublicToken().String(),
+		MemberID:    claimant.MemberID().String(),
+	})
+	if !isConflictError(err) {
+		t.Errorf("expected conflict error for an archived event, got %v", err)
+	}
+}
+
+func TestClaimSwapRequestUsecase_ErrorWhenInvalidToken(t *testing.T) {
+	uc := appshift.NewClaimSwapRequestUsecase(
+		&MockSwapRequestRepository{requests: map[shift.SwapRequestID]*shift.SwapRequest{}},
+		&MockShiftSlotRepository{}, &MockShiftAssignmentRepository{}, &MockBusinessDayRepository{}, &MockEventRepository{},
+		&MockMemberRepository{}, &MockMemberRoleRepository{}, &MockMemberGroupRepository{},
+		&MockAvailabilityRepository{}, &MockWorkloadPolicyRepository{},
+		&MockTxManager{}, &MockClock{now: time.Now()},
+	)
+
+	_, err := uc.Execute(context.Background(), appshift.ClaimSwapRequestInput{
+		PublicToken: "not-a-token",
+		MemberID:    common.NewMemberID().String(),
+	})
+	if !common.IsNotFoundError(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+// =====================================================
+// Reject / Cancel Tests
+// =====================================================
+
+func TestRejectSwapRequestUsecase_KeepsAssignment(t *testing.T) {
+	tenantID := common.NewTenantID()
+	_, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	offered := createTestAssignment(t, slot, common.NewMemberID())
+	req := createTestSwapRequest(t, offered, shift.SwapRequestTypeDrop, true)
+	if err := req.Claim(time.Now(), common.NewMemberID(), nil); err != nil {
+		t.Fatalf("Claim should succeed, got error: %v", err)
+	}
+
+	reject := appshift.NewRejectSwapRequestUsecase(
+		&MockSwapRequestRepository{requests: map[shift.SwapRequestID]*shift.SwapRequest{req.SwapRequestID(): req}},
+		&MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	rejected, err := reject.Execute(context.Background(), appshift.RejectSwapRequestInput{
+		TenantID:      tenantID,
+		SwapRequestID: req.SwapRequestID(),
+		AdminID:       common.NewAdminID(),
+	})
+	if err != nil {
+		t.Fatalf("Reject should succeed, got error: %v", err)
+	}
+	if rejected.Status() != shift.SwapRequestStatusRejected {
+		t.Errorf("expected rejected, got %s", rejected.Status())
+	}
+	if !offered.IsConfirmed() {
+		t.Errorf("assignment should be kept after rejection")
+	}
+}
+
+func TestCancelSwapRequestUsecase_ErrorWhenNotRequester(t *testing.T) {
+	tenantID := common.NewTenantID()
+	_, slot := createTestSlotOnDate(t, tenantID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	offered := createTestAssignment(t, slot, common.NewMemberID())
+	req := createTestSwapRequest(t, offered, shift.SwapRequestTypeDrop, false)
+	otherID := common.NewMemberID()
+
+	cancel := appshift.NewCancelSwapRequestUsecase(
+		&MockSwapRequestRepository{requests: map[shift.SwapRequestID]*shift.SwapRequest{req.SwapRequestID(): req}},
+		&MockTxManager{}, &MockClock{now: time.Now()},
+	)
+	_, err := cancel.Execute(context.Background(), appshift.CancelSwapRequestInput{
+		TenantID:      tenantID,
+		SwapRequestID: req.SwapRequestID(),
+		ActorMemberID: &otherID,
+	})
+
+	var domainErr *common.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code() != common.ErrUnauthorized {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+package shift
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+// AssignmentWarningWorkloadLimitExceeded はメンバーの勤務量上限を超える割り当てであることを示す
+const AssignmentWarningWorkloadLimitExceeded = "WORKLOAD_LIMIT_EXCEEDED"
+
+// workloadLookbackDays は勤務量の判定に使用する既存シフトの取得範囲（前後の日数）
+// 対象日を含む週・月と、前後のシフトとの休憩時間を判定できる範囲
+const workloadLookbackDays = 31
+
+// workloadCheck is the result of evaluating an assignment against the member's workload policy
+type workloadCheck struct {
+	Enforcement member.WorkloadEnforcement
+	Violations  []member.WorkloadViolation
+}
+
+// Blocks returns true if the assignment must be rejected unless forced
+func (c workloadCheck) Blocks() bool {
+	return len(c.Violations) > 0 && c.Enforcement == member.WorkloadEnforcementBlock
+}
+
+// checkWorkload evaluates assigning the member to the slot on targetDate against their workload policy
+func checkWorkload(
+	ctx context.Context,
+	assignmentRepo shift.ShiftAssignmentRepository,
+	workloadPolicyRepo member.WorkloadPolicyRepository,
+	tenantID common.TenantID,
+	memberID common.MemberID,
+	slot *shift.ShiftSlot,
+	targetDate time.Time,
+) (workloadCheck, error) {
+	tenantPolicy, err := workloadPolicyRepo.FindTenantDefault(ctx, tenantID)
+	if err != nil && !common.IsNotFoundError(err) {
+		return workloadCheck{}, fmt.Errorf("failed to find tenant workload policy: %w", err)
+	}
+	memberPolicy, err := workloadPolicyRepo.FindByMemberID(ctx, tenantID, memberID)
+	if err != nil && !common.IsNotFoundError(err) {
+		return workloadCheck{}, fmt.Errorf("failed to find member workload policy: %w", err)
+	}
+
+	limits, enforcement := member.ResolveWorkloadLimits(tenantPolicy, memberPolicy)
+
+	shifts, err := assignmentRepo.FindConfirmedShiftsByDateRange(
+		ctx, tenantID, &memberID,
+		targetDate.AddDate(0, 0, -workloadLookbackDays), targetDate.AddDate(0, 0, workloadLookbackDays),
+	)
+	if err != nil {
+		return workloadCheck{}, fmt.Errorf("failed to find assigned shifts: %w", err)
+	}
+
+	start, end := slot.PeriodOn(targetDate)
+	candidate := member.WorkPeriod{Date: targetDate, Start: start, End: end}
+
+	return workloadCheck{
+		Enforcement: enforcement,
+		Violations:  limits.EvaluateAssignment(candidate, toWorkPeriods(shifts)),
+	}, nil
+}
+
+// toWorkPeriods converts assigned shifts to work periods for workload calculation
+func toWorkPeriods(shifts []shift.AssignedShift) []member.WorkPeriod {
+	periods := make([]member.WorkPeriod, 0, len(shifts))
+	for _, s := range shifts {
+		start, end := s.Period()
+		periods = append(periods, member.WorkPeriod{Date: s.TargetDate, Start: start, End: end})
+	}
+	return periods
+}
+
+// workloadWarnings converts workload violations to assignment warnings
+func workloadWarnings(check workloadCheck) []AssignmentWarning {
+	warnings := make([]AssignmentWarning, 0, len(check.Violations))
+	for _, v := range check.Violations {
+		var message string
+		switch v.Kind {
+		case member.WorkloadViolationMaxShiftsPerWeek:
+			message = fmt.Sprintf("member would have %d shifts in the week of %s (limit %d)", v.Actual, v.PeriodStart.Format("2006-01-02"), v.Limit)
+		case member.WorkloadViolationMaxShiftsPerMonth:
+			message = fmt.Sprintf("member would have %d shifts in %s (limit %d)", v.Actual, v.PeriodStart.Format("2006-01"), v.Limit)
+		case member.WorkloadViolationMaxMinutesPerBusinessDay:
+			message = fmt.Sprintf("member would work %d minutes on %s (limit %d)", v.Actual, v.PeriodStart.Format("2006-01-02"), v.Limit)
+		case member.WorkloadViolationMinRestBetweenShifts:
+			message = fmt.Sprintf("member would have only %d minutes of rest between shifts (minimum %d)", v.Actual, v.Limit)
+		default:
+			message = fmt.Sprintf("%s: %d (limit %d)", v.Kind, v.Actual, v.Limit)
+		}
+		warnings = append(warnings, AssignmentWarning{
+			Code:    AssignmentWarningWorkloadLimitExceeded,
+			Message: message,
+		})
+	}
+	return warnings
+}
@@ -0,0 +1,197 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
+)
+
+var (
+	// ErrInvalidBotToken is returned when the bot token is unknown or revoked
+	// トークンエラー → 401 を返す（存在するかどうかは返さない）
+	ErrInvalidBotToken = errors.New("invalid bot token")
+
+	// ErrDiscordUserNotLinked is returned when no active member has the Discord user ID
+	ErrDiscordUserNotLinked = errors.New("discord user is not linked to a member")
+)
+
+// =====================================================
+// Issue
+// =====================================================
+
+// IssueBotTokenInput represents the input for issuing a bot token
+type IssueBotTokenInput struct {
+	TenantID common.TenantID
+	Name     string
+}
+
+// IssueBotTokenOutput represents the issued bot token
+// Token はこの応答でのみ返す（再表示できない）
+type IssueBotTokenOutput struct {
+	BotToken *tenant.BotToken
+	Token    string
+}
+
+// IssueBotTokenUsecase handles issuing a bot token
+type IssueBotTokenUsecase struct {
+	tokenRepo tenant.BotTokenRepository
+	clock     services.Clock
+}
+
+// NewIssueBotTokenUsecase creates a new IssueBotTokenUsecase
+func NewIssueBotTokenUsecase(tokenRepo tenant.BotTokenRepository, clock services.Clock) *IssueBotTokenUsecase {
+	return &IssueBotTokenUsecase{
+		tokenRepo: tokenRepo,
+		clock:     clock,
+	}
+}
+
+// Execute issues a new bot token
+func (uc *IssueBotTokenUsecase) Execute(ctx context.Context, input IssueBotTokenInput) (*IssueBotTokenOutput, error) {
+	token, plain, err := tenant.NewBotToken(uc.clock.Now(), input.TenantID, input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.tokenRepo.Save(ctx, token); err != nil {
+		return nil, fmt.Errorf("failed to save bot token: %w", err)
+	}
+
+	return &IssueBotTokenOutput{
+		BotToken: token,
+		Token:    plain,
+	}, nil
+}
+
+// =====================================================
+// List / Revoke
+// =====================================================
+
+// ListBotTokensUsecase handles listing the bot tokens of a tenant
+type ListBotTokensUsecase struct {
+	tokenRepo tenant.BotTokenRepository
+}
+
+// NewListBotTokensUsecase creates a new ListBotTokensUsecase
+func NewListBotTokensUsecase(tokenRepo tenant.BotTokenRepository) *ListBotTokensUsecase {
+	return &ListBotTokensUsecase{
+		tokenRepo: tokenRepo,
+	}
+}
+
+// Execute lists the bot tokens of the tenant (取り消し済みも含む)
+func (uc *ListBotTokensUsecase) Execute(ctx context.Context, tenantID common.TenantID) ([]*tenant.BotToken, error) {
+	return uc.tokenRepo.FindByTenantID(ctx, tenantID)
+}
+
+// RevokeBotTokenInput represents the input for revoking a bot token
+type RevokeBotTokenInput struct {
+	TenantID common.TenantID
+	TokenID  tenant.BotTokenID
+}
+
+// RevokeBotTokenUsecase handles revoking a bot token
+type RevokeBotTokenUsecase struct {
+	tokenRepo tenant.BotTokenRepository
+	clock     services.Clock
+}
+
+// NewRevokeBotTokenUsecase creates a new RevokeBotTokenUsecase
+func NewRevokeBotTokenUsecase(tokenRepo tenant.BotTokenRepository, clock services.Clock) *RevokeBotTokenUsecase {
+	return &RevokeBotTokenUsecase{
+		tokenRepo: tokenRepo,
+		clock:     clock,
+	}
+}
+
+// Execute revokes the bot token
+func (uc *RevokeBotTokenUsecase) Execute(ctx context.Context, input RevokeBotTokenInput) (*tenant.BotToken, error) {
+	token, err := uc.tokenRepo.FindByID(ctx, input.TenantID, input.TokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := token.Revoke(uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.tokenRepo.Save(ctx, token); err != nil {
+		return nil, fmt.Errorf("failed to save bot token: %w", err)
+	}
+
+	return token, nil
+}
+
+// =====================================================
+// Authenticate
+// =====================================================
+
+// AuthenticateBotInput represents the input for authenticating a bot request
+type AuthenticateBotInput struct {
+	Token         string
+	DiscordUserID string // Bot を操作した Discord ユーザー
+}
+
+// AuthenticateBotOutput represents the tenant and member the bot acts for
+type AuthenticateBotOutput struct {
+	TenantID common.TenantID
+	Member   *member.Member
+}
+
+// AuthenticateBotUsecase resolves the tenant of a bot token and the member of a Discord user
+type AuthenticateBotUsecase struct {
+	tokenRepo  tenant.BotTokenRepository
+	memberRepo member.MemberRepository
+}
+
+// NewAuthenticateBotUsecase creates a new AuthenticateBotUsecase
+func NewAuthenticateBotUsecase(tokenRepo tenant.BotTokenRepository, memberRepo member.MemberRepository) *AuthenticateBotUsecase {
+	return &AuthenticateBotUsecase{
+		tokenRepo:  tokenRepo,
+		memberRepo: memberRepo,
+	}
+}
+
+// Execute authenticates the bot token and resolves the member
+// トークンのテナント内でのみメンバーを探すため、他テナントのメンバーとして振る舞うことはできない
+func (uc *AuthenticateBotUsecase) Execute(ctx context.Context, input AuthenticateBotInput) (*AuthenticateBotOutput, error) {
+	if !tenant.IsBotToken(input.Token) {
+		return nil, ErrInvalidBotToken
+	}
+
+	token, err := uc.tokenRepo.FindByHash(ctx, tenant.HashBotToken(input.Token))
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			return nil, ErrInvalidBotToken
+		}
+		return nil, err
+	}
+	if token.IsRevoked() {
+		return nil, ErrInvalidBotToken
+	}
+
+	if input.DiscordUserID == "" {
+		return nil, ErrDiscordUserNotLinked
+	}
+
+	m, err := uc.memberRepo.FindByDiscordUserID(ctx, token.TenantID(), input.DiscordUserID)
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			return nil, ErrDiscordUserNotLinked
+		}
+		return nil, err
+	}
+	if !m.IsActive() || m.IsDeleted() {
+		return nil, ErrDiscordUserNotLinked
+	}
+
+	return &AuthenticateBotOutput{
+		TenantID: token.TenantID(),
+		Member:   m,
+	}, nil
+}
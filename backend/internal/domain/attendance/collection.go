@@ -18,6 +18,7 @@ type AttendanceCollection struct {
 	publicToken  common.PublicToken
 	status       Status
 	deadline     *time.Time
+	autoClosedAt *time.Time // 締切を過ぎてシステムが締め切った日時（管理者が締め切った場合は nil）
 	createdAt    time.Time
 	updatedAt    time.Time
 	deletedAt    *time.Time
@@ -66,6 +67,7 @@ func ReconstructAttendanceCollection(
 	publicToken common.PublicToken,
 	status Status,
 	deadline *time.Time,
+	autoClosedAt *time.Time,
 	createdAt time.Time,
 	updatedAt time.Time,
 	deletedAt *time.Time,
@@ -80,6 +82,7 @@ func ReconstructAttendanceCollection(
 		publicToken:  publicToken,
 		status:       status,
 		deadline:     deadline,
+		autoClosedAt: autoClosedAt,
 		createdAt:    createdAt,
 		updatedAt:    updatedAt,
 		deletedAt:    deletedAt,
@@ -147,6 +150,12 @@ func (c *AttendanceCollection) Close(now time.Time) error {
 	return nil
 }
 
+// IsExpired は受付中のまま締切を過ぎているか（システムが締め切る対象か）を返す（ドメインルール）
+// 締め切る処理は Repository の CloseIfExpired が条件付き UPDATE で行い、autoClosedAt を記録する
+func (c *AttendanceCollection) IsExpired(now time.Time) bool {
+	return c.status == StatusOpen && c.deletedAt == nil && c.deadline != nil && now.After(*c.deadline)
+}
+
 // Delete はコレクションを削除済みにする（ソフトデリート）
 // now は App層から Clock 経由で渡される（Domain層で time.Now() を呼ばない）
 func (c *AttendanceCollection) Delete(now time.Time) error {
@@ -240,6 +249,15 @@ func (c *AttendanceCollection) Deadline() *time.Time {
 	return c.deadline
 }
 
+func (c *AttendanceCollection) AutoClosedAt() *time.Time {
+	return c.autoClosedAt
+}
+
+// IsAutoClosed reports whether the collection was closed by the system after its deadline
+func (c *AttendanceCollection) IsAutoClosed() bool {
+	return c.autoClosedAt != nil
+}
+
 func (c *AttendanceCollection) CreatedAt() time.Time {
 	return c.createdAt
 }
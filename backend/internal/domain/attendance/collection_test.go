@@ -214,6 +214,42 @@ func TestAttendanceCollection_Close_ErrorWhenAlreadyClosed(t *testing.T) {
 	}
 }
 
+func TestAttendanceCollection_IsExpired(t *testing.T) {
+	now := time.Now()
+	tenantID := common.NewTenantID()
+	deadline := now.Add(1 * time.Hour)
+
+	collection, _ := attendance.NewAttendanceCollection(
+		now, tenantID, "Test", "Desc", attendance.TargetTypeEvent, "", &deadline,
+	)
+
+	// 締切前は対象外
+	if collection.IsExpired(deadline) {
+		t.Fatal("IsExpired() should be false before the deadline")
+	}
+	if !collection.IsExpired(deadline.Add(1 * time.Minute)) {
+		t.Fatal("IsExpired() should be true after the deadline")
+	}
+
+	// 締め切ったものは対象外
+	_ = collection.Close(deadline.Add(1 * time.Minute))
+	if collection.IsExpired(deadline.Add(time.Hour)) {
+		t.Error("IsExpired() should be false for a closed collection")
+	}
+}
+
+func TestAttendanceCollection_IsExpired_IgnoresNoDeadline(t *testing.T) {
+	now := time.Now()
+	tenantID := common.NewTenantID()
+
+	noDeadline, _ := attendance.NewAttendanceCollection(
+		now, tenantID, "Test", "Desc", attendance.TargetTypeEvent, "", nil,
+	)
+	if noDeadline.IsExpired(now.Add(24 * time.Hour)) {
+		t.Error("IsExpired() should be false for a collection without deadline")
+	}
+}
+
 func TestAttendanceCollection_IsDeleted(t *testing.T) {
 	now := time.Now()
 	tenantID := common.NewTenantID()
@@ -346,6 +382,7 @@ func TestReconstructAttendanceCollection_Success(t *testing.T) {
 		publicToken,
 		attendance.StatusOpen,
 		&deadline,
+		nil,
 		now,
 		now,
 		nil,
@@ -384,6 +421,7 @@ func TestReconstructAttendanceCollection_WithClosedStatus(t *testing.T) {
 		publicToken,
 		attendance.StatusClosed,
 		nil,
+		nil,
 		now,
 		now,
 		nil,
@@ -415,6 +453,7 @@ func TestReconstructAttendanceCollection_WithDeletedAt(t *testing.T) {
 		publicToken,
 		attendance.StatusOpen,
 		nil,
+		nil,
 		now,
 		now,
 		&deletedAt,
@@ -2,6 +2,7 @@ package attendance
 
 import (
 	"context"
+	"time"
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 )
@@ -11,6 +12,10 @@ type AttendanceCollectionRepository interface {
 	// Save saves a collection (insert or update)
 	Save(ctx context.Context, collection *AttendanceCollection) error
 
+	// CloseIfExpired は締切を過ぎた受付中のコレクションを条件付き UPDATE で締め切る
+	// 閲覧時の自動締切が同時に行われた管理者の編集を上書きしないよう、全列の Save を使わない。締め切った場合は true を返す
+	CloseIfExpired(ctx context.Context, tenantID common.TenantID, id common.CollectionID, now time.Time) (bool, error)
+
 	// FindByID finds a collection by ID within a tenant
 	FindByID(ctx context.Context, tenantID common.TenantID, id common.CollectionID) (*AttendanceCollection, error)
 
@@ -0,0 +1,45 @@
+package attendance
+
+import "github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+
+// IsTargetMember reports whether a member is asked to respond to the collection
+// グループ・ロールの割り当てがない場合は全メンバーが対象
+// グループはいずれかに所属、ロールはいずれかを保持していれば該当し、両方ある場合は両方を満たすメンバー（AND 条件）
+func IsTargetMember(
+	groupAssignments []*CollectionGroupAssignment,
+	roleAssignments []*CollectionRoleAssignment,
+	memberGroupIDs []common.MemberGroupID,
+	memberRoleIDs []common.RoleID,
+) bool {
+	if len(groupAssignments) > 0 {
+		groups := make(map[common.MemberGroupID]bool, len(memberGroupIDs))
+		for _, id := range memberGroupIDs {
+			groups[id] = true
+		}
+		inGroup := false
+		for _, a := range groupAssignments {
+			if groups[a.GroupID()] {
+				inGroup = true
+				break
+			}
+		}
+		if !inGroup {
+			return false
+		}
+	}
+
+	if len(roleAssignments) > 0 {
+		roles := make(map[common.RoleID]bool, len(memberRoleIDs))
+		for _, id := range memberRoleIDs {
+			roles[id] = true
+		}
+		for _, a := range roleAssignments {
+			if roles[a.RoleID()] {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
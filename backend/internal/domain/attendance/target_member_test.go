@@ -0,0 +1,56 @@
+package attendance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+func TestIsTargetMember(t *testing.T) {
+	now := time.Now()
+	collectionID := common.NewCollectionID()
+	groupA := common.NewMemberGroupID()
+	groupB := common.NewMemberGroupID()
+	roleA := common.NewRoleID()
+	roleB := common.NewRoleID()
+
+	groupAssignment, err := attendance.NewCollectionGroupAssignment(now, collectionID, groupA)
+	if err != nil {
+		t.Fatalf("NewCollectionGroupAssignment() failed: %v", err)
+	}
+	roleAssignment, err := attendance.NewCollectionRoleAssignment(now, collectionID, roleA)
+	if err != nil {
+		t.Fatalf("NewCollectionRoleAssignment() failed: %v", err)
+	}
+	groups := []*attendance.CollectionGroupAssignment{groupAssignment}
+	roles := []*attendance.CollectionRoleAssignment{roleAssignment}
+
+	tests := []struct {
+		name         string
+		groups       []*attendance.CollectionGroupAssignment
+		roles        []*attendance.CollectionRoleAssignment
+		memberGroups []common.MemberGroupID
+		memberRoles  []common.RoleID
+		want         bool
+	}{
+		{"no assignments targets everyone", nil, nil, nil, nil, true},
+		{"member in assigned group", groups, nil, []common.MemberGroupID{groupB, groupA}, nil, true},
+		{"member not in assigned group", groups, nil, []common.MemberGroupID{groupB}, nil, false},
+		{"member with assigned role", nil, roles, nil, []common.RoleID{roleA}, true},
+		{"member without assigned role", nil, roles, nil, []common.RoleID{roleB}, false},
+		{"group and role both match", groups, roles, []common.MemberGroupID{groupA}, []common.RoleID{roleA}, true},
+		{"group matches but role does not", groups, roles, []common.MemberGroupID{groupA}, []common.RoleID{roleB}, false},
+		{"role matches but group does not", groups, roles, []common.MemberGroupID{groupB}, []common.RoleID{roleA}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := attendance.IsTargetMember(tt.groups, tt.roles, tt.memberGroups, tt.memberRoles)
+			if got != tt.want {
+				t.Errorf("IsTargetMember() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package common
+
+import (
+	"fmt"
+	"time"
+)
+
+// DateIn returns the calendar date of the instant t in loc, normalised to midnight UTC
+// 営業日の target_date（DATE 型）と同じ表現にそろえるため、日付のみを UTC の 0 時で返す
+func DateIn(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// StartOfDayIn returns the instant at which the calendar date begins in loc
+func StartOfDayIn(date time.Time, loc *time.Location) time.Time {
+	y, m, d := date.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
+
+// EndOfDayIn returns the last second of the calendar date in loc
+// DST の切り替え日でも 23:59:59（壁時計）を返す
+func EndOfDayIn(date time.Time, loc *time.Location) time.Time {
+	y, m, d := date.Date()
+	return time.Date(y, m, d, 23, 59, 59, 0, loc)
+}
+
+// CombineDateAndTimeIn returns the instant of the calendar date at the time-of-day of clock in loc
+func CombineDateAndTimeIn(date, clock time.Time, loc *time.Location) time.Time {
+	y, m, d := date.Date()
+	return time.Date(y, m, d, clock.Hour(), clock.Minute(), clock.Second(), 0, loc)
+}
+
+// deadlineLocalLayouts are the accepted deadline formats without a UTC offset
+var deadlineLocalLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+// ParseDeadlineIn parses a deadline string, interpreting values without a UTC offset in loc
+//
+// Accepted formats:
+//   - RFC3339 (e.g. "2025-01-10T23:59:00+09:00"): used as is
+//   - local date-time (e.g. "2025-01-10T23:59"): wall clock time in loc
+//   - date only (e.g. "2025-01-10"): end of that day (23:59:59) in loc
+func ParseDeadlineIn(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range deadlineLocalLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	if d, err := time.Parse("2006-01-02", value); err == nil {
+		return EndOfDayIn(d, loc), nil
+	}
+	return time.Time{}, NewValidationError(fmt.Sprintf("invalid deadline format: %q", value), nil)
+}
@@ -0,0 +1,187 @@
+package common
+
+import (
+	"testing"
+	"time"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("LoadLocation(%q) error = %v", name, err)
+	}
+	return loc
+}
+
+func TestDateIn(t *testing.T) {
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	newYork := mustLoadLocation(t, "America/New_York")
+
+	tests := []struct {
+		name    string
+		instant time.Time
+		loc     *time.Location
+		want    time.Time
+	}{
+		{
+			name:    "JST 00:30 is already the next day",
+			instant: time.Date(2025, 1, 9, 15, 30, 0, 0, time.UTC),
+			loc:     tokyo,
+			want:    time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "New York 22:00 EST is still the previous day",
+			instant: time.Date(2025, 1, 10, 3, 0, 0, 0, time.UTC),
+			loc:     newYork,
+			want:    time.Date(2025, 1, 9, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "New York after spring forward (EDT, UTC-4)",
+			instant: time.Date(2025, 3, 10, 3, 30, 0, 0, time.UTC),
+			loc:     newYork,
+			want:    time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "New York 04:30 UTC after spring forward is the next day",
+			instant: time.Date(2025, 3, 10, 4, 30, 0, 0, time.UTC),
+			loc:     newYork,
+			want:    time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DateIn(tt.instant, tt.loc); !got.Equal(tt.want) {
+				t.Errorf("DateIn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStartAndEndOfDayIn_DST(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+
+	// 2025-03-09 は夏時間開始日（23時間）、2025-11-02 は夏時間終了日（25時間）
+	tests := []struct {
+		name      string
+		date      time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+		wantHours float64
+	}{
+		{
+			name:      "spring forward",
+			date:      time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2025, 3, 9, 5, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2025, 3, 10, 3, 59, 59, 0, time.UTC),
+			wantHours: 23,
+		},
+		{
+			name:      "fall back",
+			date:      time.Date(2025, 11, 2, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2025, 11, 2, 4, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2025, 11, 3, 4, 59, 59, 0, time.UTC),
+			wantHours: 25,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := StartOfDayIn(tt.date, newYork)
+			end := EndOfDayIn(tt.date, newYork)
+			if !start.Equal(tt.wantStart) {
+				t.Errorf("StartOfDayIn() = %v, want %v", start.UTC(), tt.wantStart)
+			}
+			if !end.Equal(tt.wantEnd) {
+				t.Errorf("EndOfDayIn() = %v, want %v", end.UTC(), tt.wantEnd)
+			}
+			if got := end.Add(time.Second).Sub(start).Hours(); got != tt.wantHours {
+				t.Errorf("day length = %v hours, want %v", got, tt.wantHours)
+			}
+		})
+	}
+}
+
+func TestCombineDateAndTimeIn(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	clock := time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC)
+
+	winter := CombineDateAndTimeIn(time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), clock, newYork)
+	if want := time.Date(2025, 1, 11, 2, 0, 0, 0, time.UTC); !winter.Equal(want) {
+		t.Errorf("CombineDateAndTimeIn() winter = %v, want %v", winter.UTC(), want)
+	}
+
+	summer := CombineDateAndTimeIn(time.Date(2025, 7, 10, 0, 0, 0, 0, time.UTC), clock, newYork)
+	if want := time.Date(2025, 7, 11, 1, 0, 0, 0, time.UTC); !summer.Equal(want) {
+		t.Errorf("CombineDateAndTimeIn() summer = %v, want %v", summer.UTC(), want)
+	}
+}
+
+func TestParseDeadlineIn(t *testing.T) {
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	newYork := mustLoadLocation(t, "America/New_York")
+
+	tests := []struct {
+		name    string
+		value   string
+		loc     *time.Location
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:  "RFC3339 is used as is",
+			value: "2025-01-10T23:59:00+09:00",
+			loc:   newYork,
+			want:  time.Date(2025, 1, 10, 14, 59, 0, 0, time.UTC),
+		},
+		{
+			name:  "local date-time in JST",
+			value: "2025-01-10T23:59",
+			loc:   tokyo,
+			want:  time.Date(2025, 1, 10, 14, 59, 0, 0, time.UTC),
+		},
+		{
+			name:  "local date-time in EDT",
+			value: "2025-07-10T23:59:30",
+			loc:   newYork,
+			want:  time.Date(2025, 7, 11, 3, 59, 30, 0, time.UTC),
+		},
+		{
+			name:  "date only is end of day in EST",
+			value: "2025-01-10",
+			loc:   newYork,
+			want:  time.Date(2025, 1, 11, 4, 59, 59, 0, time.UTC),
+		},
+		{
+			name:  "date only on fall back day",
+			value: "2025-11-02",
+			loc:   newYork,
+			want:  time.Date(2025, 11, 3, 4, 59, 59, 0, time.UTC),
+		},
+		{
+			name:    "invalid",
+			value:   "10/01/2025",
+			loc:     tokyo,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDeadlineIn(tt.value, tt.loc)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseDeadlineIn() expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDeadlineIn() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseDeadlineIn() = %v, want %v", got.UTC(), tt.want)
+			}
+		})
+	}
+}
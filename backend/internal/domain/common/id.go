@@ -194,7 +194,7 @@ func ParseAssignmentID(s string) (AssignmentID, error) {
 	return AssignmentID(s), nil
 }
 
-// PublicToken represents a public access token for attendance collections and date schedules.
+// PublicToken represents a public access token for attendance collections, date schedules and shift swap requests.
 // It uses UUID v4 format (RFC 4122) for security and standardization.
 type PublicToken string
 
@@ -0,0 +1,328 @@
+package event
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// MaxBlackoutMoveDays is how many days an occurrence may be moved forward before it is dropped
+const MaxBlackoutMoveDays = 7
+
+// MaxBlackoutDatesPerSet is the maximum number of dates in a blackout date set
+const MaxBlackoutDatesPerSet = 1000
+
+// BlackoutDateSetID represents a blackout date set identifier
+type BlackoutDateSetID string
+
+// NewBlackoutDateSetIDWithTime creates a new BlackoutDateSetID using the provided time.
+func NewBlackoutDateSetIDWithTime(t time.Time) BlackoutDateSetID {
+	return BlackoutDateSetID(common.NewULIDWithTime(t))
+}
+
+func (id BlackoutDateSetID) String() string {
+	return string(id)
+}
+
+func (id BlackoutDateSetID) Validate() error {
+	if id == "" {
+		return common.NewValidationError("set_id is required", nil)
+	}
+	return common.ValidateULID(string(id))
+}
+
+// BlackoutAction represents what happens to an occurrence that falls on a blackout date
+type BlackoutAction string
+
+const (
+	BlackoutActionSkip BlackoutAction = "skip" // 開催しない
+	BlackoutActionMove BlackoutAction = "move" // 翌日（休業日でない最初の日）に移動する
+)
+
+func (a BlackoutAction) Validate() error {
+	switch a {
+	case BlackoutActionSkip, BlackoutActionMove:
+		return nil
+	default:
+		return common.NewValidationError(fmt.Sprintf("invalid blackout action: %s", a), nil)
+	}
+}
+
+// BlackoutDate represents a single closed date with an optional label (e.g. "元日")
+type BlackoutDate struct {
+	Date  time.Time // DATE型として扱う（UTC の 0 時）
+	Label string
+}
+
+// BlackoutDateSet represents a tenant-level set of dates on which recurring business days are not generated
+// 祝日・年末年始・VRChat のメンテナンス日などをまとめて管理する集約ルート
+type BlackoutDateSet struct {
+	setID       BlackoutDateSetID
+	tenantID    common.TenantID
+	name        string
+	description string
+	action      BlackoutAction
+	isActive    bool
+	dates       []BlackoutDate
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewBlackoutDateSet creates a new BlackoutDateSet entity
+func NewBlackoutDateSet(
+	now time.Time,
+	tenantID common.TenantID,
+	name string,
+	description string,
+	action BlackoutAction,
+	dates []BlackoutDate,
+) (*BlackoutDateSet, error) {
+	set := &BlackoutDateSet{
+		setID:       NewBlackoutDateSetIDWithTime(now),
+		tenantID:    tenantID,
+		name:        name,
+		description: description,
+		action:      action,
+		isActive:    true,
+		dates:       normalizeBlackoutDates(dates),
+		createdAt:   now,
+		updatedAt:   now,
+	}
+
+	if err := set.validate(); err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+// ReconstructBlackoutDateSet reconstructs a BlackoutDateSet entity from persistence
+func ReconstructBlackoutDateSet(
+	setID BlackoutDateSetID,
+	tenantID common.TenantID,
+	name string,
+	description string,
+	action BlackoutAction,
+	isActive bool,
+	dates []BlackoutDate,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*BlackoutDateSet, error) {
+	set := &BlackoutDateSet{
+		setID:       setID,
+		tenantID:    tenantID,
+		name:        name,
+		description: description,
+		action:      action,
+		isActive:    isActive,
+		dates:       normalizeBlackoutDates(dates),
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+
+	if err := set.validate(); err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+func (s *BlackoutDateSet) validate() error {
+	if err := s.tenantID.Validate(); err != nil {
+		return common.NewValidationError("tenant_id is required", err)
+	}
+	if s.name == "" {
+		return common.NewValidationError("name is required", nil)
+	}
+	if len(s.name) > 100 {
+		return common.NewValidationError("name must be 100 characters or less", nil)
+	}
+	if len(s.description) > 500 {
+		return common.NewValidationError("description must be 500 characters or less", nil)
+	}
+	if err := s.action.Validate(); err != nil {
+		return err
+	}
+	if len(s.dates) > MaxBlackoutDatesPerSet {
+		return common.NewValidationError(fmt.Sprintf("a blackout date set can contain at most %d dates", MaxBlackoutDatesPerSet), nil)
+	}
+	for _, d := range s.dates {
+		if len(d.Label) > 100 {
+			return common.NewValidationError("label must be 100 characters or less", nil)
+		}
+	}
+	return nil
+}
+
+// normalizeBlackoutDates truncates dates to midnight UTC, removes duplicates and sorts them
+// 同じ日付が複数ある場合は先に出現したラベルを残す
+func normalizeBlackoutDates(dates []BlackoutDate) []BlackoutDate {
+	seen := make(map[time.Time]bool, len(dates))
+	result := make([]BlackoutDate, 0, len(dates))
+	for _, d := range dates {
+		date := dateOnly(d.Date)
+		if seen[date] {
+			continue
+		}
+		seen[date] = true
+		result = append(result, BlackoutDate{Date: date, Label: d.Label})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Date.Before(result[j].Date) })
+	return result
+}
+
+// Update updates the name, description, action and active flag
+func (s *BlackoutDateSet) Update(now time.Time, name, description string, action BlackoutAction, isActive bool) error {
+	prev := *s
+	s.name = name
+	s.description = description
+	s.action = action
+	s.isActive = isActive
+	if err := s.validate(); err != nil {
+		*s = prev
+		return err
+	}
+	s.updatedAt = now
+	return nil
+}
+
+// ReplaceDates replaces all dates of the set
+func (s *BlackoutDateSet) ReplaceDates(now time.Time, dates []BlackoutDate) error {
+	prev := s.dates
+	s.dates = normalizeBlackoutDates(dates)
+	if err := s.validate(); err != nil {
+		s.dates = prev
+		return err
+	}
+	s.updatedAt = now
+	return nil
+}
+
+// AddDates merges dates into the set and returns how many dates were newly added
+// 既存の日付はラベルを含めてそのまま残す（インポートの再実行で重複しない）
+func (s *BlackoutDateSet) AddDates(now time.Time, dates []BlackoutDate) (int, error) {
+	before := len(s.dates)
+	if err := s.ReplaceDates(now, append(append([]BlackoutDate{}, s.dates...), dates...)); err != nil {
+		return 0, err
+	}
+	return len(s.dates) - before, nil
+}
+
+// Getters
+
+func (s *BlackoutDateSet) SetID() BlackoutDateSetID {
+	return s.setID
+}
+
+func (s *BlackoutDateSet) TenantID() common.TenantID {
+	return s.tenantID
+}
+
+func (s *BlackoutDateSet) Name() string {
+	return s.name
+}
+
+func (s *BlackoutDateSet) Description() string {
+	return s.description
+}
+
+func (s *BlackoutDateSet) Action() BlackoutAction {
+	return s.action
+}
+
+func (s *BlackoutDateSet) IsActive() bool {
+	return s.isActive
+}
+
+// Dates returns the dates in ascending order
+func (s *BlackoutDateSet) Dates() []BlackoutDate {
+	return s.dates
+}
+
+func (s *BlackoutDateSet) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *BlackoutDateSet) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+// BlackoutCalendar applies the active blackout date sets of a tenant to recurrence occurrences
+// 同じ日付が skip と move の両方に含まれる場合は skip を優先する
+type BlackoutCalendar struct {
+	skip map[time.Time]bool
+	move map[time.Time]bool
+}
+
+// NewBlackoutCalendar creates a BlackoutCalendar from blackout date sets (inactive sets are ignored)
+func NewBlackoutCalendar(sets []*BlackoutDateSet) *BlackoutCalendar {
+	c := &BlackoutCalendar{
+		skip: make(map[time.Time]bool),
+		move: make(map[time.Time]bool),
+	}
+	for _, s := range sets {
+		if !s.IsActive() {
+			continue
+		}
+		target := c.skip
+		if s.Action() == BlackoutActionMove {
+			target = c.move
+		}
+		for _, d := range s.Dates() {
+			target[d.Date] = true
+		}
+	}
+	return c
+}
+
+// IsBlackout reports whether the date is a blackout date
+func (c *BlackoutCalendar) IsBlackout(date time.Time) bool {
+	if c == nil {
+		return false
+	}
+	d := dateOnly(date)
+	return c.skip[d] || c.move[d]
+}
+
+// Resolve returns the date an occurrence on the given date is held on
+// 休業日でなければそのまま、skip なら false、move なら休業日でない翌日以降の最初の日（MaxBlackoutMoveDays 日以内）を返す
+func (c *BlackoutCalendar) Resolve(date time.Time) (time.Time, bool) {
+	d := dateOnly(date)
+	if c == nil || !c.IsBlackout(d) {
+		return d, true
+	}
+	if c.skip[d] {
+		return time.Time{}, false
+	}
+	for i := 1; i <= MaxBlackoutMoveDays; i++ {
+		next := d.AddDate(0, 0, i)
+		if !c.IsBlackout(next) {
+			return next, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Occurrences returns the occurrence dates of the rule within [from, to] after applying the blackout dates
+// 期間の直前の開催日が移動して期間内に入る場合も含め、移動後の日付で重複を除いて昇順に返す
+func (c *BlackoutCalendar) Occurrences(rule *RecurrenceRule, from, to time.Time) []time.Time {
+	from, to = dateOnly(from), dateOnly(to)
+	if c == nil {
+		return rule.Between(from, to)
+	}
+
+	seen := make(map[time.Time]bool)
+	var result []time.Time
+	for _, d := range rule.Between(from.AddDate(0, 0, -MaxBlackoutMoveDays), to) {
+		resolved, ok := c.Resolve(d)
+		if !ok || resolved.Before(from) || resolved.After(to) || seen[resolved] {
+			continue
+		}
+		seen[resolved] = true
+		result = append(result, resolved)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Before(result[j]) })
+	return result
+}
@@ -0,0 +1,22 @@
+package event
+
+import (
+	"context"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// BlackoutDateSetRepository defines the interface for BlackoutDateSet persistence
+type BlackoutDateSetRepository interface {
+	// Save saves a blackout date set with its dates (insert or update)
+	Save(ctx context.Context, set *BlackoutDateSet) error
+
+	// FindByID finds a blackout date set by ID within a tenant
+	FindByID(ctx context.Context, tenantID common.TenantID, setID BlackoutDateSetID) (*BlackoutDateSet, error)
+
+	// FindByTenantID finds all blackout date sets within a tenant
+	FindByTenantID(ctx context.Context, tenantID common.TenantID) ([]*BlackoutDateSet, error)
+
+	// Delete deletes a blackout date set with its dates (physical delete)
+	Delete(ctx context.Context, tenantID common.TenantID, setID BlackoutDateSetID) error
+}
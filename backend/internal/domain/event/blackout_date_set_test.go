@@ -0,0 +1,218 @@
+package event_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+)
+
+func mustNewBlackoutDateSet(t *testing.T, action event.BlackoutAction, dates ...time.Time) *event.BlackoutDateSet {
+	t.Helper()
+	blackoutDates := make([]event.BlackoutDate, len(dates))
+	for i, d := range dates {
+		blackoutDates[i] = event.BlackoutDate{Date: d}
+	}
+	set, err := event.NewBlackoutDateSet(time.Now(), common.NewTenantID(), "休業日", "", action, blackoutDates)
+	if err != nil {
+		t.Fatalf("NewBlackoutDateSet() should succeed, got error: %v", err)
+	}
+	return set
+}
+
+// =====================================================
+// BlackoutDateSet Tests
+// =====================================================
+
+func TestNewBlackoutDateSet_NormalizesDates(t *testing.T) {
+	set, err := event.NewBlackoutDateSet(time.Now(), common.NewTenantID(), "年末年始", "", event.BlackoutActionSkip, []event.BlackoutDate{
+		{Date: date(2025, 1, 3), Label: "三が日"},
+		{Date: time.Date(2025, 1, 1, 15, 30, 0, 0, time.UTC), Label: "元日"},
+		{Date: date(2025, 1, 1), Label: "重複"},
+	})
+	if err != nil {
+		t.Fatalf("NewBlackoutDateSet() should succeed, got error: %v", err)
+	}
+
+	dates := set.Dates()
+	if len(dates) != 2 {
+		t.Fatalf("Dates() should remove duplicates, got %d dates", len(dates))
+	}
+	if !dates[0].Date.Equal(date(2025, 1, 1)) || dates[0].Label != "元日" {
+		t.Errorf("first date = %s %q, want 2025-01-01 元日", dates[0].Date.Format(time.RFC3339), dates[0].Label)
+	}
+	if !dates[1].Date.Equal(date(2025, 1, 3)) {
+		t.Errorf("second date = %s, want 2025-01-03", dates[1].Date.Format("2006-01-02"))
+	}
+	if !set.IsActive() {
+		t.Error("a new set should be active")
+	}
+}
+
+func TestNewBlackoutDateSet_ErrorWhenInvalid(t *testing.T) {
+	tooMany := make([]event.BlackoutDate, event.MaxBlackoutDatesPerSet+1)
+	for i := range tooMany {
+		tooMany[i] = event.BlackoutDate{Date: date(2025, 1, 1).AddDate(0, 0, i)}
+	}
+
+	testCases := []struct {
+		name     string
+		tenantID common.TenantID
+		setName  string
+		action   event.BlackoutAction
+		dates    []event.BlackoutDate
+	}{
+		{"missing tenant", "", "休業日", event.BlackoutActionSkip, nil},
+		{"empty name", common.NewTenantID(), "", event.BlackoutActionSkip, nil},
+		{"name too long", common.NewTenantID(), strings.Repeat("a", 101), event.BlackoutActionSkip, nil},
+		{"invalid action", common.NewTenantID(), "休業日", "delete", nil},
+		{"too many dates", common.NewTenantID(), "休業日", event.BlackoutActionSkip, tooMany},
+		{"label too long", common.NewTenantID(), "休業日", event.BlackoutActionSkip, []event.BlackoutDate{{Date: date(2025, 1, 1), Label: strings.Repeat("a", 101)}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := event.NewBlackoutDateSet(time.Now(), tc.tenantID, tc.setName, "", tc.action, tc.dates); err == nil {
+				t.Error("NewBlackoutDateSet() should fail")
+			}
+		})
+	}
+}
+
+func TestBlackoutDateSet_AddDates(t *testing.T) {
+	set := mustNewBlackoutDateSet(t, event.BlackoutActionSkip, date(2025, 1, 1))
+
+	added, err := set.AddDates(time.Now(), []event.BlackoutDate{
+		{Date: date(2025, 1, 1), Label: "元日"},
+		{Date: date(2025, 1, 2)},
+	})
+	if err != nil {
+		t.Fatalf("AddDates() should succeed, got error: %v", err)
+	}
+	if added != 1 {
+		t.Errorf("AddDates() = %d, want 1 (2025-01-01 is already registered)", added)
+	}
+	if len(set.Dates()) != 2 {
+		t.Errorf("Dates() has %d dates, want 2", len(set.Dates()))
+	}
+}
+
+func TestBlackoutDateSet_Update_KeepsStateWhenInvalid(t *testing.T) {
+	set := mustNewBlackoutDateSet(t, event.BlackoutActionSkip)
+
+	if err := set.Update(time.Now(), "", "", event.BlackoutActionMove, false); err == nil {
+		t.Fatal("Update() should fail with an empty name")
+	}
+	if set.Name() != "休業日" || set.Action() != event.BlackoutActionSkip || !set.IsActive() {
+		t.Error("Update() should not change the set when validation fails")
+	}
+
+	if err := set.Update(time.Now(), "祝日", "", event.BlackoutActionMove, false); err != nil {
+		t.Fatalf("Update() should succeed, got error: %v", err)
+	}
+	if set.Name() != "祝日" || set.Action() != event.BlackoutActionMove || set.IsActive() {
+		t.Error("Update() should change the name, action and active flag")
+	}
+}
+
+// =====================================================
+// BlackoutCalendar Tests
+// =====================================================
+
+func TestBlackoutCalendar_Resolve(t *testing.T) {
+	skipSet := mustNewBlackoutDateSet(t, event.BlackoutActionSkip, date(2025, 1, 4))
+	moveSet := mustNewBlackoutDateSet(t, event.BlackoutActionMove, date(2025, 1, 11), date(2025, 1, 12), date(2025, 1, 4))
+	calendar := event.NewBlackoutCalendar([]*event.BlackoutDateSet{skipSet, moveSet})
+
+	testCases := []struct {
+		name   string
+		date   time.Time
+		want   string
+		wantOK bool
+	}{
+		{"normal day", date(2025, 1, 18), "2025-01-18", true},
+		{"skip wins over move", date(2025, 1, 4), "", false},
+		{"moved past consecutive blackout dates", date(2025, 1, 11), "2025-01-13", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := calendar.Resolve(tc.date)
+			if ok != tc.wantOK {
+				t.Fatalf("Resolve() ok = %v, want %v", ok, tc.wantOK)
+			}
+			if ok && got.Format("2006-01-02") != tc.want {
+				t.Errorf("Resolve() = %s, want %s", got.Format("2006-01-02"), tc.want)
+			}
+		})
+	}
+}
+
+func TestBlackoutCalendar_Resolve_DropsWhenNoDayWithinMoveLimit(t *testing.T) {
+	var dates []time.Time
+	for i := 0; i <= event.MaxBlackoutMoveDays; i++ {
+		dates = append(dates, date(2025, 1, 1).AddDate(0, 0, i))
+	}
+	calendar := event.NewBlackoutCalendar([]*event.BlackoutDateSet{mustNewBlackoutDateSet(t, event.BlackoutActionMove, dates...)})
+
+	if _, ok := calendar.Resolve(date(2025, 1, 1)); ok {
+		t.Error("Resolve() should drop an occurrence that cannot be moved within the limit")
+	}
+}
+
+func TestBlackoutCalendar_IgnoresInactiveSets(t *testing.T) {
+	set := mustNewBlackoutDateSet(t, event.BlackoutActionSkip, date(2025, 1, 4))
+	if err := set.Update(time.Now(), set.Name(), "", set.Action(), false); err != nil {
+		t.Fatalf("Update() should succeed, got error: %v", err)
+	}
+
+	calendar := event.NewBlackoutCalendar([]*event.BlackoutDateSet{set})
+	if calendar.IsBlackout(date(2025, 1, 4)) {
+		t.Error("inactive sets should not be applied")
+	}
+}
+
+func TestBlackoutCalendar_Occurrences(t *testing.T) {
+	// 2025-01-04 から毎週土曜日
+	rule := mustParseRecurrenceRule(t, "DTSTART:20250104\nRRULE:FREQ=WEEKLY;BYDAY=SA")
+	calendar := event.NewBlackoutCalendar([]*event.BlackoutDateSet{
+		mustNewBlackoutDateSet(t, event.BlackoutActionSkip, date(2025, 1, 11)),
+		// 1/25 は 1/26 へ、期間の直前の 2/1 は期間内の 2/2 へ移動する
+		mustNewBlackoutDateSet(t, event.BlackoutActionMove, date(2025, 1, 25), date(2025, 2, 1)),
+	})
+
+	got := formatDates(calendar.Occurrences(rule, date(2025, 1, 4), date(2025, 1, 31)))
+	if want := "2025-01-04,2025-01-18,2025-01-26"; got != want {
+		t.Errorf("Occurrences() = %s, want %s", got, want)
+	}
+
+	got = formatDates(calendar.Occurrences(rule, date(2025, 2, 2), date(2025, 2, 8)))
+	if want := "2025-02-02,2025-02-08"; got != want {
+		t.Errorf("Occurrences() = %s, want %s (moved occurrence from before the range)", got, want)
+	}
+}
+
+func TestBlackoutCalendar_Occurrences_DeduplicatesMovedOccurrences(t *testing.T) {
+	// 毎日開催で 1/1 を移動すると 1/2 と重複する
+	rule := mustParseRecurrenceRule(t, "DTSTART:20250101\nRRULE:FREQ=DAILY;COUNT=3")
+	calendar := event.NewBlackoutCalendar([]*event.BlackoutDateSet{
+		mustNewBlackoutDateSet(t, event.BlackoutActionMove, date(2025, 1, 1)),
+	})
+
+	got := formatDates(calendar.Occurrences(rule, date(2025, 1, 1), date(2025, 1, 31)))
+	if want := "2025-01-02,2025-01-03"; got != want {
+		t.Errorf("Occurrences() = %s, want %s", got, want)
+	}
+}
+
+func TestBlackoutCalendar_Occurrences_NilCalendar(t *testing.T) {
+	rule := mustParseRecurrenceRule(t, "DTSTART:20250104\nRRULE:FREQ=WEEKLY;BYDAY=SA")
+
+	var calendar *event.BlackoutCalendar
+	got := formatDates(calendar.Occurrences(rule, date(2025, 1, 1), date(2025, 1, 12)))
+	if want := "2025-01-04,2025-01-11"; got != want {
+		t.Errorf("Occurrences() = %s, want %s", got, want)
+	}
+}
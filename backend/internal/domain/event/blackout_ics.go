@@ -0,0 +1,169 @@
+package event
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// MaxICSEventDays is the maximum length of a single VEVENT imported as blackout dates
+const MaxICSEventDays = 366
+
+// ParseBlackoutICS parses the VEVENTs of an iCalendar (ICS) file into blackout dates
+//
+// 終日の予定（DTSTART;VALUE=DATE）は DTEND の前日まで、日時の予定は loc での日付に変換して
+// 開始日から終了日までの各日付を返す。SUMMARY をラベルとして使い、RRULE による繰り返しは展開しない。
+func ParseBlackoutICS(data []byte, loc *time.Location) ([]BlackoutDate, error) {
+	lines, err := unfoldICSLines(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result  []BlackoutDate
+		inEvent bool
+		start   *time.Time
+		end     *time.Time
+		allDay  bool
+		summary string
+	)
+
+	for i, line := range lines {
+		name, params, value := splitICSLine(line)
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			inEvent, start, end, allDay, summary = true, nil, nil, false, ""
+		case name == "END" && value == "VEVENT":
+			if !inEvent {
+				continue
+			}
+			inEvent = false
+			if start == nil {
+				return nil, common.NewValidationError(fmt.Sprintf("line %d: VEVENT without DTSTART", i+1), nil)
+			}
+			dates, err := icsEventDates(*start, end, allDay)
+			if err != nil {
+				return nil, common.NewValidationError(fmt.Sprintf("line %d: %s", i+1, err.Error()), nil)
+			}
+			for _, d := range dates {
+				result = append(result, BlackoutDate{Date: d, Label: summary})
+			}
+		case !inEvent:
+			continue
+		case name == "DTSTART" || name == "DTEND":
+			d, isDate, err := parseICSDateValue(params, value, loc)
+			if err != nil {
+				return nil, common.NewValidationError(fmt.Sprintf("line %d: %s", i+1, err.Error()), nil)
+			}
+			if name == "DTSTART" {
+				start, allDay = &d, isDate
+			} else {
+				end = &d
+			}
+		case name == "SUMMARY":
+			summary = unescapeICSText(value)
+		}
+	}
+
+	return result, nil
+}
+
+// unfoldICSLines splits the content into lines, joining folded lines (RFC 5545 3.1)
+func unfoldICSLines(data []byte) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, common.NewValidationError("failed to read ics file", err)
+	}
+	return lines, nil
+}
+
+// splitICSLine splits "NAME;PARAM=VALUE:value" into its name, parameters and value
+func splitICSLine(line string) (string, map[string]string, string) {
+	head, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return "", nil, ""
+	}
+	parts := strings.Split(head, ";")
+	params := make(map[string]string, len(parts)-1)
+	for _, p := range parts[1:] {
+		if k, v, ok := strings.Cut(p, "="); ok {
+			params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+		}
+	}
+	return strings.ToUpper(parts[0]), params, strings.TrimSpace(value)
+}
+
+// parseICSDateValue parses a DTSTART/DTEND value and reports whether it is a date (all-day) value
+func parseICSDateValue(params map[string]string, value string, loc *time.Location) (time.Time, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == len(icalDateFormat) {
+		d, err := time.Parse(icalDateFormat, value)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid date: %s", value)
+		}
+		return d, true, nil
+	}
+
+	var (
+		t   time.Time
+		err error
+	)
+	switch {
+	case strings.HasSuffix(value, "Z"):
+		t, err = time.Parse(icalDateTimeFormat+"Z", value)
+	case params["TZID"] != "":
+		tz, tzErr := time.LoadLocation(params["TZID"])
+		if tzErr != nil {
+			tz = loc
+		}
+		t, err = time.ParseInLocation(icalDateTimeFormat, value, tz)
+	default:
+		t, err = time.ParseInLocation(icalDateTimeFormat, value, loc)
+	}
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid date-time: %s", value)
+	}
+	return common.DateIn(t, loc), false, nil
+}
+
+// icsEventDates returns the dates covered by an event
+// 終日の予定の DTEND は翌日（含まない）、日時の予定の DTEND はその日を含む
+func icsEventDates(start time.Time, end *time.Time, allDay bool) ([]time.Time, error) {
+	last := start
+	if end != nil {
+		last = *end
+		if allDay {
+			last = last.AddDate(0, 0, -1)
+		}
+	}
+	if last.Before(start) {
+		last = start
+	}
+	if last.Sub(start) >= MaxICSEventDays*24*time.Hour {
+		return nil, fmt.Errorf("event must be shorter than %d days", MaxICSEventDays)
+	}
+
+	var dates []time.Time
+	for d := start; !d.After(last); d = d.AddDate(0, 0, 1) {
+		dates = append(dates, d)
+	}
+	return dates, nil
+}
+
+// unescapeICSText unescapes a TEXT value (RFC 5545 3.3.11)
+func unescapeICSText(value string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
@@ -0,0 +1,73 @@
+package event_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+)
+
+func TestParseBlackoutICS(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	ics := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART;VALUE=DATE:20251229\r\n" +
+		"DTEND;VALUE=DATE:20260101\r\n" +
+		"SUMMARY:年末\r\n" +
+		" 休み\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		// 2026-01-09 16:00 UTC は東京では 1/10
+		"DTSTART:20260109T160000Z\r\n" +
+		"DTEND:20260109T180000Z\r\n" +
+		"SUMMARY:VRChat メンテナンス\\, 定期\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	dates, err := event.ParseBlackoutICS([]byte(ics), tokyo)
+	if err != nil {
+		t.Fatalf("ParseBlackoutICS() should succeed, got error: %v", err)
+	}
+
+	want := []struct {
+		date  string
+		label string
+	}{
+		{"2025-12-29", "年末休み"},
+		{"2025-12-30", "年末休み"},
+		{"2025-12-31", "年末休み"},
+		{"2026-01-10", "VRChat メンテナンス, 定期"},
+	}
+	if len(dates) != len(want) {
+		t.Fatalf("ParseBlackoutICS() returned %d dates, want %d", len(dates), len(want))
+	}
+	for i, w := range want {
+		if got := dates[i].Date.Format("2006-01-02"); got != w.date || dates[i].Label != w.label {
+			t.Errorf("date %d = %s %q, want %s %q", i, got, dates[i].Label, w.date, w.label)
+		}
+	}
+}
+
+func TestParseBlackoutICS_ErrorWhenInvalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		ics  string
+	}{
+		{"missing DTSTART", "BEGIN:VEVENT\nSUMMARY:test\nEND:VEVENT\n"},
+		{"invalid date", "BEGIN:VEVENT\nDTSTART;VALUE=DATE:2025131\nEND:VEVENT\n"},
+		{"too long", "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20250101\nDTEND;VALUE=DATE:20270101\nEND:VEVENT\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := event.ParseBlackoutICS([]byte(tc.ics), time.UTC); err == nil {
+				t.Error("ParseBlackoutICS() should fail")
+			}
+		})
+	}
+}
@@ -19,12 +19,14 @@ type EventBusinessDayRepository interface {
 	FindByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) ([]*EventBusinessDay, error)
 
 	// FindByEventIDAndDateRange finds business days within a date range for an event
+	// Business days spanning midnight or multiple days are included if any part overlaps the range
 	FindByEventIDAndDateRange(ctx context.Context, tenantID common.TenantID, eventID common.EventID, startDate, endDate time.Time) ([]*EventBusinessDay, error)
 
 	// FindActiveByEventID finds all active business days for an event
 	FindActiveByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) ([]*EventBusinessDay, error)
 
 	// FindByTenantIDAndDate finds all business days on a specific date within a tenant
+	// Business days still in progress on the date (started on an earlier date) are included
 	FindByTenantIDAndDate(ctx context.Context, tenantID common.TenantID, date time.Time) ([]*EventBusinessDay, error)
 
 	// Delete deletes a business day (physical delete)
@@ -34,13 +36,19 @@ type EventBusinessDayRepository interface {
 	// ExistsByEventIDAndDate checks if a business day exists for the given event and date
 	ExistsByEventIDAndDate(ctx context.Context, tenantID common.TenantID, eventID common.EventID, date time.Time, startTime time.Time) (bool, error)
 
+	// ExistsByEventIDAndOccurrenceDate checks if a recurring business day was ever generated for the occurrence date
+	// 日時を変更・論理削除した営業日も含む（自動生成で作り直さないため）
+	ExistsByEventIDAndOccurrenceDate(ctx context.Context, tenantID common.TenantID, eventID common.EventID, occurrenceDate time.Time) (bool, error)
+
 	// FindRecentByTenantID finds recent N business days within a tenant (past only, oldest first)
-	// Used for actual attendance calculation
-	FindRecentByTenantID(ctx context.Context, tenantID common.TenantID, limit int) ([]*EventBusinessDay, error)
+	// today is the current date in the tenant's time zone (target_date <= today)
+	// Used for actual attendance calculation (cancelled business days are excluded)
+	FindRecentByTenantID(ctx context.Context, tenantID common.TenantID, today time.Time, limit int) ([]*EventBusinessDay, error)
 
 	// FindRecentByEventID finds recent N business days for a specific event
-	// If includeFuture is false, only past dates are returned (target_date <= CURRENT_DATE)
+	// If includeFuture is false, only past dates are returned (target_date <= today)
 	// If includeFuture is true, all dates including future are returned
-	// Used for actual attendance calculation filtered by event
-	FindRecentByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID, limit int, includeFuture bool) ([]*EventBusinessDay, error)
+	// today is the current date in the tenant's time zone
+	// Used for actual attendance calculation filtered by event (cancelled business days are excluded)
+	FindRecentByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID, today time.Time, limit int, includeFuture bool) ([]*EventBusinessDay, error)
 }
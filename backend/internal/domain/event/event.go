@@ -31,11 +31,12 @@ const (
 	RecurrenceTypeNone     RecurrenceType = "none"     // 定期なし
 	RecurrenceTypeWeekly   RecurrenceType = "weekly"   // 毎週
 	RecurrenceTypeBiweekly RecurrenceType = "biweekly" // 隔週
+	RecurrenceTypeRRule    RecurrenceType = "rrule"    // RFC 5545 RRULE
 )
 
 func (t RecurrenceType) Validate() error {
 	switch t {
-	case RecurrenceTypeNone, RecurrenceTypeWeekly, RecurrenceTypeBiweekly:
+	case RecurrenceTypeNone, RecurrenceTypeWeekly, RecurrenceTypeBiweekly, RecurrenceTypeRRule:
 		return nil
 	default:
 		return common.NewValidationError(fmt.Sprintf("invalid recurrence type: %s", t), nil)
@@ -54,8 +55,11 @@ type Event struct {
 	recurrenceType      RecurrenceType
 	recurrenceStartDate *time.Time // DATE型として扱う
 	recurrenceDayOfWeek *int       // 0-6: 日曜日=0, 土曜日=6
+	recurrenceRule      *RecurrenceRule
 	defaultStartTime    *time.Time // TIME型として扱う
 	defaultEndTime      *time.Time // TIME型として扱う
+	defaultTemplateID   *common.ShiftSlotTemplateID
+	archivedAt          *time.Time // アーカイブ済みのイベントは一覧・公開カレンダーに表示せず、営業日・割り当ての追加を受け付けない
 	createdAt           time.Time
 	updatedAt           time.Time
 	deletedAt           *time.Time
@@ -97,7 +101,46 @@ func NewEvent(
 	return event, nil
 }
 
+// NewEventWithRecurrenceRule creates a new Event entity recurring by an RRULE
+// recurrence_start_date には RRULE の DTSTART を使う
+func NewEventWithRecurrenceRule(
+	now time.Time,
+	tenantID common.TenantID,
+	eventName string,
+	eventType EventType,
+	description string,
+	rule *RecurrenceRule,
+	defaultStartTime *time.Time,
+	defaultEndTime *time.Time,
+) (*Event, error) {
+	event := &Event{
+		eventID:          common.NewEventID(),
+		tenantID:         tenantID,
+		eventName:        eventName,
+		eventType:        eventType,
+		description:      description,
+		isActive:         true,
+		recurrenceType:   RecurrenceTypeRRule,
+		recurrenceRule:   rule,
+		defaultStartTime: defaultStartTime,
+		defaultEndTime:   defaultEndTime,
+		createdAt:        now,
+		updatedAt:        now,
+	}
+	if rule != nil {
+		dtStart := rule.DTStart()
+		event.recurrenceStartDate = &dtStart
+	}
+
+	if err := event.validate(); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
 // ReconstructEvent reconstructs an Event entity from persistence
+// recurrenceRule は iCalendar 形式の文字列（RRULE を使わない場合は空文字）
 func ReconstructEvent(
 	eventID common.EventID,
 	tenantID common.TenantID,
@@ -108,12 +151,24 @@ func ReconstructEvent(
 	recurrenceType RecurrenceType,
 	recurrenceStartDate *time.Time,
 	recurrenceDayOfWeek *int,
+	recurrenceRule string,
 	defaultStartTime *time.Time,
 	defaultEndTime *time.Time,
+	defaultTemplateID *common.ShiftSlotTemplateID,
+	archivedAt *time.Time,
 	createdAt time.Time,
 	updatedAt time.Time,
 	deletedAt *time.Time,
 ) (*Event, error) {
+	var rule *RecurrenceRule
+	if recurrenceRule != "" {
+		var err error
+		rule, err = ParseRecurrenceRule(recurrenceRule, nil)
+		if err != nil {
+			return nil, common.NewValidationError("invalid recurrence_rule", err)
+		}
+	}
+
 	event := &Event{
 		eventID:             eventID,
 		tenantID:            tenantID,
@@ -124,8 +179,11 @@ func ReconstructEvent(
 		recurrenceType:      recurrenceType,
 		recurrenceStartDate: recurrenceStartDate,
 		recurrenceDayOfWeek: recurrenceDayOfWeek,
+		recurrenceRule:      rule,
 		defaultStartTime:    defaultStartTime,
 		defaultEndTime:      defaultEndTime,
+		defaultTemplateID:   defaultTemplateID,
+		archivedAt:          archivedAt,
 		createdAt:           createdAt,
 		updatedAt:           updatedAt,
 		deletedAt:           deletedAt,
@@ -171,6 +229,14 @@ func (e *Event) validate() error {
 		}
 	}
 
+	// recurrenceRule は recurrence_type が rrule の場合のみ必須
+	if e.recurrenceType == RecurrenceTypeRRule && e.recurrenceRule == nil {
+		return common.NewValidationError("recurrence_rule is required for rrule recurrence", nil)
+	}
+	if e.recurrenceType != RecurrenceTypeRRule && e.recurrenceRule != nil {
+		return common.NewValidationError("recurrence_rule is only allowed for rrule recurrence", nil)
+	}
+
 	return nil
 }
 
@@ -228,6 +294,10 @@ func (e *Event) RecurrenceDayOfWeek() *int {
 	return e.recurrenceDayOfWeek
 }
 
+func (e *Event) RecurrenceRule() *RecurrenceRule {
+	return e.recurrenceRule
+}
+
 func (e *Event) DefaultStartTime() *time.Time {
 	return e.defaultStartTime
 }
@@ -236,10 +306,50 @@ func (e *Event) DefaultEndTime() *time.Time {
 	return e.defaultEndTime
 }
 
+// DefaultTemplateID returns the shift slot template applied to automatically generated business days
+func (e *Event) DefaultTemplateID() *common.ShiftSlotTemplateID {
+	return e.defaultTemplateID
+}
+
+func (e *Event) ArchivedAt() *time.Time {
+	return e.archivedAt
+}
+
+func (e *Event) IsArchived() bool {
+	return e.archivedAt != nil
+}
+
 func (e *Event) HasRecurrence() bool {
 	return e.recurrenceType != RecurrenceTypeNone
 }
 
+// EffectiveRecurrenceRule returns the recurrence as an RRULE (nil when the event does not recur)
+// weekly / biweekly は開始日以降で最初の該当曜日を DTSTART とする FREQ=WEEKLY として扱う
+func (e *Event) EffectiveRecurrenceRule() (*RecurrenceRule, error) {
+	switch e.recurrenceType {
+	case RecurrenceTypeNone:
+		return nil, nil
+	case RecurrenceTypeRRule:
+		return e.recurrenceRule, nil
+	}
+
+	if e.recurrenceStartDate == nil || e.recurrenceDayOfWeek == nil {
+		return nil, common.NewValidationError("定期開催設定が不完全です", nil)
+	}
+
+	weekday := time.Weekday(*e.recurrenceDayOfWeek)
+	dtStart := dateOnly(*e.recurrenceStartDate)
+	for dtStart.Weekday() != weekday {
+		dtStart = dtStart.AddDate(0, 0, 1)
+	}
+
+	interval := 1
+	if e.recurrenceType == RecurrenceTypeBiweekly {
+		interval = 2
+	}
+	return NewWeeklyRecurrenceRule(dtStart, interval, weekday)
+}
+
 // UpdateEventName updates the event name
 func (e *Event) UpdateEventName(now time.Time, eventName string) error {
 	if eventName == "" {
@@ -260,6 +370,40 @@ func (e *Event) UpdateDescription(now time.Time, description string) {
 	e.updatedAt = now
 }
 
+// SetDefaultTemplate sets the default shift slot template (nil clears it)
+// テンプレートが同じイベントに属することは呼び出し側で確認する
+func (e *Event) SetDefaultTemplate(now time.Time, templateID *common.ShiftSlotTemplateID) {
+	e.defaultTemplateID = templateID
+	e.updatedAt = now
+}
+
+// CopyAs creates a new active event in the same tenant with the configuration of this event
+// 定期開催設定・デフォルト時刻を引き継ぐ。デフォルトテンプレートは複製先のテンプレートを呼び出し側で設定する
+func (e *Event) CopyAs(now time.Time, eventName string) (*Event, error) {
+	copied := &Event{
+		eventID:             common.NewEventID(),
+		tenantID:            e.tenantID,
+		eventName:           eventName,
+		eventType:           e.eventType,
+		description:         e.description,
+		isActive:            true,
+		recurrenceType:      e.recurrenceType,
+		recurrenceStartDate: e.recurrenceStartDate,
+		recurrenceDayOfWeek: e.recurrenceDayOfWeek,
+		recurrenceRule:      e.recurrenceRule,
+		defaultStartTime:    e.defaultStartTime,
+		defaultEndTime:      e.defaultEndTime,
+		createdAt:           now,
+		updatedAt:           now,
+	}
+
+	if err := copied.validate(); err != nil {
+		return nil, err
+	}
+
+	return copied, nil
+}
+
 // Activate activates the event
 func (e *Event) Activate(now time.Time) {
 	e.isActive = true
@@ -272,6 +416,38 @@ func (e *Event) Deactivate(now time.Time) {
 	e.updatedAt = now
 }
 
+// Archive archives the event
+// 営業日・割り当て・出欠などの履歴はそのまま参照できる
+func (e *Event) Archive(now time.Time) error {
+	if e.IsArchived() {
+		return common.NewConflictError("Event is already archived")
+	}
+
+	e.archivedAt = &now
+	e.updatedAt = now
+	return nil
+}
+
+// Restore restores an archived event
+func (e *Event) Restore(now time.Time) error {
+	if !e.IsArchived() {
+		return common.NewConflictError("Event is not archived")
+	}
+
+	e.archivedAt = nil
+	e.updatedAt = now
+	return nil
+}
+
+// EnsureNotArchived returns an error if the event is archived
+// 営業日・割り当ての追加前に呼び出す
+func (e *Event) EnsureNotArchived() error {
+	if e.IsArchived() {
+		return common.NewConflictError("アーカイブ済みのイベントは変更できません。復元してから操作してください")
+	}
+	return nil
+}
+
 // Delete marks the event as deleted (soft delete)
 func (e *Event) Delete(now time.Time) {
 	e.deletedAt = &now
@@ -2,6 +2,7 @@ package event
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
@@ -56,6 +57,26 @@ func ParseBusinessDayID(s string) (BusinessDayID, error) {
 	return BusinessDayID(s), nil
 }
 
+// MaxBusinessDaySpanDays is the maximum number of days a business day can span after its target date
+// 週末をまたぐフェスなどを想定（target_date から end_date までの日数）
+const MaxBusinessDaySpanDays = 7
+
+// MaxCancellationReasonLength is the maximum length of a cancellation reason
+const MaxCancellationReasonLength = 500
+
+// BusinessDayCancellation represents why and by whom a business day was cancelled
+type BusinessDayCancellation struct {
+	CancelledAt time.Time
+	CancelledBy *common.AdminID // 管理者以外（バッチ等）による中止の場合は nil
+	Reason      string
+}
+
+// AppliedTemplate records which shift slot template (and version) produced the slots of a business day
+type AppliedTemplate struct {
+	TemplateID common.ShiftSlotTemplateID
+	Version    int
+}
+
 // EventBusinessDay represents an event business day entity
 // Event とは独立したエンティティ（Event集約には含まれない）
 // Event は「営業の定義」、EventBusinessDay は「生成されたインスタンス」
@@ -66,11 +87,15 @@ type EventBusinessDay struct {
 	targetDate         time.Time // DATE型として扱う
 	startTime          time.Time // TIME型として扱う（HH:MM:SS）
 	endTime            time.Time // TIME型として扱う（HH:MM:SS）
+	endDate            time.Time // DATE型として扱う（終了時刻の日付。深夜営業は翌日、複数日営業は最終日）
 	occurrenceType     OccurrenceType
 	recurringPatternID *common.EventID // recurring の場合のみ
+	occurrenceDate     *time.Time      // DATE型として扱う（recurring の場合のみ。定期設定から生成された本来の開催日）
 	isActive           bool
 	validFrom          *time.Time // DATE型として扱う
 	validTo            *time.Time // DATE型として扱う
+	cancellation       *BusinessDayCancellation
+	appliedTemplate    *AppliedTemplate // シフト枠の生成元テンプレート（未適用の場合は nil）
 	createdAt          time.Time
 	updatedAt          time.Time
 	deletedAt          *time.Time
@@ -94,12 +119,18 @@ func NewEventBusinessDay(
 		targetDate:         truncateToDate(targetDate),
 		startTime:          truncateToTime(startTime),
 		endTime:            truncateToTime(endTime),
+		endDate:            naturalEndDate(targetDate, startTime, endTime),
 		occurrenceType:     occurrenceType,
 		recurringPatternID: recurringPatternID,
 		isActive:           true,
 		createdAt:          now,
 		updatedAt:          now,
 	}
+	// 日時を変更した後も、定期設定のどの開催日から生成されたかを保持する
+	if occurrenceType == OccurrenceTypeRecurring {
+		occurrenceDate := businessDay.targetDate
+		businessDay.occurrenceDate = &occurrenceDate
+	}
 
 	if err := businessDay.validate(); err != nil {
 		return nil, err
@@ -116,15 +147,28 @@ func ReconstructEventBusinessDay(
 	targetDate time.Time,
 	startTime time.Time,
 	endTime time.Time,
+	endDate time.Time,
 	occurrenceType OccurrenceType,
 	recurringPatternID *common.EventID,
+	occurrenceDate *time.Time,
 	isActive bool,
 	validFrom *time.Time,
 	validTo *time.Time,
+	cancellation *BusinessDayCancellation,
+	appliedTemplate *AppliedTemplate,
 	createdAt time.Time,
 	updatedAt time.Time,
 	deletedAt *time.Time,
 ) (*EventBusinessDay, error) {
+	// end_date 導入前のデータは開始・終了時刻から導出する
+	if endDate.IsZero() {
+		endDate = naturalEndDate(targetDate, startTime, endTime)
+	}
+	if occurrenceDate != nil {
+		d := truncateToDate(*occurrenceDate)
+		occurrenceDate = &d
+	}
+
 	businessDay := &EventBusinessDay{
 		businessDayID:      businessDayID,
 		tenantID:           tenantID,
@@ -132,11 +176,15 @@ func ReconstructEventBusinessDay(
 		targetDate:         truncateToDate(targetDate),
 		startTime:          truncateToTime(startTime),
 		endTime:            truncateToTime(endTime),
+		endDate:            truncateToDate(endDate),
 		occurrenceType:     occurrenceType,
 		recurringPatternID: recurringPatternID,
+		occurrenceDate:     occurrenceDate,
 		isActive:           isActive,
 		validFrom:          validFrom,
 		validTo:            validTo,
+		cancellation:       cancellation,
+		appliedTemplate:    appliedTemplate,
 		createdAt:          createdAt,
 		updatedAt:          updatedAt,
 		deletedAt:          deletedAt,
@@ -171,6 +219,9 @@ func (b *EventBusinessDay) validate() error {
 		if b.recurringPatternID != nil {
 			return common.NewValidationError("recurring_pattern_id must be null for special occurrence", nil)
 		}
+		if b.occurrenceDate != nil {
+			return common.NewValidationError("occurrence_date must be null for special occurrence", nil)
+		}
 	}
 
 	// valid_from と valid_to の整合性チェック
@@ -182,14 +233,60 @@ func (b *EventBusinessDay) validate() error {
 		return common.NewValidationError("valid_from and valid_to must be both set or both null", nil)
 	}
 
-	// 時刻の前後関係チェック（深夜営業対応）
-	// start_time < end_time OR end_time < start_time のどちらかを満たす必要がある
-	// （深夜営業の場合、end_time が start_time より前になる）
-	// このチェックは常に true になるため、省略可能だが、明示的に記述
+	// 中止理由の長さチェック
+	if b.cancellation != nil && len([]rune(b.cancellation.Reason)) > MaxCancellationReasonLength {
+		return common.NewValidationError(fmt.Sprintf("cancellation reason must be %d characters or less", MaxCancellationReasonLength), nil)
+	}
+
+	// 適用済みテンプレートの整合性チェック
+	if b.appliedTemplate != nil {
+		if err := b.appliedTemplate.TemplateID.Validate(); err != nil {
+			return common.NewValidationError("invalid applied template_id", err)
+		}
+		if b.appliedTemplate.Version < 1 {
+			return common.NewValidationError("applied template version must be at least 1", nil)
+		}
+	}
+
+	// 終了日のチェック（深夜営業・複数日営業対応）
+	// end_time <= start_time の場合は翌日以降に終了する必要がある
+	if err := validateEndDate(b.targetDate, b.startTime, b.endTime, b.endDate); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// validateEndDate checks that the end instant is after the start instant and within the max span
+func validateEndDate(targetDate, startTime, endTime, endDate time.Time) error {
+	span := daysBetween(targetDate, endDate)
+	if span < daysBetween(targetDate, naturalEndDate(targetDate, startTime, endTime)) {
+		return common.NewValidationError("end_date must be after the start of the business day", nil)
+	}
+	if span > MaxBusinessDaySpanDays {
+		return common.NewValidationError(fmt.Sprintf("business day must not span more than %d days", MaxBusinessDaySpanDays), nil)
+	}
+	return nil
+}
+
+// naturalEndDate returns the end date implied by the start/end time-of-day
+// 終了時刻が開始時刻以前の場合（22:00-03:00 など）は翌日とする
+func naturalEndDate(targetDate, startTime, endTime time.Time) time.Time {
+	date := truncateToDate(targetDate)
+	if !truncateToTime(endTime).After(truncateToTime(startTime)) {
+		return date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+// daysBetween returns the number of calendar days from one date to another
+// タイムゾーンの夏時間の影響を受けないよう、日付部分のみで計算する
+func daysBetween(from, to time.Time) int {
+	fy, fm, fd := from.Date()
+	ty, tm, td := to.Date()
+	return int(time.Date(ty, tm, td, 0, 0, 0, 0, time.UTC).Sub(time.Date(fy, fm, fd, 0, 0, 0, 0, time.UTC)).Hours() / 24)
+}
+
 // truncateToDate truncates a time to date only (YYYY-MM-DD 00:00:00)
 func truncateToDate(t time.Time) time.Time {
 	year, month, day := t.Date()
@@ -229,6 +326,53 @@ func (b *EventBusinessDay) EndTime() time.Time {
 	return b.endTime
 }
 
+// EndDate returns the date on which the business day ends
+func (b *EventBusinessDay) EndDate() time.Time {
+	return b.endDate
+}
+
+// SpanDays returns the number of days from the target date to the end date
+// 同日内の営業は 0、深夜営業は 1、複数日営業はそれ以上
+func (b *EventBusinessDay) SpanDays() int {
+	return daysBetween(b.targetDate, b.endDate)
+}
+
+// IsOvernight returns true if the business day ends on a later date than it starts
+func (b *EventBusinessDay) IsOvernight() bool {
+	return b.SpanDays() > 0
+}
+
+// StartAt returns the start instant of the business day as wall clock time in loc
+func (b *EventBusinessDay) StartAt(loc *time.Location) time.Time {
+	return combineDateAndTime(b.targetDate, b.startTime, loc)
+}
+
+// EndAt returns the end instant of the business day as wall clock time in loc
+func (b *EventBusinessDay) EndAt(loc *time.Location) time.Time {
+	return combineDateAndTime(b.endDate, b.endTime, loc)
+}
+
+// Duration returns the length of the business day
+// 壁時計での長さ（夏時間の切り替えは考慮しない）
+func (b *EventBusinessDay) Duration() time.Duration {
+	return b.EndAt(time.UTC).Sub(b.StartAt(time.UTC))
+}
+
+// DayOffsetFor returns the day offset from the target date at which a slot starting at startTime begins
+// 深夜営業・複数日営業で開始時刻より前の時刻は翌日の枠とみなす（複数日営業の 2 日目以降は明示的に指定する）
+func (b *EventBusinessDay) DayOffsetFor(startTime time.Time) int {
+	if b.SpanDays() > 0 && truncateToTime(startTime).Before(b.startTime) {
+		return 1
+	}
+	return 0
+}
+
+// combineDateAndTime combines a date with a time-of-day as wall clock time in loc
+func combineDateAndTime(date, clock time.Time, loc *time.Location) time.Time {
+	y, m, d := date.Date()
+	return time.Date(y, m, d, clock.Hour(), clock.Minute(), clock.Second(), 0, loc)
+}
+
 func (b *EventBusinessDay) OccurrenceType() OccurrenceType {
 	return b.occurrenceType
 }
@@ -237,6 +381,12 @@ func (b *EventBusinessDay) RecurringPatternID() *common.EventID {
 	return b.recurringPatternID
 }
 
+// OccurrenceDate returns the date of the recurrence this business day was generated for (nil for special business days)
+// Reschedule で日時を変更しても変わらないため、定期営業の自動生成はこの日付で作成済みかを判定する
+func (b *EventBusinessDay) OccurrenceDate() *time.Time {
+	return b.occurrenceDate
+}
+
 func (b *EventBusinessDay) IsActive() bool {
 	return b.isActive
 }
@@ -249,6 +399,28 @@ func (b *EventBusinessDay) ValidTo() *time.Time {
 	return b.validTo
 }
 
+func (b *EventBusinessDay) Cancellation() *BusinessDayCancellation {
+	return b.cancellation
+}
+
+// IsCancelled returns true if the business day has been cancelled
+func (b *EventBusinessDay) IsCancelled() bool {
+	return b.cancellation != nil
+}
+
+// EnsureNotCancelled returns a conflict error if the business day has been cancelled
+// 中止された営業日のシフト枠には新たに割り当てない
+func (b *EventBusinessDay) EnsureNotCancelled() error {
+	if b.IsCancelled() {
+		return common.NewConflictError("中止された営業日には割り当てできません。中止を取り消してから操作してください")
+	}
+	return nil
+}
+
+func (b *EventBusinessDay) AppliedTemplate() *AppliedTemplate {
+	return b.appliedTemplate
+}
+
 func (b *EventBusinessDay) CreatedAt() time.Time {
 	return b.createdAt
 }
@@ -293,6 +465,127 @@ func (b *EventBusinessDay) SetValidPeriod(now time.Time, validFrom, validTo *tim
 	return nil
 }
 
+// Cancel marks the business day as cancelled
+// 削除と異なりシフト枠・割り当ては監査のために残し、出席の集計からは除外される
+func (b *EventBusinessDay) Cancel(now time.Time, reason string, cancelledBy *common.AdminID) error {
+	if b.IsDeleted() {
+		return common.NewValidationError("deleted business day cannot be cancelled", nil)
+	}
+	if b.IsCancelled() {
+		return common.NewConflictError("Business day is already cancelled")
+	}
+	reason = strings.TrimSpace(reason)
+	if len([]rune(reason)) > MaxCancellationReasonLength {
+		return common.NewValidationError(fmt.Sprintf("cancellation reason must be %d characters or less", MaxCancellationReasonLength), nil)
+	}
+
+	b.cancellation = &BusinessDayCancellation{
+		CancelledAt: now,
+		CancelledBy: cancelledBy,
+		Reason:      reason,
+	}
+	b.updatedAt = now
+	return nil
+}
+
+// Uncancel reverts the cancellation of the business day
+func (b *EventBusinessDay) Uncancel(now time.Time) error {
+	if !b.IsCancelled() {
+		return common.NewConflictError("Business day is not cancelled")
+	}
+
+	b.cancellation = nil
+	b.updatedAt = now
+	return nil
+}
+
+// RecordAppliedTemplate records the template version the slots of this business day were generated from
+// テンプレート更新後に「将来の営業日へ反映」する際の差分検出に使用する
+func (b *EventBusinessDay) RecordAppliedTemplate(now time.Time, templateID common.ShiftSlotTemplateID, version int) error {
+	if err := templateID.Validate(); err != nil {
+		return common.NewValidationError("invalid template_id", err)
+	}
+	if version < 1 {
+		return common.NewValidationError("template version must be at least 1", nil)
+	}
+
+	b.appliedTemplate = &AppliedTemplate{
+		TemplateID: templateID,
+		Version:    version,
+	}
+	b.updatedAt = now
+	return nil
+}
+
+// SetEndDate sets the end date of the business day (for multi-day business days)
+// 開始時刻より後、かつ MaxBusinessDaySpanDays 以内である必要がある
+func (b *EventBusinessDay) SetEndDate(now time.Time, endDate time.Time) error {
+	if endDate.IsZero() {
+		return common.NewValidationError("end_date is required", nil)
+	}
+	if err := validateEndDate(b.targetDate, b.startTime, b.endTime, endDate); err != nil {
+		return err
+	}
+
+	b.endDate = truncateToDate(endDate)
+	b.updatedAt = now
+	return nil
+}
+
+// Reschedule changes the date and the start/end time of the business day
+// 深夜営業（end_time < start_time）も許容する
+// 複数日営業の場合は、開始日からの日数を保ったまま終了日も移動する
+// 定期営業の本来の開催日（OccurrenceDate）は変更しない
+func (b *EventBusinessDay) Reschedule(now time.Time, targetDate, startTime, endTime time.Time) error {
+	if targetDate.IsZero() {
+		return common.NewValidationError("target_date is required", nil)
+	}
+	start, end := truncateToTime(startTime), truncateToTime(endTime)
+	if start.Equal(end) {
+		return common.NewValidationError("start_time and end_time must be different", nil)
+	}
+
+	extraDays := b.SpanDays() - daysBetween(b.targetDate, naturalEndDate(b.targetDate, b.startTime, b.endTime))
+	endDate := naturalEndDate(targetDate, start, end).AddDate(0, 0, extraDays)
+	if err := validateEndDate(targetDate, start, end, endDate); err != nil {
+		return err
+	}
+
+	b.targetDate = truncateToDate(targetDate)
+	b.startTime = start
+	b.endTime = end
+	b.endDate = endDate
+	b.updatedAt = now
+	return nil
+}
+
+// CopyTo creates a new business day of another event with the same schedule
+// 中止・適用済みテンプレートは引き継がない（定期パターンは複製元のイベントに属するため nil とする）
+func (b *EventBusinessDay) CopyTo(now time.Time, eventID common.EventID) (*EventBusinessDay, error) {
+	copied := &EventBusinessDay{
+		businessDayID:  NewBusinessDayIDWithTime(now),
+		tenantID:       b.tenantID,
+		eventID:        eventID,
+		targetDate:     b.targetDate,
+		startTime:      b.startTime,
+		endTime:        b.endTime,
+		endDate:        b.endDate,
+		occurrenceType: b.occurrenceType,
+		occurrenceDate: b.occurrenceDate,
+		isActive:       b.isActive,
+		validFrom:      b.validFrom,
+		validTo:        b.validTo,
+		createdAt:      now,
+		updatedAt:      now,
+	}
+
+	if err := copied.validate(); err != nil {
+		return nil, err
+	}
+
+	return copied, nil
+}
+
 // Delete marks the business day as deleted (soft delete)
 func (b *EventBusinessDay) Delete(now time.Time) {
 	b.deletedAt = &now
@@ -301,7 +594,7 @@ func (b *EventBusinessDay) Delete(now time.Time) {
 
 // IsValidOn checks if the business day is valid on the given date
 func (b *EventBusinessDay) IsValidOn(date time.Time) bool {
-	if !b.isActive {
+	if !b.isActive || b.IsCancelled() {
 		return false
 	}
 
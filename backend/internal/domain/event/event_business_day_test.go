@@ -1,6 +1,7 @@
 package event_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -333,11 +334,15 @@ func TestReconstructEventBusinessDay_Success(t *testing.T) {
 		targetDate,
 		startTime,
 		endTime,
+		targetDate,
 		event.OccurrenceTypeSpecial,
 		nil,
+		nil,
 		true,
 		nil,
 		nil,
+		nil,
+		nil,
 		now,
 		now,
 		nil,
@@ -370,11 +375,15 @@ func TestReconstructEventBusinessDay_WithValidPeriod(t *testing.T) {
 		targetDate,
 		startTime,
 		endTime,
+		targetDate,
 		event.OccurrenceTypeSpecial,
 		nil,
+		nil,
 		true,
 		&validFrom,
 		&validTo,
+		nil,
+		nil,
 		now,
 		now,
 		nil,
@@ -407,11 +416,15 @@ func TestReconstructEventBusinessDay_ErrorWhenValidFromAfterValidTo(t *testing.T
 		targetDate,
 		startTime,
 		endTime,
+		targetDate,
 		event.OccurrenceTypeSpecial,
 		nil,
+		nil,
 		true,
 		&validFrom,
 		&validTo,
+		nil,
+		nil,
 		now,
 		now,
 		nil,
@@ -439,11 +452,15 @@ func TestReconstructEventBusinessDay_ErrorWhenOnlyValidFromSet(t *testing.T) {
 		targetDate,
 		startTime,
 		endTime,
+		targetDate,
 		event.OccurrenceTypeSpecial,
 		nil,
+		nil,
 		true,
 		&validFrom,
 		nil, // Only validFrom set
+		nil,
+		nil,
 		now,
 		now,
 		nil,
@@ -638,3 +655,199 @@ func TestEventBusinessDay_DayOfWeek(t *testing.T) {
 		t.Errorf("DayOfWeekString() mismatch: got %v, want %v", bd.DayOfWeekString(), event.Monday)
 	}
 }
+
+func TestEventBusinessDay_Reschedule(t *testing.T) {
+	now := time.Now()
+	startTime := time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC)
+	endTime := time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC)
+	bd, _ := event.NewEventBusinessDay(now, common.NewTenantID(), common.NewEventID(),
+		time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), startTime, endTime, event.OccurrenceTypeSpecial, nil)
+
+	later := now.Add(time.Hour)
+	err := bd.Reschedule(later, time.Date(2025, 2, 2, 15, 0, 0, 0, time.UTC), startTime.Add(time.Hour), endTime.Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("Reschedule() should succeed, got error: %v", err)
+	}
+	if got := bd.TargetDate().Format("2006-01-02"); got != "2025-02-02" {
+		t.Errorf("TargetDate() = %s, want 2025-02-02", got)
+	}
+	if got := bd.StartTime().Format("15:04") + "-" + bd.EndTime().Format("15:04"); got != "22:00-01:00" {
+		t.Errorf("time = %s, want 22:00-01:00", got)
+	}
+	if !bd.UpdatedAt().Equal(later) {
+		t.Error("UpdatedAt should be updated")
+	}
+
+	if err := bd.Reschedule(later, bd.TargetDate(), startTime, startTime); err == nil {
+		t.Error("Reschedule() should fail when start_time equals end_time")
+	}
+}
+
+func TestEventBusinessDay_Reschedule_KeepsOccurrenceDate(t *testing.T) {
+	now := time.Now()
+	startTime := time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC)
+	endTime := time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC)
+	bd, _ := event.NewEventBusinessDay(now, common.NewTenantID(), common.NewEventID(),
+		time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), startTime, endTime, event.OccurrenceTypeRecurring, nil)
+
+	if err := bd.Reschedule(now, time.Date(2025, 2, 2, 0, 0, 0, 0, time.UTC), startTime, endTime); err != nil {
+		t.Fatalf("Reschedule() should succeed, got error: %v", err)
+	}
+	if bd.OccurrenceDate() == nil || bd.OccurrenceDate().Format("2006-01-02") != "2025-02-01" {
+		t.Errorf("OccurrenceDate() = %v, want 2025-02-01", bd.OccurrenceDate())
+	}
+
+	special, _ := event.NewEventBusinessDay(now, common.NewTenantID(), common.NewEventID(),
+		time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), startTime, endTime, event.OccurrenceTypeSpecial, nil)
+	if special.OccurrenceDate() != nil {
+		t.Errorf("OccurrenceDate() should be nil for a special business day, got %v", special.OccurrenceDate())
+	}
+}
+
+func TestEventBusinessDay_CancelAndUncancel(t *testing.T) {
+	now := time.Now()
+	startTime := time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC)
+	endTime := time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC)
+	targetDate := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	bd, _ := event.NewEventBusinessDay(now, common.NewTenantID(), common.NewEventID(),
+		targetDate, startTime, endTime, event.OccurrenceTypeSpecial, nil)
+	adminID := common.NewAdminID()
+
+	if err := bd.Cancel(now, "  機材トラブル  ", &adminID); err != nil {
+		t.Fatalf("Cancel() should succeed, got error: %v", err)
+	}
+	if !bd.IsCancelled() || bd.Cancellation().Reason != "機材トラブル" || *bd.Cancellation().CancelledBy != adminID {
+		t.Errorf("Cancel() recorded %+v", bd.Cancellation())
+	}
+	if !bd.IsActive() {
+		t.Error("Cancel() should not deactivate the business day")
+	}
+	if bd.IsValidOn(targetDate) {
+		t.Error("Cancelled business day should not be valid")
+	}
+	if err := bd.EnsureNotCancelled(); err == nil {
+		t.Error("EnsureNotCancelled() should fail when cancelled")
+	}
+	if err := bd.Cancel(now, "", nil); err == nil {
+		t.Error("Cancel() should fail when already cancelled")
+	}
+
+	if err := bd.Uncancel(now); err != nil {
+		t.Fatalf("Uncancel() should succeed, got error: %v", err)
+	}
+	if bd.IsCancelled() {
+		t.Error("Uncancel() should clear the cancellation")
+	}
+	if err := bd.EnsureNotCancelled(); err != nil {
+		t.Errorf("EnsureNotCancelled() should succeed after Uncancel(), got error: %v", err)
+	}
+	if err := bd.Uncancel(now); err == nil {
+		t.Error("Uncancel() should fail when not cancelled")
+	}
+}
+
+func TestEventBusinessDay_Cancel_ErrorWhenReasonTooLong(t *testing.T) {
+	now := time.Now()
+	bd, _ := event.NewEventBusinessDay(now, common.NewTenantID(), common.NewEventID(),
+		time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+		event.OccurrenceTypeSpecial, nil)
+
+	if err := bd.Cancel(now, strings.Repeat("あ", event.MaxCancellationReasonLength+1), nil); err == nil {
+		t.Error("Cancel() should fail when reason is too long")
+	}
+	if bd.IsCancelled() {
+		t.Error("business day should not be cancelled after a failed Cancel()")
+	}
+}
+
+func TestEventBusinessDay_Overnight_StartAndEndInstants(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+	bd, err := event.NewEventBusinessDay(time.Now(), common.NewTenantID(), common.NewEventID(),
+		time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 3, 0, 0, 0, time.UTC),
+		event.OccurrenceTypeSpecial, nil)
+	if err != nil {
+		t.Fatalf("NewEventBusinessDay() should succeed, got error: %v", err)
+	}
+
+	if !bd.IsOvernight() || bd.EndDate().Format("2006-01-02") != "2025-02-01" {
+		t.Errorf("EndDate() = %s, want 2025-02-01 (overnight)", bd.EndDate().Format("2006-01-02"))
+	}
+	if got := bd.StartAt(tokyo).Format(time.RFC3339); got != "2025-01-31T22:00:00+09:00" {
+		t.Errorf("StartAt() = %s", got)
+	}
+	if got := bd.EndAt(tokyo).Format(time.RFC3339); got != "2025-02-01T03:00:00+09:00" {
+		t.Errorf("EndAt() = %s", got)
+	}
+	if bd.Duration() != 5*time.Hour {
+		t.Errorf("Duration() = %v, want 5h", bd.Duration())
+	}
+	if bd.DayOffsetFor(time.Date(2000, 1, 1, 1, 0, 0, 0, time.UTC)) != 1 || bd.DayOffsetFor(time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC)) != 0 {
+		t.Error("DayOffsetFor() should place times before the start on the next day")
+	}
+}
+
+func TestEventBusinessDay_SetEndDate_MultiDay(t *testing.T) {
+	now := time.Now()
+	// 土曜 18:00 〜 日曜 23:00 のフェス
+	bd, _ := event.NewEventBusinessDay(now, common.NewTenantID(), common.NewEventID(),
+		time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 18, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+		event.OccurrenceTypeSpecial, nil)
+
+	if err := bd.SetEndDate(now, time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("SetEndDate() should succeed, got error: %v", err)
+	}
+	if bd.SpanDays() != 1 || bd.Duration() != 29*time.Hour {
+		t.Errorf("SpanDays() = %d, Duration() = %v, want 1, 29h", bd.SpanDays(), bd.Duration())
+	}
+
+	if err := bd.SetEndDate(now, time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("SetEndDate() should fail when end_date is before target_date")
+	}
+	if err := bd.SetEndDate(now, time.Date(2025, 3, 1+event.MaxBusinessDaySpanDays+1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("SetEndDate() should fail when the span is too long")
+	}
+
+	// 日付を移動しても日数は保たれる
+	if err := bd.Reschedule(now, time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC), bd.StartTime(), bd.EndTime()); err != nil {
+		t.Fatalf("Reschedule() should succeed, got error: %v", err)
+	}
+	if got := bd.EndDate().Format("2006-01-02"); got != "2025-03-09" {
+		t.Errorf("EndDate() after Reschedule() = %s, want 2025-03-09", got)
+	}
+}
+
+func TestEventBusinessDay_RecordAppliedTemplate(t *testing.T) {
+	now := time.Now()
+	bd, _ := event.NewEventBusinessDay(now, common.NewTenantID(), common.NewEventID(),
+		time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+		event.OccurrenceTypeSpecial, nil)
+
+	if bd.AppliedTemplate() != nil {
+		t.Fatal("AppliedTemplate() should be nil for a new business day")
+	}
+
+	templateID := common.NewShiftSlotTemplateID()
+	if err := bd.RecordAppliedTemplate(now, templateID, 3); err != nil {
+		t.Fatalf("RecordAppliedTemplate() should succeed, got error: %v", err)
+	}
+	if at := bd.AppliedTemplate(); at == nil || at.TemplateID != templateID || at.Version != 3 {
+		t.Errorf("AppliedTemplate() = %+v, want %v v3", at, templateID)
+	}
+
+	if err := bd.RecordAppliedTemplate(now, templateID, 0); err == nil {
+		t.Error("RecordAppliedTemplate() should fail when version is 0")
+	}
+	if err := bd.RecordAppliedTemplate(now, "", 1); err == nil {
+		t.Error("RecordAppliedTemplate() should fail when template_id is empty")
+	}
+	if bd.AppliedTemplate().Version != 3 {
+		t.Error("AppliedTemplate() should not change on error")
+	}
+}
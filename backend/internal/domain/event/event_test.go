@@ -221,6 +221,26 @@ func TestEvent_ActivateDeactivate(t *testing.T) {
 	}
 }
 
+func TestEvent_SetDefaultTemplate(t *testing.T) {
+	tenantID := common.NewTenantID()
+	event := createTestEvent(t, tenantID, "テストイベント", EventTypeNormal, "説明")
+
+	if event.DefaultTemplateID() != nil {
+		t.Error("DefaultTemplateID should be nil by default")
+	}
+
+	templateID := common.NewShiftSlotTemplateID()
+	event.SetDefaultTemplate(time.Now(), &templateID)
+	if event.DefaultTemplateID() == nil || *event.DefaultTemplateID() != templateID {
+		t.Errorf("DefaultTemplateID = %v, want %s", event.DefaultTemplateID(), templateID)
+	}
+
+	event.SetDefaultTemplate(time.Now(), nil)
+	if event.DefaultTemplateID() != nil {
+		t.Error("DefaultTemplateID should be nil after clearing")
+	}
+}
+
 func TestEvent_Delete(t *testing.T) {
 	tenantID := common.NewTenantID()
 	event := createTestEvent(t, tenantID, "テストイベント", EventTypeNormal, "説明")
@@ -277,6 +297,7 @@ func TestRecurrenceType_Validate(t *testing.T) {
 		{"None type is valid", RecurrenceTypeNone, false},
 		{"Weekly type is valid", RecurrenceTypeWeekly, false},
 		{"Biweekly type is valid", RecurrenceTypeBiweekly, false},
+		{"RRule type is valid", RecurrenceTypeRRule, false},
 		{"Invalid type returns error", RecurrenceType("invalid"), true},
 		{"Empty type returns error", RecurrenceType(""), true},
 	}
@@ -290,3 +311,165 @@ func TestRecurrenceType_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestNewEventWithRecurrenceRule(t *testing.T) {
+	rule, err := ParseRecurrenceRule("DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYDAY=-1FR", nil)
+	if err != nil {
+		t.Fatalf("ParseRecurrenceRule() should succeed, got error: %v", err)
+	}
+
+	e, err := NewEventWithRecurrenceRule(time.Now(), common.NewTenantID(), "月末金曜集会", EventTypeNormal, "", rule, nil, nil)
+	if err != nil {
+		t.Fatalf("NewEventWithRecurrenceRule() should succeed, got error: %v", err)
+	}
+	if e.RecurrenceType() != RecurrenceTypeRRule || !e.HasRecurrence() {
+		t.Errorf("RecurrenceType: expected rrule, got %s", e.RecurrenceType())
+	}
+	if e.RecurrenceStartDate() == nil || !e.RecurrenceStartDate().Equal(rule.DTStart()) {
+		t.Errorf("RecurrenceStartDate should be DTSTART, got %v", e.RecurrenceStartDate())
+	}
+
+	if _, err := NewEventWithRecurrenceRule(time.Now(), common.NewTenantID(), "月末金曜集会", EventTypeNormal, "", nil, nil, nil); err == nil {
+		t.Error("NewEventWithRecurrenceRule() should fail without a rule")
+	}
+}
+
+func TestReconstructEvent_RecurrenceRule(t *testing.T) {
+	now := time.Now()
+	text := "DTSTART;VALUE=DATE:20250101\nRRULE:FREQ=MONTHLY;BYDAY=SA;BYSETPOS=2,4"
+
+	e, err := ReconstructEvent(common.NewEventID(), common.NewTenantID(), "集会", EventTypeNormal, "", true,
+		RecurrenceTypeRRule, nil, nil, text, nil, nil, nil, nil, now, now, nil)
+	if err != nil {
+		t.Fatalf("ReconstructEvent() should succeed, got error: %v", err)
+	}
+	if e.RecurrenceRule() == nil || e.RecurrenceRule().String() != text {
+		t.Errorf("RecurrenceRule mismatch: got %v", e.RecurrenceRule())
+	}
+
+	if _, err := ReconstructEvent(common.NewEventID(), common.NewTenantID(), "集会", EventTypeNormal, "", true,
+		RecurrenceTypeWeekly, nil, nil, text, nil, nil, nil, nil, now, now, nil); err == nil {
+		t.Error("ReconstructEvent() should fail when a weekly event has an rrule")
+	}
+	if _, err := ReconstructEvent(common.NewEventID(), common.NewTenantID(), "集会", EventTypeNormal, "", true,
+		RecurrenceTypeRRule, nil, nil, "RRULE:FREQ=SECONDLY", nil, nil, nil, nil, now, now, nil); err == nil {
+		t.Error("ReconstructEvent() should fail for an invalid rrule")
+	}
+}
+
+func TestEvent_EffectiveRecurrenceRule(t *testing.T) {
+	// 2025-01-05 は日曜日
+	start := time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)
+	saturday := int(time.Saturday)
+
+	tests := []struct {
+		name           string
+		recurrenceType RecurrenceType
+		dayOfWeek      *int
+		want           []string
+		wantError      bool
+	}{
+		{"none", RecurrenceTypeNone, nil, nil, false},
+		{"weekly", RecurrenceTypeWeekly, &saturday, []string{"2025-01-11", "2025-01-18", "2025-01-25"}, false},
+		// 開始日以降で最初の土曜日から 14 日ごと
+		{"biweekly", RecurrenceTypeBiweekly, &saturday, []string{"2025-01-11", "2025-01-25"}, false},
+		{"incomplete", RecurrenceTypeWeekly, nil, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := NewEvent(time.Now(), common.NewTenantID(), "集会", EventTypeNormal, "", tt.recurrenceType, &start, tt.dayOfWeek, nil, nil)
+			if err != nil {
+				t.Fatalf("NewEvent() should succeed, got error: %v", err)
+			}
+
+			rule, err := e.EffectiveRecurrenceRule()
+			if (err != nil) != tt.wantError {
+				t.Fatalf("EffectiveRecurrenceRule() error = %v, wantError %v", err, tt.wantError)
+			}
+			if tt.want == nil {
+				if rule != nil {
+					t.Errorf("expected no rule, got %s", rule)
+				}
+				return
+			}
+
+			dates := rule.Between(start, time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC))
+			if len(dates) != len(tt.want) {
+				t.Fatalf("expected %d dates, got %v", len(tt.want), dates)
+			}
+			for i, d := range dates {
+				if d.Format("2006-01-02") != tt.want[i] {
+					t.Errorf("date[%d] = %s, want %s", i, d.Format("2006-01-02"), tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestEvent_CopyAs(t *testing.T) {
+	now := time.Now()
+	dayOfWeek := 5
+	start := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)
+	source, err := NewEvent(now, common.NewTenantID(), "夏季営業", EventTypeSpecial, "Desc", RecurrenceTypeWeekly, &start, &dayOfWeek, nil, nil)
+	if err != nil {
+		t.Fatalf("NewEvent() should succeed, got error: %v", err)
+	}
+	templateID := common.NewShiftSlotTemplateID()
+	source.SetDefaultTemplate(now, &templateID)
+	source.Deactivate(now)
+
+	copied, err := source.CopyAs(now, "冬季営業")
+	if err != nil {
+		t.Fatalf("CopyAs() should succeed, got error: %v", err)
+	}
+
+	if copied.EventID() == source.EventID() || copied.TenantID() != source.TenantID() {
+		t.Errorf("copied event should have a new ID in the same tenant")
+	}
+	if copied.EventName() != "冬季営業" || copied.EventType() != EventTypeSpecial || copied.Description() != "Desc" {
+		t.Errorf("unexpected copied event: %s %s %s", copied.EventName(), copied.EventType(), copied.Description())
+	}
+	if copied.RecurrenceType() != RecurrenceTypeWeekly || *copied.RecurrenceDayOfWeek() != 5 {
+		t.Errorf("recurrence should be copied")
+	}
+	if !copied.IsActive() || copied.DefaultTemplateID() != nil {
+		t.Errorf("copied event should be active without a default template")
+	}
+
+	if _, err := source.CopyAs(now, ""); err == nil {
+		t.Error("CopyAs() should fail when event_name is empty")
+	}
+}
+
+func TestEvent_ArchiveAndRestore(t *testing.T) {
+	e := createTestEvent(t, common.NewTenantID(), "夏季営業", EventTypeNormal, "")
+	now := time.Now()
+
+	if err := e.EnsureNotArchived(); err != nil {
+		t.Fatalf("EnsureNotArchived() should succeed for an active event, got %v", err)
+	}
+
+	if err := e.Archive(now); err != nil {
+		t.Fatalf("Archive() should succeed, got error: %v", err)
+	}
+	if !e.IsArchived() || !e.ArchivedAt().Equal(now) {
+		t.Errorf("event should be archived at %v, got %v", now, e.ArchivedAt())
+	}
+	if err := e.EnsureNotArchived(); err == nil {
+		t.Error("EnsureNotArchived() should fail for an archived event")
+	}
+	if err := e.Archive(now); err == nil {
+		t.Error("Archive() should fail when the event is already archived")
+	}
+
+	if err := e.Restore(now); err != nil {
+		t.Fatalf("Restore() should succeed, got error: %v", err)
+	}
+	if e.IsArchived() {
+		t.Error("event should not be archived after Restore()")
+	}
+	if err := e.Restore(now); err == nil {
+		t.Error("Restore() should fail when the event is not archived")
+	}
+}
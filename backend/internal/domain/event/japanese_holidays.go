@@ -0,0 +1,169 @@
+package event
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// 日本の祝日を算出できる年の範囲
+// 春分・秋分の日の近似式が有効な範囲で、振替休日は 2007 年以降の規定で算出する
+const (
+	JapaneseHolidaysMinYear = 2007
+	JapaneseHolidaysMaxYear = 2099
+)
+
+// japaneseHolidayOverrides are the one-off holidays and the moved holidays of specific years
+// 2019 年の天皇即位関連と、2020・2021 年の東京オリンピック・パラリンピックに伴う移動
+var japaneseHolidayOverrides = map[int]struct {
+	add    []BlackoutDate
+	remove []string // 通常の算出から除く祝日名
+}{
+	2019: {
+		add: []BlackoutDate{
+			{Date: time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC), Label: "即位の日"},
+			{Date: time.Date(2019, 10, 22, 0, 0, 0, 0, time.UTC), Label: "即位礼正殿の儀の行われる日"},
+		},
+		remove: []string{"天皇誕生日"},
+	},
+	2020: {
+		add: []BlackoutDate{
+			{Date: time.Date(2020, 7, 23, 0, 0, 0, 0, time.UTC), Label: "海の日"},
+			{Date: time.Date(2020, 7, 24, 0, 0, 0, 0, time.UTC), Label: "スポーツの日"},
+			{Date: time.Date(2020, 8, 10, 0, 0, 0, 0, time.UTC), Label: "山の日"},
+		},
+		remove: []string{"海の日", "スポーツの日", "山の日"},
+	},
+	2021: {
+		add: []BlackoutDate{
+			{Date: time.Date(2021, 7, 22, 0, 0, 0, 0, time.UTC), Label: "海の日"},
+			{Date: time.Date(2021, 7, 23, 0, 0, 0, 0, time.UTC), Label: "スポーツの日"},
+			{Date: time.Date(2021, 8, 8, 0, 0, 0, 0, time.UTC), Label: "山の日"},
+		},
+		remove: []string{"海の日", "スポーツの日", "山の日"},
+	},
+}
+
+// JapaneseHolidays returns the national holidays of Japan (including substitute and citizens' holidays) for the years
+// 「国民の祝日に関する法律」の規定から算出する（同梱の祝日データとして使う）
+func JapaneseHolidays(fromYear, toYear int) ([]BlackoutDate, error) {
+	if fromYear > toYear {
+		return nil, common.NewValidationError("from_year must be less than or equal to to_year", nil)
+	}
+	if fromYear < JapaneseHolidaysMinYear || toYear > JapaneseHolidaysMaxYear {
+		return nil, common.NewValidationError(
+			fmt.Sprintf("japanese holidays are available from %d to %d", JapaneseHolidaysMinYear, JapaneseHolidaysMaxYear), nil)
+	}
+
+	var result []BlackoutDate
+	for year := fromYear; year <= toYear; year++ {
+		result = append(result, japaneseHolidaysOf(year)...)
+	}
+	return result, nil
+}
+
+func japaneseHolidaysOf(year int) []BlackoutDate {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	holidays := []BlackoutDate{
+		{Date: date(time.January, 1), Label: "元日"},
+		{Date: nthWeekday(year, time.January, time.Monday, 2), Label: "成人の日"},
+		{Date: date(time.February, 11), Label: "建国記念の日"},
+		{Date: date(time.March, vernalEquinoxDay(year)), Label: "春分の日"},
+		{Date: date(time.April, 29), Label: "昭和の日"},
+		{Date: date(time.May, 3), Label: "憲法記念日"},
+		{Date: date(time.May, 4), Label: "みどりの日"},
+		{Date: date(time.May, 5), Label: "こどもの日"},
+		{Date: nthWeekday(year, time.July, time.Monday, 3), Label: "海の日"},
+		{Date: nthWeekday(year, time.September, time.Monday, 3), Label: "敬老の日"},
+		{Date: date(time.September, autumnalEquinoxDay(year)), Label: "秋分の日"},
+		{Date: date(time.November, 3), Label: "文化の日"},
+		{Date: date(time.November, 23), Label: "勤労感謝の日"},
+	}
+
+	// 天皇誕生日: 2018 年までは 12/23、2020 年からは 2/23（2019 年はなし）
+	switch {
+	case year <= 2018:
+		holidays = append(holidays, BlackoutDate{Date: date(time.December, 23), Label: "天皇誕生日"})
+	case year >= 2020:
+		holidays = append(holidays, BlackoutDate{Date: date(time.February, 23), Label: "天皇誕生日"})
+	}
+	// 山の日は 2016 年から
+	if year >= 2016 {
+		holidays = append(holidays, BlackoutDate{Date: date(time.August, 11), Label: "山の日"})
+	}
+	// 体育の日は 2020 年からスポーツの日
+	sportsDay := "体育の日"
+	if year >= 2020 {
+		sportsDay = "スポーツの日"
+	}
+	holidays = append(holidays, BlackoutDate{Date: nthWeekday(year, time.October, time.Monday, 2), Label: sportsDay})
+
+	if override, ok := japaneseHolidayOverrides[year]; ok {
+		removed := make(map[string]bool, len(override.remove))
+		for _, name := range override.remove {
+			removed[name] = true
+		}
+		filtered := holidays[:0]
+		for _, h := range holidays {
+			if !removed[h.Label] {
+				filtered = append(filtered, h)
+			}
+		}
+		holidays = append(filtered, override.add...)
+	}
+
+	isHoliday := make(map[time.Time]bool, len(holidays))
+	for _, h := range holidays {
+		isHoliday[h.Date] = true
+	}
+
+	var extra []BlackoutDate
+	added := make(map[time.Time]bool)
+	for _, h := range holidays {
+		// 振替休日: 日曜日の祝日の後で最も近い祝日でない日
+		if h.Date.Weekday() == time.Sunday {
+			d := h.Date.AddDate(0, 0, 1)
+			for isHoliday[d] {
+				d = d.AddDate(0, 0, 1)
+			}
+			if !added[d] {
+				added[d] = true
+				extra = append(extra, BlackoutDate{Date: d, Label: "振替休日"})
+			}
+		}
+	}
+	for _, h := range holidays {
+		// 国民の休日: 前日と翌日が祝日である祝日でない日
+		d := h.Date.AddDate(0, 0, 1)
+		if !isHoliday[d] && !added[d] && isHoliday[d.AddDate(0, 0, 1)] && d.Year() == year {
+			added[d] = true
+			extra = append(extra, BlackoutDate{Date: d, Label: "国民の休日"})
+		}
+	}
+	holidays = append(holidays, extra...)
+
+	sort.Slice(holidays, func(i, j int) bool { return holidays[i].Date.Before(holidays[j].Date) })
+	return holidays
+}
+
+// nthWeekday returns the n-th weekday of the month (e.g. the second Monday)
+func nthWeekday(year int, month time.Month, weekday time.Weekday, n int) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+	return first.AddDate(0, 0, offset+7*(n-1))
+}
+
+// vernalEquinoxDay returns the day of March of the vernal equinox (valid for 1980-2099)
+func vernalEquinoxDay(year int) int {
+	return int(20.8431+0.242194*float64(year-1980)) - (year-1980)/4
+}
+
+// autumnalEquinoxDay returns the day of September of the autumnal equinox (valid for 1980-2099)
+func autumnalEquinoxDay(year int) int {
+	return int(23.2488+0.242194*float64(year-1980)) - (year-1980)/4
+}
@@ -0,0 +1,101 @@
+package event_test
+
+import (
+	"testing"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+)
+
+func holidayMap(t *testing.T, year int) map[string]string {
+	t.Helper()
+	holidays, err := event.JapaneseHolidays(year, year)
+	if err != nil {
+		t.Fatalf("JapaneseHolidays(%d) should succeed, got error: %v", year, err)
+	}
+	result := make(map[string]string, len(holidays))
+	for _, h := range holidays {
+		result[h.Date.Format("2006-01-02")] = h.Label
+	}
+	return result
+}
+
+func TestJapaneseHolidays_2025(t *testing.T) {
+	holidays := holidayMap(t, 2025)
+
+	if len(holidays) != 19 {
+		t.Errorf("2025 has %d holidays, want 19", len(holidays))
+	}
+
+	want := map[string]string{
+		"2025-01-01": "元日",
+		"2025-01-13": "成人の日",
+		"2025-02-23": "天皇誕生日",
+		"2025-02-24": "振替休日",
+		"2025-03-20": "春分の日",
+		"2025-05-06": "振替休日",
+		"2025-07-21": "海の日",
+		"2025-09-15": "敬老の日",
+		"2025-09-23": "秋分の日",
+		"2025-10-13": "スポーツの日",
+		"2025-11-24": "振替休日",
+	}
+	for d, label := range want {
+		if holidays[d] != label {
+			t.Errorf("%s = %q, want %q", d, holidays[d], label)
+		}
+	}
+}
+
+func TestJapaneseHolidays_2026_CitizensHoliday(t *testing.T) {
+	holidays := holidayMap(t, 2026)
+
+	if len(holidays) != 18 {
+		t.Errorf("2026 has %d holidays, want 18", len(holidays))
+	}
+	// 5/3（日）の振替で 5/6、敬老の日（9/21）と秋分の日（9/23）に挟まれた 9/22
+	if holidays["2026-05-06"] != "振替休日" {
+		t.Errorf("2026-05-06 = %q, want 振替休日", holidays["2026-05-06"])
+	}
+	if holidays["2026-09-22"] != "国民の休日" {
+		t.Errorf("2026-09-22 = %q, want 国民の休日", holidays["2026-09-22"])
+	}
+}
+
+func TestJapaneseHolidays_2019_Enthronement(t *testing.T) {
+	holidays := holidayMap(t, 2019)
+
+	want := map[string]string{
+		"2019-04-30": "国民の休日",
+		"2019-05-01": "即位の日",
+		"2019-05-02": "国民の休日",
+		"2019-10-22": "即位礼正殿の儀の行われる日",
+	}
+	for d, label := range want {
+		if holidays[d] != label {
+			t.Errorf("%s = %q, want %q", d, holidays[d], label)
+		}
+	}
+	if _, ok := holidays["2019-12-23"]; ok {
+		t.Error("2019 has no emperor's birthday")
+	}
+}
+
+func TestJapaneseHolidays_ErrorWhenOutOfRange(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fromYear int
+		toYear   int
+	}{
+		{"reversed", 2026, 2025},
+		{"before min", event.JapaneseHolidaysMinYear - 1, 2025},
+		{"after max", 2025, event.JapaneseHolidaysMaxYear + 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := event.JapaneseHolidays(tc.fromYear, tc.toYear); err == nil {
+				t.Error("JapaneseHolidays() should fail")
+			}
+		})
+	}
+}
@@ -0,0 +1,720 @@
+package event
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// RecurrenceFrequency represents the FREQ rule part of an RRULE
+type RecurrenceFrequency string
+
+const (
+	FrequencyDaily   RecurrenceFrequency = "DAILY"
+	FrequencyWeekly  RecurrenceFrequency = "WEEKLY"
+	FrequencyMonthly RecurrenceFrequency = "MONTHLY"
+	FrequencyYearly  RecurrenceFrequency = "YEARLY"
+)
+
+func (f RecurrenceFrequency) Validate() error {
+	switch f {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+		return nil
+	default:
+		return common.NewValidationError(fmt.Sprintf("unsupported FREQ: %s", f), nil)
+	}
+}
+
+// 営業日は日付単位で扱うため、時刻単位の FREQ と BYxxx はサポートしない
+var unsupportedRuleParts = map[string]bool{
+	"BYSECOND":  true,
+	"BYMINUTE":  true,
+	"BYHOUR":    true,
+	"BYYEARDAY": true,
+	"BYWEEKNO":  true,
+}
+
+// 展開の暴走を防ぐための上限（期間数）
+const maxRecurrencePeriods = 100000
+
+const (
+	icalDateFormat     = "20060102"
+	icalDateTimeFormat = "20060102T150405"
+)
+
+var icalWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+var icalWeekdayNames = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// WeekdayNum represents a BYDAY element such as "FR", "2SA" or "-1FR"
+// N が 0 の場合は期間内のすべての該当曜日、正なら先頭から、負なら末尾から N 番目
+type WeekdayNum struct {
+	N       int
+	Weekday time.Weekday
+}
+
+func (w WeekdayNum) String() string {
+	if w.N == 0 {
+		return icalWeekdayNames[w.Weekday]
+	}
+	return strconv.Itoa(w.N) + icalWeekdayNames[w.Weekday]
+}
+
+// RecurrenceRule represents an RFC 5545 recurrence (DTSTART + RRULE + EXDATE/RDATE) at date granularity
+// 時刻はイベントのデフォルト開始・終了時刻を使うため、ここでは日付のみを扱う
+type RecurrenceRule struct {
+	dtStart    time.Time
+	freq       RecurrenceFrequency
+	interval   int
+	count      *int
+	until      *time.Time
+	byDay      []WeekdayNum
+	byMonthDay []int
+	byMonth    []int
+	bySetPos   []int
+	wkst       time.Weekday
+	exDates    []time.Time
+	rDates     []time.Time
+}
+
+// ParseRecurrenceRule parses iCalendar content lines (DTSTART, RRULE, EXDATE, RDATE)
+//
+// 例:
+//
+//	DTSTART;VALUE=DATE:20250131
+//	RRULE:FREQ=MONTHLY;BYDAY=FR;BYSETPOS=-1
+//	EXDATE;VALUE=DATE:20250328
+//
+// DTSTART を省略した場合は defaultStart を使う（どちらもない場合はエラー）。
+// "RRULE:" を省略して "FREQ=..." だけを渡すこともできる。
+func ParseRecurrenceRule(text string, defaultStart *time.Time) (*RecurrenceRule, error) {
+	r := &RecurrenceRule{interval: 1, wkst: time.Monday}
+	hasRule := false
+	hasStart := false
+
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		name, value := "RRULE", line
+		if idx := strings.Index(line, ":"); idx >= 0 {
+			name, value = line[:idx], line[idx+1:]
+		} else if !strings.HasPrefix(strings.ToUpper(line), "FREQ=") {
+			return nil, common.NewValidationError(fmt.Sprintf("invalid recurrence line: %s", line), nil)
+		}
+		// パラメータ（;VALUE=DATE など）は日付単位で扱うため無視する
+		if idx := strings.Index(name, ";"); idx >= 0 {
+			name = name[:idx]
+		}
+
+		switch strings.ToUpper(name) {
+		case "DTSTART":
+			d, err := parseICalDate(value)
+			if err != nil {
+				return nil, err
+			}
+			r.dtStart = d
+			hasStart = true
+		case "RRULE":
+			if hasRule {
+				return nil, common.NewValidationError("only one RRULE is supported", nil)
+			}
+			if err := r.parseRule(value); err != nil {
+				return nil, err
+			}
+			hasRule = true
+		case "EXDATE":
+			dates, err := parseICalDateList(value)
+			if err != nil {
+				return nil, err
+			}
+			r.exDates = append(r.exDates, dates...)
+		case "RDATE":
+			dates, err := parseICalDateList(value)
+			if err != nil {
+				return nil, err
+			}
+			r.rDates = append(r.rDates, dates...)
+		default:
+			return nil, common.NewValidationError(fmt.Sprintf("unsupported recurrence property: %s", name), nil)
+		}
+	}
+
+	if !hasRule {
+		return nil, common.NewValidationError("RRULE is required", nil)
+	}
+	if !hasStart {
+		if defaultStart == nil {
+			return nil, common.NewValidationError("DTSTART is required", nil)
+		}
+		r.dtStart = dateOnly(*defaultStart)
+	}
+
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// NewWeeklyRecurrenceRule creates a rule repeating on the given weekday every interval weeks
+// 旧来の weekly / biweekly 設定を RRULE として扱うために使う
+func NewWeeklyRecurrenceRule(dtStart time.Time, interval int, weekday time.Weekday) (*RecurrenceRule, error) {
+	r := &RecurrenceRule{
+		dtStart:  dateOnly(dtStart),
+		freq:     FrequencyWeekly,
+		interval: interval,
+		byDay:    []WeekdayNum{{Weekday: weekday}},
+		wkst:     time.Monday,
+	}
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *RecurrenceRule) parseRule(value string) error {
+	for _, part := range strings.Split(value, ";") {
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return common.NewValidationError(fmt.Sprintf("invalid RRULE part: %s", part), nil)
+		}
+		key, val := strings.ToUpper(kv[0]), strings.ToUpper(kv[1])
+
+		if unsupportedRuleParts[key] {
+			return common.NewValidationError(fmt.Sprintf("%s is not supported", key), nil)
+		}
+
+		var err error
+		switch key {
+		case "FREQ":
+			r.freq = RecurrenceFrequency(val)
+		case "INTERVAL":
+			r.interval, err = strconv.Atoi(val)
+		case "COUNT":
+			var count int
+			count, err = strconv.Atoi(val)
+			r.count = &count
+		case "UNTIL":
+			var until time.Time
+			until, err = parseICalDate(val)
+			r.until = &until
+		case "BYDAY":
+			r.byDay, err = parseWeekdayNums(val)
+		case "BYMONTHDAY":
+			r.byMonthDay, err = parseIntList(key, val)
+		case "BYMONTH":
+			r.byMonth, err = parseIntList(key, val)
+		case "BYSETPOS":
+			r.bySetPos, err = parseIntList(key, val)
+		case "WKST":
+			wd, ok := icalWeekdays[val]
+			if !ok {
+				return common.NewValidationError(fmt.Sprintf("invalid WKST: %s", val), nil)
+			}
+			r.wkst = wd
+		default:
+			return common.NewValidationError(fmt.Sprintf("unknown RRULE part: %s", key), nil)
+		}
+		if err != nil {
+			return common.NewValidationError(fmt.Sprintf("invalid %s: %s", key, val), err)
+		}
+	}
+	return nil
+}
+
+func (r *RecurrenceRule) validate() error {
+	if r.dtStart.IsZero() {
+		return common.NewValidationError("DTSTART is required", nil)
+	}
+	if r.freq == "" {
+		return common.NewValidationError("FREQ is required", nil)
+	}
+	if err := r.freq.Validate(); err != nil {
+		return err
+	}
+	if r.interval < 1 {
+		return common.NewValidationError("INTERVAL must be 1 or greater", nil)
+	}
+	if r.count != nil && r.until != nil {
+		return common.NewValidationError("COUNT and UNTIL must not be used together", nil)
+	}
+	if r.count != nil && *r.count < 1 {
+		return common.NewValidationError("COUNT must be 1 or greater", nil)
+	}
+	if r.until != nil && r.until.Before(r.dtStart) {
+		return common.NewValidationError("UNTIL must not be before DTSTART", nil)
+	}
+
+	for _, d := range r.byDay {
+		if d.N == 0 {
+			continue
+		}
+		// RFC 5545: 序数付きの BYDAY は MONTHLY / YEARLY でのみ有効
+		if r.freq != FrequencyMonthly && r.freq != FrequencyYearly {
+			return common.NewValidationError("BYDAY with an ordinal is only valid for MONTHLY or YEARLY", nil)
+		}
+		limit := 5
+		if r.freq == FrequencyYearly && len(r.byMonth) == 0 {
+			limit = 53
+		}
+		if d.N < -limit || d.N > limit {
+			return common.NewValidationError(fmt.Sprintf("BYDAY ordinal out of range: %s", d), nil)
+		}
+	}
+	for _, day := range r.byMonthDay {
+		if day == 0 || day < -31 || day > 31 {
+			return common.NewValidationError(fmt.Sprintf("BYMONTHDAY out of range: %d", day), nil)
+		}
+	}
+	if r.freq == FrequencyWeekly && len(r.byMonthDay) > 0 {
+		return common.NewValidationError("BYMONTHDAY is not valid for WEEKLY", nil)
+	}
+	for _, month := range r.byMonth {
+		if month < 1 || month > 12 {
+			return common.NewValidationError(fmt.Sprintf("BYMONTH out of range: %d", month), nil)
+		}
+	}
+	for _, pos := range r.bySetPos {
+		if pos == 0 || pos < -366 || pos > 366 {
+			return common.NewValidationError(fmt.Sprintf("BYSETPOS out of range: %d", pos), nil)
+		}
+	}
+	if len(r.bySetPos) > 0 && len(r.byDay) == 0 && len(r.byMonthDay) == 0 && len(r.byMonth) == 0 {
+		return common.NewValidationError("BYSETPOS requires another BYxxx rule part", nil)
+	}
+
+	return nil
+}
+
+// Getters
+
+func (r *RecurrenceRule) DTStart() time.Time {
+	return r.dtStart
+}
+
+func (r *RecurrenceRule) Frequency() RecurrenceFrequency {
+	return r.freq
+}
+
+func (r *RecurrenceRule) Interval() int {
+	return r.interval
+}
+
+func (r *RecurrenceRule) Count() *int {
+	return r.count
+}
+
+func (r *RecurrenceRule) Until() *time.Time {
+	return r.until
+}
+
+func (r *RecurrenceRule) ExDates() []time.Time {
+	return append([]time.Time(nil), r.exDates...)
+}
+
+func (r *RecurrenceRule) RDates() []time.Time {
+	return append([]time.Time(nil), r.rDates...)
+}
+
+// RRule returns the RRULE value (without the "RRULE:" prefix) in canonical order
+func (r *RecurrenceRule) RRule() string {
+	parts := []string{"FREQ=" + string(r.freq)}
+	if r.interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.interval))
+	}
+	if r.count != nil {
+		parts = append(parts, "COUNT="+strconv.Itoa(*r.count))
+	}
+	if r.until != nil {
+		parts = append(parts, "UNTIL="+r.until.Format(icalDateFormat))
+	}
+	if len(r.byMonth) > 0 {
+		parts = append(parts, "BYMONTH="+joinInts(r.byMonth))
+	}
+	if len(r.byMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.byMonthDay))
+	}
+	if len(r.byDay) > 0 {
+		days := make([]string, len(r.byDay))
+		for i, d := range r.byDay {
+			days[i] = d.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.bySetPos) > 0 {
+		parts = append(parts, "BYSETPOS="+joinInts(r.bySetPos))
+	}
+	if r.wkst != time.Monday {
+		parts = append(parts, "WKST="+icalWeekdayNames[r.wkst])
+	}
+	return strings.Join(parts, ";")
+}
+
+// String returns the recurrence as iCalendar content lines (the format accepted by ParseRecurrenceRule)
+func (r *RecurrenceRule) String() string {
+	lines := []string{
+		"DTSTART;VALUE=DATE:" + r.dtStart.Format(icalDateFormat),
+		"RRULE:" + r.RRule(),
+	}
+	if len(r.exDates) > 0 {
+		lines = append(lines, "EXDATE;VALUE=DATE:"+joinDates(r.exDates))
+	}
+	if len(r.rDates) > 0 {
+		lines = append(lines, "RDATE;VALUE=DATE:"+joinDates(r.rDates))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Between returns the occurrence dates within [from, to] (inclusive, date granularity) in ascending order
+//
+// RRULE で展開した日付（COUNT / UNTIL 適用後）に RDATE を加え、EXDATE を除外する。
+func (r *RecurrenceRule) Between(from, to time.Time) []time.Time {
+	from, to = dateOnly(from), dateOnly(to)
+	if to.Before(from) {
+		return nil
+	}
+
+	excluded := make(map[time.Time]bool, len(r.exDates))
+	for _, d := range r.exDates {
+		excluded[d] = true
+	}
+
+	seen := make(map[time.Time]bool)
+	var result []time.Time
+	add := func(d time.Time) {
+		if d.Before(from) || d.After(to) || excluded[d] || seen[d] {
+			return
+		}
+		seen[d] = true
+		result = append(result, d)
+	}
+
+	for _, d := range r.expand(to) {
+		add(d)
+	}
+	for _, d := range r.rDates {
+		add(d)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Before(result[j]) })
+	return result
+}
+
+// expand returns the RRULE occurrences from DTSTART up to limit (COUNT is counted from DTSTART)
+func (r *RecurrenceRule) expand(limit time.Time) []time.Time {
+	var result []time.Time
+	emitted := 0
+
+	for i := 0; i < maxRecurrencePeriods; i++ {
+		periodStart := r.periodStart(i)
+		if periodStart.After(limit) || (r.until != nil && periodStart.After(*r.until)) {
+			break
+		}
+
+		for _, d := range applySetPos(r.expandPeriod(periodStart), r.bySetPos) {
+			if d.Before(r.dtStart) {
+				continue
+			}
+			if d.After(limit) || (r.until != nil && d.After(*r.until)) {
+				return result
+			}
+			if r.count != nil && emitted >= *r.count {
+				return result
+			}
+			emitted++
+			result = append(result, d)
+		}
+	}
+
+	return result
+}
+
+// periodStart returns the first day of the i-th period (every INTERVAL periods from DTSTART's period)
+func (r *RecurrenceRule) periodStart(i int) time.Time {
+	step := i * r.interval
+	switch r.freq {
+	case FrequencyDaily:
+		return r.dtStart.AddDate(0, 0, step)
+	case FrequencyWeekly:
+		offset := (int(r.dtStart.Weekday()) - int(r.wkst) + 7) % 7
+		return r.dtStart.AddDate(0, 0, -offset+7*step)
+	case FrequencyMonthly:
+		return time.Date(r.dtStart.Year(), r.dtStart.Month()+time.Month(step), 1, 0, 0, 0, 0, time.UTC)
+	default: // FrequencyYearly
+		return time.Date(r.dtStart.Year()+step, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// expandPeriod returns the candidate dates of a period in ascending order (before BYSETPOS)
+func (r *RecurrenceRule) expandPeriod(periodStart time.Time) []time.Time {
+	switch r.freq {
+	case FrequencyDaily:
+		d := periodStart
+		if r.matchesMonth(d) && r.matchesMonthDay(d) && r.matchesWeekday(d) {
+			return []time.Time{d}
+		}
+		return nil
+
+	case FrequencyWeekly:
+		var days []time.Time
+		for i := 0; i < 7; i++ {
+			d := periodStart.AddDate(0, 0, i)
+			if !r.matchesMonth(d) {
+				continue
+			}
+			if len(r.byDay) > 0 {
+				if r.matchesWeekday(d) {
+					days = append(days, d)
+				}
+			} else if d.Weekday() == r.dtStart.Weekday() {
+				days = append(days, d)
+			}
+		}
+		return days
+
+	case FrequencyMonthly:
+		if !r.matchesMonth(periodStart) {
+			return nil
+		}
+		return r.expandMonth(periodStart.Year(), periodStart.Month())
+
+	default: // FrequencyYearly
+		year := periodStart.Year()
+		if len(r.byMonth) > 0 {
+			var days []time.Time
+			for month := time.January; month <= time.December; month++ {
+				if r.matchesMonth(time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)) {
+					days = append(days, r.expandMonth(year, month)...)
+				}
+			}
+			return days
+		}
+		if len(r.byMonthDay) == 0 && len(r.byDay) == 0 {
+			d := time.Date(year, r.dtStart.Month(), r.dtStart.Day(), 0, 0, 0, 0, time.UTC)
+			if d.Day() != r.dtStart.Day() {
+				return nil // 2/29 など存在しない日付はスキップ
+			}
+			return []time.Time{d}
+		}
+		// BYMONTH なしの BYDAY は年単位で数える
+		var days []time.Time
+		first := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		length := daysIn(year, 0)
+		for i := 0; i < length; i++ {
+			d := first.AddDate(0, 0, i)
+			if r.matchesMonthDay(d) && r.matchesByDayIn(d, i, length) {
+				days = append(days, d)
+			}
+		}
+		return days
+	}
+}
+
+// expandMonth returns the candidate dates of a month (BYDAY ordinals are relative to the month)
+func (r *RecurrenceRule) expandMonth(year int, month time.Month) []time.Time {
+	length := daysIn(year, month)
+	if len(r.byMonthDay) == 0 && len(r.byDay) == 0 {
+		if r.dtStart.Day() > length {
+			return nil // 31日などが存在しない月はスキップ
+		}
+		return []time.Time{time.Date(year, month, r.dtStart.Day(), 0, 0, 0, 0, time.UTC)}
+	}
+
+	var days []time.Time
+	for day := 1; day <= length; day++ {
+		d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		if r.matchesMonthDay(d) && r.matchesByDayIn(d, day-1, length) {
+			days = append(days, d)
+		}
+	}
+	return days
+}
+
+func (r *RecurrenceRule) matchesMonth(d time.Time) bool {
+	if len(r.byMonth) == 0 {
+		return true
+	}
+	for _, month := range r.byMonth {
+		if time.Month(month) == d.Month() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *RecurrenceRule) matchesMonthDay(d time.Time) bool {
+	if len(r.byMonthDay) == 0 {
+		return true
+	}
+	length := daysIn(d.Year(), d.Month())
+	for _, day := range r.byMonthDay {
+		if day > 0 && d.Day() == day {
+			return true
+		}
+		if day < 0 && d.Day() == length+day+1 {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesWeekday checks BYDAY ignoring ordinals (DAILY / WEEKLY)
+func (r *RecurrenceRule) matchesWeekday(d time.Time) bool {
+	if len(r.byDay) == 0 {
+		return true
+	}
+	for _, wd := range r.byDay {
+		if wd.Weekday == d.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesByDayIn checks BYDAY with ordinals counted within a scope (index is 0-based, length is the scope size)
+func (r *RecurrenceRule) matchesByDayIn(d time.Time, index, length int) bool {
+	if len(r.byDay) == 0 {
+		return true
+	}
+	for _, wd := range r.byDay {
+		if wd.Weekday != d.Weekday() {
+			continue
+		}
+		switch {
+		case wd.N == 0:
+			return true
+		case wd.N > 0 && index/7+1 == wd.N:
+			return true
+		case wd.N < 0 && (length-1-index)/7+1 == -wd.N:
+			return true
+		}
+	}
+	return false
+}
+
+// applySetPos selects the BYSETPOS positions from a sorted candidate set
+func applySetPos(days []time.Time, positions []int) []time.Time {
+	if len(positions) == 0 || len(days) == 0 {
+		return days
+	}
+	selected := make(map[int]bool, len(positions))
+	for _, pos := range positions {
+		idx := pos - 1
+		if pos < 0 {
+			idx = len(days) + pos
+		}
+		if idx >= 0 && idx < len(days) {
+			selected[idx] = true
+		}
+	}
+	var result []time.Time
+	for i, d := range days {
+		if selected[i] {
+			result = append(result, d)
+		}
+	}
+	return result
+}
+
+// daysIn returns the number of days of the month (or of the year when month is 0)
+func daysIn(year int, month time.Month) int {
+	if month == 0 {
+		return time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1).YearDay()
+	}
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// dateOnly truncates t to a UTC date
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func parseICalDate(value string) (time.Time, error) {
+	value = strings.TrimSuffix(strings.TrimSpace(value), "Z")
+	if t, err := time.Parse(icalDateFormat, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(icalDateTimeFormat, value); err == nil {
+		return dateOnly(t), nil
+	}
+	return time.Time{}, common.NewValidationError(fmt.Sprintf("invalid date: %s (expected YYYYMMDD)", value), nil)
+}
+
+func parseICalDateList(value string) ([]time.Time, error) {
+	var dates []time.Time
+	for _, v := range strings.Split(value, ",") {
+		d, err := parseICalDate(v)
+		if err != nil {
+			return nil, err
+		}
+		dates = append(dates, d)
+	}
+	return dates, nil
+}
+
+func parseWeekdayNums(value string) ([]WeekdayNum, error) {
+	var result []WeekdayNum
+	for _, v := range strings.Split(value, ",") {
+		if len(v) < 2 {
+			return nil, fmt.Errorf("invalid weekday: %q", v)
+		}
+		wd, ok := icalWeekdays[v[len(v)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday: %q", v)
+		}
+		n := 0
+		if prefix := v[:len(v)-2]; prefix != "" {
+			var err error
+			if n, err = strconv.Atoi(prefix); err != nil || n == 0 {
+				return nil, fmt.Errorf("invalid weekday ordinal: %q", v)
+			}
+		}
+		result = append(result, WeekdayNum{N: n, Weekday: wd})
+	}
+	return result, nil
+}
+
+func parseIntList(key, value string) ([]int, error) {
+	var result []int
+	for _, v := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value: %q", key, v)
+		}
+		result = append(result, n)
+	}
+	return result, nil
+}
+
+func joinInts(values []int) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = strconv.Itoa(v)
+	}
+	return strings.Join(parts, ",")
+}
+
+func joinDates(dates []time.Time) string {
+	parts := make([]string, len(dates))
+	for i, d := range dates {
+		parts[i] = d.Format(icalDateFormat)
+	}
+	return strings.Join(parts, ",")
+}
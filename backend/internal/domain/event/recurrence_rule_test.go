@@ -0,0 +1,448 @@
+package event_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func mustParseRecurrenceRule(t *testing.T, text string) *event.RecurrenceRule {
+	t.Helper()
+	rule, err := event.ParseRecurrenceRule(text, nil)
+	if err != nil {
+		t.Fatalf("ParseRecurrenceRule(%q) should succeed, got error: %v", text, err)
+	}
+	return rule
+}
+
+func formatDates(dates []time.Time) string {
+	parts := make([]string, len(dates))
+	for i, d := range dates {
+		parts[i] = d.Format("2006-01-02")
+	}
+	return strings.Join(parts, ",")
+}
+
+// =====================================================
+// ParseRecurrenceRule Tests
+// =====================================================
+
+func TestParseRecurrenceRule_ErrorWhenInvalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		text string
+	}{
+		{"empty", ""},
+		{"missing RRULE", "DTSTART:20250101"},
+		{"missing DTSTART", "RRULE:FREQ=WEEKLY"},
+		{"missing FREQ", "DTSTART:20250101\nRRULE:INTERVAL=2"},
+		{"hourly frequency", "DTSTART:20250101\nRRULE:FREQ=HOURLY"},
+		{"unsupported BYHOUR", "DTSTART:20250101\nRRULE:FREQ=DAILY;BYHOUR=20"},
+		{"unsupported BYWEEKNO", "DTSTART:20250101\nRRULE:FREQ=YEARLY;BYWEEKNO=20"},
+		{"unknown part", "DTSTART:20250101\nRRULE:FREQ=DAILY;FOO=1"},
+		{"malformed part", "DTSTART:20250101\nRRULE:FREQ=DAILY;COUNT"},
+		{"zero interval", "DTSTART:20250101\nRRULE:FREQ=DAILY;INTERVAL=0"},
+		{"zero count", "DTSTART:20250101\nRRULE:FREQ=DAILY;COUNT=0"},
+		{"count and until", "DTSTART:20250101\nRRULE:FREQ=DAILY;COUNT=3;UNTIL=20250201"},
+		{"until before dtstart", "DTSTART:20250101\nRRULE:FREQ=DAILY;UNTIL=20241231"},
+		{"invalid weekday", "DTSTART:20250101\nRRULE:FREQ=WEEKLY;BYDAY=XX"},
+		{"zero ordinal", "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYDAY=0FR"},
+		{"ordinal with weekly", "DTSTART:20250101\nRRULE:FREQ=WEEKLY;BYDAY=2FR"},
+		{"ordinal out of month range", "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYDAY=6FR"},
+		{"month day zero", "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYMONTHDAY=0"},
+		{"month day out of range", "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYMONTHDAY=32"},
+		{"month day with weekly", "DTSTART:20250101\nRRULE:FREQ=WEEKLY;BYMONTHDAY=1"},
+		{"month out of range", "DTSTART:20250101\nRRULE:FREQ=YEARLY;BYMONTH=13"},
+		{"setpos without other parts", "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYSETPOS=1"},
+		{"setpos zero", "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYDAY=FR;BYSETPOS=0"},
+		{"invalid WKST", "DTSTART:20250101\nRRULE:FREQ=WEEKLY;WKST=XX"},
+		{"invalid DTSTART", "DTSTART:2025-01-01\nRRULE:FREQ=DAILY"},
+		{"invalid EXDATE", "DTSTART:20250101\nRRULE:FREQ=DAILY\nEXDATE:20250230"},
+		{"two RRULEs", "DTSTART:20250101\nRRULE:FREQ=DAILY\nRRULE:FREQ=WEEKLY"},
+		{"unsupported property", "DTSTART:20250101\nRRULE:FREQ=DAILY\nEXRULE:FREQ=WEEKLY"},
+		{"garbage line", "DTSTART:20250101\nRRULE:FREQ=DAILY\nhello"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := event.ParseRecurrenceRule(tc.text, nil); err == nil {
+				t.Errorf("ParseRecurrenceRule(%q) should fail", tc.text)
+			}
+		})
+	}
+}
+
+func TestParseRecurrenceRule_AcceptsVariants(t *testing.T) {
+	defaultStart := time.Date(2025, 1, 3, 21, 30, 0, 0, time.FixedZone("JST", 9*60*60))
+
+	testCases := []struct {
+		name      string
+		text      string
+		wantStart time.Time
+		wantRRule string
+	}{
+		{"bare RRULE uses default start", "FREQ=weekly;byday=fr", date(2025, 1, 3), "FREQ=WEEKLY;BYDAY=FR"},
+		{"DTSTART with VALUE parameter", "DTSTART;VALUE=DATE:20250110\nRRULE:FREQ=MONTHLY;BYDAY=-1FR", date(2025, 1, 10), "FREQ=MONTHLY;BYDAY=-1FR"},
+		{"DTSTART date-time is truncated", "DTSTART:20250110T200000Z\r\nRRULE:FREQ=DAILY;INTERVAL=2", date(2025, 1, 10), "FREQ=DAILY;INTERVAL=2"},
+		{"UNTIL date-time", "DTSTART:20250110\nRRULE:FREQ=DAILY;UNTIL=20250131T235959Z", date(2025, 1, 10), "FREQ=DAILY;UNTIL=20250131"},
+		{"canonical order", "DTSTART:20250110\nRRULE:BYSETPOS=2,4;BYDAY=SA;FREQ=MONTHLY;WKST=SU", date(2025, 1, 10), "FREQ=MONTHLY;BYDAY=SA;BYSETPOS=2,4;WKST=SU"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, err := event.ParseRecurrenceRule(tc.text, &defaultStart)
+			if err != nil {
+				t.Fatalf("ParseRecurrenceRule() should succeed, got error: %v", err)
+			}
+			if !rule.DTStart().Equal(tc.wantStart) {
+				t.Errorf("DTStart = %v, want %v", rule.DTStart(), tc.wantStart)
+			}
+			if rule.RRule() != tc.wantRRule {
+				t.Errorf("RRule() = %q, want %q", rule.RRule(), tc.wantRRule)
+			}
+		})
+	}
+}
+
+func TestRecurrenceRule_StringRoundTrip(t *testing.T) {
+	text := "DTSTART;VALUE=DATE:20250110\n" +
+		"RRULE:FREQ=MONTHLY;INTERVAL=2;COUNT=5;BYMONTH=1,3,5;BYMONTHDAY=-1,15;BYDAY=MO,-1FR;BYSETPOS=1,-1;WKST=SU\n" +
+		"EXDATE;VALUE=DATE:20250131,20250315\n" +
+		"RDATE;VALUE=DATE:20250401"
+
+	rule := mustParseRecurrenceRule(t, text)
+	if rule.String() != text {
+		t.Errorf("String() = %q, want %q", rule.String(), text)
+	}
+
+	again := mustParseRecurrenceRule(t, rule.String())
+	if again.String() != text {
+		t.Errorf("round trip changed the rule: %q", again.String())
+	}
+}
+
+// =====================================================
+// Between (expansion) Tests
+// =====================================================
+
+func TestRecurrenceRule_Between(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		from, to time.Time
+		want     string
+	}{
+		// --- DAILY ---
+		{
+			name: "daily every third day",
+			text: "DTSTART:20250101\nRRULE:FREQ=DAILY;INTERVAL=3",
+			from: date(2025, 1, 1), to: date(2025, 1, 12),
+			want: "2025-01-01,2025-01-04,2025-01-07,2025-01-10",
+		},
+		{
+			name: "daily limited to weekdays",
+			text: "DTSTART:20250103\nRRULE:FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			from: date(2025, 1, 3), to: date(2025, 1, 8),
+			want: "2025-01-03,2025-01-06,2025-01-07,2025-01-08",
+		},
+		{
+			name: "daily filtered by month and month day",
+			text: "DTSTART:20250101\nRRULE:FREQ=DAILY;BYMONTH=2,3;BYMONTHDAY=1",
+			from: date(2025, 1, 1), to: date(2025, 12, 31),
+			want: "2025-02-01,2025-03-01",
+		},
+
+		// --- WEEKLY ---
+		{
+			name: "weekly defaults to the DTSTART weekday",
+			text: "DTSTART:20250104\nRRULE:FREQ=WEEKLY",
+			from: date(2025, 1, 1), to: date(2025, 1, 25),
+			want: "2025-01-04,2025-01-11,2025-01-18,2025-01-25",
+		},
+		{
+			name: "weekly on several days",
+			text: "DTSTART:20250106\nRRULE:FREQ=WEEKLY;BYDAY=TU,TH,SA",
+			from: date(2025, 1, 6), to: date(2025, 1, 16),
+			want: "2025-01-07,2025-01-09,2025-01-11,2025-01-14,2025-01-16",
+		},
+		{
+			name: "biweekly counts weeks from the DTSTART week",
+			text: "DTSTART:20250105\nRRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=SA",
+			from: date(2025, 1, 1), to: date(2025, 2, 28),
+			// 2025-01-05 (日) は WKST=MO の週の最終日なので、同じ週の土曜 (1/4) は DTSTART より前
+			want: "2025-01-18,2025-02-01,2025-02-15",
+		},
+		{
+			// RFC 5545 3.8.5.3 の WKST の例
+			name: "WKST=MO",
+			text: "DTSTART:19970805\nRRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=MO",
+			from: date(1997, 1, 1), to: date(1997, 12, 31),
+			want: "1997-08-05,1997-08-10,1997-08-19,1997-08-24",
+		},
+		{
+			name: "WKST=SU",
+			text: "DTSTART:19970805\nRRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=SU",
+			from: date(1997, 1, 1), to: date(1997, 12, 31),
+			want: "1997-08-05,1997-08-17,1997-08-19,1997-08-31",
+		},
+		{
+			name: "weekly limited by month",
+			text: "DTSTART:20250126\nRRULE:FREQ=WEEKLY;BYDAY=SU;BYMONTH=2",
+			from: date(2025, 1, 1), to: date(2025, 3, 31),
+			want: "2025-02-02,2025-02-09,2025-02-16,2025-02-23",
+		},
+
+		// --- MONTHLY ---
+		{
+			name: "last Friday of the month",
+			text: "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYDAY=-1FR",
+			from: date(2025, 1, 1), to: date(2025, 6, 30),
+			want: "2025-01-31,2025-02-28,2025-03-28,2025-04-25,2025-05-30,2025-06-27",
+		},
+		{
+			name: "last Friday via BYSETPOS",
+			text: "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYDAY=FR;BYSETPOS=-1",
+			from: date(2025, 1, 1), to: date(2025, 6, 30),
+			want: "2025-01-31,2025-02-28,2025-03-28,2025-04-25,2025-05-30,2025-06-27",
+		},
+		{
+			name: "2nd and 4th Saturday via BYSETPOS",
+			text: "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYDAY=SA;BYSETPOS=2,4",
+			from: date(2025, 1, 1), to: date(2025, 3, 31),
+			want: "2025-01-11,2025-01-25,2025-02-08,2025-02-22,2025-03-08,2025-03-22",
+		},
+		{
+			name: "2nd and 4th Saturday via ordinals",
+			text: "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYDAY=2SA,4SA",
+			from: date(2025, 1, 1), to: date(2025, 3, 31),
+			want: "2025-01-11,2025-01-25,2025-02-08,2025-02-22,2025-03-08,2025-03-22",
+		},
+		{
+			name: "fifth Saturday only in months that have one",
+			text: "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYDAY=5SA",
+			from: date(2025, 1, 1), to: date(2025, 6, 30),
+			want: "2025-03-29,2025-05-31",
+		},
+		{
+			name: "second to last Sunday",
+			text: "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYDAY=-2SU",
+			from: date(2025, 1, 1), to: date(2025, 3, 31),
+			want: "2025-01-19,2025-02-16,2025-03-23",
+		},
+		{
+			name: "last weekday of the month",
+			text: "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			from: date(2025, 1, 1), to: date(2025, 5, 31),
+			want: "2025-01-31,2025-02-28,2025-03-31,2025-04-30,2025-05-30",
+		},
+		{
+			name: "first and last day of the month",
+			text: "DTSTART:20240101\nRRULE:FREQ=MONTHLY;BYMONTHDAY=1,-1",
+			from: date(2024, 1, 1), to: date(2024, 3, 31),
+			want: "2024-01-01,2024-01-31,2024-02-01,2024-02-29,2024-03-01,2024-03-31",
+		},
+		{
+			name: "month day 31 skips shorter months",
+			text: "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYMONTHDAY=31",
+			from: date(2025, 1, 1), to: date(2025, 6, 30),
+			want: "2025-01-31,2025-03-31,2025-05-31",
+		},
+		{
+			name: "monthly defaults to the DTSTART day and skips missing days",
+			text: "DTSTART:20250131\nRRULE:FREQ=MONTHLY",
+			from: date(2025, 1, 1), to: date(2025, 5, 31),
+			want: "2025-01-31,2025-03-31,2025-05-31",
+		},
+		{
+			name: "Friday the 13th",
+			text: "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13",
+			from: date(2025, 1, 1), to: date(2026, 12, 31),
+			want: "2025-06-13,2026-02-13,2026-03-13,2026-11-13",
+		},
+		{
+			name: "every other month",
+			text: "DTSTART:20250110\nRRULE:FREQ=MONTHLY;INTERVAL=2",
+			from: date(2025, 1, 1), to: date(2025, 8, 31),
+			want: "2025-01-10,2025-03-10,2025-05-10,2025-07-10",
+		},
+		{
+			name: "monthly limited by month",
+			text: "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYMONTH=1,3;BYDAY=1MO",
+			from: date(2025, 1, 1), to: date(2025, 12, 31),
+			want: "2025-01-06,2025-03-03",
+		},
+		{
+			name: "month rolls over the year",
+			text: "DTSTART:20251101\nRRULE:FREQ=MONTHLY;BYDAY=-1FR",
+			from: date(2025, 11, 1), to: date(2026, 2, 28),
+			want: "2025-11-28,2025-12-26,2026-01-30,2026-02-27",
+		},
+
+		// --- YEARLY ---
+		{
+			name: "yearly defaults to the DTSTART month and day",
+			text: "DTSTART:20250704\nRRULE:FREQ=YEARLY",
+			from: date(2025, 1, 1), to: date(2027, 12, 31),
+			want: "2025-07-04,2026-07-04,2027-07-04",
+		},
+		{
+			name: "yearly on February 29th",
+			text: "DTSTART:20240229\nRRULE:FREQ=YEARLY",
+			from: date(2024, 1, 1), to: date(2029, 12, 31),
+			want: "2024-02-29,2028-02-29",
+		},
+		{
+			name: "yearly by month and month day",
+			text: "DTSTART:20250101\nRRULE:FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=24,25",
+			from: date(2025, 1, 1), to: date(2026, 12, 31),
+			want: "2025-12-24,2025-12-25,2026-12-24,2026-12-25",
+		},
+		{
+			name: "yearly by month uses the DTSTART day",
+			text: "DTSTART:20250110\nRRULE:FREQ=YEARLY;BYMONTH=3,6",
+			from: date(2025, 1, 1), to: date(2025, 12, 31),
+			want: "2025-03-10,2025-06-10",
+		},
+		{
+			// RFC 5545 の例: 毎年 20 番目の月曜日
+			name: "20th Monday of the year",
+			text: "DTSTART:19970519\nRRULE:FREQ=YEARLY;BYDAY=20MO",
+			from: date(1997, 1, 1), to: date(1999, 12, 31),
+			want: "1997-05-19,1998-05-18,1999-05-17",
+		},
+		{
+			name: "last Sunday of the year",
+			text: "DTSTART:20250101\nRRULE:FREQ=YEARLY;BYDAY=-1SU",
+			from: date(2025, 1, 1), to: date(2026, 12, 31),
+			want: "2025-12-28,2026-12-27",
+		},
+		{
+			name: "ordinal is relative to the month with BYMONTH",
+			text: "DTSTART:20250101\nRRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=4TH",
+			from: date(2025, 1, 1), to: date(2026, 12, 31),
+			want: "2025-11-27,2026-11-26",
+		},
+
+		// --- COUNT / UNTIL / DTSTART ---
+		{
+			name: "count",
+			text: "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYDAY=-1FR;COUNT=3",
+			from: date(2025, 1, 1), to: date(2025, 12, 31),
+			want: "2025-01-31,2025-02-28,2025-03-28",
+		},
+		{
+			name: "count is counted from DTSTART even when the window starts later",
+			text: "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYDAY=-1FR;COUNT=3",
+			from: date(2025, 3, 1), to: date(2025, 12, 31),
+			want: "2025-03-28",
+		},
+		{
+			name: "until is inclusive",
+			text: "DTSTART:20250104\nRRULE:FREQ=WEEKLY;UNTIL=20250118",
+			from: date(2025, 1, 1), to: date(2025, 12, 31),
+			want: "2025-01-04,2025-01-11,2025-01-18",
+		},
+		{
+			name: "DTSTART that does not match the rule is not an occurrence",
+			text: "DTSTART:20250102\nRRULE:FREQ=WEEKLY;BYDAY=SA;COUNT=2",
+			from: date(2025, 1, 1), to: date(2025, 12, 31),
+			want: "2025-01-04,2025-01-11",
+		},
+		{
+			name: "occurrences before DTSTART in the first period are skipped",
+			text: "DTSTART:20250115\nRRULE:FREQ=MONTHLY;BYDAY=SA;BYSETPOS=2,4",
+			from: date(2025, 1, 1), to: date(2025, 2, 28),
+			want: "2025-01-25,2025-02-08,2025-02-22",
+		},
+
+		// --- EXDATE / RDATE ---
+		{
+			name: "exdate removes occurrences",
+			text: "DTSTART:20250104\nRRULE:FREQ=WEEKLY\nEXDATE;VALUE=DATE:20250111,20250125",
+			from: date(2025, 1, 1), to: date(2025, 1, 31),
+			want: "2025-01-04,2025-01-18",
+		},
+		{
+			name: "rdate adds dates outside the rule",
+			text: "DTSTART:20250104\nRRULE:FREQ=WEEKLY;COUNT=2\nRDATE;VALUE=DATE:20250107,20250301",
+			from: date(2025, 1, 1), to: date(2025, 3, 31),
+			want: "2025-01-04,2025-01-07,2025-01-11,2025-03-01",
+		},
+		{
+			name: "rdate duplicating an occurrence is returned once",
+			text: "DTSTART:20250104\nRRULE:FREQ=WEEKLY;COUNT=2\nRDATE:20250111",
+			from: date(2025, 1, 1), to: date(2025, 1, 31),
+			want: "2025-01-04,2025-01-11",
+		},
+		{
+			name: "exdate wins over rdate",
+			text: "DTSTART:20250104\nRRULE:FREQ=WEEKLY;COUNT=1\nRDATE:20250107\nEXDATE:20250107",
+			from: date(2025, 1, 1), to: date(2025, 1, 31),
+			want: "2025-01-04",
+		},
+
+		// --- window ---
+		{
+			name: "window bounds are inclusive",
+			text: "DTSTART:20250104\nRRULE:FREQ=WEEKLY",
+			from: date(2025, 1, 11), to: date(2025, 1, 18),
+			want: "2025-01-11,2025-01-18",
+		},
+		{
+			name: "window ignores time of day",
+			text: "DTSTART:20250104\nRRULE:FREQ=WEEKLY",
+			from: time.Date(2025, 1, 11, 23, 0, 0, 0, time.UTC), to: time.Date(2025, 1, 18, 1, 0, 0, 0, time.UTC),
+			want: "2025-01-11,2025-01-18",
+		},
+		{
+			name: "window before DTSTART",
+			text: "DTSTART:20250104\nRRULE:FREQ=WEEKLY",
+			from: date(2024, 12, 1), to: date(2024, 12, 31),
+			want: "",
+		},
+		{
+			name: "inverted window",
+			text: "DTSTART:20250104\nRRULE:FREQ=WEEKLY",
+			from: date(2025, 2, 1), to: date(2025, 1, 1),
+			want: "",
+		},
+		{
+			name: "rule that never matches terminates",
+			text: "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYMONTH=2;BYMONTHDAY=30",
+			from: date(2025, 1, 1), to: date(2035, 12, 31),
+			want: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule := mustParseRecurrenceRule(t, tc.text)
+			if got := formatDates(rule.Between(tc.from, tc.to)); got != tc.want {
+				t.Errorf("Between() = %s\nwant      %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewWeeklyRecurrenceRule(t *testing.T) {
+	rule, err := event.NewWeeklyRecurrenceRule(time.Date(2025, 1, 4, 21, 0, 0, 0, time.UTC), 2, time.Saturday)
+	if err != nil {
+		t.Fatalf("NewWeeklyRecurrenceRule() should succeed, got error: %v", err)
+	}
+	if rule.RRule() != "FREQ=WEEKLY;INTERVAL=2;BYDAY=SA" {
+		t.Errorf("RRule() = %q", rule.RRule())
+	}
+	if got := formatDates(rule.Between(date(2025, 1, 1), date(2025, 2, 1))); got != "2025-01-04,2025-01-18,2025-02-01" {
+		t.Errorf("Between() = %s", got)
+	}
+
+	if _, err := event.NewWeeklyRecurrenceRule(date(2025, 1, 4), 0, time.Saturday); err == nil {
+		t.Error("NewWeeklyRecurrenceRule() should fail with interval 0")
+	}
+}
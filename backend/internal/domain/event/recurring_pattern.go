@@ -116,13 +116,32 @@ func (c *MonthlyDatePatternConfig) ToJSON() ([]byte, error) {
 }
 
 // CustomPatternConfig represents a custom pattern configuration
+// "rrule" に iCalendar 形式（DTSTART + RRULE、任意で EXDATE / RDATE）の定期ルールを持つ
 type CustomPatternConfig map[string]interface{}
 
 func (c CustomPatternConfig) Validate() error {
-	// カスタムパターンは柔軟性のため、特にバリデーションしない
+	if _, err := c.RecurrenceRule(); err != nil {
+		return err
+	}
+
+	startTime, _ := c["start_time"].(string)
+	endTime, _ := c["end_time"].(string)
+	if startTime == "" || endTime == "" {
+		return common.NewValidationError("start_time and end_time are required", nil)
+	}
+
 	return nil
 }
 
+// RecurrenceRule parses the "rrule" entry of the config
+func (c CustomPatternConfig) RecurrenceRule() (*RecurrenceRule, error) {
+	text, ok := c["rrule"].(string)
+	if !ok || text == "" {
+		return nil, common.NewValidationError("rrule is required for custom pattern", nil)
+	}
+	return ParseRecurrenceRule(text, nil)
+}
+
 func (c CustomPatternConfig) ToJSON() ([]byte, error) {
 	return json.Marshal(c)
 }
@@ -311,22 +311,43 @@ func TestMonthlyDatePatternConfig_ToJSON(t *testing.T) {
 
 func TestCustomPatternConfig_Validate_Success(t *testing.T) {
 	config := event.CustomPatternConfig{
-		"key1": "value1",
-		"key2": 123,
+		"rrule":      "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYDAY=-1FR",
+		"start_time": "21:00",
+		"end_time":   "23:00",
 	}
 
 	err := config.Validate()
 	if err != nil {
 		t.Errorf("Validate() should succeed, got error: %v", err)
 	}
+
+	rule, err := config.RecurrenceRule()
+	if err != nil {
+		t.Fatalf("RecurrenceRule() should succeed, got error: %v", err)
+	}
+	if rule.RRule() != "FREQ=MONTHLY;BYDAY=-1FR" {
+		t.Errorf("RRule mismatch: got %s", rule.RRule())
+	}
 }
 
-func TestCustomPatternConfig_Validate_SuccessEmpty(t *testing.T) {
-	config := event.CustomPatternConfig{}
+func TestCustomPatternConfig_Validate_Error(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config event.CustomPatternConfig
+	}{
+		{"empty", event.CustomPatternConfig{}},
+		{"missing rrule", event.CustomPatternConfig{"start_time": "21:00", "end_time": "23:00"}},
+		{"rrule is not a string", event.CustomPatternConfig{"rrule": 123, "start_time": "21:00", "end_time": "23:00"}},
+		{"invalid rrule", event.CustomPatternConfig{"rrule": "DTSTART:20250101\nRRULE:FREQ=HOURLY", "start_time": "21:00", "end_time": "23:00"}},
+		{"missing times", event.CustomPatternConfig{"rrule": "DTSTART:20250101\nRRULE:FREQ=WEEKLY"}},
+	}
 
-	err := config.Validate()
-	if err != nil {
-		t.Errorf("Validate() should succeed for empty config, got error: %v", err)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.config.Validate(); err == nil {
+				t.Errorf("Validate() should fail for %s", tc.name)
+			}
+		})
 	}
 }
 
@@ -427,7 +448,9 @@ func TestNewRecurringPattern_Success_Custom(t *testing.T) {
 	eventID := common.NewEventID()
 
 	config := event.CustomPatternConfig{
-		"custom_key": "custom_value",
+		"rrule":      "DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYDAY=SA;BYSETPOS=2,4",
+		"start_time": "21:00",
+		"end_time":   "23:00",
 	}
 
 	pattern, err := event.NewRecurringPattern(time.Now(), tenantID, eventID, event.PatternTypeCustom, config)
@@ -585,7 +608,7 @@ func TestReconstructRecurringPattern_Success_Custom(t *testing.T) {
 	createdAt := time.Now()
 	updatedAt := time.Now()
 
-	configJSON := []byte(`{"custom_key":"custom_value"}`)
+	configJSON := []byte(`{"rrule":"DTSTART:20250101\nRRULE:FREQ=MONTHLY;BYDAY=-1FR","start_time":"21:00","end_time":"23:00"}`)
 
 	pattern, err := event.ReconstructRecurringPattern(
 		patternID,
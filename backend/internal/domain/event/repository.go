@@ -17,10 +17,11 @@ type EventRepository interface {
 	FindByID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) (*Event, error)
 
 	// FindByTenantID finds all events within a tenant
-	// deleted_at IS NULL のレコードのみ返す
+	// deleted_at IS NULL のレコードのみ返す（アーカイブ済みのイベントを含む）
 	FindByTenantID(ctx context.Context, tenantID common.TenantID) ([]*Event, error)
 
 	// FindActiveByTenantID finds all active events within a tenant
+	// アーカイブ済みのイベントは含まない
 	FindActiveByTenantID(ctx context.Context, tenantID common.TenantID) ([]*Event, error)
 
 	// Delete deletes an event (physical delete)
@@ -0,0 +1,508 @@
+package member
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// AvailabilityWindowID represents an availability window identifier
+type AvailabilityWindowID string
+
+// NewAvailabilityWindowIDWithTime creates a new AvailabilityWindowID using the provided time.
+func NewAvailabilityWindowIDWithTime(t time.Time) AvailabilityWindowID {
+	return AvailabilityWindowID(common.NewULIDWithTime(t))
+}
+
+func (id AvailabilityWindowID) String() string {
+	return string(id)
+}
+
+func (id AvailabilityWindowID) Validate() error {
+	if id == "" {
+		return fmt.Errorf("window_id is required")
+	}
+	return common.ValidateULID(string(id))
+}
+
+func ParseAvailabilityWindowID(s string) (AvailabilityWindowID, error) {
+	if err := common.ValidateULID(s); err != nil {
+		return "", err
+	}
+	return AvailabilityWindowID(s), nil
+}
+
+// BlackoutID represents a blackout date identifier
+type BlackoutID string
+
+// NewBlackoutIDWithTime creates a new BlackoutID using the provided time.
+func NewBlackoutIDWithTime(t time.Time) BlackoutID {
+	return BlackoutID(common.NewULIDWithTime(t))
+}
+
+func (id BlackoutID) String() string {
+	return string(id)
+}
+
+func (id BlackoutID) Validate() error {
+	if id == "" {
+		return fmt.Errorf("blackout_id is required")
+	}
+	return common.ValidateULID(string(id))
+}
+
+func ParseBlackoutID(s string) (BlackoutID, error) {
+	if err := common.ValidateULID(s); err != nil {
+		return "", err
+	}
+	return BlackoutID(s), nil
+}
+
+// AvailabilityWindow represents a recurring weekly window in which a member can take shifts
+// 終了時刻が開始時刻より前の場合は翌日にまたがる（深夜帯）ものとして扱う
+type AvailabilityWindow struct {
+	windowID  AvailabilityWindowID
+	tenantID  common.TenantID
+	memberID  common.MemberID
+	dayOfWeek time.Weekday
+	startTime time.Time // HH:MM:SS のみ使用
+	endTime   time.Time // HH:MM:SS のみ使用
+	note      string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewAvailabilityWindow creates a new AvailabilityWindow
+func NewAvailabilityWindow(
+	now time.Time,
+	tenantID common.TenantID,
+	memberID common.MemberID,
+	dayOfWeek time.Weekday,
+	startTime time.Time,
+	endTime time.Time,
+	note string,
+) (*AvailabilityWindow, error) {
+	window := &AvailabilityWindow{
+		windowID:  NewAvailabilityWindowIDWithTime(now),
+		tenantID:  tenantID,
+		memberID:  memberID,
+		dayOfWeek: dayOfWeek,
+		startTime: truncateToTimeOfDay(startTime),
+		endTime:   truncateToTimeOfDay(endTime),
+		note:      note,
+		createdAt: now,
+		updatedAt: now,
+	}
+
+	if err := window.validate(); err != nil {
+		return nil, err
+	}
+
+	return window, nil
+}
+
+// ReconstructAvailabilityWindow reconstructs an AvailabilityWindow from persistence
+func ReconstructAvailabilityWindow(
+	windowID AvailabilityWindowID,
+	tenantID common.TenantID,
+	memberID common.MemberID,
+	dayOfWeek time.Weekday,
+	startTime time.Time,
+	endTime time.Time,
+	note string,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*AvailabilityWindow, error) {
+	window := &AvailabilityWindow{
+		windowID:  windowID,
+		tenantID:  tenantID,
+		memberID:  memberID,
+		dayOfWeek: dayOfWeek,
+		startTime: truncateToTimeOfDay(startTime),
+		endTime:   truncateToTimeOfDay(endTime),
+		note:      note,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+
+	if err := window.validate(); err != nil {
+		return nil, err
+	}
+
+	return window, nil
+}
+
+func (w *AvailabilityWindow) validate() error {
+	// TenantID の必須性チェック
+	if err := w.tenantID.Validate(); err != nil {
+		return common.NewValidationError("tenant_id is required", err)
+	}
+
+	// MemberID の必須性チェック
+	if err := w.memberID.Validate(); err != nil {
+		return common.NewValidationError("member_id is required", err)
+	}
+
+	// 曜日の範囲チェック（0=日曜 〜 6=土曜）
+	if w.dayOfWeek < time.Sunday || w.dayOfWeek > time.Saturday {
+		return common.NewValidationError("day_of_week must be between 0 and 6", nil)
+	}
+
+	// 同じ時刻は不正（深夜帯は end_time < start_time で表す）
+	if w.startTime.Equal(w.endTime) {
+		return common.NewValidationError("start_time and end_time must be different", nil)
+	}
+
+	// Note の長さチェック
+	if len(w.note) > 1000 {
+		return common.NewValidationError("note must be less than 1000 characters", nil)
+	}
+
+	return nil
+}
+
+// Getters
+
+func (w *AvailabilityWindow) WindowID() AvailabilityWindowID {
+	return w.windowID
+}
+
+func (w *AvailabilityWindow) TenantID() common.TenantID {
+	return w.tenantID
+}
+
+func (w *AvailabilityWindow) MemberID() common.MemberID {
+	return w.memberID
+}
+
+func (w *AvailabilityWindow) DayOfWeek() time.Weekday {
+	return w.dayOfWeek
+}
+
+func (w *AvailabilityWindow) StartTime() time.Time {
+	return w.startTime
+}
+
+func (w *AvailabilityWindow) EndTime() time.Time {
+	return w.endTime
+}
+
+func (w *AvailabilityWindow) Note() string {
+	return w.note
+}
+
+func (w *AvailabilityWindow) CreatedAt() time.Time {
+	return w.createdAt
+}
+
+func (w *AvailabilityWindow) UpdatedAt() time.Time {
+	return w.updatedAt
+}
+
+// IsOvernight returns true if the window crosses midnight
+func (w *AvailabilityWindow) IsOvernight() bool {
+	return w.endTime.Before(w.startTime)
+}
+
+// Update updates the day of week, times and note of the window
+func (w *AvailabilityWindow) Update(now time.Time, dayOfWeek time.Weekday, startTime, endTime time.Time, note string) error {
+	// Validate before mutating using a temporary copy
+	tmp := *w
+	tmp.dayOfWeek = dayOfWeek
+	tmp.startTime = truncateToTimeOfDay(startTime)
+	tmp.endTime = truncateToTimeOfDay(endTime)
+	tmp.note = note
+	tmp.updatedAt = now
+	if err := tmp.validate(); err != nil {
+		return err
+	}
+
+	*w = tmp
+	return nil
+}
+
+// periodOn returns the actual start/end datetime of the window on the given date
+func (w *AvailabilityWindow) periodOn(date time.Time) (time.Time, time.Time) {
+	return periodOnDate(date, w.startTime, w.endTime)
+}
+
+// AvailabilityBlackout represents a one-off date (or part of it) on which a member cannot take shifts
+// 時刻の指定がない場合は終日として扱う
+type AvailabilityBlackout struct {
+	blackoutID BlackoutID
+	tenantID   common.TenantID
+	memberID   common.MemberID
+	date       time.Time
+	startTime  *time.Time // nil の場合は終日
+	endTime    *time.Time // nil の場合は終日
+	reason     string
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+// NewAvailabilityBlackout creates a new AvailabilityBlackout
+func NewAvailabilityBlackout(
+	now time.Time,
+	tenantID common.TenantID,
+	memberID common.MemberID,
+	date time.Time,
+	startTime *time.Time,
+	endTime *time.Time,
+	reason string,
+) (*AvailabilityBlackout, error) {
+	blackout := &AvailabilityBlackout{
+		blackoutID: NewBlackoutIDWithTime(now),
+		tenantID:   tenantID,
+		memberID:   memberID,
+		date:       truncateToDate(date),
+		startTime:  truncateToTimeOfDayPtr(startTime),
+		endTime:    truncateToTimeOfDayPtr(endTime),
+		reason:     reason,
+		createdAt:  now,
+		updatedAt:  now,
+	}
+
+	if err := blackout.validate(); err != nil {
+		return nil, err
+	}
+
+	return blackout, nil
+}
+
+// ReconstructAvailabilityBlackout reconstructs an AvailabilityBlackout from persistence
+func ReconstructAvailabilityBlackout(
+	blackoutID BlackoutID,
+	tenantID common.TenantID,
+	memberID common.MemberID,
+	date time.Time,
+	startTime *time.Time,
+	endTime *time.Time,
+	reason string,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*AvailabilityBlackout, error) {
+	blackout := &AvailabilityBlackout{
+		blackoutID: blackoutID,
+		tenantID:   tenantID,
+		memberID:   memberID,
+		date:       truncateToDate(date),
+		startTime:  truncateToTimeOfDayPtr(startTime),
+		endTime:    truncateToTimeOfDayPtr(endTime),
+		reason:     reason,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}
+
+	if err := blackout.validate(); err != nil {
+		return nil, err
+	}
+
+	return blackout, nil
+}
+
+func (b *AvailabilityBlackout) validate() error {
+	// TenantID の必須性チェック
+	if err := b.tenantID.Validate(); err != nil {
+		return common.NewValidationError("tenant_id is required", err)
+	}
+
+	// MemberID の必須性チェック
+	if err := b.memberID.Validate(); err != nil {
+		return common.NewValidationError("member_id is required", err)
+	}
+
+	// Date の必須性チェック
+	if b.date.IsZero() {
+		return common.NewValidationError("date is required", nil)
+	}
+
+	// 時刻は両方指定するか、両方省略する（終日）
+	if (b.startTime == nil) != (b.endTime == nil) {
+		return common.NewValidationError("start_time and end_time must be specified together", nil)
+	}
+	if b.startTime != nil && b.startTime.Equal(*b.endTime) {
+		return common.NewValidationError("start_time and end_time must be different", nil)
+	}
+
+	// Reason の長さチェック
+	if len(b.reason) > 1000 {
+		return common.NewValidationError("reason must be less than 1000 characters", nil)
+	}
+
+	return nil
+}
+
+// Getters
+
+func (b *AvailabilityBlackout) BlackoutID() BlackoutID {
+	return b.blackoutID
+}
+
+func (b *AvailabilityBlackout) TenantID() common.TenantID {
+	return b.tenantID
+}
+
+func (b *AvailabilityBlackout) MemberID() common.MemberID {
+	return b.memberID
+}
+
+func (b *AvailabilityBlackout) Date() time.Time {
+	return b.date
+}
+
+func (b *AvailabilityBlackout) StartTime() *time.Time {
+	return b.startTime
+}
+
+func (b *AvailabilityBlackout) EndTime() *time.Time {
+	return b.endTime
+}
+
+func (b *AvailabilityBlackout) Reason() string {
+	return b.reason
+}
+
+func (b *AvailabilityBlackout) CreatedAt() time.Time {
+	return b.createdAt
+}
+
+func (b *AvailabilityBlackout) UpdatedAt() time.Time {
+	return b.updatedAt
+}
+
+// IsAllDay returns true if the blackout covers the whole day
+func (b *AvailabilityBlackout) IsAllDay() bool {
+	return b.startTime == nil
+}
+
+// Update updates the date, times and reason of the blackout
+func (b *AvailabilityBlackout) Update(now time.Time, date time.Time, startTime, endTime *time.Time, reason string) error {
+	// Validate before mutating using a temporary copy
+	tmp := *b
+	tmp.date = truncateToDate(date)
+	tmp.startTime = truncateToTimeOfDayPtr(startTime)
+	tmp.endTime = truncateToTimeOfDayPtr(endTime)
+	tmp.reason = reason
+	tmp.updatedAt = now
+	if err := tmp.validate(); err != nil {
+		return err
+	}
+
+	*b = tmp
+	return nil
+}
+
+// Period returns the actual start/end datetime covered by the blackout
+// 終日の場合は当日 00:00 から翌日 00:00 まで
+func (b *AvailabilityBlackout) Period() (time.Time, time.Time) {
+	if b.IsAllDay() {
+		return b.date, b.date.AddDate(0, 0, 1)
+	}
+	return periodOnDate(b.date, *b.startTime, *b.endTime)
+}
+
+// UnavailabilityReason represents why a member cannot take a shift
+type UnavailabilityReason string
+
+const (
+	// UnavailabilityReasonBlackout はブラックアウト日と重なっている
+	UnavailabilityReasonBlackout UnavailabilityReason = "blackout"
+	// UnavailabilityReasonOutsideWindows は参加可能時間帯の外にある
+	UnavailabilityReasonOutsideWindows UnavailabilityReason = "outside_availability"
+)
+
+// Availability is the result of checking a member's availability for a period
+type Availability struct {
+	Available bool
+	Reason    UnavailabilityReason  // Available が false の場合のみ設定
+	Blackout  *AvailabilityBlackout // Reason が blackout の場合、重なったブラックアウト
+}
+
+// CheckAvailability checks whether a member with the given windows and blackouts can take
+// a shift from start to end.
+//
+// - 期間と重なるブラックアウトがあれば参加不可
+// - 参加可能時間帯が1件も登録されていなければ、時間帯による制限はない
+// - 登録されている場合は、時間帯（連続する複数の時間帯を含む）が期間全体を覆っていなければ参加不可
+func CheckAvailability(windows []*AvailabilityWindow, blackouts []*AvailabilityBlackout, start, end time.Time) Availability {
+	for _, b := range blackouts {
+		bStart, bEnd := b.Period()
+		if start.Before(bEnd) && bStart.Before(end) {
+			return Availability{Reason: UnavailabilityReasonBlackout, Blackout: b}
+		}
+	}
+
+	if len(windows) == 0 || windowsCover(windows, start, end) {
+		return Availability{Available: true}
+	}
+	return Availability{Reason: UnavailabilityReasonOutsideWindows}
+}
+
+// windowsCover returns true if the windows together cover the whole period
+func windowsCover(windows []*AvailabilityWindow, start, end time.Time) bool {
+	type period struct{ start, end time.Time }
+
+	// 前日からの深夜帯の時間帯も考慮する
+	var periods []period
+	for d := truncateToDate(start).AddDate(0, 0, -1); !d.After(end); d = d.AddDate(0, 0, 1) {
+		for _, w := range windows {
+			if w.dayOfWeek != d.Weekday() {
+				continue
+			}
+			wStart, wEnd := w.periodOn(d)
+			periods = append(periods, period{wStart, wEnd})
+		}
+	}
+
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i].start.Before(periods[j].start)
+	})
+
+	covered := start
+	for _, p := range periods {
+		if p.start.After(covered) {
+			break
+		}
+		if p.end.After(covered) {
+			covered = p.end
+		}
+		if !covered.Before(end) {
+			return true
+		}
+	}
+	return false
+}
+
+// periodOnDate returns the actual start/end datetime of a time range on the given date
+// 終了時刻が開始時刻より前の場合は翌日の時刻とする
+func periodOnDate(date, startTime, endTime time.Time) (time.Time, time.Time) {
+	y, m, d := date.Date()
+	start := time.Date(y, m, d, startTime.Hour(), startTime.Minute(), startTime.Second(), 0, time.UTC)
+	end := time.Date(y, m, d, endTime.Hour(), endTime.Minute(), endTime.Second(), 0, time.UTC)
+	if endTime.Before(startTime) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return start, end
+}
+
+// truncateToDate truncates a time to date only (UTC)
+func truncateToDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// truncateToTimeOfDay truncates a time to time only (HH:MM:SS)
+func truncateToTimeOfDay(t time.Time) time.Time {
+	hour, min, sec := t.Clock()
+	return time.Date(2000, 1, 1, hour, min, sec, 0, time.UTC)
+}
+
+func truncateToTimeOfDayPtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := truncateToTimeOfDay(*t)
+	return &v
+}
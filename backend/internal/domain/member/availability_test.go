@@ -0,0 +1,187 @@
+package member
+
+import (
+	"testing"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+func clock(hour, min int) time.Time {
+	return time.Date(2000, 1, 1, hour, min, 0, 0, time.UTC)
+}
+
+func newTestWindow(t *testing.T, day time.Weekday, start, end time.Time) *AvailabilityWindow {
+	t.Helper()
+	w, err := NewAvailabilityWindow(time.Now(), common.NewTenantID(), common.NewMemberID(), day, start, end, "")
+	if err != nil {
+		t.Fatalf("NewAvailabilityWindow() should succeed, got error: %v", err)
+	}
+	return w
+}
+
+func newTestBlackout(t *testing.T, date time.Time, start, end *time.Time) *AvailabilityBlackout {
+	t.Helper()
+	b, err := NewAvailabilityBlackout(time.Now(), common.NewTenantID(), common.NewMemberID(), date, start, end, "")
+	if err != nil {
+		t.Fatalf("NewAvailabilityBlackout() should succeed, got error: %v", err)
+	}
+	return b
+}
+
+// =====================================================
+// NewAvailabilityWindow / NewAvailabilityBlackout Tests
+// =====================================================
+
+func TestNewAvailabilityWindow_ErrorWhenInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		day   time.Weekday
+		start time.Time
+		end   time.Time
+	}{
+		{"day out of range", time.Weekday(7), clock(20, 0), clock(23, 0)},
+		{"same start and end", time.Friday, clock(20, 0), clock(20, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAvailabilityWindow(time.Now(), common.NewTenantID(), common.NewMemberID(), tt.day, tt.start, tt.end, "")
+			if err == nil {
+				t.Errorf("expected validation error")
+			}
+		})
+	}
+}
+
+func TestNewAvailabilityBlackout_ErrorWhenOnlyOneTimeSpecified(t *testing.T) {
+	start := clock(20, 0)
+	_, err := NewAvailabilityBlackout(time.Now(), common.NewTenantID(), common.NewMemberID(), time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), &start, nil, "")
+	if err == nil {
+		t.Errorf("expected validation error when only start_time is set")
+	}
+}
+
+func TestAvailabilityWindow_Update_KeepsStateOnError(t *testing.T) {
+	w := newTestWindow(t, time.Friday, clock(20, 0), clock(23, 0))
+
+	if err := w.Update(time.Now(), time.Friday, clock(21, 0), clock(21, 0), ""); err == nil {
+		t.Fatalf("expected validation error")
+	}
+	if !w.StartTime().Equal(clock(20, 0)) {
+		t.Errorf("window should not change on error, got start %v", w.StartTime())
+	}
+}
+
+// =====================================================
+// CheckAvailability Tests
+// =====================================================
+
+func TestCheckAvailability(t *testing.T) {
+	// 2025-01-10 は金曜日
+	friday := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	at := func(day time.Time, hour, min int) time.Time {
+		return day.Add(time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute)
+	}
+	saturday := friday.AddDate(0, 0, 1)
+
+	blackoutStart, blackoutEnd := clock(21, 0), clock(22, 0)
+	nightStart, nightEnd := clock(23, 0), clock(1, 0)
+
+	tests := []struct {
+		name       string
+		windows    []*AvailabilityWindow
+		blackouts  []*AvailabilityBlackout
+		start      time.Time
+		end        time.Time
+		wantReason UnavailabilityReason
+	}{
+		{
+			name:  "no windows means no restriction",
+			start: at(friday, 20, 0), end: at(friday, 22, 0),
+		},
+		{
+			name:    "inside a window",
+			windows: []*AvailabilityWindow{newTestWindow(t, time.Friday, clock(19, 0), clock(23, 0))},
+			start:   at(friday, 20, 0), end: at(friday, 22, 0),
+		},
+		{
+			name:    "window on another weekday",
+			windows: []*AvailabilityWindow{newTestWindow(t, time.Saturday, clock(19, 0), clock(23, 0))},
+			start:   at(friday, 20, 0), end: at(friday, 22, 0),
+			wantReason: UnavailabilityReasonOutsideWindows,
+		},
+		{
+			name:    "partially outside a window",
+			windows: []*AvailabilityWindow{newTestWindow(t, time.Friday, clock(21, 0), clock(23, 0))},
+			start:   at(friday, 20, 0), end: at(friday, 22, 0),
+			wantReason: UnavailabilityReasonOutsideWindows,
+		},
+		{
+			name:    "overnight window covers a slot after midnight",
+			windows: []*AvailabilityWindow{newTestWindow(t, time.Friday, clock(22, 0), clock(3, 0))},
+			start:   at(saturday, 1, 0), end: at(saturday, 2, 0),
+		},
+		{
+			name: "adjacent windows cover a slot across midnight",
+			windows: []*AvailabilityWindow{
+				newTestWindow(t, time.Friday, clock(20, 0), clock(0, 0)),
+				newTestWindow(t, time.Saturday, clock(0, 0), clock(2, 0)),
+			},
+			start: at(friday, 23, 0), end: at(saturday, 1, 0),
+		},
+		{
+			name: "gap between windows",
+			windows: []*AvailabilityWindow{
+				newTestWindow(t, time.Friday, clock(19, 0), clock(20, 30)),
+				newTestWindow(t, time.Friday, clock(21, 0), clock(23, 0)),
+			},
+			start: at(friday, 20, 0), end: at(friday, 22, 0),
+			wantReason: UnavailabilityReasonOutsideWindows,
+		},
+		{
+			name:      "all-day blackout",
+			windows:   []*AvailabilityWindow{newTestWindow(t, time.Friday, clock(19, 0), clock(23, 0))},
+			blackouts: []*AvailabilityBlackout{newTestBlackout(t, friday, nil, nil)},
+			start:     at(friday, 20, 0), end: at(friday, 22, 0),
+			wantReason: UnavailabilityReasonBlackout,
+		},
+		{
+			name:      "all-day blackout on the previous day does not cover the slot",
+			blackouts: []*AvailabilityBlackout{newTestBlackout(t, friday.AddDate(0, 0, -1), nil, nil)},
+			start:     at(friday, 20, 0), end: at(friday, 22, 0),
+		},
+		{
+			name:      "partial blackout overlaps",
+			blackouts: []*AvailabilityBlackout{newTestBlackout(t, friday, &blackoutStart, &blackoutEnd)},
+			start:     at(friday, 20, 0), end: at(friday, 21, 30),
+			wantReason: UnavailabilityReasonBlackout,
+		},
+		{
+			name:      "partial blackout touching the slot end",
+			blackouts: []*AvailabilityBlackout{newTestBlackout(t, friday, &blackoutStart, &blackoutEnd)},
+			start:     at(friday, 20, 0), end: at(friday, 21, 0),
+		},
+		{
+			name:      "overnight blackout reaches into the next day",
+			blackouts: []*AvailabilityBlackout{newTestBlackout(t, friday, &nightStart, &nightEnd)},
+			start:     at(saturday, 0, 30), end: at(saturday, 2, 0),
+			wantReason: UnavailabilityReasonBlackout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CheckAvailability(tt.windows, tt.blackouts, tt.start, tt.end)
+			if got.Available != (tt.wantReason == "") {
+				t.Errorf("Available = %v, want %v", got.Available, tt.wantReason == "")
+			}
+			if got.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", got.Reason, tt.wantReason)
+			}
+			if tt.wantReason == UnavailabilityReasonBlackout && got.Blackout == nil {
+				t.Errorf("Blackout should be set for blackout reason")
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package member
 
 import (
 	"context"
+	"time"
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 )
@@ -86,3 +87,52 @@ type MemberGroupRepository interface {
 	// SetMemberGroups sets all groups for a member (replaces existing groups)
 	SetMemberGroups(ctx context.Context, memberID common.MemberID, groupIDs []common.MemberGroupID) error
 }
+
+// AvailabilityRepository defines the interface for member availability persistence
+// 参加可能時間帯（毎週）とブラックアウト日（単発）を扱う
+type AvailabilityRepository interface {
+	// SaveWindow saves an availability window (insert or update)
+	SaveWindow(ctx context.Context, window *AvailabilityWindow) error
+
+	// FindWindowByID finds an availability window by ID within a tenant
+	FindWindowByID(ctx context.Context, tenantID common.TenantID, windowID AvailabilityWindowID) (*AvailabilityWindow, error)
+
+	// FindWindowsByMemberID finds all availability windows of a member (day_of_week, start_time ascending)
+	FindWindowsByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*AvailabilityWindow, error)
+
+	// DeleteWindow deletes an availability window (physical delete)
+	DeleteWindow(ctx context.Context, tenantID common.TenantID, windowID AvailabilityWindowID) error
+
+	// SaveBlackout saves a blackout (insert or update)
+	SaveBlackout(ctx context.Context, blackout *AvailabilityBlackout) error
+
+	// FindBlackoutByID finds a blackout by ID within a tenant
+	FindBlackoutByID(ctx context.Context, tenantID common.TenantID, blackoutID BlackoutID) (*AvailabilityBlackout, error)
+
+	// FindBlackoutsByMemberID finds all blackouts of a member (date ascending)
+	FindBlackoutsByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*AvailabilityBlackout, error)
+
+	// FindBlackoutsByMemberIDAndDateRange finds blackouts of a member whose date is within [from, to]
+	FindBlackoutsByMemberIDAndDateRange(ctx context.Context, tenantID common.TenantID, memberID common.MemberID, from, to time.Time) ([]*AvailabilityBlackout, error)
+
+	// DeleteBlackout deletes a blackout (physical delete)
+	DeleteBlackout(ctx context.Context, tenantID common.TenantID, blackoutID BlackoutID) error
+}
+
+// WorkloadPolicyRepository defines the interface for workload policy persistence
+type WorkloadPolicyRepository interface {
+	// Save saves a workload policy (insert or update)
+	Save(ctx context.Context, policy *WorkloadPolicy) error
+
+	// FindTenantDefault finds the tenant-wide default policy
+	FindTenantDefault(ctx context.Context, tenantID common.TenantID) (*WorkloadPolicy, error)
+
+	// FindByMemberID finds the policy override of a member
+	FindByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) (*WorkloadPolicy, error)
+
+	// FindMemberPoliciesByTenantID finds all member policy overrides within a tenant
+	FindMemberPoliciesByTenantID(ctx context.Context, tenantID common.TenantID) ([]*WorkloadPolicy, error)
+
+	// Delete deletes a workload policy (physical delete)
+	Delete(ctx context.Context, tenantID common.TenantID, policyID WorkloadPolicyID) error
+}
@@ -0,0 +1,474 @@
+package member
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// WorkloadPolicyID represents a workload policy identifier
+type WorkloadPolicyID string
+
+// NewWorkloadPolicyIDWithTime creates a new WorkloadPolicyID using the provided time.
+func NewWorkloadPolicyIDWithTime(t time.Time) WorkloadPolicyID {
+	return WorkloadPolicyID(common.NewULIDWithTime(t))
+}
+
+func (id WorkloadPolicyID) String() string {
+	return string(id)
+}
+
+func (id WorkloadPolicyID) Validate() error {
+	if id == "" {
+		return fmt.Errorf("policy_id is required")
+	}
+	return common.ValidateULID(string(id))
+}
+
+// WorkloadEnforcement represents how workload limit violations are handled on manual assignment
+type WorkloadEnforcement string
+
+const (
+	// WorkloadEnforcementWarn は上限を超えても割り当て、警告のみ返す
+	WorkloadEnforcementWarn WorkloadEnforcement = "warn"
+	// WorkloadEnforcementBlock は上限を超える割り当てを拒否する（override_workload 指定時を除く）
+	WorkloadEnforcementBlock WorkloadEnforcement = "block"
+)
+
+func (e WorkloadEnforcement) Validate() error {
+	switch e {
+	case WorkloadEnforcementWarn, WorkloadEnforcementBlock:
+		return nil
+	default:
+		return common.NewValidationError("invalid workload enforcement", nil)
+	}
+}
+
+// WorkloadLimits represents the workload limits of a member (値オブジェクト)
+// nil の項目は制限なし（メンバー個別ポリシーではテナントの既定値を引き継ぐ）
+type WorkloadLimits struct {
+	MaxShiftsPerWeek            *int // 週（月曜始まり）あたりの最大シフト数
+	MaxShiftsPerMonth           *int // 月あたりの最大シフト数
+	MinShiftsPerMonth           *int // 月あたりの目標最小シフト数（レポートのみで使用）
+	MaxMinutesPerBusinessDay    *int // 営業日あたりの最大勤務時間（分）
+	MinRestMinutesBetweenShifts *int // シフト間の最小休憩時間（分）
+}
+
+func (l WorkloadLimits) validate() error {
+	for name, v := range map[string]*int{
+		"max_shifts_per_week":             l.MaxShiftsPerWeek,
+		"max_shifts_per_month":            l.MaxShiftsPerMonth,
+		"min_shifts_per_month":            l.MinShiftsPerMonth,
+		"max_minutes_per_business_day":    l.MaxMinutesPerBusinessDay,
+		"min_rest_minutes_between_shifts": l.MinRestMinutesBetweenShifts,
+	} {
+		if v != nil && *v < 0 {
+			return common.NewValidationError(name+" must not be negative", nil)
+		}
+	}
+
+	if l.MinShiftsPerMonth != nil && l.MaxShiftsPerMonth != nil && *l.MinShiftsPerMonth > *l.MaxShiftsPerMonth {
+		return common.NewValidationError("min_shifts_per_month must not exceed max_shifts_per_month", nil)
+	}
+
+	return nil
+}
+
+// Override returns the limits with the non-nil fields of other taking precedence
+func (l WorkloadLimits) Override(other WorkloadLimits) WorkloadLimits {
+	pick := func(base, override *int) *int {
+		if override != nil {
+			return override
+		}
+		return base
+	}
+	return WorkloadLimits{
+		MaxShiftsPerWeek:            pick(l.MaxShiftsPerWeek, other.MaxShiftsPerWeek),
+		MaxShiftsPerMonth:           pick(l.MaxShiftsPerMonth, other.MaxShiftsPerMonth),
+		MinShiftsPerMonth:           pick(l.MinShiftsPerMonth, other.MinShiftsPerMonth),
+		MaxMinutesPerBusinessDay:    pick(l.MaxMinutesPerBusinessDay, other.MaxMinutesPerBusinessDay),
+		MinRestMinutesBetweenShifts: pick(l.MinRestMinutesBetweenShifts, other.MinRestMinutesBetweenShifts),
+	}
+}
+
+// WorkloadPolicy represents the workload limits of a tenant (default) or a single member (override)
+// memberID が nil の場合はテナントの既定ポリシー
+type WorkloadPolicy struct {
+	policyID    WorkloadPolicyID
+	tenantID    common.TenantID
+	memberID    *common.MemberID
+	limits      WorkloadLimits
+	enforcement WorkloadEnforcement // メンバー個別ポリシーでは空の場合テナントの設定を引き継ぐ
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewWorkloadPolicy creates a new WorkloadPolicy
+func NewWorkloadPolicy(
+	now time.Time,
+	tenantID common.TenantID,
+	memberID *common.MemberID,
+	limits WorkloadLimits,
+	enforcement WorkloadEnforcement,
+) (*WorkloadPolicy, error) {
+	policy := &WorkloadPolicy{
+		policyID:    NewWorkloadPolicyIDWithTime(now),
+		tenantID:    tenantID,
+		memberID:    memberID,
+		limits:      limits,
+		enforcement: enforcement,
+		createdAt:   now,
+		updatedAt:   now,
+	}
+
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// ReconstructWorkloadPolicy reconstructs a WorkloadPolicy from persistence
+func ReconstructWorkloadPolicy(
+	policyID WorkloadPolicyID,
+	tenantID common.TenantID,
+	memberID *common.MemberID,
+	limits WorkloadLimits,
+	enforcement WorkloadEnforcement,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*WorkloadPolicy, error) {
+	policy := &WorkloadPolicy{
+		policyID:    policyID,
+		tenantID:    tenantID,
+		memberID:    memberID,
+		limits:      limits,
+		enforcement: enforcement,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (p *WorkloadPolicy) validate() error {
+	// TenantID の必須性チェック
+	if err := p.tenantID.Validate(); err != nil {
+		return common.NewValidationError("tenant_id is required", err)
+	}
+
+	// MemberID はメンバー個別ポリシーの場合のみ
+	if p.memberID != nil {
+		if err := p.memberID.Validate(); err != nil {
+			return common.NewValidationError("member_id is invalid", err)
+		}
+	}
+
+	// テナントの既定ポリシーは enforcement 必須、メンバー個別ポリシーは空（引き継ぎ）を許容
+	if p.enforcement != "" || p.memberID == nil {
+		if err := p.enforcement.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return p.limits.validate()
+}
+
+// Getters
+
+func (p *WorkloadPolicy) PolicyID() WorkloadPolicyID {
+	return p.policyID
+}
+
+func (p *WorkloadPolicy) TenantID() common.TenantID {
+	return p.tenantID
+}
+
+func (p *WorkloadPolicy) MemberID() *common.MemberID {
+	return p.memberID
+}
+
+func (p *WorkloadPolicy) Limits() WorkloadLimits {
+	return p.limits
+}
+
+func (p *WorkloadPolicy) Enforcement() WorkloadEnforcement {
+	return p.enforcement
+}
+
+func (p *WorkloadPolicy) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *WorkloadPolicy) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// IsTenantDefault returns true if the policy is the tenant-wide default
+func (p *WorkloadPolicy) IsTenantDefault() bool {
+	return p.memberID == nil
+}
+
+// Update replaces the limits and enforcement of the policy
+func (p *WorkloadPolicy) Update(now time.Time, limits WorkloadLimits, enforcement WorkloadEnforcement) error {
+	// Validate before mutating using a temporary copy
+	tmp := *p
+	tmp.limits = limits
+	tmp.enforcement = enforcement
+	tmp.updatedAt = now
+	if err := tmp.validate(); err != nil {
+		return err
+	}
+
+	*p = tmp
+	return nil
+}
+
+// ResolveWorkloadLimits returns the effective limits and enforcement for a member
+// メンバー個別ポリシーの項目がテナントの既定値より優先される。どちらもない場合は制限なし・警告のみ
+func ResolveWorkloadLimits(tenantPolicy, memberPolicy *WorkloadPolicy) (WorkloadLimits, WorkloadEnforcement) {
+	limits := WorkloadLimits{}
+	enforcement := WorkloadEnforcementWarn
+
+	if tenantPolicy != nil {
+		limits = tenantPolicy.limits
+		enforcement = tenantPolicy.enforcement
+	}
+	if memberPolicy != nil {
+		limits = limits.Override(memberPolicy.limits)
+		if memberPolicy.enforcement != "" {
+			enforcement = memberPolicy.enforcement
+		}
+	}
+
+	return limits, enforcement
+}
+
+// WorkPeriod represents one shift of a member for workload calculation
+type WorkPeriod struct {
+	Date  time.Time // 営業日の日付（週・月・営業日の集計に使用）
+	Start time.Time // 実際の開始日時
+	End   time.Time // 実際の終了日時（深夜帯は翌日）
+}
+
+// Minutes returns the length of the period in minutes
+func (p WorkPeriod) Minutes() int {
+	return int(p.End.Sub(p.Start).Minutes())
+}
+
+// WorkloadViolationKind represents which workload limit is violated
+type WorkloadViolationKind string
+
+const (
+	WorkloadViolationMaxShiftsPerWeek         WorkloadViolationKind = "max_shifts_per_week"
+	WorkloadViolationMaxShiftsPerMonth        WorkloadViolationKind = "max_shifts_per_month"
+	WorkloadViolationMinShiftsPerMonth        WorkloadViolationKind = "min_shifts_per_month"
+	WorkloadViolationMaxMinutesPerBusinessDay WorkloadViolationKind = "max_minutes_per_business_day"
+	WorkloadViolationMinRestBetweenShifts     WorkloadViolationKind = "min_rest_between_shifts"
+)
+
+// WorkloadViolation represents a workload limit that is (or would be) violated
+type WorkloadViolation struct {
+	Kind        WorkloadViolationKind
+	Limit       int       // 上限・下限（シフト数または分）
+	Actual      int       // 実際の値（シフト数または分）
+	PeriodStart time.Time // 対象期間の開始日（週・月・営業日）、休憩時間の場合は前のシフトの終了日時
+}
+
+// IsOver returns true if the violation is an upper limit (or minimum rest) violation
+func (v WorkloadViolation) IsOver() bool {
+	return v.Kind != WorkloadViolationMinShiftsPerMonth
+}
+
+// WorkloadLimitError is returned when a manual assignment exceeds the member's workload limits
+// enforcement が block の場合のみ返される（override_workload 指定時は警告として扱う）
+type WorkloadLimitError struct {
+	MemberID   common.MemberID
+	Violations []WorkloadViolation
+}
+
+func (e *WorkloadLimitError) Error() string {
+	return fmt.Sprintf("member %s would exceed %d workload limit(s)", e.MemberID, len(e.Violations))
+}
+
+// EvaluateAssignment evaluates adding candidate to the member's existing shifts against the limits
+// 上限は「追加後の値が上限を超える」場合に違反とする。時間帯が重なるシフトは割り当ての重複検出で扱うため休憩時間の対象外
+func (l WorkloadLimits) EvaluateAssignment(candidate WorkPeriod, existing []WorkPeriod) []WorkloadViolation {
+	var violations []WorkloadViolation
+
+	weekStart := startOfWeek(candidate.Date)
+	monthStart := startOfMonth(candidate.Date)
+	day := truncateToDate(candidate.Date)
+
+	weekCount, monthCount, dayMinutes := 1, 1, candidate.Minutes()
+	minRest := -1
+	var restAfter time.Time
+	for _, p := range existing {
+		if startOfWeek(p.Date).Equal(weekStart) {
+			weekCount++
+		}
+		if startOfMonth(p.Date).Equal(monthStart) {
+			monthCount++
+		}
+		if truncateToDate(p.Date).Equal(day) {
+			dayMinutes += p.Minutes()
+		}
+
+		var gap time.Duration
+		var prevEnd time.Time
+		switch {
+		case !candidate.Start.Before(p.End):
+			gap, prevEnd = candidate.Start.Sub(p.End), p.End
+		case !p.Start.Before(candidate.End):
+			gap, prevEnd = p.Start.Sub(candidate.End), candidate.End
+		default:
+			continue
+		}
+		if minRest < 0 || int(gap.Minutes()) < minRest {
+			minRest = int(gap.Minutes())
+			restAfter = prevEnd
+		}
+	}
+
+	if l.MaxShiftsPerWeek != nil && weekCount > *l.MaxShiftsPerWeek {
+		violations = append(violations, WorkloadViolation{WorkloadViolationMaxShiftsPerWeek, *l.MaxShiftsPerWeek, weekCount, weekStart})
+	}
+	if l.MaxShiftsPerMonth != nil && monthCount > *l.MaxShiftsPerMonth {
+		violations = append(violations, WorkloadViolation{WorkloadViolationMaxShiftsPerMonth, *l.MaxShiftsPerMonth, monthCount, monthStart})
+	}
+	if l.MaxMinutesPerBusinessDay != nil && dayMinutes > *l.MaxMinutesPerBusinessDay {
+		violations = append(violations, WorkloadViolation{WorkloadViolationMaxMinutesPerBusinessDay, *l.MaxMinutesPerBusinessDay, dayMinutes, day})
+	}
+	if l.MinRestMinutesBetweenShifts != nil && minRest >= 0 && minRest < *l.MinRestMinutesBetweenShifts {
+		violations = append(violations, WorkloadViolation{WorkloadViolationMinRestBetweenShifts, *l.MinRestMinutesBetweenShifts, minRest, restAfter})
+	}
+
+	return violations
+}
+
+// WorkloadStatus represents a member's workload relative to their limits over a date range
+type WorkloadStatus string
+
+const (
+	WorkloadStatusOver   WorkloadStatus = "over"
+	WorkloadStatusUnder  WorkloadStatus = "under"
+	WorkloadStatusWithin WorkloadStatus = "within"
+)
+
+// WorkloadSummary is the workload of a member over a date range
+type WorkloadSummary struct {
+	ShiftCount   int
+	TotalMinutes int
+	Status       WorkloadStatus
+	Violations   []WorkloadViolation
+}
+
+// Summarize evaluates a member's shifts with dates within [from, to] against the limits
+//
+// - 週・月・営業日ごとの上限超過、シフト間の休憩不足があれば over
+// - 期間に完全に含まれる月でシフト数が min_shifts_per_month を下回れば under
+// - 両方に該当する場合は over を優先する
+func (l WorkloadLimits) Summarize(shifts []WorkPeriod, from, to time.Time) WorkloadSummary {
+	from, to = truncateToDate(from), truncateToDate(to)
+
+	var inRange []WorkPeriod
+	for _, s := range shifts {
+		d := truncateToDate(s.Date)
+		if d.Before(from) || d.After(to) {
+			continue
+		}
+		inRange = append(inRange, s)
+	}
+	sort.Slice(inRange, func(i, j int) bool {
+		return inRange[i].Start.Before(inRange[j].Start)
+	})
+
+	summary := WorkloadSummary{ShiftCount: len(inRange), Status: WorkloadStatusWithin}
+
+	weekCounts := map[time.Time]int{}
+	monthCounts := map[time.Time]int{}
+	dayMinutes := map[time.Time]int{}
+	for i, s := range inRange {
+		summary.TotalMinutes += s.Minutes()
+		weekCounts[startOfWeek(s.Date)]++
+		monthCounts[startOfMonth(s.Date)]++
+		dayMinutes[truncateToDate(s.Date)] += s.Minutes()
+
+		if l.MinRestMinutesBetweenShifts != nil && i > 0 {
+			prev := inRange[i-1]
+			gap := int(s.Start.Sub(prev.End).Minutes())
+			if gap >= 0 && gap < *l.MinRestMinutesBetweenShifts {
+				summary.Violations = append(summary.Violations, WorkloadViolation{WorkloadViolationMinRestBetweenShifts, *l.MinRestMinutesBetweenShifts, gap, prev.End})
+			}
+		}
+	}
+
+	for _, week := range sortedKeys(weekCounts) {
+		if l.MaxShiftsPerWeek != nil && weekCounts[week] > *l.MaxShiftsPerWeek {
+			summary.Violations = append(summary.Violations, WorkloadViolation{WorkloadViolationMaxShiftsPerWeek, *l.MaxShiftsPerWeek, weekCounts[week], week})
+		}
+	}
+	for _, month := range sortedKeys(monthCounts) {
+		if l.MaxShiftsPerMonth != nil && monthCounts[month] > *l.MaxShiftsPerMonth {
+			summary.Violations = append(summary.Violations, WorkloadViolation{WorkloadViolationMaxShiftsPerMonth, *l.MaxShiftsPerMonth, monthCounts[month], month})
+		}
+	}
+	for _, day := range sortedKeys(dayMinutes) {
+		if l.MaxMinutesPerBusinessDay != nil && dayMinutes[day] > *l.MaxMinutesPerBusinessDay {
+			summary.Violations = append(summary.Violations, WorkloadViolation{WorkloadViolationMaxMinutesPerBusinessDay, *l.MaxMinutesPerBusinessDay, dayMinutes[day], day})
+		}
+	}
+
+	// 期間に完全に含まれる月のみ下限を判定する
+	if l.MinShiftsPerMonth != nil {
+		for month := startOfMonth(from); !month.After(to); month = month.AddDate(0, 1, 0) {
+			monthEnd := month.AddDate(0, 1, -1)
+			if month.Before(from) || monthEnd.After(to) {
+				continue
+			}
+			if monthCounts[month] < *l.MinShiftsPerMonth {
+				summary.Violations = append(summary.Violations, WorkloadViolation{WorkloadViolationMinShiftsPerMonth, *l.MinShiftsPerMonth, monthCounts[month], month})
+			}
+		}
+	}
+
+	for _, v := range summary.Violations {
+		if v.IsOver() {
+			summary.Status = WorkloadStatusOver
+			break
+		}
+		summary.Status = WorkloadStatusUnder
+	}
+
+	return summary
+}
+
+// startOfWeek returns the Monday of the week containing t
+func startOfWeek(t time.Time) time.Time {
+	d := truncateToDate(t)
+	offset := (int(d.Weekday()) + 6) % 7 // 月曜=0
+	return d.AddDate(0, 0, -offset)
+}
+
+// startOfMonth returns the first day of the month containing t
+func startOfMonth(t time.Time) time.Time {
+	y, m, _ := t.Date()
+	return time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func sortedKeys(m map[time.Time]int) []time.Time {
+	keys := make([]time.Time, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Before(keys[j])
+	})
+	return keys
+}
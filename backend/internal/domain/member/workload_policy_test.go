@@ -0,0 +1,234 @@
+package member
+
+import (
+	"testing"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+// shiftOn returns a work period on the given date from startHour to endHour (overnight if endHour <= startHour)
+func shiftOn(date time.Time, startHour, endHour int) WorkPeriod {
+	start := date.Add(time.Duration(startHour) * time.Hour)
+	end := date.Add(time.Duration(endHour) * time.Hour)
+	if endHour <= startHour {
+		end = end.AddDate(0, 0, 1)
+	}
+	return WorkPeriod{Date: date, Start: start, End: end}
+}
+
+// =====================================================
+// WorkloadPolicy Tests
+// =====================================================
+
+func TestNewWorkloadPolicy_Validation(t *testing.T) {
+	memberID := common.NewMemberID()
+
+	tests := []struct {
+		name        string
+		memberID    *common.MemberID
+		limits      WorkloadLimits
+		enforcement WorkloadEnforcement
+		wantErr     bool
+	}{
+		{"tenant default", nil, WorkloadLimits{MaxShiftsPerWeek: intPtr(3)}, WorkloadEnforcementWarn, false},
+		{"tenant default requires enforcement", nil, WorkloadLimits{}, "", true},
+		{"member override inherits enforcement", &memberID, WorkloadLimits{}, "", false},
+		{"invalid enforcement", &memberID, WorkloadLimits{}, "deny", true},
+		{"negative limit", nil, WorkloadLimits{MaxShiftsPerMonth: intPtr(-1)}, WorkloadEnforcementWarn, true},
+		{"min exceeds max", nil, WorkloadLimits{MinShiftsPerMonth: intPtr(5), MaxShiftsPerMonth: intPtr(4)}, WorkloadEnforcementWarn, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWorkloadPolicy(time.Now(), common.NewTenantID(), tt.memberID, tt.limits, tt.enforcement)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewWorkloadPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResolveWorkloadLimits_MemberOverridesTenantDefault(t *testing.T) {
+	tenantID := common.NewTenantID()
+	memberID := common.NewMemberID()
+
+	tenantPolicy, err := NewWorkloadPolicy(time.Now(), tenantID, nil, WorkloadLimits{
+		MaxShiftsPerWeek:  intPtr(3),
+		MaxShiftsPerMonth: intPtr(10),
+	}, WorkloadEnforcementBlock)
+	if err != nil {
+		t.Fatalf("NewWorkloadPolicy() should succeed, got error: %v", err)
+	}
+	memberPolicy, err := NewWorkloadPolicy(time.Now(), tenantID, &memberID, WorkloadLimits{
+		MaxShiftsPerWeek: intPtr(1),
+	}, "")
+	if err != nil {
+		t.Fatalf("NewWorkloadPolicy() should succeed, got error: %v", err)
+	}
+
+	limits, enforcement := ResolveWorkloadLimits(tenantPolicy, memberPolicy)
+	if *limits.MaxShiftsPerWeek != 1 || *limits.MaxShiftsPerMonth != 10 {
+		t.Errorf("unexpected limits: week=%d month=%d", *limits.MaxShiftsPerWeek, *limits.MaxShiftsPerMonth)
+	}
+	if enforcement != WorkloadEnforcementBlock {
+		t.Errorf("enforcement should be inherited from the tenant default, got %s", enforcement)
+	}
+
+	limits, enforcement = ResolveWorkloadLimits(nil, nil)
+	if limits.MaxShiftsPerWeek != nil || enforcement != WorkloadEnforcementWarn {
+		t.Errorf("no policy should mean no limits and warn, got %+v %s", limits, enforcement)
+	}
+}
+
+// =====================================================
+// EvaluateAssignment Tests
+// =====================================================
+
+func TestWorkloadLimits_EvaluateAssignment(t *testing.T) {
+	// 2025-01-06 は月曜日
+	monday := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		limits    WorkloadLimits
+		candidate WorkPeriod
+		existing  []WorkPeriod
+		wantKinds []WorkloadViolationKind
+	}{
+		{
+			name:      "week limit counts only the same week",
+			limits:    WorkloadLimits{MaxShiftsPerWeek: intPtr(2)},
+			candidate: shiftOn(monday.AddDate(0, 0, 6), 20, 22), // 日曜
+			existing:  []WorkPeriod{shiftOn(monday, 20, 22), shiftOn(monday.AddDate(0, 0, -1), 20, 22)},
+			wantKinds: nil,
+		},
+		{
+			name:      "week limit exceeded",
+			limits:    WorkloadLimits{MaxShiftsPerWeek: intPtr(2)},
+			candidate: shiftOn(monday.AddDate(0, 0, 6), 20, 22),
+			existing:  []WorkPeriod{shiftOn(monday, 20, 22), shiftOn(monday.AddDate(0, 0, 2), 20, 22)},
+			wantKinds: []WorkloadViolationKind{WorkloadViolationMaxShiftsPerWeek},
+		},
+		{
+			name:      "month limit exceeded",
+			limits:    WorkloadLimits{MaxShiftsPerMonth: intPtr(1)},
+			candidate: shiftOn(monday.AddDate(0, 0, 14), 20, 22),
+			existing:  []WorkPeriod{shiftOn(monday, 20, 22), shiftOn(monday.AddDate(0, 1, 0), 20, 22)},
+			wantKinds: []WorkloadViolationKind{WorkloadViolationMaxShiftsPerMonth},
+		},
+		{
+			name:      "business day minutes include overnight shifts",
+			limits:    WorkloadLimits{MaxMinutesPerBusinessDay: intPtr(240)},
+			candidate: shiftOn(monday, 23, 2),
+			existing:  []WorkPeriod{shiftOn(monday, 20, 22)},
+			wantKinds: []WorkloadViolationKind{WorkloadViolationMaxMinutesPerBusinessDay},
+		},
+		{
+			name:      "rest between shifts across midnight",
+			limits:    WorkloadLimits{MinRestMinutesBetweenShifts: intPtr(480)},
+			candidate: shiftOn(monday.AddDate(0, 0, 1), 6, 8),
+			existing:  []WorkPeriod{shiftOn(monday, 22, 2)},
+			wantKinds: []WorkloadViolationKind{WorkloadViolationMinRestBetweenShifts},
+		},
+		{
+			name:      "back-to-back shifts violate minimum rest",
+			limits:    WorkloadLimits{MinRestMinutesBetweenShifts: intPtr(30)},
+			candidate: shiftOn(monday, 22, 23),
+			existing:  []WorkPeriod{shiftOn(monday, 20, 22)},
+			wantKinds: []WorkloadViolationKind{WorkloadViolationMinRestBetweenShifts},
+		},
+		{
+			name:      "enough rest",
+			limits:    WorkloadLimits{MinRestMinutesBetweenShifts: intPtr(60)},
+			candidate: shiftOn(monday, 23, 1),
+			existing:  []WorkPeriod{shiftOn(monday, 20, 22)},
+			wantKinds: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := tt.limits.EvaluateAssignment(tt.candidate, tt.existing)
+			if len(violations) != len(tt.wantKinds) {
+				t.Fatalf("expected %d violations, got %+v", len(tt.wantKinds), violations)
+			}
+			for i, v := range violations {
+				if v.Kind != tt.wantKinds[i] {
+					t.Errorf("violation[%d] = %s, want %s", i, v.Kind, tt.wantKinds[i])
+				}
+			}
+		})
+	}
+}
+
+// =====================================================
+// Summarize Tests
+// =====================================================
+
+func TestWorkloadLimits_Summarize(t *testing.T) {
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	janEnd := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	limits := WorkloadLimits{MaxShiftsPerWeek: intPtr(2), MinShiftsPerMonth: intPtr(2)}
+
+	tests := []struct {
+		name       string
+		shifts     []WorkPeriod
+		from, to   time.Time
+		wantStatus WorkloadStatus
+		wantCount  int
+	}{
+		{
+			name:       "under the monthly target",
+			shifts:     []WorkPeriod{shiftOn(jan.AddDate(0, 0, 5), 20, 22)},
+			from:       jan,
+			to:         janEnd,
+			wantStatus: WorkloadStatusUnder,
+			wantCount:  1,
+		},
+		{
+			name:       "partial month is not checked against the target",
+			shifts:     []WorkPeriod{shiftOn(jan.AddDate(0, 0, 5), 20, 22)},
+			from:       jan,
+			to:         jan.AddDate(0, 0, 14),
+			wantStatus: WorkloadStatusWithin,
+			wantCount:  1,
+		},
+		{
+			name: "over takes precedence",
+			shifts: []WorkPeriod{
+				shiftOn(jan.AddDate(0, 0, 5), 20, 22), // 2025-01-06 月曜
+				shiftOn(jan.AddDate(0, 0, 6), 20, 22),
+				shiftOn(jan.AddDate(0, 0, 7), 20, 22),
+			},
+			from:       jan,
+			to:         janEnd,
+			wantStatus: WorkloadStatusOver,
+			wantCount:  3,
+		},
+		{
+			name:       "shifts outside the range are ignored",
+			shifts:     []WorkPeriod{shiftOn(jan.AddDate(0, 0, 5), 20, 22), shiftOn(jan.AddDate(0, 0, 10), 20, 22), shiftOn(jan.AddDate(0, 1, 0), 20, 22)},
+			from:       jan,
+			to:         janEnd,
+			wantStatus: WorkloadStatusWithin,
+			wantCount:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := limits.Summarize(tt.shifts, tt.from, tt.to)
+			if summary.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s (violations: %+v)", summary.Status, tt.wantStatus, summary.Violations)
+			}
+			if summary.ShiftCount != tt.wantCount {
+				t.Errorf("shift count = %d, want %d", summary.ShiftCount, tt.wantCount)
+			}
+		})
+	}
+}
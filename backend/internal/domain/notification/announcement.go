@@ -0,0 +1,66 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+)
+
+// AnnouncementKind represents the kind of channel-wide announcement
+type AnnouncementKind string
+
+const (
+	AnnouncementKindCollectionOpened   AnnouncementKind = "collection_opened"   // 出欠確認の受付開始
+	AnnouncementKindCollectionDeadline AnnouncementKind = "collection_deadline" // 出欠確認の締切間近
+	AnnouncementKindUnderstaffedSlot   AnnouncementKind = "understaffed_slot"   // 人員不足のシフト枠
+	AnnouncementKindCollectionClosed   AnnouncementKind = "collection_closed"   // 出欠確認の締切（回答のまとめ）
+	AnnouncementKindScheduleClosed     AnnouncementKind = "schedule_closed"     // 日程調整の締切（回答のまとめ）
+)
+
+func (k AnnouncementKind) Validate() error {
+	switch k {
+	case AnnouncementKindCollectionOpened, AnnouncementKindCollectionDeadline, AnnouncementKindUnderstaffedSlot,
+		AnnouncementKindCollectionClosed, AnnouncementKindScheduleClosed:
+		return nil
+	default:
+		return fmt.Errorf("invalid announcement kind: %s", k)
+	}
+}
+
+// AnnouncementField represents a labeled value shown in an announcement
+type AnnouncementField struct {
+	Name  string
+	Value string
+}
+
+// Announcement is a channel-wide post that is not addressed to a single member
+// メンバー個人宛ての通知はアウトボックス（OutboxMessage）を使い、こちらはチャネル全体への告知に使う
+type Announcement struct {
+	TenantID      common.TenantID
+	EventID       *common.EventID      // 投稿先の解決に使う（イベント個別の設定を優先）
+	BusinessDayID *event.BusinessDayID // EventID がない場合、営業日からイベントを解決する
+	Kind          AnnouncementKind
+	Title         string
+	Description   string
+	URL           string
+	Fields        []AnnouncementField
+	Mentions      []string // メンションする Discord ユーザーID
+}
+
+// Announcer posts channel-wide announcements
+type Announcer interface {
+	// Announce posts the announcement; it returns ErrUndeliverable if no destination is configured
+	Announce(ctx context.Context, a Announcement) error
+}
+
+// AnnouncementLogRepository records posted announcements so that each subject is announced once
+type AnnouncementLogRepository interface {
+	// Exists reports whether the announcement of the subject has already been posted
+	Exists(ctx context.Context, tenantID common.TenantID, kind AnnouncementKind, subjectID string) (bool, error)
+
+	// Record records that the announcement of the subject has been posted (既に記録済みの場合は何もしない)
+	Record(ctx context.Context, tenantID common.TenantID, kind AnnouncementKind, subjectID string, announcedAt time.Time) error
+}
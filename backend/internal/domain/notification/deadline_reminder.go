@@ -0,0 +1,36 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// ReminderSubjectKind represents the kind of subject a deadline reminder is sent for
+type ReminderSubjectKind string
+
+const (
+	ReminderSubjectAttendanceCollection ReminderSubjectKind = "attendance_collection" // 出欠確認
+	ReminderSubjectDateSchedule         ReminderSubjectKind = "date_schedule"         // 日程調整
+)
+
+func (k ReminderSubjectKind) Validate() error {
+	switch k {
+	case ReminderSubjectAttendanceCollection, ReminderSubjectDateSchedule:
+		return nil
+	default:
+		return fmt.Errorf("invalid reminder subject kind: %s", k)
+	}
+}
+
+// DeadlineReminderLogRepository records the deadline reminders sent to members
+// 締切ごとに記録するため、締切が変更された場合は改めてリマインドできる
+type DeadlineReminderLogRepository interface {
+	// Exists reports whether the member has already been reminded of the deadline of the subject
+	Exists(ctx context.Context, tenantID common.TenantID, kind ReminderSubjectKind, subjectID string, memberID common.MemberID, deadline time.Time) (bool, error)
+
+	// Record records the reminder; it returns false if the member has already been reminded (既に記録済みの場合は何もしない)
+	Record(ctx context.Context, tenantID common.TenantID, kind ReminderSubjectKind, subjectID string, memberID common.MemberID, deadline time.Time, remindedAt time.Time) (bool, error)
+}
@@ -0,0 +1,212 @@
+package notification
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// DiscordWebhookID represents a Discord webhook setting identifier
+type DiscordWebhookID string
+
+// NewDiscordWebhookIDWithTime creates a new DiscordWebhookID using the provided time.
+func NewDiscordWebhookIDWithTime(t time.Time) DiscordWebhookID {
+	return DiscordWebhookID(common.NewULIDWithTime(t))
+}
+
+func (id DiscordWebhookID) String() string {
+	return string(id)
+}
+
+func (id DiscordWebhookID) Validate() error {
+	if id == "" {
+		return fmt.Errorf("webhook_id is required")
+	}
+	return common.ValidateULID(string(id))
+}
+
+// DiscordWebhook represents the Discord webhook a tenant (default) or a single event (override) posts to
+// eventID が nil の場合はテナントの既定設定
+type DiscordWebhook struct {
+	webhookID  DiscordWebhookID
+	tenantID   common.TenantID
+	eventID    *common.EventID
+	webhookURL string
+	enabled    bool // イベント個別設定で false の場合、そのイベントの投稿を止める（テナントの既定設定も使わない）
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+// NewDiscordWebhook creates a new DiscordWebhook
+func NewDiscordWebhook(
+	now time.Time,
+	tenantID common.TenantID,
+	eventID *common.EventID,
+	webhookURL string,
+	enabled bool,
+) (*DiscordWebhook, error) {
+	webhook := &DiscordWebhook{
+		webhookID:  NewDiscordWebhookIDWithTime(now),
+		tenantID:   tenantID,
+		eventID:    eventID,
+		webhookURL: webhookURL,
+		enabled:    enabled,
+		createdAt:  now,
+		updatedAt:  now,
+	}
+
+	if err := webhook.validate(); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// ReconstructDiscordWebhook reconstructs a DiscordWebhook from persistence
+func ReconstructDiscordWebhook(
+	webhookID DiscordWebhookID,
+	tenantID common.TenantID,
+	eventID *common.EventID,
+	webhookURL string,
+	enabled bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*DiscordWebhook, error) {
+	webhook := &DiscordWebhook{
+		webhookID:  webhookID,
+		tenantID:   tenantID,
+		eventID:    eventID,
+		webhookURL: webhookURL,
+		enabled:    enabled,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}
+
+	if err := webhook.validate(); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func (w *DiscordWebhook) validate() error {
+	if err := w.tenantID.Validate(); err != nil {
+		return common.NewValidationError("tenant_id is required", err)
+	}
+
+	if w.eventID != nil {
+		if err := w.eventID.Validate(); err != nil {
+			return common.NewValidationError("event_id is invalid", err)
+		}
+	}
+
+	return ValidateWebhookURL(w.webhookURL)
+}
+
+// webhookHosts are the hosts that serve Discord webhooks
+var webhookHosts = map[string]bool{
+	"discord.com":        true,
+	"discordapp.com":     true,
+	"canary.discord.com": true,
+}
+
+// webhookPathPrefix is the path prefix of a Discord webhook URL (/api/webhooks/{id}/{token})
+const webhookPathPrefix = "/api/webhooks/"
+
+// ValidateWebhookURL validates the webhook URL
+// サーバーから任意のURLへリクエストを送らせない（SSRF 対策）ため、Discord の Webhook URL のみ受け付ける
+func ValidateWebhookURL(webhookURL string) error {
+	if webhookURL == "" {
+		return common.NewValidationError("webhook_url is required", nil)
+	}
+	if len(webhookURL) > 500 {
+		return common.NewValidationError("webhook_url must be less than 500 characters", nil)
+	}
+
+	u, err := url.Parse(webhookURL)
+	if err != nil || u.Scheme != "https" || u.User != nil || u.Port() != "" || !webhookHosts[u.Hostname()] ||
+		!strings.HasPrefix(u.Path, webhookPathPrefix) || len(u.Path) == len(webhookPathPrefix) {
+		return common.NewValidationError("webhook_url must be a Discord webhook URL (https://discord.com/api/webhooks/...)", err)
+	}
+
+	return nil
+}
+
+// Getters
+
+func (w *DiscordWebhook) WebhookID() DiscordWebhookID {
+	return w.webhookID
+}
+
+func (w *DiscordWebhook) TenantID() common.TenantID {
+	return w.tenantID
+}
+
+func (w *DiscordWebhook) EventID() *common.EventID {
+	return w.eventID
+}
+
+func (w *DiscordWebhook) WebhookURL() string {
+	return w.webhookURL
+}
+
+// MaskedWebhookURL returns the webhook URL without its token
+// トークンを知っていれば誰でも投稿できるため、API レスポンスには Webhook ID までしか含めない
+func (w *DiscordWebhook) MaskedWebhookURL() string {
+	u, err := url.Parse(w.webhookURL)
+	if err != nil {
+		return ""
+	}
+	webhookID, _, _ := strings.Cut(strings.TrimPrefix(u.Path, webhookPathPrefix), "/")
+	return u.Scheme + "://" + u.Host + webhookPathPrefix + webhookID + "/****"
+}
+
+func (w *DiscordWebhook) IsEnabled() bool {
+	return w.enabled
+}
+
+func (w *DiscordWebhook) CreatedAt() time.Time {
+	return w.createdAt
+}
+
+func (w *DiscordWebhook) UpdatedAt() time.Time {
+	return w.updatedAt
+}
+
+// IsTenantDefault returns true if the webhook is the tenant-wide default
+func (w *DiscordWebhook) IsTenantDefault() bool {
+	return w.eventID == nil
+}
+
+// Update replaces the URL and enabled flag of the webhook
+func (w *DiscordWebhook) Update(now time.Time, webhookURL string, enabled bool) error {
+	// Validate before mutating using a temporary copy
+	tmp := *w
+	tmp.webhookURL = webhookURL
+	tmp.enabled = enabled
+	tmp.updatedAt = now
+	if err := tmp.validate(); err != nil {
+		return err
+	}
+
+	*w = tmp
+	return nil
+}
+
+// ResolveDiscordWebhook returns the webhook used for an event, or nil if nothing should be posted
+// イベント個別設定がある場合はそれを優先し（無効化されていれば投稿しない）、なければテナントの既定設定を使う
+func ResolveDiscordWebhook(tenantDefault, eventWebhook *DiscordWebhook) *DiscordWebhook {
+	if eventWebhook != nil {
+		if !eventWebhook.enabled {
+			return nil
+		}
+		return eventWebhook
+	}
+	if tenantDefault != nil && tenantDefault.enabled {
+		return tenantDefault
+	}
+	return nil
+}
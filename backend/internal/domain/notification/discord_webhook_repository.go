@@ -0,0 +1,22 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// DiscordWebhookRepository defines the interface for DiscordWebhook persistence
+type DiscordWebhookRepository interface {
+	// Save saves a webhook setting (insert or update)
+	Save(ctx context.Context, webhook *DiscordWebhook) error
+
+	// FindTenantDefault finds the tenant-wide default webhook
+	FindTenantDefault(ctx context.Context, tenantID common.TenantID) (*DiscordWebhook, error)
+
+	// FindByEventID finds the webhook override of an event
+	FindByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) (*DiscordWebhook, error)
+
+	// Delete deletes a webhook setting (physical delete)
+	Delete(ctx context.Context, tenantID common.TenantID, webhookID DiscordWebhookID) error
+}
@@ -0,0 +1,98 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+func createTestDiscordWebhook(t *testing.T, eventID *common.EventID, url string, enabled bool) *DiscordWebhook {
+	t.Helper()
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	webhook, err := NewDiscordWebhook(now, common.NewTenantID(), eventID, url, enabled)
+	if err != nil {
+		t.Fatalf("NewDiscordWebhook() failed: %v", err)
+	}
+	return webhook
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{"discord", "https://discord.com/api/webhooks/123/abc", false},
+		{"discordapp", "https://discordapp.com/api/webhooks/123/abc", false},
+		{"canary", "https://canary.discord.com/api/webhooks/123/abc", false},
+		{"empty", "", true},
+		{"relative", "/api/webhooks/123/abc", true},
+		{"http", "http://discord.com/api/webhooks/123/abc", true},
+		{"unsupported scheme", "ftp://discord.com/api/webhooks/123/abc", true},
+		{"local server", "https://127.0.0.1:8081/api/webhooks/123/abc", true},
+		{"internal host", "https://metadata.google.internal/api/webhooks/123/abc", true},
+		{"lookalike host", "https://discord.com.example.com/api/webhooks/123/abc", true},
+		{"explicit port", "https://discord.com:8443/api/webhooks/123/abc", true},
+		{"userinfo", "https://discord.com@127.0.0.1/api/webhooks/123/abc", true},
+		{"other api path", "https://discord.com/api/users/@me", true},
+		{"webhook prefix only", "https://discord.com/api/webhooks/", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateWebhookURL(tt.url)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateWebhookURL(%q) error = %v, wantErr %v", tt.url, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDiscordWebhook_MaskedWebhookURL(t *testing.T) {
+	webhook := createTestDiscordWebhook(t, nil, "https://discord.com/api/webhooks/123/secret-token?wait=true", true)
+
+	if got := webhook.MaskedWebhookURL(); got != "https://discord.com/api/webhooks/123/****" {
+		t.Errorf("MaskedWebhookURL() = %q, token should be masked", got)
+	}
+}
+
+func TestDiscordWebhook_Update_KeepsStateOnError(t *testing.T) {
+	webhook := createTestDiscordWebhook(t, nil, "https://discord.com/api/webhooks/1/a", true)
+
+	if err := webhook.Update(time.Now(), "not a url", false); err == nil {
+		t.Fatal("Update() should fail for an invalid URL")
+	}
+	if webhook.WebhookURL() != "https://discord.com/api/webhooks/1/a" || !webhook.IsEnabled() {
+		t.Errorf("webhook should be unchanged after a failed update: url=%s enabled=%v", webhook.WebhookURL(), webhook.IsEnabled())
+	}
+}
+
+func TestResolveDiscordWebhook(t *testing.T) {
+	eventID := common.NewEventID()
+	tenantDefault := createTestDiscordWebhook(t, nil, "https://discord.com/api/webhooks/1/tenant", true)
+	disabledDefault := createTestDiscordWebhook(t, nil, "https://discord.com/api/webhooks/1/tenant", false)
+	eventWebhook := createTestDiscordWebhook(t, &eventID, "https://discord.com/api/webhooks/2/event", true)
+	disabledEvent := createTestDiscordWebhook(t, &eventID, "https://discord.com/api/webhooks/2/event", false)
+
+	tests := []struct {
+		name          string
+		tenantDefault *DiscordWebhook
+		eventWebhook  *DiscordWebhook
+		want          *DiscordWebhook
+	}{
+		{"event override wins", tenantDefault, eventWebhook, eventWebhook},
+		{"disabled event override mutes the event", tenantDefault, disabledEvent, nil},
+		{"falls back to tenant default", tenantDefault, nil, tenantDefault},
+		{"disabled tenant default", disabledDefault, nil, nil},
+		{"not configured", nil, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveDiscordWebhook(tt.tenantDefault, tt.eventWebhook); got != tt.want {
+				t.Errorf("ResolveDiscordWebhook() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package notification
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrUndeliverable indicates that the message can never be delivered (配信先が未設定など)
+// Dispatcher がこのエラーを返した場合、ワーカーはリトライせずに配信を断念する
+var ErrUndeliverable = errors.New("notification is undeliverable")
+
+// Dispatcher delivers a notification through a single channel (Discord / Email / WebPush)
+// 配信先の解決（Webhook URL・メールアドレスなど）は実装側の責務とし、ワーカーはチャネルごとに振り分けるだけとする
+type Dispatcher interface {
+	// Channel returns the channel this dispatcher delivers to
+	Channel() Channel
+
+	// Dispatch delivers the message; a non-nil error is recorded as a failed delivery and retried later
+	// (ErrUndeliverable を wrap したエラーの場合はリトライしない)
+	Dispatch(ctx context.Context, msg Message) error
+}
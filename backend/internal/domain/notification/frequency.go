@@ -0,0 +1,32 @@
+package notification
+
+import "time"
+
+// DefaultFrequencyLimit is the frequency limit applied to each recipient
+var DefaultFrequencyLimit = FrequencyLimit{MaxCount: 5, Window: time.Hour}
+
+// FrequencyLimit limits how many notifications a recipient receives within a window
+// 直近 Window の送信成功件数が MaxCount に達している場合、送信を見送って後で再試行する
+type FrequencyLimit struct {
+	MaxCount int
+	Window   time.Duration
+}
+
+// Since returns the start of the window ending at now
+func (l FrequencyLimit) Since(now time.Time) time.Time {
+	return now.Add(-l.Window)
+}
+
+// Allows reports whether another notification can be sent given the count sent within the window
+// MaxCount が 0 以下の場合は制限しない
+func (l FrequencyLimit) Allows(sentCount int) bool {
+	return l.MaxCount <= 0 || sentCount < l.MaxCount
+}
+
+// DeferUntil returns when a deferred notification should be retried (ウィンドウ内の平均送信間隔だけ後ろにずらす)
+func (l FrequencyLimit) DeferUntil(now time.Time) time.Time {
+	if l.MaxCount <= 0 {
+		return now
+	}
+	return now.Add(l.Window / time.Duration(l.MaxCount))
+}
@@ -0,0 +1,45 @@
+package notification
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFrequencyLimit(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	limit := FrequencyLimit{MaxCount: 3, Window: 30 * time.Minute}
+
+	if !limit.Since(now).Equal(now.Add(-30 * time.Minute)) {
+		t.Errorf("Since() = %v", limit.Since(now))
+	}
+	if !limit.Allows(2) || limit.Allows(3) {
+		t.Error("Allows() should permit up to MaxCount-1 previous sends")
+	}
+	if !limit.DeferUntil(now).Equal(now.Add(10 * time.Minute)) {
+		t.Errorf("DeferUntil() = %v, want the average interval", limit.DeferUntil(now))
+	}
+
+	unlimited := FrequencyLimit{}
+	if !unlimited.Allows(100) {
+		t.Error("a zero limit should not restrict sending")
+	}
+}
+
+func TestPreferredChannel(t *testing.T) {
+	tests := []struct {
+		discordUserID string
+		email         string
+		want          Channel
+		ok            bool
+	}{
+		{"123456789", "a@example.com", ChannelDiscord, true},
+		{"", "a@example.com", ChannelEmail, true},
+		{"", "", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := PreferredChannel(tt.discordUserID, tt.email)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("PreferredChannel(%q, %q) = (%q, %v), want (%q, %v)", tt.discordUserID, tt.email, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package notification
+
+import (
+	"fmt"
+)
+
+// NotificationType represents the kind of notification
+// notification_logs.notification_type の CHECK 制約と一致させる
+type NotificationType string
+
+const (
+	NotificationTypeShiftRecruitment   NotificationType = "shift_recruitment"   // シフト募集
+	NotificationTypeDeadlineReminder   NotificationType = "deadline_reminder"   // 締切リマインダー
+	NotificationTypeShiftConfirmed     NotificationType = "shift_confirmed"     // シフト確定通知
+	NotificationTypeAttendanceReminder NotificationType = "attendance_reminder" // 出勤リマインダー
+	NotificationTypeUrgentHelp         NotificationType = "urgent_help"         // 緊急ヘルプ要請
+)
+
+func (t NotificationType) Validate() error {
+	switch t {
+	case NotificationTypeShiftRecruitment, NotificationTypeDeadlineReminder, NotificationTypeShiftConfirmed,
+		NotificationTypeAttendanceReminder, NotificationTypeUrgentHelp:
+		return nil
+	default:
+		return fmt.Errorf("invalid notification type: %s", t)
+	}
+}
+
+// BypassesFrequencyLimit reports whether the notification is delivered regardless of the frequency limit
+// 緊急ヘルプ要請は頻度制御の対象外
+func (t NotificationType) BypassesFrequencyLimit() bool {
+	return t == NotificationTypeUrgentHelp
+}
+
+// Channel represents a delivery channel
+// notification_logs.delivery_channel の CHECK 制約と一致させる
+type Channel string
+
+const (
+	ChannelDiscord Channel = "Discord"
+	ChannelEmail   Channel = "Email"
+	ChannelWebPush Channel = "WebPush"
+)
+
+func (c Channel) Validate() error {
+	switch c {
+	case ChannelDiscord, ChannelEmail, ChannelWebPush:
+		return nil
+	default:
+		return fmt.Errorf("invalid delivery channel: %s", c)
+	}
+}
+
+// PreferredChannel returns the channel used to reach a member
+// Discord の連携がある場合は Discord、なければメールを使う。どちらもない場合は通知できない
+func PreferredChannel(discordUserID, email string) (Channel, bool) {
+	switch {
+	case discordUserID != "":
+		return ChannelDiscord, true
+	case email != "":
+		return ChannelEmail, true
+	default:
+		return "", false
+	}
+}
+
+// DeliveryStatus represents the result of a delivery recorded in notification_logs
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending DeliveryStatus = "pending"
+	DeliveryStatusSuccess DeliveryStatus = "success"
+	DeliveryStatusFailed  DeliveryStatus = "failed"
+)
+
+func (s DeliveryStatus) Validate() error {
+	switch s {
+	case DeliveryStatusPending, DeliveryStatusSuccess, DeliveryStatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("invalid delivery status: %s", s)
+	}
+}
@@ -0,0 +1,189 @@
+package notification
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+)
+
+// LogID represents a notification log identifier
+type LogID string
+
+// NewLogIDWithTime creates a new LogID using the provided time.
+func NewLogIDWithTime(t time.Time) LogID {
+	return LogID(common.NewULIDWithTime(t))
+}
+
+func (id LogID) String() string {
+	return string(id)
+}
+
+func (id LogID) Validate() error {
+	if id == "" {
+		return fmt.Errorf("log_id is required")
+	}
+	return common.ValidateULID(string(id))
+}
+
+// NotificationLog represents a delivery attempt recorded in notification_logs
+// 配信のたびに1件記録し、頻度制御（同一メンバーへの直近の送信件数）の集計にも使う
+type NotificationLog struct {
+	logID            LogID
+	tenantID         common.TenantID
+	businessDayID    *event.BusinessDayID
+	recipientID      common.MemberID
+	notificationType NotificationType
+	content          string
+	channel          Channel
+	status           DeliveryStatus
+	errorMessage     string
+	sentAt           *time.Time
+	createdAt        time.Time
+}
+
+// NewDeliveryLog creates a log of a delivery attempt of the outbox message
+// deliveryErr が nil の場合は success、それ以外は failed として記録する
+func NewDeliveryLog(now time.Time, msg *OutboxMessage, deliveryErr error) (*NotificationLog, error) {
+	status := DeliveryStatusSuccess
+	errorMessage := ""
+	if deliveryErr != nil {
+		status = DeliveryStatusFailed
+		errorMessage = deliveryErr.Error()
+	}
+
+	log := &NotificationLog{
+		logID:            NewLogIDWithTime(now),
+		tenantID:         msg.TenantID(),
+		businessDayID:    msg.BusinessDayID(),
+		recipientID:      msg.RecipientID(),
+		notificationType: msg.NotificationType(),
+		content:          msg.Content(),
+		channel:          msg.Channel(),
+		status:           status,
+		errorMessage:     errorMessage,
+		sentAt:           &now,
+		createdAt:        now,
+	}
+
+	if err := log.validate(); err != nil {
+		return nil, err
+	}
+
+	return log, nil
+}
+
+// ReconstructNotificationLog reconstructs a NotificationLog from persistence
+func ReconstructNotificationLog(
+	logID LogID,
+	tenantID common.TenantID,
+	businessDayID *event.BusinessDayID,
+	recipientID common.MemberID,
+	notificationType NotificationType,
+	content string,
+	channel Channel,
+	status DeliveryStatus,
+	errorMessage string,
+	sentAt *time.Time,
+	createdAt time.Time,
+) (*NotificationLog, error) {
+	log := &NotificationLog{
+		logID:            logID,
+		tenantID:         tenantID,
+		businessDayID:    businessDayID,
+		recipientID:      recipientID,
+		notificationType: notificationType,
+		content:          content,
+		channel:          channel,
+		status:           status,
+		errorMessage:     errorMessage,
+		sentAt:           sentAt,
+		createdAt:        createdAt,
+	}
+
+	if err := log.validate(); err != nil {
+		return nil, err
+	}
+
+	return log, nil
+}
+
+func (l *NotificationLog) validate() error {
+	if err := l.tenantID.Validate(); err != nil {
+		return common.NewValidationError("tenant_id is required", err)
+	}
+
+	if err := l.recipientID.Validate(); err != nil {
+		return common.NewValidationError("recipient_id is required", err)
+	}
+
+	if err := l.notificationType.Validate(); err != nil {
+		return common.NewValidationError("invalid notification_type", err)
+	}
+
+	if err := l.channel.Validate(); err != nil {
+		return common.NewValidationError("invalid delivery_channel", err)
+	}
+
+	if err := l.status.Validate(); err != nil {
+		return common.NewValidationError("invalid delivery_status", err)
+	}
+
+	// 送信成功/失敗の場合は sent_at が必須（notification_logs_sent_consistency と同じ）
+	if (l.status == DeliveryStatusPending) != (l.sentAt == nil) {
+		return common.NewValidationError("sent_at is required once delivered or failed", nil)
+	}
+
+	return nil
+}
+
+// Getters
+
+func (l *NotificationLog) LogID() LogID {
+	return l.logID
+}
+
+func (l *NotificationLog) TenantID() common.TenantID {
+	return l.tenantID
+}
+
+func (l *NotificationLog) BusinessDayID() *event.BusinessDayID {
+	return l.businessDayID
+}
+
+func (l *NotificationLog) RecipientID() common.MemberID {
+	return l.recipientID
+}
+
+func (l *NotificationLog) NotificationType() NotificationType {
+	return l.notificationType
+}
+
+func (l *NotificationLog) Content() string {
+	return l.content
+}
+
+func (l *NotificationLog) Channel() Channel {
+	return l.channel
+}
+
+func (l *NotificationLog) Status() DeliveryStatus {
+	return l.status
+}
+
+func (l *NotificationLog) ErrorMessage() string {
+	return l.errorMessage
+}
+
+func (l *NotificationLog) SentAt() *time.Time {
+	return l.sentAt
+}
+
+func (l *NotificationLog) CreatedAt() time.Time {
+	return l.createdAt
+}
+
+func (l *NotificationLog) IsSuccess() bool {
+	return l.status == DeliveryStatusSuccess
+}
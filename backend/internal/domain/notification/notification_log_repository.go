@@ -0,0 +1,18 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// LogRepository defines the interface for NotificationLog persistence
+type LogRepository interface {
+	// Save inserts a notification log
+	Save(ctx context.Context, log *NotificationLog) error
+
+	// CountSentSince counts the successful deliveries to a recipient since the given time
+	// 頻度制御用（idx_notification_logs_recipient_sent_at を使う）
+	CountSentSince(ctx context.Context, tenantID common.TenantID, recipientID common.MemberID, since time.Time) (int, error)
+}
@@ -0,0 +1,326 @@
+package notification
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+)
+
+// MaxDeliveryAttempts is the number of delivery attempts before a message is given up
+const MaxDeliveryAttempts = 5
+
+// retryBaseDelay is the delay before the first retry (2回目以降は倍々で延ばす)
+const retryBaseDelay = time.Minute
+
+// OutboxStatus represents the status of an outbox message
+type OutboxStatus string
+
+const (
+	OutboxStatusPending   OutboxStatus = "pending"   // 配信待ち（リトライ待ちを含む）
+	OutboxStatusDelivered OutboxStatus = "delivered" // 配信済み
+	OutboxStatusFailed    OutboxStatus = "failed"    // リトライ上限に達して配信を断念
+)
+
+func (s OutboxStatus) Validate() error {
+	switch s {
+	case OutboxStatusPending, OutboxStatusDelivered, OutboxStatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("invalid outbox status: %s", s)
+	}
+}
+
+// OutboxID represents an outbox message identifier
+type OutboxID string
+
+// NewOutboxIDWithTime creates a new OutboxID using the provided time.
+func NewOutboxIDWithTime(t time.Time) OutboxID {
+	return OutboxID(common.NewULIDWithTime(t))
+}
+
+func (id OutboxID) String() string {
+	return string(id)
+}
+
+func (id OutboxID) Validate() error {
+	if id == "" {
+		return fmt.Errorf("outbox_id is required")
+	}
+	return common.ValidateULID(string(id))
+}
+
+// Message is the channel-agnostic content handed to a Dispatcher
+type Message struct {
+	TenantID      common.TenantID
+	BusinessDayID *event.BusinessDayID
+	RecipientID   common.MemberID
+	Type          NotificationType
+	Content       string
+}
+
+// OutboxMessage represents a notification waiting to be delivered
+// 通知のきっかけとなる変更と同じトランザクションで保存し、ワーカーが配信して notification_logs に結果を記録する。
+//
+// 状態遷移:
+//   - pending → delivered（配信成功）
+//   - pending → pending（配信失敗・頻度制御による延期。next_attempt_at を延ばす）
+//   - pending → failed（MaxDeliveryAttempts 回失敗、または配信先がなく配信できない）
+type OutboxMessage struct {
+	outboxID         OutboxID
+	tenantID         common.TenantID
+	businessDayID    *event.BusinessDayID
+	recipientID      common.MemberID
+	notificationType NotificationType
+	channel          Channel
+	content          string
+	status           OutboxStatus
+	attempts         int
+	nextAttemptAt    time.Time
+	lastError        string
+	processedAt      *time.Time
+	createdAt        time.Time
+	updatedAt        time.Time
+}
+
+// NewOutboxMessage creates a new pending OutboxMessage that is due immediately
+func NewOutboxMessage(
+	now time.Time,
+	tenantID common.TenantID,
+	businessDayID *event.BusinessDayID,
+	recipientID common.MemberID,
+	notificationType NotificationType,
+	channel Channel,
+	content string,
+) (*OutboxMessage, error) {
+	msg := &OutboxMessage{
+		outboxID:         NewOutboxIDWithTime(now),
+		tenantID:         tenantID,
+		businessDayID:    businessDayID,
+		recipientID:      recipientID,
+		notificationType: notificationType,
+		channel:          channel,
+		content:          content,
+		status:           OutboxStatusPending,
+		nextAttemptAt:    now,
+		createdAt:        now,
+		updatedAt:        now,
+	}
+
+	if err := msg.validate(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// ReconstructOutboxMessage reconstructs an OutboxMessage from persistence
+func ReconstructOutboxMessage(
+	outboxID OutboxID,
+	tenantID common.TenantID,
+	businessDayID *event.BusinessDayID,
+	recipientID common.MemberID,
+	notificationType NotificationType,
+	channel Channel,
+	content string,
+	status OutboxStatus,
+	attempts int,
+	nextAttemptAt time.Time,
+	lastError string,
+	processedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*OutboxMessage, error) {
+	msg := &OutboxMessage{
+		outboxID:         outboxID,
+		tenantID:         tenantID,
+		businessDayID:    businessDayID,
+		recipientID:      recipientID,
+		notificationType: notificationType,
+		channel:          channel,
+		content:          content,
+		status:           status,
+		attempts:         attempts,
+		nextAttemptAt:    nextAttemptAt,
+		lastError:        lastError,
+		processedAt:      processedAt,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+	}
+
+	if err := msg.validate(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+func (m *OutboxMessage) validate() error {
+	if err := m.tenantID.Validate(); err != nil {
+		return common.NewValidationError("tenant_id is required", err)
+	}
+
+	if err := m.recipientID.Validate(); err != nil {
+		return common.NewValidationError("recipient_id is required", err)
+	}
+
+	if err := m.notificationType.Validate(); err != nil {
+		return common.NewValidationError("invalid notification_type", err)
+	}
+
+	if err := m.channel.Validate(); err != nil {
+		return common.NewValidationError("invalid delivery_channel", err)
+	}
+
+	if m.content == "" {
+		return common.NewValidationError("message_content is required", nil)
+	}
+
+	if err := m.status.Validate(); err != nil {
+		return common.NewValidationError("invalid status", err)
+	}
+
+	if m.attempts < 0 {
+		return common.NewValidationError("attempts must not be negative", nil)
+	}
+
+	// 配信済み・断念したメッセージは処理日時が必須
+	if m.status != OutboxStatusPending && m.processedAt == nil {
+		return common.NewValidationError("processed_at is required once processed", nil)
+	}
+
+	return nil
+}
+
+// Getters
+
+func (m *OutboxMessage) OutboxID() OutboxID {
+	return m.outboxID
+}
+
+func (m *OutboxMessage) TenantID() common.TenantID {
+	return m.tenantID
+}
+
+func (m *OutboxMessage) BusinessDayID() *event.BusinessDayID {
+	return m.businessDayID
+}
+
+func (m *OutboxMessage) RecipientID() common.MemberID {
+	return m.recipientID
+}
+
+func (m *OutboxMessage) NotificationType() NotificationType {
+	return m.notificationType
+}
+
+func (m *OutboxMessage) Channel() Channel {
+	return m.channel
+}
+
+func (m *OutboxMessage) Content() string {
+	return m.content
+}
+
+func (m *OutboxMessage) Status() OutboxStatus {
+	return m.status
+}
+
+func (m *OutboxMessage) Attempts() int {
+	return m.attempts
+}
+
+func (m *OutboxMessage) NextAttemptAt() time.Time {
+	return m.nextAttemptAt
+}
+
+func (m *OutboxMessage) LastError() string {
+	return m.lastError
+}
+
+func (m *OutboxMessage) ProcessedAt() *time.Time {
+	return m.processedAt
+}
+
+func (m *OutboxMessage) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+func (m *OutboxMessage) UpdatedAt() time.Time {
+	return m.updatedAt
+}
+
+func (m *OutboxMessage) IsPending() bool {
+	return m.status == OutboxStatusPending
+}
+
+// Message returns the content handed to the dispatcher
+func (m *OutboxMessage) Message() Message {
+	return Message{
+		TenantID:      m.tenantID,
+		BusinessDayID: m.businessDayID,
+		RecipientID:   m.recipientID,
+		Type:          m.notificationType,
+		Content:       m.content,
+	}
+}
+
+// MarkDelivered records a successful delivery
+func (m *OutboxMessage) MarkDelivered(now time.Time) error {
+	if !m.IsPending() {
+		return common.NewConflictError("outbox message is not pending")
+	}
+
+	m.attempts++
+	m.status = OutboxStatusDelivered
+	m.lastError = ""
+	m.processedAt = &now
+	m.updatedAt = now
+	return nil
+}
+
+// MarkFailed records a failed delivery
+// MaxDeliveryAttempts 回に達するまでは指数バックオフで再配信を予約する
+func (m *OutboxMessage) MarkFailed(now time.Time, cause string) error {
+	if !m.IsPending() {
+		return common.NewConflictError("outbox message is not pending")
+	}
+
+	m.attempts++
+	m.lastError = cause
+	m.updatedAt = now
+	if m.attempts >= MaxDeliveryAttempts {
+		m.status = OutboxStatusFailed
+		m.processedAt = &now
+		return nil
+	}
+
+	m.nextAttemptAt = now.Add(retryBaseDelay << (m.attempts - 1))
+	return nil
+}
+
+// GiveUp records a failed delivery that will not be retried (配信先がなく配信できない場合)
+func (m *OutboxMessage) GiveUp(now time.Time, cause string) error {
+	if !m.IsPending() {
+		return common.NewConflictError("outbox message is not pending")
+	}
+
+	m.attempts++
+	m.status = OutboxStatusFailed
+	m.lastError = cause
+	m.processedAt = &now
+	m.updatedAt = now
+	return nil
+}
+
+// Defer postpones the delivery without counting an attempt (頻度制御で送信を見送る場合)
+func (m *OutboxMessage) Defer(now time.Time, until time.Time) error {
+	if !m.IsPending() {
+		return common.NewConflictError("outbox message is not pending")
+	}
+
+	m.nextAttemptAt = until
+	m.updatedAt = now
+	return nil
+}
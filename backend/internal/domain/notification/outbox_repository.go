@@ -0,0 +1,17 @@
+package notification
+
+import (
+	"context"
+	"time"
+)
+
+// OutboxRepository defines the interface for OutboxMessage persistence
+type OutboxRepository interface {
+	// Save saves an outbox message (insert or update)
+	// 通知のきっかけとなる変更と同じトランザクション（context）で呼び出す
+	Save(ctx context.Context, msg *OutboxMessage) error
+
+	// FindDue finds pending messages whose next attempt is due, oldest first
+	// ワーカー用のためテナントを横断する。トランザクション内では行ロックを取得し、他のワーカーが処理中の行はスキップする
+	FindDue(ctx context.Context, now time.Time, channels []Channel, limit int) ([]*OutboxMessage, error)
+}
@@ -0,0 +1,105 @@
+package notification
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+func createTestOutboxMessage(t *testing.T, now time.Time) *OutboxMessage {
+	t.Helper()
+	msg, err := NewOutboxMessage(now, common.NewTenantID(), nil, common.NewMemberID(), NotificationTypeShiftConfirmed, ChannelDiscord, "シフトが確定しました")
+	if err != nil {
+		t.Fatalf("NewOutboxMessage() failed: %v", err)
+	}
+	return msg
+}
+
+func TestNewOutboxMessage(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	msg := createTestOutboxMessage(t, now)
+
+	if !msg.IsPending() || msg.Attempts() != 0 || !msg.NextAttemptAt().Equal(now) {
+		t.Errorf("new message should be pending and due now: status=%s attempts=%d next=%v", msg.Status(), msg.Attempts(), msg.NextAttemptAt())
+	}
+
+	if _, err := NewOutboxMessage(now, common.NewTenantID(), nil, common.NewMemberID(), NotificationTypeShiftConfirmed, ChannelDiscord, ""); err == nil {
+		t.Error("NewOutboxMessage() should fail without content")
+	}
+	if _, err := NewOutboxMessage(now, common.NewTenantID(), nil, common.NewMemberID(), NotificationType("unknown"), ChannelDiscord, "本文"); err == nil {
+		t.Error("NewOutboxMessage() should fail for an unknown notification type")
+	}
+	if _, err := NewOutboxMessage(now, common.NewTenantID(), nil, common.NewMemberID(), NotificationTypeShiftConfirmed, Channel("LINE"), "本文"); err == nil {
+		t.Error("NewOutboxMessage() should fail for an unknown channel")
+	}
+}
+
+func TestOutboxMessage_MarkFailed_RetriesWithBackoffThenGivesUp(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	msg := createTestOutboxMessage(t, now)
+
+	wantDelays := []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute}
+	for i, delay := range wantDelays {
+		if err := msg.MarkFailed(now, "timeout"); err != nil {
+			t.Fatalf("MarkFailed() failed: %v", err)
+		}
+		if !msg.IsPending() || msg.Attempts() != i+1 || !msg.NextAttemptAt().Equal(now.Add(delay)) {
+			t.Fatalf("attempt %d: status=%s attempts=%d next=%v, want retry after %v", i+1, msg.Status(), msg.Attempts(), msg.NextAttemptAt(), delay)
+		}
+	}
+
+	if err := msg.MarkFailed(now, "timeout"); err != nil {
+		t.Fatalf("MarkFailed() failed: %v", err)
+	}
+	if msg.Status() != OutboxStatusFailed || msg.ProcessedAt() == nil || msg.LastError() != "timeout" {
+		t.Errorf("message should be given up after %d attempts: status=%s", MaxDeliveryAttempts, msg.Status())
+	}
+
+	var domainErr *common.DomainError
+	if err := msg.MarkDelivered(now); !errors.As(err, &domainErr) || domainErr.Code() != common.ErrConflict {
+		t.Errorf("MarkDelivered() on a failed message should return Conflict, got %v", err)
+	}
+}
+
+func TestOutboxMessage_MarkDeliveredAndDefer(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	msg := createTestOutboxMessage(t, now)
+
+	later := now.Add(10 * time.Minute)
+	if err := msg.Defer(now, later); err != nil {
+		t.Fatalf("Defer() failed: %v", err)
+	}
+	if msg.Attempts() != 0 || !msg.NextAttemptAt().Equal(later) {
+		t.Errorf("Defer() should not count an attempt: attempts=%d next=%v", msg.Attempts(), msg.NextAttemptAt())
+	}
+
+	if err := msg.MarkDelivered(later); err != nil {
+		t.Fatalf("MarkDelivered() failed: %v", err)
+	}
+	if msg.Status() != OutboxStatusDelivered || msg.Attempts() != 1 || msg.ProcessedAt() == nil {
+		t.Errorf("message should be delivered: status=%s attempts=%d", msg.Status(), msg.Attempts())
+	}
+}
+
+func TestNewDeliveryLog(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	msg := createTestOutboxMessage(t, now)
+
+	success, err := NewDeliveryLog(now, msg, nil)
+	if err != nil {
+		t.Fatalf("NewDeliveryLog() failed: %v", err)
+	}
+	if !success.IsSuccess() || success.SentAt() == nil || success.RecipientID() != msg.RecipientID() {
+		t.Errorf("success log mismatch: %+v", success)
+	}
+
+	failed, err := NewDeliveryLog(now, msg, errors.New("webhook returned 500"))
+	if err != nil {
+		t.Fatalf("NewDeliveryLog() failed: %v", err)
+	}
+	if failed.Status() != DeliveryStatusFailed || failed.ErrorMessage() != "webhook returned 500" || failed.SentAt() == nil {
+		t.Errorf("failed log mismatch: %+v", failed)
+	}
+}
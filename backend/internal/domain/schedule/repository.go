@@ -2,6 +2,7 @@ package schedule
 
 import (
 	"context"
+	"time"
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 )
@@ -11,6 +12,10 @@ type DateScheduleRepository interface {
 	// Save saves a schedule (insert or update)
 	Save(ctx context.Context, schedule *DateSchedule) error
 
+	// CloseIfExpired は締切を過ぎた受付中のスケジュールを条件付き UPDATE で締め切る
+	// 閲覧時の自動締切が同時に行われた管理者の編集を上書きしないよう、全列の Save を使わない。締め切った場合は true を返す
+	CloseIfExpired(ctx context.Context, tenantID common.TenantID, id common.ScheduleID, now time.Time) (bool, error)
+
 	// FindByID finds a schedule by ID within a tenant
 	FindByID(ctx context.Context, tenantID common.TenantID, id common.ScheduleID) (*DateSchedule, error)
 
@@ -20,6 +20,7 @@ type DateSchedule struct {
 	deadline           *time.Time
 	decidedCandidateID *common.CandidateID
 	candidates         []*CandidateDate // 候補日は集約内で保持
+	autoClosedAt       *time.Time       // 締切を過ぎてシステムが締め切った日時（管理者が締め切った場合は nil）
 	createdAt          time.Time
 	updatedAt          time.Time
 	deletedAt          *time.Time
@@ -70,6 +71,7 @@ func ReconstructDateSchedule(
 	deadline *time.Time,
 	decidedCandidateID *common.CandidateID,
 	candidates []*CandidateDate,
+	autoClosedAt *time.Time,
 	createdAt time.Time,
 	updatedAt time.Time,
 	deletedAt *time.Time,
@@ -85,6 +87,7 @@ func ReconstructDateSchedule(
 		deadline:           deadline,
 		decidedCandidateID: decidedCandidateID,
 		candidates:         candidates,
+		autoClosedAt:       autoClosedAt,
 		createdAt:          createdAt,
 		updatedAt:          updatedAt,
 		deletedAt:          deletedAt,
@@ -177,6 +180,12 @@ func (s *DateSchedule) Close(now time.Time) error {
 	return nil
 }
 
+// IsExpired は受付中のまま締切を過ぎているか（システムが締め切る対象か）を返す（ドメインルール）
+// 締め切る処理は Repository の CloseIfExpired が条件付き UPDATE で行い、autoClosedAt を記録する
+func (s *DateSchedule) IsExpired(now time.Time) bool {
+	return s.status == StatusOpen && s.deletedAt == nil && s.deadline != nil && now.After(*s.deadline)
+}
+
 // Delete はスケジュールを削除済みにする（ソフトデリート）
 // now は App層から Clock 経由で渡される（Domain層で time.Now() を呼ばない）
 func (s *DateSchedule) Delete(now time.Time) error {
@@ -268,6 +277,15 @@ func (s *DateSchedule) Candidates() []*CandidateDate {
 	return s.candidates
 }
 
+func (s *DateSchedule) AutoClosedAt() *time.Time {
+	return s.autoClosedAt
+}
+
+// IsAutoClosed reports whether the schedule was closed by the system after its deadline
+func (s *DateSchedule) IsAutoClosed() bool {
+	return s.autoClosedAt != nil
+}
+
 func (s *DateSchedule) CreatedAt() time.Time {
 	return s.createdAt
 }
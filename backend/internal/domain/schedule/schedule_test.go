@@ -292,6 +292,39 @@ func TestDateSchedule_Close_ErrorWhenDecided(t *testing.T) {
 	}
 }
 
+func TestDateSchedule_IsExpired(t *testing.T) {
+	now := time.Now()
+	tenantID := common.NewTenantID()
+	scheduleID := common.NewScheduleID()
+	candidates := createTestCandidates(t, scheduleID, now)
+	deadline := now.Add(1 * time.Hour)
+
+	ds, _ := schedule.NewDateSchedule(now, scheduleID, tenantID, "Test", "Desc", nil, candidates, &deadline)
+
+	// 締切前は対象外
+	if ds.IsExpired(deadline) {
+		t.Fatal("IsExpired() should be false before the deadline")
+	}
+	if !ds.IsExpired(deadline.Add(1 * time.Minute)) {
+		t.Fatal("IsExpired() should be true after the deadline")
+	}
+}
+
+func TestDateSchedule_IsExpired_IgnoresDecided(t *testing.T) {
+	now := time.Now()
+	tenantID := common.NewTenantID()
+	scheduleID := common.NewScheduleID()
+	candidates := createTestCandidates(t, scheduleID, now)
+	deadline := now.Add(1 * time.Hour)
+
+	ds, _ := schedule.NewDateSchedule(now, scheduleID, tenantID, "Test", "Desc", nil, candidates, &deadline)
+	_ = ds.Decide(candidates[0].CandidateID(), now)
+
+	if ds.IsExpired(deadline.Add(time.Hour)) {
+		t.Error("IsExpired() should be false for a decided schedule")
+	}
+}
+
 // =====================================================
 // Status Tests
 // =====================================================
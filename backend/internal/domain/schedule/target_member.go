@@ -0,0 +1,22 @@
+package schedule
+
+import "github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+
+// IsTargetMember reports whether a member is asked to respond to the schedule
+// グループの割り当てがない場合は全メンバーが対象、ある場合はいずれかのグループに所属するメンバー
+func IsTargetMember(groupAssignments []*ScheduleGroupAssignment, memberGroupIDs []common.MemberGroupID) bool {
+	if len(groupAssignments) == 0 {
+		return true
+	}
+
+	groups := make(map[common.MemberGroupID]bool, len(memberGroupIDs))
+	for _, id := range memberGroupIDs {
+		groups[id] = true
+	}
+	for _, a := range groupAssignments {
+		if groups[a.GroupID()] {
+			return true
+		}
+	}
+	return false
+}
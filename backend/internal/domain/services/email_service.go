@@ -22,6 +22,13 @@ type SendPasswordResetEmailInput struct {
 	ExpiresAt time.Time // 有効期限
 }
 
+// SendNotificationEmailInput represents the input for sending a notification email to a member
+type SendNotificationEmailInput struct {
+	To      string // 送信先メールアドレス
+	Subject string // 件名（サービス名の接頭辞は実装側で付与する）
+	Body    string // 本文（プレーンテキスト）
+}
+
 // EmailService defines the interface for sending emails
 type EmailService interface {
 	// SendInvitationEmail sends an invitation email to a new admin
@@ -29,4 +36,7 @@ type EmailService interface {
 
 	// SendPasswordResetEmail sends a password reset email
 	SendPasswordResetEmail(ctx context.Context, input SendPasswordResetEmailInput) error
+
+	// SendNotificationEmail sends a notification (shift confirmed, reminders, etc.) to a member
+	SendNotificationEmail(ctx context.Context, input SendNotificationEmailInput) error
 }
@@ -0,0 +1,88 @@
+package shift
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+)
+
+var (
+	// ErrPlanNotDraft is returned when trying to modify a plan that is not a draft
+	ErrPlanNotDraft = common.NewInvariantViolationError("shift plan is not a draft")
+
+	// ErrPlanNotPublished is returned when trying to archive a plan that is not published
+	ErrPlanNotPublished = common.NewInvariantViolationError("shift plan is not published")
+
+	// ErrPlanScopeOverlaps is returned when publishing a plan whose scope partly overlaps another published plan
+	// 同じ範囲の公開中プランは置き換える（アーカイブする）が、範囲が一部だけ重なるプランは置き換えられない
+	ErrPlanScopeOverlaps = common.NewConflictError("shift plan scope overlaps another published plan")
+
+	// ErrSlotOutOfPlanScope is returned when a slot does not belong to the plan's business day or period
+	ErrSlotOutOfPlanScope = common.NewInvariantViolationError("shift slot is out of plan scope")
+
+	// ErrSlotFull is returned when the slot already has required_count confirmed assignments
+	ErrSlotFull = common.NewConflictError("shift slot is full")
+
+	// ErrAssignmentNotSwappable is returned when offering an assignment that is not confirmed
+	ErrAssignmentNotSwappable = common.NewInvariantViolationError("only confirmed assignments can be offered for swap")
+
+	// ErrSwapRequestNotOpen is returned when claiming or cancelling a swap request that is no longer open
+	ErrSwapRequestNotOpen = common.NewConflictError("swap request is no longer open")
+
+	// ErrSwapRequestNotPending is returned when approving or rejecting a swap request that is not awaiting approval
+	ErrSwapRequestNotPending = common.NewConflictError("swap request is not pending approval")
+
+	// ErrSwapNotEligible is returned when the claimant does not share a role or group with the requester
+	ErrSwapNotEligible = common.NewUnauthorizedError("member is not eligible to claim this swap request")
+
+	// ErrAlreadyOnStandby is returned when the member is already assigned to or waiting for the slot
+	ErrAlreadyOnStandby = common.NewConflictError("member is already assigned to or on standby for this slot")
+
+	// ErrStandbyNotWaiting is returned when the standby entry is no longer active
+	ErrStandbyNotWaiting = common.NewConflictError("standby entry is no longer active")
+
+	// ErrStandbyNotOffered is returned when responding to a standby entry that has no open offer
+	ErrStandbyNotOffered = common.NewConflictError("standby entry has no open offer")
+
+	// ErrStandbyOfferExpired is returned when accepting an offer after its deadline
+	ErrStandbyOfferExpired = common.NewConflictError("standby offer has expired")
+
+	// ErrAlreadyAssigned is returned when the member already has a confirmed assignment to the slot
+	ErrAlreadyAssigned = common.NewConflictError("member is already assigned to this slot")
+
+	// ErrSlotNotClaimable is returned when claiming a slot whose business day has started or been cancelled
+	ErrSlotNotClaimable = common.NewConflictError("shift slot can no longer be claimed")
+)
+
+// AssignmentConflict represents an existing assignment of the member that overlaps in time
+type AssignmentConflict struct {
+	AssignmentID  AssignmentID
+	SlotID        SlotID
+	SlotName      string
+	BusinessDayID event.BusinessDayID
+	StartAt       time.Time
+	EndAt         time.Time
+}
+
+// AssignmentConflictError is returned when the member already has overlapping assignments
+// force 指定で確定する場合は割り当てに強制確定の記録が残る
+type AssignmentConflictError struct {
+	MemberID  common.MemberID
+	Conflicts []AssignmentConflict
+}
+
+func (e *AssignmentConflictError) Error() string {
+	return fmt.Sprintf("member %s has %d overlapping assignment(s)", e.MemberID, len(e.Conflicts))
+}
+
+// RoleRequirementError is returned when assigning the member would leave required roles of the slot unfillable
+type RoleRequirementError struct {
+	MemberID   common.MemberID
+	Shortfalls []RoleShortfall
+}
+
+func (e *RoleRequirementError) Error() string {
+	return fmt.Sprintf("member %s cannot be assigned: %d required role(s) of the slot would remain unfilled", e.MemberID, len(e.Shortfalls))
+}
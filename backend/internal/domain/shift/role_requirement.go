@@ -0,0 +1,211 @@
+package shift
+
+import (
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// RoleRequirementKind represents whether a role is mandatory or merely preferred for a slot
+type RoleRequirementKind string
+
+const (
+	// RoleRequirementRequired は必須ロール。満たせない割り当ては拒否される
+	RoleRequirementRequired RoleRequirementKind = "required"
+	// RoleRequirementPreferred は推奨ロール。満たせない場合は警告のみ
+	RoleRequirementPreferred RoleRequirementKind = "preferred"
+)
+
+func (k RoleRequirementKind) Validate() error {
+	switch k {
+	case RoleRequirementRequired, RoleRequirementPreferred:
+		return nil
+	default:
+		return common.NewValidationError("invalid role requirement kind", nil)
+	}
+}
+
+// RoleRequirement represents a role that members assigned to a slot should have (値オブジェクト)
+// count はそのロールを持つメンバーが何人必要か（1以上、required_count 以下）
+type RoleRequirement struct {
+	roleID common.RoleID
+	kind   RoleRequirementKind
+	count  int
+}
+
+// NewRoleRequirement creates a new RoleRequirement
+func NewRoleRequirement(roleID common.RoleID, kind RoleRequirementKind, count int) (RoleRequirement, error) {
+	if err := roleID.Validate(); err != nil {
+		return RoleRequirement{}, common.NewValidationError("role_id is invalid", err)
+	}
+	if err := kind.Validate(); err != nil {
+		return RoleRequirement{}, err
+	}
+	if count < 1 {
+		return RoleRequirement{}, common.NewValidationError("role requirement count must be at least 1", nil)
+	}
+
+	return RoleRequirement{
+		roleID: roleID,
+		kind:   kind,
+		count:  count,
+	}, nil
+}
+
+func (r RoleRequirement) RoleID() common.RoleID {
+	return r.roleID
+}
+
+func (r RoleRequirement) Kind() RoleRequirementKind {
+	return r.kind
+}
+
+func (r RoleRequirement) Count() int {
+	return r.count
+}
+
+func (r RoleRequirement) IsRequired() bool {
+	return r.kind == RoleRequirementRequired
+}
+
+// validateRoleRequirements checks the requirements against the slot's required_count
+func validateRoleRequirements(requirements []RoleRequirement, requiredCount int) error {
+	seen := make(map[common.RoleID]bool, len(requirements))
+	for _, req := range requirements {
+		if seen[req.roleID] {
+			return common.NewValidationError("role requirement is duplicated: "+req.roleID.String(), nil)
+		}
+		seen[req.roleID] = true
+
+		if req.count > requiredCount {
+			return common.NewValidationError("role requirement count must not exceed required_count", nil)
+		}
+	}
+	return nil
+}
+
+// equalRoleRequirements returns true if both have the same requirements regardless of order
+// ロールの重複は validateRoleRequirements で禁止しているため、ロールごとの比較で十分
+func equalRoleRequirements(a, b []RoleRequirement) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	byRole := make(map[common.RoleID]RoleRequirement, len(a))
+	for _, req := range a {
+		byRole[req.roleID] = req
+	}
+	for _, req := range b {
+		if other, ok := byRole[req.roleID]; !ok || other != req {
+			return false
+		}
+	}
+	return true
+}
+
+// RoleShortfall represents a role requirement that is not (or cannot be) met
+type RoleShortfall struct {
+	RoleID common.RoleID
+	Kind   RoleRequirementKind
+	Count  int // 必要人数
+	Filled int // 候補者を含めた充足人数
+}
+
+// RoleRequirementCheck is the result of evaluating a candidate against the slot's role requirements
+type RoleRequirementCheck struct {
+	// Violations は必須ロールが残りの枠では満たせなくなる場合に設定される
+	Violations []RoleShortfall
+	// Warnings は候補者が未充足の推奨ロールを持っていない場合に設定される
+	Warnings []RoleShortfall
+}
+
+// Satisfiable returns true if the assignment keeps all required roles achievable
+func (c RoleRequirementCheck) Satisfiable() bool {
+	return len(c.Violations) == 0
+}
+
+// EvaluateRoleRequirements evaluates assigning a candidate with candidateRoles to the slot,
+// given the roles of the members already confirmed on it.
+//
+// 必須ロール: 候補者を割り当てた後の残り枠数で、未充足の必須ロールをすべて満たせない場合は違反とする
+// （1人が複数ロールを兼ねる可能性があるため、残り枠 < 不足人数の合計 の場合のみ違反）
+// 既に満たせない状態の枠でも、不足ロールを持つ候補者は充足に近づくため許可する
+// 推奨ロール: 候補者がそのロールを持たず、まだ充足していない場合は警告とする
+func (s *ShiftSlot) EvaluateRoleRequirements(assignedRoles [][]common.RoleID, candidateRoles []common.RoleID) RoleRequirementCheck {
+	var check RoleRequirementCheck
+	if len(s.roleRequirements) == 0 {
+		return check
+	}
+
+	filled := make(map[common.RoleID]int, len(s.roleRequirements))
+	for _, roles := range assignedRoles {
+		for _, roleID := range roles {
+			filled[roleID]++
+		}
+	}
+	candidateHas := make(map[common.RoleID]bool, len(candidateRoles))
+	for _, roleID := range candidateRoles {
+		candidateHas[roleID] = true
+		filled[roleID]++
+	}
+
+	remainingSeats := s.requiredCount - len(assignedRoles) - 1
+	if remainingSeats < 0 {
+		remainingSeats = 0
+	}
+
+	totalShortage := 0
+	contributes := false
+	var unmet []RoleShortfall
+	for _, req := range s.roleRequirements {
+		shortfall := RoleShortfall{
+			RoleID: req.roleID,
+			Kind:   req.kind,
+			Count:  req.count,
+			Filled: filled[req.roleID],
+		}
+		if req.IsRequired() {
+			if candidateHas[req.roleID] && shortfall.Filled <= req.count {
+				contributes = true
+			}
+			if shortfall.Filled < req.count {
+				totalShortage += req.count - shortfall.Filled
+				unmet = append(unmet, shortfall)
+			}
+			continue
+		}
+		if !candidateHas[req.roleID] && shortfall.Filled < req.count {
+			check.Warnings = append(check.Warnings, shortfall)
+		}
+	}
+
+	if totalShortage > remainingSeats && !contributes {
+		check.Violations = unmet
+	}
+
+	return check
+}
+
+// MatchingRoleRequirements returns the unmet role requirements of the slot that the candidate would help fill
+// 候補者の順位付けに使用する（必須・推奨の両方を返す）
+func (s *ShiftSlot) MatchingRoleRequirements(assignedRoles [][]common.RoleID, candidateRoles []common.RoleID) []RoleRequirement {
+	if len(s.roleRequirements) == 0 || len(candidateRoles) == 0 {
+		return nil
+	}
+
+	filled := make(map[common.RoleID]int, len(s.roleRequirements))
+	for _, roles := range assignedRoles {
+		for _, roleID := range roles {
+			filled[roleID]++
+		}
+	}
+	candidateHas := make(map[common.RoleID]bool, len(candidateRoles))
+	for _, roleID := range candidateRoles {
+		candidateHas[roleID] = true
+	}
+
+	var matches []RoleRequirement
+	for _, req := range s.roleRequirements {
+		if candidateHas[req.roleID] && filled[req.roleID] < req.count {
+			matches = append(matches, req)
+		}
+	}
+	return matches
+}
@@ -0,0 +1,216 @@
+package shift_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+func newRoleRequirement(t *testing.T, roleID common.RoleID, kind shift.RoleRequirementKind, count int) shift.RoleRequirement {
+	t.Helper()
+	req, err := shift.NewRoleRequirement(roleID, kind, count)
+	if err != nil {
+		t.Fatalf("NewRoleRequirement() should succeed, got error: %v", err)
+	}
+	return req
+}
+
+func newSlotWithRoleRequirements(t *testing.T, requiredCount int, reqs ...shift.RoleRequirement) *shift.ShiftSlot {
+	t.Helper()
+	now := time.Now()
+	slot, err := shift.NewShiftSlot(
+		now,
+		common.NewTenantID(),
+		event.NewBusinessDayID(),
+		nil,
+		"受付",
+		"",
+		time.Date(2000, 1, 1, 20, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC),
+		requiredCount,
+		1,
+	)
+	if err != nil {
+		t.Fatalf("NewShiftSlot() should succeed, got error: %v", err)
+	}
+	if err := slot.SetRoleRequirements(now, reqs); err != nil {
+		t.Fatalf("SetRoleRequirements() should succeed, got error: %v", err)
+	}
+	return slot
+}
+
+// =====================================================
+// NewRoleRequirement / SetRoleRequirements Tests
+// =====================================================
+
+func TestNewRoleRequirement_ErrorWhenInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		roleID common.RoleID
+		kind   shift.RoleRequirementKind
+		count  int
+	}{
+		{"empty role", "", shift.RoleRequirementRequired, 1},
+		{"unknown kind", common.NewRoleID(), "optional", 1},
+		{"zero count", common.NewRoleID(), shift.RoleRequirementPreferred, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := shift.NewRoleRequirement(tt.roleID, tt.kind, tt.count); err == nil {
+				t.Errorf("expected validation error")
+			}
+		})
+	}
+}
+
+func TestShiftSlot_SetRoleRequirements_ErrorWhenDuplicated(t *testing.T) {
+	slot := newSlotWithRoleRequirements(t, 3)
+	roleID := common.NewRoleID()
+
+	err := slot.SetRoleRequirements(time.Now(), []shift.RoleRequirement{
+		newRoleRequirement(t, roleID, shift.RoleRequirementRequired, 1),
+		newRoleRequirement(t, roleID, shift.RoleRequirementPreferred, 1),
+	})
+	if err == nil {
+		t.Errorf("expected validation error for duplicated role")
+	}
+}
+
+func TestShiftSlot_SetRoleRequirements_ErrorWhenCountExceedsRequiredCount(t *testing.T) {
+	slot := newSlotWithRoleRequirements(t, 2)
+
+	err := slot.SetRoleRequirements(time.Now(), []shift.RoleRequirement{
+		newRoleRequirement(t, common.NewRoleID(), shift.RoleRequirementRequired, 3),
+	})
+	if err == nil {
+		t.Errorf("expected validation error for count exceeding required_count")
+	}
+}
+
+func TestShiftSlot_UpdateRequiredCount_ErrorWhenBelowRoleRequirement(t *testing.T) {
+	slot := newSlotWithRoleRequirements(t, 3,
+		newRoleRequirement(t, common.NewRoleID(), shift.RoleRequirementRequired, 2),
+	)
+
+	if err := slot.UpdateRequiredCount(time.Now(), 1); err == nil {
+		t.Errorf("expected validation error when required_count drops below a role requirement")
+	}
+}
+
+// =====================================================
+// EvaluateRoleRequirements Tests
+// =====================================================
+
+func TestShiftSlot_EvaluateRoleRequirements_NoRequirements(t *testing.T) {
+	slot := newSlotWithRoleRequirements(t, 1)
+
+	check := slot.EvaluateRoleRequirements(nil, nil)
+	if !check.Satisfiable() || len(check.Warnings) != 0 {
+		t.Errorf("slot without requirements should accept anyone, got %+v", check)
+	}
+}
+
+func TestShiftSlot_EvaluateRoleRequirements_Required(t *testing.T) {
+	staff := common.NewRoleID()
+	other := common.NewRoleID()
+
+	tests := []struct {
+		name           string
+		requiredCount  int
+		count          int
+		assignedRoles  [][]common.RoleID
+		candidateRoles []common.RoleID
+		wantOK         bool
+	}{
+		{"seats left for the role", 3, 1, nil, nil, true},
+		{"last seat without the role", 2, 1, [][]common.RoleID{{other}}, nil, false},
+		{"last seat with the role", 2, 1, [][]common.RoleID{{other}}, []common.RoleID{staff}, true},
+		{"requirement already met", 2, 1, [][]common.RoleID{{staff}}, nil, true},
+		{"two needed with one seat after", 3, 2, [][]common.RoleID{{other}}, nil, false},
+		{"unmet slot accepts a contributing candidate", 2, 2, [][]common.RoleID{{other}}, []common.RoleID{staff}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slot := newSlotWithRoleRequirements(t, tt.requiredCount,
+				newRoleRequirement(t, staff, shift.RoleRequirementRequired, tt.count),
+			)
+
+			check := slot.EvaluateRoleRequirements(tt.assignedRoles, tt.candidateRoles)
+			if check.Satisfiable() != tt.wantOK {
+				t.Errorf("Satisfiable() = %v, want %v (violations: %+v)", check.Satisfiable(), tt.wantOK, check.Violations)
+			}
+		})
+	}
+}
+
+func TestShiftSlot_EvaluateRoleRequirements_MultipleRequiredRolesShareSeats(t *testing.T) {
+	dj := common.NewRoleID()
+	vj := common.NewRoleID()
+	slot := newSlotWithRoleRequirements(t, 2,
+		newRoleRequirement(t, dj, shift.RoleRequirementRequired, 1),
+		newRoleRequirement(t, vj, shift.RoleRequirementRequired, 1),
+	)
+
+	// 1人目が役割なしだと残り1枠で DJ と VJ を両方満たせない
+	if check := slot.EvaluateRoleRequirements(nil, nil); check.Satisfiable() {
+		t.Errorf("expected violation when the remaining seats cannot cover both roles")
+	}
+
+	// 両方のロールを兼ねるメンバーなら問題ない
+	if check := slot.EvaluateRoleRequirements(nil, []common.RoleID{dj, vj}); !check.Satisfiable() {
+		t.Errorf("member with both roles should be accepted, got %+v", check.Violations)
+	}
+}
+
+func TestShiftSlot_EvaluateRoleRequirements_PreferredWarns(t *testing.T) {
+	preferred := common.NewRoleID()
+	slot := newSlotWithRoleRequirements(t, 1,
+		newRoleRequirement(t, preferred, shift.RoleRequirementPreferred, 1),
+	)
+
+	check := slot.EvaluateRoleRequirements(nil, nil)
+	if !check.Satisfiable() {
+		t.Errorf("preferred roles should never reject")
+	}
+	if len(check.Warnings) != 1 || check.Warnings[0].RoleID != preferred {
+		t.Errorf("expected a warning for the preferred role, got %+v", check.Warnings)
+	}
+
+	if check := slot.EvaluateRoleRequirements(nil, []common.RoleID{preferred}); len(check.Warnings) != 0 {
+		t.Errorf("no warning expected when the candidate has the role, got %+v", check.Warnings)
+	}
+}
+
+// =====================================================
+// MatchingRoleRequirements Tests
+// =====================================================
+
+func TestShiftSlot_MatchingRoleRequirements(t *testing.T) {
+	dj := common.NewRoleID()
+	vj := common.NewRoleID()
+	staff := common.NewRoleID()
+	slot := newSlotWithRoleRequirements(t, 3,
+		newRoleRequirement(t, dj, shift.RoleRequirementRequired, 1),
+		newRoleRequirement(t, vj, shift.RoleRequirementPreferred, 1),
+	)
+
+	matches := slot.MatchingRoleRequirements(nil, []common.RoleID{dj, vj, staff})
+	if len(matches) != 2 {
+		t.Fatalf("expected both requirements to match, got %+v", matches)
+	}
+
+	// DJ は既に埋まっているので VJ のみ
+	matches = slot.MatchingRoleRequirements([][]common.RoleID{{dj}}, []common.RoleID{dj, vj})
+	if len(matches) != 1 || matches[0].RoleID() != vj {
+		t.Errorf("expected only the unfilled VJ requirement, got %+v", matches)
+	}
+
+	if matches := slot.MatchingRoleRequirements(nil, []common.RoleID{staff}); len(matches) != 0 {
+		t.Errorf("unrelated roles should not match, got %+v", matches)
+	}
+}
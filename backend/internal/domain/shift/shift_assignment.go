@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
 )
 
 // AssignmentStatus represents the status of a shift assignment
@@ -98,22 +99,30 @@ func (id PlanID) Validate() error {
 	return common.ValidateULID(string(id))
 }
 
+func ParsePlanID(s string) (PlanID, error) {
+	if err := common.ValidateULID(s); err != nil {
+		return "", err
+	}
+	return PlanID(s), nil
+}
+
 // ShiftAssignment represents a shift assignment entity
 // ShiftPlan 集約内のエンティティ
 type ShiftAssignment struct {
-	assignmentID        AssignmentID
-	tenantID            common.TenantID
-	planID              PlanID
-	slotID              SlotID
-	memberID            common.MemberID
-	assignmentStatus    AssignmentStatus
-	assignmentMethod    AssignmentMethod
-	isOutsidePreference bool
-	assignedAt          time.Time
-	cancelledAt         *time.Time
-	createdAt           time.Time
-	updatedAt           time.Time
-	deletedAt           *time.Time
+	assignmentID         AssignmentID
+	tenantID             common.TenantID
+	planID               PlanID
+	slotID               SlotID
+	memberID             common.MemberID
+	assignmentStatus     AssignmentStatus
+	assignmentMethod     AssignmentMethod
+	isOutsidePreference  bool
+	isConflictOverridden bool // 他シフトとの時間帯重複を承知の上で強制確定したか
+	assignedAt           time.Time
+	cancelledAt          *time.Time
+	createdAt            time.Time
+	updatedAt            time.Time
+	deletedAt            *time.Time
 }
 
 // NewShiftAssignment creates a new ShiftAssignment entity
@@ -157,6 +166,7 @@ func ReconstructShiftAssignment(
 	assignmentStatus AssignmentStatus,
 	assignmentMethod AssignmentMethod,
 	isOutsidePreference bool,
+	isConflictOverridden bool,
 	assignedAt time.Time,
 	cancelledAt *time.Time,
 	createdAt time.Time,
@@ -164,19 +174,20 @@ func ReconstructShiftAssignment(
 	deletedAt *time.Time,
 ) (*ShiftAssignment, error) {
 	assignment := &ShiftAssignment{
-		assignmentID:        assignmentID,
-		tenantID:            tenantID,
-		planID:              planID,
-		slotID:              slotID,
-		memberID:            memberID,
-		assignmentStatus:    assignmentStatus,
-		assignmentMethod:    assignmentMethod,
-		isOutsidePreference: isOutsidePreference,
-		assignedAt:          assignedAt,
-		cancelledAt:         cancelledAt,
-		createdAt:           createdAt,
-		updatedAt:           updatedAt,
-		deletedAt:           deletedAt,
+		assignmentID:         assignmentID,
+		tenantID:             tenantID,
+		planID:               planID,
+		slotID:               slotID,
+		memberID:             memberID,
+		assignmentStatus:     assignmentStatus,
+		assignmentMethod:     assignmentMethod,
+		isOutsidePreference:  isOutsidePreference,
+		isConflictOverridden: isConflictOverridden,
+		assignedAt:           assignedAt,
+		cancelledAt:          cancelledAt,
+		createdAt:            createdAt,
+		updatedAt:            updatedAt,
+		deletedAt:            deletedAt,
 	}
 
 	if err := assignment.validate(); err != nil {
@@ -267,6 +278,10 @@ func (a *ShiftAssignment) IsOutsidePreference() bool {
 	return a.isOutsidePreference
 }
 
+func (a *ShiftAssignment) IsConflictOverridden() bool {
+	return a.isConflictOverridden
+}
+
 func (a *ShiftAssignment) AssignedAt() time.Time {
 	return a.assignedAt
 }
@@ -311,6 +326,12 @@ func (a *ShiftAssignment) Cancel(now time.Time) error {
 	return nil
 }
 
+// OverrideConflict records that the assignment was confirmed despite overlapping shifts
+func (a *ShiftAssignment) OverrideConflict(now time.Time) {
+	a.isConflictOverridden = true
+	a.updatedAt = now
+}
+
 // Delete marks the assignment as deleted (soft delete)
 // cancelledとdeleted_atの違い:
 // - cancelled: メンバーがキャンセルした（履歴として残し、UIにも表示可能）
@@ -319,3 +340,27 @@ func (a *ShiftAssignment) Delete(now time.Time) {
 	a.deletedAt = &now
 	a.updatedAt = now
 }
+
+// AssignedShift is a read model of a confirmed assignment joined with its slot and business day
+// 勤務量の集計など、割り当てを日時で扱う場合に使用する
+type AssignedShift struct {
+	AssignmentID  AssignmentID
+	MemberID      common.MemberID
+	SlotID        SlotID
+	SlotName      string
+	BusinessDayID event.BusinessDayID
+	TargetDate    time.Time
+	StartTime     time.Time // 枠の開始時刻（時刻のみ）
+	EndTime       time.Time // 枠の終了時刻（時刻のみ）
+	DayOffset     int       // 営業日の target_date から枠の開始日までの日数
+}
+
+// Period returns the actual start/end datetime of the shift
+func (s AssignedShift) Period() (time.Time, time.Time) {
+	return periodOn(s.TargetDate.AddDate(0, 0, s.DayOffset), s.StartTime, s.EndTime)
+}
+
+// PeriodIn returns the start/end instants of the shift in loc
+func (s AssignedShift) PeriodIn(loc *time.Location) (time.Time, time.Time) {
+	return periodIn(s.TargetDate.AddDate(0, 0, s.DayOffset), s.StartTime, s.EndTime, loc)
+}
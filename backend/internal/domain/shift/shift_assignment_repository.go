@@ -2,12 +2,17 @@ package shift
 
 import (
 	"context"
+	"time"
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
 )
 
 // ShiftAssignmentRepository defines the interface for ShiftAssignment persistence
+//
+// FindByID / FindByPlanID 以外の検索は公開済みの割り当てのみを返す
+// （plan_id が NULL、または公開中の ShiftPlan に属する割り当て）
+// 下書き・アーカイブ済みプランの割り当てはメンバーから見えない
 type ShiftAssignmentRepository interface {
 	// Save saves a shift assignment (insert or update)
 	Save(ctx context.Context, assignment *ShiftAssignment) error
@@ -15,6 +20,10 @@ type ShiftAssignmentRepository interface {
 	// FindByID finds a shift assignment by ID within a tenant
 	FindByID(ctx context.Context, tenantID common.TenantID, assignmentID AssignmentID) (*ShiftAssignment, error)
 
+	// SetLive switches whether the assignment is published to members
+	// プランの公開・アーカイブ時に使用。公開済みの同じ枠・メンバーの確定割り当てがある場合は ErrAlreadyAssigned
+	SetLive(ctx context.Context, tenantID common.TenantID, assignmentID AssignmentID, live bool) error
+
 	// FindBySlotID finds all shift assignments for a slot
 	FindBySlotID(ctx context.Context, tenantID common.TenantID, slotID SlotID) ([]*ShiftAssignment, error)
 
@@ -50,4 +59,13 @@ type ShiftAssignmentRepository interface {
 	// FindByBusinessDayID finds all shift assignments for a business day
 	// Used for bulk fetching assignments to avoid N+1 problem
 	FindByBusinessDayID(ctx context.Context, tenantID common.TenantID, businessDayID event.BusinessDayID) ([]*ShiftAssignment, error)
+
+	// FindConfirmedShiftsByDateRange finds confirmed assignments whose business day is within [from, to]
+	// memberID が nil の場合はテナント内の全メンバーが対象。勤務量の集計に使用
+	// 中止された営業日の割り当ては含まない
+	FindConfirmedShiftsByDateRange(ctx context.Context, tenantID common.TenantID, memberID *common.MemberID, from, to time.Time) ([]AssignedShift, error)
+
+	// CountConfirmedByMemberIDsAndDateRange counts confirmed assignments per member whose business day is within [from, to]
+	// 自動割り当ての公平性の計算に使用。割り当てのないメンバーはマップに含まれない。中止された営業日の割り当ては含まない
+	CountConfirmedByMemberIDsAndDateRange(ctx context.Context, tenantID common.TenantID, memberIDs []common.MemberID, from, to time.Time) (map[common.MemberID]int, error)
 }
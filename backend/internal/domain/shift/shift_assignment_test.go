@@ -465,6 +465,7 @@ func TestReconstructShiftAssignment_Success(t *testing.T) {
 		shift.AssignmentStatusConfirmed,
 		shift.AssignmentMethodAuto,
 		false,
+		false,
 		now,
 		nil,
 		now,
@@ -499,6 +500,7 @@ func TestReconstructShiftAssignment_Success_Cancelled(t *testing.T) {
 		shift.AssignmentStatusCancelled,
 		shift.AssignmentMethodManual,
 		true,
+		false,
 		now.Add(-time.Hour),
 		&cancelledAt,
 		now.Add(-time.Hour),
@@ -537,6 +539,7 @@ func TestReconstructShiftAssignment_Success_Deleted(t *testing.T) {
 		shift.AssignmentStatusConfirmed,
 		shift.AssignmentMethodAuto,
 		false,
+		false,
 		now,
 		nil,
 		now,
@@ -574,6 +577,7 @@ func TestReconstructShiftAssignment_ErrorWhenCancelledWithoutCancelledAt(t *test
 		shift.AssignmentStatusCancelled, // Cancelled but no cancelledAt
 		shift.AssignmentMethodAuto,
 		false,
+		false,
 		now,
 		nil, // cancelledAt is nil
 		now,
@@ -604,6 +608,7 @@ func TestReconstructShiftAssignment_ErrorWhenConfirmedWithCancelledAt(t *testing
 		shift.AssignmentStatusConfirmed, // Confirmed but with cancelledAt
 		shift.AssignmentMethodAuto,
 		false,
+		false,
 		now,
 		&cancelledAt, // Should be nil for confirmed
 		now,
@@ -0,0 +1,343 @@
+package shift
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+)
+
+// PlanStatus represents the status of a shift plan
+type PlanStatus string
+
+const (
+	PlanStatusDraft     PlanStatus = "draft"     // 下書き（メンバーには非公開）
+	PlanStatusPublished PlanStatus = "published" // 公開中
+	PlanStatusArchived  PlanStatus = "archived"  // アーカイブ（新しいプランに置き換え済み）
+)
+
+func (s PlanStatus) Validate() error {
+	switch s {
+	case PlanStatusDraft, PlanStatusPublished, PlanStatusArchived:
+		return nil
+	default:
+		return fmt.Errorf("invalid plan status: %s", s)
+	}
+}
+
+// ShiftPlan represents a shift plan aggregate root
+// 営業日単位 または イベント期間単位でシフト割り当てをまとめる
+//
+// 状態遷移: draft → published → archived
+//   - draft の割り当てはメンバーに公開されない
+//   - publish 時に同じ範囲の公開中プランは archived になる
+//   - 範囲が一部だけ重なる公開中プランがある場合は publish できない
+type ShiftPlan struct {
+	planID        PlanID
+	tenantID      common.TenantID
+	eventID       common.EventID
+	businessDayID *event.BusinessDayID // 営業日単位のプランの場合
+	periodStart   *time.Time           // イベント期間単位のプランの場合
+	periodEnd     *time.Time           // イベント期間単位のプランの場合
+	planName      string
+	planStatus    PlanStatus
+	publishedAt   *time.Time
+	archivedAt    *time.Time
+	createdAt     time.Time
+	updatedAt     time.Time
+	deletedAt     *time.Time
+}
+
+// NewShiftPlan creates a new draft ShiftPlan
+// businessDayID か periodStart/periodEnd のどちらか一方を指定する
+func NewShiftPlan(
+	now time.Time,
+	tenantID common.TenantID,
+	eventID common.EventID,
+	businessDayID *event.BusinessDayID,
+	periodStart *time.Time,
+	periodEnd *time.Time,
+	planName string,
+) (*ShiftPlan, error) {
+	if periodStart != nil {
+		d := truncateToDate(*periodStart)
+		periodStart = &d
+	}
+	if periodEnd != nil {
+		d := truncateToDate(*periodEnd)
+		periodEnd = &d
+	}
+
+	plan := &ShiftPlan{
+		planID:        NewPlanIDWithTime(now),
+		tenantID:      tenantID,
+		eventID:       eventID,
+		businessDayID: businessDayID,
+		periodStart:   periodStart,
+		periodEnd:     periodEnd,
+		planName:      planName,
+		planStatus:    PlanStatusDraft,
+		createdAt:     now,
+		updatedAt:     now,
+	}
+
+	if err := plan.validate(); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// ReconstructShiftPlan reconstructs a ShiftPlan from persistence
+func ReconstructShiftPlan(
+	planID PlanID,
+	tenantID common.TenantID,
+	eventID common.EventID,
+	businessDayID *event.BusinessDayID,
+	periodStart *time.Time,
+	periodEnd *time.Time,
+	planName string,
+	planStatus PlanStatus,
+	publishedAt *time.Time,
+	archivedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+	deletedAt *time.Time,
+) (*ShiftPlan, error) {
+	plan := &ShiftPlan{
+		planID:        planID,
+		tenantID:      tenantID,
+		eventID:       eventID,
+		businessDayID: businessDayID,
+		periodStart:   periodStart,
+		periodEnd:     periodEnd,
+		planName:      planName,
+		planStatus:    planStatus,
+		publishedAt:   publishedAt,
+		archivedAt:    archivedAt,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+		deletedAt:     deletedAt,
+	}
+
+	if err := plan.validate(); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (p *ShiftPlan) validate() error {
+	// TenantID の必須性チェック
+	if err := p.tenantID.Validate(); err != nil {
+		return common.NewValidationError("tenant_id is required", err)
+	}
+
+	// EventID の必須性チェック
+	if err := p.eventID.Validate(); err != nil {
+		return common.NewValidationError("event_id is required", err)
+	}
+
+	// PlanName の必須性チェック
+	if p.planName == "" {
+		return common.NewValidationError("plan_name is required", nil)
+	}
+	if len(p.planName) > 255 {
+		return common.NewValidationError("plan_name must be less than 255 characters", nil)
+	}
+
+	// PlanStatus のバリデーション
+	if err := p.planStatus.Validate(); err != nil {
+		return common.NewValidationError("invalid plan_status", err)
+	}
+
+	// 対象範囲: 営業日 または 期間 のどちらか一方
+	hasPeriod := p.periodStart != nil || p.periodEnd != nil
+	if p.businessDayID != nil {
+		if hasPeriod {
+			return common.NewValidationError("business_day_id and period cannot be specified together", nil)
+		}
+		if err := p.businessDayID.Validate(); err != nil {
+			return common.NewValidationError("invalid business_day_id", err)
+		}
+	} else {
+		if p.periodStart == nil || p.periodEnd == nil {
+			return common.NewValidationError("either business_day_id or period_start/period_end is required", nil)
+		}
+		if p.periodEnd.Before(*p.periodStart) {
+			return common.NewValidationError("period_end must be on or after period_start", nil)
+		}
+	}
+
+	return nil
+}
+
+// Getters
+
+func (p *ShiftPlan) PlanID() PlanID {
+	return p.planID
+}
+
+func (p *ShiftPlan) TenantID() common.TenantID {
+	return p.tenantID
+}
+
+func (p *ShiftPlan) EventID() common.EventID {
+	return p.eventID
+}
+
+// BusinessDayID returns the target business day (nil for period plans)
+func (p *ShiftPlan) BusinessDayID() *event.BusinessDayID {
+	return p.businessDayID
+}
+
+// PeriodStart returns the first date of the target period (nil for business day plans)
+func (p *ShiftPlan) PeriodStart() *time.Time {
+	return p.periodStart
+}
+
+// PeriodEnd returns the last date of the target period (nil for business day plans)
+func (p *ShiftPlan) PeriodEnd() *time.Time {
+	return p.periodEnd
+}
+
+func (p *ShiftPlan) PlanName() string {
+	return p.planName
+}
+
+func (p *ShiftPlan) PlanStatus() PlanStatus {
+	return p.planStatus
+}
+
+func (p *ShiftPlan) PublishedAt() *time.Time {
+	return p.publishedAt
+}
+
+func (p *ShiftPlan) ArchivedAt() *time.Time {
+	return p.archivedAt
+}
+
+func (p *ShiftPlan) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *ShiftPlan) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+func (p *ShiftPlan) DeletedAt() *time.Time {
+	return p.deletedAt
+}
+
+func (p *ShiftPlan) IsDeleted() bool {
+	return p.deletedAt != nil
+}
+
+func (p *ShiftPlan) IsDraft() bool {
+	return p.planStatus == PlanStatusDraft
+}
+
+func (p *ShiftPlan) IsPublished() bool {
+	return p.planStatus == PlanStatusPublished
+}
+
+func (p *ShiftPlan) IsArchived() bool {
+	return p.planStatus == PlanStatusArchived
+}
+
+// IsBusinessDayPlan returns true if the plan targets a single business day
+func (p *ShiftPlan) IsBusinessDayPlan() bool {
+	return p.businessDayID != nil
+}
+
+// UpdatePlanName updates the plan name (draft only)
+func (p *ShiftPlan) UpdatePlanName(now time.Time, planName string) error {
+	if !p.IsDraft() {
+		return ErrPlanNotDraft
+	}
+	if planName == "" {
+		return common.NewValidationError("plan_name is required", nil)
+	}
+	if len(planName) > 255 {
+		return common.NewValidationError("plan_name must be less than 255 characters", nil)
+	}
+	p.planName = planName
+	p.updatedAt = now
+	return nil
+}
+
+// Publish publishes the draft plan
+func (p *ShiftPlan) Publish(now time.Time) error {
+	if !p.IsDraft() {
+		return ErrPlanNotDraft
+	}
+	p.planStatus = PlanStatusPublished
+	p.publishedAt = &now
+	p.updatedAt = now
+	return nil
+}
+
+// Archive archives the published plan (新しいプランの公開時に呼ばれる)
+func (p *ShiftPlan) Archive(now time.Time) error {
+	if !p.IsPublished() {
+		return ErrPlanNotPublished
+	}
+	p.planStatus = PlanStatusArchived
+	p.archivedAt = &now
+	p.updatedAt = now
+	return nil
+}
+
+// Delete deletes the draft plan (soft delete)
+func (p *ShiftPlan) Delete(now time.Time) error {
+	if !p.IsDraft() {
+		return ErrPlanNotDraft
+	}
+	p.deletedAt = &now
+	p.updatedAt = now
+	return nil
+}
+
+// SameScope returns true if both plans target the same business day or the same event period
+func (p *ShiftPlan) SameScope(other *ShiftPlan) bool {
+	if p.tenantID != other.tenantID || p.eventID != other.eventID {
+		return false
+	}
+	if p.businessDayID != nil || other.businessDayID != nil {
+		return p.businessDayID != nil && other.businessDayID != nil && *p.businessDayID == *other.businessDayID
+	}
+	return p.periodStart.Equal(*other.periodStart) && p.periodEnd.Equal(*other.periodEnd)
+}
+
+// OverlapsPeriod returns true if both are period plans of the same event whose periods share a date
+// 営業日単位のプランとの重なりは Covers で判定する
+func (p *ShiftPlan) OverlapsPeriod(other *ShiftPlan) bool {
+	if p.tenantID != other.tenantID || p.eventID != other.eventID {
+		return false
+	}
+	if p.businessDayID != nil || other.businessDayID != nil {
+		return false
+	}
+	return !p.periodEnd.Before(*other.periodStart) && !other.periodEnd.Before(*p.periodStart)
+}
+
+// Covers returns true if the business day is within the plan scope
+func (p *ShiftPlan) Covers(bd *event.EventBusinessDay) bool {
+	if bd.TenantID() != p.tenantID || bd.EventID() != p.eventID {
+		return false
+	}
+	if p.businessDayID != nil {
+		return bd.BusinessDayID() == *p.businessDayID
+	}
+	date := truncateToDate(bd.TargetDate())
+	y, m, d := date.Date()
+	date = time.Date(y, m, d, 0, 0, 0, 0, p.periodStart.Location())
+	return !date.Before(*p.periodStart) && !date.After(*p.periodEnd)
+}
+
+// truncateToDate truncates a time to date only (YYYY-MM-DD)
+func truncateToDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,27 @@
+package shift
+
+import (
+	"context"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// ShiftPlanRepository defines the interface for ShiftPlan persistence
+type ShiftPlanRepository interface {
+	// Save saves a shift plan (insert or update)
+	Save(ctx context.Context, plan *ShiftPlan) error
+
+	// FindByID finds a shift plan by ID within a tenant
+	FindByID(ctx context.Context, tenantID common.TenantID, planID PlanID) (*ShiftPlan, error)
+
+	// FindByEventID finds all shift plans for an event (newest first)
+	FindByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) ([]*ShiftPlan, error)
+
+	// FindPublishedByEventID finds all published shift plans for an event
+	// publish 時に同じ範囲の公開中プランを探すために使用
+	FindPublishedByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) ([]*ShiftPlan, error)
+
+	// LockPublicationByEventID serializes publishing shift plans of the event until the transaction ends
+	// 同じ範囲のプランの同時公開で、公開中のプランが複数にならないようにする（トランザクション内で呼ぶこと）
+	LockPublicationByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) error
+}
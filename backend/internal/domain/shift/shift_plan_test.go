@@ -0,0 +1,227 @@
+package shift_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+func newBusinessDayPlan(t *testing.T, tenantID common.TenantID, eventID common.EventID, bdID event.BusinessDayID) *shift.ShiftPlan {
+	t.Helper()
+	plan, err := shift.NewShiftPlan(time.Now(), tenantID, eventID, &bdID, nil, nil, "1/10 シフト")
+	if err != nil {
+		t.Fatalf("NewShiftPlan() should succeed, got error: %v", err)
+	}
+	return plan
+}
+
+// =====================================================
+// NewShiftPlan Tests
+// =====================================================
+
+func TestNewShiftPlan_BusinessDayScope(t *testing.T) {
+	tenantID := common.NewTenantID()
+	eventID := common.NewEventID()
+	bdID := event.NewBusinessDayID()
+
+	plan := newBusinessDayPlan(t, tenantID, eventID, bdID)
+
+	if !plan.IsDraft() {
+		t.Errorf("new plan should be draft, got %s", plan.PlanStatus())
+	}
+	if !plan.IsBusinessDayPlan() || *plan.BusinessDayID() != bdID {
+		t.Errorf("BusinessDayID: expected %s", bdID)
+	}
+	if plan.PublishedAt() != nil {
+		t.Errorf("PublishedAt should be nil for a draft")
+	}
+}
+
+func TestNewShiftPlan_PeriodScope(t *testing.T) {
+	start := time.Date(2025, 1, 1, 15, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	plan, err := shift.NewShiftPlan(time.Now(), common.NewTenantID(), common.NewEventID(), nil, &start, &end, "1月")
+	if err != nil {
+		t.Fatalf("NewShiftPlan() should succeed, got error: %v", err)
+	}
+
+	if plan.IsBusinessDayPlan() {
+		t.Errorf("period plan should not be a business day plan")
+	}
+	if plan.PeriodStart().Hour() != 0 || plan.PeriodEnd().Day() != 31 {
+		t.Errorf("period should be truncated to dates, got %v - %v", plan.PeriodStart(), plan.PeriodEnd())
+	}
+}
+
+func TestNewShiftPlan_ValidationErrors(t *testing.T) {
+	tenantID := common.NewTenantID()
+	eventID := common.NewEventID()
+	bdID := event.NewBusinessDayID()
+	start := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		eventID  common.EventID
+		bdID     *event.BusinessDayID
+		start    *time.Time
+		end      *time.Time
+		planName string
+	}{
+		{"no scope", eventID, nil, nil, nil, "plan"},
+		{"both scopes", eventID, &bdID, &end, &start, "plan"},
+		{"period reversed", eventID, nil, &start, &end, "plan"},
+		{"period missing end", eventID, nil, &start, nil, "plan"},
+		{"empty name", eventID, &bdID, nil, nil, ""},
+		{"empty event", common.EventID(""), &bdID, nil, nil, "plan"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := shift.NewShiftPlan(time.Now(), tenantID, tc.eventID, tc.bdID, tc.start, tc.end, tc.planName)
+			if err == nil {
+				t.Errorf("NewShiftPlan() should fail for %s", tc.name)
+			}
+		})
+	}
+}
+
+// =====================================================
+// State transition Tests
+// =====================================================
+
+func TestShiftPlan_PublishAndArchive(t *testing.T) {
+	plan := newBusinessDayPlan(t, common.NewTenantID(), common.NewEventID(), event.NewBusinessDayID())
+	now := time.Now()
+
+	if err := plan.Archive(now); !errors.Is(err, shift.ErrPlanNotPublished) {
+		t.Errorf("Archive() on draft should return ErrPlanNotPublished, got %v", err)
+	}
+
+	if err := plan.Publish(now); err != nil {
+		t.Fatalf("Publish() should succeed, got error: %v", err)
+	}
+	if !plan.IsPublished() || plan.PublishedAt() == nil {
+		t.Errorf("plan should be published with published_at set")
+	}
+	if err := plan.Publish(now); !errors.Is(err, shift.ErrPlanNotDraft) {
+		t.Errorf("Publish() twice should return ErrPlanNotDraft, got %v", err)
+	}
+	if err := plan.UpdatePlanName(now, "renamed"); !errors.Is(err, shift.ErrPlanNotDraft) {
+		t.Errorf("UpdatePlanName() on published plan should return ErrPlanNotDraft, got %v", err)
+	}
+	if err := plan.Delete(now); !errors.Is(err, shift.ErrPlanNotDraft) {
+		t.Errorf("Delete() on published plan should return ErrPlanNotDraft, got %v", err)
+	}
+
+	if err := plan.Archive(now); err != nil {
+		t.Fatalf("Archive() should succeed, got error: %v", err)
+	}
+	if !plan.IsArchived() || plan.ArchivedAt() == nil {
+		t.Errorf("plan should be archived with archived_at set")
+	}
+}
+
+// =====================================================
+// Scope Tests
+// =====================================================
+
+func TestShiftPlan_SameScope(t *testing.T) {
+	tenantID := common.NewTenantID()
+	eventID := common.NewEventID()
+	bdID := event.NewBusinessDayID()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	a := newBusinessDayPlan(t, tenantID, eventID, bdID)
+	b := newBusinessDayPlan(t, tenantID, eventID, bdID)
+	c := newBusinessDayPlan(t, tenantID, eventID, event.NewBusinessDayID())
+	p1, _ := shift.NewShiftPlan(time.Now(), tenantID, eventID, nil, &start, &end, "1月")
+	p2, _ := shift.NewShiftPlan(time.Now(), tenantID, eventID, nil, &start, &end, "1月 v2")
+
+	if !a.SameScope(b) {
+		t.Errorf("plans for the same business day should share scope")
+	}
+	if a.SameScope(c) {
+		t.Errorf("plans for different business days should not share scope")
+	}
+	if a.SameScope(p1) || p1.SameScope(a) {
+		t.Errorf("business day plan and period plan should not share scope")
+	}
+	if !p1.SameScope(p2) {
+		t.Errorf("plans for the same period should share scope")
+	}
+}
+
+func TestShiftPlan_OverlapsPeriod(t *testing.T) {
+	tenantID := common.NewTenantID()
+	eventID := common.NewEventID()
+	date := func(day int) *time.Time {
+		d := time.Date(2025, 1, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+
+	january, _ := shift.NewShiftPlan(time.Now(), tenantID, eventID, nil, date(1), date(31), "1月")
+	lateJanuary, _ := shift.NewShiftPlan(time.Now(), tenantID, eventID, nil, date(31), date(31), "1月末")
+	earlyJanuary, _ := shift.NewShiftPlan(time.Now(), tenantID, eventID, nil, date(1), date(10), "1月上旬")
+	midJanuary, _ := shift.NewShiftPlan(time.Now(), tenantID, eventID, nil, date(11), date(20), "1月中旬")
+	dayPlan := newBusinessDayPlan(t, tenantID, eventID, event.NewBusinessDayID())
+
+	if !january.OverlapsPeriod(lateJanuary) || !lateJanuary.OverlapsPeriod(january) {
+		t.Errorf("periods sharing the last date should overlap")
+	}
+	if earlyJanuary.OverlapsPeriod(midJanuary) {
+		t.Errorf("adjacent periods should not overlap")
+	}
+	if january.OverlapsPeriod(dayPlan) {
+		t.Errorf("business day plans should be checked with Covers")
+	}
+}
+
+func TestShiftPlan_Covers(t *testing.T) {
+	tenantID := common.NewTenantID()
+	eventID := common.NewEventID()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	plan, err := shift.NewShiftPlan(time.Now(), tenantID, eventID, nil, &start, &end, "1月")
+	if err != nil {
+		t.Fatalf("NewShiftPlan() should succeed, got error: %v", err)
+	}
+
+	newBD := func(eventID common.EventID, date time.Time) *event.EventBusinessDay {
+		bd, err := event.NewEventBusinessDay(
+			time.Now(), tenantID, eventID, date,
+			time.Date(2000, 1, 1, 20, 0, 0, 0, time.UTC),
+			time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC),
+			event.OccurrenceTypeSpecial, nil,
+		)
+		if err != nil {
+			t.Fatalf("NewEventBusinessDay() should succeed, got error: %v", err)
+		}
+		return bd
+	}
+
+	if !plan.Covers(newBD(eventID, time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC))) {
+		t.Errorf("plan should cover the last day of the period")
+	}
+	if plan.Covers(newBD(eventID, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))) {
+		t.Errorf("plan should not cover a day after the period")
+	}
+	if plan.Covers(newBD(common.NewEventID(), time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))) {
+		t.Errorf("plan should not cover a business day of another event")
+	}
+
+	bd := newBD(eventID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	bdPlan := newBusinessDayPlan(t, tenantID, eventID, bd.BusinessDayID())
+	if !bdPlan.Covers(bd) {
+		t.Errorf("business day plan should cover its business day")
+	}
+	if bdPlan.Covers(newBD(eventID, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))) {
+		t.Errorf("business day plan should not cover another business day on the same date")
+	}
+}
@@ -1,6 +1,7 @@
 package shift
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
@@ -49,19 +50,22 @@ func ParseSlotID(s string) (SlotID, error) {
 //   - 既存データとの互換性のため priority=0 を許容
 //   - 新規作成時のデフォルト priority は 1（ユースケース層で設定）
 type ShiftSlot struct {
-	slotID        SlotID
-	tenantID      common.TenantID
-	businessDayID event.BusinessDayID
-	instanceID    *InstanceID // インスタンスへの参照（FK）- nullable for migration
-	slotName      string
-	instanceName  string    // Deprecated: 移行完了後に削除予定。instanceID を使用してください。
-	startTime     time.Time // TIME型として扱う（HH:MM:SS）
-	endTime       time.Time // TIME型として扱う（HH:MM:SS）
-	requiredCount int
-	priority      int
-	createdAt     time.Time
-	updatedAt     time.Time
-	deletedAt     *time.Time
+	slotID           SlotID
+	tenantID         common.TenantID
+	businessDayID    event.BusinessDayID
+	instanceID       *InstanceID // インスタンスへの参照（FK）- nullable for migration
+	slotName         string
+	instanceName     string    // Deprecated: 移行完了後に削除予定。instanceID を使用してください。
+	startTime        time.Time // TIME型として扱う（HH:MM:SS）
+	endTime          time.Time // TIME型として扱う（HH:MM:SS）
+	dayOffset        int       // 営業日の target_date から枠の開始日までの日数（深夜営業の日付変更後の枠は 1）
+	requiredCount    int
+	priority         int
+	roleRequirements []RoleRequirement           // 必須/推奨ロール（任意）
+	templateID       *common.ShiftSlotTemplateID // 生成元テンプレート（手動作成の場合は nil）
+	createdAt        time.Time
+	updatedAt        time.Time
+	deletedAt        *time.Time
 }
 
 // NewShiftSlot creates a new ShiftSlot entity
@@ -110,26 +114,32 @@ func ReconstructShiftSlot(
 	instanceName string,
 	startTime time.Time,
 	endTime time.Time,
+	dayOffset int,
 	requiredCount int,
 	priority int,
+	roleRequirements []RoleRequirement,
+	templateID *common.ShiftSlotTemplateID,
 	createdAt time.Time,
 	updatedAt time.Time,
 	deletedAt *time.Time,
 ) (*ShiftSlot, error) {
 	slot := &ShiftSlot{
-		slotID:        slotID,
-		tenantID:      tenantID,
-		businessDayID: businessDayID,
-		instanceID:    instanceID,
-		slotName:      slotName,
-		instanceName:  instanceName,
-		startTime:     truncateToTime(startTime),
-		endTime:       truncateToTime(endTime),
-		requiredCount: requiredCount,
-		priority:      priority,
-		createdAt:     createdAt,
-		updatedAt:     updatedAt,
-		deletedAt:     deletedAt,
+		slotID:           slotID,
+		tenantID:         tenantID,
+		businessDayID:    businessDayID,
+		instanceID:       instanceID,
+		slotName:         slotName,
+		instanceName:     instanceName,
+		startTime:        truncateToTime(startTime),
+		endTime:          truncateToTime(endTime),
+		dayOffset:        dayOffset,
+		requiredCount:    requiredCount,
+		priority:         priority,
+		roleRequirements: roleRequirements,
+		templateID:       templateID,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+		deletedAt:        deletedAt,
 	}
 
 	if err := slot.validate(); err != nil {
@@ -170,6 +180,14 @@ func (s *ShiftSlot) validate() error {
 		return common.NewValidationError("priority must be at least 0", nil)
 	}
 
+	if err := validateRoleRequirements(s.roleRequirements, s.requiredCount); err != nil {
+		return err
+	}
+
+	if err := validateDayOffset(s.dayOffset); err != nil {
+		return err
+	}
+
 	// 時刻の前後関係チェック（深夜営業対応）
 	// start_time < end_time OR end_time < start_time のどちらかを満たす必要がある
 	// （深夜営業の場合、end_time が start_time より前になる）
@@ -181,6 +199,14 @@ func (s *ShiftSlot) validate() error {
 	return nil
 }
 
+// validateDayOffset checks that the day offset is within the span of a business day
+func validateDayOffset(dayOffset int) error {
+	if dayOffset < 0 || dayOffset > event.MaxBusinessDaySpanDays {
+		return common.NewValidationError(fmt.Sprintf("day_offset must be between 0 and %d", event.MaxBusinessDaySpanDays), nil)
+	}
+	return nil
+}
+
 // truncateToTime truncates a time to time only (HH:MM:SS)
 func truncateToTime(t time.Time) time.Time {
 	hour, min, sec := t.Clock()
@@ -224,6 +250,11 @@ func (s *ShiftSlot) EndTime() time.Time {
 	return s.endTime
 }
 
+// DayOffset returns the number of days from the business day's target date to the start of the slot
+func (s *ShiftSlot) DayOffset() int {
+	return s.dayOffset
+}
+
 func (s *ShiftSlot) RequiredCount() int {
 	return s.requiredCount
 }
@@ -232,6 +263,21 @@ func (s *ShiftSlot) Priority() int {
 	return s.priority
 }
 
+// RoleRequirements returns the role requirements of the slot
+func (s *ShiftSlot) RoleRequirements() []RoleRequirement {
+	return append([]RoleRequirement(nil), s.roleRequirements...)
+}
+
+// HasRoleRequirements returns true if the slot has any role requirements
+func (s *ShiftSlot) HasRoleRequirements() bool {
+	return len(s.roleRequirements) > 0
+}
+
+// TemplateID returns the template the slot was generated from (nil if created manually)
+func (s *ShiftSlot) TemplateID() *common.ShiftSlotTemplateID {
+	return s.templateID
+}
+
 func (s *ShiftSlot) CreatedAt() time.Time {
 	return s.createdAt
 }
@@ -267,6 +313,9 @@ func (s *ShiftSlot) UpdateRequiredCount(now time.Time, requiredCount int) error
 	if requiredCount < 1 {
 		return common.NewValidationError("required_count must be at least 1", nil)
 	}
+	if err := validateRoleRequirements(s.roleRequirements, requiredCount); err != nil {
+		return err
+	}
 
 	s.requiredCount = requiredCount
 	s.updatedAt = now
@@ -284,6 +333,17 @@ func (s *ShiftSlot) UpdatePriority(now time.Time, priority int) error {
 	return nil
 }
 
+// SetRoleRequirements replaces the role requirements of the slot
+func (s *ShiftSlot) SetRoleRequirements(now time.Time, requirements []RoleRequirement) error {
+	if err := validateRoleRequirements(requirements, s.requiredCount); err != nil {
+		return err
+	}
+
+	s.roleRequirements = append([]RoleRequirement(nil), requirements...)
+	s.updatedAt = now
+	return nil
+}
+
 // SetInstanceID sets the instance ID (for data migration)
 func (s *ShiftSlot) SetInstanceID(now time.Time, instanceID InstanceID) {
 	s.instanceID = &instanceID
@@ -296,6 +356,133 @@ func (s *ShiftSlot) ClearInstanceID(now time.Time) {
 	s.updatedAt = now
 }
 
+// MarkFromTemplate records the template the slot was generated from
+func (s *ShiftSlot) MarkFromTemplate(now time.Time, templateID common.ShiftSlotTemplateID) {
+	s.templateID = &templateID
+	s.updatedAt = now
+}
+
+// MatchesTemplateItem returns true if the slot has the same definition as the template item
+// 枠名・インスタンス名は対応付けのキーとして呼び出し側で照合する
+func (s *ShiftSlot) MatchesTemplateItem(item *ShiftSlotTemplateItem) bool {
+	return s.startTime.Equal(truncateToTime(item.StartTime())) &&
+		s.endTime.Equal(truncateToTime(item.EndTime())) &&
+		s.requiredCount == item.RequiredCount() &&
+		s.priority == item.Priority() &&
+		equalRoleRequirements(s.roleRequirements, item.RoleRequirements())
+}
+
+// ChangesTimesOrRolesFrom returns true if applying the template item would change the times or role requirements of the slot
+// 割り当て済みのメンバーの参加条件が変わる変更（必要人数・優先度の変更は含まない）
+func (s *ShiftSlot) ChangesTimesOrRolesFrom(item *ShiftSlotTemplateItem) bool {
+	return !s.startTime.Equal(truncateToTime(item.StartTime())) ||
+		!s.endTime.Equal(truncateToTime(item.EndTime())) ||
+		!equalRoleRequirements(s.roleRequirements, item.RoleRequirements())
+}
+
+// ApplyTemplateItem updates the slot to the definition of the template item
+// 枠を作り直さずに更新するため、既存の割り当ては保持される（day_offset は呼び出し側で営業日に合わせる）
+func (s *ShiftSlot) ApplyTemplateItem(now time.Time, item *ShiftSlotTemplateItem) error {
+	// Validate before mutating using a temporary copy
+	tmp := *s
+	tmp.startTime = truncateToTime(item.StartTime())
+	tmp.endTime = truncateToTime(item.EndTime())
+	tmp.requiredCount = item.RequiredCount()
+	tmp.priority = item.Priority()
+	tmp.roleRequirements = item.RoleRequirements()
+	if err := tmp.validate(); err != nil {
+		return err
+	}
+
+	templateID := item.TemplateID()
+	tmp.templateID = &templateID
+	tmp.updatedAt = now
+	*s = tmp
+	return nil
+}
+
+// SetDayOffset sets the day offset of the slot from the business day's target date
+func (s *ShiftSlot) SetDayOffset(now time.Time, dayOffset int) error {
+	if err := validateDayOffset(dayOffset); err != nil {
+		return err
+	}
+
+	s.dayOffset = dayOffset
+	s.updatedAt = now
+	return nil
+}
+
+// AlignToBusinessDay sets the day offset so that the slot falls within the business day
+// 深夜営業で営業開始時刻より前に始まる枠（22:00-03:00 の営業日の 01:00 枠など）を翌日の枠とする
+func (s *ShiftSlot) AlignToBusinessDay(now time.Time, bd *event.EventBusinessDay) {
+	s.dayOffset = bd.DayOffsetFor(s.startTime)
+	s.updatedAt = now
+}
+
+// ShiftTimes moves the start and end time of the slot by delta
+// 営業日の開始時刻の変更に合わせて枠をずらす（日付をまたぐ場合は day_offset も移動する）
+// 営業日の対象日より前、または day_offset の上限を超えて始まる場合はエラーを返し、枠は変更しない
+func (s *ShiftSlot) ShiftTimes(now time.Time, delta time.Duration) error {
+	// truncateToTime の基準日（2000-01-01）からの日数を新しい day_offset とする
+	base := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	start := s.startTime.AddDate(0, 0, s.dayOffset).Add(delta)
+	if start.Before(base) {
+		return common.NewValidationError(fmt.Sprintf("shift slot %s would start before the business day's target date", s.slotName), nil)
+	}
+	dayOffset := int(start.Sub(base) / (24 * time.Hour))
+	if err := validateDayOffset(dayOffset); err != nil {
+		return err
+	}
+
+	s.startTime = truncateToTime(start)
+	s.endTime = truncateToTime(s.endTime.Add(delta))
+	s.dayOffset = dayOffset
+	s.updatedAt = now
+	return nil
+}
+
+// ShiftEndTime moves only the end time of the slot by delta
+// 営業日の終了時刻の変更に合わせて、営業日の終了まで続く枠を伸縮する
+// 枠の長さが 0 以下、または 24 時間以上になる場合はエラーを返し、枠は変更しない
+func (s *ShiftSlot) ShiftEndTime(now time.Time, delta time.Duration) error {
+	start, end := periodOn(time.Time{}, s.startTime, s.endTime)
+	end = end.Add(delta)
+	if !end.After(start) || end.Sub(start) >= 24*time.Hour {
+		return common.NewValidationError(fmt.Sprintf("shift slot %s must end within 24 hours after it starts", s.slotName), nil)
+	}
+
+	s.endTime = truncateToTime(end)
+	s.updatedAt = now
+	return nil
+}
+
+// CopyTo creates a new slot on another business day with the same definition
+// instanceID は複製先のイベントのインスタンス（nil 可）。生成元テンプレートは呼び出し側で MarkFromTemplate する
+func (s *ShiftSlot) CopyTo(now time.Time, businessDayID event.BusinessDayID, instanceID *InstanceID) (*ShiftSlot, error) {
+	copied := &ShiftSlot{
+		slotID:           NewSlotIDWithTime(now),
+		tenantID:         s.tenantID,
+		businessDayID:    businessDayID,
+		instanceID:       instanceID,
+		slotName:         s.slotName,
+		instanceName:     s.instanceName,
+		startTime:        s.startTime,
+		endTime:          s.endTime,
+		dayOffset:        s.dayOffset,
+		requiredCount:    s.requiredCount,
+		priority:         s.priority,
+		roleRequirements: s.RoleRequirements(),
+		createdAt:        now,
+		updatedAt:        now,
+	}
+
+	if err := copied.validate(); err != nil {
+		return nil, err
+	}
+
+	return copied, nil
+}
+
 // Delete marks the slot as deleted (soft delete)
 func (s *ShiftSlot) Delete(now time.Time) {
 	s.deletedAt = &now
@@ -308,6 +495,13 @@ func (s *ShiftSlot) IsOvernight() bool {
 	return s.endTime.Before(s.startTime)
 }
 
+// Duration returns the length of the slot
+// 深夜帯の枠（IsOvernight）は日付をまたいだ長さを返す
+func (s *ShiftSlot) Duration() time.Duration {
+	start, end := periodOn(time.Time{}, s.startTime, s.endTime)
+	return end.Sub(start)
+}
+
 // StartTimeString returns the start time as HH:MM string
 func (s *ShiftSlot) StartTimeString() string {
 	return s.startTime.Format("15:04")
@@ -317,3 +511,40 @@ func (s *ShiftSlot) StartTimeString() string {
 func (s *ShiftSlot) EndTimeString() string {
 	return s.endTime.Format("15:04")
 }
+
+// PeriodOn returns the actual start/end datetime of the slot on the given business day date
+// 深夜帯の枠（IsOvernight）は終了日時を翌日として扱い、day_offset の分だけ営業日の日付から後ろにずらす
+func (s *ShiftSlot) PeriodOn(targetDate time.Time) (time.Time, time.Time) {
+	return periodOn(targetDate.AddDate(0, 0, s.dayOffset), s.startTime, s.endTime)
+}
+
+// PeriodIn returns the start/end instants of the slot on the given business day date in loc
+// PeriodOn は時刻を UTC の壁時計として扱うため、実際の時刻（API 応答や DST を跨ぐ計算）にはこちらを使う
+func (s *ShiftSlot) PeriodIn(targetDate time.Time, loc *time.Location) (time.Time, time.Time) {
+	return periodIn(targetDate.AddDate(0, 0, s.dayOffset), s.startTime, s.endTime, loc)
+}
+
+// periodOn combines the business day date with start/end time-of-day
+// 終了時刻が開始時刻より前の場合は翌日の終了とする
+func periodOn(targetDate, startTime, endTime time.Time) (time.Time, time.Time) {
+	return periodIn(targetDate, startTime, endTime, time.UTC)
+}
+
+// periodIn combines the business day date with start/end time-of-day as wall clock times in loc
+func periodIn(targetDate, startTime, endTime time.Time, loc *time.Location) (time.Time, time.Time) {
+	y, m, d := targetDate.Date()
+	start := time.Date(y, m, d, startTime.Hour(), startTime.Minute(), startTime.Second(), 0, loc)
+	end := time.Date(y, m, d, endTime.Hour(), endTime.Minute(), endTime.Second(), 0, loc)
+	if endTime.Before(startTime) {
+		end = time.Date(y, m, d+1, endTime.Hour(), endTime.Minute(), endTime.Second(), 0, loc)
+	}
+	return start, end
+}
+
+// OverlapsWith returns true if the slot on targetDate overlaps with other slot on otherDate
+// 終了時刻と開始時刻が一致する連続したシフトは重複とみなさない
+func (s *ShiftSlot) OverlapsWith(targetDate time.Time, other *ShiftSlot, otherDate time.Time) bool {
+	start, end := s.PeriodOn(targetDate)
+	otherStart, otherEnd := other.PeriodOn(otherDate)
+	return start.Before(otherEnd) && otherStart.Before(end)
+}
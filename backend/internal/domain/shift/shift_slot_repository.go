@@ -15,6 +15,9 @@ type ShiftSlotRepository interface {
 	// FindByID finds a shift slot by ID within a tenant
 	FindByID(ctx context.Context, tenantID common.TenantID, slotID SlotID) (*ShiftSlot, error)
 
+	// FindByIDForUpdate finds a shift slot by ID with row lock (トランザクション内で使用する)
+	FindByIDForUpdate(ctx context.Context, tenantID common.TenantID, slotID SlotID) (*ShiftSlot, error)
+
 	// FindByBusinessDayID finds all shift slots for a business day
 	FindByBusinessDayID(ctx context.Context, tenantID common.TenantID, businessDayID event.BusinessDayID) ([]*ShiftSlot, error)
 
@@ -465,3 +465,301 @@ func TestShiftSlot_TimeString(t *testing.T) {
 		t.Errorf("EndTimeString() = %s, want 23:45", slot.EndTimeString())
 	}
 }
+
+func TestShiftSlot_PeriodOn_Overnight(t *testing.T) {
+	tenantID := common.NewTenantID()
+	slot := createTestSlot(t, tenantID, "深夜スタッフ",
+		time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 2, 0, 0, 0, time.UTC), 1)
+
+	start, end := slot.PeriodOn(time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC))
+
+	if !start.Equal(time.Date(2025, 1, 31, 23, 0, 0, 0, time.UTC)) {
+		t.Errorf("start = %v, want 2025-01-31 23:00", start)
+	}
+	if !end.Equal(time.Date(2025, 2, 1, 2, 0, 0, 0, time.UTC)) {
+		t.Errorf("end = %v, want 2025-02-01 02:00", end)
+	}
+}
+
+func TestShiftSlot_PeriodIn_DST(t *testing.T) {
+	tenantID := common.NewTenantID()
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+	// 23:00〜翌3:00 の深夜枠。2025-11-01 の夜は夏時間終了（翌 2:00 EDT → 1:00 EST）を跨ぐ
+	slot := createTestSlot(t, tenantID, "深夜スタッフ",
+		time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 3, 0, 0, 0, time.UTC), 1)
+
+	start, end := slot.PeriodIn(time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC), newYork)
+
+	if !start.Equal(time.Date(2025, 11, 2, 3, 0, 0, 0, time.UTC)) {
+		t.Errorf("start = %v, want 2025-11-02 03:00 UTC", start.UTC())
+	}
+	if !end.Equal(time.Date(2025, 11, 2, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("end = %v, want 2025-11-02 08:00 UTC", end.UTC())
+	}
+	if got := end.Sub(start); got != 5*time.Hour {
+		t.Errorf("duration = %v, want 5h across fall back", got)
+	}
+
+	// 夏時間開始（2025-03-09 2:00 EST → 3:00 EDT）を跨ぐ夜は 1 時間短い
+	start, end = slot.PeriodIn(time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC), newYork)
+	if got := end.Sub(start); got != 3*time.Hour {
+		t.Errorf("duration = %v, want 3h across spring forward", got)
+	}
+}
+
+func TestShiftSlot_OverlapsWith(t *testing.T) {
+	tenantID := common.NewTenantID()
+	at := func(h, m int) time.Time { return time.Date(2000, 1, 1, h, m, 0, 0, time.UTC) }
+	day := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	nextDay := day.AddDate(0, 0, 1)
+
+	tests := []struct {
+		name      string
+		a         *ShiftSlot
+		b         *ShiftSlot
+		otherDate time.Time
+		want      bool
+	}{
+		{
+			name:      "同日で時間帯が重なる",
+			a:         createTestSlot(t, tenantID, "A", at(20, 0), at(22, 0), 1),
+			b:         createTestSlot(t, tenantID, "B", at(21, 0), at(23, 0), 1),
+			otherDate: day,
+			want:      true,
+		},
+		{
+			name:      "連続したシフトは重複しない",
+			a:         createTestSlot(t, tenantID, "A", at(20, 0), at(22, 0), 1),
+			b:         createTestSlot(t, tenantID, "B", at(22, 0), at(23, 0), 1),
+			otherDate: day,
+			want:      false,
+		},
+		{
+			name:      "深夜シフトと同日深夜シフトが重なる",
+			a:         createTestSlot(t, tenantID, "A", at(23, 0), at(2, 0), 1),
+			b:         createTestSlot(t, tenantID, "B", at(23, 30), at(1, 0), 1),
+			otherDate: day,
+			want:      true,
+		},
+		{
+			name:      "深夜シフトが翌日早朝のシフトと重なる",
+			a:         createTestSlot(t, tenantID, "A", at(23, 0), at(2, 0), 1),
+			b:         createTestSlot(t, tenantID, "B", at(1, 0), at(3, 0), 1),
+			otherDate: nextDay,
+			want:      true,
+		},
+		{
+			name:      "同日早朝のシフトとは重ならない",
+			a:         createTestSlot(t, tenantID, "A", at(23, 0), at(2, 0), 1),
+			b:         createTestSlot(t, tenantID, "B", at(1, 0), at(3, 0), 1),
+			otherDate: day,
+			want:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.OverlapsWith(day, tt.b, tt.otherDate); got != tt.want {
+				t.Errorf("OverlapsWith() = %v, want %v", got, tt.want)
+			}
+			if got := tt.b.OverlapsWith(tt.otherDate, tt.a, day); got != tt.want {
+				t.Errorf("OverlapsWith() (reversed) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShiftSlot_AlignToBusinessDay_Overnight(t *testing.T) {
+	tenantID := common.NewTenantID()
+	at := func(h int) time.Time { return time.Date(2000, 1, 1, h, 0, 0, 0, time.UTC) }
+	targetDate := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	// 22:00〜翌3:00 の営業日
+	bd, err := event.NewEventBusinessDay(time.Now(), tenantID, common.NewEventID(), targetDate, at(22), at(3), event.OccurrenceTypeSpecial, nil)
+	if err != nil {
+		t.Fatalf("NewEventBusinessDay() failed: %v", err)
+	}
+
+	early := createTestSlot(t, tenantID, "受付", at(22), at(2), 1)
+	late := createTestSlot(t, tenantID, "撤収", at(1), at(3), 1)
+	early.AlignToBusinessDay(time.Now(), bd)
+	late.AlignToBusinessDay(time.Now(), bd)
+
+	if early.DayOffset() != 0 || late.DayOffset() != 1 {
+		t.Fatalf("DayOffset() = %d, %d, want 0, 1", early.DayOffset(), late.DayOffset())
+	}
+	start, end := late.PeriodOn(targetDate)
+	if !start.Equal(time.Date(2025, 2, 1, 1, 0, 0, 0, time.UTC)) || !end.Equal(time.Date(2025, 2, 1, 3, 0, 0, 0, time.UTC)) {
+		t.Errorf("PeriodOn() = %v - %v, want 2025-02-01 01:00 - 03:00", start, end)
+	}
+	if !early.OverlapsWith(targetDate, late, targetDate) {
+		t.Error("22:00-02:00 should overlap with 01:00-03:00 on the next day")
+	}
+	if late.Duration() != 2*time.Hour || early.Duration() != 4*time.Hour {
+		t.Errorf("Duration() = %v, %v, want 2h, 4h", late.Duration(), early.Duration())
+	}
+}
+
+func TestShiftSlot_ShiftTimes_MovesDayOffset(t *testing.T) {
+	tenantID := common.NewTenantID()
+	at := func(h int) time.Time { return time.Date(2000, 1, 1, h, 0, 0, 0, time.UTC) }
+	slot := createTestSlot(t, tenantID, "深夜スタッフ", at(23), at(1), 1)
+
+	if err := slot.ShiftTimes(time.Now(), 2*time.Hour); err != nil {
+		t.Fatalf("ShiftTimes(+2h) failed: %v", err)
+	}
+	if slot.DayOffset() != 1 || slot.StartTimeString() != "01:00" {
+		t.Errorf("after +2h: DayOffset() = %d, start = %s, want 1, 01:00", slot.DayOffset(), slot.StartTimeString())
+	}
+
+	if err := slot.ShiftTimes(time.Now(), -3*time.Hour); err != nil {
+		t.Fatalf("ShiftTimes(-3h) failed: %v", err)
+	}
+	if slot.DayOffset() != 0 || slot.StartTimeString() != "22:00" {
+		t.Errorf("after -3h: DayOffset() = %d, start = %s, want 0, 22:00", slot.DayOffset(), slot.StartTimeString())
+	}
+}
+
+func TestShiftSlot_ShiftTimes_ErrorWhenBeforeTargetDate(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2000, 1, 1, h, 0, 0, 0, time.UTC) }
+	slot := createTestSlot(t, common.NewTenantID(), "オープニング", at(1), at(3), 1)
+
+	if err := slot.ShiftTimes(time.Now(), -2*time.Hour); err == nil {
+		t.Fatal("ShiftTimes() should fail when the slot would start on the previous day")
+	}
+	if slot.DayOffset() != 0 || slot.StartTimeString() != "01:00" || slot.EndTimeString() != "03:00" {
+		t.Errorf("slot should not change: DayOffset() = %d, start = %s, end = %s", slot.DayOffset(), slot.StartTimeString(), slot.EndTimeString())
+	}
+}
+
+func TestShiftSlot_ShiftEndTime(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2000, 1, 1, h, 0, 0, 0, time.UTC) }
+	slot := createTestSlot(t, common.NewTenantID(), "クロージング", at(22), at(23), 1)
+
+	if err := slot.ShiftEndTime(time.Now(), 2*time.Hour); err != nil {
+		t.Fatalf("ShiftEndTime(+2h) failed: %v", err)
+	}
+	if slot.StartTimeString() != "22:00" || slot.EndTimeString() != "01:00" {
+		t.Errorf("after +2h: %s-%s, want 22:00-01:00", slot.StartTimeString(), slot.EndTimeString())
+	}
+
+	if err := slot.ShiftEndTime(time.Now(), -3*time.Hour); err == nil {
+		t.Fatal("ShiftEndTime() should fail when the slot would end before it starts")
+	}
+	if slot.EndTimeString() != "01:00" {
+		t.Errorf("slot should not change: end = %s", slot.EndTimeString())
+	}
+}
+
+func TestShiftSlot_SetDayOffset_ErrorWhenOutOfRange(t *testing.T) {
+	slot := createTestSlot(t, common.NewTenantID(), "A",
+		time.Date(2000, 1, 1, 20, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC), 1)
+
+	for _, offset := range []int{-1, event.MaxBusinessDaySpanDays + 1} {
+		if err := slot.SetDayOffset(time.Now(), offset); err == nil {
+			t.Errorf("SetDayOffset(%d) should fail", offset)
+		}
+	}
+}
+
+func TestShiftSlot_ApplyTemplateItem_KeepsSlotID(t *testing.T) {
+	tenantID := common.NewTenantID()
+	templateID := common.NewShiftSlotTemplateID()
+	slot := createTestSlot(t, tenantID, "DJ", time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC), 1)
+	slotID := slot.SlotID()
+
+	item, err := NewShiftSlotTemplateItem(time.Now(), templateID, "DJ", "", time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 0, 30, 0, 0, time.UTC), 2, 3)
+	if err != nil {
+		t.Fatalf("NewShiftSlotTemplateItem() failed: %v", err)
+	}
+	if slot.MatchesTemplateItem(item) {
+		t.Fatal("MatchesTemplateItem() should be false before applying")
+	}
+
+	if err := slot.ApplyTemplateItem(time.Now(), item); err != nil {
+		t.Fatalf("ApplyTemplateItem() should succeed: %v", err)
+	}
+
+	if slot.SlotID() != slotID {
+		t.Error("SlotID should not change")
+	}
+	if slot.StartTimeString() != "22:00" || slot.EndTimeString() != "00:30" {
+		t.Errorf("times should be updated: got %s-%s", slot.StartTimeString(), slot.EndTimeString())
+	}
+	if slot.RequiredCount() != 2 || slot.Priority() != 3 {
+		t.Errorf("required_count/priority should be updated: got %d/%d", slot.RequiredCount(), slot.Priority())
+	}
+	if slot.TemplateID() == nil || *slot.TemplateID() != templateID {
+		t.Errorf("TemplateID should be set to %v: got %v", templateID, slot.TemplateID())
+	}
+	if !slot.MatchesTemplateItem(item) {
+		t.Error("MatchesTemplateItem() should be true after applying")
+	}
+}
+
+func TestShiftSlot_ChangesTimesOrRolesFrom(t *testing.T) {
+	templateID := common.NewShiftSlotTemplateID()
+	slot := createTestSlot(t, common.NewTenantID(), "DJ", time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC), 1)
+
+	// 必要人数・優先度だけの変更は対象外
+	sameTimes, err := NewShiftSlotTemplateItem(time.Now(), templateID, "DJ", "", time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC), 3, 5)
+	if err != nil {
+		t.Fatalf("NewShiftSlotTemplateItem() failed: %v", err)
+	}
+	if slot.ChangesTimesOrRolesFrom(sameTimes) {
+		t.Error("ChangesTimesOrRolesFrom() should be false when only required_count/priority differ")
+	}
+
+	otherTimes, err := NewShiftSlotTemplateItem(time.Now(), templateID, "DJ", "", time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC), 1, 1)
+	if err != nil {
+		t.Fatalf("NewShiftSlotTemplateItem() failed: %v", err)
+	}
+	if !slot.ChangesTimesOrRolesFrom(otherTimes) {
+		t.Error("ChangesTimesOrRolesFrom() should be true when start_time differs")
+	}
+
+	req, err := NewRoleRequirement(common.NewRoleID(), RoleRequirementRequired, 1)
+	if err != nil {
+		t.Fatalf("NewRoleRequirement() failed: %v", err)
+	}
+	if err := sameTimes.SetRoleRequirements(time.Now(), []RoleRequirement{req}); err != nil {
+		t.Fatalf("SetRoleRequirements() failed: %v", err)
+	}
+	if !slot.ChangesTimesOrRolesFrom(sameTimes) {
+		t.Error("ChangesTimesOrRolesFrom() should be true when role requirements differ")
+	}
+}
+
+func TestShiftSlot_ApplyTemplateItem_ErrorDoesNotMutate(t *testing.T) {
+	tenantID := common.NewTenantID()
+	slot := createTestSlot(t, tenantID, "DJ", time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC), 1)
+
+	// 開始と終了が同じ時刻の枠は不正
+	item, err := ReconstructShiftSlotTemplateItem(
+		common.NewShiftSlotTemplateItemID(),
+		common.NewShiftSlotTemplateID(),
+		"DJ",
+		"",
+		time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC),
+		2,
+		1,
+		nil,
+		time.Now(),
+		time.Now(),
+	)
+	if err != nil {
+		t.Fatalf("ReconstructShiftSlotTemplateItem() failed: %v", err)
+	}
+
+	if err := slot.ApplyTemplateItem(time.Now(), item); err == nil {
+		t.Fatal("ApplyTemplateItem() should fail when start_time equals end_time")
+	}
+	if slot.StartTimeString() != "21:00" || slot.RequiredCount() != 1 || slot.TemplateID() != nil {
+		t.Error("slot should not be changed on error")
+	}
+}
@@ -0,0 +1,324 @@
+package shift
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// DefaultStandbyOfferWindow is how long a standby member has to accept an offered slot
+const DefaultStandbyOfferWindow = 12 * time.Hour
+
+// StandbyStatus represents the status of a standby entry
+type StandbyStatus string
+
+const (
+	StandbyStatusWaiting   StandbyStatus = "waiting"   // 空き待ち
+	StandbyStatusOffered   StandbyStatus = "offered"   // 空きが出て回答待ち（期限付き）
+	StandbyStatusPromoted  StandbyStatus = "promoted"  // 割り当て確定
+	StandbyStatusDeclined  StandbyStatus = "declined"  // オファーを辞退
+	StandbyStatusExpired   StandbyStatus = "expired"   // オファーの回答期限切れ
+	StandbyStatusWithdrawn StandbyStatus = "withdrawn" // 空き待ちを取り下げ
+)
+
+func (s StandbyStatus) Validate() error {
+	switch s {
+	case StandbyStatusWaiting, StandbyStatusOffered, StandbyStatusPromoted,
+		StandbyStatusDeclined, StandbyStatusExpired, StandbyStatusWithdrawn:
+		return nil
+	default:
+		return fmt.Errorf("invalid standby status: %s", s)
+	}
+}
+
+// StandbyID represents a standby entry identifier
+type StandbyID string
+
+// NewStandbyIDWithTime creates a new StandbyID using the provided time.
+func NewStandbyIDWithTime(t time.Time) StandbyID {
+	return StandbyID(common.NewULIDWithTime(t))
+}
+
+func (id StandbyID) String() string {
+	return string(id)
+}
+
+func (id StandbyID) Validate() error {
+	if id == "" {
+		return fmt.Errorf("standby_id is required")
+	}
+	return common.ValidateULID(string(id))
+}
+
+func ParseStandbyID(s string) (StandbyID, error) {
+	if err := common.ValidateULID(s); err != nil {
+		return "", err
+	}
+	return StandbyID(s), nil
+}
+
+// StandbyEntry represents a member waiting for a vacancy in a full shift slot
+// 枠ごとに position の昇順で繰り上げる。
+//
+// 状態遷移:
+//   - waiting → promoted（autoAccept の場合、空きが出た時点で自動確定）
+//   - waiting → offered → promoted / declined / expired（回答期限付きのオファー）
+//   - waiting / offered → withdrawn（取り下げ）
+type StandbyEntry struct {
+	standbyID      StandbyID
+	tenantID       common.TenantID
+	slotID         SlotID
+	memberID       common.MemberID
+	position       int
+	autoAccept     bool // true: 空きが出たら確認なしで割り当てる
+	status         StandbyStatus
+	offerExpiresAt *time.Time
+	assignmentID   *AssignmentID // 繰り上げで作成された割り当て
+	note           string
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+// NewStandbyEntry creates a new waiting StandbyEntry
+func NewStandbyEntry(
+	now time.Time,
+	tenantID common.TenantID,
+	slotID SlotID,
+	memberID common.MemberID,
+	position int,
+	autoAccept bool,
+	note string,
+) (*StandbyEntry, error) {
+	entry := &StandbyEntry{
+		standbyID:  NewStandbyIDWithTime(now),
+		tenantID:   tenantID,
+		slotID:     slotID,
+		memberID:   memberID,
+		position:   position,
+		autoAccept: autoAccept,
+		status:     StandbyStatusWaiting,
+		note:       note,
+		createdAt:  now,
+		updatedAt:  now,
+	}
+
+	if err := entry.validate(); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// ReconstructStandbyEntry reconstructs a StandbyEntry from persistence
+func ReconstructStandbyEntry(
+	standbyID StandbyID,
+	tenantID common.TenantID,
+	slotID SlotID,
+	memberID common.MemberID,
+	position int,
+	autoAccept bool,
+	status StandbyStatus,
+	offerExpiresAt *time.Time,
+	assignmentID *AssignmentID,
+	note string,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*StandbyEntry, error) {
+	entry := &StandbyEntry{
+		standbyID:      standbyID,
+		tenantID:       tenantID,
+		slotID:         slotID,
+		memberID:       memberID,
+		position:       position,
+		autoAccept:     autoAccept,
+		status:         status,
+		offerExpiresAt: offerExpiresAt,
+		assignmentID:   assignmentID,
+		note:           note,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}
+
+	if err := entry.validate(); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (e *StandbyEntry) validate() error {
+	// TenantID の必須性チェック
+	if err := e.tenantID.Validate(); err != nil {
+		return common.NewValidationError("tenant_id is required", err)
+	}
+
+	// SlotID の必須性チェック
+	if err := e.slotID.Validate(); err != nil {
+		return err
+	}
+
+	// MemberID の必須性チェック
+	if err := e.memberID.Validate(); err != nil {
+		return common.NewValidationError("member_id is required", err)
+	}
+
+	// Position は1以上
+	if e.position < 1 {
+		return common.NewValidationError("position must be at least 1", nil)
+	}
+
+	// Status のバリデーション
+	if err := e.status.Validate(); err != nil {
+		return common.NewValidationError("invalid status", err)
+	}
+
+	// Note の長さチェック
+	if len(e.note) > 1000 {
+		return common.NewValidationError("note must be less than 1000 characters", nil)
+	}
+
+	// オファー中は回答期限が必須
+	if e.status == StandbyStatusOffered && e.offerExpiresAt == nil {
+		return common.NewValidationError("offer_expires_at is required while offered", nil)
+	}
+
+	// 繰り上げ済みは割り当てが必須
+	if e.status == StandbyStatusPromoted && e.assignmentID == nil {
+		return common.NewValidationError("assignment_id is required once promoted", nil)
+	}
+
+	return nil
+}
+
+// Getters
+
+func (e *StandbyEntry) StandbyID() StandbyID {
+	return e.standbyID
+}
+
+func (e *StandbyEntry) TenantID() common.TenantID {
+	return e.tenantID
+}
+
+func (e *StandbyEntry) SlotID() SlotID {
+	return e.slotID
+}
+
+func (e *StandbyEntry) MemberID() common.MemberID {
+	return e.memberID
+}
+
+func (e *StandbyEntry) Position() int {
+	return e.position
+}
+
+func (e *StandbyEntry) AutoAccept() bool {
+	return e.autoAccept
+}
+
+func (e *StandbyEntry) Status() StandbyStatus {
+	return e.status
+}
+
+func (e *StandbyEntry) OfferExpiresAt() *time.Time {
+	return e.offerExpiresAt
+}
+
+func (e *StandbyEntry) AssignmentID() *AssignmentID {
+	return e.assignmentID
+}
+
+func (e *StandbyEntry) Note() string {
+	return e.note
+}
+
+func (e *StandbyEntry) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+func (e *StandbyEntry) UpdatedAt() time.Time {
+	return e.updatedAt
+}
+
+// IsActive returns true if the entry is still waiting for or holding an offer
+func (e *StandbyEntry) IsActive() bool {
+	return e.status == StandbyStatusWaiting || e.status == StandbyStatusOffered
+}
+
+func (e *StandbyEntry) IsWaiting() bool {
+	return e.status == StandbyStatusWaiting
+}
+
+func (e *StandbyEntry) IsOffered() bool {
+	return e.status == StandbyStatusOffered
+}
+
+// IsOfferExpired returns true if the entry is offered and the deadline has passed
+func (e *StandbyEntry) IsOfferExpired(now time.Time) bool {
+	return e.IsOffered() && !now.Before(*e.offerExpiresAt)
+}
+
+// Offer offers the vacant slot to the waiting member until expiresAt
+func (e *StandbyEntry) Offer(now time.Time, expiresAt time.Time) error {
+	if !e.IsWaiting() {
+		return ErrStandbyNotWaiting
+	}
+	if !expiresAt.After(now) {
+		return common.NewValidationError("offer_expires_at must be in the future", nil)
+	}
+
+	e.status = StandbyStatusOffered
+	e.offerExpiresAt = &expiresAt
+	e.updatedAt = now
+	return nil
+}
+
+// Promote records the assignment created for the standby member
+// オファー中の場合は回答期限内である必要がある
+func (e *StandbyEntry) Promote(now time.Time, assignmentID AssignmentID) error {
+	if e.IsOfferExpired(now) {
+		return ErrStandbyOfferExpired
+	}
+	if !e.IsActive() {
+		return ErrStandbyNotWaiting
+	}
+
+	e.status = StandbyStatusPromoted
+	e.assignmentID = &assignmentID
+	e.updatedAt = now
+	return nil
+}
+
+// Decline declines the offered slot
+func (e *StandbyEntry) Decline(now time.Time) error {
+	if !e.IsOffered() {
+		return ErrStandbyNotOffered
+	}
+
+	e.status = StandbyStatusDeclined
+	e.updatedAt = now
+	return nil
+}
+
+// Expire marks the offer as expired after the deadline has passed
+func (e *StandbyEntry) Expire(now time.Time) error {
+	if !e.IsOfferExpired(now) {
+		return ErrStandbyNotOffered
+	}
+
+	e.status = StandbyStatusExpired
+	e.updatedAt = now
+	return nil
+}
+
+// Withdraw removes the entry from the standby list
+func (e *StandbyEntry) Withdraw(now time.Time) error {
+	if !e.IsActive() {
+		return ErrStandbyNotWaiting
+	}
+
+	e.status = StandbyStatusWithdrawn
+	e.updatedAt = now
+	return nil
+}
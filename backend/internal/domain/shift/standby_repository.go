@@ -0,0 +1,25 @@
+package shift
+
+import (
+	"context"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// StandbyRepository defines the interface for StandbyEntry persistence
+//
+// 繰り上げ・オファー回答は ShiftSlotRepository.FindByIDForUpdate で枠をロックした
+// トランザクション内で行うため、エントリ自体の行ロックは持たない
+type StandbyRepository interface {
+	// Save saves a standby entry (insert or update)
+	Save(ctx context.Context, entry *StandbyEntry) error
+
+	// FindByID finds a standby entry by ID within a tenant
+	FindByID(ctx context.Context, tenantID common.TenantID, standbyID StandbyID) (*StandbyEntry, error)
+
+	// FindBySlotID finds all standby entries for a slot (position ascending)
+	FindBySlotID(ctx context.Context, tenantID common.TenantID, slotID SlotID) ([]*StandbyEntry, error)
+
+	// FindActiveBySlotID finds waiting or offered standby entries for a slot (position ascending)
+	FindActiveBySlotID(ctx context.Context, tenantID common.TenantID, slotID SlotID) ([]*StandbyEntry, error)
+}
@@ -0,0 +1,127 @@
+package shift_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+func newTestStandbyEntry(t *testing.T, autoAccept bool) *shift.StandbyEntry {
+	t.Helper()
+	entry, err := shift.NewStandbyEntry(time.Now(), common.NewTenantID(), shift.NewSlotID(), common.NewMemberID(), 1, autoAccept, "")
+	if err != nil {
+		t.Fatalf("NewStandbyEntry() should succeed, got error: %v", err)
+	}
+	return entry
+}
+
+// =====================================================
+// NewStandbyEntry Tests
+// =====================================================
+
+func TestNewStandbyEntry_Success(t *testing.T) {
+	entry := newTestStandbyEntry(t, true)
+
+	if !entry.IsWaiting() {
+		t.Errorf("new standby entry should be waiting, got %s", entry.Status())
+	}
+	if !entry.AutoAccept() {
+		t.Errorf("AutoAccept should be true")
+	}
+}
+
+func TestNewStandbyEntry_ErrorWhenPositionInvalid(t *testing.T) {
+	_, err := shift.NewStandbyEntry(time.Now(), common.NewTenantID(), shift.NewSlotID(), common.NewMemberID(), 0, false, "")
+	if err == nil {
+		t.Errorf("expected validation error for position 0")
+	}
+}
+
+// =====================================================
+// Offer / Promote Tests
+// =====================================================
+
+func TestStandbyEntry_OfferAndPromote(t *testing.T) {
+	entry := newTestStandbyEntry(t, false)
+	now := time.Now()
+
+	if err := entry.Offer(now, now.Add(shift.DefaultStandbyOfferWindow)); err != nil {
+		t.Fatalf("Offer() should succeed, got error: %v", err)
+	}
+	if !entry.IsOffered() || entry.OfferExpiresAt() == nil {
+		t.Fatalf("entry should be offered with a deadline, got %s", entry.Status())
+	}
+
+	assignmentID := shift.NewAssignmentIDWithTime(now)
+	if err := entry.Promote(now.Add(time.Hour), assignmentID); err != nil {
+		t.Fatalf("Promote() should succeed, got error: %v", err)
+	}
+	if entry.Status() != shift.StandbyStatusPromoted || *entry.AssignmentID() != assignmentID {
+		t.Errorf("entry should be promoted with the assignment, got %s", entry.Status())
+	}
+	if entry.IsActive() {
+		t.Errorf("promoted entry should not be active")
+	}
+}
+
+func TestStandbyEntry_Offer_ErrorWhenDeadlineInPast(t *testing.T) {
+	entry := newTestStandbyEntry(t, false)
+	now := time.Now()
+
+	if err := entry.Offer(now, now); err == nil {
+		t.Errorf("expected validation error for a deadline that is not in the future")
+	}
+}
+
+func TestStandbyEntry_Promote_ErrorWhenOfferExpired(t *testing.T) {
+	entry := newTestStandbyEntry(t, false)
+	now := time.Now()
+	_ = entry.Offer(now, now.Add(time.Hour))
+
+	err := entry.Promote(now.Add(time.Hour), shift.NewAssignmentIDWithTime(now))
+	if !errors.Is(err, shift.ErrStandbyOfferExpired) {
+		t.Errorf("expected ErrStandbyOfferExpired, got %v", err)
+	}
+}
+
+// =====================================================
+// Decline / Expire / Withdraw Tests
+// =====================================================
+
+func TestStandbyEntry_Decline_ErrorWhenNotOffered(t *testing.T) {
+	entry := newTestStandbyEntry(t, false)
+
+	if err := entry.Decline(time.Now()); !errors.Is(err, shift.ErrStandbyNotOffered) {
+		t.Errorf("expected ErrStandbyNotOffered, got %v", err)
+	}
+}
+
+func TestStandbyEntry_Expire(t *testing.T) {
+	entry := newTestStandbyEntry(t, false)
+	now := time.Now()
+	_ = entry.Offer(now, now.Add(time.Hour))
+
+	if err := entry.Expire(now.Add(30 * time.Minute)); !errors.Is(err, shift.ErrStandbyNotOffered) {
+		t.Errorf("Expire() before the deadline should fail, got %v", err)
+	}
+	if err := entry.Expire(now.Add(2 * time.Hour)); err != nil {
+		t.Fatalf("Expire() should succeed, got error: %v", err)
+	}
+	if entry.Status() != shift.StandbyStatusExpired {
+		t.Errorf("expected expired, got %s", entry.Status())
+	}
+}
+
+func TestStandbyEntry_Withdraw(t *testing.T) {
+	entry := newTestStandbyEntry(t, true)
+
+	if err := entry.Withdraw(time.Now()); err != nil {
+		t.Fatalf("Withdraw() should succeed, got error: %v", err)
+	}
+	if err := entry.Withdraw(time.Now()); !errors.Is(err, shift.ErrStandbyNotWaiting) {
+		t.Errorf("expected ErrStandbyNotWaiting, got %v", err)
+	}
+}
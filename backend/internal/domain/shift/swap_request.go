@@ -0,0 +1,384 @@
+package shift
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// SwapRequestType represents the kind of swap request
+type SwapRequestType string
+
+const (
+	SwapRequestTypeDrop SwapRequestType = "drop" // 譲渡（引き受けたメンバーに割り当てを渡す）
+	SwapRequestTypeSwap SwapRequestType = "swap" // 交換（引き受けたメンバーの割り当てと入れ替える）
+)
+
+func (t SwapRequestType) Validate() error {
+	switch t {
+	case SwapRequestTypeDrop, SwapRequestTypeSwap:
+		return nil
+	default:
+		return fmt.Errorf("invalid swap request type: %s", t)
+	}
+}
+
+// SwapRequestStatus represents the status of a swap request
+type SwapRequestStatus string
+
+const (
+	SwapRequestStatusOpen            SwapRequestStatus = "open"             // 引き受け募集中
+	SwapRequestStatusPendingApproval SwapRequestStatus = "pending_approval" // 管理者の承認待ち
+	SwapRequestStatusCompleted       SwapRequestStatus = "completed"        // 入れ替え完了
+	SwapRequestStatusRejected        SwapRequestStatus = "rejected"         // 管理者が却下
+	SwapRequestStatusCancelled       SwapRequestStatus = "cancelled"        // 依頼者が取り下げ
+)
+
+func (s SwapRequestStatus) Validate() error {
+	switch s {
+	case SwapRequestStatusOpen, SwapRequestStatusPendingApproval, SwapRequestStatusCompleted,
+		SwapRequestStatusRejected, SwapRequestStatusCancelled:
+		return nil
+	default:
+		return fmt.Errorf("invalid swap request status: %s", s)
+	}
+}
+
+// SwapRequestID represents a swap request identifier
+type SwapRequestID string
+
+// NewSwapRequestIDWithTime creates a new SwapRequestID using the provided time.
+func NewSwapRequestIDWithTime(t time.Time) SwapRequestID {
+	return SwapRequestID(common.NewULIDWithTime(t))
+}
+
+func (id SwapRequestID) String() string {
+	return string(id)
+}
+
+func (id SwapRequestID) Validate() error {
+	if id == "" {
+		return fmt.Errorf("swap_request_id is required")
+	}
+	return common.ValidateULID(string(id))
+}
+
+func ParseSwapRequestID(s string) (SwapRequestID, error) {
+	if err := common.ValidateULID(s); err != nil {
+		return "", err
+	}
+	return SwapRequestID(s), nil
+}
+
+// SwapRequest represents a request to hand over or exchange a confirmed assignment
+// 公開トークンのリンクから他のメンバーが引き受ける。完了後も履歴として残す。
+//
+// 状態遷移:
+//   - open → completed（承認不要の場合、引き受けと同時に入れ替え）
+//   - open → pending_approval → completed / rejected（承認が必要な場合）
+//   - open / pending_approval → cancelled（依頼者による取り下げ）
+type SwapRequest struct {
+	swapRequestID        SwapRequestID
+	tenantID             common.TenantID
+	requestType          SwapRequestType
+	assignmentID         AssignmentID // 依頼者が手放す割り当て
+	requesterMemberID    common.MemberID
+	publicToken          common.PublicToken
+	requiresApproval     bool
+	status               SwapRequestStatus
+	note                 string
+	claimantMemberID     *common.MemberID
+	claimantAssignmentID *AssignmentID // swap の場合に引き受け側が差し出す割り当て
+	newAssignmentID      *AssignmentID // 引き受け側に作成された割り当て
+	counterAssignmentID  *AssignmentID // swap の場合に依頼者に作成された割り当て
+	claimedAt            *time.Time
+	decidedByAdminID     *common.AdminID
+	decidedAt            *time.Time
+	createdAt            time.Time
+	updatedAt            time.Time
+}
+
+// NewSwapRequest creates a new open SwapRequest for the requester's assignment
+func NewSwapRequest(
+	now time.Time,
+	tenantID common.TenantID,
+	requestType SwapRequestType,
+	assignment *ShiftAssignment,
+	requiresApproval bool,
+	note string,
+) (*SwapRequest, error) {
+	if !assignment.IsConfirmed() {
+		return nil, ErrAssignmentNotSwappable
+	}
+
+	req := &SwapRequest{
+		swapRequestID:     NewSwapRequestIDWithTime(now),
+		tenantID:          tenantID,
+		requestType:       requestType,
+		assignmentID:      assignment.AssignmentID(),
+		requesterMemberID: assignment.MemberID(),
+		publicToken:       common.NewPublicToken(),
+		requiresApproval:  requiresApproval,
+		status:            SwapRequestStatusOpen,
+		note:              note,
+		createdAt:         now,
+		updatedAt:         now,
+	}
+
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// ReconstructSwapRequest reconstructs a SwapRequest from persistence
+func ReconstructSwapRequest(
+	swapRequestID SwapRequestID,
+	tenantID common.TenantID,
+	requestType SwapRequestType,
+	assignmentID AssignmentID,
+	requesterMemberID common.MemberID,
+	publicToken common.PublicToken,
+	requiresApproval bool,
+	status SwapRequestStatus,
+	note string,
+	claimantMemberID *common.MemberID,
+	claimantAssignmentID *AssignmentID,
+	newAssignmentID *AssignmentID,
+	counterAssignmentID *AssignmentID,
+	claimedAt *time.Time,
+	decidedByAdminID *common.AdminID,
+	decidedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*SwapRequest, error) {
+	req := &SwapRequest{
+		swapRequestID:        swapRequestID,
+		tenantID:             tenantID,
+		requestType:          requestType,
+		assignmentID:         assignmentID,
+		requesterMemberID:    requesterMemberID,
+		publicToken:          publicToken,
+		requiresApproval:     requiresApproval,
+		status:               status,
+		note:                 note,
+		claimantMemberID:     claimantMemberID,
+		claimantAssignmentID: claimantAssignmentID,
+		newAssignmentID:      newAssignmentID,
+		counterAssignmentID:  counterAssignmentID,
+		claimedAt:            claimedAt,
+		decidedByAdminID:     decidedByAdminID,
+		decidedAt:            decidedAt,
+		createdAt:            createdAt,
+		updatedAt:            updatedAt,
+	}
+
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (r *SwapRequest) validate() error {
+	// TenantID の必須性チェック
+	if err := r.tenantID.Validate(); err != nil {
+		return common.NewValidationError("tenant_id is required", err)
+	}
+
+	// RequestType のバリデーション
+	if err := r.requestType.Validate(); err != nil {
+		return common.NewValidationError("invalid request_type", err)
+	}
+
+	// AssignmentID の必須性チェック
+	if err := r.assignmentID.Validate(); err != nil {
+		return common.NewValidationError("assignment_id is required", err)
+	}
+
+	// RequesterMemberID の必須性チェック
+	if err := r.requesterMemberID.Validate(); err != nil {
+		return common.NewValidationError("requester_member_id is required", err)
+	}
+
+	// PublicToken のバリデーション
+	if err := r.publicToken.Validate(); err != nil {
+		return err
+	}
+
+	// Status のバリデーション
+	if err := r.status.Validate(); err != nil {
+		return common.NewValidationError("invalid status", err)
+	}
+
+	// Note の長さチェック
+	if len(r.note) > 1000 {
+		return common.NewValidationError("note must be less than 1000 characters", nil)
+	}
+
+	// 引き受け済みの状態では引き受けメンバーが必須
+	switch r.status {
+	case SwapRequestStatusPendingApproval, SwapRequestStatusCompleted, SwapRequestStatusRejected:
+		if r.claimantMemberID == nil {
+			return common.NewValidationError("claimant_member_id is required once claimed", nil)
+		}
+		if r.requestType == SwapRequestTypeSwap && r.claimantAssignmentID == nil {
+			return common.NewValidationError("claimant_assignment_id is required for swap requests", nil)
+		}
+	}
+
+	return nil
+}
+
+// Getters
+
+func (r *SwapRequest) SwapRequestID() SwapRequestID {
+	return r.swapRequestID
+}
+
+func (r *SwapRequest) TenantID() common.TenantID {
+	return r.tenantID
+}
+
+func (r *SwapRequest) RequestType() SwapRequestType {
+	return r.requestType
+}
+
+func (r *SwapRequest) AssignmentID() AssignmentID {
+	return r.assignmentID
+}
+
+func (r *SwapRequest) RequesterMemberID() common.MemberID {
+	return r.requesterMemberID
+}
+
+func (r *SwapRequest) PublicToken() common.PublicToken {
+	return r.publicToken
+}
+
+func (r *SwapRequest) RequiresApproval() bool {
+	return r.requiresApproval
+}
+
+func (r *SwapRequest) Status() SwapRequestStatus {
+	return r.status
+}
+
+func (r *SwapRequest) Note() string {
+	return r.note
+}
+
+func (r *SwapRequest) ClaimantMemberID() *common.MemberID {
+	return r.claimantMemberID
+}
+
+func (r *SwapRequest) ClaimantAssignmentID() *AssignmentID {
+	return r.claimantAssignmentID
+}
+
+func (r *SwapRequest) NewAssignmentID() *AssignmentID {
+	return r.newAssignmentID
+}
+
+func (r *SwapRequest) CounterAssignmentID() *AssignmentID {
+	return r.counterAssignmentID
+}
+
+func (r *SwapRequest) ClaimedAt() *time.Time {
+	return r.claimedAt
+}
+
+func (r *SwapRequest) DecidedByAdminID() *common.AdminID {
+	return r.decidedByAdminID
+}
+
+func (r *SwapRequest) DecidedAt() *time.Time {
+	return r.decidedAt
+}
+
+func (r *SwapRequest) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r *SwapRequest) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+func (r *SwapRequest) IsOpen() bool {
+	return r.status == SwapRequestStatusOpen
+}
+
+func (r *SwapRequest) IsPendingApproval() bool {
+	return r.status == SwapRequestStatusPendingApproval
+}
+
+// Claim records the claimant of an open request
+// swap の場合は claimantAssignmentID に引き受け側が差し出す割り当てを指定する
+func (r *SwapRequest) Claim(now time.Time, claimantMemberID common.MemberID, claimantAssignmentID *AssignmentID) error {
+	if !r.IsOpen() {
+		return ErrSwapRequestNotOpen
+	}
+	if claimantMemberID == r.requesterMemberID {
+		return common.NewValidationError("requester cannot claim own swap request", nil)
+	}
+	if r.requestType == SwapRequestTypeSwap && claimantAssignmentID == nil {
+		return common.NewValidationError("claimant_assignment_id is required for swap requests", nil)
+	}
+	if r.requestType == SwapRequestTypeDrop {
+		claimantAssignmentID = nil
+	}
+
+	r.claimantMemberID = &claimantMemberID
+	r.claimantAssignmentID = claimantAssignmentID
+	r.claimedAt = &now
+	r.status = SwapRequestStatusPendingApproval
+	r.updatedAt = now
+	return nil
+}
+
+// Complete marks the request as completed with the assignments created by the swap
+// 承認が必要な場合は decidedBy に承認した管理者を指定する
+func (r *SwapRequest) Complete(now time.Time, newAssignmentID AssignmentID, counterAssignmentID *AssignmentID, decidedBy *common.AdminID) error {
+	if !r.IsPendingApproval() {
+		return ErrSwapRequestNotPending
+	}
+	if r.requiresApproval && decidedBy == nil {
+		return common.NewValidationError("approval is required for this swap request", nil)
+	}
+
+	r.newAssignmentID = &newAssignmentID
+	r.counterAssignmentID = counterAssignmentID
+	r.status = SwapRequestStatusCompleted
+	if decidedBy != nil {
+		r.decidedByAdminID = decidedBy
+		r.decidedAt = &now
+	}
+	r.updatedAt = now
+	return nil
+}
+
+// Reject rejects the claimed request (manager only)
+func (r *SwapRequest) Reject(now time.Time, decidedBy common.AdminID) error {
+	if !r.IsPendingApproval() {
+		return ErrSwapRequestNotPending
+	}
+
+	r.status = SwapRequestStatusRejected
+	r.decidedByAdminID = &decidedBy
+	r.decidedAt = &now
+	r.updatedAt = now
+	return nil
+}
+
+// Cancel withdraws the request before it is completed
+func (r *SwapRequest) Cancel(now time.Time) error {
+	if !r.IsOpen() && !r.IsPendingApproval() {
+		return ErrSwapRequestNotOpen
+	}
+
+	r.status = SwapRequestStatusCancelled
+	r.updatedAt = now
+	return nil
+}
@@ -0,0 +1,38 @@
+package shift
+
+import (
+	"context"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// SwapRequestRepository defines the interface for SwapRequest persistence
+type SwapRequestRepository interface {
+	// Save saves a swap request (insert or update)
+	Save(ctx context.Context, req *SwapRequest) error
+
+	// FindByID finds a swap request by ID within a tenant
+	FindByID(ctx context.Context, tenantID common.TenantID, swapRequestID SwapRequestID) (*SwapRequest, error)
+
+	// FindByIDForUpdate finds a swap request by ID and locks the row until the transaction ends
+	// 承認と取り下げの同時実行を防ぐため、トランザクション内で使用する
+	FindByIDForUpdate(ctx context.Context, tenantID common.TenantID, swapRequestID SwapRequestID) (*SwapRequest, error)
+
+	// FindByTokenForUpdate finds a swap request by public token and locks the row until the transaction ends
+	// 複数メンバーによる同時引き受けを防ぐため、トランザクション内で使用する
+	FindByTokenForUpdate(ctx context.Context, token common.PublicToken) (*SwapRequest, error)
+
+	// FindByToken finds a swap request by public token (認証不要の公開API用)
+	FindByToken(ctx context.Context, token common.PublicToken) (*SwapRequest, error)
+
+	// FindByTenantID finds swap requests within a tenant (newest first)
+	// status が空の場合は全ステータスを返す
+	FindByTenantID(ctx context.Context, tenantID common.TenantID, status SwapRequestStatus) ([]*SwapRequest, error)
+
+	// FindByMemberID finds swap requests where the member is the requester or the claimant (newest first)
+	FindByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*SwapRequest, error)
+
+	// FindActiveByAssignmentID finds the open or pending swap request for an assignment
+	// 同じ割り当てに対する重複依頼の防止に使用。存在しない場合は NotFoundError を返す
+	FindActiveByAssignmentID(ctx context.Context, tenantID common.TenantID, assignmentID AssignmentID) (*SwapRequest, error)
+}
@@ -0,0 +1,174 @@
+package shift_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+)
+
+func newTestSwapRequest(t *testing.T, requestType shift.SwapRequestType, requiresApproval bool) *shift.SwapRequest {
+	t.Helper()
+	tenantID := common.NewTenantID()
+	assignment, err := shift.NewShiftAssignment(time.Now(), tenantID, "", shift.NewSlotID(), common.NewMemberID(), shift.AssignmentMethodManual, false)
+	if err != nil {
+		t.Fatalf("NewShiftAssignment() should succeed, got error: %v", err)
+	}
+	req, err := shift.NewSwapRequest(time.Now(), tenantID, requestType, assignment, requiresApproval, "急用のため")
+	if err != nil {
+		t.Fatalf("NewSwapRequest() should succeed, got error: %v", err)
+	}
+	return req
+}
+
+// =====================================================
+// NewSwapRequest Tests
+// =====================================================
+
+func TestNewSwapRequest_Success(t *testing.T) {
+	req := newTestSwapRequest(t, shift.SwapRequestTypeDrop, false)
+
+	if !req.IsOpen() {
+		t.Errorf("new swap request should be open, got %s", req.Status())
+	}
+	if err := req.PublicToken().Validate(); err != nil {
+		t.Errorf("PublicToken should be a valid UUID, got error: %v", err)
+	}
+	if req.ClaimantMemberID() != nil {
+		t.Errorf("ClaimantMemberID should be nil before claim")
+	}
+}
+
+func TestNewSwapRequest_ErrorWhenAssignmentCancelled(t *testing.T) {
+	assignment, err := shift.NewShiftAssignment(time.Now(), common.NewTenantID(), "", shift.NewSlotID(), common.NewMemberID(), shift.AssignmentMethodManual, false)
+	if err != nil {
+		t.Fatalf("NewShiftAssignment() should succeed, got error: %v", err)
+	}
+	_ = assignment.Cancel(time.Now())
+
+	_, err = shift.NewSwapRequest(time.Now(), assignment.TenantID(), shift.SwapRequestTypeDrop, assignment, false, "")
+	if !errors.Is(err, shift.ErrAssignmentNotSwappable) {
+		t.Errorf("expected ErrAssignmentNotSwappable, got %v", err)
+	}
+}
+
+func TestNewSwapRequest_ErrorWhenInvalidType(t *testing.T) {
+	assignment, err := shift.NewShiftAssignment(time.Now(), common.NewTenantID(), "", shift.NewSlotID(), common.NewMemberID(), shift.AssignmentMethodManual, false)
+	if err != nil {
+		t.Fatalf("NewShiftAssignment() should succeed, got error: %v", err)
+	}
+
+	if _, err := shift.NewSwapRequest(time.Now(), assignment.TenantID(), "trade", assignment, false, ""); err == nil {
+		t.Errorf("expected validation error for invalid request type")
+	}
+}
+
+// =====================================================
+// Claim Tests
+// =====================================================
+
+func TestSwapRequest_Claim(t *testing.T) {
+	req := newTestSwapRequest(t, shift.SwapRequestTypeDrop, false)
+	claimant := common.NewMemberID()
+
+	if err := req.Claim(time.Now(), claimant, nil); err != nil {
+		t.Fatalf("Claim() should succeed, got error: %v", err)
+	}
+	if !req.IsPendingApproval() {
+		t.Errorf("claimed request should be pending, got %s", req.Status())
+	}
+	if *req.ClaimantMemberID() != claimant || req.ClaimedAt() == nil {
+		t.Errorf("claimant should be recorded")
+	}
+
+	// 2人目の引き受けは不可
+	if err := req.Claim(time.Now(), common.NewMemberID(), nil); !errors.Is(err, shift.ErrSwapRequestNotOpen) {
+		t.Errorf("expected ErrSwapRequestNotOpen, got %v", err)
+	}
+}
+
+func TestSwapRequest_Claim_ErrorWhenRequesterClaims(t *testing.T) {
+	req := newTestSwapRequest(t, shift.SwapRequestTypeDrop, false)
+
+	if err := req.Claim(time.Now(), req.RequesterMemberID(), nil); err == nil {
+		t.Errorf("requester should not be able to claim own request")
+	}
+}
+
+func TestSwapRequest_Claim_SwapRequiresClaimantAssignment(t *testing.T) {
+	req := newTestSwapRequest(t, shift.SwapRequestTypeSwap, false)
+
+	if err := req.Claim(time.Now(), common.NewMemberID(), nil); err == nil {
+		t.Errorf("swap request should require claimant assignment")
+	}
+
+	offered := shift.NewAssignmentIDWithTime(time.Now())
+	if err := req.Claim(time.Now(), common.NewMemberID(), &offered); err != nil {
+		t.Fatalf("Claim() should succeed, got error: %v", err)
+	}
+	if *req.ClaimantAssignmentID() != offered {
+		t.Errorf("ClaimantAssignmentID: expected %s", offered)
+	}
+}
+
+// =====================================================
+// Complete / Reject / Cancel Tests
+// =====================================================
+
+func TestSwapRequest_Complete_RequiresApprover(t *testing.T) {
+	req := newTestSwapRequest(t, shift.SwapRequestTypeDrop, true)
+	_ = req.Claim(time.Now(), common.NewMemberID(), nil)
+	newID := shift.NewAssignmentIDWithTime(time.Now())
+
+	if err := req.Complete(time.Now(), newID, nil, nil); err == nil {
+		t.Errorf("Complete() without approver should fail when approval is required")
+	}
+
+	adminID := common.NewAdminID()
+	if err := req.Complete(time.Now(), newID, nil, &adminID); err != nil {
+		t.Fatalf("Complete() should succeed, got error: %v", err)
+	}
+	if req.Status() != shift.SwapRequestStatusCompleted {
+		t.Errorf("expected completed, got %s", req.Status())
+	}
+	if *req.NewAssignmentID() != newID || *req.DecidedByAdminID() != adminID {
+		t.Errorf("new assignment and approver should be recorded")
+	}
+}
+
+func TestSwapRequest_Complete_ErrorWhenNotClaimed(t *testing.T) {
+	req := newTestSwapRequest(t, shift.SwapRequestTypeDrop, false)
+
+	err := req.Complete(time.Now(), shift.NewAssignmentIDWithTime(time.Now()), nil, nil)
+	if !errors.Is(err, shift.ErrSwapRequestNotPending) {
+		t.Errorf("expected ErrSwapRequestNotPending, got %v", err)
+	}
+}
+
+func TestSwapRequest_Reject(t *testing.T) {
+	req := newTestSwapRequest(t, shift.SwapRequestTypeDrop, true)
+	_ = req.Claim(time.Now(), common.NewMemberID(), nil)
+
+	if err := req.Reject(time.Now(), common.NewAdminID()); err != nil {
+		t.Fatalf("Reject() should succeed, got error: %v", err)
+	}
+	if req.Status() != shift.SwapRequestStatusRejected || req.DecidedAt() == nil {
+		t.Errorf("expected rejected with decided_at, got %s", req.Status())
+	}
+}
+
+func TestSwapRequest_Cancel(t *testing.T) {
+	req := newTestSwapRequest(t, shift.SwapRequestTypeDrop, false)
+
+	if err := req.Cancel(time.Now()); err != nil {
+		t.Fatalf("Cancel() should succeed, got error: %v", err)
+	}
+	if req.Status() != shift.SwapRequestStatusCancelled {
+		t.Errorf("expected cancelled, got %s", req.Status())
+	}
+	if err := req.Cancel(time.Now()); !errors.Is(err, shift.ErrSwapRequestNotOpen) {
+		t.Errorf("expected ErrSwapRequestNotOpen, got %v", err)
+	}
+}
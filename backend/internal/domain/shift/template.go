@@ -8,6 +8,7 @@ import (
 )
 
 // ShiftSlotTemplate represents a template for shift slots
+// 内容を更新するたびに version が増え、営業日は生成元のバージョンを記録する
 type ShiftSlotTemplate struct {
 	templateID   common.ShiftSlotTemplateID
 	tenantID     common.TenantID
@@ -15,6 +16,7 @@ type ShiftSlotTemplate struct {
 	templateName string
 	description  string
 	items        []*ShiftSlotTemplateItem
+	version      int
 	createdAt    time.Time
 	updatedAt    time.Time
 	deletedAt    *time.Time
@@ -22,16 +24,17 @@ type ShiftSlotTemplate struct {
 
 // ShiftSlotTemplateItem represents an individual shift slot in a template
 type ShiftSlotTemplateItem struct {
-	itemID        common.ShiftSlotTemplateItemID
-	templateID    common.ShiftSlotTemplateID
-	slotName      string
-	instanceName  string
-	startTime     time.Time
-	endTime       time.Time
-	requiredCount int
-	priority      int
-	createdAt     time.Time
-	updatedAt     time.Time
+	itemID           common.ShiftSlotTemplateItemID
+	templateID       common.ShiftSlotTemplateID
+	slotName         string
+	instanceName     string
+	startTime        time.Time
+	endTime          time.Time
+	requiredCount    int
+	priority         int
+	roleRequirements []RoleRequirement // 生成される枠にコピーされる
+	createdAt        time.Time
+	updatedAt        time.Time
 }
 
 // NewShiftSlotTemplate creates a new shift slot template
@@ -58,6 +61,7 @@ func NewShiftSlotTemplate(
 		templateName: templateName,
 		description:  description,
 		items:        items,
+		version:      1,
 		createdAt:    now,
 		updatedAt:    now,
 	}
@@ -120,6 +124,7 @@ func ReconstructShiftSlotTemplate(
 	templateName string,
 	description string,
 	items []*ShiftSlotTemplateItem,
+	version int,
 	createdAt time.Time,
 	updatedAt time.Time,
 	deletedAt *time.Time,
@@ -131,6 +136,7 @@ func ReconstructShiftSlotTemplate(
 		templateName: templateName,
 		description:  description,
 		items:        items,
+		version:      version,
 		createdAt:    createdAt,
 		updatedAt:    updatedAt,
 		deletedAt:    deletedAt,
@@ -153,27 +159,33 @@ func ReconstructShiftSlotTemplateItem(
 	endTime time.Time,
 	requiredCount int,
 	priority int,
+	roleRequirements []RoleRequirement,
 	createdAt time.Time,
 	updatedAt time.Time,
 ) (*ShiftSlotTemplateItem, error) {
 	if slotName == "" {
 		return nil, fmt.Errorf("slot_name is required")
 	}
+	if err := validateRoleRequirements(roleRequirements, requiredCount); err != nil {
+		return nil, err
+	}
 	return &ShiftSlotTemplateItem{
-		itemID:        itemID,
-		templateID:    templateID,
-		slotName:      slotName,
-		instanceName:  instanceName,
-		startTime:     startTime,
-		endTime:       endTime,
-		requiredCount: requiredCount,
-		priority:      priority,
-		createdAt:     createdAt,
-		updatedAt:     updatedAt,
+		itemID:           itemID,
+		templateID:       templateID,
+		slotName:         slotName,
+		instanceName:     instanceName,
+		startTime:        startTime,
+		endTime:          endTime,
+		requiredCount:    requiredCount,
+		priority:         priority,
+		roleRequirements: roleRequirements,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
 	}, nil
 }
 
 // UpdateDetails updates the template details
+// 更新のたびに version を 1 増やす（生成済みの営業日との差分検出に使用）
 func (t *ShiftSlotTemplate) UpdateDetails(now time.Time, templateName, description string, items []*ShiftSlotTemplateItem) error {
 	// Validate before mutating using a temporary copy
 	tmp := *t
@@ -193,6 +205,7 @@ func (t *ShiftSlotTemplate) UpdateDetails(now time.Time, templateName, descripti
 	t.templateName = templateName
 	t.description = description
 	t.items = items
+	t.version++
 	t.updatedAt = now
 
 	return nil
@@ -205,9 +218,46 @@ func (t *ShiftSlotTemplate) validate() error {
 	if len(t.templateName) > 100 {
 		return fmt.Errorf("template_name must be 100 characters or less")
 	}
+	if t.version < 1 {
+		return fmt.Errorf("version must be at least 1")
+	}
 	return nil
 }
 
+// CopyTo creates a new template of another event with copies of the items
+// version は引き継ぐ（複製した営業日の適用済みバージョンと対応させるため）
+func (t *ShiftSlotTemplate) CopyTo(now time.Time, eventID common.EventID) (*ShiftSlotTemplate, error) {
+	if err := eventID.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid event_id: %w", err)
+	}
+
+	copied := &ShiftSlotTemplate{
+		templateID:   common.NewShiftSlotTemplateID(),
+		tenantID:     t.tenantID,
+		eventID:      eventID,
+		templateName: t.templateName,
+		description:  t.description,
+		version:      t.version,
+		createdAt:    now,
+		updatedAt:    now,
+	}
+	for _, item := range t.items {
+		copiedItem := *item
+		copiedItem.itemID = common.NewShiftSlotTemplateItemID()
+		copiedItem.templateID = copied.templateID
+		copiedItem.roleRequirements = item.RoleRequirements()
+		copiedItem.createdAt = now
+		copiedItem.updatedAt = now
+		copied.items = append(copied.items, &copiedItem)
+	}
+
+	if err := copied.validate(); err != nil {
+		return nil, err
+	}
+
+	return copied, nil
+}
+
 // Delete soft-deletes the template
 func (t *ShiftSlotTemplate) Delete(now time.Time) {
 	t.deletedAt = &now
@@ -239,6 +289,11 @@ func (t *ShiftSlotTemplate) Items() []*ShiftSlotTemplateItem {
 	return t.items
 }
 
+// Version returns the version of the template (incremented on every update)
+func (t *ShiftSlotTemplate) Version() int {
+	return t.version
+}
+
 func (t *ShiftSlotTemplate) CreatedAt() time.Time {
 	return t.createdAt
 }
@@ -284,6 +339,22 @@ func (i *ShiftSlotTemplateItem) Priority() int {
 	return i.priority
 }
 
+// RoleRequirements returns the role requirements copied onto generated slots
+func (i *ShiftSlotTemplateItem) RoleRequirements() []RoleRequirement {
+	return append([]RoleRequirement(nil), i.roleRequirements...)
+}
+
+// SetRoleRequirements replaces the role requirements of the item
+func (i *ShiftSlotTemplateItem) SetRoleRequirements(now time.Time, requirements []RoleRequirement) error {
+	if err := validateRoleRequirements(requirements, i.requiredCount); err != nil {
+		return err
+	}
+
+	i.roleRequirements = append([]RoleRequirement(nil), requirements...)
+	i.updatedAt = now
+	return nil
+}
+
 func (i *ShiftSlotTemplateItem) CreatedAt() time.Time {
 	return i.createdAt
 }
@@ -0,0 +1,68 @@
+package shift
+
+// TemplateSlotChangeType represents how a shift slot changes when a template is re-applied
+type TemplateSlotChangeType string
+
+const (
+	TemplateSlotChangeAdd    TemplateSlotChangeType = "add"    // テンプレートに追加された枠を作成する
+	TemplateSlotChangeUpdate TemplateSlotChangeType = "update" // 時刻・人数などを更新する（割り当ては保持）
+	TemplateSlotChangeRemove TemplateSlotChangeType = "remove" // テンプレートから削除された枠を削除する
+)
+
+// TemplateSlotChange represents a single change needed to bring a business day's slots up to a template
+// Add の場合は Slot が nil、Remove の場合は Item が nil
+type TemplateSlotChange struct {
+	Type TemplateSlotChangeType
+	Item *ShiftSlotTemplateItem
+	Slot *ShiftSlot
+}
+
+// DiffTemplateSlots computes the changes needed to make the slots of a business day match the template
+//
+// 対応付けのルール:
+//   - 枠名とインスタンス名が同じ枠をテンプレートのアイテムと対応付ける（同名が複数ある場合は先頭から順に対応付ける）
+//   - 対応する枠がないアイテムは Add、定義が異なる枠は Update とする
+//   - 対応するアイテムがない枠のうち、このテンプレートから生成された枠のみ Remove とする
+//     （手動で追加した枠・他のテンプレートから生成された枠は残す）
+func DiffTemplateSlots(template *ShiftSlotTemplate, slots []*ShiftSlot) []TemplateSlotChange {
+	matched := make(map[SlotID]bool, len(slots))
+	var changes []TemplateSlotChange
+
+	for _, item := range template.Items() {
+		slot := findUnmatchedSlot(slots, matched, item)
+		if slot == nil {
+			changes = append(changes, TemplateSlotChange{Type: TemplateSlotChangeAdd, Item: item})
+			continue
+		}
+		matched[slot.SlotID()] = true
+
+		if !slot.MatchesTemplateItem(item) {
+			changes = append(changes, TemplateSlotChange{Type: TemplateSlotChangeUpdate, Item: item, Slot: slot})
+		}
+	}
+
+	for _, slot := range slots {
+		if slot.IsDeleted() || matched[slot.SlotID()] {
+			continue
+		}
+		if slot.TemplateID() == nil || *slot.TemplateID() != template.TemplateID() {
+			continue
+		}
+		changes = append(changes, TemplateSlotChange{Type: TemplateSlotChangeRemove, Slot: slot})
+	}
+
+	return changes
+}
+
+// findUnmatchedSlot returns the first slot not yet matched with the same slot name and instance name as the item
+func findUnmatchedSlot(slots []*ShiftSlot, matched map[SlotID]bool, item *ShiftSlotTemplateItem) *ShiftSlot {
+	for _, slot := range slots {
+		if slot.IsDeleted() || matched[slot.SlotID()] {
+			continue
+		}
+		if slot.SlotName() == item.SlotName() && slot.InstanceName() == item.InstanceName() {
+			return slot
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package shift
+
+import (
+	"testing"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+func createTestTemplateItem(t *testing.T, templateID common.ShiftSlotTemplateID, slotName string, startHour, endHour, requiredCount int) *ShiftSlotTemplateItem {
+	item, err := NewShiftSlotTemplateItem(
+		time.Now(),
+		templateID,
+		slotName,
+		"",
+		time.Date(2000, 1, 1, startHour, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, endHour, 0, 0, 0, time.UTC),
+		requiredCount,
+		1,
+	)
+	if err != nil {
+		t.Fatalf("createTestTemplateItem() failed: %v", err)
+	}
+	return item
+}
+
+func TestDiffTemplateSlots(t *testing.T) {
+	tenantID := common.NewTenantID()
+	templateID := common.NewShiftSlotTemplateID()
+	otherTemplateID := common.NewShiftSlotTemplateID()
+	now := time.Now()
+
+	// 現在の営業日の枠: 受付（変更なし）・DJ（時刻変更）・撤去（テンプレートから削除）・手動追加・他テンプレート
+	reception := createTestSlot(t, tenantID, "受付", time.Date(2000, 1, 1, 20, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC), 2)
+	dj := createTestSlot(t, tenantID, "DJ", time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC), 1)
+	teardown := createTestSlot(t, tenantID, "撤去", time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 30, 0, 0, time.UTC), 1)
+	manual := createTestSlot(t, tenantID, "臨時", time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC), 1)
+	other := createTestSlot(t, tenantID, "照明", time.Date(2000, 1, 1, 20, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC), 1)
+	for _, s := range []*ShiftSlot{reception, dj, teardown} {
+		s.MarkFromTemplate(now, templateID)
+	}
+	other.MarkFromTemplate(now, otherTemplateID)
+
+	template, err := ReconstructShiftSlotTemplate(
+		templateID,
+		tenantID,
+		common.NewEventID(),
+		"定期営業",
+		"",
+		[]*ShiftSlotTemplateItem{
+			createTestTemplateItem(t, templateID, "受付", 20, 22, 2),
+			createTestTemplateItem(t, templateID, "DJ", 22, 23, 1),
+			createTestTemplateItem(t, templateID, "写真", 21, 22, 1),
+		},
+		2,
+		now,
+		now,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("ReconstructShiftSlotTemplate() failed: %v", err)
+	}
+
+	changes := DiffTemplateSlots(template, []*ShiftSlot{reception, dj, teardown, manual, other})
+
+	if len(changes) != 3 {
+		t.Fatalf("expected 3 changes, got %d: %+v", len(changes), changes)
+	}
+	if changes[0].Type != TemplateSlotChangeUpdate || changes[0].Slot != dj || changes[0].Item.SlotName() != "DJ" {
+		t.Errorf("changes[0] should update DJ: %+v", changes[0])
+	}
+	if changes[1].Type != TemplateSlotChangeAdd || changes[1].Slot != nil || changes[1].Item.SlotName() != "写真" {
+		t.Errorf("changes[1] should add 写真: %+v", changes[1])
+	}
+	if changes[2].Type != TemplateSlotChangeRemove || changes[2].Slot != teardown || changes[2].Item != nil {
+		t.Errorf("changes[2] should remove 撤去: %+v", changes[2])
+	}
+}
+
+func TestDiffTemplateSlots_MatchesDuplicateNamesInOrder(t *testing.T) {
+	tenantID := common.NewTenantID()
+	templateID := common.NewShiftSlotTemplateID()
+	now := time.Now()
+
+	first := createTestSlot(t, tenantID, "スタッフ", time.Date(2000, 1, 1, 20, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), 1)
+	second := createTestSlot(t, tenantID, "スタッフ", time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC), 1)
+	first.MarkFromTemplate(now, templateID)
+	second.MarkFromTemplate(now, templateID)
+
+	template, err := ReconstructShiftSlotTemplate(
+		templateID,
+		tenantID,
+		common.NewEventID(),
+		"定期営業",
+		"",
+		[]*ShiftSlotTemplateItem{
+			createTestTemplateItem(t, templateID, "スタッフ", 20, 21, 1),
+		},
+		3,
+		now,
+		now,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("ReconstructShiftSlotTemplate() failed: %v", err)
+	}
+
+	changes := DiffTemplateSlots(template, []*ShiftSlot{first, second})
+
+	if len(changes) != 1 {
+		t.Fatalf("expected 1 change, got %d: %+v", len(changes), changes)
+	}
+	if changes[0].Type != TemplateSlotChangeRemove || changes[0].Slot != second {
+		t.Errorf("the second スタッフ slot should be removed: %+v", changes[0])
+	}
+}
@@ -374,6 +374,7 @@ func TestReconstructShiftSlotTemplate_Success(t *testing.T) {
 		"Test Template",
 		"Description",
 		[]*shift.ShiftSlotTemplateItem{},
+		1,
 		createdAt,
 		updatedAt,
 		nil,
@@ -410,6 +411,7 @@ func TestReconstructShiftSlotTemplate_Success_Deleted(t *testing.T) {
 		"Test Template",
 		"",
 		[]*shift.ShiftSlotTemplateItem{},
+		1,
 		createdAt,
 		updatedAt,
 		&deletedAt,
@@ -444,6 +446,7 @@ func TestReconstructShiftSlotTemplateItem_Success(t *testing.T) {
 		endTime,
 		3,
 		2,
+		nil,
 		createdAt,
 		updatedAt,
 	)
@@ -648,3 +651,97 @@ func TestShiftSlotTemplate_Delete(t *testing.T) {
 		t.Error("DeletedAt should be set after Delete()")
 	}
 }
+
+// =====================================================
+// Template Version Tests
+// =====================================================
+
+func TestShiftSlotTemplate_Version_IncrementedOnUpdate(t *testing.T) {
+	template, _ := shift.NewShiftSlotTemplate(
+		time.Now(),
+		common.NewTenantID(),
+		common.NewEventID(),
+		"Original Name",
+		"",
+		[]*shift.ShiftSlotTemplateItem{},
+	)
+
+	if template.Version() != 1 {
+		t.Fatalf("Version should start at 1: got %v", template.Version())
+	}
+
+	item, _ := shift.NewShiftSlotTemplateItem(
+		time.Now(),
+		template.TemplateID(),
+		"DJ Slot",
+		"",
+		time.Date(2000, 1, 1, 20, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC),
+		1,
+		1,
+	)
+
+	if err := template.UpdateDetails(time.Now(), "Updated Name", "", []*shift.ShiftSlotTemplateItem{item}); err != nil {
+		t.Fatalf("UpdateDetails() should succeed: %v", err)
+	}
+	if template.Version() != 2 {
+		t.Errorf("Version should be incremented: got %v, want 2", template.Version())
+	}
+
+	// 失敗した更新ではバージョンは変わらない
+	if err := template.UpdateDetails(time.Now(), "", "", []*shift.ShiftSlotTemplateItem{item}); err == nil {
+		t.Fatal("UpdateDetails() should fail when template_name is empty")
+	}
+	if template.Version() != 2 {
+		t.Errorf("Version should not change on failed update: got %v, want 2", template.Version())
+	}
+}
+
+func TestReconstructShiftSlotTemplate_ErrorWhenVersionZero(t *testing.T) {
+	_, err := shift.ReconstructShiftSlotTemplate(
+		common.NewShiftSlotTemplateID(),
+		common.NewTenantID(),
+		common.NewEventID(),
+		"Test Template",
+		"",
+		[]*shift.ShiftSlotTemplateItem{},
+		0,
+		time.Now(),
+		time.Now(),
+		nil,
+	)
+	if err == nil {
+		t.Error("ReconstructShiftSlotTemplate() should fail when version is 0")
+	}
+}
+
+func TestShiftSlotTemplate_CopyTo(t *testing.T) {
+	now := time.Now()
+	templateID := common.NewShiftSlotTemplateID()
+	item, _ := shift.NewShiftSlotTemplateItem(now, templateID, "受付", "Main", time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC), time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC), 2, 1)
+	requirement, _ := shift.NewRoleRequirement(common.NewRoleID(), shift.RoleRequirementRequired, 1)
+	if err := item.SetRoleRequirements(now, []shift.RoleRequirement{requirement}); err != nil {
+		t.Fatalf("SetRoleRequirements() failed: %v", err)
+	}
+	template, _ := shift.ReconstructShiftSlotTemplate(templateID, common.NewTenantID(), common.NewEventID(), "Test Template", "Desc", []*shift.ShiftSlotTemplateItem{item}, 3, now, now, nil)
+
+	eventID := common.NewEventID()
+	copied, err := template.CopyTo(now, eventID)
+	if err != nil {
+		t.Fatalf("CopyTo() should succeed, got error: %v", err)
+	}
+
+	if copied.TemplateID() == template.TemplateID() || copied.EventID() != eventID || copied.Version() != 3 {
+		t.Errorf("unexpected copied template: id=%s event=%s version=%d", copied.TemplateID(), copied.EventID(), copied.Version())
+	}
+	if len(copied.Items()) != 1 {
+		t.Fatalf("expected 1 item, got %d", len(copied.Items()))
+	}
+	copiedItem := copied.Items()[0]
+	if copiedItem.ItemID() == item.ItemID() || copiedItem.TemplateID() != copied.TemplateID() {
+		t.Errorf("copied item should have a new ID and belong to the copied template")
+	}
+	if copiedItem.SlotName() != "受付" || copiedItem.RequiredCount() != 2 || len(copiedItem.RoleRequirements()) != 1 {
+		t.Errorf("copied item should keep the definition: %+v", copiedItem)
+	}
+}
@@ -0,0 +1,183 @@
+package tenant
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// BotTokenPrefix is the prefix of every bot token (漏えい時にトークンの種類を判別できるようにする)
+const BotTokenPrefix = "vrcs_bot_"
+
+// botTokenDisplayLength is the number of leading characters kept for display
+const botTokenDisplayLength = len(BotTokenPrefix) + 6
+
+// BotTokenID represents a bot token identifier
+type BotTokenID string
+
+// NewBotTokenIDWithTime creates a new BotTokenID using the provided time.
+func NewBotTokenIDWithTime(t time.Time) BotTokenID {
+	return BotTokenID(common.NewULIDWithTime(t))
+}
+
+func (id BotTokenID) String() string {
+	return string(id)
+}
+
+func (id BotTokenID) Validate() error {
+	if id == "" {
+		return fmt.Errorf("token_id is required")
+	}
+	return common.ValidateULID(string(id))
+}
+
+// ParseBotTokenID parses a string into a BotTokenID
+func ParseBotTokenID(s string) (BotTokenID, error) {
+	id := BotTokenID(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// BotToken represents a service token with which the Discord bot calls the API on behalf of the tenant's members
+// トークン本体は発行時にのみ返し、永続化するのは SHA-256 ハッシュのみ
+type BotToken struct {
+	tokenID     BotTokenID
+	tenantID    common.TenantID
+	name        string
+	tokenHash   string
+	tokenPrefix string // 一覧表示用のトークン先頭部分
+	createdAt   time.Time
+	revokedAt   *time.Time
+}
+
+// NewBotToken issues a new bot token and returns it with the plain token
+func NewBotToken(now time.Time, tenantID common.TenantID, name string) (*BotToken, string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return nil, "", fmt.Errorf("failed to generate bot token: %w", err)
+	}
+	plain := BotTokenPrefix + hex.EncodeToString(bytes)
+
+	token := &BotToken{
+		tokenID:     NewBotTokenIDWithTime(now),
+		tenantID:    tenantID,
+		name:        strings.TrimSpace(name),
+		tokenHash:   HashBotToken(plain),
+		tokenPrefix: plain[:botTokenDisplayLength],
+		createdAt:   now,
+	}
+
+	if err := token.validate(); err != nil {
+		return nil, "", err
+	}
+
+	return token, plain, nil
+}
+
+// ReconstructBotToken reconstructs a BotToken from persistence
+func ReconstructBotToken(
+	tokenID BotTokenID,
+	tenantID common.TenantID,
+	name string,
+	tokenHash string,
+	tokenPrefix string,
+	createdAt time.Time,
+	revokedAt *time.Time,
+) (*BotToken, error) {
+	token := &BotToken{
+		tokenID:     tokenID,
+		tenantID:    tenantID,
+		name:        name,
+		tokenHash:   tokenHash,
+		tokenPrefix: tokenPrefix,
+		createdAt:   createdAt,
+		revokedAt:   revokedAt,
+	}
+
+	if err := token.validate(); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (t *BotToken) validate() error {
+	if err := t.tokenID.Validate(); err != nil {
+		return common.NewValidationError("token_id is invalid", err)
+	}
+	if err := t.tenantID.Validate(); err != nil {
+		return common.NewValidationError("tenant_id is required", err)
+	}
+	if t.name == "" {
+		return common.NewValidationError("name is required", nil)
+	}
+	if len(t.name) > 100 {
+		return common.NewValidationError("name must be less than 100 characters", nil)
+	}
+	if len(t.tokenHash) != 64 {
+		return common.NewValidationError("token_hash must be a SHA-256 hex digest", nil)
+	}
+	return nil
+}
+
+// HashBotToken computes the SHA-256 hash of a bot token
+func HashBotToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// IsBotToken reports whether the string looks like a bot token
+func IsBotToken(token string) bool {
+	return strings.HasPrefix(token, BotTokenPrefix)
+}
+
+// Revoke revokes the token (取り消したトークンでは認証できない)
+func (t *BotToken) Revoke(now time.Time) error {
+	if t.IsRevoked() {
+		return common.NewConflictError("bot token is already revoked")
+	}
+	t.revokedAt = &now
+	return nil
+}
+
+// IsRevoked returns true if the token has been revoked
+func (t *BotToken) IsRevoked() bool {
+	return t.revokedAt != nil
+}
+
+// Getters
+
+func (t *BotToken) TokenID() BotTokenID {
+	return t.tokenID
+}
+
+func (t *BotToken) TenantID() common.TenantID {
+	return t.tenantID
+}
+
+func (t *BotToken) Name() string {
+	return t.name
+}
+
+func (t *BotToken) TokenHash() string {
+	return t.tokenHash
+}
+
+func (t *BotToken) TokenPrefix() string {
+	return t.tokenPrefix
+}
+
+func (t *BotToken) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *BotToken) RevokedAt() *time.Time {
+	return t.revokedAt
+}
@@ -0,0 +1,22 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// BotTokenRepository defines the interface for bot token persistence
+type BotTokenRepository interface {
+	// Save saves a bot token (insert or update)
+	Save(ctx context.Context, token *BotToken) error
+
+	// FindByID finds a bot token by ID within a tenant
+	FindByID(ctx context.Context, tenantID common.TenantID, tokenID BotTokenID) (*BotToken, error)
+
+	// FindByHash finds a bot token by the hash of the plain token (取り消し済みも含む)
+	FindByHash(ctx context.Context, tokenHash string) (*BotToken, error)
+
+	// FindByTenantID finds all bot tokens within a tenant (created_at descending)
+	FindByTenantID(ctx context.Context, tenantID common.TenantID) ([]*BotToken, error)
+}
@@ -0,0 +1,90 @@
+package tenant_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
+)
+
+// =====================================================
+// BotToken Entity Tests
+// =====================================================
+
+func TestNewBotToken_Success(t *testing.T) {
+	now := time.Now()
+	tenantID := common.NewTenantID()
+
+	token, plain, err := tenant.NewBotToken(now, tenantID, "  Discord Bot  ")
+	if err != nil {
+		t.Fatalf("NewBotToken() should succeed, got error: %v", err)
+	}
+
+	if !tenant.IsBotToken(plain) {
+		t.Errorf("plain token should start with %q: got %v", tenant.BotTokenPrefix, plain)
+	}
+	if token.TokenHash() != tenant.HashBotToken(plain) {
+		t.Error("TokenHash should be the hash of the plain token")
+	}
+	if strings.Contains(token.TokenHash(), plain) {
+		t.Error("TokenHash must not contain the plain token")
+	}
+	if !strings.HasPrefix(plain, token.TokenPrefix()) {
+		t.Errorf("TokenPrefix should be the head of the plain token: got %v", token.TokenPrefix())
+	}
+	if token.Name() != "Discord Bot" {
+		t.Errorf("Name should be trimmed: got %q", token.Name())
+	}
+	if token.TenantID() != tenantID {
+		t.Errorf("TenantID mismatch: got %v, want %v", token.TenantID(), tenantID)
+	}
+	if token.IsRevoked() {
+		t.Error("New token should not be revoked")
+	}
+}
+
+func TestNewBotToken_UniquePlainToken(t *testing.T) {
+	now := time.Now()
+	tenantID := common.NewTenantID()
+
+	_, first, err := tenant.NewBotToken(now, tenantID, "bot")
+	if err != nil {
+		t.Fatalf("NewBotToken() failed: %v", err)
+	}
+	_, second, err := tenant.NewBotToken(now, tenantID, "bot")
+	if err != nil {
+		t.Fatalf("NewBotToken() failed: %v", err)
+	}
+
+	if first == second {
+		t.Error("each issued token should be unique")
+	}
+}
+
+func TestNewBotToken_ErrorWhenNameEmpty(t *testing.T) {
+	_, _, err := tenant.NewBotToken(time.Now(), common.NewTenantID(), "   ")
+	if err == nil {
+		t.Fatal("NewBotToken() should fail when name is empty")
+	}
+}
+
+func TestBotToken_Revoke(t *testing.T) {
+	now := time.Now()
+	token, _, err := tenant.NewBotToken(now, common.NewTenantID(), "bot")
+	if err != nil {
+		t.Fatalf("NewBotToken() failed: %v", err)
+	}
+
+	if err := token.Revoke(now.Add(time.Hour)); err != nil {
+		t.Fatalf("Revoke() should succeed, got error: %v", err)
+	}
+	if !token.IsRevoked() {
+		t.Error("token should be revoked")
+	}
+
+	if err := token.Revoke(now.Add(2 * time.Hour)); err == nil {
+		t.Error("Revoke() should fail when already revoked")
+	}
+}
@@ -229,6 +229,12 @@ func (t *Tenant) Timezone() string {
 	return t.timezone
 }
 
+// Location returns the tenant's time zone
+// 日付境界（営業日生成・締切・「過去/未来」判定など）はすべてこのロケーションで計算する
+func (t *Tenant) Location() *time.Location {
+	return LoadLocation(t.timezone)
+}
+
 func (t *Tenant) IsActive() bool {
 	return t.isActive
 }
@@ -287,6 +293,9 @@ func (t *Tenant) UpdateTimezone(now time.Time, timezone string) error {
 	if len(timezone) > 50 {
 		return common.NewValidationError("timezone must be less than 50 characters", nil)
 	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return common.NewValidationError("invalid timezone format", err)
+	}
 
 	t.timezone = timezone
 	t.updatedAt = now
@@ -144,6 +144,38 @@ func TestTenant_UpdateTimezone_ErrorWhenEmpty(t *testing.T) {
 	}
 }
 
+func TestTenant_UpdateTimezone_ErrorWhenInvalid(t *testing.T) {
+	now := time.Now()
+	ten, _ := tenant.NewTenant(now, "Test Organization", "Asia/Tokyo")
+
+	err := ten.UpdateTimezone(now, "Invalid/Timezone")
+
+	if err == nil {
+		t.Fatal("UpdateTimezone() should fail when timezone is invalid")
+	}
+	if ten.Timezone() != "Asia/Tokyo" {
+		t.Errorf("Timezone should not change on error: got %v", ten.Timezone())
+	}
+}
+
+func TestTenant_Location(t *testing.T) {
+	now := time.Now()
+	ten, _ := tenant.NewTenant(now, "Test Organization", "America/New_York")
+
+	if got := ten.Location().String(); got != "America/New_York" {
+		t.Errorf("Location() = %v, want America/New_York", got)
+	}
+}
+
+func TestLoadLocation_FallsBackToDefault(t *testing.T) {
+	if got := tenant.LoadLocation("").String(); got != tenant.DefaultTimezone {
+		t.Errorf("LoadLocation(\"\") = %v, want %v", got, tenant.DefaultTimezone)
+	}
+	if got := tenant.LoadLocation("Invalid/Timezone").String(); got != tenant.DefaultTimezone {
+		t.Errorf("LoadLocation(invalid) = %v, want %v", got, tenant.DefaultTimezone)
+	}
+}
+
 func TestTenant_ActivateDeactivate(t *testing.T) {
 	now := time.Now()
 	ten, _ := tenant.NewTenant(now, "Test Organization", "Asia/Tokyo")
@@ -0,0 +1,32 @@
+package tenant
+
+import (
+	"context"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// DefaultTimezone is the time zone assigned to tenants that do not specify one
+const DefaultTimezone = "Asia/Tokyo"
+
+// LoadLocation loads an IANA time zone, falling back to DefaultTimezone (or UTC) when it cannot be loaded
+// Tenant は validate() で LoadLocation 済みのため、フォールバックは壊れたデータへの保険
+func LoadLocation(timezone string) *time.Location {
+	if loc, err := time.LoadLocation(timezone); err == nil && timezone != "" {
+		return loc
+	}
+	if loc, err := time.LoadLocation(DefaultTimezone); err == nil {
+		return loc
+	}
+	return time.UTC
+}
+
+// ResolveLocation finds the tenant and returns its time zone
+func ResolveLocation(ctx context.Context, repo TenantRepository, tenantID common.TenantID) (*time.Location, error) {
+	t, err := repo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return t.Location(), nil
+}
@@ -28,8 +28,8 @@ func (r *AttendanceRepository) Save(ctx context.Context, c *attendance.Attendanc
 	query := `
 		INSERT INTO attendance_collections (
 			collection_id, tenant_id, title, description, target_type, target_id,
-			public_token, status, deadline, created_at, updated_at, deleted_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+			public_token, status, deadline, auto_closed_at, created_at, updated_at, deleted_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 		ON CONFLICT (collection_id) DO UPDATE SET
 			title = EXCLUDED.title,
 			description = EXCLUDED.description,
@@ -37,6 +37,7 @@ func (r *AttendanceRepository) Save(ctx context.Context, c *attendance.Attendanc
 			target_id = EXCLUDED.target_id,
 			status = EXCLUDED.status,
 			deadline = EXCLUDED.deadline,
+			auto_closed_at = EXCLUDED.auto_closed_at,
 			updated_at = EXCLUDED.updated_at,
 			deleted_at = EXCLUDED.deleted_at
 	`
@@ -53,6 +54,7 @@ func (r *AttendanceRepository) Save(ctx context.Context, c *attendance.Attendanc
 		c.PublicToken().String(),
 		c.Status().String(),
 		c.Deadline(),
+		c.AutoClosedAt(),
 		c.CreatedAt(),
 		c.UpdatedAt(),
 		c.DeletedAt(),
@@ -65,12 +67,29 @@ func (r *AttendanceRepository) Save(ctx context.Context, c *attendance.Attendanc
 	return nil
 }
 
+// CloseIfExpired closes the collection only if it is still open and its deadline has passed
+func (r *AttendanceRepository) CloseIfExpired(ctx context.Context, tenantID common.TenantID, id common.CollectionID, now time.Time) (bool, error) {
+	query := `
+		UPDATE attendance_collections
+		SET status = 'closed', auto_closed_at = $3, updated_at = $3
+		WHERE tenant_id = $1 AND collection_id = $2
+			AND status = 'open' AND deadline < $3 AND deleted_at IS NULL
+	`
+
+	tag, err := GetTx(ctx, r.pool).Exec(ctx, query, tenantID.String(), id.String(), now)
+	if err != nil {
+		return false, fmt.Errorf("failed to close expired attendance collection: %w", err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
 // FindByID finds a collection by ID within a tenant
 func (r *AttendanceRepository) FindByID(ctx context.Context, tenantID common.TenantID, id common.CollectionID) (*attendance.AttendanceCollection, error) {
 	query := `
 		SELECT
 			collection_id, tenant_id, title, description, target_type, target_id,
-			public_token, status, deadline, created_at, updated_at, deleted_at
+			public_token, status, deadline, auto_closed_at, created_at, updated_at, deleted_at
 		FROM attendance_collections
 		WHERE tenant_id = $1 AND collection_id = $2 AND deleted_at IS NULL
 	`
@@ -87,6 +106,7 @@ func (r *AttendanceRepository) FindByID(ctx context.Context, tenantID common.Ten
 		publicTokenStr  string
 		statusStr       string
 		deadline        sql.NullTime
+		autoClosedAt    sql.NullTime
 		createdAt       time.Time
 		updatedAt       time.Time
 		deletedAt       sql.NullTime
@@ -102,6 +122,7 @@ func (r *AttendanceRepository) FindByID(ctx context.Context, tenantID common.Ten
 		&publicTokenStr,
 		&statusStr,
 		&deadline,
+		&autoClosedAt,
 		&createdAt,
 		&updatedAt,
 		&deletedAt,
@@ -116,7 +137,7 @@ func (r *AttendanceRepository) FindByID(ctx context.Context, tenantID common.Ten
 
 	return r.scanCollection(
 		collectionIDStr, tenantIDStr, title, description, targetTypeStr, targetID,
-		publicTokenStr, statusStr, deadline, createdAt, updatedAt, deletedAt,
+		publicTokenStr, statusStr, deadline, autoClosedAt, createdAt, updatedAt, deletedAt,
 	)
 }
 
@@ -125,7 +146,7 @@ func (r *AttendanceRepository) FindByToken(ctx context.Context, token common.Pub
 	query := `
 		SELECT
 			collection_id, tenant_id, title, description, target_type, target_id,
-			public_token, status, deadline, created_at, updated_at, deleted_at
+			public_token, status, deadline, auto_closed_at, created_at, updated_at, deleted_at
 		FROM attendance_collections
 		WHERE public_token = $1 AND deleted_at IS NULL
 	`
@@ -142,6 +163,7 @@ func (r *AttendanceRepository) FindByToken(ctx context.Context, token common.Pub
 		publicTokenStr  string
 		statusStr       string
 		deadline        sql.NullTime
+		autoClosedAt    sql.NullTime
 		createdAt       time.Time
 		updatedAt       time.Time
 		deletedAt       sql.NullTime
@@ -157,6 +179,7 @@ func (r *AttendanceRepository) FindByToken(ctx context.Context, token common.Pub
 		&publicTokenStr,
 		&statusStr,
 		&deadline,
+		&autoClosedAt,
 		&createdAt,
 		&updatedAt,
 		&deletedAt,
@@ -171,7 +194,7 @@ func (r *AttendanceRepository) FindByToken(ctx context.Context, token common.Pub
 
 	return r.scanCollection(
 		collectionIDStr, tenantIDStr, title, description, targetTypeStr, targetID,
-		publicTokenStr, statusStr, deadline, createdAt, updatedAt, deletedAt,
+		publicTokenStr, statusStr, deadline, autoClosedAt, createdAt, updatedAt, deletedAt,
 	)
 }
 
@@ -180,7 +203,7 @@ func (r *AttendanceRepository) FindByTenantID(ctx context.Context, tenantID comm
 	query := `
 		SELECT
 			collection_id, tenant_id, title, description, target_type, target_id,
-			public_token, status, deadline, created_at, updated_at, deleted_at
+			public_token, status, deadline, auto_closed_at, created_at, updated_at, deleted_at
 		FROM attendance_collections
 		WHERE tenant_id = $1 AND deleted_at IS NULL
 		ORDER BY created_at DESC
@@ -206,6 +229,7 @@ func (r *AttendanceRepository) FindByTenantID(ctx context.Context, tenantID comm
 			publicTokenStr  string
 			statusStr       string
 			deadline        sql.NullTime
+			autoClosedAt    sql.NullTime
 			createdAt       time.Time
 			updatedAt       time.Time
 			deletedAt       sql.NullTime
@@ -221,6 +245,7 @@ func (r *AttendanceRepository) FindByTenantID(ctx context.Context, tenantID comm
 			&publicTokenStr,
 			&statusStr,
 			&deadline,
+			&autoClosedAt,
 			&createdAt,
 			&updatedAt,
 			&deletedAt,
@@ -231,7 +256,7 @@ func (r *AttendanceRepository) FindByTenantID(ctx context.Context, tenantID comm
 
 		collection, err := r.scanCollection(
 			collectionIDStr, tenantIDStr, title, description, targetTypeStr, targetID,
-			publicTokenStr, statusStr, deadline, createdAt, updatedAt, deletedAt,
+			publicTokenStr, statusStr, deadline, autoClosedAt, createdAt, updatedAt, deletedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to reconstruct collection: %w", err)
@@ -637,6 +662,7 @@ func (r *AttendanceRepository) scanCollection(
 	collectionIDStr, tenantIDStr, title, description, targetTypeStr, targetID,
 	publicTokenStr, statusStr string,
 	deadline sql.NullTime,
+	autoClosedAt sql.NullTime,
 	createdAt, updatedAt time.Time,
 	deletedAt sql.NullTime,
 ) (*attendance.AttendanceCollection, error) {
@@ -670,6 +696,11 @@ func (r *AttendanceRepository) scanCollection(
 		deadlinePtr = &deadline.Time
 	}
 
+	var autoClosedAtPtr *time.Time
+	if autoClosedAt.Valid {
+		autoClosedAtPtr = &autoClosedAt.Time
+	}
+
 	var deletedAtPtr *time.Time
 	if deletedAt.Valid {
 		deletedAtPtr = &deletedAt.Time
@@ -685,6 +716,7 @@ func (r *AttendanceRepository) scanCollection(
 		publicToken,
 		status,
 		deadlinePtr,
+		autoClosedAtPtr,
 		createdAt,
 		updatedAt,
 		deletedAtPtr,
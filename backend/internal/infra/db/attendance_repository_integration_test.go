@@ -699,3 +699,78 @@ func TestAttendanceRepository_UpdateStatus(t *testing.T) {
 		t.Errorf("Status should be closed: got %v", foundCollection.Status())
 	}
 }
+
+func TestAttendanceRepository_CloseIfExpired(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := db.NewAttendanceRepository(pool)
+	ctx := context.Background()
+
+	tenantID := common.NewTenantID()
+	createTestTenant(t, pool, tenantID)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	deadline := now.Add(-1 * time.Hour)
+	expired, err := attendance.NewAttendanceCollection(now.Add(-48*time.Hour), tenantID, "自動締切テスト", "", attendance.TargetTypeEvent, "", &deadline)
+	if err != nil {
+		t.Fatalf("Failed to create test collection: %v", err)
+	}
+	extended, err := attendance.NewAttendanceCollection(now.Add(-48*time.Hour), tenantID, "締切延長テスト", "", attendance.TargetTypeEvent, "", &deadline)
+	if err != nil {
+		t.Fatalf("Failed to create test collection: %v", err)
+	}
+	for _, c := range []*attendance.AttendanceCollection{expired, extended} {
+		if err := repo.Save(ctx, c); err != nil {
+			t.Fatalf("Failed to save collection: %v", err)
+		}
+	}
+
+	// 締切を過ぎた受付中のものは締め切る
+	closed, err := repo.CloseIfExpired(ctx, tenantID, expired.CollectionID(), now)
+	if err != nil {
+		t.Fatalf("Failed to close expired collection: %v", err)
+	}
+	if !closed {
+		t.Fatal("Expired collection should be closed")
+	}
+	found, err := repo.FindByID(ctx, tenantID, expired.CollectionID())
+	if err != nil {
+		t.Fatalf("Failed to find collection: %v", err)
+	}
+	if found.Status() != attendance.StatusClosed || !found.IsAutoClosed() || !found.AutoClosedAt().Equal(now) {
+		t.Errorf("Collection should be auto closed at %v: got status %v, auto_closed_at %v", now, found.Status(), found.AutoClosedAt())
+	}
+
+	// 締め切り済みのものは更新しない
+	closed, err = repo.CloseIfExpired(ctx, tenantID, expired.CollectionID(), now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("Failed to close expired collection: %v", err)
+	}
+	if closed {
+		t.Error("Closed collection should not be closed twice")
+	}
+
+	// 読み込み後に管理者が締切を延長した場合は締め切らない
+	newDeadline := now.Add(24 * time.Hour)
+	if err := extended.Update(now, "", "", &newDeadline); err != nil {
+		t.Fatalf("Failed to update collection: %v", err)
+	}
+	if err := repo.Save(ctx, extended); err != nil {
+		t.Fatalf("Failed to save collection: %v", err)
+	}
+	closed, err = repo.CloseIfExpired(ctx, tenantID, extended.CollectionID(), now)
+	if err != nil {
+		t.Fatalf("Failed to close expired collection: %v", err)
+	}
+	if closed {
+		t.Error("Collection with an extended deadline should not be closed")
+	}
+	found, err = repo.FindByID(ctx, tenantID, extended.CollectionID())
+	if err != nil {
+		t.Fatalf("Failed to find collection: %v", err)
+	}
+	if found.Status() != attendance.StatusOpen || !found.Deadline().Equal(newDeadline) {
+		t.Errorf("Collection should stay open with the extended deadline: got status %v, deadline %v", found.Status(), found.Deadline())
+	}
+}
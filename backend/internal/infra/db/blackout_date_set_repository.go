@@ -0,0 +1,234 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// BlackoutDateSetRepository implements event.BlackoutDateSetRepository for PostgreSQL
+type BlackoutDateSetRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewBlackoutDateSetRepository creates a new BlackoutDateSetRepository
+func NewBlackoutDateSetRepository(db *pgxpool.Pool) *BlackoutDateSetRepository {
+	return &BlackoutDateSetRepository{db: db}
+}
+
+// Save saves a blackout date set with its dates (insert or update)
+// 日付は全件を入れ替える
+func (r *BlackoutDateSetRepository) Save(ctx context.Context, set *event.BlackoutDateSet) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	setQuery := `
+		INSERT INTO blackout_date_sets (
+			set_id, tenant_id, name, description, action, is_active, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (set_id) DO UPDATE SET
+			name = EXCLUDED.name,
+			description = EXCLUDED.description,
+			action = EXCLUDED.action,
+			is_active = EXCLUDED.is_active,
+			updated_at = EXCLUDED.updated_at
+	`
+	if _, err := tx.Exec(ctx, setQuery,
+		set.SetID().String(),
+		set.TenantID().String(),
+		set.Name(),
+		set.Description(),
+		string(set.Action()),
+		set.IsActive(),
+		set.CreatedAt(),
+		set.UpdatedAt(),
+	); err != nil {
+		return fmt.Errorf("failed to save blackout date set: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM blackout_dates WHERE set_id = $1`, set.SetID().String()); err != nil {
+		return fmt.Errorf("failed to delete blackout dates: %w", err)
+	}
+
+	if len(set.Dates()) > 0 {
+		dates := make([]time.Time, len(set.Dates()))
+		labels := make([]string, len(set.Dates()))
+		for i, d := range set.Dates() {
+			dates[i] = d.Date
+			labels[i] = d.Label
+		}
+
+		datesQuery := `
+			INSERT INTO blackout_dates (set_id, blackout_date, label)
+			SELECT $1, d, l FROM unnest($2::date[], $3::text[]) AS t(d, l)
+		`
+		if _, err := tx.Exec(ctx, datesQuery, set.SetID().String(), dates, labels); err != nil {
+			return fmt.Errorf("failed to save blackout dates: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// FindByID finds a blackout date set by ID within a tenant
+func (r *BlackoutDateSetRepository) FindByID(ctx context.Context, tenantID common.TenantID, setID event.BlackoutDateSetID) (*event.BlackoutDateSet, error) {
+	query := `
+		SELECT set_id, tenant_id, name, description, action, is_active, created_at, updated_at
+		FROM blackout_date_sets
+		WHERE tenant_id = $1 AND set_id = $2
+	`
+
+	sets, err := r.querySets(ctx, query, tenantID.String(), setID.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(sets) == 0 {
+		return nil, common.NewNotFoundError("BlackoutDateSet", setID.String())
+	}
+
+	return sets[0], nil
+}
+
+// FindByTenantID finds all blackout date sets within a tenant
+func (r *BlackoutDateSetRepository) FindByTenantID(ctx context.Context, tenantID common.TenantID) ([]*event.BlackoutDateSet, error) {
+	query := `
+		SELECT set_id, tenant_id, name, description, action, is_active, created_at, updated_at
+		FROM blackout_date_sets
+		WHERE tenant_id = $1
+		ORDER BY created_at ASC
+	`
+
+	return r.querySets(ctx, query, tenantID.String())
+}
+
+// Delete deletes a blackout date set with its dates (physical delete)
+func (r *BlackoutDateSetRepository) Delete(ctx context.Context, tenantID common.TenantID, setID event.BlackoutDateSetID) error {
+	query := `
+		DELETE FROM blackout_date_sets
+		WHERE tenant_id = $1 AND set_id = $2
+	`
+
+	result, err := GetTx(ctx, r.db).Exec(ctx, query, tenantID.String(), setID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete blackout date set: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return common.NewNotFoundError("BlackoutDateSet", setID.String())
+	}
+
+	return nil
+}
+
+// blackoutDateSetRow holds the columns of a blackout_date_sets row
+type blackoutDateSetRow struct {
+	setID       string
+	tenantID    string
+	name        string
+	description string
+	action      string
+	isActive    bool
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// querySets runs a blackout_date_sets query and loads the dates of the found sets
+func (r *BlackoutDateSetRepository) querySets(ctx context.Context, query string, args ...interface{}) ([]*event.BlackoutDateSet, error) {
+	rows, err := GetTx(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find blackout date sets: %w", err)
+	}
+
+	var setRows []blackoutDateSetRow
+	for rows.Next() {
+		var row blackoutDateSetRow
+		if err := rows.Scan(
+			&row.setID, &row.tenantID, &row.name, &row.description,
+			&row.action, &row.isActive, &row.createdAt, &row.updatedAt,
+		); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan blackout date set: %w", err)
+		}
+		setRows = append(setRows, row)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating blackout date set rows: %w", err)
+	}
+	if len(setRows) == 0 {
+		return nil, nil
+	}
+
+	setIDs := make([]string, len(setRows))
+	for i, row := range setRows {
+		setIDs[i] = row.setID
+	}
+	datesBySet, err := r.findDates(ctx, setIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	sets := make([]*event.BlackoutDateSet, 0, len(setRows))
+	for _, row := range setRows {
+		set, err := event.ReconstructBlackoutDateSet(
+			event.BlackoutDateSetID(row.setID),
+			common.TenantID(row.tenantID),
+			row.name,
+			row.description,
+			event.BlackoutAction(row.action),
+			row.isActive,
+			datesBySet[row.setID],
+			row.createdAt,
+			row.updatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reconstruct blackout date set: %w", err)
+		}
+		sets = append(sets, set)
+	}
+
+	return sets, nil
+}
+
+// findDates finds the dates of the given sets
+func (r *BlackoutDateSetRepository) findDates(ctx context.Context, setIDs []string) (map[string][]event.BlackoutDate, error) {
+	query := `
+		SELECT set_id, blackout_date, label
+		FROM blackout_dates
+		WHERE set_id = ANY($1)
+		ORDER BY blackout_date ASC
+	`
+
+	rows, err := GetTx(ctx, r.db).Query(ctx, query, setIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find blackout dates: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string][]event.BlackoutDate)
+	for rows.Next() {
+		var (
+			setID string
+			d     event.BlackoutDate
+		)
+		if err := rows.Scan(&setID, &d.Date, &d.Label); err != nil {
+			return nil, fmt.Errorf("failed to scan blackout date: %w", err)
+		}
+		result[setID] = append(result[setID], d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating blackout date rows: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,164 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// BotTokenRepository implements tenant.BotTokenRepository for PostgreSQL
+type BotTokenRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewBotTokenRepository creates a new BotTokenRepository
+func NewBotTokenRepository(pool *pgxpool.Pool) *BotTokenRepository {
+	return &BotTokenRepository{pool: pool}
+}
+
+const botTokenColumns = `
+	token_id, tenant_id, name, token_hash, token_prefix, created_at, revoked_at
+`
+
+// Save saves a bot token (insert or update)
+func (r *BotTokenRepository) Save(ctx context.Context, token *tenant.BotToken) error {
+	query := `
+		INSERT INTO bot_tokens (` + botTokenColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (token_id) DO UPDATE SET
+			name = EXCLUDED.name,
+			revoked_at = EXCLUDED.revoked_at
+	`
+
+	_, err := GetTx(ctx, r.pool).Exec(ctx, query,
+		token.TokenID().String(),
+		token.TenantID().String(),
+		token.Name(),
+		token.TokenHash(),
+		token.TokenPrefix(),
+		token.CreatedAt(),
+		token.RevokedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save bot token: %w", err)
+	}
+
+	return nil
+}
+
+// FindByID finds a bot token by ID within a tenant
+func (r *BotTokenRepository) FindByID(ctx context.Context, tenantID common.TenantID, tokenID tenant.BotTokenID) (*tenant.BotToken, error) {
+	query := `
+		SELECT ` + botTokenColumns + `
+		FROM bot_tokens
+		WHERE tenant_id = $1 AND token_id = $2
+	`
+
+	token, err := scanBotToken(GetTx(ctx, r.pool).QueryRow(ctx, query, tenantID.String(), tokenID.String()))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, common.NewNotFoundError("BotToken", tokenID.String())
+		}
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// FindByHash finds a bot token by the hash of the plain token
+func (r *BotTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*tenant.BotToken, error) {
+	query := `
+		SELECT ` + botTokenColumns + `
+		FROM bot_tokens
+		WHERE token_hash = $1
+	`
+
+	token, err := scanBotToken(GetTx(ctx, r.pool).QueryRow(ctx, query, tokenHash))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, common.NewNotFoundError("BotToken", "")
+		}
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// FindByTenantID finds all bot tokens within a tenant
+func (r *BotTokenRepository) FindByTenantID(ctx context.Context, tenantID common.TenantID) ([]*tenant.BotToken, error) {
+	query := `
+		SELECT ` + botTokenColumns + `
+		FROM bot_tokens
+		WHERE tenant_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := GetTx(ctx, r.pool).Query(ctx, query, tenantID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bot tokens: %w", err)
+	}
+	defer rows.Close()
+
+	var tokens []*tenant.BotToken
+	for rows.Next() {
+		token, err := scanBotToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating bot token rows: %w", err)
+	}
+
+	return tokens, nil
+}
+
+func scanBotToken(row pgx.Row) (*tenant.BotToken, error) {
+	var (
+		tokenIDStr  string
+		tenantIDStr string
+		name        string
+		tokenHash   string
+		tokenPrefix string
+		createdAt   time.Time
+		revokedAt   *time.Time
+	)
+
+	err := row.Scan(
+		&tokenIDStr,
+		&tenantIDStr,
+		&name,
+		&tokenHash,
+		&tokenPrefix,
+		&createdAt,
+		&revokedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan bot token row: %w", err)
+	}
+
+	token, err := tenant.ReconstructBotToken(
+		tenant.BotTokenID(tokenIDStr),
+		common.TenantID(tenantIDStr),
+		name,
+		tokenHash,
+		tokenPrefix,
+		createdAt,
+		revokedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconstruct bot token: %w", err)
+	}
+
+	return token, nil
+}
@@ -35,19 +35,28 @@ func pgtypeTimeToTime(pt pgtype.Time) time.Time {
 func (r *EventBusinessDayRepository) Save(ctx context.Context, bd *event.EventBusinessDay) error {
 	query := `
 		INSERT INTO event_business_days (
-			business_day_id, tenant_id, event_id, target_date, start_time, end_time,
-			occurrence_type, recurring_pattern_id, is_active, valid_from, valid_to,
+			business_day_id, tenant_id, event_id, target_date, start_time, end_time, end_date,
+			occurrence_type, recurring_pattern_id, occurrence_date, is_active, valid_from, valid_to,
+			cancelled_at, cancelled_by_admin_id, cancellation_reason,
+			applied_template_id, applied_template_version,
 			created_at, updated_at, deleted_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
 		ON CONFLICT (business_day_id) DO UPDATE SET
 			target_date = EXCLUDED.target_date,
 			start_time = EXCLUDED.start_time,
 			end_time = EXCLUDED.end_time,
+			end_date = EXCLUDED.end_date,
 			occurrence_type = EXCLUDED.occurrence_type,
 			recurring_pattern_id = EXCLUDED.recurring_pattern_id,
+			occurrence_date = EXCLUDED.occurrence_date,
 			is_active = EXCLUDED.is_active,
 			valid_from = EXCLUDED.valid_from,
 			valid_to = EXCLUDED.valid_to,
+			cancelled_at = EXCLUDED.cancelled_at,
+			cancelled_by_admin_id = EXCLUDED.cancelled_by_admin_id,
+			cancellation_reason = EXCLUDED.cancellation_reason,
+			applied_template_id = EXCLUDED.applied_template_id,
+			applied_template_version = EXCLUDED.applied_template_version,
 			updated_at = EXCLUDED.updated_at,
 			deleted_at = EXCLUDED.deleted_at
 	`
@@ -58,18 +67,49 @@ func (r *EventBusinessDayRepository) Save(ctx context.Context, bd *event.EventBu
 		recurringPatternID = &id
 	}
 
-	_, err := r.db.Exec(ctx, query,
+	var (
+		cancelledAt        *time.Time
+		cancelledBy        *string
+		cancellationReason string
+	)
+	if c := bd.Cancellation(); c != nil {
+		cancelledAt = &c.CancelledAt
+		if c.CancelledBy != nil {
+			id := c.CancelledBy.String()
+			cancelledBy = &id
+		}
+		cancellationReason = c.Reason
+	}
+
+	var (
+		templateID      *string
+		templateVersion *int
+	)
+	if at := bd.AppliedTemplate(); at != nil {
+		id := at.TemplateID.String()
+		templateID = &id
+		templateVersion = &at.Version
+	}
+
+	_, err := GetTx(ctx, r.db).Exec(ctx, query,
 		bd.BusinessDayID().String(),
 		bd.TenantID().String(),
 		bd.EventID().String(),
 		bd.TargetDate(),
 		bd.StartTime(),
 		bd.EndTime(),
+		bd.EndDate(),
 		string(bd.OccurrenceType()),
 		recurringPatternID,
+		bd.OccurrenceDate(),
 		bd.IsActive(),
 		bd.ValidFrom(),
 		bd.ValidTo(),
+		cancelledAt,
+		cancelledBy,
+		cancellationReason,
+		templateID,
+		templateVersion,
 		bd.CreatedAt(),
 		bd.UpdatedAt(),
 		bd.DeletedAt(),
@@ -86,8 +126,10 @@ func (r *EventBusinessDayRepository) Save(ctx context.Context, bd *event.EventBu
 func (r *EventBusinessDayRepository) FindByID(ctx context.Context, tenantID common.TenantID, businessDayID event.BusinessDayID) (*event.EventBusinessDay, error) {
 	query := `
 		SELECT
-			business_day_id, tenant_id, event_id, target_date, start_time, end_time,
-			occurrence_type, recurring_pattern_id, is_active, valid_from, valid_to,
+			business_day_id, tenant_id, event_id, target_date, start_time, end_time, end_date,
+			occurrence_type, recurring_pattern_id, occurrence_date, is_active, valid_from, valid_to,
+			cancelled_at, cancelled_by_admin_id, cancellation_reason,
+			applied_template_id, applied_template_version,
 			created_at, updated_at, deleted_at
 		FROM event_business_days
 		WHERE tenant_id = $1 AND business_day_id = $2 AND deleted_at IS NULL
@@ -100,28 +142,42 @@ func (r *EventBusinessDayRepository) FindByID(ctx context.Context, tenantID comm
 		targetDate         time.Time
 		startTime          pgtype.Time
 		endTime            pgtype.Time
+		endDate            time.Time
 		occurrenceTypeStr  string
 		recurringPatternID sql.NullString
+		occurrenceDate     sql.NullTime
 		isActive           bool
 		validFrom          sql.NullTime
 		validTo            sql.NullTime
+		cancelledAt        sql.NullTime
+		cancelledBy        sql.NullString
+		cancellationReason string
+		templateID         sql.NullString
+		templateVersion    sql.NullInt32
 		createdAt          time.Time
 		updatedAt          time.Time
 		deletedAt          sql.NullTime
 	)
 
-	err := r.db.QueryRow(ctx, query, tenantID.String(), businessDayID.String()).Scan(
+	err := GetTx(ctx, r.db).QueryRow(ctx, query, tenantID.String(), businessDayID.String()).Scan(
 		&businessDayIDStr,
 		&tenantIDStr,
 		&eventIDStr,
 		&targetDate,
 		&startTime,
 		&endTime,
+		&endDate,
 		&occurrenceTypeStr,
 		&recurringPatternID,
+		&occurrenceDate,
 		&isActive,
 		&validFrom,
 		&validTo,
+		&cancelledAt,
+		&cancelledBy,
+		&cancellationReason,
+		&templateID,
+		&templateVersion,
 		&createdAt,
 		&updatedAt,
 		&deletedAt,
@@ -135,8 +191,10 @@ func (r *EventBusinessDayRepository) FindByID(ctx context.Context, tenantID comm
 	}
 
 	return r.scanToBusinessDay(
-		businessDayIDStr, tenantIDStr, eventIDStr, targetDate, pgtypeTimeToTime(startTime), pgtypeTimeToTime(endTime),
-		occurrenceTypeStr, recurringPatternID, isActive, validFrom, validTo,
+		businessDayIDStr, tenantIDStr, eventIDStr, targetDate, pgtypeTimeToTime(startTime), pgtypeTimeToTime(endTime), endDate,
+		occurrenceTypeStr, recurringPatternID, occurrenceDate, isActive, validFrom, validTo,
+		cancelledAt, cancelledBy, cancellationReason,
+		templateID, templateVersion,
 		createdAt, updatedAt, deletedAt,
 	)
 }
@@ -145,8 +203,10 @@ func (r *EventBusinessDayRepository) FindByID(ctx context.Context, tenantID comm
 func (r *EventBusinessDayRepository) FindByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) ([]*event.EventBusinessDay, error) {
 	query := `
 		SELECT
-			business_day_id, tenant_id, event_id, target_date, start_time, end_time,
-			occurrence_type, recurring_pattern_id, is_active, valid_from, valid_to,
+			business_day_id, tenant_id, event_id, target_date, start_time, end_time, end_date,
+			occurrence_type, recurring_pattern_id, occurrence_date, is_active, valid_from, valid_to,
+			cancelled_at, cancelled_by_admin_id, cancellation_reason,
+			applied_template_id, applied_template_version,
 			created_at, updated_at, deleted_at
 		FROM event_business_days
 		WHERE tenant_id = $1 AND event_id = $2 AND deleted_at IS NULL
@@ -157,15 +217,18 @@ func (r *EventBusinessDayRepository) FindByEventID(ctx context.Context, tenantID
 }
 
 // FindByEventIDAndDateRange finds business days within a date range for an event
+// 深夜営業・複数日営業を取りこぼさないよう、期間と重なる営業日（target_date〜end_date）を返す
 func (r *EventBusinessDayRepository) FindByEventIDAndDateRange(ctx context.Context, tenantID common.TenantID, eventID common.EventID, startDate, endDate time.Time) ([]*event.EventBusinessDay, error) {
 	query := `
 		SELECT
-			business_day_id, tenant_id, event_id, target_date, start_time, end_time,
-			occurrence_type, recurring_pattern_id, is_active, valid_from, valid_to,
+			business_day_id, tenant_id, event_id, target_date, start_time, end_time, end_date,
+			occurrence_type, recurring_pattern_id, occurrence_date, is_active, valid_from, valid_to,
+			cancelled_at, cancelled_by_admin_id, cancellation_reason,
+			applied_template_id, applied_template_version,
 			created_at, updated_at, deleted_at
 		FROM event_business_days
 		WHERE tenant_id = $1 AND event_id = $2
-			AND target_date >= $3 AND target_date <= $4
+			AND target_date <= $4 AND end_date >= $3
 			AND deleted_at IS NULL
 		ORDER BY target_date ASC, start_time ASC
 	`
@@ -177,8 +240,10 @@ func (r *EventBusinessDayRepository) FindByEventIDAndDateRange(ctx context.Conte
 func (r *EventBusinessDayRepository) FindActiveByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) ([]*event.EventBusinessDay, error) {
 	query := `
 		SELECT
-			business_day_id, tenant_id, event_id, target_date, start_time, end_time,
-			occurrence_type, recurring_pattern_id, is_active, valid_from, valid_to,
+			business_day_id, tenant_id, event_id, target_date, start_time, end_time, end_date,
+			occurrence_type, recurring_pattern_id, occurrence_date, is_active, valid_from, valid_to,
+			cancelled_at, cancelled_by_admin_id, cancellation_reason,
+			applied_template_id, applied_template_version,
 			created_at, updated_at, deleted_at
 		FROM event_business_days
 		WHERE tenant_id = $1 AND event_id = $2 AND is_active = true AND deleted_at IS NULL
@@ -189,15 +254,18 @@ func (r *EventBusinessDayRepository) FindActiveByEventID(ctx context.Context, te
 }
 
 // FindByTenantIDAndDate finds all business days on a specific date within a tenant
+// 前日から続く深夜営業や複数日営業など、その日に開催中の営業日も含む
 func (r *EventBusinessDayRepository) FindByTenantIDAndDate(ctx context.Context, tenantID common.TenantID, date time.Time) ([]*event.EventBusinessDay, error) {
 	query := `
 		SELECT
-			business_day_id, tenant_id, event_id, target_date, start_time, end_time,
-			occurrence_type, recurring_pattern_id, is_active, valid_from, valid_to,
+			business_day_id, tenant_id, event_id, target_date, start_time, end_time, end_date,
+			occurrence_type, recurring_pattern_id, occurrence_date, is_active, valid_from, valid_to,
+			cancelled_at, cancelled_by_admin_id, cancellation_reason,
+			applied_template_id, applied_template_version,
 			created_at, updated_at, deleted_at
 		FROM event_business_days
-		WHERE tenant_id = $1 AND target_date = $2 AND deleted_at IS NULL
-		ORDER BY start_time ASC
+		WHERE tenant_id = $1 AND target_date <= $2 AND end_date >= $2 AND deleted_at IS NULL
+		ORDER BY target_date ASC, start_time ASC
 	`
 
 	return r.queryBusinessDays(ctx, query, tenantID.String(), date)
@@ -210,7 +278,7 @@ func (r *EventBusinessDayRepository) Delete(ctx context.Context, tenantID common
 		WHERE tenant_id = $1 AND business_day_id = $2
 	`
 
-	result, err := r.db.Exec(ctx, query, tenantID.String(), businessDayID.String())
+	result, err := GetTx(ctx, r.db).Exec(ctx, query, tenantID.String(), businessDayID.String())
 	if err != nil {
 		return fmt.Errorf("failed to delete event business day: %w", err)
 	}
@@ -232,7 +300,7 @@ func (r *EventBusinessDayRepository) ExistsByEventIDAndDate(ctx context.Context,
 	`
 
 	var exists bool
-	err := r.db.QueryRow(ctx, query, tenantID.String(), eventID.String(), date, startTime).Scan(&exists)
+	err := GetTx(ctx, r.db).QueryRow(ctx, query, tenantID.String(), eventID.String(), date, startTime).Scan(&exists)
 	if err != nil {
 		return false, fmt.Errorf("failed to check event business day existence: %w", err)
 	}
@@ -240,64 +308,97 @@ func (r *EventBusinessDayRepository) ExistsByEventIDAndDate(ctx context.Context,
 	return exists, nil
 }
 
+// ExistsByEventIDAndOccurrenceDate checks if a recurring business day was ever generated for the occurrence date
+// 日時の変更・論理削除後も作り直さないよう、論理削除済みの営業日も含めて判定する
+func (r *EventBusinessDayRepository) ExistsByEventIDAndOccurrenceDate(ctx context.Context, tenantID common.TenantID, eventID common.EventID, occurrenceDate time.Time) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM event_business_days
+			WHERE tenant_id = $1 AND event_id = $2 AND occurrence_date = $3
+		)
+	`
+
+	var exists bool
+	err := GetTx(ctx, r.db).QueryRow(ctx, query, tenantID.String(), eventID.String(), occurrenceDate).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check event business day occurrence: %w", err)
+	}
+
+	return exists, nil
+}
+
 // FindRecentByTenantID finds recent N business days within a tenant (past only, oldest first)
-func (r *EventBusinessDayRepository) FindRecentByTenantID(ctx context.Context, tenantID common.TenantID, limit int) ([]*event.EventBusinessDay, error) {
+// 中止された営業日は出席の集計対象外のため除外する
+// 「過去」の判定は DB サーバーの CURRENT_DATE ではなく、テナントのタイムゾーンでの today を使う
+func (r *EventBusinessDayRepository) FindRecentByTenantID(ctx context.Context, tenantID common.TenantID, today time.Time, limit int) ([]*event.EventBusinessDay, error) {
 	query := `
 		SELECT
-			business_day_id, tenant_id, event_id, target_date, start_time, end_time,
-			occurrence_type, recurring_pattern_id, is_active, valid_from, valid_to,
+			business_day_id, tenant_id, event_id, target_date, start_time, end_time, end_date,
+			occurrence_type, recurring_pattern_id, occurrence_date, is_active, valid_from, valid_to,
+			cancelled_at, cancelled_by_admin_id, cancellation_reason,
+			applied_template_id, applied_template_version,
 			created_at, updated_at, deleted_at
 		FROM event_business_days
 		WHERE tenant_id = $1
 		  AND deleted_at IS NULL
-		  AND target_date <= CURRENT_DATE
+		  AND cancelled_at IS NULL
+		  AND target_date <= $2
 		ORDER BY target_date ASC
-		LIMIT $2
+		LIMIT $3
 	`
 
-	return r.queryBusinessDays(ctx, query, tenantID.String(), limit)
+	return r.queryBusinessDays(ctx, query, tenantID.String(), today, limit)
 }
 
 // FindRecentByEventID finds N business days for a specific event
-// If includeFuture is false, only past dates are returned
+// If includeFuture is false, only past dates are returned (target_date <= today in the tenant's time zone)
 // If includeFuture is true, all dates including future are returned
-func (r *EventBusinessDayRepository) FindRecentByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID, limit int, includeFuture bool) ([]*event.EventBusinessDay, error) {
+// 中止された営業日は除外する
+func (r *EventBusinessDayRepository) FindRecentByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID, today time.Time, limit int, includeFuture bool) ([]*event.EventBusinessDay, error) {
 	var query string
+	args := []interface{}{tenantID.String(), eventID.String(), limit}
 	if includeFuture {
 		query = `
 			SELECT
-				business_day_id, tenant_id, event_id, target_date, start_time, end_time,
-				occurrence_type, recurring_pattern_id, is_active, valid_from, valid_to,
+				business_day_id, tenant_id, event_id, target_date, start_time, end_time, end_date,
+				occurrence_type, recurring_pattern_id, occurrence_date, is_active, valid_from, valid_to,
+				cancelled_at, cancelled_by_admin_id, cancellation_reason,
+				applied_template_id, applied_template_version,
 				created_at, updated_at, deleted_at
 			FROM event_business_days
 			WHERE tenant_id = $1
 			  AND event_id = $2
 			  AND deleted_at IS NULL
+			  AND cancelled_at IS NULL
 			ORDER BY target_date ASC
 			LIMIT $3
 		`
 	} else {
 		query = `
 			SELECT
-				business_day_id, tenant_id, event_id, target_date, start_time, end_time,
-				occurrence_type, recurring_pattern_id, is_active, valid_from, valid_to,
+				business_day_id, tenant_id, event_id, target_date, start_time, end_time, end_date,
+				occurrence_type, recurring_pattern_id, occurrence_date, is_active, valid_from, valid_to,
+				cancelled_at, cancelled_by_admin_id, cancellation_reason,
+				applied_template_id, applied_template_version,
 				created_at, updated_at, deleted_at
 			FROM event_business_days
 			WHERE tenant_id = $1
 			  AND event_id = $2
 			  AND deleted_at IS NULL
-			  AND target_date <= CURRENT_DATE
+			  AND cancelled_at IS NULL
+			  AND target_date <= $4
 			ORDER BY target_date ASC
 			LIMIT $3
 		`
+		args = append(args, today)
 	}
 
-	return r.queryBusinessDays(ctx, query, tenantID.String(), eventID.String(), limit)
+	return r.queryBusinessDays(ctx, query, args...)
 }
 
 // queryBusinessDays executes a query and returns a list of business days
 func (r *EventBusinessDayRepository) queryBusinessDays(ctx context.Context, query string, args ...interface{}) ([]*event.EventBusinessDay, error) {
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := GetTx(ctx, r.db).Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query event business days: %w", err)
 	}
@@ -312,11 +413,18 @@ func (r *EventBusinessDayRepository) queryBusinessDays(ctx context.Context, quer
 			targetDate         time.Time
 			startTime          pgtype.Time
 			endTime            pgtype.Time
+			endDate            time.Time
 			occurrenceTypeStr  string
 			recurringPatternID sql.NullString
+			occurrenceDate     sql.NullTime
 			isActive           bool
 			validFrom          sql.NullTime
 			validTo            sql.NullTime
+			cancelledAt        sql.NullTime
+			cancelledBy        sql.NullString
+			cancellationReason string
+			templateID         sql.NullString
+			templateVersion    sql.NullInt32
 			createdAt          time.Time
 			updatedAt          time.Time
 			deletedAt          sql.NullTime
@@ -329,11 +437,18 @@ func (r *EventBusinessDayRepository) queryBusinessDays(ctx context.Context, quer
 			&targetDate,
 			&startTime,
 			&endTime,
+			&endDate,
 			&occurrenceTypeStr,
 			&recurringPatternID,
+			&occurrenceDate,
 			&isActive,
 			&validFrom,
 			&validTo,
+			&cancelledAt,
+			&cancelledBy,
+			&cancellationReason,
+			&templateID,
+			&templateVersion,
 			&createdAt,
 			&updatedAt,
 			&deletedAt,
@@ -347,8 +462,10 @@ func (r *EventBusinessDayRepository) queryBusinessDays(ctx context.Context, quer
 		endTimeVal := pgtypeTimeToTime(endTime)
 
 		bd, err := r.scanToBusinessDay(
-			businessDayIDStr, tenantIDStr, eventIDStr, targetDate, startTimeVal, endTimeVal,
-			occurrenceTypeStr, recurringPatternID, isActive, validFrom, validTo,
+			businessDayIDStr, tenantIDStr, eventIDStr, targetDate, startTimeVal, endTimeVal, endDate,
+			occurrenceTypeStr, recurringPatternID, occurrenceDate, isActive, validFrom, validTo,
+			cancelledAt, cancelledBy, cancellationReason,
+			templateID, templateVersion,
 			createdAt, updatedAt, deletedAt,
 		)
 		if err != nil {
@@ -368,11 +485,17 @@ func (r *EventBusinessDayRepository) queryBusinessDays(ctx context.Context, quer
 // scanToBusinessDay converts scanned row data to EventBusinessDay entity
 func (r *EventBusinessDayRepository) scanToBusinessDay(
 	businessDayIDStr, tenantIDStr, eventIDStr string,
-	targetDate, startTime, endTime time.Time,
+	targetDate, startTime, endTime, endDate time.Time,
 	occurrenceTypeStr string,
 	recurringPatternID sql.NullString,
+	occurrenceDate sql.NullTime,
 	isActive bool,
 	validFrom, validTo sql.NullTime,
+	cancelledAt sql.NullTime,
+	cancelledBy sql.NullString,
+	cancellationReason string,
+	templateID sql.NullString,
+	templateVersion sql.NullInt32,
 	createdAt, updatedAt time.Time,
 	deletedAt sql.NullTime,
 ) (*event.EventBusinessDay, error) {
@@ -382,6 +505,11 @@ func (r *EventBusinessDayRepository) scanToBusinessDay(
 		recurringPatternIDPtr = &id
 	}
 
+	var occurrenceDatePtr *time.Time
+	if occurrenceDate.Valid {
+		occurrenceDatePtr = &occurrenceDate.Time
+	}
+
 	var validFromPtr, validToPtr *time.Time
 	if validFrom.Valid {
 		validFromPtr = &validFrom.Time
@@ -390,6 +518,26 @@ func (r *EventBusinessDayRepository) scanToBusinessDay(
 		validToPtr = &validTo.Time
 	}
 
+	var cancellation *event.BusinessDayCancellation
+	if cancelledAt.Valid {
+		cancellation = &event.BusinessDayCancellation{
+			CancelledAt: cancelledAt.Time,
+			Reason:      cancellationReason,
+		}
+		if cancelledBy.Valid {
+			adminID := common.AdminID(cancelledBy.String)
+			cancellation.CancelledBy = &adminID
+		}
+	}
+
+	var appliedTemplate *event.AppliedTemplate
+	if templateID.Valid && templateVersion.Valid {
+		appliedTemplate = &event.AppliedTemplate{
+			TemplateID: common.ShiftSlotTemplateID(templateID.String),
+			Version:    int(templateVersion.Int32),
+		}
+	}
+
 	var deletedAtPtr *time.Time
 	if deletedAt.Valid {
 		deletedAtPtr = &deletedAt.Time
@@ -402,11 +550,15 @@ func (r *EventBusinessDayRepository) scanToBusinessDay(
 		targetDate,
 		startTime,
 		endTime,
+		endDate,
 		event.OccurrenceType(occurrenceTypeStr),
 		recurringPatternIDPtr,
+		occurrenceDatePtr,
 		isActive,
 		validFromPtr,
 		validToPtr,
+		cancellation,
+		appliedTemplate,
 		createdAt,
 		updatedAt,
 		deletedAtPtr,
@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DeadlineReminderLogRepository implements notification.DeadlineReminderLogRepository for PostgreSQL
+type DeadlineReminderLogRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewDeadlineReminderLogRepository creates a new DeadlineReminderLogRepository
+func NewDeadlineReminderLogRepository(pool *pgxpool.Pool) *DeadlineReminderLogRepository {
+	return &DeadlineReminderLogRepository{pool: pool}
+}
+
+// Exists reports whether the member has already been reminded of the deadline of the subject
+func (r *DeadlineReminderLogRepository) Exists(ctx context.Context, tenantID common.TenantID, kind notification.ReminderSubjectKind, subjectID string, memberID common.MemberID, deadline time.Time) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM deadline_reminder_logs
+			WHERE tenant_id = $1 AND subject_kind = $2 AND subject_id = $3
+			AND member_id = $4 AND deadline = $5
+		)
+	`
+
+	var exists bool
+	err := GetTx(ctx, r.pool).QueryRow(ctx, query, tenantID.String(), string(kind), subjectID, memberID.String(), deadline).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check deadline reminder log: %w", err)
+	}
+
+	return exists, nil
+}
+
+// Record records the reminder; it returns false if the member has already been reminded
+func (r *DeadlineReminderLogRepository) Record(ctx context.Context, tenantID common.TenantID, kind notification.ReminderSubjectKind, subjectID string, memberID common.MemberID, deadline time.Time, remindedAt time.Time) (bool, error) {
+	query := `
+		INSERT INTO deadline_reminder_logs (tenant_id, subject_kind, subject_id, member_id, deadline, reminded_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (tenant_id, subject_kind, subject_id, member_id, deadline) DO NOTHING
+	`
+
+	tag, err := GetTx(ctx, r.pool).Exec(ctx, query, tenantID.String(), string(kind), subjectID, memberID.String(), deadline, remindedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to record deadline reminder log: %w", err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DiscordAnnouncementRepository implements notification.AnnouncementLogRepository for PostgreSQL
+type DiscordAnnouncementRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewDiscordAnnouncementRepository creates a new DiscordAnnouncementRepository
+func NewDiscordAnnouncementRepository(pool *pgxpool.Pool) *DiscordAnnouncementRepository {
+	return &DiscordAnnouncementRepository{pool: pool}
+}
+
+// Exists reports whether the announcement of the subject has already been posted
+func (r *DiscordAnnouncementRepository) Exists(ctx context.Context, tenantID common.TenantID, kind notification.AnnouncementKind, subjectID string) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM discord_announcements
+			WHERE tenant_id = $1 AND announcement_kind = $2 AND subject_id = $3
+		)
+	`
+
+	var exists bool
+	err := GetTx(ctx, r.pool).QueryRow(ctx, query, tenantID.String(), string(kind), subjectID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check discord announcement: %w", err)
+	}
+
+	return exists, nil
+}
+
+// Record records that the announcement of the subject has been posted
+func (r *DiscordAnnouncementRepository) Record(ctx context.Context, tenantID common.TenantID, kind notification.AnnouncementKind, subjectID string, announcedAt time.Time) error {
+	query := `
+		INSERT INTO discord_announcements (tenant_id, announcement_kind, subject_id, announced_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (tenant_id, announcement_kind, subject_id) DO NOTHING
+	`
+
+	_, err := GetTx(ctx, r.pool).Exec(ctx, query, tenantID.String(), string(kind), subjectID, announcedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record discord announcement: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DiscordWebhookRepository implements notification.DiscordWebhookRepository for PostgreSQL
+type DiscordWebhookRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewDiscordWebhookRepository creates a new DiscordWebhookRepository
+func NewDiscordWebhookRepository(pool *pgxpool.Pool) *DiscordWebhookRepository {
+	return &DiscordWebhookRepository{pool: pool}
+}
+
+const discordWebhookColumns = `
+	webhook_id, tenant_id, event_id, webhook_url, is_enabled, created_at, updated_at
+`
+
+// Save saves a webhook setting (insert or update)
+func (r *DiscordWebhookRepository) Save(ctx context.Context, webhook *notification.DiscordWebhook) error {
+	query := `
+		INSERT INTO discord_webhooks (` + discordWebhookColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (webhook_id) DO UPDATE SET
+			webhook_url = EXCLUDED.webhook_url,
+			is_enabled = EXCLUDED.is_enabled,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	var eventID *string
+	if webhook.EventID() != nil {
+		s := webhook.EventID().String()
+		eventID = &s
+	}
+
+	_, err := GetTx(ctx, r.pool).Exec(ctx, query,
+		webhook.WebhookID().String(),
+		webhook.TenantID().String(),
+		eventID,
+		webhook.WebhookURL(),
+		webhook.IsEnabled(),
+		webhook.CreatedAt(),
+		webhook.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save discord webhook: %w", err)
+	}
+
+	return nil
+}
+
+// FindTenantDefault finds the tenant-wide default webhook
+func (r *DiscordWebhookRepository) FindTenantDefault(ctx context.Context, tenantID common.TenantID) (*notification.DiscordWebhook, error) {
+	query := `
+		SELECT ` + discordWebhookColumns + `
+		FROM discord_webhooks
+		WHERE tenant_id = $1 AND event_id IS NULL
+	`
+
+	webhook, err := scanDiscordWebhook(GetTx(ctx, r.pool).QueryRow(ctx, query, tenantID.String()))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, common.NewNotFoundError("DiscordWebhook", tenantID.String())
+		}
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// FindByEventID finds the webhook override of an event
+func (r *DiscordWebhookRepository) FindByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) (*notification.DiscordWebhook, error) {
+	query := `
+		SELECT ` + discordWebhookColumns + `
+		FROM discord_webhooks
+		WHERE tenant_id = $1 AND event_id = $2
+	`
+
+	webhook, err := scanDiscordWebhook(GetTx(ctx, r.pool).QueryRow(ctx, query, tenantID.String(), eventID.String()))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, common.NewNotFoundError("DiscordWebhook", eventID.String())
+		}
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// Delete deletes a webhook setting (physical delete)
+func (r *DiscordWebhookRepository) Delete(ctx context.Context, tenantID common.TenantID, webhookID notification.DiscordWebhookID) error {
+	query := `
+		DELETE FROM discord_webhooks
+		WHERE tenant_id = $1 AND webhook_id = $2
+	`
+
+	result, err := GetTx(ctx, r.pool).Exec(ctx, query, tenantID.String(), webhookID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete discord webhook: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return common.NewNotFoundError("DiscordWebhook", webhookID.String())
+	}
+
+	return nil
+}
+
+func scanDiscordWebhook(row pgx.Row) (*notification.DiscordWebhook, error) {
+	var (
+		webhookIDStr string
+		tenantIDStr  string
+		eventIDStr   sql.NullString
+		webhookURL   string
+		enabled      bool
+		createdAt    time.Time
+		updatedAt    time.Time
+	)
+
+	err := row.Scan(
+		&webhookIDStr,
+		&tenantIDStr,
+		&eventIDStr,
+		&webhookURL,
+		&enabled,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan discord webhook row: %w", err)
+	}
+
+	var eventID *common.EventID
+	if eventIDStr.Valid {
+		id := common.EventID(eventIDStr.String)
+		eventID = &id
+	}
+
+	webhook, err := notification.ReconstructDiscordWebhook(
+		notification.DiscordWebhookID(webhookIDStr),
+		common.TenantID(tenantIDStr),
+		eventID,
+		webhookURL,
+		enabled,
+		createdAt,
+		updatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconstruct discord webhook: %w", err)
+	}
+
+	return webhook, nil
+}
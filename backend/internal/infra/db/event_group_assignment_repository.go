@@ -23,7 +23,7 @@ func NewEventGroupAssignmentRepository(db *pgxpool.Pool) *EventGroupAssignmentRe
 // SaveGroupAssignments saves member group assignments for an event
 // Replaces all existing assignments with the new ones
 func (r *EventGroupAssignmentRepository) SaveGroupAssignments(ctx context.Context, eventID common.EventID, groupIDs []common.MemberGroupID) error {
-	tx, err := r.db.Begin(ctx)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -64,7 +64,7 @@ func (r *EventGroupAssignmentRepository) FindGroupAssignmentsByEventID(ctx conte
 		ORDER BY created_at
 	`
 
-	rows, err := r.db.Query(ctx, query, eventID.String())
+	rows, err := GetTx(ctx, r.db).Query(ctx, query, eventID.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to find group assignments: %w", err)
 	}
@@ -101,7 +101,7 @@ func (r *EventGroupAssignmentRepository) FindGroupAssignmentsByEventID(ctx conte
 // DeleteGroupAssignments deletes all group assignments for an event
 func (r *EventGroupAssignmentRepository) DeleteGroupAssignments(ctx context.Context, eventID common.EventID) error {
 	query := `DELETE FROM event_group_assignments WHERE event_id = $1`
-	_, err := r.db.Exec(ctx, query, eventID.String())
+	_, err := GetTx(ctx, r.db).Exec(ctx, query, eventID.String())
 	if err != nil {
 		return fmt.Errorf("failed to delete group assignments: %w", err)
 	}
@@ -111,7 +111,7 @@ func (r *EventGroupAssignmentRepository) DeleteGroupAssignments(ctx context.Cont
 // SaveRoleGroupAssignments saves role group assignments for an event
 // Replaces all existing assignments with the new ones
 func (r *EventGroupAssignmentRepository) SaveRoleGroupAssignments(ctx context.Context, eventID common.EventID, roleGroupIDs []common.RoleGroupID) error {
-	tx, err := r.db.Begin(ctx)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -152,7 +152,7 @@ func (r *EventGroupAssignmentRepository) FindRoleGroupAssignmentsByEventID(ctx c
 		ORDER BY created_at
 	`
 
-	rows, err := r.db.Query(ctx, query, eventID.String())
+	rows, err := GetTx(ctx, r.db).Query(ctx, query, eventID.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to find role group assignments: %w", err)
 	}
@@ -189,7 +189,7 @@ func (r *EventGroupAssignmentRepository) FindRoleGroupAssignmentsByEventID(ctx c
 // DeleteRoleGroupAssignments deletes all role group assignments for an event
 func (r *EventGroupAssignmentRepository) DeleteRoleGroupAssignments(ctx context.Context, eventID common.EventID) error {
 	query := `DELETE FROM event_role_group_assignments WHERE event_id = $1`
-	_, err := r.db.Exec(ctx, query, eventID.String())
+	_, err := GetTx(ctx, r.db).Exec(ctx, query, eventID.String())
 	if err != nil {
 		return fmt.Errorf("failed to delete role group assignments: %w", err)
 	}
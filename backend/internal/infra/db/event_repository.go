@@ -28,9 +28,9 @@ func (r *EventRepository) Save(ctx context.Context, e *event.Event) error {
 	query := `
 		INSERT INTO events (
 			event_id, tenant_id, event_name, event_type, description,
-			is_active, recurrence_type, recurrence_start_date, recurrence_day_of_week,
-			default_start_time, default_end_time, created_at, updated_at, deleted_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+			is_active, recurrence_type, recurrence_start_date, recurrence_day_of_week, recurrence_rule,
+			default_start_time, default_end_time, default_template_id, archived_at, created_at, updated_at, deleted_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 		ON CONFLICT (event_id) DO UPDATE SET
 			event_name = EXCLUDED.event_name,
 			event_type = EXCLUDED.event_type,
@@ -39,13 +39,28 @@ func (r *EventRepository) Save(ctx context.Context, e *event.Event) error {
 			recurrence_type = EXCLUDED.recurrence_type,
 			recurrence_start_date = EXCLUDED.recurrence_start_date,
 			recurrence_day_of_week = EXCLUDED.recurrence_day_of_week,
+			recurrence_rule = EXCLUDED.recurrence_rule,
 			default_start_time = EXCLUDED.default_start_time,
 			default_end_time = EXCLUDED.default_end_time,
+			default_template_id = EXCLUDED.default_template_id,
+			archived_at = EXCLUDED.archived_at,
 			updated_at = EXCLUDED.updated_at,
 			deleted_at = EXCLUDED.deleted_at
 	`
 
-	_, err := r.db.Exec(ctx, query,
+	var recurrenceRule *string
+	if e.RecurrenceRule() != nil {
+		text := e.RecurrenceRule().String()
+		recurrenceRule = &text
+	}
+
+	var defaultTemplateID *string
+	if e.DefaultTemplateID() != nil {
+		id := e.DefaultTemplateID().String()
+		defaultTemplateID = &id
+	}
+
+	_, err := GetTx(ctx, r.db).Exec(ctx, query,
 		e.EventID().String(),
 		e.TenantID().String(),
 		e.EventName(),
@@ -55,8 +70,11 @@ func (r *EventRepository) Save(ctx context.Context, e *event.Event) error {
 		string(e.RecurrenceType()),
 		e.RecurrenceStartDate(),
 		e.RecurrenceDayOfWeek(),
+		recurrenceRule,
 		e.DefaultStartTime(),
 		e.DefaultEndTime(),
+		defaultTemplateID,
+		e.ArchivedAt(),
 		e.CreatedAt(),
 		e.UpdatedAt(),
 		e.DeletedAt(),
@@ -74,8 +92,8 @@ func (r *EventRepository) FindByID(ctx context.Context, tenantID common.TenantID
 	query := `
 		SELECT
 			event_id, tenant_id, event_name, event_type, description,
-			is_active, recurrence_type, recurrence_start_date, recurrence_day_of_week,
-			default_start_time, default_end_time, created_at, updated_at, deleted_at
+			is_active, recurrence_type, recurrence_start_date, recurrence_day_of_week, recurrence_rule,
+			default_start_time, default_end_time, default_template_id, archived_at, created_at, updated_at, deleted_at
 		FROM events
 		WHERE tenant_id = $1 AND event_id = $2 AND deleted_at IS NULL
 	`
@@ -90,14 +108,17 @@ func (r *EventRepository) FindByID(ctx context.Context, tenantID common.TenantID
 		recurrenceTypeStr   string
 		recurrenceStartDate sql.NullTime
 		recurrenceDayOfWeek sql.NullInt32
+		recurrenceRule      sql.NullString
 		defaultStartTime    pgtype.Time
 		defaultEndTime      pgtype.Time
+		defaultTemplateID   sql.NullString
+		archivedAt          sql.NullTime
 		createdAt           time.Time
 		updatedAt           time.Time
 		deletedAt           sql.NullTime
 	)
 
-	err := r.db.QueryRow(ctx, query, tenantID.String(), eventID.String()).Scan(
+	err := GetTx(ctx, r.db).QueryRow(ctx, query, tenantID.String(), eventID.String()).Scan(
 		&eventIDStr,
 		&tenantIDStr,
 		&eventName,
@@ -107,8 +128,11 @@ func (r *EventRepository) FindByID(ctx context.Context, tenantID common.TenantID
 		&recurrenceTypeStr,
 		&recurrenceStartDate,
 		&recurrenceDayOfWeek,
+		&recurrenceRule,
 		&defaultStartTime,
 		&defaultEndTime,
+		&defaultTemplateID,
+		&archivedAt,
 		&createdAt,
 		&updatedAt,
 		&deletedAt,
@@ -126,6 +150,11 @@ func (r *EventRepository) FindByID(ctx context.Context, tenantID common.TenantID
 		deletedAtPtr = &deletedAt.Time
 	}
 
+	var archivedAtPtr *time.Time
+	if archivedAt.Valid {
+		archivedAtPtr = &archivedAt.Time
+	}
+
 	var recurrenceStartDatePtr *time.Time
 	if recurrenceStartDate.Valid {
 		recurrenceStartDatePtr = &recurrenceStartDate.Time
@@ -149,6 +178,12 @@ func (r *EventRepository) FindByID(ctx context.Context, tenantID common.TenantID
 		defaultEndTimePtr = &t
 	}
 
+	var defaultTemplateIDPtr *common.ShiftSlotTemplateID
+	if defaultTemplateID.Valid {
+		id := common.ShiftSlotTemplateID(defaultTemplateID.String)
+		defaultTemplateIDPtr = &id
+	}
+
 	return event.ReconstructEvent(
 		common.EventID(eventIDStr),
 		common.TenantID(tenantIDStr),
@@ -159,8 +194,11 @@ func (r *EventRepository) FindByID(ctx context.Context, tenantID common.TenantID
 		event.RecurrenceType(recurrenceTypeStr),
 		recurrenceStartDatePtr,
 		recurrenceDayOfWeekPtr,
+		recurrenceRule.String,
 		defaultStartTimePtr,
 		defaultEndTimePtr,
+		defaultTemplateIDPtr,
+		archivedAtPtr,
 		createdAt,
 		updatedAt,
 		deletedAtPtr,
@@ -172,14 +210,14 @@ func (r *EventRepository) FindByTenantID(ctx context.Context, tenantID common.Te
 	query := `
 		SELECT
 			event_id, tenant_id, event_name, event_type, description,
-			is_active, recurrence_type, recurrence_start_date, recurrence_day_of_week,
-			default_start_time, default_end_time, created_at, updated_at, deleted_at
+			is_active, recurrence_type, recurrence_start_date, recurrence_day_of_week, recurrence_rule,
+			default_start_time, default_end_time, default_template_id, archived_at, created_at, updated_at, deleted_at
 		FROM events
 		WHERE tenant_id = $1 AND deleted_at IS NULL
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.Query(ctx, query, tenantID.String())
+	rows, err := GetTx(ctx, r.db).Query(ctx, query, tenantID.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to find events by tenant: %w", err)
 	}
@@ -213,8 +251,11 @@ func (r *EventRepository) scanEventRow(rows pgx.Rows) (*event.Event, error) {
 		recurrenceTypeStr   string
 		recurrenceStartDate sql.NullTime
 		recurrenceDayOfWeek sql.NullInt32
+		recurrenceRule      sql.NullString
 		defaultStartTime    pgtype.Time
 		defaultEndTime      pgtype.Time
+		defaultTemplateID   sql.NullString
+		archivedAt          sql.NullTime
 		createdAt           time.Time
 		updatedAt           time.Time
 		deletedAt           sql.NullTime
@@ -230,8 +271,11 @@ func (r *EventRepository) scanEventRow(rows pgx.Rows) (*event.Event, error) {
 		&recurrenceTypeStr,
 		&recurrenceStartDate,
 		&recurrenceDayOfWeek,
+		&recurrenceRule,
 		&defaultStartTime,
 		&defaultEndTime,
+		&defaultTemplateID,
+		&archivedAt,
 		&createdAt,
 		&updatedAt,
 		&deletedAt,
@@ -245,6 +289,11 @@ func (r *EventRepository) scanEventRow(rows pgx.Rows) (*event.Event, error) {
 		deletedAtPtr = &deletedAt.Time
 	}
 
+	var archivedAtPtr *time.Time
+	if archivedAt.Valid {
+		archivedAtPtr = &archivedAt.Time
+	}
+
 	var recurrenceStartDatePtr *time.Time
 	if recurrenceStartDate.Valid {
 		recurrenceStartDatePtr = &recurrenceStartDate.Time
@@ -268,6 +317,12 @@ func (r *EventRepository) scanEventRow(rows pgx.Rows) (*event.Event, error) {
 		defaultEndTimePtr = &t
 	}
 
+	var defaultTemplateIDPtr *common.ShiftSlotTemplateID
+	if defaultTemplateID.Valid {
+		id := common.ShiftSlotTemplateID(defaultTemplateID.String)
+		defaultTemplateIDPtr = &id
+	}
+
 	e, err := event.ReconstructEvent(
 		common.EventID(eventIDStr),
 		common.TenantID(tenantIDStr),
@@ -278,8 +333,11 @@ func (r *EventRepository) scanEventRow(rows pgx.Rows) (*event.Event, error) {
 		event.RecurrenceType(recurrenceTypeStr),
 		recurrenceStartDatePtr,
 		recurrenceDayOfWeekPtr,
+		recurrenceRule.String,
 		defaultStartTimePtr,
 		defaultEndTimePtr,
+		defaultTemplateIDPtr,
+		archivedAtPtr,
 		createdAt,
 		updatedAt,
 		deletedAtPtr,
@@ -292,18 +350,19 @@ func (r *EventRepository) scanEventRow(rows pgx.Rows) (*event.Event, error) {
 }
 
 // FindActiveByTenantID finds all active events within a tenant
+// アーカイブ済みのイベントは含まない
 func (r *EventRepository) FindActiveByTenantID(ctx context.Context, tenantID common.TenantID) ([]*event.Event, error) {
 	query := `
 		SELECT
 			event_id, tenant_id, event_name, event_type, description,
-			is_active, recurrence_type, recurrence_start_date, recurrence_day_of_week,
-			default_start_time, default_end_time, created_at, updated_at, deleted_at
+			is_active, recurrence_type, recurrence_start_date, recurrence_day_of_week, recurrence_rule,
+			default_start_time, default_end_time, default_template_id, archived_at, created_at, updated_at, deleted_at
 		FROM events
-		WHERE tenant_id = $1 AND is_active = true AND deleted_at IS NULL
+		WHERE tenant_id = $1 AND is_active = true AND archived_at IS NULL AND deleted_at IS NULL
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.Query(ctx, query, tenantID.String())
+	rows, err := GetTx(ctx, r.db).Query(ctx, query, tenantID.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to find active events: %w", err)
 	}
@@ -332,7 +391,7 @@ func (r *EventRepository) Delete(ctx context.Context, tenantID common.TenantID,
 		WHERE tenant_id = $1 AND event_id = $2
 	`
 
-	result, err := r.db.Exec(ctx, query, tenantID.String(), eventID.String())
+	result, err := GetTx(ctx, r.db).Exec(ctx, query, tenantID.String(), eventID.String())
 	if err != nil {
 		return fmt.Errorf("failed to delete event: %w", err)
 	}
@@ -354,7 +413,7 @@ func (r *EventRepository) ExistsByName(ctx context.Context, tenantID common.Tena
 	`
 
 	var exists bool
-	err := r.db.QueryRow(ctx, query, tenantID.String(), eventName).Scan(&exists)
+	err := GetTx(ctx, r.db).QueryRow(ctx, query, tenantID.String(), eventName).Scan(&exists)
 	if err != nil {
 		return false, fmt.Errorf("failed to check event existence: %w", err)
 	}
@@ -38,7 +38,7 @@ func (r *InstanceRepository) Save(ctx context.Context, instance *shift.Instance)
 			deleted_at = EXCLUDED.deleted_at
 	`
 
-	_, err := r.db.Exec(ctx, query,
+	_, err := GetTx(ctx, r.db).Exec(ctx, query,
 		instance.InstanceID().String(),
 		instance.TenantID().String(),
 		instance.EventID().String(),
@@ -80,7 +80,7 @@ func (r *InstanceRepository) FindByID(ctx context.Context, tenantID common.Tenan
 		deletedAt     sql.NullTime
 	)
 
-	err := r.db.QueryRow(ctx, query, tenantID.String(), instanceID.String()).Scan(
+	err := GetTx(ctx, r.db).QueryRow(ctx, query, tenantID.String(), instanceID.String()).Scan(
 		&instanceIDStr,
 		&tenantIDStr,
 		&eventIDStr,
@@ -144,7 +144,7 @@ func (r *InstanceRepository) FindByEventIDAndName(ctx context.Context, tenantID
 		deletedAt     sql.NullTime
 	)
 
-	err := r.db.QueryRow(ctx, query, tenantID.String(), eventID.String(), name).Scan(
+	err := GetTx(ctx, r.db).QueryRow(ctx, query, tenantID.String(), eventID.String(), name).Scan(
 		&instanceIDStr,
 		&tenantIDStr,
 		&eventIDStr,
@@ -178,7 +178,7 @@ func (r *InstanceRepository) Delete(ctx context.Context, tenantID common.TenantI
 		WHERE tenant_id = $1 AND instance_id = $2
 	`
 
-	result, err := r.db.Exec(ctx, query, tenantID.String(), instanceID.String())
+	result, err := GetTx(ctx, r.db).Exec(ctx, query, tenantID.String(), instanceID.String())
 	if err != nil {
 		return fmt.Errorf("failed to delete instance: %w", err)
 	}
@@ -192,7 +192,7 @@ func (r *InstanceRepository) Delete(ctx context.Context, tenantID common.TenantI
 
 // queryInstances executes a query and returns a list of instances
 func (r *InstanceRepository) queryInstances(ctx context.Context, query string, args ...interface{}) ([]*shift.Instance, error) {
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := GetTx(ctx, r.db).Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query instances: %w", err)
 	}
@@ -0,0 +1,345 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// MemberAvailabilityRepository implements member.AvailabilityRepository for PostgreSQL
+type MemberAvailabilityRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewMemberAvailabilityRepository creates a new MemberAvailabilityRepository
+func NewMemberAvailabilityRepository(pool *pgxpool.Pool) *MemberAvailabilityRepository {
+	return &MemberAvailabilityRepository{pool: pool}
+}
+
+const availabilityWindowColumns = `
+	window_id, tenant_id, member_id, day_of_week, start_time, end_time,
+	note, created_at, updated_at
+`
+
+const availabilityBlackoutColumns = `
+	blackout_id, tenant_id, member_id, blackout_date, start_time, end_time,
+	reason, created_at, updated_at
+`
+
+// SaveWindow saves an availability window (insert or update)
+func (r *MemberAvailabilityRepository) SaveWindow(ctx context.Context, window *member.AvailabilityWindow) error {
+	query := `
+		INSERT INTO member_availability_windows (` + availabilityWindowColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (window_id) DO UPDATE SET
+			day_of_week = EXCLUDED.day_of_week,
+			start_time = EXCLUDED.start_time,
+			end_time = EXCLUDED.end_time,
+			note = EXCLUDED.note,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := GetTx(ctx, r.pool).Exec(ctx, query,
+		window.WindowID().String(),
+		window.TenantID().String(),
+		window.MemberID().String(),
+		int(window.DayOfWeek()),
+		window.StartTime(),
+		window.EndTime(),
+		window.Note(),
+		window.CreatedAt(),
+		window.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save availability window: %w", err)
+	}
+
+	return nil
+}
+
+// FindWindowByID finds an availability window by ID within a tenant
+func (r *MemberAvailabilityRepository) FindWindowByID(ctx context.Context, tenantID common.TenantID, windowID member.AvailabilityWindowID) (*member.AvailabilityWindow, error) {
+	query := `
+		SELECT ` + availabilityWindowColumns + `
+		FROM member_availability_windows
+		WHERE tenant_id = $1 AND window_id = $2
+	`
+
+	windows, err := r.queryWindows(ctx, query, tenantID.String(), windowID.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(windows) == 0 {
+		return nil, common.NewNotFoundError("AvailabilityWindow", windowID.String())
+	}
+
+	return windows[0], nil
+}
+
+// FindWindowsByMemberID finds all availability windows of a member (day_of_week, start_time ascending)
+func (r *MemberAvailabilityRepository) FindWindowsByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*member.AvailabilityWindow, error) {
+	query := `
+		SELECT ` + availabilityWindowColumns + `
+		FROM member_availability_windows
+		WHERE tenant_id = $1 AND member_id = $2
+		ORDER BY day_of_week ASC, start_time ASC
+	`
+
+	return r.queryWindows(ctx, query, tenantID.String(), memberID.String())
+}
+
+// DeleteWindow deletes an availability window (physical delete)
+func (r *MemberAvailabilityRepository) DeleteWindow(ctx context.Context, tenantID common.TenantID, windowID member.AvailabilityWindowID) error {
+	query := `
+		DELETE FROM member_availability_windows
+		WHERE tenant_id = $1 AND window_id = $2
+	`
+
+	result, err := GetTx(ctx, r.pool).Exec(ctx, query, tenantID.String(), windowID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete availability window: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return common.NewNotFoundError("AvailabilityWindow", windowID.String())
+	}
+
+	return nil
+}
+
+// SaveBlackout saves a blackout (insert or update)
+func (r *MemberAvailabilityRepository) SaveBlackout(ctx context.Context, blackout *member.AvailabilityBlackout) error {
+	query := `
+		INSERT INTO member_availability_blackouts (` + availabilityBlackoutColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (blackout_id) DO UPDATE SET
+			blackout_date = EXCLUDED.blackout_date,
+			start_time = EXCLUDED.start_time,
+			end_time = EXCLUDED.end_time,
+			reason = EXCLUDED.reason,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := GetTx(ctx, r.pool).Exec(ctx, query,
+		blackout.BlackoutID().String(),
+		blackout.TenantID().String(),
+		blackout.MemberID().String(),
+		blackout.Date(),
+		blackout.StartTime(),
+		blackout.EndTime(),
+		blackout.Reason(),
+		blackout.CreatedAt(),
+		blackout.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save availability blackout: %w", err)
+	}
+
+	return nil
+}
+
+// FindBlackoutByID finds a blackout by ID within a tenant
+func (r *MemberAvailabilityRepository) FindBlackoutByID(ctx context.Context, tenantID common.TenantID, blackoutID member.BlackoutID) (*member.AvailabilityBlackout, error) {
+	query := `
+		SELECT ` + availabilityBlackoutColumns + `
+		FROM member_availability_blackouts
+		WHERE tenant_id = $1 AND blackout_id = $2
+	`
+
+	blackouts, err := r.queryBlackouts(ctx, query, tenantID.String(), blackoutID.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(blackouts) == 0 {
+		return nil, common.NewNotFoundError("AvailabilityBlackout", blackoutID.String())
+	}
+
+	return blackouts[0], nil
+}
+
+// FindBlackoutsByMemberID finds all blackouts of a member (date ascending)
+func (r *MemberAvailabilityRepository) FindBlackoutsByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*member.AvailabilityBlackout, error) {
+	query := `
+		SELECT ` + availabilityBlackoutColumns + `
+		FROM member_availability_blackouts
+		WHERE tenant_id = $1 AND member_id = $2
+		ORDER BY blackout_date ASC, start_time ASC NULLS FIRST
+	`
+
+	return r.queryBlackouts(ctx, query, tenantID.String(), memberID.String())
+}
+
+// FindBlackoutsByMemberIDAndDateRange finds blackouts of a member whose date is within [from, to]
+func (r *MemberAvailabilityRepository) FindBlackoutsByMemberIDAndDateRange(ctx context.Context, tenantID common.TenantID, memberID common.MemberID, from, to time.Time) ([]*member.AvailabilityBlackout, error) {
+	query := `
+		SELECT ` + availabilityBlackoutColumns + `
+		FROM member_availability_blackouts
+		WHERE tenant_id = $1 AND member_id = $2
+		  AND blackout_date BETWEEN $3 AND $4
+		ORDER BY blackout_date ASC, start_time ASC NULLS FIRST
+	`
+
+	return r.queryBlackouts(ctx, query, tenantID.String(), memberID.String(), from, to)
+}
+
+// DeleteBlackout deletes a blackout (physical delete)
+func (r *MemberAvailabilityRepository) DeleteBlackout(ctx context.Context, tenantID common.TenantID, blackoutID member.BlackoutID) error {
+	query := `
+		DELETE FROM member_availability_blackouts
+		WHERE tenant_id = $1 AND blackout_id = $2
+	`
+
+	result, err := GetTx(ctx, r.pool).Exec(ctx, query, tenantID.String(), blackoutID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete availability blackout: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return common.NewNotFoundError("AvailabilityBlackout", blackoutID.String())
+	}
+
+	return nil
+}
+
+// queryWindows executes a query and returns a list of availability windows
+func (r *MemberAvailabilityRepository) queryWindows(ctx context.Context, query string, args ...interface{}) ([]*member.AvailabilityWindow, error) {
+	rows, err := GetTx(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query availability windows: %w", err)
+	}
+	defer rows.Close()
+
+	var windows []*member.AvailabilityWindow
+	for rows.Next() {
+		window, err := scanAvailabilityWindow(rows)
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, window)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating availability window rows: %w", err)
+	}
+
+	return windows, nil
+}
+
+// queryBlackouts executes a query and returns a list of blackouts
+func (r *MemberAvailabilityRepository) queryBlackouts(ctx context.Context, query string, args ...interface{}) ([]*member.AvailabilityBlackout, error) {
+	rows, err := GetTx(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query availability blackouts: %w", err)
+	}
+	defer rows.Close()
+
+	var blackouts []*member.AvailabilityBlackout
+	for rows.Next() {
+		blackout, err := scanAvailabilityBlackout(rows)
+		if err != nil {
+			return nil, err
+		}
+		blackouts = append(blackouts, blackout)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating availability blackout rows: %w", err)
+	}
+
+	return blackouts, nil
+}
+
+func scanAvailabilityWindow(row pgx.Row) (*member.AvailabilityWindow, error) {
+	var (
+		windowIDStr string
+		tenantIDStr string
+		memberIDStr string
+		dayOfWeek   int
+		startTime   time.Time
+		endTime     time.Time
+		note        string
+		createdAt   time.Time
+		updatedAt   time.Time
+	)
+
+	err := row.Scan(
+		&windowIDStr,
+		&tenantIDStr,
+		&memberIDStr,
+		&dayOfWeek,
+		&startTime,
+		&endTime,
+		&note,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan availability window row: %w", err)
+	}
+
+	window, err := member.ReconstructAvailabilityWindow(
+		member.AvailabilityWindowID(windowIDStr),
+		common.TenantID(tenantIDStr),
+		common.MemberID(memberIDStr),
+		time.Weekday(dayOfWeek),
+		startTime,
+		endTime,
+		note,
+		createdAt,
+		updatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconstruct availability window: %w", err)
+	}
+
+	return window, nil
+}
+
+func scanAvailabilityBlackout(row pgx.Row) (*member.AvailabilityBlackout, error) {
+	var (
+		blackoutIDStr string
+		tenantIDStr   string
+		memberIDStr   string
+		date          time.Time
+		startTime     *time.Time
+		endTime       *time.Time
+		reason        string
+		createdAt     time.Time
+		updatedAt     time.Time
+	)
+
+	err := row.Scan(
+		&blackoutIDStr,
+		&tenantIDStr,
+		&memberIDStr,
+		&date,
+		&startTime,
+		&endTime,
+		&reason,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan availability blackout row: %w", err)
+	}
+
+	blackout, err := member.ReconstructAvailabilityBlackout(
+		member.BlackoutID(blackoutIDStr),
+		common.TenantID(tenantIDStr),
+		common.MemberID(memberIDStr),
+		date,
+		startTime,
+		endTime,
+		reason,
+		createdAt,
+		updatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconstruct availability blackout: %w", err)
+	}
+
+	return blackout, nil
+}
@@ -0,0 +1,28 @@
+-- Migration: 047_extend_shift_plans (DOWN)
+
+DROP INDEX IF EXISTS idx_shift_assignments_slot_member_confirmed_unique;
+
+CREATE UNIQUE INDEX idx_shift_assignments_slot_member_confirmed_unique
+    ON shift_assignments(slot_id, member_id, assignment_status)
+    WHERE assignment_status = 'confirmed' AND deleted_at IS NULL;
+
+DROP INDEX IF EXISTS idx_shift_plans_tenant_event_status;
+
+ALTER TABLE shift_plans DROP CONSTRAINT IF EXISTS shift_plans_scope_check;
+ALTER TABLE shift_plans DROP CONSTRAINT IF EXISTS fk_shift_plans_business_day;
+
+ALTER TABLE shift_plans
+    DROP COLUMN IF EXISTS archived_at,
+    DROP COLUMN IF EXISTS published_at,
+    DROP COLUMN IF EXISTS period_end,
+    DROP COLUMN IF EXISTS period_start,
+    DROP COLUMN IF EXISTS business_day_id;
+
+UPDATE shift_plans SET plan_status = 'finalized' WHERE plan_status = 'archived';
+
+ALTER TABLE shift_plans DROP CONSTRAINT IF EXISTS shift_plans_status_check;
+
+ALTER TABLE shift_plans
+    ADD CONSTRAINT shift_plans_status_check CHECK (
+        plan_status IN ('draft', 'published', 'finalized')
+    );
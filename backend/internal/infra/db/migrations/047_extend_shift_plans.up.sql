@@ -0,0 +1,51 @@
+-- Migration: 047_extend_shift_plans
+-- Description: シフト計画を下書き→公開→アーカイブの集約として利用できるよう拡張
+
+-- プラン状態: finalized を archived に置き換え
+UPDATE shift_plans SET plan_status = 'archived' WHERE plan_status = 'finalized';
+
+ALTER TABLE shift_plans
+    DROP CONSTRAINT IF EXISTS shift_plans_status_check;
+
+ALTER TABLE shift_plans
+    ADD CONSTRAINT shift_plans_status_check CHECK (
+        plan_status IN ('draft', 'published', 'archived')
+    );
+
+-- 対象範囲: 営業日単位 または イベント期間単位
+ALTER TABLE shift_plans
+    ADD COLUMN business_day_id CHAR(26) NULL,
+    ADD COLUMN period_start DATE NULL,
+    ADD COLUMN period_end DATE NULL,
+    ADD COLUMN published_at TIMESTAMPTZ NULL,
+    ADD COLUMN archived_at TIMESTAMPTZ NULL;
+
+ALTER TABLE shift_plans
+    ADD CONSTRAINT fk_shift_plans_business_day FOREIGN KEY (business_day_id)
+        REFERENCES event_business_days(business_day_id) ON DELETE CASCADE;
+
+ALTER TABLE shift_plans
+    ADD CONSTRAINT shift_plans_scope_check CHECK (
+        (business_day_id IS NOT NULL AND period_start IS NULL AND period_end IS NULL) OR
+        (business_day_id IS NULL AND period_start IS NOT NULL AND period_end IS NOT NULL AND period_start <= period_end)
+    );
+
+-- 公開中プランの検索用
+CREATE INDEX idx_shift_plans_tenant_event_status
+    ON shift_plans(tenant_id, event_id, plan_status)
+    WHERE deleted_at IS NULL;
+
+-- 同じ枠・メンバーの確定割り当てはプランごとに一意
+-- （下書きプランが公開中プランと同じ割り当てを持てるようにする）
+DROP INDEX IF EXISTS idx_shift_assignments_slot_member_confirmed_unique;
+
+CREATE UNIQUE INDEX idx_shift_assignments_slot_member_confirmed_unique
+    ON shift_assignments(slot_id, member_id, COALESCE(plan_id, ''))
+    WHERE assignment_status = 'confirmed' AND deleted_at IS NULL;
+
+COMMENT ON COLUMN shift_plans.plan_status IS 'プラン状態: draft（下書き）、published（公開）、archived（アーカイブ）';
+COMMENT ON COLUMN shift_plans.business_day_id IS '対象営業日ID（営業日単位のプランの場合）';
+COMMENT ON COLUMN shift_plans.period_start IS '対象期間の開始日（イベント期間単位のプランの場合）';
+COMMENT ON COLUMN shift_plans.period_end IS '対象期間の終了日（イベント期間単位のプランの場合）';
+COMMENT ON COLUMN shift_plans.published_at IS '公開日時';
+COMMENT ON COLUMN shift_plans.archived_at IS 'アーカイブ日時';
@@ -0,0 +1 @@
+ALTER TABLE shift_assignments DROP COLUMN IF EXISTS is_conflict_overridden;
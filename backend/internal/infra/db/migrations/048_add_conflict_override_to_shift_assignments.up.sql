@@ -0,0 +1,7 @@
+-- Migration: 048_add_conflict_override_to_shift_assignments
+-- Description: 時間帯が重複するシフトへの割り当てを強制確定した記録を保持する
+
+ALTER TABLE shift_assignments
+    ADD COLUMN is_conflict_overridden BOOLEAN NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN shift_assignments.is_conflict_overridden IS '同一メンバーの他シフトと時間帯が重複していることを承知の上で強制確定したか';
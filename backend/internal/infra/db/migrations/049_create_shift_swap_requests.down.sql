@@ -0,0 +1,4 @@
+-- Migration: 049_create_shift_swap_requests (Rollback)
+-- Description: シフト交換・譲渡依頼テーブルの削除
+
+DROP TABLE IF EXISTS shift_swap_requests;
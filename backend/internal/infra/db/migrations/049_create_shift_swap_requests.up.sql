@@ -0,0 +1,66 @@
+-- Migration: 049_create_shift_swap_requests
+-- Description: シフト交換・譲渡依頼テーブルの作成
+-- 公開トークンのリンクから他のメンバーが引き受け、完了後も交換履歴として残す
+
+CREATE TABLE IF NOT EXISTS shift_swap_requests (
+    swap_request_id CHAR(26) PRIMARY KEY,  -- ULID形式
+    tenant_id CHAR(26) NOT NULL,
+    request_type VARCHAR(10) NOT NULL,     -- 'drop' | 'swap'
+    assignment_id CHAR(26) NOT NULL,       -- 依頼者が手放す割り当て
+    requester_member_id CHAR(26) NOT NULL,
+    public_token UUID NOT NULL UNIQUE,     -- UUID v4形式
+    requires_approval BOOLEAN NOT NULL DEFAULT false,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    note TEXT NOT NULL DEFAULT '',
+    claimant_member_id CHAR(26) NULL,
+    claimant_assignment_id CHAR(26) NULL,  -- swap の場合に引き受け側が差し出す割り当て
+    new_assignment_id CHAR(26) NULL,       -- 引き受け側に作成された割り当て
+    counter_assignment_id CHAR(26) NULL,   -- swap の場合に依頼者に作成された割り当て
+    claimed_at TIMESTAMPTZ NULL,
+    decided_by_admin_id CHAR(26) NULL,
+    decided_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_shift_swap_requests_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(tenant_id) ON DELETE CASCADE,
+
+    CONSTRAINT fk_shift_swap_requests_assignment FOREIGN KEY (assignment_id)
+        REFERENCES shift_assignments(assignment_id) ON DELETE CASCADE,
+
+    CONSTRAINT fk_shift_swap_requests_requester FOREIGN KEY (requester_member_id)
+        REFERENCES members(member_id) ON DELETE CASCADE,
+
+    CONSTRAINT fk_shift_swap_requests_claimant FOREIGN KEY (claimant_member_id)
+        REFERENCES members(member_id) ON DELETE SET NULL,
+
+    CONSTRAINT shift_swap_requests_type_check CHECK (
+        request_type IN ('drop', 'swap')
+    ),
+
+    CONSTRAINT shift_swap_requests_status_check CHECK (
+        status IN ('open', 'pending_approval', 'completed', 'rejected', 'cancelled')
+    )
+);
+
+-- テナントごとの一覧・履歴検索用
+CREATE INDEX idx_shift_swap_requests_tenant_status
+    ON shift_swap_requests(tenant_id, status, created_at DESC);
+
+-- メンバーごとの履歴検索用
+CREATE INDEX idx_shift_swap_requests_requester
+    ON shift_swap_requests(tenant_id, requester_member_id);
+
+CREATE INDEX idx_shift_swap_requests_claimant
+    ON shift_swap_requests(tenant_id, claimant_member_id)
+    WHERE claimant_member_id IS NOT NULL;
+
+-- 同じ割り当てに対する進行中の依頼は1件まで
+CREATE UNIQUE INDEX idx_shift_swap_requests_assignment_active
+    ON shift_swap_requests(assignment_id)
+    WHERE status IN ('open', 'pending_approval');
+
+COMMENT ON TABLE shift_swap_requests IS 'シフト交換・譲渡依頼（交換履歴を兼ねる）';
+COMMENT ON COLUMN shift_swap_requests.request_type IS '依頼種別: drop（譲渡）、swap（交換）';
+COMMENT ON COLUMN shift_swap_requests.status IS '状態: open（募集中）、pending_approval（承認待ち）、completed（完了）、rejected（却下）、cancelled（取り下げ）';
+COMMENT ON COLUMN shift_swap_requests.requires_approval IS '引き受け後に管理者の承認が必要か';
@@ -0,0 +1,4 @@
+-- Migration: 050_create_shift_slot_standbys (Rollback)
+-- Description: シフト枠の空き待ちテーブルの削除
+
+DROP TABLE IF EXISTS shift_slot_standbys;
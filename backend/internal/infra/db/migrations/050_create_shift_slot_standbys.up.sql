@@ -0,0 +1,50 @@
+-- Migration: 050_create_shift_slot_standbys
+-- Description: シフト枠の空き待ち（スタンバイ）テーブルの作成
+-- 割り当てがキャンセルされたとき、position の小さい順に繰り上げる
+
+CREATE TABLE IF NOT EXISTS shift_slot_standbys (
+    standby_id CHAR(26) PRIMARY KEY,       -- ULID形式
+    tenant_id CHAR(26) NOT NULL,
+    slot_id CHAR(26) NOT NULL,
+    member_id CHAR(26) NOT NULL,
+    position INT NOT NULL,                 -- 繰り上げ順（1始まり）
+    auto_accept BOOLEAN NOT NULL DEFAULT false,
+    status VARCHAR(20) NOT NULL DEFAULT 'waiting',
+    offer_expires_at TIMESTAMPTZ NULL,     -- オファーの回答期限
+    assignment_id CHAR(26) NULL,           -- 繰り上げで作成された割り当て（割り当ては物理削除されうるため FK なし）
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_shift_slot_standbys_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(tenant_id) ON DELETE CASCADE,
+
+    CONSTRAINT fk_shift_slot_standbys_slot FOREIGN KEY (slot_id)
+        REFERENCES shift_slots(slot_id) ON DELETE CASCADE,
+
+    CONSTRAINT fk_shift_slot_standbys_member FOREIGN KEY (member_id)
+        REFERENCES members(member_id) ON DELETE CASCADE,
+
+    CONSTRAINT shift_slot_standbys_position_check CHECK (position >= 1),
+
+    CONSTRAINT shift_slot_standbys_status_check CHECK (
+        status IN ('waiting', 'offered', 'promoted', 'declined', 'expired', 'withdrawn')
+    ),
+
+    CONSTRAINT shift_slot_standbys_offer_check CHECK (
+        status <> 'offered' OR offer_expires_at IS NOT NULL
+    )
+);
+
+-- 枠ごとの繰り上げ順の取得用
+CREATE INDEX idx_shift_slot_standbys_slot_position
+    ON shift_slot_standbys(tenant_id, slot_id, position);
+
+-- 同じ枠で有効な空き待ちはメンバーごとに1件まで
+CREATE UNIQUE INDEX idx_shift_slot_standbys_slot_member_active
+    ON shift_slot_standbys(slot_id, member_id)
+    WHERE status IN ('waiting', 'offered');
+
+COMMENT ON TABLE shift_slot_standbys IS 'シフト枠の空き待ちリスト（繰り上げ履歴を兼ねる）';
+COMMENT ON COLUMN shift_slot_standbys.auto_accept IS 'true: 空きが出たら確認なしで割り当てる、false: 回答期限付きでオファーする';
+COMMENT ON COLUMN shift_slot_standbys.status IS '状態: waiting（空き待ち）、offered（回答待ち）、promoted（確定）、declined（辞退）、expired（期限切れ）、withdrawn（取り下げ）';
@@ -0,0 +1,8 @@
+-- Migration: 051_add_role_requirements_to_shift_slots (Rollback)
+-- Description: シフト枠とテンプレートアイテムのロール要件を削除
+
+ALTER TABLE shift_slot_template_items
+    DROP COLUMN IF EXISTS role_requirements;
+
+ALTER TABLE shift_slots
+    DROP COLUMN IF EXISTS role_requirements;
@@ -0,0 +1,12 @@
+-- Migration: 051_add_role_requirements_to_shift_slots
+-- Description: シフト枠とテンプレートアイテムに必須/推奨ロールを持たせる
+-- テンプレートから枠を生成する際にそのままコピーされる
+
+ALTER TABLE shift_slots
+    ADD COLUMN role_requirements JSONB NOT NULL DEFAULT '[]';
+
+ALTER TABLE shift_slot_template_items
+    ADD COLUMN role_requirements JSONB NOT NULL DEFAULT '[]';
+
+COMMENT ON COLUMN shift_slots.role_requirements IS 'ロール要件（JSONB）: [{"role_id": "...", "kind": "required|preferred", "count": 1}]';
+COMMENT ON COLUMN shift_slot_template_items.role_requirements IS 'ロール要件（JSONB）: 生成されるシフト枠にコピーされる';
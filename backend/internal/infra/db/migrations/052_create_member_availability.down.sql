@@ -0,0 +1,5 @@
+-- Migration: 052_create_member_availability (Rollback)
+-- Description: メンバーの参加可能時間帯・ブラックアウト日テーブルの削除
+
+DROP TABLE IF EXISTS member_availability_blackouts;
+DROP TABLE IF EXISTS member_availability_windows;
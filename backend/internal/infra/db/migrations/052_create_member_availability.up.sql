@@ -0,0 +1,57 @@
+-- Migration: 052_create_member_availability
+-- Description: メンバーの参加可能時間帯（毎週）とブラックアウト日（単発）テーブルの作成
+-- 参加可能時間帯が1件もないメンバーは時間帯による制限なしとして扱う
+
+CREATE TABLE IF NOT EXISTS member_availability_windows (
+    window_id CHAR(26) PRIMARY KEY,        -- ULID形式
+    tenant_id CHAR(26) NOT NULL,
+    member_id CHAR(26) NOT NULL,
+    day_of_week SMALLINT NOT NULL,         -- 0=日曜 〜 6=土曜
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,                -- start_time より前の場合は翌日まで（深夜帯）
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_member_availability_windows_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(tenant_id) ON DELETE CASCADE,
+
+    CONSTRAINT fk_member_availability_windows_member FOREIGN KEY (member_id)
+        REFERENCES members(member_id) ON DELETE CASCADE,
+
+    CONSTRAINT member_availability_windows_day_check CHECK (day_of_week BETWEEN 0 AND 6),
+
+    CONSTRAINT member_availability_windows_time_check CHECK (start_time <> end_time)
+);
+
+CREATE INDEX idx_member_availability_windows_member
+    ON member_availability_windows(tenant_id, member_id, day_of_week);
+
+CREATE TABLE IF NOT EXISTS member_availability_blackouts (
+    blackout_id CHAR(26) PRIMARY KEY,      -- ULID形式
+    tenant_id CHAR(26) NOT NULL,
+    member_id CHAR(26) NOT NULL,
+    blackout_date DATE NOT NULL,
+    start_time TIME NULL,                  -- NULL の場合は終日
+    end_time TIME NULL,                    -- NULL の場合は終日
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_member_availability_blackouts_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(tenant_id) ON DELETE CASCADE,
+
+    CONSTRAINT fk_member_availability_blackouts_member FOREIGN KEY (member_id)
+        REFERENCES members(member_id) ON DELETE CASCADE,
+
+    CONSTRAINT member_availability_blackouts_time_check CHECK (
+        (start_time IS NULL AND end_time IS NULL)
+        OR (start_time IS NOT NULL AND end_time IS NOT NULL AND start_time <> end_time)
+    )
+);
+
+CREATE INDEX idx_member_availability_blackouts_member_date
+    ON member_availability_blackouts(tenant_id, member_id, blackout_date);
+
+COMMENT ON TABLE member_availability_windows IS 'メンバーの毎週の参加可能時間帯';
+COMMENT ON TABLE member_availability_blackouts IS 'メンバーの参加不可日（単発）';
@@ -0,0 +1,4 @@
+-- Migration: 053_create_workload_policies (Rollback)
+-- Description: ワークロードポリシーテーブルの削除
+
+DROP TABLE IF EXISTS workload_policies;
@@ -0,0 +1,42 @@
+-- Migration: 053_create_workload_policies
+-- Description: メンバーの勤務量上限（ワークロードポリシー）テーブルの作成
+-- member_id が NULL の行はテナントの既定ポリシー、それ以外はメンバー個別の上書き
+-- 上限の各項目は NULL の場合制限なし（メンバー個別の場合はテナントの既定値を引き継ぐ）
+
+CREATE TABLE IF NOT EXISTS workload_policies (
+    policy_id CHAR(26) PRIMARY KEY,        -- ULID形式
+    tenant_id CHAR(26) NOT NULL,
+    member_id CHAR(26) NULL,
+    max_shifts_per_week INT NULL,
+    max_shifts_per_month INT NULL,
+    min_shifts_per_month INT NULL,         -- 目標最小シフト数（レポートのみで使用）
+    max_minutes_per_business_day INT NULL,
+    min_rest_minutes_between_shifts INT NULL,
+    enforcement VARCHAR(10) NOT NULL DEFAULT '', -- warn / block（メンバー個別では空の場合テナントの設定を引き継ぐ）
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_workload_policies_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(tenant_id) ON DELETE CASCADE,
+
+    CONSTRAINT fk_workload_policies_member FOREIGN KEY (member_id)
+        REFERENCES members(member_id) ON DELETE CASCADE,
+
+    CONSTRAINT workload_policies_enforcement_check CHECK (
+        enforcement IN ('', 'warn', 'block')
+        AND (member_id IS NOT NULL OR enforcement <> '')
+    )
+);
+
+-- テナントの既定ポリシーは1件まで
+CREATE UNIQUE INDEX idx_workload_policies_tenant_default
+    ON workload_policies(tenant_id)
+    WHERE member_id IS NULL;
+
+-- メンバー個別ポリシーはメンバーごとに1件まで
+CREATE UNIQUE INDEX idx_workload_policies_member
+    ON workload_policies(tenant_id, member_id)
+    WHERE member_id IS NOT NULL;
+
+COMMENT ON TABLE workload_policies IS 'メンバーの勤務量上限（テナント既定・メンバー個別）';
+COMMENT ON COLUMN workload_policies.enforcement IS 'warn: 上限超過は警告のみ、block: 手動割り当てを拒否（force 指定時を除く）';
@@ -0,0 +1,18 @@
+-- Migration: 054_add_recurrence_rule_to_events (Rollback)
+-- Description: イベントの RRULE 定期設定を削除
+
+-- rrule のイベントは定期なしに戻す
+UPDATE events SET recurrence_type = 'none' WHERE recurrence_type = 'rrule';
+
+ALTER TABLE events
+    DROP CONSTRAINT IF EXISTS events_recurrence_rule_check,
+    DROP CONSTRAINT IF EXISTS events_recurrence_type_check;
+
+ALTER TABLE events
+    ADD CONSTRAINT events_recurrence_type_check
+    CHECK (recurrence_type IN ('none', 'weekly', 'biweekly'));
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS recurrence_rule;
+
+COMMENT ON COLUMN events.recurrence_type IS '定期タイプ: none（定期なし）、weekly（毎週）、biweekly（隔週）';
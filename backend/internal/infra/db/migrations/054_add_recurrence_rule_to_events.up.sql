@@ -0,0 +1,21 @@
+-- Migration: 054_add_recurrence_rule_to_events
+-- Description: イベントに RFC 5545 RRULE による定期設定を追加
+
+ALTER TABLE events
+    ADD COLUMN recurrence_rule TEXT;
+
+-- recurrence_type に rrule を追加
+ALTER TABLE events
+    DROP CONSTRAINT IF EXISTS events_recurrence_type_check;
+
+ALTER TABLE events
+    ADD CONSTRAINT events_recurrence_type_check
+    CHECK (recurrence_type IN ('none', 'weekly', 'biweekly', 'rrule'));
+
+-- rrule の場合のみ recurrence_rule を持つ
+ALTER TABLE events
+    ADD CONSTRAINT events_recurrence_rule_check
+    CHECK ((recurrence_type = 'rrule') = (recurrence_rule IS NOT NULL));
+
+COMMENT ON COLUMN events.recurrence_type IS '定期タイプ: none（定期なし）、weekly（毎週）、biweekly（隔週）、rrule（RRULE）';
+COMMENT ON COLUMN events.recurrence_rule IS 'iCalendar 形式の定期ルール（DTSTART / RRULE / EXDATE / RDATE、日付単位）';
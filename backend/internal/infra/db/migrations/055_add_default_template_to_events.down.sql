@@ -0,0 +1,5 @@
+-- Migration: 055_add_default_template_to_events (Rollback)
+-- Description: イベントのデフォルトシフト枠テンプレートを削除
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS default_template_id;
@@ -0,0 +1,8 @@
+-- Migration: 055_add_default_template_to_events
+-- Description: イベントにデフォルトのシフト枠テンプレートを追加（営業日の自動生成時に適用）
+
+ALTER TABLE events
+    ADD COLUMN default_template_id CHAR(26)
+    REFERENCES shift_slot_templates(template_id) ON DELETE SET NULL;
+
+COMMENT ON COLUMN events.default_template_id IS '自動生成した営業日に適用するシフト枠テンプレート（NULL の場合は適用しない）';
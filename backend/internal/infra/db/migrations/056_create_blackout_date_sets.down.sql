@@ -0,0 +1,5 @@
+-- Migration: 056_create_blackout_date_sets (Rollback)
+-- Description: 休業日セットテーブルの削除
+
+DROP TABLE IF EXISTS blackout_dates;
+DROP TABLE IF EXISTS blackout_date_sets;
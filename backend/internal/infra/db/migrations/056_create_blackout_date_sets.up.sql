@@ -0,0 +1,36 @@
+-- Migration: 056_create_blackout_date_sets
+-- Description: テナントの休業日セット（祝日・年末年始・メンテナンス日など）テーブルの作成
+-- 有効なセットに含まれる日付には定期開催の営業日を生成しない（action = move の場合は翌日に移動）
+
+CREATE TABLE IF NOT EXISTS blackout_date_sets (
+    set_id CHAR(26) PRIMARY KEY,           -- ULID形式
+    tenant_id CHAR(26) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description VARCHAR(500) NOT NULL DEFAULT '',
+    action VARCHAR(10) NOT NULL DEFAULT 'skip', -- skip / move
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_blackout_date_sets_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(tenant_id) ON DELETE CASCADE,
+
+    CONSTRAINT blackout_date_sets_action_check CHECK (action IN ('skip', 'move'))
+);
+
+CREATE INDEX idx_blackout_date_sets_tenant ON blackout_date_sets(tenant_id);
+
+CREATE TABLE IF NOT EXISTS blackout_dates (
+    set_id CHAR(26) NOT NULL,
+    blackout_date DATE NOT NULL,
+    label VARCHAR(100) NOT NULL DEFAULT '',
+
+    PRIMARY KEY (set_id, blackout_date),
+
+    CONSTRAINT fk_blackout_dates_set FOREIGN KEY (set_id)
+        REFERENCES blackout_date_sets(set_id) ON DELETE CASCADE
+);
+
+COMMENT ON TABLE blackout_date_sets IS 'テナントの休業日セット（定期開催の営業日生成で除外・移動する日付）';
+COMMENT ON COLUMN blackout_date_sets.action IS 'skip: 開催しない、move: 休業日でない翌日以降の最初の日に移動';
+COMMENT ON TABLE blackout_dates IS '休業日セットに含まれる日付';
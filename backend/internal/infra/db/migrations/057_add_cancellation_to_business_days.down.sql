@@ -0,0 +1,7 @@
+-- Migration: 057_add_cancellation_to_business_days (Rollback)
+-- Description: 営業日の中止状態を削除
+
+ALTER TABLE event_business_days
+    DROP COLUMN IF EXISTS cancellation_reason,
+    DROP COLUMN IF EXISTS cancelled_by_admin_id,
+    DROP COLUMN IF EXISTS cancelled_at;
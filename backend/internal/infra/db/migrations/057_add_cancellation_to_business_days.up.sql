@@ -0,0 +1,11 @@
+-- Migration: 057_add_cancellation_to_business_days
+-- Description: 営業日に中止状態を追加（削除と異なりシフト枠・割り当ては残し、出席の集計からは除外する）
+
+ALTER TABLE event_business_days
+    ADD COLUMN cancelled_at TIMESTAMPTZ NULL,
+    ADD COLUMN cancelled_by_admin_id CHAR(26) NULL,
+    ADD COLUMN cancellation_reason TEXT NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN event_business_days.cancelled_at IS '中止日時（NULL の場合は開催）';
+COMMENT ON COLUMN event_business_days.cancelled_by_admin_id IS '中止した管理者';
+COMMENT ON COLUMN event_business_days.cancellation_reason IS '中止理由';
@@ -0,0 +1,12 @@
+-- Migration: 058_add_end_date_and_day_offset (Rollback)
+-- Description: 営業日の終了日とシフト枠の日数を削除
+
+ALTER TABLE shift_slots
+    DROP CONSTRAINT IF EXISTS shift_slots_day_offset_check,
+    DROP COLUMN IF EXISTS day_offset;
+
+DROP INDEX IF EXISTS idx_event_business_days_event_period;
+
+ALTER TABLE event_business_days
+    DROP CONSTRAINT IF EXISTS event_business_days_end_date_check,
+    DROP COLUMN IF EXISTS end_date;
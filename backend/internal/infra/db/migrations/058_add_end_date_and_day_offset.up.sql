@@ -0,0 +1,37 @@
+-- Migration: 058_add_end_date_and_day_offset
+-- Description: 深夜営業・複数日営業に対応するため、営業日に終了日、シフト枠に営業日からの日数を追加
+
+-- 営業日の終了日（深夜営業は翌日、複数日営業は最終日）
+ALTER TABLE event_business_days
+    ADD COLUMN end_date DATE NULL;
+
+UPDATE event_business_days
+SET end_date = CASE WHEN end_time <= start_time THEN target_date + 1 ELSE target_date END;
+
+ALTER TABLE event_business_days
+    ALTER COLUMN end_date SET NOT NULL,
+    ADD CONSTRAINT event_business_days_end_date_check CHECK (
+        end_date >= target_date AND end_date <= target_date + 7
+    );
+
+-- 期間の重なりで検索するためのインデックス
+CREATE INDEX idx_event_business_days_event_period
+    ON event_business_days(tenant_id, event_id, target_date, end_date)
+    WHERE deleted_at IS NULL;
+
+COMMENT ON COLUMN event_business_days.end_date IS '営業終了日（深夜営業は翌日、複数日営業は最終日）';
+
+-- シフト枠の開始日（営業日の target_date からの日数）
+ALTER TABLE shift_slots
+    ADD COLUMN day_offset SMALLINT NOT NULL DEFAULT 0,
+    ADD CONSTRAINT shift_slots_day_offset_check CHECK (day_offset >= 0 AND day_offset <= 7);
+
+-- 深夜営業で営業開始時刻より前に始まる枠は日付変更後の枠とみなす
+UPDATE shift_slots ss
+SET day_offset = 1
+FROM event_business_days bd
+WHERE ss.business_day_id = bd.business_day_id
+  AND bd.end_date > bd.target_date
+  AND ss.start_time < bd.start_time;
+
+COMMENT ON COLUMN shift_slots.day_offset IS '営業日の target_date から枠の開始日までの日数';
@@ -0,0 +1,15 @@
+-- Migration: 059_add_template_versioning (Rollback)
+-- Description: テンプレートのバージョンと生成元テンプレートの記録を削除
+
+ALTER TABLE shift_slots
+    DROP COLUMN IF EXISTS template_id;
+
+DROP INDEX IF EXISTS idx_event_business_days_applied_template;
+
+ALTER TABLE event_business_days
+    DROP COLUMN IF EXISTS applied_template_version,
+    DROP COLUMN IF EXISTS applied_template_id;
+
+ALTER TABLE shift_slot_templates
+    DROP CONSTRAINT IF EXISTS shift_slot_templates_version_check,
+    DROP COLUMN IF EXISTS version;
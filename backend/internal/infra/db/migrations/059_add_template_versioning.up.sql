@@ -0,0 +1,29 @@
+-- Migration: 059_add_template_versioning
+-- Description: シフト枠テンプレートにバージョンを追加し、営業日・シフト枠に生成元テンプレートを記録
+
+-- テンプレートのバージョン（更新のたびに 1 増える）
+ALTER TABLE shift_slot_templates
+    ADD COLUMN version INT NOT NULL DEFAULT 1,
+    ADD CONSTRAINT shift_slot_templates_version_check CHECK (version >= 1);
+
+COMMENT ON COLUMN shift_slot_templates.version IS 'テンプレートのバージョン（更新のたびに 1 増える）';
+
+-- 営業日のシフト枠を生成したテンプレートとそのバージョン
+ALTER TABLE event_business_days
+    ADD COLUMN applied_template_id CHAR(26) NULL
+        REFERENCES shift_slot_templates(template_id) ON DELETE SET NULL,
+    ADD COLUMN applied_template_version INT NULL;
+
+CREATE INDEX idx_event_business_days_applied_template
+    ON event_business_days(tenant_id, applied_template_id)
+    WHERE applied_template_id IS NOT NULL AND deleted_at IS NULL;
+
+COMMENT ON COLUMN event_business_days.applied_template_id IS 'シフト枠の生成元テンプレート（NULL の場合は未適用）';
+COMMENT ON COLUMN event_business_days.applied_template_version IS 'シフト枠を生成した時点のテンプレートのバージョン';
+
+-- シフト枠の生成元テンプレート（手動作成の枠は NULL）
+ALTER TABLE shift_slots
+    ADD COLUMN template_id CHAR(26) NULL
+        REFERENCES shift_slot_templates(template_id) ON DELETE SET NULL;
+
+COMMENT ON COLUMN shift_slots.template_id IS 'シフト枠の生成元テンプレート（手動作成の場合は NULL）';
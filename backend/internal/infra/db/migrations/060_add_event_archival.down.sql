@@ -0,0 +1,6 @@
+-- Migration: 060_add_event_archival (rollback)
+
+DROP INDEX IF EXISTS idx_events_tenant_not_archived;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS archived_at;
@@ -0,0 +1,13 @@
+-- Migration: 060_add_event_archival
+-- Description: イベントのアーカイブに対応するため、アーカイブ日時を追加
+
+-- アーカイブ済みのイベントは一覧・公開カレンダーに表示しない（営業日・割り当て・出欠の履歴は保持する）
+ALTER TABLE events
+    ADD COLUMN archived_at TIMESTAMPTZ NULL;
+
+-- 有効なイベントの一覧で使用するインデックス
+CREATE INDEX idx_events_tenant_not_archived
+    ON events(tenant_id, created_at DESC)
+    WHERE archived_at IS NULL AND deleted_at IS NULL;
+
+COMMENT ON COLUMN events.archived_at IS 'アーカイブ日時（NULL の場合は有効なイベント）';
@@ -0,0 +1,4 @@
+-- Migration: 061_create_notification_outbox (Rollback)
+-- Description: 通知アウトボックステーブルの削除
+
+DROP TABLE IF EXISTS notification_outbox;
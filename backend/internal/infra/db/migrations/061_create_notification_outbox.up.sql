@@ -0,0 +1,64 @@
+-- Migration: 061_create_notification_outbox
+-- Description: 通知の送信待ち（アウトボックス）テーブルの作成
+-- 通知のきっかけとなる変更と同じトランザクションで書き込み、ワーカーが配信して notification_logs に結果を記録する
+
+CREATE TABLE IF NOT EXISTS notification_outbox (
+    outbox_id CHAR(26) PRIMARY KEY,         -- ULID形式
+    tenant_id CHAR(26) NOT NULL,
+    business_day_id CHAR(26) NULL,          -- 営業日関連通知の場合のみ
+    recipient_id CHAR(26) NOT NULL,         -- 送信先メンバー
+    notification_type VARCHAR(50) NOT NULL,
+    message_content TEXT NOT NULL,
+    delivery_channel VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT NULL,
+    processed_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_notification_outbox_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(tenant_id) ON DELETE CASCADE,
+
+    CONSTRAINT fk_notification_outbox_business_day FOREIGN KEY (business_day_id)
+        REFERENCES event_business_days(business_day_id) ON DELETE SET NULL,
+
+    CONSTRAINT fk_notification_outbox_recipient FOREIGN KEY (recipient_id)
+        REFERENCES members(member_id) ON DELETE CASCADE,
+
+    CONSTRAINT notification_outbox_type_check CHECK (
+        notification_type IN (
+            'shift_recruitment',
+            'deadline_reminder',
+            'shift_confirmed',
+            'attendance_reminder',
+            'urgent_help'
+        )
+    ),
+
+    CONSTRAINT notification_outbox_channel_check CHECK (
+        delivery_channel IN ('Discord', 'Email', 'WebPush')
+    ),
+
+    CONSTRAINT notification_outbox_status_check CHECK (
+        status IN ('pending', 'delivered', 'failed')
+    ),
+
+    CONSTRAINT notification_outbox_attempts_check CHECK (attempts >= 0),
+
+    -- 配信済み・断念したメッセージは processed_at が必須
+    CONSTRAINT notification_outbox_processed_consistency CHECK (
+        (status = 'pending' AND processed_at IS NULL) OR
+        (status IN ('delivered', 'failed') AND processed_at IS NOT NULL)
+    )
+);
+
+-- ワーカーが配信期限の来たメッセージを古い順に取得する
+CREATE INDEX idx_notification_outbox_due
+    ON notification_outbox(next_attempt_at)
+    WHERE status = 'pending';
+
+COMMENT ON TABLE notification_outbox IS '通知アウトボックス: 配信待ちの通知（配信結果は notification_logs に記録）';
+COMMENT ON COLUMN notification_outbox.status IS '状態: pending（配信待ち・リトライ待ち）、delivered（配信済み）、failed（リトライ上限で断念）';
+COMMENT ON COLUMN notification_outbox.next_attempt_at IS '次に配信を試みる日時（失敗時は指数バックオフ、頻度制御で延期した場合も更新）';
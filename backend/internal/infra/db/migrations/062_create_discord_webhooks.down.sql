@@ -0,0 +1,5 @@
+-- Migration: 062_create_discord_webhooks (Rollback)
+-- Description: Discord Webhook 設定と告知履歴テーブルの削除
+
+DROP TABLE IF EXISTS discord_announcements;
+DROP TABLE IF EXISTS discord_webhooks;
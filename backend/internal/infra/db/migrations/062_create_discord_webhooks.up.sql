@@ -0,0 +1,51 @@
+-- Migration: 062_create_discord_webhooks
+-- Description: Discord Webhook 設定と告知履歴テーブルの作成
+-- event_id が NULL の行はテナントの既定設定、それ以外はイベント個別の上書き
+
+CREATE TABLE IF NOT EXISTS discord_webhooks (
+    webhook_id CHAR(26) PRIMARY KEY,       -- ULID形式
+    tenant_id CHAR(26) NOT NULL,
+    event_id CHAR(26) NULL,
+    webhook_url VARCHAR(500) NOT NULL,
+    is_enabled BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_discord_webhooks_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(tenant_id) ON DELETE CASCADE,
+
+    CONSTRAINT fk_discord_webhooks_event FOREIGN KEY (event_id)
+        REFERENCES events(event_id) ON DELETE CASCADE
+);
+
+-- テナントの既定設定は1件まで
+CREATE UNIQUE INDEX idx_discord_webhooks_tenant_default
+    ON discord_webhooks(tenant_id)
+    WHERE event_id IS NULL;
+
+-- イベント個別設定はイベントごとに1件まで
+CREATE UNIQUE INDEX idx_discord_webhooks_event
+    ON discord_webhooks(tenant_id, event_id)
+    WHERE event_id IS NOT NULL;
+
+COMMENT ON TABLE discord_webhooks IS 'Discord Webhook 設定（テナント既定・イベント個別）';
+COMMENT ON COLUMN discord_webhooks.is_enabled IS 'false の場合は投稿しない（イベント個別設定ではテナントの既定設定も使わない）';
+
+-- 告知履歴（締切間近・人員不足などの告知を対象ごとに1回だけ投稿するため）
+CREATE TABLE IF NOT EXISTS discord_announcements (
+    tenant_id CHAR(26) NOT NULL,
+    announcement_kind VARCHAR(50) NOT NULL,
+    subject_id CHAR(26) NOT NULL,          -- 告知対象（collection_id / slot_id など）
+    announced_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (tenant_id, announcement_kind, subject_id),
+
+    CONSTRAINT fk_discord_announcements_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(tenant_id) ON DELETE CASCADE,
+
+    CONSTRAINT discord_announcements_kind_check CHECK (
+        announcement_kind IN ('collection_opened', 'collection_deadline', 'understaffed_slot')
+    )
+);
+
+COMMENT ON TABLE discord_announcements IS 'Discord 告知履歴: 同じ対象への告知の重複投稿を防ぐ';
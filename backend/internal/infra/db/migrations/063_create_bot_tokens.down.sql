@@ -0,0 +1,4 @@
+-- Migration: 063_create_bot_tokens (Rollback)
+-- Description: Discord Bot 用のサービストークンテーブルの削除
+
+DROP TABLE IF EXISTS bot_tokens;
@@ -0,0 +1,22 @@
+-- Migration: 063_create_bot_tokens
+-- Description: Discord Bot 用のサービストークンテーブルの作成
+-- トークン本体は保存せず、SHA-256 ハッシュのみを保存する
+
+CREATE TABLE IF NOT EXISTS bot_tokens (
+    token_id CHAR(26) PRIMARY KEY,         -- ULID形式
+    tenant_id CHAR(26) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    token_prefix VARCHAR(20) NOT NULL,     -- 一覧表示用のトークン先頭部分
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMPTZ NULL,
+
+    CONSTRAINT fk_bot_tokens_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(tenant_id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_bot_tokens_token_hash ON bot_tokens(token_hash);
+CREATE INDEX idx_bot_tokens_tenant ON bot_tokens(tenant_id, created_at DESC);
+
+COMMENT ON TABLE bot_tokens IS 'Discord Bot 用のサービストークン（テナント単位）';
+COMMENT ON COLUMN bot_tokens.revoked_at IS '取り消し日時（取り消したトークンでは認証できない）';
@@ -0,0 +1,4 @@
+-- Migration: 064_create_deadline_reminder_logs (Rollback)
+-- Description: 締切リマインダーの送信履歴テーブルの削除
+
+DROP TABLE IF EXISTS deadline_reminder_logs;
@@ -0,0 +1,26 @@
+-- Migration: 064_create_deadline_reminder_logs
+-- Description: 締切リマインダーの送信履歴テーブルの作成
+-- 同じ締切について、メンバーごとにリマインダーを1回だけ送るために使う
+
+CREATE TABLE IF NOT EXISTS deadline_reminder_logs (
+    tenant_id CHAR(26) NOT NULL,
+    subject_kind VARCHAR(50) NOT NULL,
+    subject_id CHAR(26) NOT NULL,          -- リマインド対象（collection_id / schedule_id）
+    member_id CHAR(26) NOT NULL,
+    deadline TIMESTAMPTZ NOT NULL,         -- リマインド時点の締切
+    reminded_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (tenant_id, subject_kind, subject_id, member_id, deadline),
+
+    CONSTRAINT fk_deadline_reminder_logs_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(tenant_id) ON DELETE CASCADE,
+    CONSTRAINT fk_deadline_reminder_logs_member FOREIGN KEY (member_id)
+        REFERENCES members(member_id) ON DELETE CASCADE,
+
+    CONSTRAINT deadline_reminder_logs_kind_check CHECK (
+        subject_kind IN ('attendance_collection', 'date_schedule')
+    )
+);
+
+COMMENT ON TABLE deadline_reminder_logs IS '締切リマインダー送信履歴: 同じ締切へのリマインダーの重複送信を防ぐ';
+COMMENT ON COLUMN deadline_reminder_logs.deadline IS '締切が変更された場合は新しい締切について改めてリマインドする';
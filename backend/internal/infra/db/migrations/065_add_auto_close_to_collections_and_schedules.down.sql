@@ -0,0 +1,14 @@
+-- Migration: 065_add_auto_close_to_collections_and_schedules (Rollback)
+-- Description: 自動締切日時カラムの削除
+
+DELETE FROM discord_announcements WHERE announcement_kind IN ('collection_closed', 'schedule_closed');
+ALTER TABLE discord_announcements DROP CONSTRAINT IF EXISTS discord_announcements_kind_check;
+ALTER TABLE discord_announcements ADD CONSTRAINT discord_announcements_kind_check CHECK (
+    announcement_kind IN ('collection_opened', 'collection_deadline', 'understaffed_slot')
+);
+
+DROP INDEX IF EXISTS idx_date_schedules_open_deadline;
+DROP INDEX IF EXISTS idx_attendance_collections_open_deadline;
+
+ALTER TABLE date_schedules DROP COLUMN IF EXISTS auto_closed_at;
+ALTER TABLE attendance_collections DROP COLUMN IF EXISTS auto_closed_at;
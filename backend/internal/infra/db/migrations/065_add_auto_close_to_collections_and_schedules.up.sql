@@ -0,0 +1,23 @@
+-- Migration: 065_add_auto_close_to_collections_and_schedules
+-- Description: 締切を過ぎた出欠確認・日程調整をシステムが締め切った日時の追加
+-- 管理者が締め切った場合は NULL のまま（システムによる締切と区別する）
+
+ALTER TABLE attendance_collections ADD COLUMN IF NOT EXISTS auto_closed_at TIMESTAMPTZ NULL;
+ALTER TABLE date_schedules ADD COLUMN IF NOT EXISTS auto_closed_at TIMESTAMPTZ NULL;
+
+-- 締切切れの検索用（受付中のもののみ）
+CREATE INDEX IF NOT EXISTS idx_attendance_collections_open_deadline
+    ON attendance_collections(deadline)
+    WHERE status = 'open' AND deadline IS NOT NULL AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_date_schedules_open_deadline
+    ON date_schedules(deadline)
+    WHERE status = 'open' AND deadline IS NOT NULL AND deleted_at IS NULL;
+
+COMMENT ON COLUMN attendance_collections.auto_closed_at IS '締切を過ぎてシステムが締め切った日時（管理者が締め切った場合は NULL）';
+COMMENT ON COLUMN date_schedules.auto_closed_at IS '締切を過ぎてシステムが締め切った日時（管理者が締め切った場合は NULL）';
+
+-- 締切のまとめの告知種別を追加
+ALTER TABLE discord_announcements DROP CONSTRAINT IF EXISTS discord_announcements_kind_check;
+ALTER TABLE discord_announcements ADD CONSTRAINT discord_announcements_kind_check CHECK (
+    announcement_kind IN ('collection_opened', 'collection_deadline', 'understaffed_slot', 'collection_closed', 'schedule_closed')
+);
@@ -0,0 +1,6 @@
+-- Migration: 066_add_is_live_to_shift_assignments (Rollback)
+-- Description: 公開済みフラグと一意制約の削除
+
+DROP INDEX IF EXISTS idx_shift_assignments_live_slot_member_unique;
+
+ALTER TABLE shift_assignments DROP COLUMN IF EXISTS is_live;
@@ -0,0 +1,30 @@
+-- Migration: 066_add_is_live_to_shift_assignments
+-- Description: 公開済みの割り当てを示すフラグと、公開済みの確定割り当ての一意制約の追加
+-- plan_id が NULL、または公開中のシフト計画に属する割り当てを is_live = TRUE とする
+-- （部分インデックスの条件から shift_plans を参照できないため、割り当て側に持たせる）
+-- プランの公開・アーカイブ時に、アプリケーションがプランの割り当ての is_live を切り替える
+
+ALTER TABLE shift_assignments ADD COLUMN IF NOT EXISTS is_live BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE shift_assignments SET is_live = TRUE
+WHERE plan_id IS NULL OR plan_id IN (
+    SELECT plan_id FROM shift_plans WHERE plan_status = 'published' AND deleted_at IS NULL
+);
+
+-- 公開済みの重複した確定割り当ては、最初に確定したもの以外を論理削除する
+-- （インデックス作成前の並行実行で作られたもの）
+UPDATE shift_assignments sa SET deleted_at = NOW(), updated_at = NOW()
+WHERE sa.is_live AND sa.assignment_status = 'confirmed' AND sa.deleted_at IS NULL
+  AND EXISTS (
+    SELECT 1 FROM shift_assignments other
+    WHERE other.slot_id = sa.slot_id AND other.member_id = sa.member_id
+      AND other.is_live AND other.assignment_status = 'confirmed' AND other.deleted_at IS NULL
+      AND (other.assigned_at, other.assignment_id) < (sa.assigned_at, sa.assignment_id)
+  );
+
+-- 同じ枠・メンバーの公開済みの確定割り当ては 1 件のみ
+CREATE UNIQUE INDEX IF NOT EXISTS idx_shift_assignments_live_slot_member_unique
+    ON shift_assignments(slot_id, member_id)
+    WHERE is_live AND assignment_status = 'confirmed' AND deleted_at IS NULL;
+
+COMMENT ON COLUMN shift_assignments.is_live IS '公開済みの割り当てか（plan_id が NULL、または公開中のシフト計画に属する）';
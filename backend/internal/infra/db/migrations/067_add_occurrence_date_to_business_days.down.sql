@@ -0,0 +1,6 @@
+-- Migration: 067_add_occurrence_date_to_business_days (Rollback)
+-- Description: 定期営業の本来の開催日の削除
+
+DROP INDEX IF EXISTS idx_event_business_days_event_occurrence_date;
+
+ALTER TABLE event_business_days DROP COLUMN IF EXISTS occurrence_date;
@@ -0,0 +1,15 @@
+-- Migration: 067_add_occurrence_date_to_business_days
+-- Description: 定期営業の本来の開催日の追加
+-- 日時を変更・論理削除した定期営業日を、自動生成が元の開催日に作り直さないようにする
+
+ALTER TABLE event_business_days ADD COLUMN IF NOT EXISTS occurrence_date DATE;
+
+UPDATE event_business_days SET occurrence_date = target_date
+WHERE occurrence_type = 'recurring' AND occurrence_date IS NULL;
+
+-- 自動生成時の作成済み判定（論理削除済みを含む）
+CREATE INDEX IF NOT EXISTS idx_event_business_days_event_occurrence_date
+    ON event_business_days(tenant_id, event_id, occurrence_date)
+    WHERE occurrence_date IS NOT NULL;
+
+COMMENT ON COLUMN event_business_days.occurrence_date IS '定期設定から生成された本来の開催日（recurring のみ。日時を変更しても変わらない）';
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// NotificationLogRepository implements notification.LogRepository for PostgreSQL
+type NotificationLogRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewNotificationLogRepository creates a new NotificationLogRepository
+func NewNotificationLogRepository(pool *pgxpool.Pool) *NotificationLogRepository {
+	return &NotificationLogRepository{pool: pool}
+}
+
+// Save inserts a notification log
+func (r *NotificationLogRepository) Save(ctx context.Context, log *notification.NotificationLog) error {
+	query := `
+		INSERT INTO notification_logs (
+			log_id, tenant_id, business_day_id, recipient_id, notification_type, message_content,
+			delivery_channel, delivery_status, error_message, sent_at, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	var businessDayID *string
+	if log.BusinessDayID() != nil {
+		s := log.BusinessDayID().String()
+		businessDayID = &s
+	}
+
+	_, err := GetTx(ctx, r.pool).Exec(ctx, query,
+		log.LogID().String(),
+		log.TenantID().String(),
+		businessDayID,
+		log.RecipientID().String(),
+		string(log.NotificationType()),
+		log.Content(),
+		string(log.Channel()),
+		string(log.Status()),
+		nullString(log.ErrorMessage()),
+		log.SentAt(),
+		log.CreatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save notification log: %w", err)
+	}
+
+	return nil
+}
+
+// CountSentSince counts the successful deliveries to a recipient since the given time
+func (r *NotificationLogRepository) CountSentSince(ctx context.Context, tenantID common.TenantID, recipientID common.MemberID, since time.Time) (int, error) {
+	// idx_notification_logs_recipient_sent_at (recipient_id, sent_at DESC) WHERE sent_at IS NOT NULL を使う
+	query := `
+		SELECT COUNT(*)
+		FROM notification_logs
+		WHERE recipient_id = $1
+		AND sent_at IS NOT NULL
+		AND sent_at >= $2
+		AND tenant_id = $3
+		AND delivery_status = 'success'
+	`
+
+	var count int
+	err := GetTx(ctx, r.pool).QueryRow(ctx, query, recipientID.String(), since, tenantID.String()).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count notification logs: %w", err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,184 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// NotificationOutboxRepository implements notification.OutboxRepository for PostgreSQL
+type NotificationOutboxRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewNotificationOutboxRepository creates a new NotificationOutboxRepository
+func NewNotificationOutboxRepository(pool *pgxpool.Pool) *NotificationOutboxRepository {
+	return &NotificationOutboxRepository{pool: pool}
+}
+
+const notificationOutboxColumns = `
+	outbox_id, tenant_id, business_day_id, recipient_id, notification_type, message_content,
+	delivery_channel, status, attempts, next_attempt_at, last_error, processed_at, created_at, updated_at
+`
+
+// Save saves an outbox message (insert or update)
+func (r *NotificationOutboxRepository) Save(ctx context.Context, msg *notification.OutboxMessage) error {
+	query := `
+		INSERT INTO notification_outbox (` + notificationOutboxColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT (outbox_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			attempts = EXCLUDED.attempts,
+			next_attempt_at = EXCLUDED.next_attempt_at,
+			last_error = EXCLUDED.last_error,
+			processed_at = EXCLUDED.processed_at,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	var businessDayID *string
+	if msg.BusinessDayID() != nil {
+		s := msg.BusinessDayID().String()
+		businessDayID = &s
+	}
+
+	_, err := GetTx(ctx, r.pool).Exec(ctx, query,
+		msg.OutboxID().String(),
+		msg.TenantID().String(),
+		businessDayID,
+		msg.RecipientID().String(),
+		string(msg.NotificationType()),
+		msg.Content(),
+		string(msg.Channel()),
+		string(msg.Status()),
+		msg.Attempts(),
+		msg.NextAttemptAt(),
+		nullString(msg.LastError()),
+		msg.ProcessedAt(),
+		msg.CreatedAt(),
+		msg.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save notification outbox message: %w", err)
+	}
+
+	return nil
+}
+
+// FindDue finds pending messages whose next attempt is due, oldest first
+// 複数のワーカーが同時に動いても同じメッセージを二重に配信しないよう、行ロックを取得してロック中の行はスキップする
+func (r *NotificationOutboxRepository) FindDue(ctx context.Context, now time.Time, channels []notification.Channel, limit int) ([]*notification.OutboxMessage, error) {
+	if len(channels) == 0 || limit <= 0 {
+		return nil, nil
+	}
+
+	channelStrs := make([]string, 0, len(channels))
+	for _, c := range channels {
+		channelStrs = append(channelStrs, string(c))
+	}
+
+	query := `
+		SELECT ` + notificationOutboxColumns + `
+		FROM notification_outbox
+		WHERE status = 'pending'
+		AND next_attempt_at <= $1
+		AND delivery_channel = ANY($2)
+		ORDER BY next_attempt_at ASC, outbox_id ASC
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := GetTx(ctx, r.pool).Query(ctx, query, now, channelStrs, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notification outbox: %w", err)
+	}
+	defer rows.Close()
+
+	var messages []*notification.OutboxMessage
+	for rows.Next() {
+		msg, err := scanOutboxMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, msg)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating notification outbox rows: %w", err)
+	}
+
+	return messages, nil
+}
+
+func scanOutboxMessage(row pgx.Row) (*notification.OutboxMessage, error) {
+	var (
+		outboxIDStr      string
+		tenantIDStr      string
+		businessDayIDStr sql.NullString
+		recipientIDStr   string
+		notificationType string
+		content          string
+		channel          string
+		status           string
+		attempts         int
+		nextAttemptAt    time.Time
+		lastError        sql.NullString
+		processedAt      sql.NullTime
+		createdAt        time.Time
+		updatedAt        time.Time
+	)
+
+	err := row.Scan(
+		&outboxIDStr,
+		&tenantIDStr,
+		&businessDayIDStr,
+		&recipientIDStr,
+		&notificationType,
+		&content,
+		&channel,
+		&status,
+		&attempts,
+		&nextAttemptAt,
+		&lastError,
+		&processedAt,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan notification outbox row: %w", err)
+	}
+
+	var businessDayID *event.BusinessDayID
+	if businessDayIDStr.Valid {
+		id := event.BusinessDayID(businessDayIDStr.String)
+		businessDayID = &id
+	}
+
+	msg, err := notification.ReconstructOutboxMessage(
+		notification.OutboxID(outboxIDStr),
+		common.TenantID(tenantIDStr),
+		businessDayID,
+		common.MemberID(recipientIDStr),
+		notification.NotificationType(notificationType),
+		notification.Channel(channel),
+		content,
+		notification.OutboxStatus(status),
+		attempts,
+		nextAttemptAt,
+		stringValue(lastError),
+		nullTimePtr(processedAt),
+		createdAt,
+		updatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconstruct notification outbox message: %w", err)
+	}
+
+	return msg, nil
+}
@@ -40,15 +40,16 @@ func (r *ScheduleRepository) Save(ctx context.Context, s *schedule.DateSchedule)
 	query := `
 		INSERT INTO date_schedules (
 			schedule_id, tenant_id, title, description, event_id,
-			public_token, status, deadline, decided_candidate_id,
+			public_token, status, deadline, decided_candidate_id, auto_closed_at,
 			created_at, updated_at, deleted_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 		ON CONFLICT (schedule_id) DO UPDATE SET
 			title = EXCLUDED.title,
 			description = EXCLUDED.description,
 			status = EXCLUDED.status,
 			deadline = EXCLUDED.deadline,
 			decided_candidate_id = EXCLUDED.decided_candidate_id,
+			auto_closed_at = EXCLUDED.auto_closed_at,
 			updated_at = EXCLUDED.updated_at,
 			deleted_at = EXCLUDED.deleted_at
 	`
@@ -75,6 +76,7 @@ func (r *ScheduleRepository) Save(ctx context.Context, s *schedule.DateSchedule)
 		s.Status().String(),
 		s.Deadline(),
 		decidedCandidateIDStr,
+		s.AutoClosedAt(),
 		s.CreatedAt(),
 		s.UpdatedAt(),
 		s.DeletedAt(),
@@ -124,13 +126,30 @@ func (r *ScheduleRepository) Save(ctx context.Context, s *schedule.DateSchedule)
 	return nil
 }
 
+// CloseIfExpired closes the schedule only if it is still open and its deadline has passed
+func (r *ScheduleRepository) CloseIfExpired(ctx context.Context, tenantID common.TenantID, id common.ScheduleID, now time.Time) (bool, error) {
+	query := `
+		UPDATE date_schedules
+		SET status = 'closed', auto_closed_at = $3, updated_at = $3
+		WHERE tenant_id = $1 AND schedule_id = $2
+			AND status = 'open' AND deadline < $3 AND deleted_at IS NULL
+	`
+
+	tag, err := GetTx(ctx, r.pool).Exec(ctx, query, tenantID.String(), id.String(), now)
+	if err != nil {
+		return false, fmt.Errorf("failed to close expired schedule: %w", err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
 // FindByID finds a schedule by ID
 func (r *ScheduleRepository) FindByID(ctx context.Context, tenantID common.TenantID, id common.ScheduleID) (*schedule.DateSchedule, error) {
 	executor := GetTx(ctx, r.pool)
 
 	query := `
 		SELECT schedule_id, tenant_id, title, description, event_id, public_token, status,
-			deadline, decided_candidate_id, created_at, updated_at, deleted_at
+			deadline, decided_candidate_id, auto_closed_at, created_at, updated_at, deleted_at
 		FROM date_schedules
 		WHERE tenant_id = $1 AND schedule_id = $2 AND deleted_at IS NULL
 	`
@@ -138,13 +157,13 @@ func (r *ScheduleRepository) FindByID(ctx context.Context, tenantID common.Tenan
 	var (
 		scheduleIDStr, tenantIDStr, title, description, publicTokenStr, statusStr string
 		eventIDStr, decidedCandidateIDStr                                         *string
-		deadline, deletedAt                                                       sql.NullTime
+		deadline, autoClosedAt, deletedAt                                         sql.NullTime
 		createdAt, updatedAt                                                      time.Time
 	)
 
 	err := executor.QueryRow(ctx, query, tenantID.String(), id.String()).Scan(
 		&scheduleIDStr, &tenantIDStr, &title, &description, &eventIDStr, &publicTokenStr, &statusStr,
-		&deadline, &decidedCandidateIDStr, &createdAt, &updatedAt, &deletedAt,
+		&deadline, &decidedCandidateIDStr, &autoClosedAt, &createdAt, &updatedAt, &deletedAt,
 	)
 	if err == pgx.ErrNoRows {
 		return nil, common.NewNotFoundError("DateSchedule", id.String())
@@ -160,7 +179,7 @@ func (r *ScheduleRepository) FindByID(ctx context.Context, tenantID common.Tenan
 	}
 
 	return r.scanSchedule(scheduleIDStr, tenantIDStr, title, description, eventIDStr, publicTokenStr, statusStr,
-		deadline, decidedCandidateIDStr, createdAt, updatedAt, deletedAt, candidates)
+		deadline, decidedCandidateIDStr, autoClosedAt, createdAt, updatedAt, deletedAt, candidates)
 }
 
 // FindByToken finds a schedule by public token
@@ -169,7 +188,7 @@ func (r *ScheduleRepository) FindByToken(ctx context.Context, token common.Publi
 
 	query := `
 		SELECT schedule_id, tenant_id, title, description, event_id, public_token, status,
-			deadline, decided_candidate_id, created_at, updated_at, deleted_at
+			deadline, decided_candidate_id, auto_closed_at, created_at, updated_at, deleted_at
 		FROM date_schedules
 		WHERE public_token = $1 AND deleted_at IS NULL
 	`
@@ -177,13 +196,13 @@ func (r *ScheduleRepository) FindByToken(ctx context.Context, token common.Publi
 	var (
 		scheduleIDStr, tenantIDStr, title, description, publicTokenStr, statusStr string
 		eventIDStr, decidedCandidateIDStr                                         *string
-		deadline, deletedAt                                                       sql.NullTime
+		deadline, autoClosedAt, deletedAt                                         sql.NullTime
 		createdAt, updatedAt                                                      time.Time
 	)
 
 	err := executor.QueryRow(ctx, query, token.String()).Scan(
 		&scheduleIDStr, &tenantIDStr, &title, &description, &eventIDStr, &publicTokenStr, &statusStr,
-		&deadline, &decidedCandidateIDStr, &createdAt, &updatedAt, &deletedAt,
+		&deadline, &decidedCandidateIDStr, &autoClosedAt, &createdAt, &updatedAt, &deletedAt,
 	)
 	if err == pgx.ErrNoRows {
 		return nil, common.NewNotFoundError("DateSchedule", token.String())
@@ -199,7 +218,7 @@ func (r *ScheduleRepository) FindByToken(ctx context.Context, token common.Publi
 	}
 
 	return r.scanSchedule(scheduleIDStr, tenantIDStr, title, description, eventIDStr, publicTokenStr, statusStr,
-		deadline, decidedCandidateIDStr, createdAt, updatedAt, deletedAt, candidates)
+		deadline, decidedCandidateIDStr, autoClosedAt, createdAt, updatedAt, deletedAt, candidates)
 }
 
 // FindByTenantID finds all schedules within a tenant
@@ -208,7 +227,7 @@ func (r *ScheduleRepository) FindByTenantID(ctx context.Context, tenantID common
 
 	query := `
 		SELECT schedule_id, tenant_id, title, description, event_id, public_token, status,
-			deadline, decided_candidate_id, created_at, updated_at, deleted_at
+			deadline, decided_candidate_id, auto_closed_at, created_at, updated_at, deleted_at
 		FROM date_schedules
 		WHERE tenant_id = $1 AND deleted_at IS NULL
 		ORDER BY created_at DESC
@@ -225,12 +244,12 @@ func (r *ScheduleRepository) FindByTenantID(ctx context.Context, tenantID common
 		var (
 			scheduleIDStr, tenantIDStr, title, description, publicTokenStr, statusStr string
 			eventIDStr, decidedCandidateIDStr                                         *string
-			deadline, deletedAt                                                       sql.NullTime
+			deadline, autoClosedAt, deletedAt                                         sql.NullTime
 			createdAt, updatedAt                                                      time.Time
 		)
 
 		err := rows.Scan(&scheduleIDStr, &tenantIDStr, &title, &description, &eventIDStr, &publicTokenStr, &statusStr,
-			&deadline, &decidedCandidateIDStr, &createdAt, &updatedAt, &deletedAt)
+			&deadline, &decidedCandidateIDStr, &autoClosedAt, &createdAt, &updatedAt, &deletedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan schedule: %w", err)
 		}
@@ -242,7 +261,7 @@ func (r *ScheduleRepository) FindByTenantID(ctx context.Context, tenantID common
 		}
 
 		s, err := r.scanSchedule(scheduleIDStr, tenantIDStr, title, description, eventIDStr, publicTokenStr, statusStr,
-			deadline, decidedCandidateIDStr, createdAt, updatedAt, deletedAt, candidates)
+			deadline, decidedCandidateIDStr, autoClosedAt, createdAt, updatedAt, deletedAt, candidates)
 		if err != nil {
 			return nil, err
 		}
@@ -379,7 +398,7 @@ func (r *ScheduleRepository) FindCandidatesByScheduleID(ctx context.Context, sch
 func (r *ScheduleRepository) scanSchedule(
 	scheduleIDStr, tenantIDStr, title, description string,
 	eventIDStr *string, publicTokenStr, statusStr string,
-	deadline sql.NullTime, decidedCandidateIDStr *string,
+	deadline sql.NullTime, decidedCandidateIDStr *string, autoClosedAt sql.NullTime,
 	createdAt, updatedAt time.Time, deletedAt sql.NullTime,
 	candidates []*schedule.CandidateDate,
 ) (*schedule.DateSchedule, error) {
@@ -405,13 +424,18 @@ func (r *ScheduleRepository) scanSchedule(
 		decidedCandidateID = &cid
 	}
 
+	var autoClosedAtPtr *time.Time
+	if autoClosedAt.Valid {
+		autoClosedAtPtr = &autoClosedAt.Time
+	}
+
 	var deletedAtPtr *time.Time
 	if deletedAt.Valid {
 		deletedAtPtr = &deletedAt.Time
 	}
 
 	return schedule.ReconstructDateSchedule(scheduleID, tenantID, title, description, eventID, publicToken, status,
-		deadlinePtr, decidedCandidateID, candidates, createdAt, updatedAt, deletedAtPtr)
+		deadlinePtr, decidedCandidateID, candidates, autoClosedAtPtr, createdAt, updatedAt, deletedAtPtr)
 }
 
 // SaveGroupAssignments saves group assignments for a schedule (deletes existing ones first)
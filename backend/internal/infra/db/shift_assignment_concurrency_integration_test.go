@@ -0,0 +1,160 @@
+package db_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	appshift "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/infra/clock"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/infra/db"
+)
+
+// =====================================================
+// Concurrent ConfirmManualAssignment Integration Tests
+// =====================================================
+
+// TestConfirmManualAssignment_ConcurrentRequestsNeverExceedCapacity は
+// 同じシフト枠に対する並列の確定リクエストで定員を超えないことを検証する
+func TestConfirmManualAssignment_ConcurrentRequestsNeverExceedCapacity(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	const (
+		requiredCount = 3
+		concurrency   = 20
+	)
+
+	// テスト用のテナント・イベント・営業日・シフト枠を作成
+	tenantID := common.NewTenantID()
+	createTestTenant(t, pool, tenantID)
+
+	testEvent, err := event.NewEvent(now, tenantID, "同時割り当てテスト", event.EventTypeNormal, "", event.RecurrenceTypeNone, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create test event: %v", err)
+	}
+	if err := db.NewEventRepository(pool).Save(ctx, testEvent); err != nil {
+		t.Fatalf("Failed to save event: %v", err)
+	}
+
+	businessDay, err := event.NewEventBusinessDay(
+		now, tenantID, testEvent.EventID(),
+		now.AddDate(0, 0, 7),
+		time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+		event.OccurrenceTypeSpecial, nil,
+	)
+	if err != nil {
+		t.Fatalf("Failed to create business day: %v", err)
+	}
+	businessDayRepo := db.NewEventBusinessDayRepository(pool)
+	if err := businessDayRepo.Save(ctx, businessDay); err != nil {
+		t.Fatalf("Failed to save business day: %v", err)
+	}
+
+	slot, err := shift.NewShiftSlot(
+		now, tenantID, businessDay.BusinessDayID(), nil, "受付", "",
+		time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+		requiredCount, 1,
+	)
+	if err != nil {
+		t.Fatalf("Failed to create shift slot: %v", err)
+	}
+	slotRepo := db.NewShiftSlotRepository(pool)
+	if err := slotRepo.Save(ctx, slot); err != nil {
+		t.Fatalf("Failed to save shift slot: %v", err)
+	}
+
+	memberRepo := db.NewMemberRepository(pool)
+	memberIDs := make([]common.MemberID, 0, concurrency)
+	for i := 0; i < concurrency; i++ {
+		mem, err := member.NewMember(now, tenantID, fmt.Sprintf("メンバー%d", i), "", fmt.Sprintf("member%d@example.com", i))
+		if err != nil {
+			t.Fatalf("Failed to create member: %v", err)
+		}
+		if err := memberRepo.Save(ctx, mem); err != nil {
+			t.Fatalf("Failed to save member: %v", err)
+		}
+		memberIDs = append(memberIDs, mem.MemberID())
+	}
+
+	assignmentRepo := db.NewShiftAssignmentRepository(pool)
+	usecase := appshift.NewConfirmManualAssignmentUsecase(
+		slotRepo,
+		assignmentRepo,
+		memberRepo,
+		db.NewMemberRoleRepository(pool),
+		db.NewMemberAvailabilityRepository(pool),
+		db.NewWorkloadPolicyRepository(pool),
+		db.NewEventRepository(pool),
+		businessDayRepo,
+		db.NewNotificationOutboxRepository(pool),
+		db.NewPgxTxManager(pool),
+		&clock.RealClock{},
+	)
+
+	// 全メンバーの確定リクエストを同時に発行
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+		slotFull  int
+		others    []error
+	)
+	start := make(chan struct{})
+	for _, memberID := range memberIDs {
+		wg.Add(1)
+		go func(memberID common.MemberID) {
+			defer wg.Done()
+			<-start
+			_, err := usecase.Execute(ctx, appshift.ConfirmManualAssignmentInput{
+				TenantID: tenantID,
+				SlotID:   slot.SlotID(),
+				MemberID: memberID,
+				ActorID:  memberID,
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				succeeded++
+			case errors.Is(err, shift.ErrSlotFull):
+				slotFull++
+			default:
+				others = append(others, err)
+			}
+		}(memberID)
+	}
+	close(start)
+	wg.Wait()
+
+	if len(others) > 0 {
+		t.Fatalf("unexpected errors: %v", others)
+	}
+	if succeeded != requiredCount {
+		t.Errorf("succeeded = %d, want %d", succeeded, requiredCount)
+	}
+	if slotFull != concurrency-requiredCount {
+		t.Errorf("slot full errors = %d, want %d", slotFull, concurrency-requiredCount)
+	}
+
+	count, err := assignmentRepo.CountConfirmedBySlotID(ctx, tenantID, slot.SlotID())
+	if err != nil {
+		t.Fatalf("Failed to count assignments: %v", err)
+	}
+	if count != requiredCount {
+		t.Errorf("confirmed assignments = %d, want %d", count, requiredCount)
+	}
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,9 +11,15 @@ import (
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// liveAssignmentCondition は公開済みの割り当てに絞り込む条件
+// plan_id が NULL（プランを経由しない割り当て）または公開中のシフト計画に属する割り当てのみ対象とする
+// is_live は作成時にプランの状態から決まり、プランの公開・アーカイブ時に SetLive で切り替える
+const liveAssignmentCondition = `is_live`
+
 // ShiftAssignmentRepository implements shift.ShiftAssignmentRepository for PostgreSQL
 type ShiftAssignmentRepository struct {
 	db *pgxpool.Pool
@@ -24,17 +31,22 @@ func NewShiftAssignmentRepository(db *pgxpool.Pool) *ShiftAssignmentRepository {
 }
 
 // Save saves a shift assignment (insert or update)
+// 新規作成時の is_live はプランの状態から決める（更新時は変更しない）
 func (r *ShiftAssignmentRepository) Save(ctx context.Context, assignment *shift.ShiftAssignment) error {
 	query := `
 		INSERT INTO shift_assignments (
 			assignment_id, tenant_id, plan_id, slot_id, member_id,
-			assignment_status, assignment_method, is_outside_preference,
-			assigned_at, cancelled_at, created_at, updated_at, deleted_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+			assignment_status, assignment_method, is_outside_preference, is_conflict_overridden,
+			assigned_at, cancelled_at, created_at, updated_at, deleted_at, is_live
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14,
+			$3 IS NULL OR EXISTS (
+				SELECT 1 FROM shift_plans sp WHERE sp.plan_id = $3 AND sp.plan_status = 'published' AND sp.deleted_at IS NULL
+			))
 		ON CONFLICT (assignment_id) DO UPDATE SET
 			assignment_status = EXCLUDED.assignment_status,
 			assignment_method = EXCLUDED.assignment_method,
 			is_outside_preference = EXCLUDED.is_outside_preference,
+			is_conflict_overridden = EXCLUDED.is_conflict_overridden,
 			cancelled_at = EXCLUDED.cancelled_at,
 			updated_at = EXCLUDED.updated_at,
 			deleted_at = EXCLUDED.deleted_at
@@ -48,7 +60,7 @@ func (r *ShiftAssignmentRepository) Save(ctx context.Context, assignment *shift.
 		planIDValue = assignment.PlanID().String()
 	}
 
-	_, err := r.db.Exec(ctx, query,
+	_, err := GetTx(ctx, r.db).Exec(ctx, query,
 		assignment.AssignmentID().String(),
 		assignment.TenantID().String(),
 		planIDValue,
@@ -57,6 +69,7 @@ func (r *ShiftAssignmentRepository) Save(ctx context.Context, assignment *shift.
 		string(assignment.AssignmentStatus()),
 		string(assignment.AssignmentMethod()),
 		assignment.IsOutsidePreference(),
+		assignment.IsConflictOverridden(),
 		assignment.AssignedAt(),
 		assignment.CancelledAt(),
 		assignment.CreatedAt(),
@@ -64,6 +77,9 @@ func (r *ShiftAssignmentRepository) Save(ctx context.Context, assignment *shift.
 		assignment.DeletedAt(),
 	)
 
+	if isUniqueViolation(err) {
+		return shift.ErrAlreadyAssigned
+	}
 	if err != nil {
 		return fmt.Errorf("failed to save shift assignment: %w", err)
 	}
@@ -71,34 +87,53 @@ func (r *ShiftAssignmentRepository) Save(ctx context.Context, assignment *shift.
 	return nil
 }
 
+// SetLive switches whether the assignment is published to members
+func (r *ShiftAssignmentRepository) SetLive(ctx context.Context, tenantID common.TenantID, assignmentID shift.AssignmentID, live bool) error {
+	query := `
+		UPDATE shift_assignments SET is_live = $3
+		WHERE tenant_id = $1 AND assignment_id = $2
+	`
+
+	_, err := GetTx(ctx, r.db).Exec(ctx, query, tenantID.String(), assignmentID.String(), live)
+	if isUniqueViolation(err) {
+		return shift.ErrAlreadyAssigned
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update shift assignment visibility: %w", err)
+	}
+
+	return nil
+}
+
 // FindByID finds a shift assignment by ID within a tenant
 func (r *ShiftAssignmentRepository) FindByID(ctx context.Context, tenantID common.TenantID, assignmentID shift.AssignmentID) (*shift.ShiftAssignment, error) {
 	query := `
 		SELECT
 			assignment_id, tenant_id, plan_id, slot_id, member_id,
-			assignment_status, assignment_method, is_outside_preference,
+			assignment_status, assignment_method, is_outside_preference, is_conflict_overridden,
 			assigned_at, cancelled_at, created_at, updated_at, deleted_at
 		FROM shift_assignments
 		WHERE tenant_id = $1 AND assignment_id = $2 AND deleted_at IS NULL
 	`
 
 	var (
-		assignmentIDStr     string
-		tenantIDStr         string
-		planIDStr           sql.NullString
-		slotIDStr           string
-		memberIDStr         string
-		assignmentStatusStr string
-		assignmentMethodStr string
-		isOutsidePreference bool
-		assignedAt          time.Time
-		cancelledAt         sql.NullTime
-		createdAt           time.Time
-		updatedAt           time.Time
-		deletedAt           sql.NullTime
+		assignmentIDStr      string
+		tenantIDStr          string
+		planIDStr            sql.NullString
+		slotIDStr            string
+		memberIDStr          string
+		assignmentStatusStr  string
+		assignmentMethodStr  string
+		isOutsidePreference  bool
+		isConflictOverridden bool
+		assignedAt           time.Time
+		cancelledAt          sql.NullTime
+		createdAt            time.Time
+		updatedAt            time.Time
+		deletedAt            sql.NullTime
 	)
 
-	err := r.db.QueryRow(ctx, query, tenantID.String(), assignmentID.String()).Scan(
+	err := GetTx(ctx, r.db).QueryRow(ctx, query, tenantID.String(), assignmentID.String()).Scan(
 		&assignmentIDStr,
 		&tenantIDStr,
 		&planIDStr,
@@ -107,6 +142,7 @@ func (r *ShiftAssignmentRepository) FindByID(ctx context.Context, tenantID commo
 		&assignmentStatusStr,
 		&assignmentMethodStr,
 		&isOutsidePreference,
+		&isConflictOverridden,
 		&assignedAt,
 		&cancelledAt,
 		&createdAt,
@@ -123,7 +159,7 @@ func (r *ShiftAssignmentRepository) FindByID(ctx context.Context, tenantID commo
 
 	return r.scanToShiftAssignment(
 		assignmentIDStr, tenantIDStr, stringValue(planIDStr), slotIDStr, memberIDStr,
-		assignmentStatusStr, assignmentMethodStr, isOutsidePreference,
+		assignmentStatusStr, assignmentMethodStr, isOutsidePreference, isConflictOverridden,
 		assignedAt, cancelledAt, createdAt, updatedAt, deletedAt,
 	)
 }
@@ -133,10 +169,10 @@ func (r *ShiftAssignmentRepository) FindBySlotID(ctx context.Context, tenantID c
 	query := `
 		SELECT
 			assignment_id, tenant_id, plan_id, slot_id, member_id,
-			assignment_status, assignment_method, is_outside_preference,
+			assignment_status, assignment_method, is_outside_preference, is_conflict_overridden,
 			assigned_at, cancelled_at, created_at, updated_at, deleted_at
 		FROM shift_assignments
-		WHERE tenant_id = $1 AND slot_id = $2 AND deleted_at IS NULL
+		WHERE tenant_id = $1 AND slot_id = $2 AND deleted_at IS NULL AND ` + liveAssignmentCondition + `
 		ORDER BY assigned_at ASC
 	`
 
@@ -148,10 +184,10 @@ func (r *ShiftAssignmentRepository) FindConfirmedBySlotID(ctx context.Context, t
 	query := `
 		SELECT
 			assignment_id, tenant_id, plan_id, slot_id, member_id,
-			assignment_status, assignment_method, is_outside_preference,
+			assignment_status, assignment_method, is_outside_preference, is_conflict_overridden,
 			assigned_at, cancelled_at, created_at, updated_at, deleted_at
 		FROM shift_assignments
-		WHERE tenant_id = $1 AND slot_id = $2 AND assignment_status = 'confirmed' AND deleted_at IS NULL
+		WHERE tenant_id = $1 AND slot_id = $2 AND assignment_status = 'confirmed' AND deleted_at IS NULL AND ` + liveAssignmentCondition + `
 		ORDER BY assigned_at ASC
 	`
 
@@ -163,10 +199,10 @@ func (r *ShiftAssignmentRepository) FindByMemberID(ctx context.Context, tenantID
 	query := `
 		SELECT
 			assignment_id, tenant_id, plan_id, slot_id, member_id,
-			assignment_status, assignment_method, is_outside_preference,
+			assignment_status, assignment_method, is_outside_preference, is_conflict_overridden,
 			assigned_at, cancelled_at, created_at, updated_at, deleted_at
 		FROM shift_assignments
-		WHERE tenant_id = $1 AND member_id = $2 AND deleted_at IS NULL
+		WHERE tenant_id = $1 AND member_id = $2 AND deleted_at IS NULL AND ` + liveAssignmentCondition + `
 		ORDER BY assigned_at DESC
 	`
 
@@ -178,10 +214,10 @@ func (r *ShiftAssignmentRepository) FindConfirmedByMemberID(ctx context.Context,
 	query := `
 		SELECT
 			assignment_id, tenant_id, plan_id, slot_id, member_id,
-			assignment_status, assignment_method, is_outside_preference,
+			assignment_status, assignment_method, is_outside_preference, is_conflict_overridden,
 			assigned_at, cancelled_at, created_at, updated_at, deleted_at
 		FROM shift_assignments
-		WHERE tenant_id = $1 AND member_id = $2 AND assignment_status = 'confirmed' AND deleted_at IS NULL
+		WHERE tenant_id = $1 AND member_id = $2 AND assignment_status = 'confirmed' AND deleted_at IS NULL AND ` + liveAssignmentCondition + `
 		ORDER BY assigned_at DESC
 	`
 
@@ -193,7 +229,7 @@ func (r *ShiftAssignmentRepository) FindByPlanID(ctx context.Context, tenantID c
 	query := `
 		SELECT
 			assignment_id, tenant_id, plan_id, slot_id, member_id,
-			assignment_status, assignment_method, is_outside_preference,
+			assignment_status, assignment_method, is_outside_preference, is_conflict_overridden,
 			assigned_at, cancelled_at, created_at, updated_at, deleted_at
 		FROM shift_assignments
 		WHERE tenant_id = $1 AND plan_id = $2 AND deleted_at IS NULL
@@ -208,11 +244,11 @@ func (r *ShiftAssignmentRepository) CountConfirmedBySlotID(ctx context.Context,
 	query := `
 		SELECT COUNT(*)
 		FROM shift_assignments
-		WHERE tenant_id = $1 AND slot_id = $2 AND assignment_status = 'confirmed' AND deleted_at IS NULL
+		WHERE tenant_id = $1 AND slot_id = $2 AND assignment_status = 'confirmed' AND deleted_at IS NULL AND ` + liveAssignmentCondition + `
 	`
 
 	var count int
-	err := r.db.QueryRow(ctx, query, tenantID.String(), slotID.String()).Scan(&count)
+	err := GetTx(ctx, r.db).QueryRow(ctx, query, tenantID.String(), slotID.String()).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count confirmed assignments: %w", err)
 	}
@@ -227,7 +263,7 @@ func (r *ShiftAssignmentRepository) Delete(ctx context.Context, tenantID common.
 		WHERE tenant_id = $1 AND assignment_id = $2
 	`
 
-	result, err := r.db.Exec(ctx, query, tenantID.String(), assignmentID.String())
+	result, err := GetTx(ctx, r.db).Exec(ctx, query, tenantID.String(), assignmentID.String())
 	if err != nil {
 		return fmt.Errorf("failed to delete shift assignment: %w", err)
 	}
@@ -244,12 +280,12 @@ func (r *ShiftAssignmentRepository) ExistsBySlotIDAndMemberID(ctx context.Contex
 	query := `
 		SELECT EXISTS(
 			SELECT 1 FROM shift_assignments
-			WHERE tenant_id = $1 AND slot_id = $2 AND member_id = $3 AND assignment_status = 'confirmed' AND deleted_at IS NULL
+			WHERE tenant_id = $1 AND slot_id = $2 AND member_id = $3 AND assignment_status = 'confirmed' AND deleted_at IS NULL AND ` + liveAssignmentCondition + `
 		)
 	`
 
 	var exists bool
-	err := r.db.QueryRow(ctx, query, tenantID.String(), slotID.String(), memberID.String()).Scan(&exists)
+	err := GetTx(ctx, r.db).QueryRow(ctx, query, tenantID.String(), slotID.String(), memberID.String()).Scan(&exists)
 	if err != nil {
 		return false, fmt.Errorf("failed to check shift assignment existence: %w", err)
 	}
@@ -268,11 +304,12 @@ func (r *ShiftAssignmentRepository) HasConfirmedByMemberAndBusinessDayID(ctx con
 			  AND ss.business_day_id = $3
 			  AND sa.assignment_status = 'confirmed'
 			  AND sa.deleted_at IS NULL
+			  AND ` + liveAssignmentCondition + `
 		)
 	`
 
 	var exists bool
-	err := r.db.QueryRow(ctx, query, tenantID.String(), memberID.String(), string(businessDayID)).Scan(&exists)
+	err := GetTx(ctx, r.db).QueryRow(ctx, query, tenantID.String(), memberID.String(), string(businessDayID)).Scan(&exists)
 	if err != nil {
 		return false, fmt.Errorf("failed to check member attendance: %w", err)
 	}
@@ -285,20 +322,131 @@ func (r *ShiftAssignmentRepository) FindByBusinessDayID(ctx context.Context, ten
 	query := `
 		SELECT
 			sa.assignment_id, sa.tenant_id, sa.plan_id, sa.slot_id, sa.member_id,
-			sa.assignment_status, sa.assignment_method, sa.is_outside_preference,
+			sa.assignment_status, sa.assignment_method, sa.is_outside_preference, sa.is_conflict_overridden,
 			sa.assigned_at, sa.cancelled_at, sa.created_at, sa.updated_at, sa.deleted_at
 		FROM shift_assignments sa
 		INNER JOIN shift_slots ss ON sa.slot_id = ss.slot_id AND ss.deleted_at IS NULL
-		WHERE sa.tenant_id = $1 AND ss.business_day_id = $2 AND sa.deleted_at IS NULL
-		ORDER BY ss.start_time ASC, sa.assigned_at ASC
+		WHERE sa.tenant_id = $1 AND ss.business_day_id = $2 AND sa.deleted_at IS NULL AND ` + liveAssignmentCondition + `
+		ORDER BY ss.day_offset ASC, ss.start_time ASC, sa.assigned_at ASC
 	`
 
 	return r.queryShiftAssignments(ctx, query, tenantID.String(), string(businessDayID))
 }
 
+// FindConfirmedShiftsByDateRange finds confirmed assignments whose business day is within [from, to]
+func (r *ShiftAssignmentRepository) FindConfirmedShiftsByDateRange(ctx context.Context, tenantID common.TenantID, memberID *common.MemberID, from, to time.Time) ([]shift.AssignedShift, error) {
+	query := `
+		SELECT
+			sa.assignment_id, sa.member_id, sa.slot_id, ss.slot_name, ss.business_day_id,
+			bd.target_date, ss.start_time, ss.end_time, ss.day_offset
+		FROM shift_assignments sa
+		INNER JOIN shift_slots ss ON sa.slot_id = ss.slot_id AND ss.deleted_at IS NULL
+		INNER JOIN event_business_days bd ON ss.business_day_id = bd.business_day_id
+			AND bd.deleted_at IS NULL AND bd.cancelled_at IS NULL
+		WHERE sa.tenant_id = $1
+		  AND ($2::text IS NULL OR sa.member_id = $2)
+		  AND bd.target_date >= $3 AND bd.target_date <= $4
+		  AND sa.assignment_status = 'confirmed'
+		  AND sa.deleted_at IS NULL
+		  AND ` + liveAssignmentCondition + `
+		ORDER BY bd.target_date ASC, ss.day_offset ASC, ss.start_time ASC
+	`
+
+	var memberIDArg *string
+	if memberID != nil {
+		s := memberID.String()
+		memberIDArg = &s
+	}
+
+	rows, err := GetTx(ctx, r.db).Query(ctx, query, tenantID.String(), memberIDArg, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query assigned shifts: %w", err)
+	}
+	defer rows.Close()
+
+	var shifts []shift.AssignedShift
+	for rows.Next() {
+		var (
+			assignmentIDStr  string
+			memberIDStr      string
+			slotIDStr        string
+			businessDayIDStr string
+			s                shift.AssignedShift
+		)
+		if err := rows.Scan(
+			&assignmentIDStr, &memberIDStr, &slotIDStr, &s.SlotName, &businessDayIDStr,
+			&s.TargetDate, &s.StartTime, &s.EndTime, &s.DayOffset,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan assigned shift row: %w", err)
+		}
+		s.AssignmentID = shift.AssignmentID(assignmentIDStr)
+		s.MemberID = common.MemberID(memberIDStr)
+		s.SlotID = shift.SlotID(slotIDStr)
+		s.BusinessDayID = event.BusinessDayID(businessDayIDStr)
+		shifts = append(shifts, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating assigned shift rows: %w", err)
+	}
+
+	return shifts, nil
+}
+
+// CountConfirmedByMemberIDsAndDateRange counts confirmed assignments per member whose business day is within [from, to]
+func (r *ShiftAssignmentRepository) CountConfirmedByMemberIDsAndDateRange(ctx context.Context, tenantID common.TenantID, memberIDs []common.MemberID, from, to time.Time) (map[common.MemberID]int, error) {
+	counts := make(map[common.MemberID]int)
+	if len(memberIDs) == 0 {
+		return counts, nil
+	}
+
+	query := `
+		SELECT sa.member_id, COUNT(*)
+		FROM shift_assignments sa
+		INNER JOIN shift_slots ss ON sa.slot_id = ss.slot_id AND ss.deleted_at IS NULL
+		INNER JOIN event_business_days bd ON ss.business_day_id = bd.business_day_id
+			AND bd.deleted_at IS NULL AND bd.cancelled_at IS NULL
+		WHERE sa.tenant_id = $1
+		  AND sa.member_id = ANY($2::text[])
+		  AND bd.target_date >= $3 AND bd.target_date <= $4
+		  AND sa.assignment_status = 'confirmed'
+		  AND sa.deleted_at IS NULL
+		  AND ` + liveAssignmentCondition + `
+		GROUP BY sa.member_id
+	`
+
+	ids := make([]string, len(memberIDs))
+	for i, id := range memberIDs {
+		ids[i] = id.String()
+	}
+
+	rows, err := GetTx(ctx, r.db).Query(ctx, query, tenantID.String(), ids, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count member assignments: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			memberIDStr string
+			count       int
+		)
+		if err := rows.Scan(&memberIDStr, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan member assignment count: %w", err)
+		}
+		counts[common.MemberID(memberIDStr)] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating member assignment counts: %w", err)
+	}
+
+	return counts, nil
+}
+
 // queryShiftAssignments executes a query and returns a list of shift assignments
 func (r *ShiftAssignmentRepository) queryShiftAssignments(ctx context.Context, query string, args ...interface{}) ([]*shift.ShiftAssignment, error) {
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := GetTx(ctx, r.db).Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query shift assignments: %w", err)
 	}
@@ -307,19 +455,20 @@ func (r *ShiftAssignmentRepository) queryShiftAssignments(ctx context.Context, q
 	var assignments []*shift.ShiftAssignment
 	for rows.Next() {
 		var (
-			assignmentIDStr     string
-			tenantIDStr         string
-			planIDStr           sql.NullString
-			slotIDStr           string
-			memberIDStr         string
-			assignmentStatusStr string
-			assignmentMethodStr string
-			isOutsidePreference bool
-			assignedAt          time.Time
-			cancelledAt         sql.NullTime
-			createdAt           time.Time
-			updatedAt           time.Time
-			deletedAt           sql.NullTime
+			assignmentIDStr      string
+			tenantIDStr          string
+			planIDStr            sql.NullString
+			slotIDStr            string
+			memberIDStr          string
+			assignmentStatusStr  string
+			assignmentMethodStr  string
+			isOutsidePreference  bool
+			isConflictOverridden bool
+			assignedAt           time.Time
+			cancelledAt          sql.NullTime
+			createdAt            time.Time
+			updatedAt            time.Time
+			deletedAt            sql.NullTime
 		)
 
 		err := rows.Scan(
@@ -331,6 +480,7 @@ func (r *ShiftAssignmentRepository) queryShiftAssignments(ctx context.Context, q
 			&assignmentStatusStr,
 			&assignmentMethodStr,
 			&isOutsidePreference,
+			&isConflictOverridden,
 			&assignedAt,
 			&cancelledAt,
 			&createdAt,
@@ -343,7 +493,7 @@ func (r *ShiftAssignmentRepository) queryShiftAssignments(ctx context.Context, q
 
 		assignment, err := r.scanToShiftAssignment(
 			assignmentIDStr, tenantIDStr, stringValue(planIDStr), slotIDStr, memberIDStr,
-			assignmentStatusStr, assignmentMethodStr, isOutsidePreference,
+			assignmentStatusStr, assignmentMethodStr, isOutsidePreference, isConflictOverridden,
 			assignedAt, cancelledAt, createdAt, updatedAt, deletedAt,
 		)
 		if err != nil {
@@ -364,7 +514,7 @@ func (r *ShiftAssignmentRepository) queryShiftAssignments(ctx context.Context, q
 func (r *ShiftAssignmentRepository) scanToShiftAssignment(
 	assignmentIDStr, tenantIDStr, planIDStr, slotIDStr, memberIDStr string,
 	assignmentStatusStr, assignmentMethodStr string,
-	isOutsidePreference bool,
+	isOutsidePreference, isConflictOverridden bool,
 	assignedAt time.Time,
 	cancelledAt sql.NullTime,
 	createdAt, updatedAt time.Time,
@@ -389,6 +539,7 @@ func (r *ShiftAssignmentRepository) scanToShiftAssignment(
 		shift.AssignmentStatus(assignmentStatusStr),
 		shift.AssignmentMethod(assignmentMethodStr),
 		isOutsidePreference,
+		isConflictOverridden,
 		assignedAt,
 		cancelledAtPtr,
 		createdAt,
@@ -396,3 +547,10 @@ func (r *ShiftAssignmentRepository) scanToShiftAssignment(
 		deletedAtPtr,
 	)
 }
+
+// isUniqueViolation reports whether the error is a unique constraint violation
+// 公開済みの同じ枠・メンバーの確定割り当て（idx_shift_assignments_live_slot_member_unique）の重複を検出する
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -0,0 +1,167 @@
+package db_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/infra/db"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// =====================================================
+// Test Helpers for ShiftAssignment Integration Tests
+// =====================================================
+
+func createTestShiftSlot(t *testing.T, pool *pgxpool.Pool, tenantID common.TenantID) (*event.EventBusinessDay, *shift.ShiftSlot) {
+	t.Helper()
+	ctx := context.Background()
+	now := time.Now()
+
+	e, err := event.NewEvent(now, tenantID, "割り当てテスト_"+common.NewULID(), event.EventTypeNormal, "", event.RecurrenceTypeNone, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create test event: %v", err)
+	}
+	if err := db.NewEventRepository(pool).Save(ctx, e); err != nil {
+		t.Fatalf("Failed to save event: %v", err)
+	}
+
+	bd, err := event.NewEventBusinessDay(
+		now, tenantID, e.EventID(), now.AddDate(0, 0, 7),
+		time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+		event.OccurrenceTypeSpecial, nil,
+	)
+	if err != nil {
+		t.Fatalf("Failed to create business day: %v", err)
+	}
+	if err := db.NewEventBusinessDayRepository(pool).Save(ctx, bd); err != nil {
+		t.Fatalf("Failed to save business day: %v", err)
+	}
+
+	slot, err := shift.NewShiftSlot(
+		now, tenantID, bd.BusinessDayID(), nil, "受付", "",
+		time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+		2, 1,
+	)
+	if err != nil {
+		t.Fatalf("Failed to create shift slot: %v", err)
+	}
+	if err := db.NewShiftSlotRepository(pool).Save(ctx, slot); err != nil {
+		t.Fatalf("Failed to save shift slot: %v", err)
+	}
+	return bd, slot
+}
+
+// =====================================================
+// ShiftAssignmentRepository Integration Tests
+// =====================================================
+
+// TestShiftAssignmentRepository_LiveAssignmentsAreUnique は
+// 公開済みの同じ枠・メンバーの確定割り当てが 1 件に制限され、下書きプランの割り当ては公開時に制約を受けることを検証する
+func TestShiftAssignmentRepository_LiveAssignmentsAreUnique(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	tenantID := common.NewTenantID()
+	createTestTenant(t, pool, tenantID)
+	bd, slot := createTestShiftSlot(t, pool, tenantID)
+	mem := createTestMember(t, pool, tenantID)
+	repo := db.NewShiftAssignmentRepository(pool)
+
+	live, err := shift.NewShiftAssignment(now, tenantID, "", slot.SlotID(), mem.MemberID(), shift.AssignmentMethodManual, false)
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+	if err := repo.Save(ctx, live); err != nil {
+		t.Fatalf("Save() should succeed, got error: %v", err)
+	}
+
+	// プランを経由しない割り当ての重複
+	duplicate, err := shift.NewShiftAssignment(now, tenantID, "", slot.SlotID(), mem.MemberID(), shift.AssignmentMethodManual, false)
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+	if err := repo.Save(ctx, duplicate); !errors.Is(err, shift.ErrAlreadyAssigned) {
+		t.Errorf("Save() should return ErrAlreadyAssigned for a duplicate live assignment, got %v", err)
+	}
+
+	// 下書きプランの割り当ては公開済みの割り当てと重複して保存できるが、公開はできない
+	bdID := bd.BusinessDayID()
+	plan, err := shift.NewShiftPlan(now, tenantID, bd.EventID(), &bdID, nil, nil, "当日シフト")
+	if err != nil {
+		t.Fatalf("Failed to create shift plan: %v", err)
+	}
+	if err := db.NewShiftPlanRepository(pool).Save(ctx, plan); err != nil {
+		t.Fatalf("Failed to save shift plan: %v", err)
+	}
+	draft, err := shift.NewShiftAssignment(now, tenantID, plan.PlanID(), slot.SlotID(), mem.MemberID(), shift.AssignmentMethodManual, false)
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+	if err := repo.Save(ctx, draft); err != nil {
+		t.Fatalf("Save() should succeed for a draft plan assignment, got error: %v", err)
+	}
+	if err := repo.SetLive(ctx, tenantID, draft.AssignmentID(), true); !errors.Is(err, shift.ErrAlreadyAssigned) {
+		t.Errorf("SetLive() should return ErrAlreadyAssigned for a duplicate live assignment, got %v", err)
+	}
+
+	count, err := repo.CountConfirmedBySlotID(ctx, tenantID, slot.SlotID())
+	if err != nil {
+		t.Fatalf("Failed to count assignments: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("confirmed live assignments = %d, want 1", count)
+	}
+}
+
+// TestShiftAssignmentRepository_CountConfirmedByMemberIDsAndDateRange は
+// 割り当てた日時ではなく営業日の日付で期間を判定し、メンバーごとに集計することを検証する
+func TestShiftAssignmentRepository_CountConfirmedByMemberIDsAndDateRange(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	tenantID := common.NewTenantID()
+	createTestTenant(t, pool, tenantID)
+	bd, slot := createTestShiftSlot(t, pool, tenantID)
+	assigned := createTestMember(t, pool, tenantID)
+	unassigned := createTestMember(t, pool, tenantID)
+	repo := db.NewShiftAssignmentRepository(pool)
+
+	a, err := shift.NewShiftAssignment(now, tenantID, "", slot.SlotID(), assigned.MemberID(), shift.AssignmentMethodManual, false)
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+	if err := repo.Save(ctx, a); err != nil {
+		t.Fatalf("Save() should succeed, got error: %v", err)
+	}
+
+	memberIDs := []common.MemberID{assigned.MemberID(), unassigned.MemberID()}
+	counts, err := repo.CountConfirmedByMemberIDsAndDateRange(ctx, tenantID, memberIDs, bd.TargetDate().AddDate(0, 0, -30), bd.TargetDate())
+	if err != nil {
+		t.Fatalf("Failed to count assignments: %v", err)
+	}
+	if counts[assigned.MemberID()] != 1 || counts[unassigned.MemberID()] != 0 {
+		t.Errorf("unexpected counts within the window: %v", counts)
+	}
+
+	// 割り当てたのが今日でも、営業日が期間外なら数えない
+	counts, err = repo.CountConfirmedByMemberIDsAndDateRange(ctx, tenantID, memberIDs, now.AddDate(0, 0, -30), now)
+	if err != nil {
+		t.Fatalf("Failed to count assignments: %v", err)
+	}
+	if counts[assigned.MemberID()] != 0 {
+		t.Errorf("assignments on a business day outside the window should not be counted: %v", counts)
+	}
+}
@@ -0,0 +1,226 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ShiftPlanRepository implements shift.ShiftPlanRepository for PostgreSQL
+type ShiftPlanRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewShiftPlanRepository creates a new ShiftPlanRepository
+func NewShiftPlanRepository(pool *pgxpool.Pool) *ShiftPlanRepository {
+	return &ShiftPlanRepository{pool: pool}
+}
+
+const shiftPlanColumns = `
+	plan_id, tenant_id, event_id, business_day_id, period_start, period_end,
+	plan_name, plan_status, published_at, archived_at, created_at, updated_at, deleted_at
+`
+
+// Save saves a shift plan (insert or update)
+func (r *ShiftPlanRepository) Save(ctx context.Context, plan *shift.ShiftPlan) error {
+	query := `
+		INSERT INTO shift_plans (` + shiftPlanColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (plan_id) DO UPDATE SET
+			plan_name = EXCLUDED.plan_name,
+			plan_status = EXCLUDED.plan_status,
+			published_at = EXCLUDED.published_at,
+			archived_at = EXCLUDED.archived_at,
+			updated_at = EXCLUDED.updated_at,
+			deleted_at = EXCLUDED.deleted_at
+	`
+
+	var businessDayID *string
+	if plan.BusinessDayID() != nil {
+		s := plan.BusinessDayID().String()
+		businessDayID = &s
+	}
+
+	executor := GetTx(ctx, r.pool)
+
+	_, err := executor.Exec(ctx, query,
+		plan.PlanID().String(),
+		plan.TenantID().String(),
+		plan.EventID().String(),
+		businessDayID,
+		plan.PeriodStart(),
+		plan.PeriodEnd(),
+		plan.PlanName(),
+		string(plan.PlanStatus()),
+		plan.PublishedAt(),
+		plan.ArchivedAt(),
+		plan.CreatedAt(),
+		plan.UpdatedAt(),
+		plan.DeletedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save shift plan: %w", err)
+	}
+
+	return nil
+}
+
+// FindByID finds a shift plan by ID within a tenant
+func (r *ShiftPlanRepository) FindByID(ctx context.Context, tenantID common.TenantID, planID shift.PlanID) (*shift.ShiftPlan, error) {
+	query := `
+		SELECT ` + shiftPlanColumns + `
+		FROM shift_plans
+		WHERE tenant_id = $1 AND plan_id = $2 AND deleted_at IS NULL
+	`
+
+	plans, err := r.queryShiftPlans(ctx, query, tenantID.String(), planID.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(plans) == 0 {
+		return nil, common.NewNotFoundError("ShiftPlan", planID.String())
+	}
+
+	return plans[0], nil
+}
+
+// FindByEventID finds all shift plans for an event (newest first)
+func (r *ShiftPlanRepository) FindByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) ([]*shift.ShiftPlan, error) {
+	query := `
+		SELECT ` + shiftPlanColumns + `
+		FROM shift_plans
+		WHERE tenant_id = $1 AND event_id = $2 AND deleted_at IS NULL
+		ORDER BY created_at DESC
+	`
+
+	return r.queryShiftPlans(ctx, query, tenantID.String(), eventID.String())
+}
+
+// FindPublishedByEventID finds all published shift plans for an event
+func (r *ShiftPlanRepository) FindPublishedByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) ([]*shift.ShiftPlan, error) {
+	query := `
+		SELECT ` + shiftPlanColumns + `
+		FROM shift_plans
+		WHERE tenant_id = $1 AND event_id = $2 AND plan_status = 'published' AND deleted_at IS NULL
+		ORDER BY published_at DESC
+	`
+
+	return r.queryShiftPlans(ctx, query, tenantID.String(), eventID.String())
+}
+
+// LockPublicationByEventID serializes publishing shift plans of the event until the transaction ends
+func (r *ShiftPlanRepository) LockPublicationByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) error {
+	query := `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`
+
+	key := "shift_plan_publish:" + tenantID.String() + ":" + eventID.String()
+	if _, err := GetTx(ctx, r.pool).Exec(ctx, query, key); err != nil {
+		return fmt.Errorf("failed to lock shift plan publication: %w", err)
+	}
+
+	return nil
+}
+
+// queryShiftPlans executes a query and returns a list of shift plans
+func (r *ShiftPlanRepository) queryShiftPlans(ctx context.Context, query string, args ...interface{}) ([]*shift.ShiftPlan, error) {
+	executor := GetTx(ctx, r.pool)
+
+	rows, err := executor.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query shift plans: %w", err)
+	}
+	defer rows.Close()
+
+	var plans []*shift.ShiftPlan
+	for rows.Next() {
+		plan, err := scanShiftPlan(rows)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating shift plan rows: %w", err)
+	}
+
+	return plans, nil
+}
+
+func scanShiftPlan(row pgx.Row) (*shift.ShiftPlan, error) {
+	var (
+		planIDStr        string
+		tenantIDStr      string
+		eventIDStr       string
+		businessDayIDStr sql.NullString
+		periodStart      sql.NullTime
+		periodEnd        sql.NullTime
+		planName         string
+		planStatusStr    string
+		publishedAt      sql.NullTime
+		archivedAt       sql.NullTime
+		createdAt        time.Time
+		updatedAt        time.Time
+		deletedAt        sql.NullTime
+	)
+
+	err := row.Scan(
+		&planIDStr,
+		&tenantIDStr,
+		&eventIDStr,
+		&businessDayIDStr,
+		&periodStart,
+		&periodEnd,
+		&planName,
+		&planStatusStr,
+		&publishedAt,
+		&archivedAt,
+		&createdAt,
+		&updatedAt,
+		&deletedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan shift plan row: %w", err)
+	}
+
+	var businessDayID *event.BusinessDayID
+	if businessDayIDStr.Valid {
+		id := event.BusinessDayID(businessDayIDStr.String)
+		businessDayID = &id
+	}
+
+	plan, err := shift.ReconstructShiftPlan(
+		shift.PlanID(planIDStr),
+		common.TenantID(tenantIDStr),
+		common.EventID(eventIDStr),
+		businessDayID,
+		nullTimePtr(periodStart),
+		nullTimePtr(periodEnd),
+		planName,
+		shift.PlanStatus(planStatusStr),
+		nullTimePtr(publishedAt),
+		nullTimePtr(archivedAt),
+		createdAt,
+		updatedAt,
+		nullTimePtr(deletedAt),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconstruct shift plan: %w", err)
+	}
+
+	return plan, nil
+}
+
+func nullTimePtr(nt sql.NullTime) *time.Time {
+	if !nt.Valid {
+		return nil
+	}
+	t := nt.Time
+	return &t
+}
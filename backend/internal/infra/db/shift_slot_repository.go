@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -28,17 +29,20 @@ func (r *ShiftSlotRepository) Save(ctx context.Context, slot *shift.ShiftSlot) e
 	query := `
 		INSERT INTO shift_slots (
 			slot_id, tenant_id, business_day_id, instance_id,
-			slot_name, instance_name, start_time, end_time,
-			required_count, priority, created_at, updated_at, deleted_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+			slot_name, instance_name, start_time, end_time, day_offset,
+			required_count, priority, role_requirements, template_id, created_at, updated_at, deleted_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
 		ON CONFLICT (slot_id) DO UPDATE SET
 			instance_id = EXCLUDED.instance_id,
 			slot_name = EXCLUDED.slot_name,
 			instance_name = EXCLUDED.instance_name,
 			start_time = EXCLUDED.start_time,
 			end_time = EXCLUDED.end_time,
+			day_offset = EXCLUDED.day_offset,
 			required_count = EXCLUDED.required_count,
 			priority = EXCLUDED.priority,
+			role_requirements = EXCLUDED.role_requirements,
+			template_id = EXCLUDED.template_id,
 			updated_at = EXCLUDED.updated_at,
 			deleted_at = EXCLUDED.deleted_at
 	`
@@ -49,7 +53,18 @@ func (r *ShiftSlotRepository) Save(ctx context.Context, slot *shift.ShiftSlot) e
 		instanceIDStr = &s
 	}
 
-	_, err := r.db.Exec(ctx, query,
+	var templateIDStr *string
+	if slot.TemplateID() != nil {
+		s := slot.TemplateID().String()
+		templateIDStr = &s
+	}
+
+	roleRequirementsJSON, err := marshalRoleRequirements(slot.RoleRequirements())
+	if err != nil {
+		return err
+	}
+
+	_, err = GetTx(ctx, r.db).Exec(ctx, query,
 		slot.SlotID().String(),
 		slot.TenantID().String(),
 		slot.BusinessDayID().String(),
@@ -58,8 +73,11 @@ func (r *ShiftSlotRepository) Save(ctx context.Context, slot *shift.ShiftSlot) e
 		slot.InstanceName(),
 		slot.StartTime(),
 		slot.EndTime(),
+		slot.DayOffset(),
 		slot.RequiredCount(),
 		slot.Priority(),
+		roleRequirementsJSON,
+		templateIDStr,
 		slot.CreatedAt(),
 		slot.UpdatedAt(),
 		slot.DeletedAt(),
@@ -77,12 +95,32 @@ func (r *ShiftSlotRepository) FindByID(ctx context.Context, tenantID common.Tena
 	query := `
 		SELECT
 			slot_id, tenant_id, business_day_id, instance_id,
-			slot_name, instance_name, start_time, end_time,
-			required_count, priority, created_at, updated_at, deleted_at
+			slot_name, instance_name, start_time, end_time, day_offset,
+			required_count, priority, role_requirements, template_id, created_at, updated_at, deleted_at
+		FROM shift_slots
+		WHERE tenant_id = $1 AND slot_id = $2 AND deleted_at IS NULL
+	`
+
+	return r.findOne(ctx, query, tenantID, slotID)
+}
+
+// FindByIDForUpdate finds a shift slot by ID and locks the row until the transaction ends
+func (r *ShiftSlotRepository) FindByIDForUpdate(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
+	query := `
+		SELECT
+			slot_id, tenant_id, business_day_id, instance_id,
+			slot_name, instance_name, start_time, end_time, day_offset,
+			required_count, priority, role_requirements, template_id, created_at, updated_at, deleted_at
 		FROM shift_slots
 		WHERE tenant_id = $1 AND slot_id = $2 AND deleted_at IS NULL
+		FOR UPDATE
 	`
 
+	return r.findOne(ctx, query, tenantID, slotID)
+}
+
+// findOne executes a single-row slot query
+func (r *ShiftSlotRepository) findOne(ctx context.Context, query string, tenantID common.TenantID, slotID shift.SlotID) (*shift.ShiftSlot, error) {
 	var (
 		slotIDStr        string
 		tenantIDStr      string
@@ -92,14 +130,17 @@ func (r *ShiftSlotRepository) FindByID(ctx context.Context, tenantID common.Tena
 		instanceName     string
 		startTime        time.Time
 		endTime          time.Time
+		dayOffset        int
 		requiredCount    int
 		priority         int
+		roleRequirements []byte
+		templateIDStr    sql.NullString
 		createdAt        time.Time
 		updatedAt        time.Time
 		deletedAt        sql.NullTime
 	)
 
-	err := r.db.QueryRow(ctx, query, tenantID.String(), slotID.String()).Scan(
+	err := GetTx(ctx, r.db).QueryRow(ctx, query, tenantID.String(), slotID.String()).Scan(
 		&slotIDStr,
 		&tenantIDStr,
 		&businessDayIDStr,
@@ -108,8 +149,11 @@ func (r *ShiftSlotRepository) FindByID(ctx context.Context, tenantID common.Tena
 		&instanceName,
 		&startTime,
 		&endTime,
+		&dayOffset,
 		&requiredCount,
 		&priority,
+		&roleRequirements,
+		&templateIDStr,
 		&createdAt,
 		&updatedAt,
 		&deletedAt,
@@ -124,8 +168,8 @@ func (r *ShiftSlotRepository) FindByID(ctx context.Context, tenantID common.Tena
 
 	return r.scanToShiftSlot(
 		slotIDStr, tenantIDStr, businessDayIDStr, instanceIDStr,
-		slotName, instanceName, startTime, endTime,
-		requiredCount, priority, createdAt, updatedAt, deletedAt,
+		slotName, instanceName, startTime, endTime, dayOffset,
+		requiredCount, priority, roleRequirements, templateIDStr, createdAt, updatedAt, deletedAt,
 	)
 }
 
@@ -134,8 +178,8 @@ func (r *ShiftSlotRepository) FindByBusinessDayID(ctx context.Context, tenantID
 	query := `
 		SELECT
 			slot_id, tenant_id, business_day_id, instance_id,
-			slot_name, instance_name, start_time, end_time,
-			required_count, priority, created_at, updated_at, deleted_at
+			slot_name, instance_name, start_time, end_time, day_offset,
+			required_count, priority, role_requirements, template_id, created_at, updated_at, deleted_at
 		FROM shift_slots
 		WHERE tenant_id = $1 AND business_day_id = $2 AND deleted_at IS NULL
 		ORDER BY priority ASC, created_at ASC
@@ -149,8 +193,8 @@ func (r *ShiftSlotRepository) FindByInstanceID(ctx context.Context, tenantID com
 	query := `
 		SELECT
 			slot_id, tenant_id, business_day_id, instance_id,
-			slot_name, instance_name, start_time, end_time,
-			required_count, priority, created_at, updated_at, deleted_at
+			slot_name, instance_name, start_time, end_time, day_offset,
+			required_count, priority, role_requirements, template_id, created_at, updated_at, deleted_at
 		FROM shift_slots
 		WHERE tenant_id = $1 AND instance_id = $2 AND deleted_at IS NULL
 		ORDER BY priority ASC, created_at ASC
@@ -164,8 +208,8 @@ func (r *ShiftSlotRepository) FindByBusinessDayIDAndInstanceID(ctx context.Conte
 	query := `
 		SELECT
 			slot_id, tenant_id, business_day_id, instance_id,
-			slot_name, instance_name, start_time, end_time,
-			required_count, priority, created_at, updated_at, deleted_at
+			slot_name, instance_name, start_time, end_time, day_offset,
+			required_count, priority, role_requirements, template_id, created_at, updated_at, deleted_at
 		FROM shift_slots
 		WHERE tenant_id = $1 AND business_day_id = $2 AND instance_id = $3 AND deleted_at IS NULL
 		ORDER BY priority ASC, created_at ASC
@@ -181,7 +225,7 @@ func (r *ShiftSlotRepository) Delete(ctx context.Context, tenantID common.Tenant
 		WHERE tenant_id = $1 AND slot_id = $2
 	`
 
-	result, err := r.db.Exec(ctx, query, tenantID.String(), slotID.String())
+	result, err := GetTx(ctx, r.db).Exec(ctx, query, tenantID.String(), slotID.String())
 	if err != nil {
 		return fmt.Errorf("failed to delete shift slot: %w", err)
 	}
@@ -195,7 +239,7 @@ func (r *ShiftSlotRepository) Delete(ctx context.Context, tenantID common.Tenant
 
 // queryShiftSlots executes a query and returns a list of shift slots
 func (r *ShiftSlotRepository) queryShiftSlots(ctx context.Context, query string, args ...interface{}) ([]*shift.ShiftSlot, error) {
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := GetTx(ctx, r.db).Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query shift slots: %w", err)
 	}
@@ -212,8 +256,11 @@ func (r *ShiftSlotRepository) queryShiftSlots(ctx context.Context, query string,
 			instanceName     string
 			startTime        time.Time
 			endTime          time.Time
+			dayOffset        int
 			requiredCount    int
 			priority         int
+			roleRequirements []byte
+			templateIDStr    sql.NullString
 			createdAt        time.Time
 			updatedAt        time.Time
 			deletedAt        sql.NullTime
@@ -228,8 +275,11 @@ func (r *ShiftSlotRepository) queryShiftSlots(ctx context.Context, query string,
 			&instanceName,
 			&startTime,
 			&endTime,
+			&dayOffset,
 			&requiredCount,
 			&priority,
+			&roleRequirements,
+			&templateIDStr,
 			&createdAt,
 			&updatedAt,
 			&deletedAt,
@@ -240,8 +290,8 @@ func (r *ShiftSlotRepository) queryShiftSlots(ctx context.Context, query string,
 
 		slot, err := r.scanToShiftSlot(
 			slotIDStr, tenantIDStr, businessDayIDStr, instanceIDStr,
-			slotName, instanceName, startTime, endTime,
-			requiredCount, priority, createdAt, updatedAt, deletedAt,
+			slotName, instanceName, startTime, endTime, dayOffset,
+			requiredCount, priority, roleRequirements, templateIDStr, createdAt, updatedAt, deletedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to reconstruct shift slot: %w", err)
@@ -263,10 +313,17 @@ func (r *ShiftSlotRepository) scanToShiftSlot(
 	instanceIDStr sql.NullString,
 	slotName, instanceName string,
 	startTime, endTime time.Time,
-	requiredCount, priority int,
+	dayOffset, requiredCount, priority int,
+	roleRequirementsJSON []byte,
+	templateIDStr sql.NullString,
 	createdAt, updatedAt time.Time,
 	deletedAt sql.NullTime,
 ) (*shift.ShiftSlot, error) {
+	roleRequirements, err := unmarshalRoleRequirements(roleRequirementsJSON)
+	if err != nil {
+		return nil, err
+	}
+
 	var deletedAtPtr *time.Time
 	if deletedAt.Valid {
 		deletedAtPtr = &deletedAt.Time
@@ -278,6 +335,12 @@ func (r *ShiftSlotRepository) scanToShiftSlot(
 		instanceIDPtr = &instanceID
 	}
 
+	var templateIDPtr *common.ShiftSlotTemplateID
+	if templateIDStr.Valid {
+		templateID := common.ShiftSlotTemplateID(templateIDStr.String)
+		templateIDPtr = &templateID
+	}
+
 	return shift.ReconstructShiftSlot(
 		shift.SlotID(slotIDStr),
 		common.TenantID(tenantIDStr),
@@ -287,10 +350,64 @@ func (r *ShiftSlotRepository) scanToShiftSlot(
 		instanceName,
 		startTime,
 		endTime,
+		dayOffset,
 		requiredCount,
 		priority,
+		roleRequirements,
+		templateIDPtr,
 		createdAt,
 		updatedAt,
 		deletedAtPtr,
 	)
 }
+
+// roleRequirementRecord is the JSONB representation of shift.RoleRequirement
+type roleRequirementRecord struct {
+	RoleID string `json:"role_id"`
+	Kind   string `json:"kind"`
+	Count  int    `json:"count"`
+}
+
+// marshalRoleRequirements converts role requirements to JSONB
+func marshalRoleRequirements(requirements []shift.RoleRequirement) ([]byte, error) {
+	records := make([]roleRequirementRecord, 0, len(requirements))
+	for _, req := range requirements {
+		records = append(records, roleRequirementRecord{
+			RoleID: req.RoleID().String(),
+			Kind:   string(req.Kind()),
+			Count:  req.Count(),
+		})
+	}
+
+	data, err := json.Marshal(records)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal role requirements: %w", err)
+	}
+	return data, nil
+}
+
+// unmarshalRoleRequirements converts JSONB to role requirements
+func unmarshalRoleRequirements(data []byte) ([]shift.RoleRequirement, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var records []roleRequirementRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal role requirements: %w", err)
+	}
+
+	requirements := make([]shift.RoleRequirement, 0, len(records))
+	for _, record := range records {
+		req, err := shift.NewRoleRequirement(
+			common.RoleID(record.RoleID),
+			shift.RoleRequirementKind(record.Kind),
+			record.Count,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reconstruct role requirement: %w", err)
+		}
+		requirements = append(requirements, req)
+	}
+	return requirements, nil
+}
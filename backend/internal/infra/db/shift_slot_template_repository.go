@@ -24,7 +24,7 @@ func NewShiftSlotTemplateRepository(db *pgxpool.Pool) *ShiftSlotTemplateReposito
 
 // Save saves a shift slot template with its items (insert or update)
 func (r *ShiftSlotTemplateRepository) Save(ctx context.Context, template *shift.ShiftSlotTemplate) error {
-	tx, err := r.db.Begin(ctx)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -34,11 +34,12 @@ func (r *ShiftSlotTemplateRepository) Save(ctx context.Context, template *shift.
 	templateQuery := `
 		INSERT INTO shift_slot_templates (
 			template_id, tenant_id, event_id, template_name, description,
-			created_at, updated_at, deleted_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			version, created_at, updated_at, deleted_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		ON CONFLICT (template_id) DO UPDATE SET
 			template_name = EXCLUDED.template_name,
 			description = EXCLUDED.description,
+			version = EXCLUDED.version,
 			updated_at = EXCLUDED.updated_at,
 			deleted_at = EXCLUDED.deleted_at
 	`
@@ -56,6 +57,7 @@ func (r *ShiftSlotTemplateRepository) Save(ctx context.Context, template *shift.
 		template.EventID().String(),
 		template.TemplateName(),
 		template.Description(),
+		template.Version(),
 		template.CreatedAt(),
 		template.UpdatedAt(),
 		deletedAtValue,
@@ -84,8 +86,8 @@ func (r *ShiftSlotTemplateRepository) Save(ctx context.Context, template *shift.
 		INSERT INTO shift_slot_template_items (
 			item_id, template_id, slot_name, instance_name,
 			start_time, end_time, required_count, priority,
-			created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			role_requirements, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 	`
 
 	for _, item := range template.Items() {
@@ -94,6 +96,11 @@ func (r *ShiftSlotTemplateRepository) Save(ctx context.Context, template *shift.
 			return fmt.Errorf("template_id mismatch: item has %s, template has %s", item.TemplateID().String(), template.TemplateID().String())
 		}
 
+		roleRequirementsJSON, err := marshalRoleRequirements(item.RoleRequirements())
+		if err != nil {
+			return err
+		}
+
 		_, err = tx.Exec(ctx, itemQuery,
 			item.ItemID().String(),
 			item.TemplateID().String(),
@@ -103,6 +110,7 @@ func (r *ShiftSlotTemplateRepository) Save(ctx context.Context, template *shift.
 			item.EndTime(),
 			item.RequiredCount(),
 			item.Priority(),
+			roleRequirementsJSON,
 			item.CreatedAt(),
 			item.UpdatedAt(),
 		)
@@ -124,7 +132,7 @@ func (r *ShiftSlotTemplateRepository) FindByID(ctx context.Context, tenantID com
 	templateQuery := `
 		SELECT
 			template_id, tenant_id, event_id, template_name, description,
-			created_at, updated_at, deleted_at
+			version, created_at, updated_at, deleted_at
 		FROM shift_slot_templates
 		WHERE tenant_id = $1 AND template_id = $2 AND deleted_at IS NULL
 	`
@@ -135,17 +143,19 @@ func (r *ShiftSlotTemplateRepository) FindByID(ctx context.Context, tenantID com
 		eventIDStr    string
 		templateName  string
 		description   string
+		version       int
 		createdAt     time.Time
 		updatedAt     time.Time
 		deletedAt     sql.NullTime
 	)
 
-	err := r.db.QueryRow(ctx, templateQuery, tenantID.String(), templateID.String()).Scan(
+	err := GetTx(ctx, r.db).QueryRow(ctx, templateQuery, tenantID.String(), templateID.String()).Scan(
 		&templateIDStr,
 		&tenantIDStr,
 		&eventIDStr,
 		&templateName,
 		&description,
+		&version,
 		&createdAt,
 		&updatedAt,
 		&deletedAt,
@@ -176,6 +186,7 @@ func (r *ShiftSlotTemplateRepository) FindByID(ctx context.Context, tenantID com
 		templateName,
 		description,
 		items,
+		version,
 		createdAt,
 		updatedAt,
 		deletedAtPtr,
@@ -187,13 +198,13 @@ func (r *ShiftSlotTemplateRepository) FindByEventID(ctx context.Context, tenantI
 	templateQuery := `
 		SELECT
 			template_id, tenant_id, event_id, template_name, description,
-			created_at, updated_at, deleted_at
+			version, created_at, updated_at, deleted_at
 		FROM shift_slot_templates
 		WHERE tenant_id = $1 AND event_id = $2 AND deleted_at IS NULL
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.Query(ctx, templateQuery, tenantID.String(), eventID.String())
+	rows, err := GetTx(ctx, r.db).Query(ctx, templateQuery, tenantID.String(), eventID.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to query templates: %w", err)
 	}
@@ -207,6 +218,7 @@ func (r *ShiftSlotTemplateRepository) FindByEventID(ctx context.Context, tenantI
 			eventIDStr    string
 			templateName  string
 			description   string
+			version       int
 			createdAt     time.Time
 			updatedAt     time.Time
 			deletedAt     sql.NullTime
@@ -218,6 +230,7 @@ func (r *ShiftSlotTemplateRepository) FindByEventID(ctx context.Context, tenantI
 			&eventIDStr,
 			&templateName,
 			&description,
+			&version,
 			&createdAt,
 			&updatedAt,
 			&deletedAt,
@@ -244,6 +257,7 @@ func (r *ShiftSlotTemplateRepository) FindByEventID(ctx context.Context, tenantI
 			templateName,
 			description,
 			items,
+			version,
 			createdAt,
 			updatedAt,
 			deletedAtPtr,
@@ -264,7 +278,7 @@ func (r *ShiftSlotTemplateRepository) FindByEventID(ctx context.Context, tenantI
 
 // Delete deletes a shift slot template (physical delete)
 func (r *ShiftSlotTemplateRepository) Delete(ctx context.Context, tenantID common.TenantID, templateID common.ShiftSlotTemplateID) error {
-	tx, err := r.db.Begin(ctx)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -307,13 +321,13 @@ func (r *ShiftSlotTemplateRepository) findItemsByTemplateID(ctx context.Context,
 		SELECT
 			item_id, template_id, slot_name, instance_name,
 			start_time, end_time, required_count, priority,
-			created_at, updated_at
+			role_requirements, created_at, updated_at
 		FROM shift_slot_template_items
 		WHERE template_id = $1
 		ORDER BY start_time ASC, priority ASC
 	`
 
-	rows, err := r.db.Query(ctx, itemQuery, templateID.String())
+	rows, err := GetTx(ctx, r.db).Query(ctx, itemQuery, templateID.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to query template items: %w", err)
 	}
@@ -330,6 +344,7 @@ func (r *ShiftSlotTemplateRepository) findItemsByTemplateID(ctx context.Context,
 			endTime       time.Time
 			requiredCount int
 			priority      int
+			roleReqsJSON  []byte
 			createdAt     time.Time
 			updatedAt     time.Time
 		)
@@ -343,6 +358,7 @@ func (r *ShiftSlotTemplateRepository) findItemsByTemplateID(ctx context.Context,
 			&endTime,
 			&requiredCount,
 			&priority,
+			&roleReqsJSON,
 			&createdAt,
 			&updatedAt,
 		)
@@ -350,6 +366,11 @@ func (r *ShiftSlotTemplateRepository) findItemsByTemplateID(ctx context.Context,
 			return nil, fmt.Errorf("failed to scan template item row: %w", err)
 		}
 
+		roleRequirements, err := unmarshalRoleRequirements(roleReqsJSON)
+		if err != nil {
+			return nil, err
+		}
+
 		item, err := shift.ReconstructShiftSlotTemplateItem(
 			common.ShiftSlotTemplateItemID(itemIDStr),
 			common.ShiftSlotTemplateID(templateIDStr),
@@ -359,6 +380,7 @@ func (r *ShiftSlotTemplateRepository) findItemsByTemplateID(ctx context.Context,
 			endTime,
 			requiredCount,
 			priority,
+			roleRequirements,
 			createdAt,
 			updatedAt,
 		)
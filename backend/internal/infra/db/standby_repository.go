@@ -0,0 +1,192 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// StandbyRepository implements shift.StandbyRepository for PostgreSQL
+type StandbyRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewStandbyRepository creates a new StandbyRepository
+func NewStandbyRepository(pool *pgxpool.Pool) *StandbyRepository {
+	return &StandbyRepository{pool: pool}
+}
+
+const standbyColumns = `
+	standby_id, tenant_id, slot_id, member_id, position, auto_accept,
+	status, offer_expires_at, assignment_id, note, created_at, updated_at
+`
+
+// Save saves a standby entry (insert or update)
+func (r *StandbyRepository) Save(ctx context.Context, entry *shift.StandbyEntry) error {
+	query := `
+		INSERT INTO shift_slot_standbys (` + standbyColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (standby_id) DO UPDATE SET
+			position = EXCLUDED.position,
+			auto_accept = EXCLUDED.auto_accept,
+			status = EXCLUDED.status,
+			offer_expires_at = EXCLUDED.offer_expires_at,
+			assignment_id = EXCLUDED.assignment_id,
+			note = EXCLUDED.note,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	var assignmentID *string
+	if entry.AssignmentID() != nil {
+		s := entry.AssignmentID().String()
+		assignmentID = &s
+	}
+
+	_, err := GetTx(ctx, r.pool).Exec(ctx, query,
+		entry.StandbyID().String(),
+		entry.TenantID().String(),
+		entry.SlotID().String(),
+		entry.MemberID().String(),
+		entry.Position(),
+		entry.AutoAccept(),
+		string(entry.Status()),
+		entry.OfferExpiresAt(),
+		assignmentID,
+		entry.Note(),
+		entry.CreatedAt(),
+		entry.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save standby entry: %w", err)
+	}
+
+	return nil
+}
+
+// FindByID finds a standby entry by ID within a tenant
+func (r *StandbyRepository) FindByID(ctx context.Context, tenantID common.TenantID, standbyID shift.StandbyID) (*shift.StandbyEntry, error) {
+	query := `
+		SELECT ` + standbyColumns + `
+		FROM shift_slot_standbys
+		WHERE tenant_id = $1 AND standby_id = $2
+	`
+
+	entries, err := r.queryStandbys(ctx, query, tenantID.String(), standbyID.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, common.NewNotFoundError("StandbyEntry", standbyID.String())
+	}
+
+	return entries[0], nil
+}
+
+// FindBySlotID finds all standby entries for a slot (position ascending)
+func (r *StandbyRepository) FindBySlotID(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) ([]*shift.StandbyEntry, error) {
+	query := `
+		SELECT ` + standbyColumns + `
+		FROM shift_slot_standbys
+		WHERE tenant_id = $1 AND slot_id = $2
+		ORDER BY position ASC
+	`
+
+	return r.queryStandbys(ctx, query, tenantID.String(), slotID.String())
+}
+
+// FindActiveBySlotID finds waiting or offered standby entries for a slot (position ascending)
+func (r *StandbyRepository) FindActiveBySlotID(ctx context.Context, tenantID common.TenantID, slotID shift.SlotID) ([]*shift.StandbyEntry, error) {
+	query := `
+		SELECT ` + standbyColumns + `
+		FROM shift_slot_standbys
+		WHERE tenant_id = $1 AND slot_id = $2 AND status IN ('waiting', 'offered')
+		ORDER BY position ASC
+	`
+
+	return r.queryStandbys(ctx, query, tenantID.String(), slotID.String())
+}
+
+// queryStandbys executes a query and returns a list of standby entries
+func (r *StandbyRepository) queryStandbys(ctx context.Context, query string, args ...interface{}) ([]*shift.StandbyEntry, error) {
+	rows, err := GetTx(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query standby entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*shift.StandbyEntry
+	for rows.Next() {
+		entry, err := scanStandbyEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating standby rows: %w", err)
+	}
+
+	return entries, nil
+}
+
+func scanStandbyEntry(row pgx.Row) (*shift.StandbyEntry, error) {
+	var (
+		standbyIDStr    string
+		tenantIDStr     string
+		slotIDStr       string
+		memberIDStr     string
+		position        int
+		autoAccept      bool
+		statusStr       string
+		offerExpiresAt  sql.NullTime
+		assignmentIDStr sql.NullString
+		note            string
+		createdAt       time.Time
+		updatedAt       time.Time
+	)
+
+	err := row.Scan(
+		&standbyIDStr,
+		&tenantIDStr,
+		&slotIDStr,
+		&memberIDStr,
+		&position,
+		&autoAccept,
+		&statusStr,
+		&offerExpiresAt,
+		&assignmentIDStr,
+		&note,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan standby row: %w", err)
+	}
+
+	entry, err := shift.ReconstructStandbyEntry(
+		shift.StandbyID(standbyIDStr),
+		common.TenantID(tenantIDStr),
+		shift.SlotID(slotIDStr),
+		common.MemberID(memberIDStr),
+		position,
+		autoAccept,
+		shift.StandbyStatus(statusStr),
+		nullTimePtr(offerExpiresAt),
+		nullAssignmentIDPtr(assignmentIDStr),
+		note,
+		createdAt,
+		updatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconstruct standby entry: %w", err)
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,320 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SwapRequestRepository implements shift.SwapRequestRepository for PostgreSQL
+type SwapRequestRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewSwapRequestRepository creates a new SwapRequestRepository
+func NewSwapRequestRepository(pool *pgxpool.Pool) *SwapRequestRepository {
+	return &SwapRequestRepository{pool: pool}
+}
+
+const swapRequestColumns = `
+	swap_request_id, tenant_id, request_type, assignment_id, requester_member_id,
+	public_token, requires_approval, status, note,
+	claimant_member_id, claimant_assignment_id, new_assignment_id, counter_assignment_id,
+	claimed_at, decided_by_admin_id, decided_at, created_at, updated_at
+`
+
+// Save saves a swap request (insert or update)
+func (r *SwapRequestRepository) Save(ctx context.Context, req *shift.SwapRequest) error {
+	query := `
+		INSERT INTO shift_swap_requests (` + swapRequestColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+		ON CONFLICT (swap_request_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			claimant_member_id = EXCLUDED.claimant_member_id,
+			claimant_assignment_id = EXCLUDED.claimant_assignment_id,
+			new_assignment_id = EXCLUDED.new_assignment_id,
+			counter_assignment_id = EXCLUDED.counter_assignment_id,
+			claimed_at = EXCLUDED.claimed_at,
+			decided_by_admin_id = EXCLUDED.decided_by_admin_id,
+			decided_at = EXCLUDED.decided_at,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	var claimantMemberID, claimantAssignmentID, newAssignmentID, counterAssignmentID, decidedBy *string
+	if req.ClaimantMemberID() != nil {
+		s := req.ClaimantMemberID().String()
+		claimantMemberID = &s
+	}
+	if req.ClaimantAssignmentID() != nil {
+		s := req.ClaimantAssignmentID().String()
+		claimantAssignmentID = &s
+	}
+	if req.NewAssignmentID() != nil {
+		s := req.NewAssignmentID().String()
+		newAssignmentID = &s
+	}
+	if req.CounterAssignmentID() != nil {
+		s := req.CounterAssignmentID().String()
+		counterAssignmentID = &s
+	}
+	if req.DecidedByAdminID() != nil {
+		s := req.DecidedByAdminID().String()
+		decidedBy = &s
+	}
+
+	executor := GetTx(ctx, r.pool)
+
+	_, err := executor.Exec(ctx, query,
+		req.SwapRequestID().String(),
+		req.TenantID().String(),
+		string(req.RequestType()),
+		req.AssignmentID().String(),
+		req.RequesterMemberID().String(),
+		req.PublicToken().String(),
+		req.RequiresApproval(),
+		string(req.Status()),
+		req.Note(),
+		claimantMemberID,
+		claimantAssignmentID,
+		newAssignmentID,
+		counterAssignmentID,
+		req.ClaimedAt(),
+		decidedBy,
+		req.DecidedAt(),
+		req.CreatedAt(),
+		req.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save swap request: %w", err)
+	}
+
+	return nil
+}
+
+// FindByID finds a swap request by ID within a tenant
+func (r *SwapRequestRepository) FindByID(ctx context.Context, tenantID common.TenantID, swapRequestID shift.SwapRequestID) (*shift.SwapRequest, error) {
+	query := `
+		SELECT ` + swapRequestColumns + `
+		FROM shift_swap_requests
+		WHERE tenant_id = $1 AND swap_request_id = $2
+	`
+
+	return r.findOne(ctx, query, swapRequestID.String(), tenantID.String(), swapRequestID.String())
+}
+
+// FindByIDForUpdate finds a swap request by ID and locks the row until the transaction ends
+func (r *SwapRequestRepository) FindByIDForUpdate(ctx context.Context, tenantID common.TenantID, swapRequestID shift.SwapRequestID) (*shift.SwapRequest, error) {
+	query := `
+		SELECT ` + swapRequestColumns + `
+		FROM shift_swap_requests
+		WHERE tenant_id = $1 AND swap_request_id = $2
+		FOR UPDATE
+	`
+
+	return r.findOne(ctx, query, swapRequestID.String(), tenantID.String(), swapRequestID.String())
+}
+
+// FindByToken finds a swap request by public token
+func (r *SwapRequestRepository) FindByToken(ctx context.Context, token common.PublicToken) (*shift.SwapRequest, error) {
+	query := `
+		SELECT ` + swapRequestColumns + `
+		FROM shift_swap_requests
+		WHERE public_token = $1
+	`
+
+	return r.findOne(ctx, query, token.String(), token.String())
+}
+
+// FindByTokenForUpdate finds a swap request by public token and locks the row until the transaction ends
+func (r *SwapRequestRepository) FindByTokenForUpdate(ctx context.Context, token common.PublicToken) (*shift.SwapRequest, error) {
+	query := `
+		SELECT ` + swapRequestColumns + `
+		FROM shift_swap_requests
+		WHERE public_token = $1
+		FOR UPDATE
+	`
+
+	return r.findOne(ctx, query, token.String(), token.String())
+}
+
+// FindByTenantID finds swap requests within a tenant (newest first)
+func (r *SwapRequestRepository) FindByTenantID(ctx context.Context, tenantID common.TenantID, status shift.SwapRequestStatus) ([]*shift.SwapRequest, error) {
+	if status == "" {
+		query := `
+			SELECT ` + swapRequestColumns + `
+			FROM shift_swap_requests
+			WHERE tenant_id = $1
+			ORDER BY created_at DESC
+		`
+		return r.querySwapRequests(ctx, query, tenantID.String())
+	}
+
+	query := `
+		SELECT ` + swapRequestColumns + `
+		FROM shift_swap_requests
+		WHERE tenant_id = $1 AND status = $2
+		ORDER BY created_at DESC
+	`
+	return r.querySwapRequests(ctx, query, tenantID.String(), string(status))
+}
+
+// FindByMemberID finds swap requests where the member is the requester or the claimant (newest first)
+func (r *SwapRequestRepository) FindByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) ([]*shift.SwapRequest, error) {
+	query := `
+		SELECT ` + swapRequestColumns + `
+		FROM shift_swap_requests
+		WHERE tenant_id = $1 AND (requester_member_id = $2 OR claimant_member_id = $2)
+		ORDER BY created_at DESC
+	`
+
+	return r.querySwapRequests(ctx, query, tenantID.String(), memberID.String())
+}
+
+// FindActiveByAssignmentID finds the open or pending swap request for an assignment
+func (r *SwapRequestRepository) FindActiveByAssignmentID(ctx context.Context, tenantID common.TenantID, assignmentID shift.AssignmentID) (*shift.SwapRequest, error) {
+	query := `
+		SELECT ` + swapRequestColumns + `
+		FROM shift_swap_requests
+		WHERE tenant_id = $1 AND assignment_id = $2 AND status IN ('open', 'pending_approval')
+	`
+
+	return r.findOne(ctx, query, assignmentID.String(), tenantID.String(), assignmentID.String())
+}
+
+// findOne executes a query expected to return at most one swap request
+func (r *SwapRequestRepository) findOne(ctx context.Context, query string, id string, args ...interface{}) (*shift.SwapRequest, error) {
+	reqs, err := r.querySwapRequests(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(reqs) == 0 {
+		return nil, common.NewNotFoundError("SwapRequest", id)
+	}
+
+	return reqs[0], nil
+}
+
+// querySwapRequests executes a query and returns a list of swap requests
+func (r *SwapRequestRepository) querySwapRequests(ctx context.Context, query string, args ...interface{}) ([]*shift.SwapRequest, error) {
+	executor := GetTx(ctx, r.pool)
+
+	rows, err := executor.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query swap requests: %w", err)
+	}
+	defer rows.Close()
+
+	var reqs []*shift.SwapRequest
+	for rows.Next() {
+		req, err := scanSwapRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		reqs = append(reqs, req)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating swap request rows: %w", err)
+	}
+
+	return reqs, nil
+}
+
+func scanSwapRequest(row pgx.Row) (*shift.SwapRequest, error) {
+	var (
+		swapRequestIDStr     string
+		tenantIDStr          string
+		requestTypeStr       string
+		assignmentIDStr      string
+		requesterMemberIDStr string
+		publicTokenStr       string
+		requiresApproval     bool
+		statusStr            string
+		note                 string
+		claimantMemberIDStr  sql.NullString
+		claimantAssignIDStr  sql.NullString
+		newAssignmentIDStr   sql.NullString
+		counterAssignIDStr   sql.NullString
+		claimedAt            sql.NullTime
+		decidedByStr         sql.NullString
+		decidedAt            sql.NullTime
+		createdAt            time.Time
+		updatedAt            time.Time
+	)
+
+	err := row.Scan(
+		&swapRequestIDStr,
+		&tenantIDStr,
+		&requestTypeStr,
+		&assignmentIDStr,
+		&requesterMemberIDStr,
+		&publicTokenStr,
+		&requiresApproval,
+		&statusStr,
+		&note,
+		&claimantMemberIDStr,
+		&claimantAssignIDStr,
+		&newAssignmentIDStr,
+		&counterAssignIDStr,
+		&claimedAt,
+		&decidedByStr,
+		&decidedAt,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan swap request row: %w", err)
+	}
+
+	var claimantMemberID *common.MemberID
+	if claimantMemberIDStr.Valid {
+		id := common.MemberID(claimantMemberIDStr.String)
+		claimantMemberID = &id
+	}
+
+	var decidedBy *common.AdminID
+	if decidedByStr.Valid {
+		id := common.AdminID(decidedByStr.String)
+		decidedBy = &id
+	}
+
+	req, err := shift.ReconstructSwapRequest(
+		shift.SwapRequestID(swapRequestIDStr),
+		common.TenantID(tenantIDStr),
+		shift.SwapRequestType(requestTypeStr),
+		shift.AssignmentID(assignmentIDStr),
+		common.MemberID(requesterMemberIDStr),
+		common.PublicToken(publicTokenStr),
+		requiresApproval,
+		shift.SwapRequestStatus(statusStr),
+		note,
+		claimantMemberID,
+		nullAssignmentIDPtr(claimantAssignIDStr),
+		nullAssignmentIDPtr(newAssignmentIDStr),
+		nullAssignmentIDPtr(counterAssignIDStr),
+		nullTimePtr(claimedAt),
+		decidedBy,
+		nullTimePtr(decidedAt),
+		createdAt,
+		updatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconstruct swap request: %w", err)
+	}
+
+	return req, nil
+}
+
+func nullAssignmentIDPtr(ns sql.NullString) *shift.AssignmentID {
+	if !ns.Valid {
+		return nil
+	}
+	id := shift.AssignmentID(ns.String)
+	return &id
+}
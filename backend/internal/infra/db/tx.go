@@ -65,6 +65,15 @@ func GetTx(ctx context.Context, pool *pgxpool.Pool) pgxQuery {
 	return pool
 }
 
+// beginTx begins a transaction, or a nested transaction (savepoint) when the context already has one
+// 複数テーブルへの保存で独自にトランザクションを張る Repository を TxManager の中からも使えるようにする
+func beginTx(ctx context.Context, pool *pgxpool.Pool) (pgx.Tx, error) {
+	if tx, ok := ctx.Value(txKey).(pgx.Tx); ok {
+		return tx.Begin(ctx)
+	}
+	return pool.Begin(ctx)
+}
+
 // pgxQuery is an interface that both pgxpool.Pool and pgx.Tx implement
 // This allows repositories to work with either a pool or a transaction
 type pgxQuery interface {
@@ -0,0 +1,214 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// WorkloadPolicyRepository implements member.WorkloadPolicyRepository for PostgreSQL
+type WorkloadPolicyRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewWorkloadPolicyRepository creates a new WorkloadPolicyRepository
+func NewWorkloadPolicyRepository(pool *pgxpool.Pool) *WorkloadPolicyRepository {
+	return &WorkloadPolicyRepository{pool: pool}
+}
+
+const workloadPolicyColumns = `
+	policy_id, tenant_id, member_id,
+	max_shifts_per_week, max_shifts_per_month, min_shifts_per_month,
+	max_minutes_per_business_day, min_rest_minutes_between_shifts,
+	enforcement, created_at, updated_at
+`
+
+// Save saves a workload policy (insert or update)
+func (r *WorkloadPolicyRepository) Save(ctx context.Context, policy *member.WorkloadPolicy) error {
+	query := `
+		INSERT INTO workload_policies (` + workloadPolicyColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (policy_id) DO UPDATE SET
+			max_shifts_per_week = EXCLUDED.max_shifts_per_week,
+			max_shifts_per_month = EXCLUDED.max_shifts_per_month,
+			min_shifts_per_month = EXCLUDED.min_shifts_per_month,
+			max_minutes_per_business_day = EXCLUDED.max_minutes_per_business_day,
+			min_rest_minutes_between_shifts = EXCLUDED.min_rest_minutes_between_shifts,
+			enforcement = EXCLUDED.enforcement,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	var memberID *string
+	if policy.MemberID() != nil {
+		s := policy.MemberID().String()
+		memberID = &s
+	}
+
+	limits := policy.Limits()
+	_, err := GetTx(ctx, r.pool).Exec(ctx, query,
+		policy.PolicyID().String(),
+		policy.TenantID().String(),
+		memberID,
+		limits.MaxShiftsPerWeek,
+		limits.MaxShiftsPerMonth,
+		limits.MinShiftsPerMonth,
+		limits.MaxMinutesPerBusinessDay,
+		limits.MinRestMinutesBetweenShifts,
+		string(policy.Enforcement()),
+		policy.CreatedAt(),
+		policy.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save workload policy: %w", err)
+	}
+
+	return nil
+}
+
+// FindTenantDefault finds the tenant-wide default policy
+func (r *WorkloadPolicyRepository) FindTenantDefault(ctx context.Context, tenantID common.TenantID) (*member.WorkloadPolicy, error) {
+	query := `
+		SELECT ` + workloadPolicyColumns + `
+		FROM workload_policies
+		WHERE tenant_id = $1 AND member_id IS NULL
+	`
+
+	policies, err := r.queryPolicies(ctx, query, tenantID.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return nil, common.NewNotFoundError("WorkloadPolicy", tenantID.String())
+	}
+
+	return policies[0], nil
+}
+
+// FindByMemberID finds the policy override of a member
+func (r *WorkloadPolicyRepository) FindByMemberID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) (*member.WorkloadPolicy, error) {
+	query := `
+		SELECT ` + workloadPolicyColumns + `
+		FROM workload_policies
+		WHERE tenant_id = $1 AND member_id = $2
+	`
+
+	policies, err := r.queryPolicies(ctx, query, tenantID.String(), memberID.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return nil, common.NewNotFoundError("WorkloadPolicy", memberID.String())
+	}
+
+	return policies[0], nil
+}
+
+// FindMemberPoliciesByTenantID finds all member policy overrides within a tenant
+func (r *WorkloadPolicyRepository) FindMemberPoliciesByTenantID(ctx context.Context, tenantID common.TenantID) ([]*member.WorkloadPolicy, error) {
+	query := `
+		SELECT ` + workloadPolicyColumns + `
+		FROM workload_policies
+		WHERE tenant_id = $1 AND member_id IS NOT NULL
+		ORDER BY member_id ASC
+	`
+
+	return r.queryPolicies(ctx, query, tenantID.String())
+}
+
+// Delete deletes a workload policy (physical delete)
+func (r *WorkloadPolicyRepository) Delete(ctx context.Context, tenantID common.TenantID, policyID member.WorkloadPolicyID) error {
+	query := `
+		DELETE FROM workload_policies
+		WHERE tenant_id = $1 AND policy_id = $2
+	`
+
+	result, err := GetTx(ctx, r.pool).Exec(ctx, query, tenantID.String(), policyID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete workload policy: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return common.NewNotFoundError("WorkloadPolicy", policyID.String())
+	}
+
+	return nil
+}
+
+// queryPolicies executes a query and returns a list of workload policies
+func (r *WorkloadPolicyRepository) queryPolicies(ctx context.Context, query string, args ...interface{}) ([]*member.WorkloadPolicy, error) {
+	rows, err := GetTx(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query workload policies: %w", err)
+	}
+	defer rows.Close()
+
+	var policies []*member.WorkloadPolicy
+	for rows.Next() {
+		policy, err := scanWorkloadPolicy(rows)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating workload policy rows: %w", err)
+	}
+
+	return policies, nil
+}
+
+func scanWorkloadPolicy(row pgx.Row) (*member.WorkloadPolicy, error) {
+	var (
+		policyIDStr    string
+		tenantIDStr    string
+		memberIDStr    sql.NullString
+		limits         member.WorkloadLimits
+		enforcementStr string
+		createdAt      time.Time
+		updatedAt      time.Time
+	)
+
+	err := row.Scan(
+		&policyIDStr,
+		&tenantIDStr,
+		&memberIDStr,
+		&limits.MaxShiftsPerWeek,
+		&limits.MaxShiftsPerMonth,
+		&limits.MinShiftsPerMonth,
+		&limits.MaxMinutesPerBusinessDay,
+		&limits.MinRestMinutesBetweenShifts,
+		&enforcementStr,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan workload policy row: %w", err)
+	}
+
+	var memberID *common.MemberID
+	if memberIDStr.Valid {
+		id := common.MemberID(memberIDStr.String)
+		memberID = &id
+	}
+
+	policy, err := member.ReconstructWorkloadPolicy(
+		member.WorkloadPolicyID(policyIDStr),
+		common.TenantID(tenantIDStr),
+		memberID,
+		limits,
+		member.WorkloadEnforcement(enforcementStr),
+		createdAt,
+		updatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconstruct workload policy: %w", err)
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,158 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+)
+
+// defaultTimeout is the timeout of a webhook request
+const defaultTimeout = 10 * time.Second
+
+// maxErrorBodyBytes is the maximum size of an error response body to read
+const maxErrorBodyBytes = 1024
+
+// maxErrorMessageLength is the maximum length of the Discord error message kept in errors
+const maxErrorMessageLength = 100
+
+// maxMentions is the maximum number of users mentioned in a message
+// allowed_mentions.users の上限（100件）と本文の上限（2000文字）に収まる件数に抑える
+const maxMentions = 50
+
+// WebhookPayload represents the body of an Execute Webhook request
+type WebhookPayload struct {
+	Content         string           `json:"content,omitempty"`
+	Username        string           `json:"username,omitempty"`
+	Embeds          []Embed          `json:"embeds,omitempty"`
+	AllowedMentions *AllowedMentions `json:"allowed_mentions,omitempty"`
+}
+
+// Embed represents a rich embed
+type Embed struct {
+	Title       string       `json:"title,omitempty"`
+	Description string       `json:"description,omitempty"`
+	URL         string       `json:"url,omitempty"`
+	Color       int          `json:"color,omitempty"`
+	Fields      []EmbedField `json:"fields,omitempty"`
+	Footer      *EmbedFooter `json:"footer,omitempty"`
+	Timestamp   string       `json:"timestamp,omitempty"`
+}
+
+// EmbedField represents a field of an embed
+type EmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+// EmbedFooter represents the footer of an embed
+type EmbedFooter struct {
+	Text string `json:"text"`
+}
+
+// AllowedMentions restricts which mentions in the content actually ping
+// 本文中のメンションのうち users に含まれるユーザーのみ通知し、@everyone などは通知しない
+type AllowedMentions struct {
+	Parse []string `json:"parse"`
+	Users []string `json:"users,omitempty"`
+}
+
+// Client posts messages to Discord webhooks
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient creates a new Client
+// httpClient が nil の場合はタイムアウト付きのクライアントを使う
+func NewClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+	return &Client{httpClient: httpClient}
+}
+
+// Execute posts the payload to the webhook URL
+// Webhook が削除・無効化されている（401/403/404）場合は notification.ErrUndeliverable を wrap して返す
+func (c *Client) Execute(ctx context.Context, webhookURL string, payload WebhookPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode discord webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create discord webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post discord webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	detail := errorDetail(resp)
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+		return fmt.Errorf("%w: discord webhook returned %d%s", notification.ErrUndeliverable, resp.StatusCode, detail)
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("discord webhook rate limited (retry after %s)", resp.Header.Get("Retry-After"))
+	default:
+		return fmt.Errorf("discord webhook returned %d%s", resp.StatusCode, detail)
+	}
+}
+
+// errorDetail returns the truncated message field of a Discord error response
+// エラーはログや送信履歴に残るため、レスポンス本文はそのまま含めない
+func errorDetail(resp *http.Response) string {
+	var body struct {
+		Message string `json:"message"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxErrorBodyBytes)).Decode(&body); err != nil || body.Message == "" {
+		return ""
+	}
+
+	message := []rune(body.Message)
+	if len(message) > maxErrorMessageLength {
+		return ": " + string(message[:maxErrorMessageLength]) + "..."
+	}
+	return ": " + string(message)
+}
+
+// Mention returns the mention markup of a Discord user
+func Mention(discordUserID string) string {
+	return "<@" + discordUserID + ">"
+}
+
+// mentionsOf builds the mention line and allowed mentions for the users
+func mentionsOf(discordUserIDs []string) (string, *AllowedMentions) {
+	allowed := &AllowedMentions{Parse: []string{}}
+	if len(discordUserIDs) == 0 {
+		return "", allowed
+	}
+
+	var content bytes.Buffer
+	for i, id := range discordUserIDs {
+		if i == maxMentions {
+			fmt.Fprintf(&content, " ほか%d名", len(discordUserIDs)-maxMentions)
+			break
+		}
+		if i > 0 {
+			content.WriteString(" ")
+		}
+		content.WriteString(Mention(id))
+		allowed.Users = append(allowed.Users, id)
+	}
+
+	return content.String(), allowed
+}
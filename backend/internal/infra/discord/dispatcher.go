@@ -0,0 +1,157 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+)
+
+// Compile-time interface compliance check
+var (
+	_ notification.Dispatcher = (*Dispatcher)(nil)
+	_ notification.Announcer  = (*Dispatcher)(nil)
+)
+
+// Embed colors
+const (
+	colorInfo    = 0x5865F2 // blurple
+	colorSuccess = 0x57F287 // green
+	colorWarning = 0xFEE75C // yellow
+	colorDanger  = 0xED4245 // red
+)
+
+// Dispatcher delivers notifications and announcements to Discord webhooks
+// メンバー宛ての通知は <@discordUserID> でメンションし、Embed で本文を表示する
+type Dispatcher struct {
+	client     *Client
+	baseURL    string // 告知の相対URL（公開ページのパス）に付与するフロントエンドのURL
+	resolver   *webhookResolver
+	memberRepo member.MemberRepository
+}
+
+// NewDispatcher creates a new Dispatcher
+func NewDispatcher(
+	client *Client,
+	baseURL string,
+	webhookRepo notification.DiscordWebhookRepository,
+	businessDayRepo event.EventBusinessDayRepository,
+	memberRepo member.MemberRepository,
+) *Dispatcher {
+	return &Dispatcher{
+		client:  client,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		resolver: &webhookResolver{
+			webhookRepo:     webhookRepo,
+			businessDayRepo: businessDayRepo,
+		},
+		memberRepo: memberRepo,
+	}
+}
+
+// Channel returns the channel this dispatcher delivers to
+func (d *Dispatcher) Channel() notification.Channel {
+	return notification.ChannelDiscord
+}
+
+// Dispatch posts the notification to the webhook of the event, mentioning the recipient
+func (d *Dispatcher) Dispatch(ctx context.Context, msg notification.Message) error {
+	recipient, err := d.memberRepo.FindByID(ctx, msg.TenantID, msg.RecipientID)
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			return fmt.Errorf("%w: recipient not found", notification.ErrUndeliverable)
+		}
+		return fmt.Errorf("failed to find recipient: %w", err)
+	}
+	if recipient.DiscordUserID() == "" {
+		return fmt.Errorf("%w: recipient has no discord account", notification.ErrUndeliverable)
+	}
+
+	webhookURL, err := d.resolver.resolve(ctx, msg.TenantID, nil, msg.BusinessDayID)
+	if err != nil {
+		return err
+	}
+
+	title, color := notificationEmbedStyle(msg.Type)
+	content, allowed := mentionsOf([]string{recipient.DiscordUserID()})
+	return d.client.Execute(ctx, webhookURL, WebhookPayload{
+		Content: content,
+		Embeds: []Embed{{
+			Title:       title,
+			Description: msg.Content,
+			Color:       color,
+		}},
+		AllowedMentions: allowed,
+	})
+}
+
+// Announce posts the announcement to the webhook of the event
+func (d *Dispatcher) Announce(ctx context.Context, a notification.Announcement) error {
+	webhookURL, err := d.resolver.resolve(ctx, a.TenantID, a.EventID, a.BusinessDayID)
+	if err != nil {
+		return err
+	}
+
+	embed := Embed{
+		Title:       a.Title,
+		Description: a.Description,
+		URL:         d.absoluteURL(a.URL),
+		Color:       announcementColor(a.Kind),
+	}
+	for _, f := range a.Fields {
+		embed.Fields = append(embed.Fields, EmbedField{Name: f.Name, Value: f.Value, Inline: true})
+	}
+
+	content, allowed := mentionsOf(a.Mentions)
+	return d.client.Execute(ctx, webhookURL, WebhookPayload{
+		Content:         content,
+		Embeds:          []Embed{embed},
+		AllowedMentions: allowed,
+	})
+}
+
+// absoluteURL resolves a path against the base URL
+func (d *Dispatcher) absoluteURL(url string) string {
+	if strings.HasPrefix(url, "/") {
+		return d.baseURL + url
+	}
+	return url
+}
+
+// notificationEmbedStyle returns the embed title and color of a notification type
+func notificationEmbedStyle(t notification.NotificationType) (string, int) {
+	switch t {
+	case notification.NotificationTypeShiftRecruitment:
+		return "シフト募集", colorInfo
+	case notification.NotificationTypeDeadlineReminder:
+		return "締切リマインダー", colorWarning
+	case notification.NotificationTypeShiftConfirmed:
+		return "シフト確定", colorSuccess
+	case notification.NotificationTypeAttendanceReminder:
+		return "出勤リマインダー", colorInfo
+	case notification.NotificationTypeUrgentHelp:
+		return "緊急ヘルプ要請", colorDanger
+	default:
+		return "お知らせ", colorInfo
+	}
+}
+
+// announcementColor returns the embed color of an announcement kind
+func announcementColor(k notification.AnnouncementKind) int {
+	switch k {
+	case notification.AnnouncementKindCollectionOpened:
+		return colorInfo
+	case notification.AnnouncementKindCollectionDeadline:
+		return colorWarning
+	case notification.AnnouncementKindUnderstaffedSlot:
+		return colorDanger
+	case notification.AnnouncementKindCollectionClosed, notification.AnnouncementKindScheduleClosed:
+		return colorSuccess
+	default:
+		return colorInfo
+	}
+}
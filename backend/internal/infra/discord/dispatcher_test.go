@@ -0,0 +1,347 @@
+package discord_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/infra/discord"
+)
+
+// =====================================================
+// Stand-in Discord server and mocks
+// =====================================================
+
+// standInServer records the webhook payloads it receives
+type standInServer struct {
+	*httptest.Server
+	status   int
+	body     string // エラー時のレスポンス本文
+	paths    []string
+	payloads []discord.WebhookPayload
+}
+
+func newStandInServer(t *testing.T) *standInServer {
+	t.Helper()
+	s := &standInServer{status: http.StatusNoContent}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload discord.WebhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("failed to decode webhook payload: %v", err)
+		}
+		s.paths = append(s.paths, r.URL.Path)
+		s.payloads = append(s.payloads, payload)
+		w.WriteHeader(s.status)
+		_, _ = w.Write([]byte(s.body))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// client returns a Client whose requests to Discord are sent to the stand-in server
+func (s *standInServer) client() *discord.Client {
+	return discord.NewClient(&http.Client{Transport: &standInTransport{target: s.URL}})
+}
+
+// standInTransport rewrites the scheme and host of each request to the target server
+type standInTransport struct {
+	target string
+}
+
+func (tr *standInTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	target, err := url.Parse(tr.target)
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.URL.Scheme = target.Scheme
+	req.URL.Host = target.Host
+	req.Host = target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// webhookURL returns a Discord webhook URL ending with the suffix
+func webhookURL(suffix string) string {
+	return "https://discord.com/api/webhooks/123" + suffix
+}
+
+type mockWebhookRepository struct {
+	tenantDefault *notification.DiscordWebhook
+	byEvent       map[common.EventID]*notification.DiscordWebhook
+}
+
+func (m *mockWebhookRepository) Save(ctx context.Context, webhook *notification.DiscordWebhook) error {
+	return nil
+}
+
+func (m *mockWebhookRepository) FindTenantDefault(ctx context.Context, tenantID common.TenantID) (*notification.DiscordWebhook, error) {
+	if m.tenantDefault == nil {
+		return nil, common.NewNotFoundError("DiscordWebhook", tenantID.String())
+	}
+	return m.tenantDefault, nil
+}
+
+func (m *mockWebhookRepository) FindByEventID(ctx context.Context, tenantID common.TenantID, eventID common.EventID) (*notification.DiscordWebhook, error) {
+	w, ok := m.byEvent[eventID]
+	if !ok {
+		return nil, common.NewNotFoundError("DiscordWebhook", eventID.String())
+	}
+	return w, nil
+}
+
+func (m *mockWebhookRepository) Delete(ctx context.Context, tenantID common.TenantID, webhookID notification.DiscordWebhookID) error {
+	return nil
+}
+
+type mockBusinessDayRepository struct {
+	event.EventBusinessDayRepository
+	businessDays map[event.BusinessDayID]*event.EventBusinessDay
+}
+
+func (m *mockBusinessDayRepository) FindByID(ctx context.Context, tenantID common.TenantID, id event.BusinessDayID) (*event.EventBusinessDay, error) {
+	bd, ok := m.businessDays[id]
+	if !ok {
+		return nil, common.NewNotFoundError("EventBusinessDay", id.String())
+	}
+	return bd, nil
+}
+
+type mockMemberRepository struct {
+	member.MemberRepository
+	members map[common.MemberID]*member.Member
+}
+
+func (m *mockMemberRepository) FindByID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) (*member.Member, error) {
+	mem, ok := m.members[memberID]
+	if !ok {
+		return nil, common.NewNotFoundError("Member", memberID.String())
+	}
+	return mem, nil
+}
+
+// =====================================================
+// Helper Functions
+// =====================================================
+
+func createTestBusinessDay(t *testing.T, tenantID common.TenantID, eventID common.EventID) *event.EventBusinessDay {
+	t.Helper()
+	bd, err := event.NewEventBusinessDay(time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC), tenantID, eventID,
+		time.Date(2025, 1, 18, 0, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+		event.OccurrenceTypeSpecial, nil)
+	if err != nil {
+		t.Fatalf("Failed to create business day: %v", err)
+	}
+	return bd
+}
+
+func createTestMember(t *testing.T, tenantID common.TenantID) *member.Member {
+	t.Helper()
+	mem, err := member.NewMember(time.Now(), tenantID, "テストメンバー", "123456789012345678", "")
+	if err != nil {
+		t.Fatalf("Failed to create member: %v", err)
+	}
+	return mem
+}
+
+func createTestWebhook(t *testing.T, tenantID common.TenantID, eventID *common.EventID, url string, enabled bool) *notification.DiscordWebhook {
+	t.Helper()
+	w, err := notification.NewDiscordWebhook(time.Now(), tenantID, eventID, url, enabled)
+	if err != nil {
+		t.Fatalf("Failed to create discord webhook: %v", err)
+	}
+	return w
+}
+
+// =====================================================
+// Dispatcher Tests
+// =====================================================
+
+func TestDispatcher_Dispatch_MentionsRecipientOnEventWebhook(t *testing.T) {
+	server := newStandInServer(t)
+	tenantID := common.NewTenantID()
+	eventID := common.NewEventID()
+	bd := createTestBusinessDay(t, tenantID, eventID)
+	mem := createTestMember(t, tenantID)
+
+	dispatcher := discord.NewDispatcher(
+		server.client(),
+		"https://vrcshift.example.com/",
+		&mockWebhookRepository{
+			tenantDefault: createTestWebhook(t, tenantID, nil, webhookURL("/tenant"), true),
+			byEvent: map[common.EventID]*notification.DiscordWebhook{
+				eventID: createTestWebhook(t, tenantID, &eventID, webhookURL("/event"), true),
+			},
+		},
+		&mockBusinessDayRepository{businessDays: map[event.BusinessDayID]*event.EventBusinessDay{bd.BusinessDayID(): bd}},
+		&mockMemberRepository{members: map[common.MemberID]*member.Member{mem.MemberID(): mem}},
+	)
+
+	bdID := bd.BusinessDayID()
+	err := dispatcher.Dispatch(context.Background(), notification.Message{
+		TenantID:      tenantID,
+		BusinessDayID: &bdID,
+		RecipientID:   mem.MemberID(),
+		Type:          notification.NotificationTypeShiftConfirmed,
+		Content:       "シフトが確定しました",
+	})
+	if err != nil {
+		t.Fatalf("Dispatch() should succeed, got error: %v", err)
+	}
+
+	if len(server.payloads) != 1 || server.paths[0] != "/api/webhooks/123/event" {
+		t.Fatalf("expected one post to the event webhook, got %v", server.paths)
+	}
+	payload := server.payloads[0]
+	if payload.Content != "<@123456789012345678>" {
+		t.Errorf("content should mention the recipient, got %q", payload.Content)
+	}
+	if payload.AllowedMentions == nil || len(payload.AllowedMentions.Users) != 1 || len(payload.AllowedMentions.Parse) != 0 {
+		t.Errorf("only the recipient should be pinged: %+v", payload.AllowedMentions)
+	}
+	if len(payload.Embeds) != 1 || payload.Embeds[0].Title != "シフト確定" || payload.Embeds[0].Description != "シフトが確定しました" {
+		t.Errorf("unexpected embed: %+v", payload.Embeds)
+	}
+}
+
+func TestDispatcher_Dispatch_Undeliverable(t *testing.T) {
+	tests := []struct {
+		name         string
+		tenantURL    string // 空の場合はテナントの既定設定なし
+		eventURL     string // 空の場合はイベント個別設定なし
+		eventEnabled bool
+		status       int
+	}{
+		{name: "no webhook configured", status: http.StatusNoContent},
+		{name: "event webhook disabled", tenantURL: "/tenant", eventURL: "/event", eventEnabled: false, status: http.StatusNoContent},
+		{name: "webhook deleted on discord", tenantURL: "/tenant", status: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newStandInServer(t)
+			server.status = tt.status
+			tenantID := common.NewTenantID()
+			eventID := common.NewEventID()
+			bd := createTestBusinessDay(t, tenantID, eventID)
+			mem := createTestMember(t, tenantID)
+
+			webhooks := &mockWebhookRepository{byEvent: map[common.EventID]*notification.DiscordWebhook{}}
+			if tt.tenantURL != "" {
+				webhooks.tenantDefault = createTestWebhook(t, tenantID, nil, webhookURL(tt.tenantURL), true)
+			}
+			if tt.eventURL != "" {
+				webhooks.byEvent[eventID] = createTestWebhook(t, tenantID, &eventID, webhookURL(tt.eventURL), tt.eventEnabled)
+			}
+
+			dispatcher := discord.NewDispatcher(
+				server.client(),
+				"https://vrcshift.example.com/",
+				webhooks,
+				&mockBusinessDayRepository{businessDays: map[event.BusinessDayID]*event.EventBusinessDay{bd.BusinessDayID(): bd}},
+				&mockMemberRepository{members: map[common.MemberID]*member.Member{mem.MemberID(): mem}},
+			)
+
+			bdID := bd.BusinessDayID()
+			err := dispatcher.Dispatch(context.Background(), notification.Message{
+				TenantID:      tenantID,
+				BusinessDayID: &bdID,
+				RecipientID:   mem.MemberID(),
+				Type:          notification.NotificationTypeShiftConfirmed,
+				Content:       "シフトが確定しました",
+			})
+			if !errors.Is(err, notification.ErrUndeliverable) {
+				t.Errorf("Dispatch() should return ErrUndeliverable, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDispatcher_Dispatch_ServerErrorIsRetryable(t *testing.T) {
+	server := newStandInServer(t)
+	server.status = http.StatusInternalServerError
+	tenantID := common.NewTenantID()
+	mem := createTestMember(t, tenantID)
+
+	dispatcher := discord.NewDispatcher(
+		server.client(),
+		"https://vrcshift.example.com/",
+		&mockWebhookRepository{tenantDefault: createTestWebhook(t, tenantID, nil, webhookURL("/tenant"), true)},
+		&mockBusinessDayRepository{},
+		&mockMemberRepository{members: map[common.MemberID]*member.Member{mem.MemberID(): mem}},
+	)
+
+	err := dispatcher.Dispatch(context.Background(), notification.Message{
+		TenantID:    tenantID,
+		RecipientID: mem.MemberID(),
+		Type:        notification.NotificationTypeShiftConfirmed,
+		Content:     "シフトが確定しました",
+	})
+	if err == nil || errors.Is(err, notification.ErrUndeliverable) {
+		t.Errorf("Dispatch() should return a retryable error, got %v", err)
+	}
+}
+
+func TestClient_Execute_ErrorKeepsOnlyMessage(t *testing.T) {
+	server := newStandInServer(t)
+	server.status = http.StatusBadRequest
+	server.body = `{"message": "` + strings.Repeat("x", 300) + `", "code": 50006, "content": "secret payload"}`
+
+	err := server.client().Execute(context.Background(), webhookURL("/tenant"), discord.WebhookPayload{Content: "secret payload"})
+	if err == nil {
+		t.Fatal("Execute() should fail")
+	}
+	if !strings.HasPrefix(err.Error(), "discord webhook returned 400: xxx") || strings.Contains(err.Error(), "secret") || len(err.Error()) > 150 {
+		t.Errorf("error should keep only the status and a truncated message, got %q", err.Error())
+	}
+}
+
+func TestDispatcher_Announce(t *testing.T) {
+	server := newStandInServer(t)
+	tenantID := common.NewTenantID()
+	bd := createTestBusinessDay(t, tenantID, common.NewEventID())
+
+	dispatcher := discord.NewDispatcher(
+		server.client(),
+		"https://vrcshift.example.com/",
+		&mockWebhookRepository{tenantDefault: createTestWebhook(t, tenantID, nil, webhookURL("/tenant"), true)},
+		&mockBusinessDayRepository{businessDays: map[event.BusinessDayID]*event.EventBusinessDay{bd.BusinessDayID(): bd}},
+		&mockMemberRepository{},
+	)
+
+	bdID := bd.BusinessDayID()
+	err := dispatcher.Announce(context.Background(), notification.Announcement{
+		TenantID:      tenantID,
+		BusinessDayID: &bdID,
+		Kind:          notification.AnnouncementKindUnderstaffedSlot,
+		Title:         "人員が不足しています",
+		URL:           "/p/attendance/token",
+		Fields:        []notification.AnnouncementField{{Name: "不足", Value: "2名"}},
+		Mentions:      []string{"111", "222"},
+	})
+	if err != nil {
+		t.Fatalf("Announce() should succeed, got error: %v", err)
+	}
+
+	payload := server.payloads[0]
+	if payload.Content != "<@111> <@222>" || !strings.Contains(strings.Join(payload.AllowedMentions.Users, ","), "222") {
+		t.Errorf("unexpected mentions: %q %+v", payload.Content, payload.AllowedMentions)
+	}
+	if payload.Embeds[0].URL != "https://vrcshift.example.com/p/attendance/token" {
+		t.Errorf("relative URL should be resolved against the base URL, got %q", payload.Embeds[0].URL)
+	}
+	if len(payload.Embeds[0].Fields) != 1 || payload.Embeds[0].Fields[0].Value != "2名" {
+		t.Errorf("unexpected fields: %+v", payload.Embeds[0].Fields)
+	}
+}
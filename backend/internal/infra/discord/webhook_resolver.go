@@ -0,0 +1,53 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+)
+
+// webhookResolver resolves the webhook URL to post to
+// イベント個別の設定を優先し、なければテナントの既定設定を使う
+type webhookResolver struct {
+	webhookRepo     notification.DiscordWebhookRepository
+	businessDayRepo event.EventBusinessDayRepository
+}
+
+// resolve returns the webhook URL for the event (or the event of the business day)
+// 投稿先がない場合は notification.ErrUndeliverable を wrap して返す
+func (r *webhookResolver) resolve(ctx context.Context, tenantID common.TenantID, eventID *common.EventID, businessDayID *event.BusinessDayID) (string, error) {
+	if eventID == nil && businessDayID != nil {
+		bd, err := r.businessDayRepo.FindByID(ctx, tenantID, *businessDayID)
+		if err != nil && !common.IsNotFoundError(err) {
+			return "", fmt.Errorf("failed to find business day: %w", err)
+		}
+		if bd != nil {
+			id := bd.EventID()
+			eventID = &id
+		}
+	}
+
+	var eventWebhook *notification.DiscordWebhook
+	if eventID != nil {
+		w, err := r.webhookRepo.FindByEventID(ctx, tenantID, *eventID)
+		if err != nil && !common.IsNotFoundError(err) {
+			return "", fmt.Errorf("failed to find event discord webhook: %w", err)
+		}
+		eventWebhook = w
+	}
+
+	tenantDefault, err := r.webhookRepo.FindTenantDefault(ctx, tenantID)
+	if err != nil && !common.IsNotFoundError(err) {
+		return "", fmt.Errorf("failed to find tenant discord webhook: %w", err)
+	}
+
+	webhook := notification.ResolveDiscordWebhook(tenantDefault, eventWebhook)
+	if webhook == nil {
+		return "", fmt.Errorf("%w: discord webhook is not configured", notification.ErrUndeliverable)
+	}
+
+	return webhook.WebhookURL(), nil
+}
@@ -0,0 +1,71 @@
+package email
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+)
+
+// Compile-time interface compliance check
+var _ notification.Dispatcher = (*Dispatcher)(nil)
+
+// Dispatcher delivers notifications to the email address of the recipient member
+type Dispatcher struct {
+	emailService services.EmailService
+	memberRepo   member.MemberRepository
+}
+
+// NewDispatcher creates a new Dispatcher
+func NewDispatcher(emailService services.EmailService, memberRepo member.MemberRepository) *Dispatcher {
+	return &Dispatcher{
+		emailService: emailService,
+		memberRepo:   memberRepo,
+	}
+}
+
+// Channel returns the channel this dispatcher delivers to
+func (d *Dispatcher) Channel() notification.Channel {
+	return notification.ChannelEmail
+}
+
+// Dispatch sends the notification to the recipient's email address
+func (d *Dispatcher) Dispatch(ctx context.Context, msg notification.Message) error {
+	recipient, err := d.memberRepo.FindByID(ctx, msg.TenantID, msg.RecipientID)
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			return fmt.Errorf("%w: recipient not found", notification.ErrUndeliverable)
+		}
+		return fmt.Errorf("failed to find recipient: %w", err)
+	}
+	if recipient.Email() == "" {
+		return fmt.Errorf("%w: recipient has no email address", notification.ErrUndeliverable)
+	}
+
+	return d.emailService.SendNotificationEmail(ctx, services.SendNotificationEmailInput{
+		To:      recipient.Email(),
+		Subject: notificationSubject(msg.Type),
+		Body:    msg.Content,
+	})
+}
+
+// notificationSubject returns the email subject of a notification type
+func notificationSubject(t notification.NotificationType) string {
+	switch t {
+	case notification.NotificationTypeShiftRecruitment:
+		return "シフト募集"
+	case notification.NotificationTypeDeadlineReminder:
+		return "締切リマインダー"
+	case notification.NotificationTypeShiftConfirmed:
+		return "シフト確定"
+	case notification.NotificationTypeAttendanceReminder:
+		return "出勤リマインダー"
+	case notification.NotificationTypeUrgentHelp:
+		return "緊急ヘルプ要請"
+	default:
+		return "お知らせ"
+	}
+}
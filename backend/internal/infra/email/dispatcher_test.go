@@ -0,0 +1,113 @@
+package email_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/infra/email"
+)
+
+// =====================================================
+// Mocks
+// =====================================================
+
+type mockEmailService struct {
+	services.EmailService
+	sent []services.SendNotificationEmailInput
+}
+
+func (m *mockEmailService) SendNotificationEmail(ctx context.Context, input services.SendNotificationEmailInput) error {
+	m.sent = append(m.sent, input)
+	return nil
+}
+
+type mockMemberRepository struct {
+	member.MemberRepository
+	members map[common.MemberID]*member.Member
+}
+
+func (m *mockMemberRepository) FindByID(ctx context.Context, tenantID common.TenantID, memberID common.MemberID) (*member.Member, error) {
+	mem, ok := m.members[memberID]
+	if !ok {
+		return nil, common.NewNotFoundError("Member", memberID.String())
+	}
+	return mem, nil
+}
+
+// =====================================================
+// Dispatcher Tests
+// =====================================================
+
+func TestDispatcher_Dispatch_SendsToRecipientEmail(t *testing.T) {
+	tenantID := common.NewTenantID()
+	mem, err := member.NewMember(time.Now(), tenantID, "テストメンバー", "", "member@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create member: %v", err)
+	}
+	emailService := &mockEmailService{}
+	dispatcher := email.NewDispatcher(emailService, &mockMemberRepository{
+		members: map[common.MemberID]*member.Member{mem.MemberID(): mem},
+	})
+
+	err = dispatcher.Dispatch(context.Background(), notification.Message{
+		TenantID:    tenantID,
+		RecipientID: mem.MemberID(),
+		Type:        notification.NotificationTypeShiftConfirmed,
+		Content:     "シフトが確定しました",
+	})
+	if err != nil {
+		t.Fatalf("Dispatch() should succeed, got error: %v", err)
+	}
+
+	if len(emailService.sent) != 1 {
+		t.Fatalf("sent %d emails, want 1", len(emailService.sent))
+	}
+	sent := emailService.sent[0]
+	if sent.To != "member@example.com" || sent.Subject != "シフト確定" || sent.Body != "シフトが確定しました" {
+		t.Errorf("unexpected email: %+v", sent)
+	}
+}
+
+func TestDispatcher_Dispatch_UndeliverableWithoutEmail(t *testing.T) {
+	tenantID := common.NewTenantID()
+	noEmail, err := member.NewMember(time.Now(), tenantID, "メールなし", "123456789", "")
+	if err != nil {
+		t.Fatalf("Failed to create member: %v", err)
+	}
+
+	testCases := []struct {
+		name        string
+		recipientID common.MemberID
+	}{
+		{"no email address", noEmail.MemberID()},
+		{"recipient not found", common.NewMemberID()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			emailService := &mockEmailService{}
+			dispatcher := email.NewDispatcher(emailService, &mockMemberRepository{
+				members: map[common.MemberID]*member.Member{noEmail.MemberID(): noEmail},
+			})
+
+			err := dispatcher.Dispatch(context.Background(), notification.Message{
+				TenantID:    tenantID,
+				RecipientID: tc.recipientID,
+				Type:        notification.NotificationTypeDeadlineReminder,
+				Content:     "締切が近づいています",
+			})
+			if !errors.Is(err, notification.ErrUndeliverable) {
+				t.Errorf("Dispatch() should return ErrUndeliverable, got %v", err)
+			}
+			if len(emailService.sent) != 0 {
+				t.Errorf("sent %d emails, want 0", len(emailService.sent))
+			}
+		})
+	}
+}
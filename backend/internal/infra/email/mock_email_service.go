@@ -61,3 +61,14 @@ func (s *MockEmailService) SendPasswordResetEmail(ctx context.Context, input ser
 
 // Ensure MockEmailService implements EmailService
 var _ services.EmailService = (*MockEmailService)(nil)
+
+// SendNotificationEmail logs the notification email content
+func (s *MockEmailService) SendNotificationEmail(ctx context.Context, input services.SendNotificationEmailInput) error {
+	slog.Info("=== Mock Email Service: Notification Email ===",
+		"to", input.To,
+		"subject", notificationSubjectPrefix+input.Subject,
+		"body", input.Body,
+	)
+
+	return nil
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/resend/resend-go/v2"
 )
 
+// notificationSubjectPrefix is prepended to the subject of notification emails
+const notificationSubjectPrefix = "[VRC Shift Scheduler] "
+
 // ResendEmailService is an implementation of EmailService using Resend
 type ResendEmailService struct {
 	client    *resend.Client
@@ -125,5 +128,33 @@ func (s *ResendEmailService) SendPasswordResetEmail(ctx context.Context, input s
 	return nil
 }
 
+// SendNotificationEmail sends a notification email to a member via Resend
+func (s *ResendEmailService) SendNotificationEmail(ctx context.Context, input services.SendNotificationEmailInput) error {
+	subject := notificationSubjectPrefix + input.Subject
+
+	params := &resend.SendEmailRequest{
+		From:    s.fromEmail,
+		To:      []string{input.To},
+		Subject: subject,
+		Text:    input.Body,
+	}
+
+	sent, err := s.client.Emails.Send(params)
+	if err != nil {
+		slog.Error("Resend notification email send failed",
+			"error", err,
+			"to", input.To,
+			"from", s.fromEmail,
+			"subject", subject)
+		return fmt.Errorf("failed to send notification email via Resend: %w", err)
+	}
+
+	slog.Info("Notification email sent successfully",
+		"email_id", sent.Id,
+		"to", input.To)
+
+	return nil
+}
+
 // Ensure ResendEmailService implements EmailService
 var _ services.EmailService = (*ResendEmailService)(nil)
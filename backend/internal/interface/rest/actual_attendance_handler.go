@@ -8,7 +8,9 @@ import (
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/services"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
 )
 
 // ActualAttendanceHandler handles actual attendance-related HTTP requests
@@ -21,12 +23,16 @@ func NewActualAttendanceHandler(
 	businessDayRepo event.EventBusinessDayRepository,
 	memberRepo member.MemberRepository,
 	assignmentRepo shift.ShiftAssignmentRepository,
+	tenantRepo tenant.TenantRepository,
+	clock services.Clock,
 ) *ActualAttendanceHandler {
 	return &ActualAttendanceHandler{
 		getRecentActualAttendanceUC: appactual.NewGetRecentActualAttendanceUsecase(
 			businessDayRepo,
 			memberRepo,
 			assignmentRepo,
+			tenantRepo,
+			clock,
 		),
 	}
 }
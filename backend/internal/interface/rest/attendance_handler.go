@@ -73,7 +73,7 @@ type CreateCollectionRequest struct {
 	TargetType  string              `json:"target_type"` // "event" or "business_day"
 	TargetID    string              `json:"target_id"`   // optional
 	TargetDates []TargetDateRequest `json:"target_dates"`
-	Deadline    *time.Time          `json:"deadline"`  // optional
+	Deadline    *string             `json:"deadline"`  // optional: RFC3339、またはテナントのタイムゾーンでの日時・日付
 	GroupIDs    []string            `json:"group_ids"` // optional: target group IDs
 	RoleIDs     []string            `json:"role_ids"`  // optional: target role IDs
 }
@@ -99,6 +99,7 @@ type CollectionResponse struct {
 	PublicToken  string               `json:"public_token"`
 	Status       string               `json:"status"`
 	Deadline     *time.Time           `json:"deadline,omitempty"`
+	Timezone     string               `json:"timezone,omitempty"`  // Tenant timezone (public API only)
 	GroupIDs     []string             `json:"group_ids,omitempty"` // Target group IDs
 	RoleIDs      []string             `json:"role_ids,omitempty"`  // Target role IDs
 	CreatedAt    time.Time            `json:"created_at"`
@@ -159,7 +160,7 @@ type UpdateTargetDateRequest struct {
 type UpdateCollectionRequest struct {
 	Title       string                    `json:"title"`
 	Description string                    `json:"description"`
-	Deadline    *time.Time                `json:"deadline"`                  // optional
+	Deadline    *string                   `json:"deadline"`                  // optional: RFC3339、またはテナントのタイムゾーンでの日時・日付
 	TargetDates *[]UpdateTargetDateRequest `json:"target_dates,omitempty"` // nil=対象日更新なし
 }
 
@@ -217,6 +218,12 @@ func (h *AttendanceHandler) CreateCollection(w http.ResponseWriter, r *http.Requ
 		})
 	}
 
+	deadline, err := parseDeadline(ctx, req.Deadline)
+	if err != nil {
+		RespondBadRequest(w, "締切の形式が正しくありません")
+		return
+	}
+
 	// Usecase呼び出し
 	output, err := h.createCollectionUsecase.Execute(ctx, attendance.CreateCollectionInput{
 		TenantID:    tenantID.String(),
@@ -225,7 +232,7 @@ func (h *AttendanceHandler) CreateCollection(w http.ResponseWriter, r *http.Requ
 		TargetType:  req.TargetType,
 		TargetID:    req.TargetID,
 		TargetDates: targetDates,
-		Deadline:    req.Deadline,
+		Deadline:    deadline,
 		GroupIDs:    req.GroupIDs,
 		RoleIDs:     req.RoleIDs,
 	})
@@ -446,12 +453,18 @@ func (h *AttendanceHandler) UpdateCollection(w http.ResponseWriter, r *http.Requ
 		}
 	}
 
+	deadline, err := parseDeadline(ctx, req.Deadline)
+	if err != nil {
+		RespondBadRequest(w, "締切の形式が正しくありません")
+		return
+	}
+
 	output, err := h.updateCollectionUsecase.Execute(ctx, attendance.UpdateCollectionInput{
 		TenantID:     tenantID.String(),
 		CollectionID: collectionID,
 		Title:        req.Title,
 		Description:  req.Description,
-		Deadline:     req.Deadline,
+		Deadline:     deadline,
 		TargetDates:  targetDates,
 	})
 	if err != nil {
@@ -579,6 +592,7 @@ func (h *AttendanceHandler) GetCollectionByToken(w http.ResponseWriter, r *http.
 			PublicToken:  output.PublicToken,
 			Status:       output.Status,
 			Deadline:     output.Deadline,
+			Timezone:     output.Timezone,
 			GroupIDs:     output.GroupIDs,
 			RoleIDs:      output.RoleIDs,
 			CreatedAt:    output.CreatedAt,
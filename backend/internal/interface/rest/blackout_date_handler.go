@@ -0,0 +1,400 @@
+package rest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	appevent "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/go-chi/chi/v5"
+)
+
+// BlackoutDateHandler handles blackout date set related HTTP requests
+type BlackoutDateHandler struct {
+	createSetUC        *appevent.CreateBlackoutDateSetUsecase
+	listSetsUC         *appevent.ListBlackoutDateSetsUsecase
+	getSetUC           *appevent.GetBlackoutDateSetUsecase
+	updateSetUC        *appevent.UpdateBlackoutDateSetUsecase
+	deleteSetUC        *appevent.DeleteBlackoutDateSetUsecase
+	importJPHolidaysUC *appevent.ImportJapaneseHolidaysUsecase
+	importICSUC        *appevent.ImportBlackoutICSUsecase
+}
+
+// NewBlackoutDateHandler creates a new BlackoutDateHandler with injected usecases
+func NewBlackoutDateHandler(
+	createSetUC *appevent.CreateBlackoutDateSetUsecase,
+	listSetsUC *appevent.ListBlackoutDateSetsUsecase,
+	getSetUC *appevent.GetBlackoutDateSetUsecase,
+	updateSetUC *appevent.UpdateBlackoutDateSetUsecase,
+	deleteSetUC *appevent.DeleteBlackoutDateSetUsecase,
+	importJPHolidaysUC *appevent.ImportJapaneseHolidaysUsecase,
+	importICSUC *appevent.ImportBlackoutICSUsecase,
+) *BlackoutDateHandler {
+	return &BlackoutDateHandler{
+		createSetUC:        createSetUC,
+		listSetsUC:         listSetsUC,
+		getSetUC:           getSetUC,
+		updateSetUC:        updateSetUC,
+		deleteSetUC:        deleteSetUC,
+		importJPHolidaysUC: importJPHolidaysUC,
+		importICSUC:        importICSUC,
+	}
+}
+
+// BlackoutDateRequest represents a blackout date in request bodies
+type BlackoutDateRequest struct {
+	Date  string `json:"date"` // YYYY-MM-DD
+	Label string `json:"label,omitempty"`
+}
+
+// CreateBlackoutDateSetRequest represents the request body for creating a blackout date set
+type CreateBlackoutDateSetRequest struct {
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	Action      string                `json:"action,omitempty"` // "skip"（デフォルト）または "move"
+	Dates       []BlackoutDateRequest `json:"dates,omitempty"`
+}
+
+// UpdateBlackoutDateSetRequest represents the request body for updating a blackout date set
+type UpdateBlackoutDateSetRequest struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Action      string                 `json:"action"`
+	IsActive    *bool                  `json:"is_active,omitempty"`
+	Dates       *[]BlackoutDateRequest `json:"dates,omitempty"` // 指定した場合は全件を置き換える
+}
+
+// ImportJapaneseHolidaysRequest represents the request body for importing Japanese holidays
+type ImportJapaneseHolidaysRequest struct {
+	FromYear int `json:"from_year"`
+	ToYear   int `json:"to_year"`
+}
+
+// BlackoutDateResponse represents a blackout date in API responses
+type BlackoutDateResponse struct {
+	Date  string `json:"date"`
+	Label string `json:"label"`
+}
+
+// BlackoutDateSetResponse represents a blackout date set in API responses
+type BlackoutDateSetResponse struct {
+	SetID       string                 `json:"set_id"`
+	TenantID    string                 `json:"tenant_id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Action      string                 `json:"action"`
+	IsActive    bool                   `json:"is_active"`
+	Dates       []BlackoutDateResponse `json:"dates"`
+	CreatedAt   string                 `json:"created_at"`
+	UpdatedAt   string                 `json:"updated_at"`
+}
+
+// CreateBlackoutDateSet handles POST /api/v1/blackout-date-sets
+func (h *BlackoutDateHandler) CreateBlackoutDateSet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := GetTenantID(ctx)
+	if !ok {
+		RespondBadRequest(w, "tenant_id is required")
+		return
+	}
+
+	var req CreateBlackoutDateSetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondBadRequest(w, "Invalid request body")
+		return
+	}
+
+	action := event.BlackoutActionSkip
+	if req.Action != "" {
+		action = event.BlackoutAction(req.Action)
+	}
+
+	dates, err := parseBlackoutDates(req.Dates)
+	if err != nil {
+		RespondBadRequest(w, "Invalid date format (expected YYYY-MM-DD)")
+		return
+	}
+
+	set, err := h.createSetUC.Execute(ctx, appevent.CreateBlackoutDateSetInput{
+		TenantID:    tenantID,
+		Name:        req.Name,
+		Description: req.Description,
+		Action:      action,
+		Dates:       dates,
+	})
+	if err != nil {
+		RespondDomainError(w, err)
+		return
+	}
+
+	RespondCreated(w, toBlackoutDateSetResponse(set))
+}
+
+// ListBlackoutDateSets handles GET /api/v1/blackout-date-sets
+func (h *BlackoutDateHandler) ListBlackoutDateSets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := GetTenantID(ctx)
+	if !ok {
+		RespondBadRequest(w, "tenant_id is required")
+		return
+	}
+
+	sets, err := h.listSetsUC.Execute(ctx, appevent.ListBlackoutDateSetsInput{TenantID: tenantID})
+	if err != nil {
+		RespondDomainError(w, err)
+		return
+	}
+
+	responses := make([]BlackoutDateSetResponse, 0, len(sets))
+	for _, set := range sets {
+		responses = append(responses, toBlackoutDateSetResponse(set))
+	}
+
+	RespondSuccess(w, map[string]interface{}{
+		"blackout_date_sets": responses,
+		"count":              len(responses),
+	})
+}
+
+// GetBlackoutDateSet handles GET /api/v1/blackout-date-sets/:set_id
+func (h *BlackoutDateHandler) GetBlackoutDateSet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := GetTenantID(ctx)
+	if !ok {
+		RespondBadRequest(w, "tenant_id is required")
+		return
+	}
+
+	setID, ok := blackoutDateSetIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	set, err := h.getSetUC.Execute(ctx, appevent.GetBlackoutDateSetInput{TenantID: tenantID, SetID: setID})
+	if err != nil {
+		RespondDomainError(w, err)
+		return
+	}
+
+	RespondSuccess(w, toBlackoutDateSetResponse(set))
+}
+
+// UpdateBlackoutDateSet handles PUT /api/v1/blackout-date-sets/:set_id
+func (h *BlackoutDateHandler) UpdateBlackoutDateSet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := GetTenantID(ctx)
+	if !ok {
+		RespondBadRequest(w, "tenant_id is required")
+		return
+	}
+
+	setID, ok := blackoutDateSetIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateBlackoutDateSetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondBadRequest(w, "Invalid request body")
+		return
+	}
+
+	var dates *[]event.BlackoutDate
+	if req.Dates != nil {
+		parsed, err := parseBlackoutDates(*req.Dates)
+		if err != nil {
+			RespondBadRequest(w, "Invalid date format (expected YYYY-MM-DD)")
+			return
+		}
+		dates = &parsed
+	}
+
+	set, err := h.updateSetUC.Execute(ctx, appevent.UpdateBlackoutDateSetInput{
+		TenantID:    tenantID,
+		SetID:       setID,
+		Name:        req.Name,
+		Description: req.Description,
+		Action:      event.BlackoutAction(req.Action),
+		IsActive:    req.IsActive,
+		Dates:       dates,
+	})
+	if err != nil {
+		RespondDomainError(w, err)
+		return
+	}
+
+	RespondSuccess(w, toBlackoutDateSetResponse(set))
+}
+
+// DeleteBlackoutDateSet handles DELETE /api/v1/blackout-date-sets/:set_id
+func (h *BlackoutDateHandler) DeleteBlackoutDateSet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := GetTenantID(ctx)
+	if !ok {
+		RespondBadRequest(w, "tenant_id is required")
+		return
+	}
+
+	setID, ok := blackoutDateSetIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.deleteSetUC.Execute(ctx, appevent.DeleteBlackoutDateSetInput{TenantID: tenantID, SetID: setID}); err != nil {
+		RespondDomainError(w, err)
+		return
+	}
+
+	RespondNoContent(w)
+}
+
+// ImportJapaneseHolidays handles POST /api/v1/blackout-date-sets/:set_id/import/jp-holidays
+func (h *BlackoutDateHandler) ImportJapaneseHolidays(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := GetTenantID(ctx)
+	if !ok {
+		RespondBadRequest(w, "tenant_id is required")
+		return
+	}
+
+	setID, ok := blackoutDateSetIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	var req ImportJapaneseHolidaysRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondBadRequest(w, "Invalid request body")
+		return
+	}
+	// 年の指定がない場合は今年のみ
+	if req.FromYear == 0 {
+		req.FromYear = time.Now().Year()
+	}
+	if req.ToYear == 0 {
+		req.ToYear = req.FromYear
+	}
+
+	result, err := h.importJPHolidaysUC.Execute(ctx, appevent.ImportJapaneseHolidaysInput{
+		TenantID: tenantID,
+		SetID:    setID,
+		FromYear: req.FromYear,
+		ToYear:   req.ToYear,
+	})
+	if err != nil {
+		RespondDomainError(w, err)
+		return
+	}
+
+	RespondSuccess(w, toBlackoutImportResponse(result))
+}
+
+// ImportBlackoutICS handles POST /api/v1/blackout-date-sets/:set_id/import/ics
+// multipart/form-data の "file" フィールドで ICS ファイルを受け取る
+func (h *BlackoutDateHandler) ImportBlackoutICS(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := GetTenantID(ctx)
+	if !ok {
+		RespondBadRequest(w, "tenant_id is required")
+		return
+	}
+
+	setID, ok := blackoutDateSetIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	// マルチパートフォームのパース（最大10MB）
+	if err := r.ParseMultipartForm(10 << 20); err != nil {
+		RespondBadRequest(w, "Failed to parse form data")
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		RespondBadRequest(w, "File is required")
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		RespondInternalError(w)
+		return
+	}
+
+	result, err := h.importICSUC.Execute(ctx, appevent.ImportBlackoutICSInput{
+		TenantID: tenantID,
+		SetID:    setID,
+		Data:     data,
+	})
+	if err != nil {
+		RespondDomainError(w, err)
+		return
+	}
+
+	RespondSuccess(w, toBlackoutImportResponse(result))
+}
+
+// blackoutDateSetIDParam reads and validates the set_id URL parameter
+func blackoutDateSetIDParam(w http.ResponseWriter, r *http.Request) (event.BlackoutDateSetID, bool) {
+	setID := event.BlackoutDateSetID(chi.URLParam(r, "set_id"))
+	if err := setID.Validate(); err != nil {
+		RespondBadRequest(w, "Invalid set_id format")
+		return "", false
+	}
+	return setID, true
+}
+
+// parseBlackoutDates converts request dates (YYYY-MM-DD) into blackout dates
+func parseBlackoutDates(reqs []BlackoutDateRequest) ([]event.BlackoutDate, error) {
+	dates := make([]event.BlackoutDate, 0, len(reqs))
+	for _, req := range reqs {
+		d, err := time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			return nil, err
+		}
+		dates = append(dates, event.BlackoutDate{Date: d, Label: req.Label})
+	}
+	return dates, nil
+}
+
+// toBlackoutDateSetResponse converts a BlackoutDateSet entity to BlackoutDateSetResponse
+func toBlackoutDateSetResponse(set *event.BlackoutDateSet) BlackoutDateSetResponse {
+	dates := make([]BlackoutDateResponse, 0, len(set.Dates()))
+	for _, d := range set.Dates() {
+		dates = append(dates, BlackoutDateResponse{
+			Date:  d.Date.Format("2006-01-02"),
+			Label: d.Label,
+		})
+	}
+
+	return BlackoutDateSetResponse{
+		SetID:       set.SetID().String(),
+		TenantID:    set.TenantID().String(),
+		Name:        set.Name(),
+		Description: set.Description(),
+		Action:      string(set.Action()),
+		IsActive:    set.IsActive(),
+		Dates:       dates,
+		CreatedAt:   set.CreatedAt().Format(time.RFC3339),
+		UpdatedAt:   set.UpdatedAt().Format(time.RFC3339),
+	}
+}
+
+// toBlackoutImportResponse converts an import result into a response body
+func toBlackoutImportResponse(result *appevent.ImportBlackoutDatesOutput) map[string]interface{} {
+	return map[string]interface{}{
+		"added_count":       result.AddedCount,
+		"blackout_date_set": toBlackoutDateSetResponse(result.Set),
+	}
+}
@@ -0,0 +1,60 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	apptenant "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/tenant"
+)
+
+// BotAuthScheme is the Authorization scheme of bot requests (Authorization: Bot <token>)
+const BotAuthScheme = "Bot "
+
+// HeaderDiscordUserID is the header carrying the Discord user the bot acts for
+const HeaderDiscordUserID = "X-Discord-User-ID"
+
+// BotAuth is a middleware that authenticates the Discord bot with a bot token
+// トークンのテナントと、X-Discord-User-ID に紐付くメンバーを context にセットする
+func BotAuth(authenticateUC *apptenant.AuthenticateBotUsecase) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authHeader := r.Header.Get("Authorization")
+			if !strings.HasPrefix(authHeader, BotAuthScheme) {
+				RespondError(w, http.StatusUnauthorized, "ERR_UNAUTHORIZED", "Bot token is required", nil)
+				return
+			}
+
+			discordUserID := strings.TrimSpace(r.Header.Get(HeaderDiscordUserID))
+			if discordUserID == "" {
+				RespondError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", HeaderDiscordUserID+" header is required", nil)
+				return
+			}
+
+			output, err := authenticateUC.Execute(r.Context(), apptenant.AuthenticateBotInput{
+				Token:         strings.TrimSpace(authHeader[len(BotAuthScheme):]),
+				DiscordUserID: discordUserID,
+			})
+			if err != nil {
+				switch {
+				case errors.Is(err, apptenant.ErrInvalidBotToken):
+					RespondError(w, http.StatusUnauthorized, "ERR_UNAUTHORIZED", "Invalid or revoked bot token", nil)
+				case errors.Is(err, apptenant.ErrDiscordUserNotLinked):
+					RespondError(w, http.StatusNotFound, "ERR_MEMBER_NOT_LINKED", "この Discord アカウントはメンバーに紐付けられていません", nil)
+				default:
+					slog.Error("BotAuth: failed to authenticate", slog.Any("error", err))
+					RespondInternalError(w)
+				}
+				return
+			}
+
+			ctx := r.Context()
+			ctx = context.WithValue(ctx, ContextKeyTenantID, output.TenantID)
+			ctx = context.WithValue(ctx, ContextKeyMemberID, output.Member.MemberID())
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,339 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	appattendance "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/attendance"
+	appschedule "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/schedule"
+	appshift "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/attendance"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/go-chi/chi/v5"
+)
+
+// BotHandler handles requests from the Discord bot on behalf of a member
+// テナントとメンバーは BotAuth middleware が context にセットする
+type BotHandler struct {
+	listUpcomingShiftsUC   *appshift.ListUpcomingShiftsUsecase
+	listOpenSlotsUC        *appshift.ListOpenSlotsUsecase
+	claimOpenSlotUC        *appshift.ClaimOpenSlotUsecase
+	listOpenCollectionsUC  *appattendance.ListMemberOpenCollectionsUsecase
+	submitCollectionRespUC *appattendance.SubmitMemberResponseUsecase
+	listOpenSchedulesUC    *appschedule.ListMemberOpenSchedulesUsecase
+}
+
+// NewBotHandler creates a new BotHandler with injected usecases
+func NewBotHandler(
+	listUpcomingShiftsUC *appshift.ListUpcomingShiftsUsecase,
+	listOpenSlotsUC *appshift.ListOpenSlotsUsecase,
+	claimOpenSlotUC *appshift.ClaimOpenSlotUsecase,
+	listOpenCollectionsUC *appattendance.ListMemberOpenCollectionsUsecase,
+	submitCollectionRespUC *appattendance.SubmitMemberResponseUsecase,
+	listOpenSchedulesUC *appschedule.ListMemberOpenSchedulesUsecase,
+) *BotHandler {
+	return &BotHandler{
+		listUpcomingShiftsUC:   listUpcomingShiftsUC,
+		listOpenSlotsUC:        listOpenSlotsUC,
+		claimOpenSlotUC:        claimOpenSlotUC,
+		listOpenCollectionsUC:  listOpenCollectionsUC,
+		submitCollectionRespUC: submitCollectionRespUC,
+		listOpenSchedulesUC:    listOpenSchedulesUC,
+	}
+}
+
+// BotShiftResponse represents an upcoming shift of the member
+type BotShiftResponse struct {
+	AssignmentID  string `json:"assignment_id"`
+	SlotID        string `json:"slot_id"`
+	SlotName      string `json:"slot_name"`
+	EventID       string `json:"event_id"`
+	EventName     string `json:"event_name"`
+	BusinessDayID string `json:"business_day_id"`
+	TargetDate    string `json:"target_date"`
+	StartAt       string `json:"start_at"`
+	EndAt         string `json:"end_at"`
+}
+
+// BotOpenSlotResponse represents a slot with vacancies
+type BotOpenSlotResponse struct {
+	SlotID        string `json:"slot_id"`
+	SlotName      string `json:"slot_name"`
+	EventID       string `json:"event_id"`
+	EventName     string `json:"event_name"`
+	BusinessDayID string `json:"business_day_id"`
+	TargetDate    string `json:"target_date"`
+	StartAt       string `json:"start_at"`
+	EndAt         string `json:"end_at"`
+	RequiredCount int    `json:"required_count"`
+	AssignedCount int    `json:"assigned_count"`
+}
+
+// BotClaimSlotRequest represents the request body for claiming an open slot
+type BotClaimSlotRequest struct {
+	Note string `json:"note"`
+}
+
+// BotSubmitResponseRequest represents the request body for responding to an attendance collection
+type BotSubmitResponseRequest struct {
+	TargetDateID  string  `json:"target_date_id"`
+	Response      string  `json:"response"` // "attending" | "absent" | "undecided"
+	Note          string  `json:"note"`
+	AvailableFrom *string `json:"available_from,omitempty"`
+	AvailableTo   *string `json:"available_to,omitempty"`
+}
+
+// ListMyShifts handles GET /api/v1/bot/shifts?days=14
+func (h *BotHandler) ListMyShifts(w http.ResponseWriter, r *http.Request) {
+	tenantID, memberID, ok := botMember(w, r)
+	if !ok {
+		return
+	}
+	days, ok := botDaysParam(w, r)
+	if !ok {
+		return
+	}
+
+	shifts, err := h.listUpcomingShiftsUC.Execute(r.Context(), appshift.ListUpcomingShiftsInput{
+		TenantID: tenantID,
+		MemberID: memberID,
+		Days:     days,
+	})
+	if err != nil {
+		log.Printf("Bot ListMyShifts error: %+v", err)
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	resp := make([]BotShiftResponse, 0, len(shifts))
+	for _, s := range shifts {
+		resp = append(resp, BotShiftResponse{
+			AssignmentID:  s.Shift.AssignmentID.String(),
+			SlotID:        s.Shift.SlotID.String(),
+			SlotName:      s.Shift.SlotName,
+			EventID:       s.EventID.String(),
+			EventName:     s.EventName,
+			BusinessDayID: s.Shift.BusinessDayID.String(),
+			TargetDate:    s.Shift.TargetDate.Format("2006-01-02"),
+			StartAt:       s.StartAt.Format(time.RFC3339),
+			EndAt:         s.EndAt.Format(time.RFC3339),
+		})
+	}
+
+	writeSuccess(w, http.StatusOK, map[string]interface{}{
+		"shifts": resp,
+		"count":  len(resp),
+	})
+}
+
+// ListOpenSlots handles GET /api/v1/bot/open-slots?days=14
+func (h *BotHandler) ListOpenSlots(w http.ResponseWriter, r *http.Request) {
+	tenantID, memberID, ok := botMember(w, r)
+	if !ok {
+		return
+	}
+	days, ok := botDaysParam(w, r)
+	if !ok {
+		return
+	}
+
+	slots, err := h.listOpenSlotsUC.Execute(r.Context(), appshift.ListOpenSlotsInput{
+		TenantID: tenantID,
+		MemberID: memberID,
+		Days:     days,
+	})
+	if err != nil {
+		log.Printf("Bot ListOpenSlots error: %+v", err)
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	resp := make([]BotOpenSlotResponse, 0, len(slots))
+	for _, s := range slots {
+		resp = append(resp, BotOpenSlotResponse{
+			SlotID:        s.Slot.SlotID().String(),
+			SlotName:      s.Slot.SlotName(),
+			EventID:       s.BusinessDay.EventID().String(),
+			EventName:     s.EventName,
+			BusinessDayID: s.BusinessDay.BusinessDayID().String(),
+			TargetDate:    s.BusinessDay.TargetDate().Format("2006-01-02"),
+			StartAt:       s.StartAt.Format(time.RFC3339),
+			EndAt:         s.EndAt.Format(time.RFC3339),
+			RequiredCount: s.Slot.RequiredCount(),
+			AssignedCount: s.Assigned,
+		})
+	}
+
+	writeSuccess(w, http.StatusOK, map[string]interface{}{
+		"slots": resp,
+		"count": len(resp),
+	})
+}
+
+// ClaimOpenSlot handles POST /api/v1/bot/open-slots/{slot_id}/claim
+func (h *BotHandler) ClaimOpenSlot(w http.ResponseWriter, r *http.Request) {
+	tenantID, memberID, ok := botMember(w, r)
+	if !ok {
+		return
+	}
+
+	slotID, err := shift.ParseSlotID(chi.URLParam(r, "slot_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid slot_id format", nil)
+		return
+	}
+
+	// ボディは省略可能
+	var req BotClaimSlotRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body", nil)
+			return
+		}
+	}
+
+	result, err := h.claimOpenSlotUC.Execute(r.Context(), appshift.ClaimOpenSlotInput{
+		TenantID: tenantID,
+		SlotID:   slotID,
+		MemberID: memberID,
+		Note:     req.Note,
+	})
+	if err != nil {
+		log.Printf("Bot ClaimOpenSlot error: %+v", err)
+		respondConfirmAssignmentError(w, err)
+		return
+	}
+
+	assignment := result.Assignment
+	writeSuccess(w, http.StatusCreated, map[string]interface{}{
+		"assignment_id": assignment.AssignmentID().String(),
+		"slot_id":       assignment.SlotID().String(),
+		"member_id":     assignment.MemberID().String(),
+		"assigned_at":   assignment.AssignedAt().Format(time.RFC3339),
+		"warnings":      toAssignmentWarningResponses(result.Warnings),
+	})
+}
+
+// ListOpenCollections handles GET /api/v1/bot/attendance/collections
+func (h *BotHandler) ListOpenCollections(w http.ResponseWriter, r *http.Request) {
+	tenantID, memberID, ok := botMember(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.listOpenCollectionsUC.Execute(r.Context(), appattendance.ListMemberOpenCollectionsInput{
+		TenantID: tenantID.String(),
+		MemberID: memberID.String(),
+	})
+	if err != nil {
+		log.Printf("Bot ListOpenCollections error: %+v", err)
+		RespondDomainError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, output)
+}
+
+// SubmitCollectionResponse handles POST /api/v1/bot/attendance/collections/{collection_id}/responses
+func (h *BotHandler) SubmitCollectionResponse(w http.ResponseWriter, r *http.Request) {
+	tenantID, memberID, ok := botMember(w, r)
+	if !ok {
+		return
+	}
+
+	var req BotSubmitResponseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondBadRequest(w, "Invalid request body")
+		return
+	}
+	if req.TargetDateID == "" {
+		RespondBadRequest(w, "対象日を選択してください")
+		return
+	}
+	if req.Response == "" {
+		RespondBadRequest(w, "回答を選択してください")
+		return
+	}
+
+	output, err := h.submitCollectionRespUC.Execute(r.Context(), appattendance.SubmitMemberResponseInput{
+		TenantID:      tenantID.String(),
+		CollectionID:  chi.URLParam(r, "collection_id"),
+		MemberID:      memberID.String(),
+		TargetDateID:  req.TargetDateID,
+		Response:      req.Response,
+		Note:          req.Note,
+		AvailableFrom: req.AvailableFrom,
+		AvailableTo:   req.AvailableTo,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, appattendance.ErrCollectionNotFound):
+			RespondNotFound(w, "出欠確認が見つかりません")
+		case errors.Is(err, appattendance.ErrMemberNotAllowed):
+			RespondForbidden(w, "この出欠確認の回答対象ではありません")
+		case errors.Is(err, attendance.ErrCollectionClosed):
+			RespondConflict(w, "この出欠確認は締め切られています")
+		case errors.Is(err, attendance.ErrDeadlinePassed):
+			RespondConflict(w, "回答期限が過ぎています")
+		default:
+			RespondDomainError(w, err)
+		}
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, output)
+}
+
+// ListOpenSchedules handles GET /api/v1/bot/schedules
+func (h *BotHandler) ListOpenSchedules(w http.ResponseWriter, r *http.Request) {
+	tenantID, memberID, ok := botMember(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.listOpenSchedulesUC.Execute(r.Context(), appschedule.ListMemberOpenSchedulesInput{
+		TenantID: tenantID.String(),
+		MemberID: memberID.String(),
+	})
+	if err != nil {
+		log.Printf("Bot ListOpenSchedules error: %+v", err)
+		RespondDomainError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, output)
+}
+
+// botMember returns the tenant and member set by BotAuth
+func botMember(w http.ResponseWriter, r *http.Request) (common.TenantID, common.MemberID, bool) {
+	tenantID, ok := getTenantIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return "", "", false
+	}
+	memberID, ok := getMemberIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Member ID is required", nil)
+		return "", "", false
+	}
+	return tenantID, memberID, true
+}
+
+// botDaysParam parses the optional days query parameter (省略時は 0 = 既定の日数)
+func botDaysParam(w http.ResponseWriter, r *http.Request) (int, bool) {
+	daysStr := r.URL.Query().Get("days")
+	if daysStr == "" {
+		return 0, true
+	}
+	days, err := strconv.Atoi(daysStr)
+	if err != nil || days <= 0 {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "days must be a positive integer", nil)
+		return 0, false
+	}
+	return days, true
+}
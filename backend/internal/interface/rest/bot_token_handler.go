@@ -0,0 +1,143 @@
+package rest
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	apptenant "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/tenant"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
+	"github.com/go-chi/chi/v5"
+)
+
+// BotTokenHandler handles bot token management HTTP requests
+type BotTokenHandler struct {
+	issueTokenUC  *apptenant.IssueBotTokenUsecase
+	listTokensUC  *apptenant.ListBotTokensUsecase
+	revokeTokenUC *apptenant.RevokeBotTokenUsecase
+}
+
+// NewBotTokenHandler creates a new BotTokenHandler with injected usecases
+func NewBotTokenHandler(
+	issueTokenUC *apptenant.IssueBotTokenUsecase,
+	listTokensUC *apptenant.ListBotTokensUsecase,
+	revokeTokenUC *apptenant.RevokeBotTokenUsecase,
+) *BotTokenHandler {
+	return &BotTokenHandler{
+		issueTokenUC:  issueTokenUC,
+		listTokensUC:  listTokensUC,
+		revokeTokenUC: revokeTokenUC,
+	}
+}
+
+// IssueBotTokenRequest represents the request body for issuing a bot token
+type IssueBotTokenRequest struct {
+	Name string `json:"name"`
+}
+
+// BotTokenResponse represents a bot token in API responses (トークン本体は含まない)
+type BotTokenResponse struct {
+	TokenID     string  `json:"token_id"`
+	Name        string  `json:"name"`
+	TokenPrefix string  `json:"token_prefix"`
+	CreatedAt   string  `json:"created_at"`
+	RevokedAt   *string `json:"revoked_at,omitempty"`
+}
+
+// IssueBotToken handles POST /api/v1/settings/bot-tokens
+// token は発行時のレスポンスでのみ返す
+func (h *BotTokenHandler) IssueBotToken(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := getTenantIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	var req IssueBotTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body", nil)
+		return
+	}
+
+	output, err := h.issueTokenUC.Execute(r.Context(), apptenant.IssueBotTokenInput{
+		TenantID: tenantID,
+		Name:     req.Name,
+	})
+	if err != nil {
+		log.Printf("IssueBotToken error: %+v", err)
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, map[string]interface{}{
+		"bot_token": toBotTokenResponse(output.BotToken),
+		"token":     output.Token,
+	})
+}
+
+// ListBotTokens handles GET /api/v1/settings/bot-tokens
+func (h *BotTokenHandler) ListBotTokens(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := getTenantIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	tokens, err := h.listTokensUC.Execute(r.Context(), tenantID)
+	if err != nil {
+		log.Printf("ListBotTokens error: %+v", err)
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	resp := make([]BotTokenResponse, 0, len(tokens))
+	for _, t := range tokens {
+		resp = append(resp, toBotTokenResponse(t))
+	}
+
+	writeSuccess(w, http.StatusOK, map[string]interface{}{
+		"bot_tokens": resp,
+	})
+}
+
+// RevokeBotToken handles DELETE /api/v1/settings/bot-tokens/{token_id}
+func (h *BotTokenHandler) RevokeBotToken(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := getTenantIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	tokenID, err := tenant.ParseBotTokenID(chi.URLParam(r, "token_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid token_id format", nil)
+		return
+	}
+
+	token, err := h.revokeTokenUC.Execute(r.Context(), apptenant.RevokeBotTokenInput{
+		TenantID: tenantID,
+		TokenID:  tokenID,
+	})
+	if err != nil {
+		log.Printf("RevokeBotToken error: %+v", err)
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, toBotTokenResponse(token))
+}
+
+func toBotTokenResponse(token *tenant.BotToken) BotTokenResponse {
+	resp := BotTokenResponse{
+		TokenID:     token.TokenID().String(),
+		Name:        token.Name(),
+		TokenPrefix: token.TokenPrefix(),
+		CreatedAt:   token.CreatedAt().Format(time.RFC3339),
+	}
+	if token.RevokedAt() != nil {
+		revokedAt := token.RevokedAt().Format(time.RFC3339)
+		resp.RevokedAt = &revokedAt
+	}
+	return resp
+}
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"time"
@@ -18,6 +19,10 @@ type BusinessDayHandler struct {
 	getBusinessDayUC    *appevent.GetBusinessDayUsecase
 	applyTemplateUC     *appevent.ApplyTemplateUsecase
 	deleteBusinessDayUC *appevent.DeleteBusinessDayUsecase
+	bulkUpdateUC        *appevent.BulkUpdateBusinessDaysUsecase
+	cancelUC            *appevent.CancelBusinessDayUsecase
+	uncancelUC          *appevent.UncancelBusinessDayUsecase
+	syncTemplateUC      *appevent.SyncTemplateToBusinessDaysUsecase
 }
 
 // NewBusinessDayHandler creates a new BusinessDayHandler with injected usecases
@@ -27,6 +32,10 @@ func NewBusinessDayHandler(
 	getBusinessDayUC *appevent.GetBusinessDayUsecase,
 	applyTemplateUC *appevent.ApplyTemplateUsecase,
 	deleteBusinessDayUC *appevent.DeleteBusinessDayUsecase,
+	bulkUpdateUC *appevent.BulkUpdateBusinessDaysUsecase,
+	cancelUC *appevent.CancelBusinessDayUsecase,
+	uncancelUC *appevent.UncancelBusinessDayUsecase,
+	syncTemplateUC *appevent.SyncTemplateToBusinessDaysUsecase,
 ) *BusinessDayHandler {
 	return &BusinessDayHandler{
 		createBusinessDayUC: createBusinessDayUC,
@@ -34,6 +43,10 @@ func NewBusinessDayHandler(
 		getBusinessDayUC:    getBusinessDayUC,
 		applyTemplateUC:     applyTemplateUC,
 		deleteBusinessDayUC: deleteBusinessDayUC,
+		bulkUpdateUC:        bulkUpdateUC,
+		cancelUC:            cancelUC,
+		uncancelUC:          uncancelUC,
+		syncTemplateUC:      syncTemplateUC,
 	}
 }
 
@@ -43,6 +56,7 @@ type CreateBusinessDayRequest struct {
 	StartTime      string  `json:"start_time"`      // HH:MM
 	EndTime        string  `json:"end_time"`        // HH:MM
 	OccurrenceType string  `json:"occurrence_type"` // recurring or special
+	EndDate        *string `json:"end_date"`        // optional: 複数日営業の最終日 YYYY-MM-DD
 	TemplateID     *string `json:"template_id"`     // optional: テンプレートからシフト枠を作成
 }
 
@@ -56,8 +70,21 @@ type BusinessDayResponse struct {
 	EndTime        string `json:"end_time"`    // HH:MM:SS
 	OccurrenceType string `json:"occurrence_type"`
 	IsActive       bool   `json:"is_active"`
-	CreatedAt      string `json:"created_at"`
-	UpdatedAt      string `json:"updated_at"`
+	// 実際の開始・終了日時（深夜営業は翌日、複数日営業は最終日に終了）
+	EndDate         string `json:"end_date"`  // YYYY-MM-DD
+	StartsAt        string `json:"starts_at"` // RFC3339（テナントのタイムゾーン）
+	EndsAt          string `json:"ends_at"`   // RFC3339（テナントのタイムゾーン）
+	DurationMinutes int    `json:"duration_minutes"`
+	// 中止状態（中止されていない場合 cancelled_at / cancelled_by_admin_id は null）
+	IsCancelled        bool    `json:"is_cancelled"`
+	CancelledAt        *string `json:"cancelled_at"`
+	CancelledByAdminID *string `json:"cancelled_by_admin_id"`
+	CancellationReason string  `json:"cancellation_reason"`
+	CreatedAt          string  `json:"created_at"`
+	UpdatedAt          string  `json:"updated_at"`
+	// シフト枠の生成元テンプレートとそのバージョン（未適用の場合は null）
+	AppliedTemplateID      *string `json:"applied_template_id"`
+	AppliedTemplateVersion *int    `json:"applied_template_version"`
 }
 
 // CreateBusinessDay handles POST /api/v1/events/:event_id/business-days
@@ -116,6 +143,16 @@ func (h *BusinessDayHandler) CreateBusinessDay(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	var endDate *time.Time
+	if req.EndDate != nil && *req.EndDate != "" {
+		parsed, err := time.Parse("2006-01-02", *req.EndDate)
+		if err != nil {
+			RespondBadRequest(w, "Invalid end_date format (expected YYYY-MM-DD)")
+			return
+		}
+		endDate = &parsed
+	}
+
 	// テンプレートIDのパース
 	var templateID *common.ShiftSlotTemplateID
 	if req.TemplateID != nil && *req.TemplateID != "" {
@@ -134,6 +171,7 @@ func (h *BusinessDayHandler) CreateBusinessDay(w http.ResponseWriter, r *http.Re
 		TargetDate:     targetDate,
 		StartTime:      startTime,
 		EndTime:        endTime,
+		EndDate:        endDate,
 		OccurrenceType: event.OccurrenceTypeSpecial, // 手動作成は常にspecial
 		TemplateID:     templateID,
 	}
@@ -145,7 +183,7 @@ func (h *BusinessDayHandler) CreateBusinessDay(w http.ResponseWriter, r *http.Re
 	}
 
 	// レスポンス
-	RespondCreated(w, toBusinessDayResponse(newBusinessDay))
+	RespondCreated(w, toBusinessDayResponse(newBusinessDay, GetTenantLocation(ctx)))
 }
 
 // ListBusinessDays handles GET /api/v1/events/:event_id/business-days
@@ -209,9 +247,10 @@ func (h *BusinessDayHandler) ListBusinessDays(w http.ResponseWriter, r *http.Req
 	}
 
 	// レスポンス
+	loc := GetTenantLocation(ctx)
 	var businessDayResponses []BusinessDayResponse
 	for _, bd := range businessDays {
-		businessDayResponses = append(businessDayResponses, toBusinessDayResponse(bd))
+		businessDayResponses = append(businessDayResponses, toBusinessDayResponse(bd, loc))
 	}
 
 	RespondSuccess(w, map[string]interface{}{
@@ -257,23 +296,45 @@ func (h *BusinessDayHandler) GetBusinessDay(w http.ResponseWriter, r *http.Reque
 	}
 
 	// レスポンス
-	RespondSuccess(w, toBusinessDayResponse(foundBusinessDay))
+	RespondSuccess(w, toBusinessDayResponse(foundBusinessDay, GetTenantLocation(ctx)))
 }
 
 // toBusinessDayResponse converts an EventBusinessDay entity to BusinessDayResponse
-func toBusinessDayResponse(bd *event.EventBusinessDay) BusinessDayResponse {
-	return BusinessDayResponse{
-		BusinessDayID:  bd.BusinessDayID().String(),
-		TenantID:       bd.TenantID().String(),
-		EventID:        bd.EventID().String(),
-		TargetDate:     bd.TargetDate().Format("2006-01-02"),
-		StartTime:      bd.StartTime().Format("15:04:05"),
-		EndTime:        bd.EndTime().Format("15:04:05"),
-		OccurrenceType: string(bd.OccurrenceType()),
-		IsActive:       bd.IsActive(),
-		CreatedAt:      bd.CreatedAt().Format(time.RFC3339),
-		UpdatedAt:      bd.UpdatedAt().Format(time.RFC3339),
+// starts_at / ends_at は loc（テナントのタイムゾーン）での実際の日時を返す
+func toBusinessDayResponse(bd *event.EventBusinessDay, loc *time.Location) BusinessDayResponse {
+	resp := BusinessDayResponse{
+		BusinessDayID:   bd.BusinessDayID().String(),
+		TenantID:        bd.TenantID().String(),
+		EventID:         bd.EventID().String(),
+		TargetDate:      bd.TargetDate().Format("2006-01-02"),
+		StartTime:       bd.StartTime().Format("15:04:05"),
+		EndTime:         bd.EndTime().Format("15:04:05"),
+		EndDate:         bd.EndDate().Format("2006-01-02"),
+		StartsAt:        bd.StartAt(loc).Format(time.RFC3339),
+		EndsAt:          bd.EndAt(loc).Format(time.RFC3339),
+		DurationMinutes: int(bd.Duration().Minutes()),
+		OccurrenceType:  string(bd.OccurrenceType()),
+		IsActive:        bd.IsActive(),
+		IsCancelled:     bd.IsCancelled(),
+		CreatedAt:       bd.CreatedAt().Format(time.RFC3339),
+		UpdatedAt:       bd.UpdatedAt().Format(time.RFC3339),
+	}
+	if c := bd.Cancellation(); c != nil {
+		cancelledAt := c.CancelledAt.Format(time.RFC3339)
+		resp.CancelledAt = &cancelledAt
+		if c.CancelledBy != nil {
+			adminID := c.CancelledBy.String()
+			resp.CancelledByAdminID = &adminID
+		}
+		resp.CancellationReason = c.Reason
 	}
+	if at := bd.AppliedTemplate(); at != nil {
+		templateID := at.TemplateID.String()
+		version := at.Version
+		resp.AppliedTemplateID = &templateID
+		resp.AppliedTemplateVersion = &version
+	}
+	return resp
 }
 
 // ApplyTemplateRequest represents the request body for applying a template to a business day
@@ -384,3 +445,358 @@ func (h *BusinessDayHandler) DeleteBusinessDay(w http.ResponseWriter, r *http.Re
 	// 成功レスポンス（204 No Content）
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// BulkUpdateBusinessDaysRequest represents the request body for changing business days at once
+// 対象は business_day_ids または start_date〜end_date のどちらかで指定する
+type BulkUpdateBusinessDaysRequest struct {
+	BusinessDayIDs    []string `json:"business_day_ids"`
+	StartDate         string   `json:"start_date"` // YYYY-MM-DD
+	EndDate           string   `json:"end_date"`   // YYYY-MM-DD
+	Operation         string   `json:"operation"`  // shift_time, move, cancel
+	StartShiftMinutes int      `json:"start_shift_minutes"`
+	EndShiftMinutes   int      `json:"end_shift_minutes"`
+	MoveDays          int      `json:"move_days"`
+	CancelReason      string   `json:"cancel_reason"`
+	DryRun            bool     `json:"dry_run"`
+}
+
+// BulkUpdatedBusinessDayResponse represents a changed business day in the bulk update response
+type BulkUpdatedBusinessDayResponse struct {
+	BusinessDayResponse
+	PreviousTargetDate string `json:"previous_target_date"` // YYYY-MM-DD
+	PreviousStartTime  string `json:"previous_start_time"`  // HH:MM:SS
+	PreviousEndTime    string `json:"previous_end_time"`    // HH:MM:SS
+	SlotCount          int    `json:"slot_count"`
+}
+
+// AffectedMemberResponse represents a member assigned to a changed business day
+type AffectedMemberResponse struct {
+	MemberID      string `json:"member_id"`
+	DisplayName   string `json:"display_name"`
+	BusinessDayID string `json:"business_day_id"`
+	SlotID        string `json:"slot_id"`
+	SlotName      string `json:"slot_name"`
+}
+
+// BulkUpdateBusinessDays handles POST /api/v1/events/:event_id/business-days/bulk
+func (h *BusinessDayHandler) BulkUpdateBusinessDays(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// テナントIDの取得
+	tenantID, ok := GetTenantID(ctx)
+	if !ok {
+		RespondBadRequest(w, "tenant_id is required")
+		return
+	}
+
+	// イベントIDの取得
+	eventID := common.EventID(chi.URLParam(r, "event_id"))
+	if err := eventID.Validate(); err != nil {
+		RespondBadRequest(w, "Invalid event_id format")
+		return
+	}
+
+	// リクエストボディのパース
+	var req BulkUpdateBusinessDaysRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondBadRequest(w, "Invalid request body")
+		return
+	}
+
+	businessDayIDs := make([]event.BusinessDayID, 0, len(req.BusinessDayIDs))
+	for _, idStr := range req.BusinessDayIDs {
+		businessDayID := event.BusinessDayID(idStr)
+		if err := businessDayID.Validate(); err != nil {
+			RespondBadRequest(w, "Invalid business_day_id format")
+			return
+		}
+		businessDayIDs = append(businessDayIDs, businessDayID)
+	}
+
+	var startDate, endDate *time.Time
+	if req.StartDate != "" {
+		parsed, err := time.Parse("2006-01-02", req.StartDate)
+		if err != nil {
+			RespondBadRequest(w, "Invalid start_date format (expected YYYY-MM-DD)")
+			return
+		}
+		startDate = &parsed
+	}
+	if req.EndDate != "" {
+		parsed, err := time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			RespondBadRequest(w, "Invalid end_date format (expected YYYY-MM-DD)")
+			return
+		}
+		endDate = &parsed
+	}
+
+	// Usecaseの実行
+	input := appevent.BulkUpdateBusinessDaysInput{
+		TenantID:       tenantID,
+		EventID:        eventID,
+		BusinessDayIDs: businessDayIDs,
+		StartDate:      startDate,
+		EndDate:        endDate,
+		Operation:      appevent.BulkBusinessDayOperation(req.Operation),
+		StartShift:     time.Duration(req.StartShiftMinutes) * time.Minute,
+		EndShift:       time.Duration(req.EndShiftMinutes) * time.Minute,
+		MoveDays:       req.MoveDays,
+		CancelReason:   req.CancelReason,
+		CancelledBy:    adminIDFromContext(ctx),
+		DryRun:         req.DryRun,
+	}
+
+	output, err := h.bulkUpdateUC.Execute(ctx, input)
+	if err != nil {
+		RespondDomainError(w, err)
+		return
+	}
+
+	// レスポンス
+	businessDays := make([]BulkUpdatedBusinessDayResponse, 0, len(output.BusinessDays))
+	for _, updated := range output.BusinessDays {
+		businessDays = append(businessDays, BulkUpdatedBusinessDayResponse{
+			BusinessDayResponse: toBusinessDayResponse(updated.BusinessDay, GetTenantLocation(ctx)),
+			PreviousTargetDate:  updated.PreviousDate.Format("2006-01-02"),
+			PreviousStartTime:   updated.PreviousStartTime.Format("15:04:05"),
+			PreviousEndTime:     updated.PreviousEndTime.Format("15:04:05"),
+			SlotCount:           updated.SlotCount,
+		})
+	}
+
+	affectedMembers := make([]AffectedMemberResponse, 0, len(output.AffectedMembers))
+	for _, m := range output.AffectedMembers {
+		affectedMembers = append(affectedMembers, AffectedMemberResponse{
+			MemberID:      m.MemberID.String(),
+			DisplayName:   m.DisplayName,
+			BusinessDayID: m.BusinessDayID.String(),
+			SlotID:        m.SlotID.String(),
+			SlotName:      m.SlotName,
+		})
+	}
+
+	RespondSuccess(w, map[string]interface{}{
+		"business_days":    businessDays,
+		"affected_members": affectedMembers,
+		"count":            len(businessDays),
+		"dry_run":          req.DryRun,
+	})
+}
+
+// SyncTemplateRequest represents the request body for re-applying a template to future business days
+type SyncTemplateRequest struct {
+	DryRun bool `json:"dry_run"`
+}
+
+// TemplateSlotDefinitionResponse represents the definition of a shift slot before or after the change
+type TemplateSlotDefinitionResponse struct {
+	StartTime        string                    `json:"start_time"` // HH:MM:SS
+	EndTime          string                    `json:"end_time"`   // HH:MM:SS
+	DayOffset        int                       `json:"day_offset"`
+	RequiredCount    int                       `json:"required_count"`
+	Priority         int                       `json:"priority"`
+	RoleRequirements []RoleRequirementResponse `json:"role_requirements"`
+}
+
+// TemplateSyncSlotChangeResponse represents a change to a shift slot in the template sync response
+type TemplateSyncSlotChangeResponse struct {
+	Type          string                          `json:"type"`    // add, update, remove
+	SlotID        *string                         `json:"slot_id"` // add の dry_run では null
+	SlotName      string                          `json:"slot_name"`
+	InstanceName  string                          `json:"instance_name"`
+	Before        *TemplateSlotDefinitionResponse `json:"before"`         // add の場合は null
+	After         *TemplateSlotDefinitionResponse `json:"after"`          // remove の場合は null
+	AssignedCount int                             `json:"assigned_count"` // 変更前の枠の確定済み割り当て数
+	Skipped       bool                            `json:"skipped"`        // 割り当てがあるため削除・更新しなかった枠
+}
+
+// TemplateSyncBusinessDayResponse represents a business day in the template sync response
+type TemplateSyncBusinessDayResponse struct {
+	BusinessDayResponse
+	PreviousTemplateVersion int                              `json:"previous_template_version"`
+	Changes                 []TemplateSyncSlotChangeResponse `json:"changes"`
+}
+
+// SyncTemplateToBusinessDays handles POST /api/v1/events/:event_id/templates/:template_id/apply-to-future-days
+// テンプレートの古いバージョンから生成した将来の営業日に最新のテンプレートを反映する（dry_run で差分のプレビュー）
+func (h *BusinessDayHandler) SyncTemplateToBusinessDays(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// テナントIDの取得
+	tenantID, ok := GetTenantID(ctx)
+	if !ok {
+		RespondBadRequest(w, "tenant_id is required")
+		return
+	}
+
+	// イベントID・テンプレートIDの取得
+	eventID := common.EventID(chi.URLParam(r, "event_id"))
+	if err := eventID.Validate(); err != nil {
+		RespondBadRequest(w, "Invalid event_id format")
+		return
+	}
+	templateID := common.ShiftSlotTemplateID(chi.URLParam(r, "template_id"))
+	if err := templateID.Validate(); err != nil {
+		RespondBadRequest(w, "Invalid template_id format")
+		return
+	}
+
+	// リクエストボディのパース
+	var req SyncTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondBadRequest(w, "Invalid request body")
+		return
+	}
+
+	output, err := h.syncTemplateUC.Execute(ctx, appevent.SyncTemplateToBusinessDaysInput{
+		TenantID:   tenantID,
+		EventID:    eventID,
+		TemplateID: templateID,
+		DryRun:     req.DryRun,
+	})
+	if err != nil {
+		RespondDomainError(w, err)
+		return
+	}
+
+	// レスポンス
+	loc := GetTenantLocation(ctx)
+	businessDays := make([]TemplateSyncBusinessDayResponse, 0, len(output.BusinessDays))
+	changeCount := 0
+	for _, synced := range output.BusinessDays {
+		changes := make([]TemplateSyncSlotChangeResponse, 0, len(synced.Changes))
+		for _, c := range synced.Changes {
+			resp := TemplateSyncSlotChangeResponse{
+				Type:          string(c.Type),
+				SlotName:      c.SlotName,
+				InstanceName:  c.InstanceName,
+				Before:        toTemplateSlotDefinitionResponse(c.Before),
+				After:         toTemplateSlotDefinitionResponse(c.After),
+				AssignedCount: c.AssignedCount,
+				Skipped:       c.Skipped,
+			}
+			if c.SlotID != "" {
+				slotID := c.SlotID.String()
+				resp.SlotID = &slotID
+			}
+			changes = append(changes, resp)
+		}
+		changeCount += len(changes)
+
+		businessDays = append(businessDays, TemplateSyncBusinessDayResponse{
+			BusinessDayResponse:     toBusinessDayResponse(synced.BusinessDay, loc),
+			PreviousTemplateVersion: synced.PreviousVersion,
+			Changes:                 changes,
+		})
+	}
+
+	RespondSuccess(w, map[string]interface{}{
+		"template_id":   output.TemplateID.String(),
+		"version":       output.Version,
+		"business_days": businessDays,
+		"count":         len(businessDays),
+		"change_count":  changeCount,
+		"dry_run":       req.DryRun,
+	})
+}
+
+// toTemplateSlotDefinitionResponse converts a slot definition to its response (nil stays nil)
+func toTemplateSlotDefinitionResponse(def *appevent.TemplateSlotDefinition) *TemplateSlotDefinitionResponse {
+	if def == nil {
+		return nil
+	}
+	return &TemplateSlotDefinitionResponse{
+		StartTime:        def.StartTime.Format("15:04:05"),
+		EndTime:          def.EndTime.Format("15:04:05"),
+		DayOffset:        def.DayOffset,
+		RequiredCount:    def.RequiredCount,
+		Priority:         def.Priority,
+		RoleRequirements: toRoleRequirementResponses(def.RoleRequirements),
+	}
+}
+
+// CancelBusinessDayRequest represents the request body for cancelling a business day
+type CancelBusinessDayRequest struct {
+	Reason string `json:"reason"`
+}
+
+// CancelBusinessDay handles POST /api/v1/business-days/:business_day_id/cancel
+func (h *BusinessDayHandler) CancelBusinessDay(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// テナントIDの取得
+	tenantID, ok := GetTenantID(ctx)
+	if !ok {
+		RespondBadRequest(w, "tenant_id is required")
+		return
+	}
+
+	// BusinessDayIDの取得
+	businessDayID := event.BusinessDayID(chi.URLParam(r, "business_day_id"))
+	if err := businessDayID.Validate(); err != nil {
+		RespondBadRequest(w, "Invalid business_day_id format")
+		return
+	}
+
+	// リクエストボディのパース
+	var req CancelBusinessDayRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondBadRequest(w, "Invalid request body")
+		return
+	}
+
+	// Usecaseの実行
+	bd, err := h.cancelUC.Execute(ctx, appevent.CancelBusinessDayInput{
+		TenantID:      tenantID,
+		BusinessDayID: businessDayID,
+		Reason:        req.Reason,
+		CancelledBy:   adminIDFromContext(ctx),
+	})
+	if err != nil {
+		RespondDomainError(w, err)
+		return
+	}
+
+	RespondSuccess(w, toBusinessDayResponse(bd, GetTenantLocation(ctx)))
+}
+
+// UncancelBusinessDay handles DELETE /api/v1/business-days/:business_day_id/cancel
+func (h *BusinessDayHandler) UncancelBusinessDay(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// テナントIDの取得
+	tenantID, ok := GetTenantID(ctx)
+	if !ok {
+		RespondBadRequest(w, "tenant_id is required")
+		return
+	}
+
+	// BusinessDayIDの取得
+	businessDayID := event.BusinessDayID(chi.URLParam(r, "business_day_id"))
+	if err := businessDayID.Validate(); err != nil {
+		RespondBadRequest(w, "Invalid business_day_id format")
+		return
+	}
+
+	// Usecaseの実行
+	bd, err := h.uncancelUC.Execute(ctx, appevent.UncancelBusinessDayInput{
+		TenantID:      tenantID,
+		BusinessDayID: businessDayID,
+	})
+	if err != nil {
+		RespondDomainError(w, err)
+		return
+	}
+
+	RespondSuccess(w, toBusinessDayResponse(bd, GetTenantLocation(ctx)))
+}
+
+// adminIDFromContext returns the authenticated admin ID, or nil if the request is not made by an admin
+func adminIDFromContext(ctx context.Context) *common.AdminID {
+	adminID, ok := GetAdminIDFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return &adminID
+}
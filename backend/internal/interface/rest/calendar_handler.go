@@ -84,9 +84,11 @@ type PublicEventResponse struct {
 
 // PublicBusinessDayResponse represents a business day in public calendar
 type PublicBusinessDayResponse struct {
-	Date      string `json:"date"`
-	StartTime string `json:"start_time"`
-	EndTime   string `json:"end_time"`
+	Date        string `json:"date"`
+	StartTime   string `json:"start_time"`
+	EndTime     string `json:"end_time"`
+	EndDate     string `json:"end_date"`     // 深夜営業は翌日、複数日営業は最終日
+	IsCancelled bool   `json:"is_cancelled"` // 中止された営業日
 }
 
 // Create handles POST /api/v1/calendars
@@ -336,9 +338,11 @@ func (h *CalendarHandler) GetByPublicToken(w http.ResponseWriter, r *http.Reques
 		var businessDays []PublicBusinessDayResponse
 		for _, bd := range evt.BusinessDays {
 			businessDays = append(businessDays, PublicBusinessDayResponse{
-				Date:      bd.Date.Format("2006-01-02"),
-				StartTime: bd.StartTime,
-				EndTime:   bd.EndTime,
+				Date:        bd.Date.Format("2006-01-02"),
+				StartTime:   bd.StartTime,
+				EndTime:     bd.EndTime,
+				EndDate:     bd.EndDate.Format("2006-01-02"),
+				IsCancelled: bd.IsCancelled,
 			})
 		}
 		events = append(events, PublicEventResponse{
@@ -0,0 +1,211 @@
+package rest
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	appnotification "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/notification"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/notification"
+	"github.com/go-chi/chi/v5"
+)
+
+// DiscordWebhookHandler handles Discord webhook setting HTTP requests
+type DiscordWebhookHandler struct {
+	getWebhookUC    *appnotification.GetDiscordWebhookUsecase
+	putWebhookUC    *appnotification.PutDiscordWebhookUsecase
+	deleteWebhookUC *appnotification.DeleteDiscordWebhookUsecase
+}
+
+// NewDiscordWebhookHandler creates a new DiscordWebhookHandler with injected usecases
+func NewDiscordWebhookHandler(
+	getWebhookUC *appnotification.GetDiscordWebhookUsecase,
+	putWebhookUC *appnotification.PutDiscordWebhookUsecase,
+	deleteWebhookUC *appnotification.DeleteDiscordWebhookUsecase,
+) *DiscordWebhookHandler {
+	return &DiscordWebhookHandler{
+		getWebhookUC:    getWebhookUC,
+		putWebhookUC:    putWebhookUC,
+		deleteWebhookUC: deleteWebhookUC,
+	}
+}
+
+// DiscordWebhookRequest represents the request body for setting a Discord webhook
+type DiscordWebhookRequest struct {
+	WebhookURL string `json:"webhook_url"`
+	Enabled    *bool  `json:"enabled"` // 省略時は true（イベント個別設定で false にするとそのイベントの投稿を止める）
+}
+
+// DiscordWebhookResponse represents a Discord webhook setting in API responses
+type DiscordWebhookResponse struct {
+	WebhookID  string  `json:"webhook_id"`
+	EventID    *string `json:"event_id"`
+	WebhookURL string  `json:"webhook_url"` // トークンを伏せた URL（Webhook ID まで）
+	Enabled    bool    `json:"enabled"`
+	CreatedAt  string  `json:"created_at"`
+	UpdatedAt  string  `json:"updated_at"`
+}
+
+// GetTenantWebhook handles GET /api/v1/settings/discord-webhook
+func (h *DiscordWebhookHandler) GetTenantWebhook(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := getTenantIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	h.getWebhook(w, r, appnotification.GetDiscordWebhookInput{TenantID: tenantID})
+}
+
+// GetEventWebhook handles GET /api/v1/events/{event_id}/discord-webhook
+// effective_webhook はテナントの既定設定を含めて実際に投稿される先
+func (h *DiscordWebhookHandler) GetEventWebhook(w http.ResponseWriter, r *http.Request) {
+	tenantID, eventID, ok := discordWebhookTarget(w, r)
+	if !ok {
+		return
+	}
+
+	h.getWebhook(w, r, appnotification.GetDiscordWebhookInput{
+		TenantID: tenantID,
+		EventID:  &eventID,
+	})
+}
+
+func (h *DiscordWebhookHandler) getWebhook(w http.ResponseWriter, r *http.Request, input appnotification.GetDiscordWebhookInput) {
+	result, err := h.getWebhookUC.Execute(r.Context(), input)
+	if err != nil {
+		log.Printf("GetDiscordWebhook error: %+v", err)
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, map[string]interface{}{
+		"webhook":           toDiscordWebhookResponsePtr(result.Webhook),
+		"effective_webhook": toDiscordWebhookResponsePtr(result.Effective),
+	})
+}
+
+// PutTenantWebhook handles PUT /api/v1/settings/discord-webhook
+func (h *DiscordWebhookHandler) PutTenantWebhook(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := getTenantIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	h.putWebhook(w, r, tenantID, nil)
+}
+
+// PutEventWebhook handles PUT /api/v1/events/{event_id}/discord-webhook
+func (h *DiscordWebhookHandler) PutEventWebhook(w http.ResponseWriter, r *http.Request) {
+	tenantID, eventID, ok := discordWebhookTarget(w, r)
+	if !ok {
+		return
+	}
+
+	h.putWebhook(w, r, tenantID, &eventID)
+}
+
+func (h *DiscordWebhookHandler) putWebhook(w http.ResponseWriter, r *http.Request, tenantID common.TenantID, eventID *common.EventID) {
+	var req DiscordWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body", nil)
+		return
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	webhook, err := h.putWebhookUC.Execute(r.Context(), appnotification.PutDiscordWebhookInput{
+		TenantID:   tenantID,
+		EventID:    eventID,
+		WebhookURL: req.WebhookURL,
+		Enabled:    enabled,
+	})
+	if err != nil {
+		log.Printf("PutDiscordWebhook error: %+v", err)
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, toDiscordWebhookResponse(webhook))
+}
+
+// DeleteTenantWebhook handles DELETE /api/v1/settings/discord-webhook
+func (h *DiscordWebhookHandler) DeleteTenantWebhook(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := getTenantIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	h.deleteWebhook(w, r, tenantID, nil)
+}
+
+// DeleteEventWebhook handles DELETE /api/v1/events/{event_id}/discord-webhook
+// イベント個別の設定を削除し、テナントの既定設定に戻す
+func (h *DiscordWebhookHandler) DeleteEventWebhook(w http.ResponseWriter, r *http.Request) {
+	tenantID, eventID, ok := discordWebhookTarget(w, r)
+	if !ok {
+		return
+	}
+
+	h.deleteWebhook(w, r, tenantID, &eventID)
+}
+
+func (h *DiscordWebhookHandler) deleteWebhook(w http.ResponseWriter, r *http.Request, tenantID common.TenantID, eventID *common.EventID) {
+	err := h.deleteWebhookUC.Execute(r.Context(), appnotification.DeleteDiscordWebhookInput{
+		TenantID: tenantID,
+		EventID:  eventID,
+	})
+	if err != nil {
+		log.Printf("DeleteDiscordWebhook error: %+v", err)
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func discordWebhookTarget(w http.ResponseWriter, r *http.Request) (common.TenantID, common.EventID, bool) {
+	tenantID, ok := getTenantIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return "", "", false
+	}
+
+	eventID, err := common.ParseEventID(chi.URLParam(r, "event_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid event_id format", nil)
+		return "", "", false
+	}
+
+	return tenantID, eventID, true
+}
+
+func toDiscordWebhookResponse(webhook *notification.DiscordWebhook) DiscordWebhookResponse {
+	resp := DiscordWebhookResponse{
+		WebhookID:  webhook.WebhookID().String(),
+		WebhookURL: webhook.MaskedWebhookURL(),
+		Enabled:    webhook.IsEnabled(),
+		CreatedAt:  webhook.CreatedAt().Format(time.RFC3339),
+		UpdatedAt:  webhook.UpdatedAt().Format(time.RFC3339),
+	}
+	if webhook.EventID() != nil {
+		s := webhook.EventID().String()
+		resp.EventID = &s
+	}
+	return resp
+}
+
+func toDiscordWebhookResponsePtr(webhook *notification.DiscordWebhook) *DiscordWebhookResponse {
+	if webhook == nil {
+		return nil
+	}
+	resp := toDiscordWebhookResponse(webhook)
+	return &resp
+}
@@ -22,6 +22,9 @@ type EventHandler struct {
 	generateBusinessDaysUC   *appevent.GenerateBusinessDaysUsecase
 	getGroupAssignmentsUC    *appevent.GetEventGroupAssignmentsUsecase
 	updateGroupAssignmentsUC *appevent.UpdateEventGroupAssignmentsUsecase
+	cloneEventUC             *appevent.CloneEventUsecase
+	archiveEventUC           *appevent.ArchiveEventUsecase
+	restoreEventUC           *appevent.RestoreEventUsecase
 }
 
 // NewEventHandler creates a new EventHandler with injected usecases
@@ -34,6 +37,9 @@ func NewEventHandler(
 	generateBusinessDaysUC *appevent.GenerateBusinessDaysUsecase,
 	getGroupAssignmentsUC *appevent.GetEventGroupAssignmentsUsecase,
 	updateGroupAssignmentsUC *appevent.UpdateEventGroupAssignmentsUsecase,
+	cloneEventUC *appevent.CloneEventUsecase,
+	archiveEventUC *appevent.ArchiveEventUsecase,
+	restoreEventUC *appevent.RestoreEventUsecase,
 ) *EventHandler {
 	return &EventHandler{
 		createEventUC:            createEventUC,
@@ -44,6 +50,9 @@ func NewEventHandler(
 		generateBusinessDaysUC:   generateBusinessDaysUC,
 		getGroupAssignmentsUC:    getGroupAssignmentsUC,
 		updateGroupAssignmentsUC: updateGroupAssignmentsUC,
+		cloneEventUC:             cloneEventUC,
+		archiveEventUC:           archiveEventUC,
+		restoreEventUC:           restoreEventUC,
 	}
 }
 
@@ -52,16 +61,18 @@ type CreateEventRequest struct {
 	EventName           string  `json:"event_name"`
 	EventType           string  `json:"event_type"`
 	Description         string  `json:"description"`
-	RecurrenceType      string  `json:"recurrence_type,omitempty"`        // "none", "weekly", "biweekly"
+	RecurrenceType      string  `json:"recurrence_type,omitempty"`        // "none", "weekly", "biweekly", "rrule"
 	RecurrenceStartDate *string `json:"recurrence_start_date,omitempty"`  // YYYY-MM-DD
 	RecurrenceDayOfWeek *int    `json:"recurrence_day_of_week,omitempty"` // 0-6
+	RecurrenceRule      string  `json:"recurrence_rule,omitempty"`        // rrule の場合: "FREQ=MONTHLY;BYDAY=-1FR" または DTSTART/RRULE/EXDATE/RDATE の複数行
 	DefaultStartTime    *string `json:"default_start_time,omitempty"`     // HH:MM:SS
 	DefaultEndTime      *string `json:"default_end_time,omitempty"`       // HH:MM:SS
 }
 
 // UpdateEventRequest represents the request body for updating an event
 type UpdateEventRequest struct {
-	EventName string `json:"event_name"`
+	EventName         string  `json:"event_name"`
+	DefaultTemplateID *string `json:"default_template_id,omitempty"` // 空文字で解除
 }
 
 // EventResponse represents an event in API responses
@@ -75,8 +86,12 @@ type EventResponse struct {
 	RecurrenceType      string  `json:"recurrence_type"`
 	RecurrenceStartDate *string `json:"recurrence_start_date,omitempty"`
 	RecurrenceDayOfWeek *int    `json:"recurrence_day_of_week,omitempty"`
+	RecurrenceRule      *string `json:"recurrence_rule,omitempty"`
 	DefaultStartTime    *string `json:"default_start_time,omitempty"`
 	DefaultEndTime      *string `json:"default_end_time,omitempty"`
+	DefaultTemplateID   *string `json:"default_template_id,omitempty"`
+	IsArchived          bool    `json:"is_archived"`
+	ArchivedAt          *string `json:"archived_at,omitempty"`
 	CreatedAt           string  `json:"created_at"`
 	UpdatedAt           string  `json:"updated_at"`
 }
@@ -114,6 +129,10 @@ func (h *EventHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 		RespondBadRequest(w, "description must be 2000 characters or less")
 		return
 	}
+	if len(req.RecurrenceRule) > 2000 {
+		RespondBadRequest(w, "recurrence_rule must be 2000 characters or less")
+		return
+	}
 
 	// EventType のデフォルト値
 	eventType := event.EventTypeNormal
@@ -177,6 +196,7 @@ func (h *EventHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 		RecurrenceType:      recurrenceType,
 		RecurrenceStartDate: recurrenceStartDate,
 		RecurrenceDayOfWeek: recurrenceDayOfWeek,
+		RecurrenceRule:      req.RecurrenceRule,
 		DefaultStartTime:    defaultStartTime,
 		DefaultEndTime:      defaultEndTime,
 	}
@@ -192,6 +212,7 @@ func (h *EventHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 }
 
 // ListEvents handles GET /api/v1/events
+// Query params: status=active|archived|all（省略時は active）
 func (h *EventHandler) ListEvents(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -202,9 +223,16 @@ func (h *EventHandler) ListEvents(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	status, err := appevent.ParseEventStatusFilter(r.URL.Query().Get("status"))
+	if err != nil {
+		RespondBadRequest(w, "status must be one of active, archived, all")
+		return
+	}
+
 	// Usecaseの実行
 	input := appevent.ListEventsInput{
 		TenantID: tenantID,
+		Status:   status,
 	}
 
 	events, err := h.listEventsUC.Execute(ctx, input)
@@ -308,11 +336,24 @@ func (h *EventHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var defaultTemplateID *common.ShiftSlotTemplateID
+	if req.DefaultTemplateID != nil {
+		templateID := common.ShiftSlotTemplateID(*req.DefaultTemplateID)
+		if templateID != "" {
+			if err := templateID.Validate(); err != nil {
+				RespondBadRequest(w, "Invalid default_template_id format")
+				return
+			}
+		}
+		defaultTemplateID = &templateID
+	}
+
 	// Usecaseの実行
 	input := appevent.UpdateEventInput{
-		TenantID:  tenantID,
-		EventID:   eventID,
-		EventName: req.EventName,
+		TenantID:          tenantID,
+		EventID:           eventID,
+		EventName:         req.EventName,
+		DefaultTemplateID: defaultTemplateID,
 	}
 
 	updatedEvent, err := h.updateEventUC.Execute(ctx, input)
@@ -386,6 +427,11 @@ func toEventResponse(e *event.Event) EventResponse {
 
 	resp.RecurrenceDayOfWeek = e.RecurrenceDayOfWeek()
 
+	if e.RecurrenceRule() != nil {
+		rule := e.RecurrenceRule().String()
+		resp.RecurrenceRule = &rule
+	}
+
 	if e.DefaultStartTime() != nil {
 		timeStr := e.DefaultStartTime().Format("15:04:05")
 		resp.DefaultStartTime = &timeStr
@@ -396,9 +442,77 @@ func toEventResponse(e *event.Event) EventResponse {
 		resp.DefaultEndTime = &timeStr
 	}
 
+	if e.DefaultTemplateID() != nil {
+		templateID := e.DefaultTemplateID().String()
+		resp.DefaultTemplateID = &templateID
+	}
+
+	if e.ArchivedAt() != nil {
+		archivedAt := e.ArchivedAt().Format(time.RFC3339)
+		resp.IsArchived = true
+		resp.ArchivedAt = &archivedAt
+	}
+
 	return resp
 }
 
+// ArchiveEvent handles POST /api/v1/events/:event_id/archive
+// アーカイブ済みのイベントは一覧・公開カレンダーに表示されず、営業日の追加・シフトの割り当てができなくなる
+func (h *EventHandler) ArchiveEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := GetTenantID(ctx)
+	if !ok {
+		RespondBadRequest(w, "tenant_id is required")
+		return
+	}
+
+	eventID := common.EventID(chi.URLParam(r, "event_id"))
+	if err := eventID.Validate(); err != nil {
+		RespondBadRequest(w, "Invalid event_id format")
+		return
+	}
+
+	archivedEvent, err := h.archiveEventUC.Execute(ctx, appevent.ArchiveEventInput{
+		TenantID: tenantID,
+		EventID:  eventID,
+	})
+	if err != nil {
+		RespondDomainError(w, err)
+		return
+	}
+
+	RespondSuccess(w, toEventResponse(archivedEvent))
+}
+
+// RestoreEvent handles POST /api/v1/events/:event_id/restore
+func (h *EventHandler) RestoreEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := GetTenantID(ctx)
+	if !ok {
+		RespondBadRequest(w, "tenant_id is required")
+		return
+	}
+
+	eventID := common.EventID(chi.URLParam(r, "event_id"))
+	if err := eventID.Validate(); err != nil {
+		RespondBadRequest(w, "Invalid event_id format")
+		return
+	}
+
+	restoredEvent, err := h.restoreEventUC.Execute(ctx, appevent.RestoreEventInput{
+		TenantID: tenantID,
+		EventID:  eventID,
+	})
+	if err != nil {
+		RespondDomainError(w, err)
+		return
+	}
+
+	RespondSuccess(w, toEventResponse(restoredEvent))
+}
+
 // GenerateBusinessDaysResponse represents the response for generating business days
 type GenerateBusinessDaysResponse struct {
 	GeneratedCount int           `json:"generated_count"`
@@ -481,6 +595,67 @@ func (h *EventHandler) GenerateBusinessDays(w http.ResponseWriter, r *http.Reque
 	})
 }
 
+// CloneEventRequest represents the request body for cloning an event
+type CloneEventRequest struct {
+	EventName           string `json:"event_name"`            // 省略時は「<複製元のイベント名> (コピー)」
+	IncludeBusinessDays bool   `json:"include_business_days"` // 開始前の営業日とシフト枠も複製する（割り当ては複製しない）
+}
+
+// CloneEventResponse represents the response for cloning an event
+type CloneEventResponse struct {
+	Event            EventResponse `json:"event"`
+	InstanceCount    int           `json:"instance_count"`
+	TemplateCount    int           `json:"template_count"`
+	BusinessDayCount int           `json:"business_day_count"`
+	ShiftSlotCount   int           `json:"shift_slot_count"`
+}
+
+// CloneEvent handles POST /api/v1/events/:event_id/clone
+func (h *EventHandler) CloneEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// テナントIDの取得
+	tenantID, ok := GetTenantID(ctx)
+	if !ok {
+		RespondBadRequest(w, "tenant_id is required")
+		return
+	}
+
+	// イベントIDの取得
+	eventID := common.EventID(chi.URLParam(r, "event_id"))
+	if err := eventID.Validate(); err != nil {
+		RespondBadRequest(w, "Invalid event_id format")
+		return
+	}
+
+	// リクエストボディのパース
+	var req CloneEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RespondBadRequest(w, "Invalid request body")
+		return
+	}
+
+	output, err := h.cloneEventUC.Execute(ctx, appevent.CloneEventInput{
+		TenantID:            tenantID,
+		EventID:             eventID,
+		EventName:           req.EventName,
+		IncludeBusinessDays: req.IncludeBusinessDays,
+	})
+	if err != nil {
+		RespondDomainError(w, err)
+		return
+	}
+
+	// レスポンス
+	RespondCreated(w, CloneEventResponse{
+		Event:            toEventResponse(output.Event),
+		InstanceCount:    output.InstanceCount,
+		TemplateCount:    output.TemplateCount,
+		BusinessDayCount: output.BusinessDayCount,
+		ShiftSlotCount:   output.ShiftSlotCount,
+	})
+}
+
 // EventGroupAssignmentsRequest represents the request body for updating group assignments
 type EventGroupAssignmentsRequest struct {
 	MemberGroupIDs []string `json:"member_group_ids"`
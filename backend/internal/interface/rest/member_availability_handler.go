@@ -0,0 +1,416 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	appmember "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/go-chi/chi/v5"
+)
+
+// MemberAvailabilityHandler handles member availability calendar HTTP requests
+// Admin は任意のメンバー、Member (X-Member-ID認証) は自分のカレンダーのみ操作できる
+type MemberAvailabilityHandler struct {
+	getUC            *appmember.GetMemberAvailabilityUsecase
+	createWindowUC   *appmember.CreateAvailabilityWindowUsecase
+	updateWindowUC   *appmember.UpdateAvailabilityWindowUsecase
+	deleteWindowUC   *appmember.DeleteAvailabilityWindowUsecase
+	createBlackoutUC *appmember.CreateAvailabilityBlackoutUsecase
+	updateBlackoutUC *appmember.UpdateAvailabilityBlackoutUsecase
+	deleteBlackoutUC *appmember.DeleteAvailabilityBlackoutUsecase
+}
+
+// NewMemberAvailabilityHandler creates a new MemberAvailabilityHandler with injected usecases
+func NewMemberAvailabilityHandler(
+	getUC *appmember.GetMemberAvailabilityUsecase,
+	createWindowUC *appmember.CreateAvailabilityWindowUsecase,
+	updateWindowUC *appmember.UpdateAvailabilityWindowUsecase,
+	deleteWindowUC *appmember.DeleteAvailabilityWindowUsecase,
+	createBlackoutUC *appmember.CreateAvailabilityBlackoutUsecase,
+	updateBlackoutUC *appmember.UpdateAvailabilityBlackoutUsecase,
+	deleteBlackoutUC *appmember.DeleteAvailabilityBlackoutUsecase,
+) *MemberAvailabilityHandler {
+	return &MemberAvailabilityHandler{
+		getUC:            getUC,
+		createWindowUC:   createWindowUC,
+		updateWindowUC:   updateWindowUC,
+		deleteWindowUC:   deleteWindowUC,
+		createBlackoutUC: createBlackoutUC,
+		updateBlackoutUC: updateBlackoutUC,
+		deleteBlackoutUC: deleteBlackoutUC,
+	}
+}
+
+// AvailabilityWindowRequest represents the request body for creating or updating an availability window
+type AvailabilityWindowRequest struct {
+	DayOfWeek *int   `json:"day_of_week"` // 0=日曜 〜 6=土曜
+	StartTime string `json:"start_time"`  // HH:MM or HH:MM:SS
+	EndTime   string `json:"end_time"`    // start_time より前の場合は翌日まで
+	Note      string `json:"note"`
+}
+
+// AvailabilityBlackoutRequest represents the request body for creating or updating a blackout
+// start_time / end_time を省略した場合は終日
+type AvailabilityBlackoutRequest struct {
+	Date      string  `json:"date"` // YYYY-MM-DD
+	StartTime *string `json:"start_time,omitempty"`
+	EndTime   *string `json:"end_time,omitempty"`
+	Reason    string  `json:"reason"`
+}
+
+// AvailabilityWindowResponse represents an availability window in API responses
+type AvailabilityWindowResponse struct {
+	WindowID  string `json:"window_id"`
+	MemberID  string `json:"member_id"`
+	DayOfWeek int    `json:"day_of_week"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+	Note      string `json:"note"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// AvailabilityBlackoutResponse represents a blackout in API responses
+type AvailabilityBlackoutResponse struct {
+	BlackoutID string  `json:"blackout_id"`
+	MemberID   string  `json:"member_id"`
+	Date       string  `json:"date"`
+	StartTime  *string `json:"start_time,omitempty"`
+	EndTime    *string `json:"end_time,omitempty"`
+	AllDay     bool    `json:"all_day"`
+	Reason     string  `json:"reason"`
+	CreatedAt  string  `json:"created_at"`
+	UpdatedAt  string  `json:"updated_at"`
+}
+
+// availabilityTarget parses the tenant, target member and acting member of the request
+func availabilityTarget(w http.ResponseWriter, r *http.Request) (common.TenantID, common.MemberID, *common.MemberID, bool) {
+	tenantID, ok := getTenantIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return "", "", nil, false
+	}
+
+	actorMemberID, ok := actingMember(w, r)
+	if !ok {
+		return "", "", nil, false
+	}
+
+	memberID, err := common.ParseMemberID(chi.URLParam(r, "member_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid member_id format", nil)
+		return "", "", nil, false
+	}
+
+	return tenantID, memberID, actorMemberID, true
+}
+
+// GetAvailability handles GET /api/v1/members/{member_id}/availability
+func (h *MemberAvailabilityHandler) GetAvailability(w http.ResponseWriter, r *http.Request) {
+	tenantID, memberID, actorMemberID, ok := availabilityTarget(w, r)
+	if !ok {
+		return
+	}
+
+	availability, err := h.getUC.Execute(r.Context(), appmember.GetMemberAvailabilityInput{
+		TenantID:      tenantID,
+		MemberID:      memberID,
+		ActorMemberID: actorMemberID,
+	})
+	if err != nil {
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	windows := make([]AvailabilityWindowResponse, 0, len(availability.Windows))
+	for _, window := range availability.Windows {
+		windows = append(windows, toAvailabilityWindowResponse(window))
+	}
+	blackouts := make([]AvailabilityBlackoutResponse, 0, len(availability.Blackouts))
+	for _, blackout := range availability.Blackouts {
+		blackouts = append(blackouts, toAvailabilityBlackoutResponse(blackout))
+	}
+
+	writeSuccess(w, http.StatusOK, map[string]interface{}{
+		"member_id": memberID.String(),
+		"windows":   windows,
+		"blackouts": blackouts,
+	})
+}
+
+// CreateWindow handles POST /api/v1/members/{member_id}/availability/windows
+func (h *MemberAvailabilityHandler) CreateWindow(w http.ResponseWriter, r *http.Request) {
+	input, ok := parseAvailabilityWindowInput(w, r)
+	if !ok {
+		return
+	}
+
+	window, err := h.createWindowUC.Execute(r.Context(), input)
+	if err != nil {
+		log.Printf("CreateAvailabilityWindow error: %+v", err)
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, toAvailabilityWindowResponse(window))
+}
+
+// UpdateWindow handles PUT /api/v1/members/{member_id}/availability/windows/{window_id}
+func (h *MemberAvailabilityHandler) UpdateWindow(w http.ResponseWriter, r *http.Request) {
+	windowID, err := member.ParseAvailabilityWindowID(chi.URLParam(r, "window_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid window_id format", nil)
+		return
+	}
+
+	input, ok := parseAvailabilityWindowInput(w, r)
+	if !ok {
+		return
+	}
+	input.WindowID = windowID
+
+	window, err := h.updateWindowUC.Execute(r.Context(), input)
+	if err != nil {
+		log.Printf("UpdateAvailabilityWindow error: %+v", err)
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, toAvailabilityWindowResponse(window))
+}
+
+// DeleteWindow handles DELETE /api/v1/members/{member_id}/availability/windows/{window_id}
+func (h *MemberAvailabilityHandler) DeleteWindow(w http.ResponseWriter, r *http.Request) {
+	tenantID, memberID, actorMemberID, ok := availabilityTarget(w, r)
+	if !ok {
+		return
+	}
+
+	windowID, err := member.ParseAvailabilityWindowID(chi.URLParam(r, "window_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid window_id format", nil)
+		return
+	}
+
+	err = h.deleteWindowUC.Execute(r.Context(), appmember.DeleteAvailabilityWindowInput{
+		TenantID:      tenantID,
+		MemberID:      memberID,
+		ActorMemberID: actorMemberID,
+		WindowID:      windowID,
+	})
+	if err != nil {
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CreateBlackout handles POST /api/v1/members/{member_id}/availability/blackouts
+func (h *MemberAvailabilityHandler) CreateBlackout(w http.ResponseWriter, r *http.Request) {
+	input, ok := parseAvailabilityBlackoutInput(w, r)
+	if !ok {
+		return
+	}
+
+	blackout, err := h.createBlackoutUC.Execute(r.Context(), input)
+	if err != nil {
+		log.Printf("CreateAvailabilityBlackout error: %+v", err)
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, toAvailabilityBlackoutResponse(blackout))
+}
+
+// UpdateBlackout handles PUT /api/v1/members/{member_id}/availability/blackouts/{blackout_id}
+func (h *MemberAvailabilityHandler) UpdateBlackout(w http.ResponseWriter, r *http.Request) {
+	blackoutID, err := member.ParseBlackoutID(chi.URLParam(r, "blackout_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid blackout_id format", nil)
+		return
+	}
+
+	input, ok := parseAvailabilityBlackoutInput(w, r)
+	if !ok {
+		return
+	}
+	input.BlackoutID = blackoutID
+
+	blackout, err := h.updateBlackoutUC.Execute(r.Context(), input)
+	if err != nil {
+		log.Printf("UpdateAvailabilityBlackout error: %+v", err)
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, toAvailabilityBlackoutResponse(blackout))
+}
+
+// DeleteBlackout handles DELETE /api/v1/members/{member_id}/availability/blackouts/{blackout_id}
+func (h *MemberAvailabilityHandler) DeleteBlackout(w http.ResponseWriter, r *http.Request) {
+	tenantID, memberID, actorMemberID, ok := availabilityTarget(w, r)
+	if !ok {
+		return
+	}
+
+	blackoutID, err := member.ParseBlackoutID(chi.URLParam(r, "blackout_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid blackout_id format", nil)
+		return
+	}
+
+	err = h.deleteBlackoutUC.Execute(r.Context(), appmember.DeleteAvailabilityBlackoutInput{
+		TenantID:      tenantID,
+		MemberID:      memberID,
+		ActorMemberID: actorMemberID,
+		BlackoutID:    blackoutID,
+	})
+	if err != nil {
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseAvailabilityWindowInput parses the target member and request body of a window request
+func parseAvailabilityWindowInput(w http.ResponseWriter, r *http.Request) (appmember.AvailabilityWindowInput, bool) {
+	tenantID, memberID, actorMemberID, ok := availabilityTarget(w, r)
+	if !ok {
+		return appmember.AvailabilityWindowInput{}, false
+	}
+
+	var req AvailabilityWindowRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body", nil)
+		return appmember.AvailabilityWindowInput{}, false
+	}
+
+	if req.DayOfWeek == nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "day_of_week is required", nil)
+		return appmember.AvailabilityWindowInput{}, false
+	}
+
+	if req.StartTime == "" || req.EndTime == "" {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "start_time and end_time are required", nil)
+		return appmember.AvailabilityWindowInput{}, false
+	}
+
+	startTime, err := ParseTimeFlexible(req.StartTime)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "開始時刻の形式が正しくありません（HH:MMまたはHH:MM:SS）", nil)
+		return appmember.AvailabilityWindowInput{}, false
+	}
+
+	endTime, err := ParseTimeFlexible(req.EndTime)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "終了時刻の形式が正しくありません（HH:MMまたはHH:MM:SS）", nil)
+		return appmember.AvailabilityWindowInput{}, false
+	}
+
+	return appmember.AvailabilityWindowInput{
+		TenantID:      tenantID,
+		MemberID:      memberID,
+		ActorMemberID: actorMemberID,
+		DayOfWeek:     time.Weekday(*req.DayOfWeek),
+		StartTime:     startTime,
+		EndTime:       endTime,
+		Note:          req.Note,
+	}, true
+}
+
+// parseAvailabilityBlackoutInput parses the target member and request body of a blackout request
+func parseAvailabilityBlackoutInput(w http.ResponseWriter, r *http.Request) (appmember.AvailabilityBlackoutInput, bool) {
+	tenantID, memberID, actorMemberID, ok := availabilityTarget(w, r)
+	if !ok {
+		return appmember.AvailabilityBlackoutInput{}, false
+	}
+
+	var req AvailabilityBlackoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body", nil)
+		return appmember.AvailabilityBlackoutInput{}, false
+	}
+
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "date must be in YYYY-MM-DD format", nil)
+		return appmember.AvailabilityBlackoutInput{}, false
+	}
+
+	var startTime, endTime *time.Time
+	if req.StartTime != nil && *req.StartTime != "" {
+		t, err := ParseTimeFlexible(*req.StartTime)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "開始時刻の形式が正しくありません（HH:MMまたはHH:MM:SS）", nil)
+			return appmember.AvailabilityBlackoutInput{}, false
+		}
+		startTime = &t
+	}
+	if req.EndTime != nil && *req.EndTime != "" {
+		t, err := ParseTimeFlexible(*req.EndTime)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "終了時刻の形式が正しくありません（HH:MMまたはHH:MM:SS）", nil)
+			return appmember.AvailabilityBlackoutInput{}, false
+		}
+		endTime = &t
+	}
+
+	return appmember.AvailabilityBlackoutInput{
+		TenantID:      tenantID,
+		MemberID:      memberID,
+		ActorMemberID: actorMemberID,
+		Date:          date,
+		StartTime:     startTime,
+		EndTime:       endTime,
+		Reason:        req.Reason,
+	}, true
+}
+
+func respondAvailabilityError(w http.ResponseWriter, err error) {
+	// リポジトリのエラーはラップされているため、ドメインエラーを取り出して応答する
+	var domainErr *common.DomainError
+	if errors.As(err, &domainErr) {
+		RespondDomainError(w, domainErr)
+		return
+	}
+	RespondDomainError(w, err)
+}
+
+func toAvailabilityWindowResponse(window *member.AvailabilityWindow) AvailabilityWindowResponse {
+	return AvailabilityWindowResponse{
+		WindowID:  window.WindowID().String(),
+		MemberID:  window.MemberID().String(),
+		DayOfWeek: int(window.DayOfWeek()),
+		StartTime: window.StartTime().Format("15:04:05"),
+		EndTime:   window.EndTime().Format("15:04:05"),
+		Note:      window.Note(),
+		CreatedAt: window.CreatedAt().Format(time.RFC3339),
+		UpdatedAt: window.UpdatedAt().Format(time.RFC3339),
+	}
+}
+
+func toAvailabilityBlackoutResponse(blackout *member.AvailabilityBlackout) AvailabilityBlackoutResponse {
+	resp := AvailabilityBlackoutResponse{
+		BlackoutID: blackout.BlackoutID().String(),
+		MemberID:   blackout.MemberID().String(),
+		Date:       blackout.Date().Format("2006-01-02"),
+		AllDay:     blackout.IsAllDay(),
+		Reason:     blackout.Reason(),
+		CreatedAt:  blackout.CreatedAt().Format(time.RFC3339),
+		UpdatedAt:  blackout.UpdatedAt().Format(time.RFC3339),
+	}
+	if !blackout.IsAllDay() {
+		startTime := blackout.StartTime().Format("15:04:05")
+		endTime := blackout.EndTime().Format("15:04:05")
+		resp.StartTime = &startTime
+		resp.EndTime = &endTime
+	}
+	return resp
+}
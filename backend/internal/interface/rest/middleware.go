@@ -27,6 +27,8 @@ const (
 	ContextKeyRole ContextKey = "role"
 	// ContextKeyAllowedMemberIDs is the context key for allowed member IDs filter (map[string]struct{})
 	ContextKeyAllowedMemberIDs ContextKey = "allowed_member_ids"
+	// ContextKeyTenantLocation is the context key for the tenant's time zone (*time.Location)
+	ContextKeyTenantLocation ContextKey = "tenant_location"
 )
 
 // Logger is a middleware that logs HTTP requests with structured logging
@@ -242,6 +244,15 @@ func GetTenantID(ctx context.Context) (common.TenantID, bool) {
 	return tenantID, ok
 }
 
+// GetTenantLocation extracts the tenant's time zone from context
+// TenantStatusMiddleware を通っていない場合はデフォルトのタイムゾーンを返す
+func GetTenantLocation(ctx context.Context) *time.Location {
+	if loc, ok := ctx.Value(ContextKeyTenantLocation).(*time.Location); ok && loc != nil {
+		return loc
+	}
+	return tenant.LoadLocation(tenant.DefaultTimezone)
+}
+
 // GetMemberID extracts member ID from context
 func GetMemberID(ctx context.Context) (common.MemberID, bool) {
 	memberID, ok := ctx.Value(ContextKeyMemberID).(common.MemberID)
@@ -298,7 +309,9 @@ func TenantStatusMiddleware(tenantRepo tenant.TenantRepository) func(http.Handle
 			}
 
 			// grace, active, pending_payment は通過
-			next.ServeHTTP(w, r)
+			// 締切などの日時解釈に使うため、テナントのタイムゾーンをコンテキストに格納
+			ctx := context.WithValue(r.Context(), ContextKeyTenantLocation, t.Location())
+			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
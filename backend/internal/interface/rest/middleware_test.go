@@ -79,6 +79,38 @@ func TestTenantStatusMiddleware_ActiveTenant_Passes(t *testing.T) {
 	}
 }
 
+func TestTenantStatusMiddleware_StoresTenantLocation(t *testing.T) {
+	usTenant, _ := tenant.NewTenant(time.Now(), "Test Tenant", "America/New_York")
+
+	mockRepo := &MockTenantRepository{
+		findByIDFunc: func(ctx context.Context, id common.TenantID) (*tenant.Tenant, error) {
+			return usTenant, nil
+		},
+	}
+
+	var got *time.Location
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = rest.GetTenantLocation(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	req = req.WithContext(context.WithValue(req.Context(), rest.ContextKeyTenantID, usTenant.TenantID()))
+
+	rr := httptest.NewRecorder()
+	rest.TenantStatusMiddleware(mockRepo)(handler).ServeHTTP(rr, req)
+
+	if got == nil || got.String() != "America/New_York" {
+		t.Errorf("GetTenantLocation() = %v, want America/New_York", got)
+	}
+}
+
+func TestGetTenantLocation_DefaultsWithoutMiddleware(t *testing.T) {
+	if got := rest.GetTenantLocation(context.Background()).String(); got != tenant.DefaultTimezone {
+		t.Errorf("GetTenantLocation() = %v, want %v", got, tenant.DefaultTimezone)
+	}
+}
+
 func TestTenantStatusMiddleware_GraceTenant_Passes(t *testing.T) {
 	// Create a grace tenant (valid transition: active -> grace)
 	graceTenant, _ := tenant.NewTenant(time.Now(), "Test Tenant", "Asia/Tokyo")
@@ -18,6 +18,7 @@ import (
 	applicense "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/license"
 	appmember "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/member"
 	appmembergroup "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/member_group"
+	appnotification "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/notification"
 	apppayment "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/payment"
 	approle "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/role"
 	approlegroup "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/role_group"
@@ -31,6 +32,7 @@ import (
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/tenant"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/infra/clock"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/infra/db"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/infra/discord"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/infra/email"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/infra/security"
 	infrastripe "github.com/erenoa/vrc-shift-scheduler/backend/internal/infra/stripe"
@@ -39,13 +41,19 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-// initEmailService creates an email service based on environment configuration
-// If Resend is configured, it returns ResendEmailService; otherwise MockEmailService
-func initEmailService() services.EmailService {
+// publicBaseURL returns the base URL of the frontend used in links sent to users
+func publicBaseURL() string {
 	baseURL := os.Getenv("INVITATION_BASE_URL")
 	if baseURL == "" {
 		baseURL = "https://vrcshift.com"
 	}
+	return baseURL
+}
+
+// initEmailService creates an email service based on environment configuration
+// If Resend is configured, it returns ResendEmailService; otherwise MockEmailService
+func initEmailService() services.EmailService {
+	baseURL := publicBaseURL()
 
 	// Check if Resend is configured
 	apiKey := os.Getenv("RESEND_API_KEY")
@@ -159,32 +167,54 @@ func NewRouter(dbPool *pgxpool.Pool) http.Handler {
 		eventRepo := db.NewEventRepository(dbPool)
 		businessDayRepo := db.NewEventBusinessDayRepository(dbPool)
 		groupAssignRepo := db.NewEventGroupAssignmentRepository(dbPool)
+		templateRepo := db.NewShiftSlotTemplateRepository(dbPool)
+		blackoutRepo := db.NewBlackoutDateSetRepository(dbPool)
+		slotRepo := db.NewShiftSlotRepository(dbPool)
+		instanceRepo := db.NewInstanceRepository(dbPool)
+		eventTxManager := db.NewPgxTxManager(dbPool)
+		eventClock := &clock.RealClock{}
 		eventHandler := NewEventHandler(
-			appevent.NewCreateEventUsecase(eventRepo, businessDayRepo),
+			appevent.NewCreateEventUsecase(eventRepo, businessDayRepo, tenantRepo, blackoutRepo, eventClock),
 			appevent.NewListEventsUsecase(eventRepo),
 			appevent.NewGetEventUsecase(eventRepo),
-			appevent.NewUpdateEventUsecase(eventRepo),
+			appevent.NewUpdateEventUsecase(eventRepo, templateRepo),
 			appevent.NewDeleteEventUsecase(eventRepo),
-			appevent.NewGenerateBusinessDaysUsecase(eventRepo, businessDayRepo),
+			appevent.NewGenerateBusinessDaysUsecase(eventRepo, businessDayRepo, tenantRepo, blackoutRepo, eventClock),
 			appevent.NewGetEventGroupAssignmentsUsecase(eventRepo, groupAssignRepo),
 			appevent.NewUpdateEventGroupAssignmentsUsecase(eventRepo, groupAssignRepo),
+			appevent.NewCloneEventUsecase(eventRepo, businessDayRepo, instanceRepo, templateRepo, slotRepo, groupAssignRepo, tenantRepo, eventTxManager, eventClock),
+			appevent.NewArchiveEventUsecase(eventRepo),
+			appevent.NewRestoreEventUsecase(eventRepo),
+		)
+
+		// BlackoutDateHandler dependencies
+		blackoutDateHandler := NewBlackoutDateHandler(
+			appevent.NewCreateBlackoutDateSetUsecase(blackoutRepo, eventClock),
+			appevent.NewListBlackoutDateSetsUsecase(blackoutRepo),
+			appevent.NewGetBlackoutDateSetUsecase(blackoutRepo),
+			appevent.NewUpdateBlackoutDateSetUsecase(blackoutRepo, eventClock),
+			appevent.NewDeleteBlackoutDateSetUsecase(blackoutRepo),
+			appevent.NewImportJapaneseHolidaysUsecase(blackoutRepo, eventClock),
+			appevent.NewImportBlackoutICSUsecase(blackoutRepo, tenantRepo, eventClock),
 		)
 
 		// BusinessDayHandler dependencies
-		slotRepo := db.NewShiftSlotRepository(dbPool)
-		templateRepo := db.NewShiftSlotTemplateRepository(dbPool)
-		instanceRepo := db.NewInstanceRepository(dbPool)
 		businessDayTxManager := db.NewPgxTxManager(dbPool)
+		assignmentRepo := db.NewShiftAssignmentRepository(dbPool)
+		memberRepo := db.NewMemberRepository(dbPool)
 		businessDayHandler := NewBusinessDayHandler(
 			appevent.NewCreateBusinessDayUsecase(businessDayRepo, eventRepo, templateRepo, slotRepo, instanceRepo, businessDayTxManager),
 			appevent.NewListBusinessDaysUsecase(businessDayRepo),
 			appevent.NewGetBusinessDayUsecase(businessDayRepo),
 			appevent.NewApplyTemplateUsecase(businessDayRepo, templateRepo, slotRepo, instanceRepo, businessDayTxManager),
 			appevent.NewDeleteBusinessDayUsecase(businessDayRepo),
+			appevent.NewBulkUpdateBusinessDaysUsecase(businessDayRepo, eventRepo, slotRepo, assignmentRepo, memberRepo, businessDayTxManager, eventClock),
+			appevent.NewCancelBusinessDayUsecase(businessDayRepo, eventClock),
+			appevent.NewUncancelBusinessDayUsecase(businessDayRepo, eventClock),
+			appevent.NewSyncTemplateToBusinessDaysUsecase(businessDayRepo, templateRepo, slotRepo, assignmentRepo, instanceRepo, tenantRepo, businessDayTxManager, eventClock),
 		)
 
-		// InstanceHandler dependencies
-		assignmentRepo := db.NewShiftAssignmentRepository(dbPool)
+		// InstanceHandler dependencies (reusing assignmentRepo)
 		instanceTxManager := db.NewPgxTxManager(dbPool)
 		instanceHandler := NewInstanceHandler(
 			appshift.NewCreateInstanceUsecase(instanceRepo, eventRepo),
@@ -197,8 +227,7 @@ func NewRouter(dbPool *pgxpool.Pool) http.Handler {
 		// RoleHandler dependencies (needed by MemberHandler too)
 		roleRepo := db.NewRoleRepository(dbPool)
 
-		// MemberHandler dependencies
-		memberRepo := db.NewMemberRepository(dbPool)
+		// MemberHandler dependencies (reusing memberRepo)
 		memberRoleRepo := db.NewMemberRoleRepository(dbPool)
 		attendanceRepo := db.NewAttendanceRepository(dbPool)
 		memberTxManager := db.NewPgxTxManager(dbPool)
@@ -225,7 +254,7 @@ func NewRouter(dbPool *pgxpool.Pool) http.Handler {
 		// ShiftSlotHandler dependencies (reusing slotRepo, businessDayRepo, instanceRepo, assignmentRepo)
 		slotTxManager := db.NewPgxTxManager(dbPool)
 		shiftSlotHandler := NewShiftSlotHandler(
-			appshift.NewCreateShiftSlotUsecase(slotRepo, businessDayRepo, instanceRepo),
+			appshift.NewCreateShiftSlotUsecase(slotRepo, businessDayRepo, instanceRepo, roleRepo),
 			appshift.NewListShiftSlotsUsecase(slotRepo, assignmentRepo),
 			appshift.NewGetShiftSlotUsecase(slotRepo, assignmentRepo),
 			appshift.NewDeleteShiftSlotUsecase(slotRepo, assignmentRepo),
@@ -234,42 +263,119 @@ func NewRouter(dbPool *pgxpool.Pool) http.Handler {
 
 		// ShiftTemplateHandler dependencies (reusing templateRepo, slotRepo, businessDayRepo)
 		shiftTemplateHandler := NewShiftTemplateHandler(
-			appshift.NewCreateShiftTemplateUsecase(templateRepo),
+			appshift.NewCreateShiftTemplateUsecase(templateRepo, roleRepo),
 			appshift.NewListShiftTemplatesUsecase(templateRepo),
 			appshift.NewGetShiftTemplateUsecase(templateRepo),
-			appshift.NewUpdateShiftTemplateUsecase(templateRepo),
+			appshift.NewUpdateShiftTemplateUsecase(templateRepo, roleRepo),
 			appshift.NewDeleteShiftTemplateUsecase(templateRepo),
 			appshift.NewSaveBusinessDayAsTemplateUsecase(templateRepo, businessDayRepo, slotRepo),
 		)
 
-		// ShiftAssignmentHandler dependencies (reusing slotRepo, assignmentRepo, memberRepo, businessDayRepo)
+		systemClock := &clock.RealClock{}
+		txManager := db.NewPgxTxManager(dbPool)
+
+		// MemberAvailabilityHandler dependencies (reusing memberRepo)
+		availabilityRepo := db.NewMemberAvailabilityRepository(dbPool)
+		memberAvailabilityHandler := NewMemberAvailabilityHandler(
+			appmember.NewGetMemberAvailabilityUsecase(memberRepo, availabilityRepo),
+			appmember.NewCreateAvailabilityWindowUsecase(memberRepo, availabilityRepo, systemClock),
+			appmember.NewUpdateAvailabilityWindowUsecase(availabilityRepo, systemClock),
+			appmember.NewDeleteAvailabilityWindowUsecase(availabilityRepo),
+			appmember.NewCreateAvailabilityBlackoutUsecase(memberRepo, availabilityRepo, systemClock),
+			appmember.NewUpdateAvailabilityBlackoutUsecase(availabilityRepo, systemClock),
+			appmember.NewDeleteAvailabilityBlackoutUsecase(availabilityRepo),
+		)
+
+		// WorkloadPolicyHandler dependencies (reusing memberRepo, assignmentRepo)
+		workloadPolicyRepo := db.NewWorkloadPolicyRepository(dbPool)
+		workloadPolicyHandler := NewWorkloadPolicyHandler(
+			appmember.NewGetWorkloadPolicyUsecase(memberRepo, workloadPolicyRepo),
+			appmember.NewPutWorkloadPolicyUsecase(memberRepo, workloadPolicyRepo, systemClock),
+			appmember.NewDeleteWorkloadPolicyUsecase(workloadPolicyRepo),
+			appmember.NewGetWorkloadReportUsecase(memberRepo, workloadPolicyRepo, assignmentRepo),
+		)
+
+		// ShiftAssignmentHandler dependencies (reusing eventRepo, slotRepo, assignmentRepo, memberRepo, businessDayRepo, attendanceRepo, availabilityRepo, workloadPolicyRepo)
+		// 割り当てのキャンセル時は空き待ち（standbyRepo）から繰り上げる
+		standbyRepo := db.NewStandbyRepository(dbPool)
+		// 確定通知は割り当てと同じトランザクションでアウトボックスに書き込む
+		outboxRepo := db.NewNotificationOutboxRepository(dbPool)
 		shiftAssignmentHandler := NewShiftAssignmentHandler(
-			appshift.NewConfirmManualAssignmentUsecase(slotRepo, assignmentRepo, memberRepo),
+			appshift.NewConfirmManualAssignmentUsecase(slotRepo, assignmentRepo, memberRepo, memberRoleRepo, availabilityRepo, workloadPolicyRepo, eventRepo, businessDayRepo, outboxRepo, txManager, systemClock),
 			appshift.NewGetAssignmentsUsecase(assignmentRepo, memberRepo, slotRepo, businessDayRepo),
 			appshift.NewGetAssignmentDetailUsecase(assignmentRepo, memberRepo, slotRepo, businessDayRepo),
-			appshift.NewCancelAssignmentUsecase(assignmentRepo),
+			appshift.NewCancelAssignmentUsecase(assignmentRepo, slotRepo, standbyRepo, businessDayRepo, eventRepo, memberRoleRepo, availabilityRepo, workloadPolicyRepo, txManager, systemClock),
+			appshift.NewAutoAssignUsecase(eventRepo, businessDayRepo, slotRepo, assignmentRepo, memberRepo, memberRoleRepo, availabilityRepo, workloadPolicyRepo, attendanceRepo, outboxRepo, txManager, systemClock),
 		)
 
-		// AttendanceHandler dependencies (reusing attendanceRepo, memberRepo, roleRepo)
-		systemClock := &clock.RealClock{}
-		txManager := db.NewPgxTxManager(dbPool)
+		// ShiftPlanHandler dependencies (reusing eventRepo, businessDayRepo, slotRepo, assignmentRepo, memberRepo, outboxRepo)
+		planRepo := db.NewShiftPlanRepository(dbPool)
+		shiftPlanHandler := NewShiftPlanHandler(
+			appshift.NewCreateShiftPlanUsecase(planRepo, eventRepo, businessDayRepo, assignmentRepo, txManager, systemClock),
+			appshift.NewListShiftPlansUsecase(planRepo),
+			appshift.NewGetShiftPlanUsecase(planRepo, assignmentRepo),
+			appshift.NewDeleteShiftPlanUsecase(planRepo, systemClock),
+			appshift.NewAddPlanAssignmentUsecase(planRepo, slotRepo, businessDayRepo, memberRepo, memberRoleRepo, assignmentRepo, systemClock),
+			appshift.NewRemovePlanAssignmentUsecase(planRepo, assignmentRepo),
+			appshift.NewDiffShiftPlanUsecase(planRepo, assignmentRepo),
+			appshift.NewPublishShiftPlanUsecase(planRepo, slotRepo, assignmentRepo, memberRepo, memberRoleRepo, availabilityRepo, workloadPolicyRepo, eventRepo, businessDayRepo, outboxRepo, txManager, systemClock),
+		)
+
+		// StandbyHandler dependencies (reusing standbyRepo, slotRepo, assignmentRepo, businessDayRepo, eventRepo, memberRoleRepo, availabilityRepo, workloadPolicyRepo, memberRepo)
+		standbyHandler := NewStandbyHandler(
+			appshift.NewJoinStandbyUsecase(standbyRepo, slotRepo, assignmentRepo, businessDayRepo, eventRepo, memberRoleRepo, availabilityRepo, workloadPolicyRepo, memberRepo, txManager, systemClock),
+			appshift.NewListStandbyUsecase(standbyRepo, slotRepo),
+			appshift.NewAcceptStandbyOfferUsecase(standbyRepo, slotRepo, assignmentRepo, businessDayRepo, eventRepo, memberRoleRepo, availabilityRepo, workloadPolicyRepo, txManager, systemClock),
+			appshift.NewDeclineStandbyOfferUsecase(standbyRepo, slotRepo, assignmentRepo, businessDayRepo, eventRepo, memberRoleRepo, availabilityRepo, workloadPolicyRepo, txManager, systemClock),
+			appshift.NewWithdrawStandbyUsecase(standbyRepo, slotRepo, assignmentRepo, businessDayRepo, eventRepo, memberRoleRepo, availabilityRepo, workloadPolicyRepo, txManager, systemClock),
+		)
+
+		// SlotCandidateHandler dependencies (reusing slotRepo, businessDayRepo, assignmentRepo, memberRepo, memberRoleRepo, attendanceRepo, availabilityRepo, workloadPolicyRepo)
+		slotCandidateHandler := NewSlotCandidateHandler(
+			appshift.NewListSlotCandidatesUsecase(slotRepo, businessDayRepo, assignmentRepo, memberRepo, memberRoleRepo, attendanceRepo, availabilityRepo, workloadPolicyRepo),
+		)
+
+		// SwapRequestHandler dependencies (reusing slotRepo, assignmentRepo, businessDayRepo, eventRepo, memberRoleRepo, availabilityRepo, workloadPolicyRepo)
+		// 引き受け（claim）は公開APIで行う
+		swapRequestRepo := db.NewSwapRequestRepository(dbPool)
+		swapRequestHandler := NewSwapRequestHandler(
+			appshift.NewCreateSwapRequestUsecase(swapRequestRepo, assignmentRepo, systemClock),
+			appshift.NewListSwapRequestsUsecase(swapRequestRepo),
+			nil, // GetByToken is served by the public handler
+			nil, // Claim is served by the public handler
+			appshift.NewApproveSwapRequestUsecase(swapRequestRepo, slotRepo, assignmentRepo, businessDayRepo, eventRepo, memberRoleRepo, availabilityRepo, workloadPolicyRepo, txManager, systemClock),
+			appshift.NewRejectSwapRequestUsecase(swapRequestRepo, txManager, systemClock),
+			appshift.NewCancelSwapRequestUsecase(swapRequestRepo, txManager, systemClock),
+		)
+
+		// DiscordWebhookHandler dependencies (reusing eventRepo, businessDayRepo, memberRepo)
+		// 受付開始などチャンネル全体への告知は discordDispatcher から直接投稿する
+		discordWebhookRepo := db.NewDiscordWebhookRepository(dbPool)
+		discordDispatcher := discord.NewDispatcher(discord.NewClient(nil), publicBaseURL(), discordWebhookRepo, businessDayRepo, memberRepo)
+		discordWebhookHandler := NewDiscordWebhookHandler(
+			appnotification.NewGetDiscordWebhookUsecase(eventRepo, discordWebhookRepo),
+			appnotification.NewPutDiscordWebhookUsecase(eventRepo, discordWebhookRepo, systemClock),
+			appnotification.NewDeleteDiscordWebhookUsecase(discordWebhookRepo),
+		)
+
+		// AttendanceHandler dependencies (reusing attendanceRepo, memberRepo, roleRepo, discordDispatcher)
 		attendanceHandler := NewAttendanceHandler(
-			appattendance.NewCreateCollectionUsecase(attendanceRepo, roleRepo, txManager, systemClock),
+			appattendance.NewCreateCollectionUsecase(attendanceRepo, roleRepo, discordDispatcher, txManager, systemClock),
 			appattendance.NewSubmitResponseUsecase(attendanceRepo, txManager, systemClock),
 			appattendance.NewCloseCollectionUsecase(attendanceRepo, systemClock),
 			appattendance.NewDeleteCollectionUsecase(attendanceRepo, systemClock),
 			appattendance.NewUpdateCollectionUsecase(attendanceRepo, txManager, systemClock),
-			appattendance.NewGetCollectionUsecase(attendanceRepo),
-			appattendance.NewGetCollectionByTokenUsecase(attendanceRepo),
+			appattendance.NewGetCollectionUsecase(attendanceRepo, systemClock),
+			appattendance.NewGetCollectionByTokenUsecase(attendanceRepo, tenantRepo, systemClock),
 			appattendance.NewGetResponsesUsecase(attendanceRepo, memberRepo),
-			appattendance.NewListCollectionsUsecase(attendanceRepo),
+			appattendance.NewListCollectionsUsecase(attendanceRepo, systemClock),
 			appattendance.NewGetMemberResponsesUsecase(attendanceRepo),
 			appattendance.NewGetAllPublicResponsesUsecase(attendanceRepo, memberRepo),
 			appattendance.NewAdminUpdateResponseUsecase(attendanceRepo, memberRepo, txManager, systemClock),
 		)
 
-		// ActualAttendanceHandler dependencies (reusing memberRepo, businessDayRepo, assignmentRepo)
-		actualAttendanceHandler := NewActualAttendanceHandler(businessDayRepo, memberRepo, assignmentRepo)
+		// ActualAttendanceHandler dependencies (reusing memberRepo, businessDayRepo, assignmentRepo, tenantRepo)
+		actualAttendanceHandler := NewActualAttendanceHandler(businessDayRepo, memberRepo, assignmentRepo, tenantRepo, systemClock)
 
 		// TenantHandler dependencies (reusing tenantRepo from billing guard)
 		tenantHandler := NewTenantHandler(
@@ -298,9 +404,22 @@ func NewRouter(dbPool *pgxpool.Pool) http.Handler {
 			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Put("/{event_id}", eventHandler.UpdateEvent)
 			r.With(permissionChecker.RequirePermission(tenant.PermissionDeleteEvent)).Delete("/{event_id}", eventHandler.DeleteEvent)
 
+			// イベントの複製（インスタンス・テンプレート・グループ割り当て、任意で将来の営業日）
+			r.With(permissionChecker.RequirePermission(tenant.PermissionCreateEvent)).Post("/{event_id}/clone", eventHandler.CloneEvent)
+
+			// イベントのアーカイブ・復元（履歴は保持し、一覧は ?status=archived で参照できる）
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Post("/{event_id}/archive", eventHandler.ArchiveEvent)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Post("/{event_id}/restore", eventHandler.RestoreEvent)
+
+			// イベント個別の Discord Webhook（未設定の場合はテナントの既定設定に投稿する）
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Get("/{event_id}/discord-webhook", discordWebhookHandler.GetEventWebhook)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Put("/{event_id}/discord-webhook", discordWebhookHandler.PutEventWebhook)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Delete("/{event_id}/discord-webhook", discordWebhookHandler.DeleteEventWebhook)
+
 			// Event配下のBusinessDay
 			r.With(permissionChecker.RequirePermission(tenant.PermissionCreateEvent)).Post("/{event_id}/business-days", businessDayHandler.CreateBusinessDay)
 			r.Get("/{event_id}/business-days", businessDayHandler.ListBusinessDays)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Post("/{event_id}/business-days/bulk", businessDayHandler.BulkUpdateBusinessDays)
 
 			// Event配下の営業日生成
 			r.With(permissionChecker.RequirePermission(tenant.PermissionCreateEvent)).Post("/{event_id}/generate-business-days", eventHandler.GenerateBusinessDays)
@@ -316,11 +435,27 @@ func NewRouter(dbPool *pgxpool.Pool) http.Handler {
 			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Put("/{event_id}/templates/{template_id}", shiftTemplateHandler.UpdateTemplate)
 			r.With(permissionChecker.RequirePermission(tenant.PermissionDeleteEvent)).Delete("/{event_id}/templates/{template_id}", shiftTemplateHandler.DeleteTemplate)
 
+			// ShiftTemplateの最新バージョンを将来の営業日に反映（dry_run で差分をプレビュー）
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Post("/{event_id}/templates/{template_id}/apply-to-future-days", businessDayHandler.SyncTemplateToBusinessDays)
+
 			// Event配下のInstance
 			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Post("/{event_id}/instances", instanceHandler.CreateInstance)
 			r.Get("/{event_id}/instances", instanceHandler.GetInstances)
 		})
 
+		// BlackoutDateSet API（定期開催の営業日生成から除外する休業日）
+		r.Route("/blackout-date-sets", func(r chi.Router) {
+			r.Get("/", blackoutDateHandler.ListBlackoutDateSets)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Post("/", blackoutDateHandler.CreateBlackoutDateSet)
+			r.Get("/{set_id}", blackoutDateHandler.GetBlackoutDateSet)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Put("/{set_id}", blackoutDateHandler.UpdateBlackoutDateSet)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionDeleteEvent)).Delete("/{set_id}", blackoutDateHandler.DeleteBlackoutDateSet)
+
+			// 祝日・ICS ファイルからのインポート
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Post("/{set_id}/import/jp-holidays", blackoutDateHandler.ImportJapaneseHolidays)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Post("/{set_id}/import/ics", blackoutDateHandler.ImportBlackoutICS)
+		})
+
 		// Instance API
 		r.Route("/instances", func(r chi.Router) {
 			r.Get("/{instance_id}", instanceHandler.GetInstance)
@@ -347,6 +482,13 @@ func NewRouter(dbPool *pgxpool.Pool) http.Handler {
 
 			// BusinessDayにShiftTemplateを適用
 			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Post("/{business_day_id}/apply-template", businessDayHandler.ApplyTemplate)
+
+			// BusinessDayの中止・中止の取り消し（シフト枠・割り当ては残る）
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Post("/{business_day_id}/cancel", businessDayHandler.CancelBusinessDay)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Delete("/{business_day_id}/cancel", businessDayHandler.UncancelBusinessDay)
+
+			// 出欠回答からシフト枠を自動割り当て
+			r.With(permissionChecker.RequirePermission(tenant.PermissionAssignShift)).Post("/{business_day_id}/auto-assign", shiftAssignmentHandler.AutoAssign)
 		})
 
 		// Member API
@@ -356,9 +498,32 @@ func NewRouter(dbPool *pgxpool.Pool) http.Handler {
 			r.With(permissionChecker.RequirePermission(tenant.PermissionEditMember)).Post("/bulk-update-roles", memberHandler.BulkUpdateRoles)
 			r.Get("/", memberHandler.GetMembers)
 			r.Get("/recent-attendance", memberHandler.GetRecentAttendance)
+
+			// 勤務量ポリシー（テナントの既定値）と上限超過・目標未達のレポート
+			r.Get("/workload-policy", workloadPolicyHandler.GetTenantPolicy)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditMember)).Put("/workload-policy", workloadPolicyHandler.PutTenantPolicy)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditMember)).Delete("/workload-policy", workloadPolicyHandler.DeleteTenantPolicy)
+			r.Get("/workload-report", workloadPolicyHandler.GetReport)
+
 			r.Get("/{member_id}", memberHandler.GetMemberDetail)
 			r.With(permissionChecker.RequirePermission(tenant.PermissionEditMember)).Put("/{member_id}", memberHandler.UpdateMember)
 			r.With(permissionChecker.RequirePermission(tenant.PermissionDeleteMember)).Delete("/{member_id}", memberHandler.DeleteMember)
+
+			// 参加可能時間帯・ブラックアウト日（Member 本人も自分の分を操作できる）
+			r.Route("/{member_id}/availability", func(r chi.Router) {
+				r.Get("/", memberAvailabilityHandler.GetAvailability)
+				r.With(permissionChecker.RequirePermission(tenant.PermissionEditMember)).Post("/windows", memberAvailabilityHandler.CreateWindow)
+				r.With(permissionChecker.RequirePermission(tenant.PermissionEditMember)).Put("/windows/{window_id}", memberAvailabilityHandler.UpdateWindow)
+				r.With(permissionChecker.RequirePermission(tenant.PermissionEditMember)).Delete("/windows/{window_id}", memberAvailabilityHandler.DeleteWindow)
+				r.With(permissionChecker.RequirePermission(tenant.PermissionEditMember)).Post("/blackouts", memberAvailabilityHandler.CreateBlackout)
+				r.With(permissionChecker.RequirePermission(tenant.PermissionEditMember)).Put("/blackouts/{blackout_id}", memberAvailabilityHandler.UpdateBlackout)
+				r.With(permissionChecker.RequirePermission(tenant.PermissionEditMember)).Delete("/blackouts/{blackout_id}", memberAvailabilityHandler.DeleteBlackout)
+			})
+
+			// メンバー個別の勤務量ポリシー（テナントの既定値を上書き）
+			r.Get("/{member_id}/workload-policy", workloadPolicyHandler.GetMemberPolicy)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditMember)).Put("/{member_id}/workload-policy", workloadPolicyHandler.PutMemberPolicy)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditMember)).Delete("/{member_id}/workload-policy", workloadPolicyHandler.DeleteMemberPolicy)
 		})
 
 		// Role API
@@ -417,6 +582,20 @@ func NewRouter(dbPool *pgxpool.Pool) http.Handler {
 		r.Route("/shift-slots", func(r chi.Router) {
 			r.Get("/{slot_id}", shiftSlotHandler.GetShiftSlotDetail)
 			r.With(permissionChecker.RequirePermission(tenant.PermissionEditShift)).Delete("/{slot_id}", shiftSlotHandler.DeleteShiftSlot)
+
+			// 空き待ち（スタンバイ）リスト
+			r.Get("/{slot_id}/standby", standbyHandler.ListStandby)
+			r.Post("/{slot_id}/standby", standbyHandler.JoinStandby)
+
+			// 割り当て候補ランキング（管理者向け）
+			r.With(permissionChecker.RequirePermission(tenant.PermissionAssignShift)).Get("/{slot_id}/candidates", slotCandidateHandler.ListCandidates)
+		})
+
+		// Standby API（空き待ちのオファー回答・取り下げ）
+		r.Route("/shift-standbys", func(r chi.Router) {
+			r.Post("/{standby_id}/accept", standbyHandler.AcceptStandbyOffer)
+			r.Post("/{standby_id}/decline", standbyHandler.DeclineStandbyOffer)
+			r.Delete("/{standby_id}", standbyHandler.WithdrawStandby)
 		})
 
 		// ShiftAssignment API
@@ -427,6 +606,27 @@ func NewRouter(dbPool *pgxpool.Pool) http.Handler {
 			r.With(permissionChecker.RequirePermission(tenant.PermissionEditShift)).Delete("/{assignment_id}", shiftAssignmentHandler.CancelAssignment)
 		})
 
+		// ShiftPlan API（下書き → 公開）
+		r.Route("/shift-plans", func(r chi.Router) {
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditShift)).Post("/", shiftPlanHandler.CreateShiftPlan)
+			r.Get("/", shiftPlanHandler.ListShiftPlans)
+			r.Get("/{plan_id}", shiftPlanHandler.GetShiftPlan)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditShift)).Delete("/{plan_id}", shiftPlanHandler.DeleteShiftPlan)
+			r.Get("/{plan_id}/diff", shiftPlanHandler.DiffShiftPlan)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditShift)).Post("/{plan_id}/publish", shiftPlanHandler.PublishShiftPlan)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionAssignShift)).Post("/{plan_id}/assignments", shiftPlanHandler.AddPlanAssignment)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionAssignShift)).Delete("/{plan_id}/assignments/{assignment_id}", shiftPlanHandler.RemovePlanAssignment)
+		})
+
+		// ShiftSwap API（シフト交換・譲渡の依頼と承認）
+		r.Route("/shift-swaps", func(r chi.Router) {
+			r.Post("/", swapRequestHandler.CreateSwapRequest)
+			r.Get("/", swapRequestHandler.ListSwapRequests)
+			r.Delete("/{swap_request_id}", swapRequestHandler.CancelSwapRequest)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionAssignShift)).Post("/{swap_request_id}/approve", swapRequestHandler.ApproveSwapRequest)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionAssignShift)).Post("/{swap_request_id}/reject", swapRequestHandler.RejectSwapRequest)
+		})
+
 		// Attendance API（管理用）
 		r.Route("/attendance/collections", func(r chi.Router) {
 			r.Get("/", attendanceHandler.ListCollections)
@@ -449,10 +649,10 @@ func NewRouter(dbPool *pgxpool.Pool) http.Handler {
 			appschedule.NewCloseScheduleUsecase(scheduleRepo, systemClock),
 			appschedule.NewDeleteScheduleUsecase(scheduleRepo, systemClock),
 			appschedule.NewUpdateScheduleUsecase(scheduleRepo, txManager, systemClock),
-			appschedule.NewGetScheduleUsecase(scheduleRepo),
-			appschedule.NewGetScheduleByTokenUsecase(scheduleRepo),
+			appschedule.NewGetScheduleUsecase(scheduleRepo, systemClock),
+			appschedule.NewGetScheduleByTokenUsecase(scheduleRepo, tenantRepo, systemClock),
 			appschedule.NewGetResponsesUsecase(scheduleRepo),
-			appschedule.NewListSchedulesUsecase(scheduleRepo),
+			appschedule.NewListSchedulesUsecase(scheduleRepo, systemClock),
 			appschedule.NewGetAllPublicResponsesUsecase(scheduleRepo, memberRepo),
 			appschedule.NewConvertToAttendanceUsecase(scheduleRepo, attendanceRepo, memberGroupRepo, txManager, systemClock),
 		)
@@ -493,10 +693,28 @@ func NewRouter(dbPool *pgxpool.Pool) http.Handler {
 			apptenant.NewUpdateManagerPermissionsUsecase(managerPermissionsRepo),
 		)
 
+		// BotTokenHandler dependencies
+		botTokenRepo := db.NewBotTokenRepository(dbPool)
+		botTokenHandler := NewBotTokenHandler(
+			apptenant.NewIssueBotTokenUsecase(botTokenRepo, systemClock),
+			apptenant.NewListBotTokensUsecase(botTokenRepo),
+			apptenant.NewRevokeBotTokenUsecase(botTokenRepo, systemClock),
+		)
+
 		// Settings API
 		r.Route("/settings", func(r chi.Router) {
 			r.Get("/manager-permissions", managerPermissionsHandler.GetManagerPermissions)
 			r.Put("/manager-permissions", managerPermissionsHandler.UpdateManagerPermissions)
+
+			// テナントの既定の Discord Webhook
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Get("/discord-webhook", discordWebhookHandler.GetTenantWebhook)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Put("/discord-webhook", discordWebhookHandler.PutTenantWebhook)
+			r.With(permissionChecker.RequirePermission(tenant.PermissionEditEvent)).Delete("/discord-webhook", discordWebhookHandler.DeleteTenantWebhook)
+
+			// Discord Bot 用のサービストークン（オーナーのみ）
+			r.With(RequireOwner).Get("/bot-tokens", botTokenHandler.ListBotTokens)
+			r.With(RequireOwner).Post("/bot-tokens", botTokenHandler.IssueBotToken)
+			r.With(RequireOwner).Delete("/bot-tokens/{token_id}", botTokenHandler.RevokeBotToken)
 		})
 
 		// Import API（一括取り込み機能）
@@ -729,15 +947,15 @@ func NewRouter(dbPool *pgxpool.Pool) http.Handler {
 		publicMemberRepoForAttendance := db.NewMemberRepository(dbPool)
 		publicRoleRepoForAttendance := db.NewRoleRepository(dbPool)
 		publicAttendanceHandler := NewAttendanceHandler(
-			appattendance.NewCreateCollectionUsecase(publicAttendanceRepoForHandler, publicRoleRepoForAttendance, publicTxManager, publicClock),
+			appattendance.NewCreateCollectionUsecase(publicAttendanceRepoForHandler, publicRoleRepoForAttendance, nil, publicTxManager, publicClock),
 			appattendance.NewSubmitResponseUsecase(publicAttendanceRepoForHandler, publicTxManager, publicClock),
 			appattendance.NewCloseCollectionUsecase(publicAttendanceRepoForHandler, publicClock),
 			appattendance.NewDeleteCollectionUsecase(publicAttendanceRepoForHandler, publicClock),
 			nil,
-			appattendance.NewGetCollectionUsecase(publicAttendanceRepoForHandler),
-			appattendance.NewGetCollectionByTokenUsecase(publicAttendanceRepoForHandler),
+			appattendance.NewGetCollectionUsecase(publicAttendanceRepoForHandler, publicClock),
+			appattendance.NewGetCollectionByTokenUsecase(publicAttendanceRepoForHandler, tenantRepo, publicClock),
 			appattendance.NewGetResponsesUsecase(publicAttendanceRepoForHandler, publicMemberRepoForAttendance),
-			appattendance.NewListCollectionsUsecase(publicAttendanceRepoForHandler),
+			appattendance.NewListCollectionsUsecase(publicAttendanceRepoForHandler, publicClock),
 			appattendance.NewGetMemberResponsesUsecase(publicAttendanceRepoForHandler),
 			appattendance.NewGetAllPublicResponsesUsecase(publicAttendanceRepoForHandler, publicMemberRepoForAttendance),
 			nil, // AdminUpdateResponseUsecase は公開APIでは使用しない
@@ -760,10 +978,10 @@ func NewRouter(dbPool *pgxpool.Pool) http.Handler {
 			appschedule.NewCloseScheduleUsecase(publicScheduleRepo, publicClock),
 			appschedule.NewDeleteScheduleUsecase(publicScheduleRepo, publicClock),
 			nil,
-			appschedule.NewGetScheduleUsecase(publicScheduleRepo),
-			appschedule.NewGetScheduleByTokenUsecase(publicScheduleRepo),
+			appschedule.NewGetScheduleUsecase(publicScheduleRepo, publicClock),
+			appschedule.NewGetScheduleByTokenUsecase(publicScheduleRepo, tenantRepo, publicClock),
 			appschedule.NewGetResponsesUsecase(publicScheduleRepo),
-			appschedule.NewListSchedulesUsecase(publicScheduleRepo),
+			appschedule.NewListSchedulesUsecase(publicScheduleRepo, publicClock),
 			appschedule.NewGetAllPublicResponsesUsecase(publicScheduleRepo, publicScheduleMemberRepo),
 			nil, // ConvertToAttendance は public API では使用しない
 		)
@@ -774,6 +992,73 @@ func NewRouter(dbPool *pgxpool.Pool) http.Handler {
 		r.With(RateLimitMiddleware(publicWriteRL)).Post("/{token}/responses", publicScheduleHandler.SubmitResponse)
 	})
 
+	// 公開シフト交換API（認証不要）
+	r.Route("/api/v1/public/shift-swaps", func(r chi.Router) {
+		publicSwapRequestRepo := db.NewSwapRequestRepository(dbPool)
+		publicSwapSlotRepo := db.NewShiftSlotRepository(dbPool)
+		publicSwapAssignmentRepo := db.NewShiftAssignmentRepository(dbPool)
+		publicSwapBusinessDayRepo := db.NewEventBusinessDayRepository(dbPool)
+		publicSwapMemberRepo := db.NewMemberRepository(dbPool)
+		publicSwapRequestHandler := NewSwapRequestHandler(
+			nil, // Create not needed for public handler
+			nil, // List not needed for public handler
+			appshift.NewGetSwapRequestByTokenUsecase(publicSwapRequestRepo, publicSwapAssignmentRepo, publicSwapSlotRepo, publicSwapBusinessDayRepo, publicSwapMemberRepo),
+			appshift.NewClaimSwapRequestUsecase(
+				publicSwapRequestRepo, publicSwapSlotRepo, publicSwapAssignmentRepo, publicSwapBusinessDayRepo,
+				db.NewEventRepository(dbPool), publicSwapMemberRepo, db.NewMemberRoleRepository(dbPool), db.NewMemberGroupRepository(dbPool),
+				db.NewMemberAvailabilityRepository(dbPool), db.NewWorkloadPolicyRepository(dbPool),
+				publicTxManager, publicClock,
+			),
+			nil, // Approve not needed for public handler
+			nil, // Reject not needed for public handler
+			nil, // Cancel not needed for public handler
+		)
+		r.With(RateLimitMiddleware(publicReadRL)).Get("/{token}", publicSwapRequestHandler.GetSwapRequestByToken)
+		r.With(RateLimitMiddleware(publicWriteRL)).Post("/{token}/claim", publicSwapRequestHandler.ClaimSwapRequest)
+	})
+
+	// Discord Bot API（Bot トークン認証、X-Discord-User-ID のメンバーとして操作する）
+	r.Route("/api/v1/bot", func(r chi.Router) {
+		botClock := &clock.RealClock{}
+		botTxManager := db.NewPgxTxManager(dbPool)
+		botTokenRepo := db.NewBotTokenRepository(dbPool)
+		botMemberRepo := db.NewMemberRepository(dbPool)
+		botMemberRoleRepo := db.NewMemberRoleRepository(dbPool)
+		botMemberGroupRepo := db.NewMemberGroupRepository(dbPool)
+		botEventRepo := db.NewEventRepository(dbPool)
+		botBusinessDayRepo := db.NewEventBusinessDayRepository(dbPool)
+		botSlotRepo := db.NewShiftSlotRepository(dbPool)
+		botAssignmentRepo := db.NewShiftAssignmentRepository(dbPool)
+		botAttendanceRepo := db.NewAttendanceRepository(dbPool)
+		botScheduleRepo := db.NewScheduleRepository(dbPool)
+
+		r.Use(BotAuth(apptenant.NewAuthenticateBotUsecase(botTokenRepo, botMemberRepo)))
+		r.Use(TenantStatusMiddleware(tenantRepo))
+		r.Use(BillingGuard(billingGuardDeps))
+
+		botConfirmAssignmentUC := appshift.NewConfirmManualAssignmentUsecase(
+			botSlotRepo, botAssignmentRepo, botMemberRepo, botMemberRoleRepo,
+			db.NewMemberAvailabilityRepository(dbPool), db.NewWorkloadPolicyRepository(dbPool),
+			botEventRepo, botBusinessDayRepo, db.NewNotificationOutboxRepository(dbPool),
+			botTxManager, botClock,
+		)
+		botHandler := NewBotHandler(
+			appshift.NewListUpcomingShiftsUsecase(botAssignmentRepo, botBusinessDayRepo, botEventRepo, tenantRepo, botClock),
+			appshift.NewListOpenSlotsUsecase(botEventRepo, botBusinessDayRepo, botSlotRepo, botAssignmentRepo, tenantRepo, botClock),
+			appshift.NewClaimOpenSlotUsecase(botSlotRepo, botBusinessDayRepo, botAssignmentRepo, tenantRepo, botConfirmAssignmentUC, botClock),
+			appattendance.NewListMemberOpenCollectionsUsecase(botAttendanceRepo, botMemberGroupRepo, botMemberRoleRepo, botClock),
+			appattendance.NewSubmitMemberResponseUsecase(botAttendanceRepo, botMemberGroupRepo, botMemberRoleRepo, botTxManager, botClock),
+			appschedule.NewListMemberOpenSchedulesUsecase(botScheduleRepo, botMemberGroupRepo, botClock),
+		)
+
+		r.Get("/shifts", botHandler.ListMyShifts)
+		r.Get("/open-slots", botHandler.ListOpenSlots)
+		r.Post("/open-slots/{slot_id}/claim", botHandler.ClaimOpenSlot)
+		r.Get("/attendance/collections", botHandler.ListOpenCollections)
+		r.Post("/attendance/collections/{collection_id}/responses", botHandler.SubmitCollectionResponse)
+		r.Get("/schedules", botHandler.ListOpenSchedules)
+	})
+
 	// 公開カレンダーAPI（認証不要）
 	r.Route("/api/v1/public/calendar", func(r chi.Router) {
 		publicCalendarRepo := db.NewCalendarRepository(dbPool)
@@ -131,7 +131,7 @@ type CreateScheduleRequest struct {
 	Description string             `json:"description"`
 	EventID     *string            `json:"event_id"`
 	Candidates  []CandidateRequest `json:"candidates"`
-	Deadline    *time.Time         `json:"deadline"`
+	Deadline    *string            `json:"deadline"`  // RFC3339、またはテナントのタイムゾーンでの日時・日付
 	GroupIDs    []string           `json:"group_ids"` // optional: target group IDs
 }
 
@@ -154,7 +154,7 @@ type CreateScheduleResponse struct {
 type UpdateScheduleRequest struct {
 	Title                         string             `json:"title"`
 	Description                   string             `json:"description"`
-	Deadline                      *time.Time         `json:"deadline"`
+	Deadline                      *string            `json:"deadline"` // RFC3339、またはテナントのタイムゾーンでの日時・日付
 	Candidates                    []CandidateRequest `json:"candidates"`
 	ForceDeleteCandidateResponses bool               `json:"force_delete_candidate_responses"`
 }
@@ -204,13 +204,19 @@ func (h *ScheduleHandler) CreateSchedule(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
+	deadline, err := parseDeadline(ctx, req.Deadline)
+	if err != nil {
+		RespondBadRequest(w, "invalid deadline format")
+		return
+	}
+
 	input := schedule.CreateScheduleInput{
 		TenantID:    tenantID.String(),
 		Title:       req.Title,
 		Description: req.Description,
 		EventID:     req.EventID,
 		Candidates:  candidates,
-		Deadline:    req.Deadline,
+		Deadline:    deadline,
 		GroupIDs:    req.GroupIDs,
 	}
 
@@ -253,6 +259,7 @@ type GetScheduleResponse struct {
 	PublicToken        string              `json:"public_token"`
 	Status             string              `json:"status"`
 	Deadline           *time.Time          `json:"deadline"`
+	Timezone           string              `json:"timezone,omitempty"` // Tenant timezone (public API only)
 	DecidedCandidateID *string             `json:"decided_candidate_id"`
 	Candidates         []CandidateResponse `json:"candidates"`
 	GroupIDs           []string            `json:"group_ids,omitempty"`
@@ -550,12 +557,18 @@ func (h *ScheduleHandler) UpdateSchedule(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
+	deadline, err := parseDeadline(ctx, req.Deadline)
+	if err != nil {
+		RespondBadRequest(w, "invalid deadline format")
+		return
+	}
+
 	output, err := h.updateScheduleUsecase.Execute(ctx, schedule.UpdateScheduleInput{
 		TenantID:                      tenantID.String(),
 		ScheduleID:                    scheduleID,
 		Title:                         req.Title,
 		Description:                   req.Description,
-		Deadline:                      req.Deadline,
+		Deadline:                      deadline,
 		Candidates:                    candidates,
 		ForceDeleteCandidateResponses: req.ForceDeleteCandidateResponses,
 	})
@@ -705,6 +718,7 @@ func (h *ScheduleHandler) GetScheduleByToken(w http.ResponseWriter, r *http.Requ
 		PublicToken:        output.PublicToken,
 		Status:             output.Status,
 		Deadline:           output.Deadline,
+		Timezone:           output.Timezone,
 		DecidedCandidateID: output.DecidedCandidateID,
 		Candidates:         candidates,
 		GroupIDs:           output.GroupIDs,
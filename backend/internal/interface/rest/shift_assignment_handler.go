@@ -2,12 +2,15 @@ package rest
 
 import (
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
 	"time"
 
 	appshift "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/shift"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
 	"github.com/go-chi/chi/v5"
 )
@@ -18,6 +21,7 @@ type ShiftAssignmentHandler struct {
 	getAssignmentsUC      *appshift.GetAssignmentsUsecase
 	getAssignmentDetailUC *appshift.GetAssignmentDetailUsecase
 	cancelAssignmentUC    *appshift.CancelAssignmentUsecase
+	autoAssignUC          *appshift.AutoAssignUsecase
 }
 
 // NewShiftAssignmentHandler creates a new ShiftAssignmentHandler with injected usecases
@@ -26,12 +30,14 @@ func NewShiftAssignmentHandler(
 	getAssignmentsUC *appshift.GetAssignmentsUsecase,
 	getAssignmentDetailUC *appshift.GetAssignmentDetailUsecase,
 	cancelAssignmentUC *appshift.CancelAssignmentUsecase,
+	autoAssignUC *appshift.AutoAssignUsecase,
 ) *ShiftAssignmentHandler {
 	return &ShiftAssignmentHandler{
 		confirmAssignmentUC:   confirmAssignmentUC,
 		getAssignmentsUC:      getAssignmentsUC,
 		getAssignmentDetailUC: getAssignmentDetailUC,
 		cancelAssignmentUC:    cancelAssignmentUC,
+		autoAssignUC:          autoAssignUC,
 	}
 }
 
@@ -40,27 +46,59 @@ type ConfirmAssignmentRequest struct {
 	SlotID   string `json:"slot_id"`
 	MemberID string `json:"member_id"`
 	Note     string `json:"note"`
+	Force    bool   `json:"force"` // 時間帯が重複する割り当てがあっても確定する
+	// OverrideWorkload は勤務量の上限超過（enforcement = block）があっても確定する
+	OverrideWorkload bool `json:"override_workload"`
+}
+
+// AssignmentConflictResponse represents an overlapping assignment in a conflict error
+type AssignmentConflictResponse struct {
+	AssignmentID  string `json:"assignment_id"`
+	SlotID        string `json:"slot_id"`
+	SlotName      string `json:"slot_name"`
+	BusinessDayID string `json:"business_day_id"`
+	StartAt       string `json:"start_at"`
+	EndAt         string `json:"end_at"`
 }
 
 // ShiftAssignmentResponse represents a shift assignment in API responses
 type ShiftAssignmentResponse struct {
-	AssignmentID        string  `json:"assignment_id"`
-	TenantID            string  `json:"tenant_id"`
-	SlotID              string  `json:"slot_id"`
-	MemberID            string  `json:"member_id"`
-	MemberDisplayName   string  `json:"member_display_name,omitempty"`
-	SlotName            string  `json:"slot_name,omitempty"`
-	TargetDate          string  `json:"target_date,omitempty"`
-	StartTime           string  `json:"start_time,omitempty"`
-	EndTime             string  `json:"end_time,omitempty"`
-	AssignmentStatus    string  `json:"assignment_status"`
-	AssignmentMethod    string  `json:"assignment_method"`
-	IsOutsidePreference bool    `json:"is_outside_preference"`
-	AssignedAt          string  `json:"assigned_at"`
-	CancelledAt         *string `json:"cancelled_at,omitempty"`
-	CreatedAt           string  `json:"created_at"`
-	UpdatedAt           string  `json:"updated_at"`
-	NotificationSent    bool    `json:"notification_sent"`
+	AssignmentID         string  `json:"assignment_id"`
+	TenantID             string  `json:"tenant_id"`
+	SlotID               string  `json:"slot_id"`
+	MemberID             string  `json:"member_id"`
+	MemberDisplayName    string  `json:"member_display_name,omitempty"`
+	SlotName             string  `json:"slot_name,omitempty"`
+	TargetDate           string  `json:"target_date,omitempty"`
+	StartTime            string  `json:"start_time,omitempty"`
+	EndTime              string  `json:"end_time,omitempty"`
+	AssignmentStatus     string  `json:"assignment_status"`
+	AssignmentMethod     string  `json:"assignment_method"`
+	IsOutsidePreference  bool    `json:"is_outside_preference"`
+	IsConflictOverridden bool    `json:"is_conflict_overridden"`
+	AssignedAt           string  `json:"assigned_at"`
+	CancelledAt          *string `json:"cancelled_at,omitempty"`
+	CreatedAt            string  `json:"created_at"`
+	UpdatedAt            string  `json:"updated_at"`
+	NotificationSent     bool    `json:"notification_sent"`
+	// Warnings は確定時の注意事項（推奨ロールの不足など）。確定時のレスポンスのみ
+	Warnings []AssignmentWarningResponse `json:"warnings,omitempty"`
+	// OverriddenWorkloadLimits は override_workload により上書きした勤務量の上限。確定時のレスポンスのみ
+	OverriddenWorkloadLimits []WorkloadViolationResponse `json:"overridden_workload_limits,omitempty"`
+}
+
+// AssignmentWarningResponse represents a non-blocking warning returned when confirming an assignment
+type AssignmentWarningResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// RoleShortfallResponse represents an unmet role requirement of a slot
+type RoleShortfallResponse struct {
+	RoleID string `json:"role_id"`
+	Kind   string `json:"kind"`
+	Count  int    `json:"count"`
+	Filled int    `json:"filled"`
 }
 
 // ConfirmAssignment handles POST /api/v1/shift-assignments
@@ -121,33 +159,27 @@ func (h *ShiftAssignmentHandler) ConfirmAssignment(w http.ResponseWriter, r *htt
 
 	// Execute usecase
 	input := appshift.ConfirmManualAssignmentInput{
-		TenantID: tenantID,
-		SlotID:   slotID,
-		MemberID: memberID,
-		ActorID:  actorID,
-		Note:     req.Note,
+		TenantID:         tenantID,
+		SlotID:           slotID,
+		MemberID:         memberID,
+		ActorID:          actorID,
+		Note:             req.Note,
+		Force:            req.Force,
+		OverrideWorkload: req.OverrideWorkload,
 	}
 
-	assignment, err := h.confirmAssignmentUC.Execute(ctx, input)
+	result, err := h.confirmAssignmentUC.Execute(ctx, input)
 	if err != nil {
 		log.Printf("ConfirmAssignment error: %+v", err)
-		// Handle domain errors
-		if domainErr, ok := err.(*common.DomainError); ok {
-			switch domainErr.Code() {
-			case common.ErrConflict:
-				writeError(w, http.StatusConflict, "ERR_SLOT_FULL", domainErr.Error(), nil)
-				return
-			case common.ErrNotFound:
-				writeError(w, http.StatusNotFound, "ERR_NOT_FOUND", domainErr.Error(), nil)
-				return
-			case common.ErrInvalidInput:
-				writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", domainErr.Error(), nil)
-				return
-			}
-		}
-		writeError(w, http.StatusInternalServerError, "ERR_INTERNAL", "Failed to confirm shift assignment", nil)
+		respondConfirmAssignmentError(w, err)
 		return
 	}
+	assignment := result.Assignment
+	warnings := toAssignmentWarningResponses(result.Warnings)
+	var overridden []WorkloadViolationResponse
+	if len(result.OverriddenWorkloadLimits) > 0 {
+		overridden = toWorkloadViolationResponses(result.OverriddenWorkloadLimits)
+	}
 
 	// Get assignment details with JOIN data
 	detailInput := appshift.GetAssignmentDetailInput{
@@ -159,27 +191,113 @@ func (h *ShiftAssignmentHandler) ConfirmAssignment(w http.ResponseWriter, r *htt
 	if err != nil {
 		// If JOIN fails, return minimal response
 		resp := &ShiftAssignmentResponse{
-			AssignmentID:        assignment.AssignmentID().String(),
-			TenantID:            assignment.TenantID().String(),
-			SlotID:              assignment.SlotID().String(),
-			MemberID:            assignment.MemberID().String(),
-			AssignmentStatus:    "confirmed",
-			AssignmentMethod:    "manual",
-			IsOutsidePreference: assignment.IsOutsidePreference(),
-			AssignedAt:          assignment.AssignedAt().Format(time.RFC3339),
-			CreatedAt:           assignment.CreatedAt().Format(time.RFC3339),
-			UpdatedAt:           assignment.UpdatedAt().Format(time.RFC3339),
-			NotificationSent:    false,
+			AssignmentID:         assignment.AssignmentID().String(),
+			TenantID:             assignment.TenantID().String(),
+			SlotID:               assignment.SlotID().String(),
+			MemberID:             assignment.MemberID().String(),
+			AssignmentStatus:     "confirmed",
+			AssignmentMethod:     "manual",
+			IsOutsidePreference:  assignment.IsOutsidePreference(),
+			IsConflictOverridden: assignment.IsConflictOverridden(),
+			AssignedAt:           assignment.AssignedAt().Format(time.RFC3339),
+			CreatedAt:            assignment.CreatedAt().Format(time.RFC3339),
+			UpdatedAt:            assignment.UpdatedAt().Format(time.RFC3339),
+			NotificationSent:     false,
+			Warnings:             warnings,
 		}
+		resp.OverriddenWorkloadLimits = overridden
 		writeSuccess(w, http.StatusCreated, resp)
 		return
 	}
 
 	// Build full response with JOIN data
 	resp := buildAssignmentResponse(details)
+	resp.Warnings = warnings
+	resp.OverriddenWorkloadLimits = overridden
 	writeSuccess(w, http.StatusCreated, resp)
 }
 
+// respondConfirmAssignmentError writes the error response of confirming an assignment
+func respondConfirmAssignmentError(w http.ResponseWriter, err error) {
+	// 必須ロールを満たせなくなる割り当て（force でも上書き不可）
+	var roleErr *shift.RoleRequirementError
+	if errors.As(err, &roleErr) {
+		shortfalls := make([]RoleShortfallResponse, 0, len(roleErr.Shortfalls))
+		for _, sf := range roleErr.Shortfalls {
+			shortfalls = append(shortfalls, RoleShortfallResponse{
+				RoleID: sf.RoleID.String(),
+				Kind:   string(sf.Kind),
+				Count:  sf.Count,
+				Filled: sf.Filled,
+			})
+		}
+		writeError(w, http.StatusConflict, "ERR_ROLE_REQUIREMENT", roleErr.Error(), map[string]interface{}{
+			"shortfalls": shortfalls,
+		})
+		return
+	}
+	// 時間帯の重複（force で上書き可能）
+	var conflictErr *shift.AssignmentConflictError
+	if errors.As(err, &conflictErr) {
+		conflicts := make([]AssignmentConflictResponse, 0, len(conflictErr.Conflicts))
+		for _, c := range conflictErr.Conflicts {
+			conflicts = append(conflicts, AssignmentConflictResponse{
+				AssignmentID:  c.AssignmentID.String(),
+				SlotID:        c.SlotID.String(),
+				SlotName:      c.SlotName,
+				BusinessDayID: c.BusinessDayID.String(),
+				StartAt:       c.StartAt.Format(time.RFC3339),
+				EndAt:         c.EndAt.Format(time.RFC3339),
+			})
+		}
+		writeError(w, http.StatusConflict, "ERR_ASSIGNMENT_CONFLICT", conflictErr.Error(), map[string]interface{}{
+			"conflicts": conflicts,
+		})
+		return
+	}
+	// 勤務量の上限超過（enforcement = block、override_workload で上書き可能）
+	var workloadErr *member.WorkloadLimitError
+	if errors.As(err, &workloadErr) {
+		writeError(w, http.StatusConflict, "ERR_WORKLOAD_LIMIT", workloadErr.Error(), map[string]interface{}{
+			"violations": toWorkloadViolationResponses(workloadErr.Violations),
+		})
+		return
+	}
+	// Handle domain errors
+	if domainErr, ok := err.(*common.DomainError); ok {
+		switch domainErr.Code() {
+		case common.ErrConflict:
+			if errors.Is(err, shift.ErrSlotFull) {
+				writeError(w, http.StatusConflict, "ERR_SLOT_FULL", domainErr.Error(), nil)
+			} else {
+				writeError(w, http.StatusConflict, "ERR_CONFLICT", domainErr.Error(), nil)
+			}
+			return
+		case common.ErrNotFound:
+			writeError(w, http.StatusNotFound, "ERR_NOT_FOUND", domainErr.Error(), nil)
+			return
+		case common.ErrInvalidInput:
+			writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", domainErr.Error(), nil)
+			return
+		}
+	}
+	writeError(w, http.StatusInternalServerError, "ERR_INTERNAL", "Failed to confirm shift assignment", nil)
+}
+
+func toAssignmentWarningResponses(warnings []appshift.AssignmentWarning) []AssignmentWarningResponse {
+	if len(warnings) == 0 {
+		return nil
+	}
+	responses := make([]AssignmentWarningResponse, 0, len(warnings))
+	for _, w := range warnings {
+		responses = append(responses, AssignmentWarningResponse{
+			Code:    w.Code,
+			Message: w.Message,
+		})
+	}
+	return responses
+}
+
 // GetAssignments handles GET /api/v1/shift-assignments
 func (h *ShiftAssignmentHandler) GetAssignments(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -320,23 +438,24 @@ func buildAssignmentResponse(details *appshift.AssignmentWithDetails) ShiftAssig
 	}
 
 	return ShiftAssignmentResponse{
-		AssignmentID:        details.Assignment.AssignmentID().String(),
-		TenantID:            details.Assignment.TenantID().String(),
-		SlotID:              details.Assignment.SlotID().String(),
-		MemberID:            details.Assignment.MemberID().String(),
-		MemberDisplayName:   details.MemberDisplayName,
-		SlotName:            details.SlotName,
-		TargetDate:          details.TargetDate.Format("2006-01-02"),
-		StartTime:           details.StartTime.Format("15:04:05"),
-		EndTime:             details.EndTime.Format("15:04:05"),
-		AssignmentStatus:    map[bool]string{true: "cancelled", false: "confirmed"}[details.Assignment.IsCancelled()],
-		AssignmentMethod:    string(details.Assignment.AssignmentMethod()),
-		IsOutsidePreference: details.Assignment.IsOutsidePreference(),
-		AssignedAt:          details.Assignment.AssignedAt().Format(time.RFC3339),
-		CancelledAt:         cancelledAtStr,
-		CreatedAt:           details.Assignment.CreatedAt().Format(time.RFC3339),
-		UpdatedAt:           details.Assignment.UpdatedAt().Format(time.RFC3339),
-		NotificationSent:    false, // stub
+		AssignmentID:         details.Assignment.AssignmentID().String(),
+		TenantID:             details.Assignment.TenantID().String(),
+		SlotID:               details.Assignment.SlotID().String(),
+		MemberID:             details.Assignment.MemberID().String(),
+		MemberDisplayName:    details.MemberDisplayName,
+		SlotName:             details.SlotName,
+		TargetDate:           details.TargetDate.Format("2006-01-02"),
+		StartTime:            details.StartTime.Format("15:04:05"),
+		EndTime:              details.EndTime.Format("15:04:05"),
+		AssignmentStatus:     map[bool]string{true: "cancelled", false: "confirmed"}[details.Assignment.IsCancelled()],
+		AssignmentMethod:     string(details.Assignment.AssignmentMethod()),
+		IsOutsidePreference:  details.Assignment.IsOutsidePreference(),
+		IsConflictOverridden: details.Assignment.IsConflictOverridden(),
+		AssignedAt:           details.Assignment.AssignedAt().Format(time.RFC3339),
+		CancelledAt:          cancelledAtStr,
+		CreatedAt:            details.Assignment.CreatedAt().Format(time.RFC3339),
+		UpdatedAt:            details.Assignment.UpdatedAt().Format(time.RFC3339),
+		NotificationSent:     false, // stub
 	}
 }
 
@@ -372,13 +491,109 @@ func (h *ShiftAssignmentHandler) CancelAssignment(w http.ResponseWriter, r *http
 
 	err = h.cancelAssignmentUC.Execute(ctx, input)
 	if err != nil {
-		if err.Error() == "shift assignment not found" {
+		if err.Error() == "shift assignment not found" || common.IsNotFoundError(err) {
 			writeError(w, http.StatusNotFound, "ERR_NOT_FOUND", "Shift assignment not found", nil)
 			return
 		}
+		log.Printf("CancelAssignment error: %+v", err)
 		writeError(w, http.StatusInternalServerError, "ERR_INTERNAL", "Failed to delete shift assignment", nil)
 		return
 	}
 
 	writeSuccess(w, http.StatusNoContent, nil)
 }
+
+// AutoAssignResponse represents the result of automatic shift assignment
+type AutoAssignResponse struct {
+	BusinessDayID string                    `json:"business_day_id"`
+	Assignments   []ShiftAssignmentResponse `json:"assignments"`
+	AssignedCount int                       `json:"assigned_count"`
+	UnfilledSlots []UnfilledSlotResponse    `json:"unfilled_slots"`
+}
+
+// UnfilledSlotResponse represents a slot that could not be filled
+type UnfilledSlotResponse struct {
+	SlotID        string `json:"slot_id"`
+	SlotName      string `json:"slot_name"`
+	RequiredCount int    `json:"required_count"`
+	AssignedCount int    `json:"assigned_count"`
+}
+
+// AutoAssign handles POST /api/v1/business-days/:business_day_id/auto-assign
+func (h *ShiftAssignmentHandler) AutoAssign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// テナントIDの取得
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	// アクター（操作者）IDの取得
+	var actorID common.MemberID
+	if adminID, ok := GetAdminIDFromContext(ctx); ok {
+		actorID = common.MemberID(adminID)
+	} else if memberID, ok := getMemberIDFromContext(ctx); ok {
+		actorID = memberID
+	} else {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Member ID or Admin ID is required", nil)
+		return
+	}
+
+	businessDayIDStr := chi.URLParam(r, "business_day_id")
+	if businessDayIDStr == "" {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "business_day_id is required", nil)
+		return
+	}
+
+	businessDayID, err := event.ParseBusinessDayID(businessDayIDStr)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid business_day_id format", nil)
+		return
+	}
+
+	output, err := h.autoAssignUC.Execute(ctx, appshift.AutoAssignInput{
+		TenantID:      tenantID,
+		BusinessDayID: businessDayID,
+		ActorID:       actorID,
+	})
+	if err != nil {
+		log.Printf("AutoAssign error: %+v", err)
+		RespondDomainError(w, err)
+		return
+	}
+
+	assignments := make([]ShiftAssignmentResponse, 0, len(output.Assignments))
+	for _, a := range output.Assignments {
+		assignments = append(assignments, ShiftAssignmentResponse{
+			AssignmentID:        a.AssignmentID().String(),
+			TenantID:            a.TenantID().String(),
+			SlotID:              a.SlotID().String(),
+			MemberID:            a.MemberID().String(),
+			AssignmentStatus:    string(a.AssignmentStatus()),
+			AssignmentMethod:    string(a.AssignmentMethod()),
+			IsOutsidePreference: a.IsOutsidePreference(),
+			AssignedAt:          a.AssignedAt().Format(time.RFC3339),
+			CreatedAt:           a.CreatedAt().Format(time.RFC3339),
+			UpdatedAt:           a.UpdatedAt().Format(time.RFC3339),
+		})
+	}
+
+	unfilled := make([]UnfilledSlotResponse, 0, len(output.UnfilledSlots))
+	for _, u := range output.UnfilledSlots {
+		unfilled = append(unfilled, UnfilledSlotResponse{
+			SlotID:        u.SlotID.String(),
+			SlotName:      u.SlotName,
+			RequiredCount: u.RequiredCount,
+			AssignedCount: u.AssignedCount,
+		})
+	}
+
+	writeSuccess(w, http.StatusOK, AutoAssignResponse{
+		BusinessDayID: output.BusinessDayID.String(),
+		Assignments:   assignments,
+		AssignedCount: len(assignments),
+		UnfilledSlots: unfilled,
+	})
+}
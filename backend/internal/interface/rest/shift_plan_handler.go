@@ -0,0 +1,492 @@
+package rest
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	appshift "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/go-chi/chi/v5"
+)
+
+// ShiftPlanHandler handles shift plan (draft / publish) HTTP requests
+type ShiftPlanHandler struct {
+	createPlanUC       *appshift.CreateShiftPlanUsecase
+	listPlansUC        *appshift.ListShiftPlansUsecase
+	getPlanUC          *appshift.GetShiftPlanUsecase
+	deletePlanUC       *appshift.DeleteShiftPlanUsecase
+	addAssignmentUC    *appshift.AddPlanAssignmentUsecase
+	removeAssignmentUC *appshift.RemovePlanAssignmentUsecase
+	diffPlanUC         *appshift.DiffShiftPlanUsecase
+	publishPlanUC      *appshift.PublishShiftPlanUsecase
+}
+
+// NewShiftPlanHandler creates a new ShiftPlanHandler with injected usecases
+func NewShiftPlanHandler(
+	createPlanUC *appshift.CreateShiftPlanUsecase,
+	listPlansUC *appshift.ListShiftPlansUsecase,
+	getPlanUC *appshift.GetShiftPlanUsecase,
+	deletePlanUC *appshift.DeleteShiftPlanUsecase,
+	addAssignmentUC *appshift.AddPlanAssignmentUsecase,
+	removeAssignmentUC *appshift.RemovePlanAssignmentUsecase,
+	diffPlanUC *appshift.DiffShiftPlanUsecase,
+	publishPlanUC *appshift.PublishShiftPlanUsecase,
+) *ShiftPlanHandler {
+	return &ShiftPlanHandler{
+		createPlanUC:       createPlanUC,
+		listPlansUC:        listPlansUC,
+		getPlanUC:          getPlanUC,
+		deletePlanUC:       deletePlanUC,
+		addAssignmentUC:    addAssignmentUC,
+		removeAssignmentUC: removeAssignmentUC,
+		diffPlanUC:         diffPlanUC,
+		publishPlanUC:      publishPlanUC,
+	}
+}
+
+// CreateShiftPlanRequest represents the request body for creating a draft shift plan
+type CreateShiftPlanRequest struct {
+	EventID           string  `json:"event_id"`
+	BusinessDayID     *string `json:"business_day_id,omitempty"`
+	PeriodStart       *string `json:"period_start,omitempty"` // YYYY-MM-DD
+	PeriodEnd         *string `json:"period_end,omitempty"`   // YYYY-MM-DD
+	PlanName          string  `json:"plan_name"`
+	CopyFromPublished bool    `json:"copy_from_published"`
+}
+
+// AddPlanAssignmentRequest represents the request body for adding an assignment to a draft plan
+type AddPlanAssignmentRequest struct {
+	SlotID   string `json:"slot_id"`
+	MemberID string `json:"member_id"`
+}
+
+// ShiftPlanResponse represents a shift plan in API responses
+type ShiftPlanResponse struct {
+	PlanID        string  `json:"plan_id"`
+	TenantID      string  `json:"tenant_id"`
+	EventID       string  `json:"event_id"`
+	BusinessDayID *string `json:"business_day_id,omitempty"`
+	PeriodStart   *string `json:"period_start,omitempty"`
+	PeriodEnd     *string `json:"period_end,omitempty"`
+	PlanName      string  `json:"plan_name"`
+	PlanStatus    string  `json:"plan_status"`
+	PublishedAt   *string `json:"published_at,omitempty"`
+	ArchivedAt    *string `json:"archived_at,omitempty"`
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+}
+
+// ShiftPlanDetailResponse represents a shift plan with its assignments
+type ShiftPlanDetailResponse struct {
+	ShiftPlanResponse
+	Assignments []ShiftAssignmentResponse `json:"assignments"`
+}
+
+// ShiftPlanDiffResponse represents the diff between a plan and the published plan
+type ShiftPlanDiffResponse struct {
+	Plan          ShiftPlanResponse         `json:"plan"`
+	PublishedPlan *ShiftPlanResponse        `json:"published_plan,omitempty"`
+	Added         []ShiftAssignmentResponse `json:"added"`
+	Removed       []ShiftAssignmentResponse `json:"removed"`
+	Unchanged     []ShiftAssignmentResponse `json:"unchanged"`
+}
+
+// PublishShiftPlanResponse represents the result of publishing a plan
+type PublishShiftPlanResponse struct {
+	Plan         ShiftPlanResponse  `json:"plan"`
+	ArchivedPlan *ShiftPlanResponse `json:"archived_plan,omitempty"`
+}
+
+// CreateShiftPlan handles POST /api/v1/shift-plans
+func (h *ShiftPlanHandler) CreateShiftPlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	var req CreateShiftPlanRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body", nil)
+		return
+	}
+
+	eventID, err := common.ParseEventID(req.EventID)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid event_id format", nil)
+		return
+	}
+
+	input := appshift.CreateShiftPlanInput{
+		TenantID:          tenantID,
+		EventID:           eventID,
+		PlanName:          req.PlanName,
+		CopyFromPublished: req.CopyFromPublished,
+	}
+
+	if req.BusinessDayID != nil && *req.BusinessDayID != "" {
+		businessDayID, err := event.ParseBusinessDayID(*req.BusinessDayID)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid business_day_id format", nil)
+			return
+		}
+		input.BusinessDayID = &businessDayID
+	}
+
+	if req.PeriodStart != nil {
+		periodStart, err := time.Parse("2006-01-02", *req.PeriodStart)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid period_start format (expected YYYY-MM-DD)", nil)
+			return
+		}
+		input.PeriodStart = &periodStart
+	}
+
+	if req.PeriodEnd != nil {
+		periodEnd, err := time.Parse("2006-01-02", *req.PeriodEnd)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid period_end format (expected YYYY-MM-DD)", nil)
+			return
+		}
+		input.PeriodEnd = &periodEnd
+	}
+
+	plan, err := h.createPlanUC.Execute(ctx, input)
+	if err != nil {
+		log.Printf("CreateShiftPlan error: %+v", err)
+		RespondDomainError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, toShiftPlanResponse(plan))
+}
+
+// ListShiftPlans handles GET /api/v1/shift-plans?event_id=xxx
+func (h *ShiftPlanHandler) ListShiftPlans(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	eventID, err := common.ParseEventID(r.URL.Query().Get("event_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid event_id format", nil)
+		return
+	}
+
+	plans, err := h.listPlansUC.Execute(ctx, appshift.ListShiftPlansInput{
+		TenantID: tenantID,
+		EventID:  eventID,
+	})
+	if err != nil {
+		log.Printf("ListShiftPlans error: %+v", err)
+		RespondDomainError(w, err)
+		return
+	}
+
+	responses := make([]ShiftPlanResponse, 0, len(plans))
+	for _, p := range plans {
+		responses = append(responses, toShiftPlanResponse(p))
+	}
+
+	writeSuccess(w, http.StatusOK, map[string]interface{}{
+		"plans": responses,
+		"count": len(responses),
+	})
+}
+
+// GetShiftPlan handles GET /api/v1/shift-plans/{plan_id}
+func (h *ShiftPlanHandler) GetShiftPlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	planID, err := shift.ParsePlanID(chi.URLParam(r, "plan_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid plan_id format", nil)
+		return
+	}
+
+	output, err := h.getPlanUC.Execute(ctx, appshift.GetShiftPlanInput{
+		TenantID: tenantID,
+		PlanID:   planID,
+	})
+	if err != nil {
+		log.Printf("GetShiftPlan error: %+v", err)
+		RespondDomainError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, ShiftPlanDetailResponse{
+		ShiftPlanResponse: toShiftPlanResponse(output.Plan),
+		Assignments:       toPlanAssignmentResponses(output.Assignments),
+	})
+}
+
+// DeleteShiftPlan handles DELETE /api/v1/shift-plans/{plan_id}
+func (h *ShiftPlanHandler) DeleteShiftPlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	planID, err := shift.ParsePlanID(chi.URLParam(r, "plan_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid plan_id format", nil)
+		return
+	}
+
+	if err := h.deletePlanUC.Execute(ctx, appshift.DeleteShiftPlanInput{
+		TenantID: tenantID,
+		PlanID:   planID,
+	}); err != nil {
+		log.Printf("DeleteShiftPlan error: %+v", err)
+		RespondDomainError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AddPlanAssignment handles POST /api/v1/shift-plans/{plan_id}/assignments
+func (h *ShiftPlanHandler) AddPlanAssignment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	planID, err := shift.ParsePlanID(chi.URLParam(r, "plan_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid plan_id format", nil)
+		return
+	}
+
+	var req AddPlanAssignmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body", nil)
+		return
+	}
+
+	slotID, err := shift.ParseSlotID(req.SlotID)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid slot_id format", nil)
+		return
+	}
+
+	memberID, err := common.ParseMemberID(req.MemberID)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid member_id format", nil)
+		return
+	}
+
+	assignment, err := h.addAssignmentUC.Execute(ctx, appshift.AddPlanAssignmentInput{
+		TenantID: tenantID,
+		PlanID:   planID,
+		SlotID:   slotID,
+		MemberID: memberID,
+	})
+	if err != nil {
+		log.Printf("AddPlanAssignment error: %+v", err)
+		RespondDomainError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, toPlanAssignmentResponse(assignment))
+}
+
+// RemovePlanAssignment handles DELETE /api/v1/shift-plans/{plan_id}/assignments/{assignment_id}
+func (h *ShiftPlanHandler) RemovePlanAssignment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	planID, err := shift.ParsePlanID(chi.URLParam(r, "plan_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid plan_id format", nil)
+		return
+	}
+
+	assignmentID, err := shift.ParseAssignmentID(chi.URLParam(r, "assignment_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid assignment_id format", nil)
+		return
+	}
+
+	if err := h.removeAssignmentUC.Execute(ctx, appshift.RemovePlanAssignmentInput{
+		TenantID:     tenantID,
+		PlanID:       planID,
+		AssignmentID: assignmentID,
+	}); err != nil {
+		log.Printf("RemovePlanAssignment error: %+v", err)
+		RespondDomainError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DiffShiftPlan handles GET /api/v1/shift-plans/{plan_id}/diff
+func (h *ShiftPlanHandler) DiffShiftPlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	planID, err := shift.ParsePlanID(chi.URLParam(r, "plan_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid plan_id format", nil)
+		return
+	}
+
+	diff, err := h.diffPlanUC.Execute(ctx, appshift.DiffShiftPlanInput{
+		TenantID: tenantID,
+		PlanID:   planID,
+	})
+	if err != nil {
+		log.Printf("DiffShiftPlan error: %+v", err)
+		RespondDomainError(w, err)
+		return
+	}
+
+	resp := ShiftPlanDiffResponse{
+		Plan:      toShiftPlanResponse(diff.Plan),
+		Added:     toPlanAssignmentResponses(diff.Added),
+		Removed:   toPlanAssignmentResponses(diff.Removed),
+		Unchanged: toPlanAssignmentResponses(diff.Unchanged),
+	}
+	if diff.PublishedPlan != nil {
+		published := toShiftPlanResponse(diff.PublishedPlan)
+		resp.PublishedPlan = &published
+	}
+
+	writeSuccess(w, http.StatusOK, resp)
+}
+
+// PublishShiftPlan handles POST /api/v1/shift-plans/{plan_id}/publish
+func (h *ShiftPlanHandler) PublishShiftPlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	// アクター（操作者）IDの取得
+	var actorID common.MemberID
+	if adminID, ok := GetAdminIDFromContext(ctx); ok {
+		actorID = common.MemberID(adminID)
+	} else if memberID, ok := getMemberIDFromContext(ctx); ok {
+		actorID = memberID
+	} else {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Member ID or Admin ID is required", nil)
+		return
+	}
+
+	planID, err := shift.ParsePlanID(chi.URLParam(r, "plan_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid plan_id format", nil)
+		return
+	}
+
+	output, err := h.publishPlanUC.Execute(ctx, appshift.PublishShiftPlanInput{
+		TenantID: tenantID,
+		PlanID:   planID,
+		ActorID:  actorID,
+	})
+	if err != nil {
+		log.Printf("PublishShiftPlan error: %+v", err)
+		RespondDomainError(w, err)
+		return
+	}
+
+	resp := PublishShiftPlanResponse{Plan: toShiftPlanResponse(output.Plan)}
+	if output.ArchivedPlan != nil {
+		archived := toShiftPlanResponse(output.ArchivedPlan)
+		resp.ArchivedPlan = &archived
+	}
+
+	writeSuccess(w, http.StatusOK, resp)
+}
+
+func toShiftPlanResponse(p *shift.ShiftPlan) ShiftPlanResponse {
+	resp := ShiftPlanResponse{
+		PlanID:     p.PlanID().String(),
+		TenantID:   p.TenantID().String(),
+		EventID:    p.EventID().String(),
+		PlanName:   p.PlanName(),
+		PlanStatus: string(p.PlanStatus()),
+		CreatedAt:  p.CreatedAt().Format(time.RFC3339),
+		UpdatedAt:  p.UpdatedAt().Format(time.RFC3339),
+	}
+	if p.BusinessDayID() != nil {
+		s := p.BusinessDayID().String()
+		resp.BusinessDayID = &s
+	}
+	if p.PeriodStart() != nil {
+		s := p.PeriodStart().Format("2006-01-02")
+		resp.PeriodStart = &s
+	}
+	if p.PeriodEnd() != nil {
+		s := p.PeriodEnd().Format("2006-01-02")
+		resp.PeriodEnd = &s
+	}
+	if p.PublishedAt() != nil {
+		s := p.PublishedAt().Format(time.RFC3339)
+		resp.PublishedAt = &s
+	}
+	if p.ArchivedAt() != nil {
+		s := p.ArchivedAt().Format(time.RFC3339)
+		resp.ArchivedAt = &s
+	}
+	return resp
+}
+
+func toPlanAssignmentResponse(a *shift.ShiftAssignment) ShiftAssignmentResponse {
+	return ShiftAssignmentResponse{
+		AssignmentID:        a.AssignmentID().String(),
+		TenantID:            a.TenantID().String(),
+		SlotID:              a.SlotID().String(),
+		MemberID:            a.MemberID().String(),
+		AssignmentStatus:    string(a.AssignmentStatus()),
+		AssignmentMethod:    string(a.AssignmentMethod()),
+		IsOutsidePreference: a.IsOutsidePreference(),
+		AssignedAt:          a.AssignedAt().Format(time.RFC3339),
+		CreatedAt:           a.CreatedAt().Format(time.RFC3339),
+		UpdatedAt:           a.UpdatedAt().Format(time.RFC3339),
+	}
+}
+
+func toPlanAssignmentResponses(assignments []*shift.ShiftAssignment) []ShiftAssignmentResponse {
+	responses := make([]ShiftAssignmentResponse, 0, len(assignments))
+	for _, a := range assignments {
+		responses = append(responses, toPlanAssignmentResponse(a))
+	}
+	return responses
+}
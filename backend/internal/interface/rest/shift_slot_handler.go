@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	appshift "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/event"
 	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
 	"github.com/go-chi/chi/v5"
@@ -46,6 +47,23 @@ type CreateShiftSlotRequest struct {
 	EndTime       string  `json:"end_time"`   // HH:MM
 	RequiredCount int     `json:"required_count"`
 	Priority      int     `json:"priority"`
+	DayOffset     *int    `json:"day_offset,omitempty"` // optional - 営業日の target_date から枠の開始日までの日数（省略時は開始時刻から判定）
+	// RoleRequirements は枠に必要/推奨されるロール（任意）
+	RoleRequirements []RoleRequirementRequest `json:"role_requirements,omitempty"`
+}
+
+// RoleRequirementRequest represents a role requirement of a shift slot or template item
+type RoleRequirementRequest struct {
+	RoleID string `json:"role_id"`
+	Kind   string `json:"kind"`  // required | preferred（省略時は required）
+	Count  int    `json:"count"` // 省略時は 1
+}
+
+// RoleRequirementResponse represents a role requirement in API responses
+type RoleRequirementResponse struct {
+	RoleID string `json:"role_id"`
+	Kind   string `json:"kind"`
+	Count  int    `json:"count"`
 }
 
 // ShiftSlotResponse represents a shift slot in API responses
@@ -56,6 +74,7 @@ type ShiftSlotResponse struct {
 	SlotName      string  `json:"slot_name"`
 	InstanceName  string  `json:"instance_name"`
 	InstanceID    *string `json:"instance_id,omitempty"` // インスタンスへの参照（FK）
+	TemplateID    *string `json:"template_id,omitempty"` // 生成元テンプレート（手動作成の場合は省略）
 	StartTime     string  `json:"start_time"`
 	EndTime       string  `json:"end_time"`
 	RequiredCount int     `json:"required_count"`
@@ -64,6 +83,12 @@ type ShiftSlotResponse struct {
 	IsOvernight   bool    `json:"is_overnight"`
 	CreatedAt     string  `json:"created_at"`
 	UpdatedAt     string  `json:"updated_at"`
+
+	// 深夜営業・複数日営業で日付変更後に始まる枠は day_offset が 1 以上になる
+	DayOffset       int `json:"day_offset"`
+	DurationMinutes int `json:"duration_minutes"`
+
+	RoleRequirements []RoleRequirementResponse `json:"role_requirements"`
 }
 
 // CreateShiftSlot handles POST /api/v1/business-days/:business_day_id/shift-slots
@@ -142,6 +167,12 @@ func (h *ShiftSlotHandler) CreateShiftSlot(w http.ResponseWriter, r *http.Reques
 		instanceID = &parsedID
 	}
 
+	roleRequirements, err := parseRoleRequirementRequests(req.RoleRequirements)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid role_id format in role_requirements", nil)
+		return
+	}
+
 	// Usecaseの実行
 	// Priority のデフォルト値はユースケース層で設定される
 	input := appshift.CreateShiftSlotInput{
@@ -154,6 +185,9 @@ func (h *ShiftSlotHandler) CreateShiftSlot(w http.ResponseWriter, r *http.Reques
 		EndTime:       endTime,
 		RequiredCount: req.RequiredCount,
 		Priority:      req.Priority,
+		DayOffset:     req.DayOffset,
+
+		RoleRequirements: roleRequirements,
 	}
 
 	newSlot, err := h.createShiftSlotUC.Execute(ctx, input)
@@ -177,11 +211,20 @@ func (h *ShiftSlotHandler) CreateShiftSlot(w http.ResponseWriter, r *http.Reques
 		IsOvernight:   newSlot.IsOvernight(),
 		CreatedAt:     newSlot.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:     newSlot.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+
+		DayOffset:       newSlot.DayOffset(),
+		DurationMinutes: int(newSlot.Duration().Minutes()),
+
+		RoleRequirements: toRoleRequirementResponses(newSlot.RoleRequirements()),
 	}
 	if newSlot.InstanceID() != nil {
 		instanceIDStr := newSlot.InstanceID().String()
 		resp.InstanceID = &instanceIDStr
 	}
+	if newSlot.TemplateID() != nil {
+		templateIDStr := newSlot.TemplateID().String()
+		resp.TemplateID = &templateIDStr
+	}
 
 	writeSuccess(w, http.StatusCreated, resp)
 }
@@ -239,11 +282,20 @@ func (h *ShiftSlotHandler) GetShiftSlots(w http.ResponseWriter, r *http.Request)
 			IsOvernight:   s.Slot.IsOvernight(),
 			CreatedAt:     s.Slot.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
 			UpdatedAt:     s.Slot.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+
+			DayOffset:       s.Slot.DayOffset(),
+			DurationMinutes: int(s.Slot.Duration().Minutes()),
+
+			RoleRequirements: toRoleRequirementResponses(s.Slot.RoleRequirements()),
 		}
 		if s.Slot.InstanceID() != nil {
 			instanceIDStr := s.Slot.InstanceID().String()
 			resp.InstanceID = &instanceIDStr
 		}
+		if s.Slot.TemplateID() != nil {
+			templateIDStr := s.Slot.TemplateID().String()
+			resp.TemplateID = &templateIDStr
+		}
 		slotResponses = append(slotResponses, resp)
 	}
 
@@ -304,11 +356,20 @@ func (h *ShiftSlotHandler) GetShiftSlotDetail(w http.ResponseWriter, r *http.Req
 		IsOvernight:   result.Slot.IsOvernight(),
 		CreatedAt:     result.Slot.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:     result.Slot.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+
+		DayOffset:       result.Slot.DayOffset(),
+		DurationMinutes: int(result.Slot.Duration().Minutes()),
+
+		RoleRequirements: toRoleRequirementResponses(result.Slot.RoleRequirements()),
 	}
 	if result.Slot.InstanceID() != nil {
 		instanceIDStr := result.Slot.InstanceID().String()
 		resp.InstanceID = &instanceIDStr
 	}
+	if result.Slot.TemplateID() != nil {
+		templateIDStr := result.Slot.TemplateID().String()
+		resp.TemplateID = &templateIDStr
+	}
 
 	writeSuccess(w, http.StatusOK, resp)
 }
@@ -471,3 +532,37 @@ func (h *ShiftSlotHandler) DeleteSlotsByInstance(w http.ResponseWriter, r *http.
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// parseRoleRequirementRequests converts role requirement requests to usecase inputs
+// kind の値はドメイン層で検証する
+func parseRoleRequirementRequests(reqs []RoleRequirementRequest) ([]appshift.RoleRequirementInput, error) {
+	inputs := make([]appshift.RoleRequirementInput, 0, len(reqs))
+	for _, req := range reqs {
+		roleID, err := common.ParseRoleID(req.RoleID)
+		if err != nil {
+			return nil, err
+		}
+		kind := shift.RoleRequirementKind(req.Kind)
+		if kind == "" {
+			kind = shift.RoleRequirementRequired
+		}
+		inputs = append(inputs, appshift.RoleRequirementInput{
+			RoleID: roleID,
+			Kind:   kind,
+			Count:  req.Count,
+		})
+	}
+	return inputs, nil
+}
+
+func toRoleRequirementResponses(requirements []shift.RoleRequirement) []RoleRequirementResponse {
+	responses := make([]RoleRequirementResponse, 0, len(requirements))
+	for _, req := range requirements {
+		responses = append(responses, RoleRequirementResponse{
+			RoleID: req.RoleID().String(),
+			Kind:   string(req.Kind()),
+			Count:  req.Count(),
+		})
+	}
+	return responses
+}
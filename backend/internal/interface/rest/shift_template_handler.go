@@ -52,6 +52,8 @@ type TemplateItemRequest struct {
 	EndTime       string `json:"end_time"`   // HH:MM:SS
 	RequiredCount int    `json:"required_count"`
 	Priority      int    `json:"priority"`
+
+	RoleRequirements []RoleRequirementRequest `json:"role_requirements,omitempty"`
 }
 
 // CreateTemplateRequest represents the request body for creating a template
@@ -83,6 +85,8 @@ type TemplateItemResponse struct {
 	EndTime       string `json:"end_time"`
 	RequiredCount int    `json:"required_count"`
 	Priority      int    `json:"priority"`
+
+	RoleRequirements []RoleRequirementResponse `json:"role_requirements"`
 }
 
 // TemplateResponse represents a template in API responses
@@ -93,6 +97,7 @@ type TemplateResponse struct {
 	TemplateName string                 `json:"template_name"`
 	Description  string                 `json:"description"`
 	Items        []TemplateItemResponse `json:"items"`
+	Version      int                    `json:"version"` // 更新のたびに 1 増える
 	CreatedAt    string                 `json:"created_at"`
 	UpdatedAt    string                 `json:"updated_at"`
 }
@@ -154,6 +159,12 @@ func (h *ShiftTemplateHandler) CreateTemplate(w http.ResponseWriter, r *http.Req
 			return
 		}
 
+		roleRequirements, err := parseRoleRequirementRequests(itemReq.RoleRequirements)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid role_id format in role_requirements", nil)
+			return
+		}
+
 		items = append(items, appshift.TemplateItemInput{
 			SlotName:      itemReq.SlotName,
 			InstanceName:  itemReq.InstanceName,
@@ -161,6 +172,8 @@ func (h *ShiftTemplateHandler) CreateTemplate(w http.ResponseWriter, r *http.Req
 			EndTime:       endTime,
 			RequiredCount: itemReq.RequiredCount,
 			Priority:      itemReq.Priority,
+
+			RoleRequirements: roleRequirements,
 		})
 	}
 
@@ -331,6 +344,12 @@ func (h *ShiftTemplateHandler) UpdateTemplate(w http.ResponseWriter, r *http.Req
 			return
 		}
 
+		roleRequirements, err := parseRoleRequirementRequests(itemReq.RoleRequirements)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid role_id format in role_requirements", nil)
+			return
+		}
+
 		items = append(items, appshift.TemplateItemInput{
 			SlotName:      itemReq.SlotName,
 			InstanceName:  itemReq.InstanceName,
@@ -338,6 +357,8 @@ func (h *ShiftTemplateHandler) UpdateTemplate(w http.ResponseWriter, r *http.Req
 			EndTime:       endTime,
 			RequiredCount: itemReq.RequiredCount,
 			Priority:      itemReq.Priority,
+
+			RoleRequirements: roleRequirements,
 		})
 	}
 
@@ -469,6 +490,8 @@ func (h *ShiftTemplateHandler) toTemplateResponse(template *shift.ShiftSlotTempl
 			EndTime:       item.EndTime().Format("15:04:05"),
 			RequiredCount: item.RequiredCount(),
 			Priority:      item.Priority(),
+
+			RoleRequirements: toRoleRequirementResponses(item.RoleRequirements()),
 		})
 	}
 
@@ -479,6 +502,7 @@ func (h *ShiftTemplateHandler) toTemplateResponse(template *shift.ShiftSlotTempl
 		TemplateName: template.TemplateName(),
 		Description:  template.Description(),
 		Items:        items,
+		Version:      template.Version(),
 		CreatedAt:    template.CreatedAt().Format(time.RFC3339),
 		UpdatedAt:    template.UpdatedAt().Format(time.RFC3339),
 	}
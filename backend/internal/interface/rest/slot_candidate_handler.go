@@ -0,0 +1,143 @@
+package rest
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	appshift "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/go-chi/chi/v5"
+)
+
+// SlotCandidateHandler handles shift slot candidate ranking HTTP requests
+type SlotCandidateHandler struct {
+	listUC *appshift.ListSlotCandidatesUsecase
+}
+
+// NewSlotCandidateHandler creates a new SlotCandidateHandler with injected usecases
+func NewSlotCandidateHandler(listUC *appshift.ListSlotCandidatesUsecase) *SlotCandidateHandler {
+	return &SlotCandidateHandler{
+		listUC: listUC,
+	}
+}
+
+// CandidateRoleResponse represents an unmet role requirement the candidate can fill
+type CandidateRoleResponse struct {
+	RoleID string `json:"role_id"`
+	Kind   string `json:"kind"`
+}
+
+// CandidateShiftResponse represents another confirmed shift of the candidate that night
+type CandidateShiftResponse struct {
+	AssignmentID string `json:"assignment_id"`
+	SlotID       string `json:"slot_id"`
+	SlotName     string `json:"slot_name"`
+	StartAt      string `json:"start_at"`
+	EndAt        string `json:"end_at"`
+	Overlaps     bool   `json:"overlaps"`
+}
+
+// SlotCandidateResponse represents a ranked candidate in API responses
+type SlotCandidateResponse struct {
+	Rank               int                         `json:"rank"`
+	MemberID           string                      `json:"member_id"`
+	DisplayName        string                      `json:"display_name"`
+	Score              int                         `json:"score"`
+	Reason             string                      `json:"reason"`
+	AttendanceResponse *string                     `json:"attendance_response"` // attending / absent / undecided（未回答は null）
+	AvailableFrom      *string                     `json:"available_from"`
+	AvailableTo        *string                     `json:"available_to"`
+	CoversSlot         *bool                       `json:"covers_slot"`
+	Available          bool                        `json:"available"` // 参加可能時間帯・ブラックアウト日による判定
+	UnavailableReason  *string                     `json:"unavailable_reason,omitempty"`
+	MatchedRoles       []CandidateRoleResponse     `json:"matched_roles"`
+	RoleViolation      bool                        `json:"role_violation"`
+	ShiftsThatNight    []CandidateShiftResponse    `json:"shifts_that_night"`
+	RecentShiftCount   int                         `json:"recent_shift_count"`
+	WorkloadViolations []WorkloadViolationResponse `json:"workload_violations"`
+}
+
+// ListCandidates handles GET /api/v1/shift-slots/{slot_id}/candidates
+func (h *SlotCandidateHandler) ListCandidates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	slotID := shift.SlotID(chi.URLParam(r, "slot_id"))
+	if err := slotID.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid slot_id format", nil)
+		return
+	}
+
+	result, err := h.listUC.Execute(ctx, appshift.ListSlotCandidatesInput{
+		TenantID: tenantID,
+		SlotID:   slotID,
+	})
+	if err != nil {
+		log.Printf("ListSlotCandidates error: %+v", err)
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	// 枠の時刻はテナントのタイムゾーンの壁時計なので、実際の日時に変換して返す
+	loc := GetTenantLocation(ctx)
+	slotStart, slotEnd := result.Slot.PeriodIn(result.BusinessDay.TargetDate(), loc)
+	candidates := make([]SlotCandidateResponse, 0, len(result.Candidates))
+	for i, c := range result.Candidates {
+		resp := SlotCandidateResponse{
+			Rank:               i + 1,
+			MemberID:           c.Member.MemberID().String(),
+			DisplayName:        c.Member.DisplayName(),
+			Score:              c.Score,
+			Reason:             c.Reason,
+			AvailableFrom:      c.AvailableFrom,
+			AvailableTo:        c.AvailableTo,
+			CoversSlot:         c.CoversSlot,
+			Available:          c.Availability.Available,
+			MatchedRoles:       make([]CandidateRoleResponse, 0, len(c.MatchedRoles)),
+			RoleViolation:      !c.RoleCheck.Satisfiable(),
+			ShiftsThatNight:    make([]CandidateShiftResponse, 0, len(c.ShiftsThatNight)),
+			RecentShiftCount:   c.RecentShiftCount,
+			WorkloadViolations: toWorkloadViolationResponses(c.WorkloadViolations),
+		}
+		if c.AttendanceResponse != "" {
+			response := string(c.AttendanceResponse)
+			resp.AttendanceResponse = &response
+		}
+		if !c.Availability.Available {
+			reason := string(c.Availability.Reason)
+			resp.UnavailableReason = &reason
+		}
+		for _, req := range c.MatchedRoles {
+			resp.MatchedRoles = append(resp.MatchedRoles, CandidateRoleResponse{
+				RoleID: req.RoleID().String(),
+				Kind:   string(req.Kind()),
+			})
+		}
+		for _, s := range c.ShiftsThatNight {
+			startAt, endAt := s.PeriodIn(loc)
+			resp.ShiftsThatNight = append(resp.ShiftsThatNight, CandidateShiftResponse{
+				AssignmentID: s.AssignmentID.String(),
+				SlotID:       s.SlotID.String(),
+				SlotName:     s.SlotName,
+				StartAt:      startAt.Format(time.RFC3339),
+				EndAt:        endAt.Format(time.RFC3339),
+				Overlaps:     startAt.Before(slotEnd) && slotStart.Before(endAt),
+			})
+		}
+		candidates = append(candidates, resp)
+	}
+
+	writeSuccess(w, http.StatusOK, map[string]interface{}{
+		"slot_id":         result.Slot.SlotID().String(),
+		"business_day_id": result.BusinessDay.BusinessDayID().String(),
+		"start_at":        slotStart.Format(time.RFC3339),
+		"end_at":          slotEnd.Format(time.RFC3339),
+		"candidates":      candidates,
+	})
+}
@@ -0,0 +1,278 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	appshift "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/go-chi/chi/v5"
+)
+
+// StandbyHandler handles shift slot standby list HTTP requests
+type StandbyHandler struct {
+	joinUC     *appshift.JoinStandbyUsecase
+	listUC     *appshift.ListStandbyUsecase
+	acceptUC   *appshift.AcceptStandbyOfferUsecase
+	declineUC  *appshift.DeclineStandbyOfferUsecase
+	withdrawUC *appshift.WithdrawStandbyUsecase
+}
+
+// NewStandbyHandler creates a new StandbyHandler with injected usecases
+func NewStandbyHandler(
+	joinUC *appshift.JoinStandbyUsecase,
+	listUC *appshift.ListStandbyUsecase,
+	acceptUC *appshift.AcceptStandbyOfferUsecase,
+	declineUC *appshift.DeclineStandbyOfferUsecase,
+	withdrawUC *appshift.WithdrawStandbyUsecase,
+) *StandbyHandler {
+	return &StandbyHandler{
+		joinUC:     joinUC,
+		listUC:     listUC,
+		acceptUC:   acceptUC,
+		declineUC:  declineUC,
+		withdrawUC: withdrawUC,
+	}
+}
+
+// JoinStandbyRequest represents the request body for joining a slot's standby list
+type JoinStandbyRequest struct {
+	MemberID   string `json:"member_id"`
+	AutoAccept bool   `json:"auto_accept"` // true: 空きが出たら確認なしで割り当てる
+	Note       string `json:"note"`
+}
+
+// StandbyResponse represents a standby entry in API responses
+type StandbyResponse struct {
+	StandbyID      string  `json:"standby_id"`
+	TenantID       string  `json:"tenant_id"`
+	SlotID         string  `json:"slot_id"`
+	MemberID       string  `json:"member_id"`
+	Position       int     `json:"position"`
+	AutoAccept     bool    `json:"auto_accept"`
+	Status         string  `json:"status"`
+	OfferExpiresAt *string `json:"offer_expires_at,omitempty"`
+	AssignmentID   *string `json:"assignment_id,omitempty"`
+	Note           string  `json:"note"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+// actingMember returns the acting member, or nil for admins acting on behalf of members
+// Admin (JWT認証) でも Member (X-Member-ID認証) でもない場合は 403 を返す
+func actingMember(w http.ResponseWriter, r *http.Request) (*common.MemberID, bool) {
+	if _, ok := GetAdminIDFromContext(r.Context()); ok {
+		return nil, true
+	}
+	memberID, ok := getMemberIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Member ID or Admin ID is required", nil)
+		return nil, false
+	}
+	return &memberID, true
+}
+
+// JoinStandby handles POST /api/v1/shift-slots/{slot_id}/standby
+func (h *StandbyHandler) JoinStandby(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	// Admin (JWT認証) は代理で登録できる。Member (X-Member-ID認証) は自分のみ
+	actorMemberID, ok := actingMember(w, r)
+	if !ok {
+		return
+	}
+
+	slotID, err := shift.ParseSlotID(chi.URLParam(r, "slot_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid slot_id format", nil)
+		return
+	}
+
+	var req JoinStandbyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body", nil)
+		return
+	}
+
+	// member_id 省略時はメンバー本人を登録する
+	var memberID common.MemberID
+	if req.MemberID != "" {
+		memberID, err = common.ParseMemberID(req.MemberID)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid member_id format", nil)
+			return
+		}
+	} else if actorMemberID != nil {
+		memberID = *actorMemberID
+	} else {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "member_id is required", nil)
+		return
+	}
+
+	entry, err := h.joinUC.Execute(ctx, appshift.JoinStandbyInput{
+		TenantID:      tenantID,
+		SlotID:        slotID,
+		MemberID:      memberID,
+		ActorMemberID: actorMemberID,
+		AutoAccept:    req.AutoAccept,
+		Note:          req.Note,
+	})
+	if err != nil {
+		log.Printf("JoinStandby error: %+v", err)
+		respondStandbyError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, toStandbyResponse(entry))
+}
+
+// ListStandby handles GET /api/v1/shift-slots/{slot_id}/standby
+func (h *StandbyHandler) ListStandby(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	slotID, err := shift.ParseSlotID(chi.URLParam(r, "slot_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid slot_id format", nil)
+		return
+	}
+
+	entries, err := h.listUC.Execute(ctx, appshift.ListStandbyInput{
+		TenantID: tenantID,
+		SlotID:   slotID,
+	})
+	if err != nil {
+		respondStandbyError(w, err)
+		return
+	}
+
+	responses := make([]StandbyResponse, 0, len(entries))
+	for _, entry := range entries {
+		responses = append(responses, toStandbyResponse(entry))
+	}
+
+	writeSuccess(w, http.StatusOK, map[string]interface{}{
+		"standbys": responses,
+		"count":    len(responses),
+	})
+}
+
+// AcceptStandbyOffer handles POST /api/v1/shift-standbys/{standby_id}/accept
+func (h *StandbyHandler) AcceptStandbyOffer(w http.ResponseWriter, r *http.Request) {
+	h.handleAction(w, r, h.acceptUC.Execute)
+}
+
+// DeclineStandbyOffer handles POST /api/v1/shift-standbys/{standby_id}/decline
+func (h *StandbyHandler) DeclineStandbyOffer(w http.ResponseWriter, r *http.Request) {
+	h.handleAction(w, r, h.declineUC.Execute)
+}
+
+// WithdrawStandby handles DELETE /api/v1/shift-standbys/{standby_id}
+func (h *StandbyHandler) WithdrawStandby(w http.ResponseWriter, r *http.Request) {
+	h.handleAction(w, r, h.withdrawUC.Execute)
+}
+
+// handleAction parses the standby entry and actor, then runs the action usecase
+func (h *StandbyHandler) handleAction(
+	w http.ResponseWriter,
+	r *http.Request,
+	execute func(ctx context.Context, input appshift.StandbyActionInput) (*shift.StandbyEntry, error),
+) {
+	ctx := r.Context()
+
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	actorMemberID, ok := actingMember(w, r)
+	if !ok {
+		return
+	}
+
+	standbyID, err := shift.ParseStandbyID(chi.URLParam(r, "standby_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid standby_id format", nil)
+		return
+	}
+
+	entry, err := execute(ctx, appshift.StandbyActionInput{
+		TenantID:      tenantID,
+		StandbyID:     standbyID,
+		ActorMemberID: actorMemberID,
+	})
+	if err != nil {
+		log.Printf("Standby action error: %+v", err)
+		respondStandbyError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, toStandbyResponse(entry))
+}
+
+// respondStandbyError maps errors from standby operations to HTTP responses
+func respondStandbyError(w http.ResponseWriter, err error) {
+	var conflictErr *shift.AssignmentConflictError
+	if errors.As(err, &conflictErr) {
+		writeError(w, http.StatusConflict, "ERR_ASSIGNMENT_CONFLICT", conflictErr.Error(), nil)
+		return
+	}
+	if errors.Is(err, shift.ErrSlotFull) {
+		writeError(w, http.StatusConflict, "ERR_SLOT_FULL", shift.ErrSlotFull.Message, nil)
+		return
+	}
+	if errors.Is(err, shift.ErrStandbyOfferExpired) {
+		writeError(w, http.StatusConflict, "ERR_OFFER_EXPIRED", shift.ErrStandbyOfferExpired.Message, nil)
+		return
+	}
+
+	// リポジトリのエラーはラップされているため、ドメインエラーを取り出して応答する
+	var domainErr *common.DomainError
+	if errors.As(err, &domainErr) {
+		RespondDomainError(w, domainErr)
+		return
+	}
+	RespondDomainError(w, err)
+}
+
+func toStandbyResponse(entry *shift.StandbyEntry) StandbyResponse {
+	resp := StandbyResponse{
+		StandbyID:  entry.StandbyID().String(),
+		TenantID:   entry.TenantID().String(),
+		SlotID:     entry.SlotID().String(),
+		MemberID:   entry.MemberID().String(),
+		Position:   entry.Position(),
+		AutoAccept: entry.AutoAccept(),
+		Status:     string(entry.Status()),
+		Note:       entry.Note(),
+		CreatedAt:  entry.CreatedAt().Format(time.RFC3339),
+		UpdatedAt:  entry.UpdatedAt().Format(time.RFC3339),
+	}
+	if entry.OfferExpiresAt() != nil {
+		s := entry.OfferExpiresAt().Format(time.RFC3339)
+		resp.OfferExpiresAt = &s
+	}
+	if entry.AssignmentID() != nil {
+		s := entry.AssignmentID().String()
+		resp.AssignmentID = &s
+	}
+
+	return resp
+}
@@ -0,0 +1,416 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	appshift "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/shift"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/shift"
+	"github.com/go-chi/chi/v5"
+)
+
+// SwapRequestHandler handles shift swap / drop request HTTP requests
+type SwapRequestHandler struct {
+	createUC     *appshift.CreateSwapRequestUsecase
+	listUC       *appshift.ListSwapRequestsUsecase
+	getByTokenUC *appshift.GetSwapRequestByTokenUsecase
+	claimUC      *appshift.ClaimSwapRequestUsecase
+	approveUC    *appshift.ApproveSwapRequestUsecase
+	rejectUC     *appshift.RejectSwapRequestUsecase
+	cancelUC     *appshift.CancelSwapRequestUsecase
+}
+
+// NewSwapRequestHandler creates a new SwapRequestHandler with injected usecases
+func NewSwapRequestHandler(
+	createUC *appshift.CreateSwapRequestUsecase,
+	listUC *appshift.ListSwapRequestsUsecase,
+	getByTokenUC *appshift.GetSwapRequestByTokenUsecase,
+	claimUC *appshift.ClaimSwapRequestUsecase,
+	approveUC *appshift.ApproveSwapRequestUsecase,
+	rejectUC *appshift.RejectSwapRequestUsecase,
+	cancelUC *appshift.CancelSwapRequestUsecase,
+) *SwapRequestHandler {
+	return &SwapRequestHandler{
+		createUC:     createUC,
+		listUC:       listUC,
+		getByTokenUC: getByTokenUC,
+		claimUC:      claimUC,
+		approveUC:    approveUC,
+		rejectUC:     rejectUC,
+		cancelUC:     cancelUC,
+	}
+}
+
+// CreateSwapRequestRequest represents the request body for offering an assignment
+type CreateSwapRequestRequest struct {
+	AssignmentID     string `json:"assignment_id"`
+	RequestType      string `json:"request_type"` // drop | swap
+	RequiresApproval bool   `json:"requires_approval"`
+	Note             string `json:"note"`
+}
+
+// ClaimSwapRequestRequest represents the request body for claiming a swap request
+type ClaimSwapRequestRequest struct {
+	MemberID            string `json:"member_id"`
+	OfferedAssignmentID string `json:"offered_assignment_id,omitempty"` // swap の場合に必須
+}
+
+// SwapRequestResponse represents a swap request in API responses
+type SwapRequestResponse struct {
+	SwapRequestID        string  `json:"swap_request_id"`
+	TenantID             string  `json:"tenant_id"`
+	RequestType          string  `json:"request_type"`
+	AssignmentID         string  `json:"assignment_id"`
+	RequesterMemberID    string  `json:"requester_member_id"`
+	PublicToken          string  `json:"public_token"`
+	PublicURL            string  `json:"public_url"`
+	RequiresApproval     bool    `json:"requires_approval"`
+	Status               string  `json:"status"`
+	Note                 string  `json:"note"`
+	ClaimantMemberID     *string `json:"claimant_member_id,omitempty"`
+	ClaimantAssignmentID *string `json:"claimant_assignment_id,omitempty"`
+	NewAssignmentID      *string `json:"new_assignment_id,omitempty"`
+	CounterAssignmentID  *string `json:"counter_assignment_id,omitempty"`
+	ClaimedAt            *string `json:"claimed_at,omitempty"`
+	DecidedByAdminID     *string `json:"decided_by_admin_id,omitempty"`
+	DecidedAt            *string `json:"decided_at,omitempty"`
+	CreatedAt            string  `json:"created_at"`
+	UpdatedAt            string  `json:"updated_at"`
+}
+
+// PublicSwapRequestResponse represents a swap request on the public claim page
+type PublicSwapRequestResponse struct {
+	RequestType          string `json:"request_type"`
+	Status               string `json:"status"`
+	RequiresApproval     bool   `json:"requires_approval"`
+	Note                 string `json:"note"`
+	RequesterDisplayName string `json:"requester_display_name"`
+	SlotName             string `json:"slot_name"`
+	TargetDate           string `json:"target_date"`
+	StartTime            string `json:"start_time"`
+	EndTime              string `json:"end_time"`
+}
+
+// CreateSwapRequest handles POST /api/v1/shift-swaps
+func (h *SwapRequestHandler) CreateSwapRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	// Admin (JWT認証) は代理で依頼できる。Member (X-Member-ID認証) は自分の割り当てのみ
+	var actorMemberID *common.MemberID
+	if _, ok := GetAdminIDFromContext(ctx); !ok {
+		memberID, ok := getMemberIDFromContext(ctx)
+		if !ok {
+			writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Member ID or Admin ID is required", nil)
+			return
+		}
+		actorMemberID = &memberID
+	}
+
+	var req CreateSwapRequestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body", nil)
+		return
+	}
+
+	assignmentID, err := shift.ParseAssignmentID(req.AssignmentID)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid assignment_id format", nil)
+		return
+	}
+
+	requestType := shift.SwapRequestType(req.RequestType)
+	if requestType == "" {
+		requestType = shift.SwapRequestTypeDrop
+	}
+
+	swapReq, err := h.createUC.Execute(ctx, appshift.CreateSwapRequestInput{
+		TenantID:         tenantID,
+		AssignmentID:     assignmentID,
+		ActorMemberID:    actorMemberID,
+		RequestType:      requestType,
+		RequiresApproval: req.RequiresApproval,
+		Note:             req.Note,
+	})
+	if err != nil {
+		log.Printf("CreateSwapRequest error: %+v", err)
+		RespondDomainError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, toSwapRequestResponse(swapReq))
+}
+
+// ListSwapRequests handles GET /api/v1/shift-swaps
+func (h *SwapRequestHandler) ListSwapRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	input := appshift.ListSwapRequestsInput{
+		TenantID: tenantID,
+		Status:   shift.SwapRequestStatus(r.URL.Query().Get("status")),
+	}
+	if memberIDStr := r.URL.Query().Get("member_id"); memberIDStr != "" {
+		memberID, err := common.ParseMemberID(memberIDStr)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid member_id format", nil)
+			return
+		}
+		input.MemberID = &memberID
+	}
+
+	reqs, err := h.listUC.Execute(ctx, input)
+	if err != nil {
+		RespondDomainError(w, err)
+		return
+	}
+
+	responses := make([]SwapRequestResponse, 0, len(reqs))
+	for _, req := range reqs {
+		responses = append(responses, toSwapRequestResponse(req))
+	}
+
+	writeSuccess(w, http.StatusOK, map[string]interface{}{
+		"swap_requests": responses,
+		"count":         len(responses),
+	})
+}
+
+// ApproveSwapRequest handles POST /api/v1/shift-swaps/{swap_request_id}/approve
+func (h *SwapRequestHandler) ApproveSwapRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	adminID, ok := GetAdminIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Admin authentication is required", nil)
+		return
+	}
+
+	swapRequestID, err := shift.ParseSwapRequestID(chi.URLParam(r, "swap_request_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid swap_request_id format", nil)
+		return
+	}
+
+	swapReq, err := h.approveUC.Execute(ctx, appshift.ApproveSwapRequestInput{
+		TenantID:      tenantID,
+		SwapRequestID: swapRequestID,
+		AdminID:       adminID,
+	})
+	if err != nil {
+		log.Printf("ApproveSwapRequest error: %+v", err)
+		respondSwapError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, toSwapRequestResponse(swapReq))
+}
+
+// RejectSwapRequest handles POST /api/v1/shift-swaps/{swap_request_id}/reject
+func (h *SwapRequestHandler) RejectSwapRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	adminID, ok := GetAdminIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Admin authentication is required", nil)
+		return
+	}
+
+	swapRequestID, err := shift.ParseSwapRequestID(chi.URLParam(r, "swap_request_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid swap_request_id format", nil)
+		return
+	}
+
+	swapReq, err := h.rejectUC.Execute(ctx, appshift.RejectSwapRequestInput{
+		TenantID:      tenantID,
+		SwapRequestID: swapRequestID,
+		AdminID:       adminID,
+	})
+	if err != nil {
+		RespondDomainError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, toSwapRequestResponse(swapReq))
+}
+
+// CancelSwapRequest handles DELETE /api/v1/shift-swaps/{swap_request_id}
+func (h *SwapRequestHandler) CancelSwapRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tenantID, ok := getTenantIDFromContext(ctx)
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	var actorMemberID *common.MemberID
+	if _, ok := GetAdminIDFromContext(ctx); !ok {
+		memberID, ok := getMemberIDFromContext(ctx)
+		if !ok {
+			writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Member ID or Admin ID is required", nil)
+			return
+		}
+		actorMemberID = &memberID
+	}
+
+	swapRequestID, err := shift.ParseSwapRequestID(chi.URLParam(r, "swap_request_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid swap_request_id format", nil)
+		return
+	}
+
+	swapReq, err := h.cancelUC.Execute(ctx, appshift.CancelSwapRequestInput{
+		TenantID:      tenantID,
+		SwapRequestID: swapRequestID,
+		ActorMemberID: actorMemberID,
+	})
+	if err != nil {
+		RespondDomainError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, toSwapRequestResponse(swapReq))
+}
+
+// GetSwapRequestByToken handles GET /api/v1/public/shift-swaps/{token}
+func (h *SwapRequestHandler) GetSwapRequestByToken(w http.ResponseWriter, r *http.Request) {
+	detail, err := h.getByTokenUC.Execute(r.Context(), appshift.GetSwapRequestByTokenInput{
+		PublicToken: chi.URLParam(r, "token"),
+	})
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			writeError(w, http.StatusNotFound, "ERR_NOT_FOUND", "Swap request not found", nil)
+			return
+		}
+		log.Printf("GetSwapRequestByToken error: %+v", err)
+		writeError(w, http.StatusInternalServerError, "ERR_INTERNAL", "Failed to fetch swap request", nil)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, PublicSwapRequestResponse{
+		RequestType:          string(detail.Request.RequestType()),
+		Status:               string(detail.Request.Status()),
+		RequiresApproval:     detail.Request.RequiresApproval(),
+		Note:                 detail.Request.Note(),
+		RequesterDisplayName: detail.RequesterDisplayName,
+		SlotName:             detail.SlotName,
+		TargetDate:           detail.TargetDate.Format("2006-01-02"),
+		StartTime:            detail.StartTime.Format("15:04"),
+		EndTime:              detail.EndTime.Format("15:04"),
+	})
+}
+
+// ClaimSwapRequest handles POST /api/v1/public/shift-swaps/{token}/claim
+func (h *SwapRequestHandler) ClaimSwapRequest(w http.ResponseWriter, r *http.Request) {
+	var req ClaimSwapRequestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body", nil)
+		return
+	}
+
+	swapReq, err := h.claimUC.Execute(r.Context(), appshift.ClaimSwapRequestInput{
+		PublicToken:         chi.URLParam(r, "token"),
+		MemberID:            req.MemberID,
+		OfferedAssignmentID: req.OfferedAssignmentID,
+	})
+	if err != nil {
+		log.Printf("ClaimSwapRequest error: %+v", err)
+		respondSwapError(w, err)
+		return
+	}
+
+	// 公開APIでは公開トークン等の管理情報を返さない
+	writeSuccess(w, http.StatusOK, map[string]interface{}{
+		"status":            string(swapReq.Status()),
+		"requires_approval": swapReq.RequiresApproval(),
+	})
+}
+
+// respondSwapError maps errors from executing a swap to HTTP responses
+func respondSwapError(w http.ResponseWriter, err error) {
+	var conflictErr *shift.AssignmentConflictError
+	if errors.As(err, &conflictErr) {
+		writeError(w, http.StatusConflict, "ERR_ASSIGNMENT_CONFLICT", conflictErr.Error(), nil)
+		return
+	}
+	if errors.Is(err, shift.ErrSwapNotEligible) {
+		writeError(w, http.StatusForbidden, "ERR_NOT_ELIGIBLE", shift.ErrSwapNotEligible.Message, nil)
+		return
+	}
+	RespondDomainError(w, err)
+}
+
+func toSwapRequestResponse(req *shift.SwapRequest) SwapRequestResponse {
+	formatTime := func(t *time.Time) *string {
+		if t == nil {
+			return nil
+		}
+		s := t.Format(time.RFC3339)
+		return &s
+	}
+	assignmentIDStr := func(id *shift.AssignmentID) *string {
+		if id == nil {
+			return nil
+		}
+		s := id.String()
+		return &s
+	}
+
+	resp := SwapRequestResponse{
+		SwapRequestID:        req.SwapRequestID().String(),
+		TenantID:             req.TenantID().String(),
+		RequestType:          string(req.RequestType()),
+		AssignmentID:         req.AssignmentID().String(),
+		RequesterMemberID:    req.RequesterMemberID().String(),
+		PublicToken:          req.PublicToken().String(),
+		PublicURL:            "/api/v1/public/shift-swaps/" + req.PublicToken().String(),
+		RequiresApproval:     req.RequiresApproval(),
+		Status:               string(req.Status()),
+		Note:                 req.Note(),
+		ClaimantAssignmentID: assignmentIDStr(req.ClaimantAssignmentID()),
+		NewAssignmentID:      assignmentIDStr(req.NewAssignmentID()),
+		CounterAssignmentID:  assignmentIDStr(req.CounterAssignmentID()),
+		ClaimedAt:            formatTime(req.ClaimedAt()),
+		DecidedAt:            formatTime(req.DecidedAt()),
+		CreatedAt:            req.CreatedAt().Format(time.RFC3339),
+		UpdatedAt:            req.UpdatedAt().Format(time.RFC3339),
+	}
+	if req.ClaimantMemberID() != nil {
+		s := req.ClaimantMemberID().String()
+		resp.ClaimantMemberID = &s
+	}
+	if req.DecidedByAdminID() != nil {
+		s := req.DecidedByAdminID().String()
+		resp.DecidedByAdminID = &s
+	}
+
+	return resp
+}
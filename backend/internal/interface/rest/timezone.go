@@ -0,0 +1,22 @@
+package rest
+
+import (
+	"context"
+	"time"
+
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+)
+
+// parseDeadline parses an optional deadline in a request body
+// RFC3339 はそのまま、オフセットなしの日時・日付のみの値はテナントのタイムゾーンで解釈する
+// （日付のみの場合はその日の終わり）
+func parseDeadline(ctx context.Context, value *string) (*time.Time, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	deadline, err := common.ParseDeadlineIn(*value, GetTenantLocation(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &deadline, nil
+}
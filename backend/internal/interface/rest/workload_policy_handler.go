@@ -0,0 +1,326 @@
+package rest
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	appmember "github.com/erenoa/vrc-shift-scheduler/backend/internal/app/member"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/common"
+	"github.com/erenoa/vrc-shift-scheduler/backend/internal/domain/member"
+	"github.com/go-chi/chi/v5"
+)
+
+// WorkloadPolicyHandler handles workload policy and workload report HTTP requests
+type WorkloadPolicyHandler struct {
+	getPolicyUC    *appmember.GetWorkloadPolicyUsecase
+	putPolicyUC    *appmember.PutWorkloadPolicyUsecase
+	deletePolicyUC *appmember.DeleteWorkloadPolicyUsecase
+	reportUC       *appmember.GetWorkloadReportUsecase
+}
+
+// NewWorkloadPolicyHandler creates a new WorkloadPolicyHandler with injected usecases
+func NewWorkloadPolicyHandler(
+	getPolicyUC *appmember.GetWorkloadPolicyUsecase,
+	putPolicyUC *appmember.PutWorkloadPolicyUsecase,
+	deletePolicyUC *appmember.DeleteWorkloadPolicyUsecase,
+	reportUC *appmember.GetWorkloadReportUsecase,
+) *WorkloadPolicyHandler {
+	return &WorkloadPolicyHandler{
+		getPolicyUC:    getPolicyUC,
+		putPolicyUC:    putPolicyUC,
+		deletePolicyUC: deletePolicyUC,
+		reportUC:       reportUC,
+	}
+}
+
+// WorkloadLimitsPayload represents workload limits in API requests and responses
+// null の項目は制限なし（メンバー個別ポリシーではテナントの既定値を引き継ぐ）
+type WorkloadLimitsPayload struct {
+	MaxShiftsPerWeek            *int `json:"max_shifts_per_week"`
+	MaxShiftsPerMonth           *int `json:"max_shifts_per_month"`
+	MinShiftsPerMonth           *int `json:"min_shifts_per_month"`
+	MaxMinutesPerBusinessDay    *int `json:"max_minutes_per_business_day"`
+	MinRestMinutesBetweenShifts *int `json:"min_rest_minutes_between_shifts"`
+}
+
+// WorkloadPolicyRequest represents the request body for setting a workload policy
+type WorkloadPolicyRequest struct {
+	WorkloadLimitsPayload
+	Enforcement string `json:"enforcement"` // warn / block（メンバー個別では省略するとテナントの設定を引き継ぐ）
+}
+
+// WorkloadPolicyResponse represents a workload policy in API responses
+type WorkloadPolicyResponse struct {
+	PolicyID    string                `json:"policy_id"`
+	MemberID    *string               `json:"member_id"`
+	Limits      WorkloadLimitsPayload `json:"limits"`
+	Enforcement string                `json:"enforcement"`
+	CreatedAt   string                `json:"created_at"`
+	UpdatedAt   string                `json:"updated_at"`
+}
+
+// WorkloadViolationResponse represents a workload limit violation in API responses
+type WorkloadViolationResponse struct {
+	Kind        string `json:"kind"`
+	Limit       int    `json:"limit"`
+	Actual      int    `json:"actual"`
+	PeriodStart string `json:"period_start"`
+}
+
+// MemberWorkloadResponse represents a member's workload in the report
+type MemberWorkloadResponse struct {
+	MemberID     string                      `json:"member_id"`
+	DisplayName  string                      `json:"display_name"`
+	ShiftCount   int                         `json:"shift_count"`
+	TotalMinutes int                         `json:"total_minutes"`
+	Status       string                      `json:"status"` // over / under / within
+	Limits       WorkloadLimitsPayload       `json:"limits"`
+	Violations   []WorkloadViolationResponse `json:"violations"`
+}
+
+// GetTenantPolicy handles GET /api/v1/members/workload-policy
+func (h *WorkloadPolicyHandler) GetTenantPolicy(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := getTenantIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	h.getPolicy(w, r, appmember.GetWorkloadPolicyInput{TenantID: tenantID})
+}
+
+// GetMemberPolicy handles GET /api/v1/members/{member_id}/workload-policy
+// Member (X-Member-ID認証) は自分のポリシーのみ参照できる
+func (h *WorkloadPolicyHandler) GetMemberPolicy(w http.ResponseWriter, r *http.Request) {
+	tenantID, memberID, actorMemberID, ok := availabilityTarget(w, r)
+	if !ok {
+		return
+	}
+
+	h.getPolicy(w, r, appmember.GetWorkloadPolicyInput{
+		TenantID:      tenantID,
+		MemberID:      &memberID,
+		ActorMemberID: actorMemberID,
+	})
+}
+
+func (h *WorkloadPolicyHandler) getPolicy(w http.ResponseWriter, r *http.Request, input appmember.GetWorkloadPolicyInput) {
+	result, err := h.getPolicyUC.Execute(r.Context(), input)
+	if err != nil {
+		log.Printf("GetWorkloadPolicy error: %+v", err)
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	var policy *WorkloadPolicyResponse
+	if result.Policy != nil {
+		resp := toWorkloadPolicyResponse(result.Policy)
+		policy = &resp
+	}
+
+	writeSuccess(w, http.StatusOK, map[string]interface{}{
+		"policy":                policy,
+		"effective_limits":      toWorkloadLimitsPayload(result.Limits),
+		"effective_enforcement": string(result.Enforcement),
+	})
+}
+
+// PutTenantPolicy handles PUT /api/v1/members/workload-policy
+func (h *WorkloadPolicyHandler) PutTenantPolicy(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := getTenantIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	h.putPolicy(w, r, tenantID, nil)
+}
+
+// PutMemberPolicy handles PUT /api/v1/members/{member_id}/workload-policy
+func (h *WorkloadPolicyHandler) PutMemberPolicy(w http.ResponseWriter, r *http.Request) {
+	tenantID, memberID, ok := workloadPolicyTarget(w, r)
+	if !ok {
+		return
+	}
+
+	h.putPolicy(w, r, tenantID, &memberID)
+}
+
+func (h *WorkloadPolicyHandler) putPolicy(w http.ResponseWriter, r *http.Request, tenantID common.TenantID, memberID *common.MemberID) {
+	var req WorkloadPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid request body", nil)
+		return
+	}
+
+	policy, err := h.putPolicyUC.Execute(r.Context(), appmember.PutWorkloadPolicyInput{
+		TenantID: tenantID,
+		MemberID: memberID,
+		Limits: member.WorkloadLimits{
+			MaxShiftsPerWeek:            req.MaxShiftsPerWeek,
+			MaxShiftsPerMonth:           req.MaxShiftsPerMonth,
+			MinShiftsPerMonth:           req.MinShiftsPerMonth,
+			MaxMinutesPerBusinessDay:    req.MaxMinutesPerBusinessDay,
+			MinRestMinutesBetweenShifts: req.MinRestMinutesBetweenShifts,
+		},
+		Enforcement: member.WorkloadEnforcement(req.Enforcement),
+	})
+	if err != nil {
+		log.Printf("PutWorkloadPolicy error: %+v", err)
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, toWorkloadPolicyResponse(policy))
+}
+
+// DeleteTenantPolicy handles DELETE /api/v1/members/workload-policy
+func (h *WorkloadPolicyHandler) DeleteTenantPolicy(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := getTenantIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	h.deletePolicy(w, r, tenantID, nil)
+}
+
+// DeleteMemberPolicy handles DELETE /api/v1/members/{member_id}/workload-policy
+// メンバー個別の上書きを削除し、テナントの既定値に戻す
+func (h *WorkloadPolicyHandler) DeleteMemberPolicy(w http.ResponseWriter, r *http.Request) {
+	tenantID, memberID, ok := workloadPolicyTarget(w, r)
+	if !ok {
+		return
+	}
+
+	h.deletePolicy(w, r, tenantID, &memberID)
+}
+
+func (h *WorkloadPolicyHandler) deletePolicy(w http.ResponseWriter, r *http.Request, tenantID common.TenantID, memberID *common.MemberID) {
+	err := h.deletePolicyUC.Execute(r.Context(), appmember.DeleteWorkloadPolicyInput{
+		TenantID: tenantID,
+		MemberID: memberID,
+	})
+	if err != nil {
+		log.Printf("DeleteWorkloadPolicy error: %+v", err)
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetReport handles GET /api/v1/members/workload-report?start_date=YYYY-MM-DD&end_date=YYYY-MM-DD
+// 既定では上限超過（over）・目標未達（under）のメンバーのみ返す。include_within=true で全員を返す
+func (h *WorkloadPolicyHandler) GetReport(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := getTenantIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return
+	}
+
+	startDate, err := time.Parse("2006-01-02", r.URL.Query().Get("start_date"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid start_date format (expected YYYY-MM-DD)", nil)
+		return
+	}
+	endDate, err := time.Parse("2006-01-02", r.URL.Query().Get("end_date"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid end_date format (expected YYYY-MM-DD)", nil)
+		return
+	}
+
+	report, err := h.reportUC.Execute(r.Context(), appmember.GetWorkloadReportInput{
+		TenantID:      tenantID,
+		From:          startDate,
+		To:            endDate,
+		IncludeWithin: r.URL.Query().Get("include_within") == "true",
+	})
+	if err != nil {
+		log.Printf("GetWorkloadReport error: %+v", err)
+		respondAvailabilityError(w, err)
+		return
+	}
+
+	members := make([]MemberWorkloadResponse, 0, len(report.Members))
+	for _, mw := range report.Members {
+		members = append(members, MemberWorkloadResponse{
+			MemberID:     mw.Member.MemberID().String(),
+			DisplayName:  mw.Member.DisplayName(),
+			ShiftCount:   mw.Summary.ShiftCount,
+			TotalMinutes: mw.Summary.TotalMinutes,
+			Status:       string(mw.Summary.Status),
+			Limits:       toWorkloadLimitsPayload(mw.Limits),
+			Violations:   toWorkloadViolationResponses(mw.Summary.Violations),
+		})
+	}
+
+	writeSuccess(w, http.StatusOK, map[string]interface{}{
+		"start_date": report.From.Format("2006-01-02"),
+		"end_date":   report.To.Format("2006-01-02"),
+		"members":    members,
+	})
+}
+
+// workloadPolicyTarget parses the tenant and target member of an admin request
+func workloadPolicyTarget(w http.ResponseWriter, r *http.Request) (common.TenantID, common.MemberID, bool) {
+	tenantID, ok := getTenantIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "ERR_FORBIDDEN", "Tenant ID is required", nil)
+		return "", "", false
+	}
+
+	memberID, err := common.ParseMemberID(chi.URLParam(r, "member_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "ERR_INVALID_REQUEST", "Invalid member_id format", nil)
+		return "", "", false
+	}
+
+	return tenantID, memberID, true
+}
+
+func toWorkloadLimitsPayload(limits member.WorkloadLimits) WorkloadLimitsPayload {
+	return WorkloadLimitsPayload{
+		MaxShiftsPerWeek:            limits.MaxShiftsPerWeek,
+		MaxShiftsPerMonth:           limits.MaxShiftsPerMonth,
+		MinShiftsPerMonth:           limits.MinShiftsPerMonth,
+		MaxMinutesPerBusinessDay:    limits.MaxMinutesPerBusinessDay,
+		MinRestMinutesBetweenShifts: limits.MinRestMinutesBetweenShifts,
+	}
+}
+
+func toWorkloadPolicyResponse(policy *member.WorkloadPolicy) WorkloadPolicyResponse {
+	var memberID *string
+	if policy.MemberID() != nil {
+		s := policy.MemberID().String()
+		memberID = &s
+	}
+
+	return WorkloadPolicyResponse{
+		PolicyID:    policy.PolicyID().String(),
+		MemberID:    memberID,
+		Limits:      toWorkloadLimitsPayload(policy.Limits()),
+		Enforcement: string(policy.Enforcement()),
+		CreatedAt:   policy.CreatedAt().Format(time.RFC3339),
+		UpdatedAt:   policy.UpdatedAt().Format(time.RFC3339),
+	}
+}
+
+func toWorkloadViolationResponses(violations []member.WorkloadViolation) []WorkloadViolationResponse {
+	resp := make([]WorkloadViolationResponse, 0, len(violations))
+	for _, v := range violations {
+		periodStart := v.PeriodStart.Format("2006-01-02")
+		if v.Kind == member.WorkloadViolationMinRestBetweenShifts {
+			periodStart = v.PeriodStart.Format(time.RFC3339)
+		}
+		resp = append(resp, WorkloadViolationResponse{
+			Kind:        string(v.Kind),
+			Limit:       v.Limit,
+			Actual:      v.Actual,
+			PeriodStart: periodStart,
+		})
+	}
+	return resp
+}
@@ -13,6 +13,7 @@ interface DayItem {
   startTime?: string;
   endTime?: string;
   note?: string;
+  cancelled?: boolean;
 }
 
 type ItemsByDate = Record<string, DayItem[]>;
@@ -40,6 +41,7 @@ export default function CalendarGrid({ events, entries = [] }: CalendarGridProps
           description: event.description,
           startTime: bd.start_time,
           endTime: bd.end_time,
+          cancelled: bd.is_cancelled,
         });
       });
     });
@@ -235,11 +237,13 @@ export default function CalendarGrid({ events, entries = [] }: CalendarGridProps
                     <div
                       key={itemIndex}
                       className={`text-xs rounded px-1 py-0.5 truncate ${
-                        item.type === 'event'
+                        item.cancelled
+                          ? 'bg-gray-100 text-gray-400 line-through'
+                          : item.type === 'event'
                           ? 'bg-accent/10 text-accent'
                           : 'bg-emerald-100 text-emerald-700'
                       }`}
-                      title={`${item.title}${item.startTime ? ` ${item.startTime}` : ''}${item.endTime ? `-${item.endTime}` : ''}`}
+                      title={`${item.cancelled ? '【中止】' : ''}${item.title}${item.startTime ? ` ${item.startTime}` : ''}${item.endTime ? `-${item.endTime}` : ''}`}
                     >
                       {item.startTime && <span className="hidden md:inline">{item.startTime} </span>}
                       {item.title}
@@ -293,6 +297,9 @@ export default function CalendarGrid({ events, entries = [] }: CalendarGridProps
                     }`}>
                       {item.type === 'event' ? 'イベント' : '予定'}
                     </span>
+                    {item.cancelled && (
+                      <span className="text-xs px-1.5 py-0.5 rounded bg-red-100 text-red-700">中止</span>
+                    )}
                   </div>
                 )}
                 {!item.startTime && !item.endTime && (
@@ -306,7 +313,9 @@ export default function CalendarGrid({ events, entries = [] }: CalendarGridProps
                     </span>
                   </div>
                 )}
-                <div className="font-medium text-gray-900">{item.title}</div>
+                <div className={`font-medium ${item.cancelled ? 'text-gray-400 line-through' : 'text-gray-900'}`}>
+                  {item.title}
+                </div>
                 {item.description && (
                   <div className="text-sm text-gray-600 mt-1 whitespace-pre-wrap">
                     {item.description}
@@ -0,0 +1,145 @@
+import { apiClient, ApiClientError } from '../apiClient';
+import type { ApiResponse } from '../../types/api';
+
+/**
+ * 休業日に重なった定期開催日の扱い
+ * skip: 開催しない / move: 休業日でない翌日以降の最初の日に移動する
+ */
+export type BlackoutAction = 'skip' | 'move';
+
+/**
+ * 休業日の型定義
+ */
+export interface BlackoutDate {
+  date: string; // YYYY-MM-DD
+  label: string;
+}
+
+/**
+ * 休業日セットの型定義
+ */
+export interface BlackoutDateSet {
+  set_id: string;
+  tenant_id: string;
+  name: string;
+  description: string;
+  action: BlackoutAction;
+  is_active: boolean;
+  dates: BlackoutDate[];
+  created_at: string;
+  updated_at: string;
+}
+
+/**
+ * 休業日セット作成リクエストの型
+ */
+export interface CreateBlackoutDateSetRequest {
+  name: string;
+  description: string;
+  action?: BlackoutAction;
+  dates?: { date: string; label?: string }[];
+}
+
+/**
+ * 休業日セット更新リクエストの型
+ */
+export interface UpdateBlackoutDateSetRequest {
+  name: string;
+  description: string;
+  action?: BlackoutAction;
+  is_active?: boolean;
+  dates?: { date: string; label?: string }[]; // 指定した場合は全件を置き換える
+}
+
+/**
+ * 休業日インポートのレスポンス型
+ */
+export interface BlackoutImportResponse {
+  added_count: number;
+  blackout_date_set: BlackoutDateSet;
+}
+
+/**
+ * 休業日セット一覧取得
+ */
+export async function getBlackoutDateSets(): Promise<{ blackout_date_sets: BlackoutDateSet[]; count: number }> {
+  const res = await apiClient.get<ApiResponse<{ blackout_date_sets: BlackoutDateSet[]; count: number }>>(
+    '/api/v1/blackout-date-sets'
+  );
+  return res.data;
+}
+
+/**
+ * 休業日セット詳細取得
+ */
+export async function getBlackoutDateSet(setId: string): Promise<BlackoutDateSet> {
+  const res = await apiClient.get<ApiResponse<BlackoutDateSet>>(`/api/v1/blackout-date-sets/${setId}`);
+  return res.data;
+}
+
+/**
+ * 休業日セット作成
+ */
+export async function createBlackoutDateSet(data: CreateBlackoutDateSetRequest): Promise<BlackoutDateSet> {
+  const res = await apiClient.post<ApiResponse<BlackoutDateSet>>('/api/v1/blackout-date-sets', data);
+  return res.data;
+}
+
+/**
+ * 休業日セット更新
+ */
+export async function updateBlackoutDateSet(setId: string, data: UpdateBlackoutDateSetRequest): Promise<BlackoutDateSet> {
+  const res = await apiClient.put<ApiResponse<BlackoutDateSet>>(`/api/v1/blackout-date-sets/${setId}`, data);
+  return res.data;
+}
+
+/**
+ * 休業日セット削除
+ */
+export async function deleteBlackoutDateSet(setId: string): Promise<void> {
+  await apiClient.delete(`/api/v1/blackout-date-sets/${setId}`);
+}
+
+/**
+ * 日本の祝日をインポート（振替休日・国民の休日を含む）
+ */
+export async function importJapaneseHolidays(
+  setId: string,
+  fromYear: number,
+  toYear: number
+): Promise<BlackoutImportResponse> {
+  const res = await apiClient.post<ApiResponse<BlackoutImportResponse>>(
+    `/api/v1/blackout-date-sets/${setId}/import/jp-holidays`,
+    { from_year: fromYear, to_year: toYear }
+  );
+  return res.data;
+}
+
+/**
+ * ICS ファイルから休業日をインポート
+ */
+export async function importBlackoutICS(setId: string, file: File): Promise<BlackoutImportResponse> {
+  const formData = new FormData();
+  formData.append('file', file);
+
+  const headers: HeadersInit = {};
+  const authToken = localStorage.getItem('auth_token');
+  if (authToken) {
+    headers['Authorization'] = `Bearer ${authToken}`;
+  }
+
+  const res = await fetch(
+    `${import.meta.env.VITE_API_BASE_URL || ''}/api/v1/blackout-date-sets/${setId}/import/ics`,
+    { method: 'POST', headers, body: formData }
+  );
+  const json = await res.json().catch(() => ({}));
+  if (!res.ok) {
+    throw new ApiClientError(
+      json.error?.message || `HTTP ${res.status}: ${res.statusText}`,
+      res.status,
+      json.error?.code || 'ERR_UNKNOWN',
+      json.error?.details
+    );
+  }
+  return json.data;
+}
@@ -11,6 +11,7 @@ export async function createBusinessDay(
     start_time: string; // HH:MM
     end_time: string; // HH:MM
     occurrence_type: 'recurring' | 'special';
+    end_date?: string; // optional: 複数日営業の最終日 YYYY-MM-DD
     template_id?: string; // optional: テンプレートから自動的にシフト枠を作成
   }
 ): Promise<BusinessDay> {
@@ -80,3 +81,78 @@ export async function deleteBusinessDay(businessDayId: string): Promise<void> {
   await apiClient.delete(`/api/v1/business-days/${businessDayId}`);
 }
 
+
+/**
+ * 営業日の一括変更の種類
+ * shift_time: 時刻をずらす / move: 日付を移動する / cancel: 開催を中止する
+ */
+export type BulkBusinessDayOperation = 'shift_time' | 'move' | 'cancel';
+
+/**
+ * 営業日一括変更リクエストの型
+ * business_day_ids または start_date〜end_date のどちらかで対象を指定する
+ */
+export interface BulkUpdateBusinessDaysRequest {
+  business_day_ids?: string[];
+  start_date?: string; // YYYY-MM-DD
+  end_date?: string; // YYYY-MM-DD
+  operation: BulkBusinessDayOperation;
+  start_shift_minutes?: number;
+  end_shift_minutes?: number;
+  move_days?: number;
+  cancel_reason?: string;
+  dry_run?: boolean;
+}
+
+/**
+ * 営業日一括変更レスポンスの型
+ */
+export interface BulkUpdateBusinessDaysResponse {
+  business_days: (BusinessDay & {
+    previous_target_date: string;
+    previous_start_time: string;
+    previous_end_time: string;
+    slot_count: number;
+  })[];
+  affected_members: {
+    member_id: string;
+    display_name: string;
+    business_day_id: string;
+    slot_id: string;
+    slot_name: string;
+  }[];
+  count: number;
+  dry_run: boolean;
+}
+
+/**
+ * 営業日を一括変更（dry_run: true で変更内容と影響するメンバーを確認）
+ */
+export async function bulkUpdateBusinessDays(
+  eventId: string,
+  data: BulkUpdateBusinessDaysRequest
+): Promise<BulkUpdateBusinessDaysResponse> {
+  const res = await apiClient.post<ApiResponse<BulkUpdateBusinessDaysResponse>>(
+    `/api/v1/events/${eventId}/business-days/bulk`,
+    data
+  );
+  return res.data;
+}
+
+/**
+ * 営業日を中止（シフト枠・割り当ては残る）
+ */
+export async function cancelBusinessDay(businessDayId: string, reason: string): Promise<BusinessDay> {
+  const res = await apiClient.post<ApiResponse<BusinessDay>>(`/api/v1/business-days/${businessDayId}/cancel`, {
+    reason,
+  });
+  return res.data;
+}
+
+/**
+ * 営業日の中止を取り消し
+ */
+export async function uncancelBusinessDay(businessDayId: string): Promise<BusinessDay> {
+  const res = await apiClient.delete<ApiResponse<BusinessDay>>(`/api/v1/business-days/${businessDayId}/cancel`);
+  return res.data;
+}
@@ -8,9 +8,10 @@ export interface CreateEventRequest {
   event_name: string;
   event_type: 'normal' | 'special';
   description: string;
-  recurrence_type?: 'none' | 'weekly' | 'biweekly';
+  recurrence_type?: 'none' | 'weekly' | 'biweekly' | 'rrule';
   recurrence_start_date?: string; // YYYY-MM-DD
   recurrence_day_of_week?: number; // 0-6: 日曜=0, 土曜=6
+  recurrence_rule?: string; // 例: FREQ=MONTHLY;BYDAY=-1FR
   default_start_time?: string; // HH:MM:SS
   default_end_time?: string; // HH:MM:SS
 }
@@ -28,6 +29,7 @@ export async function createEvent(data: CreateEventRequest): Promise<Event> {
  */
 export async function getEvents(params?: {
   is_active?: boolean;
+  status?: 'active' | 'archived' | 'all'; // 省略時は active（アーカイブ済みを除く）
 }): Promise<EventListResponse> {
   const res = await apiClient.get<ApiResponse<EventListResponse>>('/api/v1/events', params);
   return res.data;
@@ -49,6 +51,7 @@ export async function updateEvent(
   data: {
     event_name?: string;
     description?: string;
+    default_template_id?: string; // 空文字で解除
   }
 ): Promise<Event> {
   const res = await apiClient.put<ApiResponse<Event>>(`/api/v1/events/${eventId}`, data);
@@ -62,6 +65,22 @@ export async function deleteEvent(eventId: string): Promise<void> {
   await apiClient.delete(`/api/v1/events/${eventId}`);
 }
 
+/**
+ * Event アーカイブ（履歴は保持し、営業日の追加・シフトの割り当てを停止）
+ */
+export async function archiveEvent(eventId: string): Promise<Event> {
+  const res = await apiClient.post<ApiResponse<Event>>(`/api/v1/events/${eventId}/archive`, {});
+  return res.data;
+}
+
+/**
+ * アーカイブ済みの Event を復元
+ */
+export async function restoreEvent(eventId: string): Promise<Event> {
+  const res = await apiClient.post<ApiResponse<Event>>(`/api/v1/events/${eventId}/restore`, {});
+  return res.data;
+}
+
 /**
  * 営業日を自動生成（定期イベント用）
  * 指定された月数分の営業日を生成する
@@ -103,6 +122,33 @@ export async function updateEventGroupAssignments(
   return res.data;
 }
 
+/**
+ * イベント複製リクエストの型
+ */
+export interface CloneEventRequest {
+  event_name?: string; // 省略時は「<複製元のイベント名> (コピー)」
+  include_business_days?: boolean; // 開始前の営業日とシフト枠も複製する（割り当ては複製しない）
+}
+
+/**
+ * イベント複製レスポンスの型
+ */
+export interface CloneEventResponse {
+  event: Event;
+  instance_count: number;
+  template_count: number;
+  business_day_count: number;
+  shift_slot_count: number;
+}
+
+/**
+ * イベントを複製（インスタンス・テンプレート・グループ割り当てをコピー）
+ */
+export async function cloneEvent(eventId: string, data: CloneEventRequest): Promise<CloneEventResponse> {
+  const res = await apiClient.post<ApiResponse<CloneEventResponse>>(`/api/v1/events/${eventId}/clone`, data);
+  return res.data;
+}
+
 // BusinessDay 型は types/api.ts から re-export
 export type { BusinessDay };
 
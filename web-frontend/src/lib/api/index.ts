@@ -44,3 +44,5 @@ export * from './billingApi';
 // Calendar API
 export * from './calendarApi';
 
+// BlackoutDate API（定期開催の休業日）
+export * from './blackoutDateApi';
@@ -91,6 +91,7 @@ export interface AttendanceCollection {
   public_token: string;
   status: 'open' | 'closed';
   deadline?: string;
+  timezone?: string; // Tenant timezone (IANA)
   group_ids?: string[]; // Target group IDs
   role_ids?: string[]; // Target role IDs
   created_at: string;
@@ -237,6 +238,7 @@ export interface DateSchedule {
   public_token: string;
   status: 'open' | 'closed' | 'decided';
   deadline?: string;
+  timezone?: string; // Tenant timezone (IANA)
   decided_candidate_id?: string;
   candidates: ScheduleCandidate[];
   group_ids?: string[]; // Target group IDs
@@ -324,6 +326,8 @@ export interface PublicBusinessDay {
   date: string;
   start_time: string;
   end_time: string;
+  end_date: string; // 深夜営業は翌日、複数日営業は最終日
+  is_cancelled: boolean; // 中止された営業日
 }
 
 export interface PublicEvent {
@@ -14,6 +14,7 @@ export async function createShiftSlot(
     end_time: string; // HH:MM
     required_count: number;
     priority?: number;
+    day_offset?: number; // optional - 省略時は営業日の開始時刻から判定
   }
 ): Promise<ShiftSlot> {
   const res = await apiClient.post<ApiResponse<ShiftSlot>>(
@@ -1,6 +1,7 @@
 import { apiClient } from '../apiClient';
 import type {
   ApiResponse,
+  BusinessDay,
   Template,
   TemplateListResponse,
   CreateTemplateRequest,
@@ -83,3 +84,55 @@ export async function saveBusinessDayAsTemplate(
   );
   return res.data;
 }
+
+/**
+ * テンプレート反映時のシフト枠の定義
+ */
+export interface TemplateSlotDefinition {
+  start_time: string; // HH:MM:SS
+  end_time: string; // HH:MM:SS
+  day_offset: number;
+  required_count: number;
+  priority: number;
+  role_requirements: { role_id: string; kind: string; count: number }[];
+}
+
+/**
+ * テンプレート反映レスポンスの型
+ * skipped: 割り当てがあるため削除しなかった枠
+ */
+export interface SyncTemplateToFutureDaysResponse {
+  template_id: string;
+  version: number;
+  business_days: (BusinessDay & {
+    previous_template_version: number;
+    changes: {
+      type: 'add' | 'update' | 'remove';
+      slot_id: string | null;
+      slot_name: string;
+      instance_name: string;
+      before: TemplateSlotDefinition | null;
+      after: TemplateSlotDefinition | null;
+      assigned_count: number;
+      skipped: boolean;
+    }[];
+  })[];
+  count: number;
+  change_count: number;
+  dry_run: boolean;
+}
+
+/**
+ * テンプレートの最新バージョンを将来の営業日に反映（dry_run: true で差分を確認）
+ */
+export async function syncTemplateToFutureDays(
+  eventId: string,
+  templateId: string,
+  dryRun: boolean
+): Promise<SyncTemplateToFutureDaysResponse> {
+  const res = await apiClient.post<ApiResponse<SyncTemplateToFutureDaysResponse>>(
+    `/api/v1/events/${eventId}/templates/${templateId}/apply-to-future-days`,
+    { dry_run: dryRun }
+  );
+  return res.data;
+}
@@ -318,7 +318,12 @@ export default function BusinessDayList() {
                           })}
                         </div>
                         <div className="text-sm text-gray-600">
-                          {day.start_time.slice(0, 5)} 〜 {day.end_time.slice(0, 5)}
+                          {day.start_time.slice(0, 5)} 〜{' '}
+                          {day.end_date !== day.target_date &&
+                            (day.end_date === nextDate(day.target_date)
+                              ? '翌'
+                              : `${day.end_date.slice(5).replace('-', '/')} `)}
+                          {day.end_time.slice(0, 5)}
                         </div>
                       </div>
                       <div className="flex items-center gap-2">
@@ -350,6 +355,11 @@ export default function BusinessDayList() {
                         </button>
                       </div>
                     </div>
+                    {day.is_cancelled && (
+                      <div className="mt-2 text-xs text-red-600">
+                        （中止{day.cancellation_reason ? `: ${day.cancellation_reason}` : ''}）
+                      </div>
+                    )}
                     {!day.is_active && (
                       <div className="mt-2 text-xs text-red-600">（非アクティブ）</div>
                     )}
@@ -782,3 +792,9 @@ function CreateBusinessDayModal({
   );
 }
 
+// 翌日の日付（YYYY-MM-DD）を返す（深夜営業の終了日表示用）
+function nextDate(date: string): string {
+  const d = new Date(`${date}T00:00:00Z`);
+  d.setUTCDate(d.getUTCDate() + 1);
+  return d.toISOString().slice(0, 10);
+}
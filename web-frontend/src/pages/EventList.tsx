@@ -25,6 +25,7 @@ const RECURRENCE_TYPE_LABELS: Record<string, string> = {
   none: 'なし',
   weekly: '毎週',
   biweekly: '隔週',
+  rrule: 'カスタム',
 };
 
 export default function EventList() {
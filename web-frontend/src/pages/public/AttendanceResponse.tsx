@@ -333,7 +333,7 @@ export default function AttendanceResponse() {
           )}
           {collection?.deadline && (
             <p className="text-sm text-gray-500">
-              締切: {new Date(collection.deadline).toLocaleString('ja-JP')}
+              締切: {new Date(collection.deadline).toLocaleString('ja-JP', { timeZone: collection.timezone })}
             </p>
           )}
           {collection?.status === 'closed' && (
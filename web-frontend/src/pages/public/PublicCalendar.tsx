@@ -134,7 +134,10 @@ export default function PublicCalendar() {
                         {event.business_days.slice(0, 5).map((bd, bdIndex) => (
                           <span
                             key={bdIndex}
-                            className="text-xs bg-gray-100 text-gray-700 rounded px-2 py-1"
+                            className={`text-xs rounded px-2 py-1 ${
+                              bd.is_cancelled ? 'bg-red-50 text-gray-400 line-through' : 'bg-gray-100 text-gray-700'
+                            }`}
+                            title={bd.is_cancelled ? '中止' : undefined}
                           >
                             {new Date(bd.date + 'T00:00:00').toLocaleDateString('ja-JP', {
                               month: 'short',
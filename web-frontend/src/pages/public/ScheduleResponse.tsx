@@ -251,7 +251,7 @@ export default function ScheduleResponse() {
           )}
           {schedule?.deadline && (
             <p className="text-sm text-gray-500">
-              締切: {new Date(schedule.deadline).toLocaleString('ja-JP')}
+              締切: {new Date(schedule.deadline).toLocaleString('ja-JP', { timeZone: schedule.timezone })}
             </p>
           )}
           {schedule?.status === 'closed' && (
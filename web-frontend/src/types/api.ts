@@ -22,11 +22,15 @@ export interface Event {
   event_type: 'normal' | 'special';
   description: string;
   is_active: boolean;
-  recurrence_type: 'none' | 'weekly' | 'biweekly';
+  recurrence_type: 'none' | 'weekly' | 'biweekly' | 'rrule';
   recurrence_start_date?: string; // YYYY-MM-DD
   recurrence_day_of_week?: number; // 0-6: 日曜=0, 土曜=6
+  recurrence_rule?: string; // iCalendar 形式（DTSTART / RRULE / EXDATE / RDATE）
   default_start_time?: string; // HH:MM:SS
   default_end_time?: string; // HH:MM:SS
+  default_template_id?: string; // 自動生成した営業日に適用するシフト枠テンプレート
+  is_archived: boolean; // アーカイブ済みのイベントは一覧・公開カレンダーに表示されない
+  archived_at?: string;
   created_at: string;
   updated_at: string;
 }
@@ -52,6 +56,16 @@ export interface BusinessDay {
   end_time: string; // HH:MM:SS
   occurrence_type: 'recurring' | 'special';
   is_active: boolean;
+  end_date: string; // YYYY-MM-DD（深夜営業は翌日、複数日営業は最終日）
+  starts_at: string; // RFC3339（テナントのタイムゾーン）
+  ends_at: string; // RFC3339（テナントのタイムゾーン）
+  duration_minutes: number;
+  is_cancelled: boolean;
+  cancelled_at: string | null;
+  cancelled_by_admin_id: string | null;
+  cancellation_reason: string;
+  applied_template_id: string | null; // シフト枠の生成元テンプレート
+  applied_template_version: number | null;
   created_at: string;
   updated_at: string;
 }
@@ -92,6 +106,9 @@ export interface ShiftSlot {
   assigned_count?: number; // API から取得時に含まれる
   priority: number;
   is_overnight: boolean;
+  day_offset: number; // 営業日の target_date から枠の開始日までの日数
+  duration_minutes: number;
+  template_id?: string; // テンプレートから生成した枠の場合のみ
   created_at: string;
   updated_at: string;
 }
@@ -180,6 +197,7 @@ export interface Template {
   template_name: string;
   description: string;
   items: TemplateItem[];
+  version: number; // 更新のたびに 1 ずつ増える
   created_at: string;
   updated_at: string;
 }